@@ -67,6 +67,8 @@ func init() {
 		logCmd,
 
 		sqlShellCmd,
+		dumpCmd,
+		importCmd,
 		kvCmd,
 		acctCmd,
 		permCmd,
@@ -19,6 +19,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"strings"
@@ -49,8 +50,11 @@ func newCLITest() cliTest {
 }
 
 func (c cliTest) Run(line string) {
-	a := strings.Fields(line)
+	c.RunWithArgs(strings.Fields(line))
+}
 
+// RunWithArgs runs a command whose arguments may contain spaces.
+func (c cliTest) RunWithArgs(a []string) {
 	var args []string
 	args = append(args, a[0])
 	args = append(args, fmt.Sprintf("--addr=%s", c.ServingAddr()))
@@ -59,7 +63,7 @@ func (c cliTest) Run(line string) {
 	args = append(args, a[1:]...)
 
 	fmt.Fprintf(os.Stderr, "%s\n", args)
-	fmt.Println(line)
+	fmt.Println(strings.Join(a, " "))
 	if err := Run(args); err != nil {
 		fmt.Println(err)
 	}
@@ -230,3 +234,202 @@ func Example_logging() {
 	// kv --verbosity=0 scan
 	// kv --vmodule=foo=1 scan
 }
+
+func Example_sql() {
+	c := newCLITest()
+
+	c.RunWithArgs([]string{"sql", "-e", "create database t; create table t.f (x int primary key, y char)"})
+	c.RunWithArgs([]string{"sql", "-e", "insert into t.f values (42, 'a,b'), (43, NULL)"})
+	c.RunWithArgs([]string{"sql", "-e", "select * from t.f; select x from t.f where x > 42"})
+	c.RunWithArgs([]string{"sql", "--format=csv", "-e", "select * from t.f"})
+	c.RunWithArgs([]string{"sql", "--format=tsv", "-e", "select * from t.f"})
+	c.RunWithArgs([]string{"sql", "--format=json", "-e", "select * from t.f"})
+	c.RunWithArgs([]string{"sql", "--format=table", "-e", `\dt t`})
+	c.RunWithArgs([]string{"sql", "-e", "select * from t.g"})
+	c.Run("quit")
+
+	// Output:
+	// sql -e create database t; create table t.f (x int primary key, y char)
+	// OK
+	// OK
+	// sql -e insert into t.f values (42, 'a,b'), (43, NULL)
+	// OK
+	// sql -e select * from t.f; select x from t.f where x > 42
+	// x	y
+	// 42	a,b
+	// 43	NULL
+	// x
+	// 43
+	// sql --format=csv -e select * from t.f
+	// x,y
+	// 42,"a,b"
+	// 43,NULL
+	// sql --format=tsv -e select * from t.f
+	// x	y
+	// 42	a,b
+	// 43	NULL
+	// sql --format=json -e select * from t.f
+	// [
+	//   {"x": 42, "y": "a,b"},
+	//   {"x": 43, "y": null}
+	// ]
+	// sql --format=table -e \dt t
+	// Table
+	// f
+	// sql -e select * from t.g
+	// Error: query error: table "t.g" does not exist
+	// quit
+	// node drained and shutdown: ok
+}
+
+func Example_sql_format() {
+	c := newCLITest()
+
+	c.RunWithArgs([]string{"sql", "-e", "create database t; create table t.f (x int primary key, y char); insert into t.f values (42, 'a,b')"})
+
+	// Without --format, the shell prints the results of each input in the
+	// session's output_format.
+	db := makeSQLClient()
+	db.SetMaxOpenConns(1)
+	shell := &sqlShell{db: db, w: os.Stdout}
+	runScript(shell, strings.NewReader(`SHOW output_format;
+SET output_format = 'csv';
+SELECT * FROM t.f;
+SET output_format = DEFAULT;
+SELECT x FROM t.f;
+`))
+	db.Close()
+	c.Run("quit")
+
+	// Output:
+	// sql -e create database t; create table t.f (x int primary key, y char); insert into t.f values (42, 'a,b')
+	// OK
+	// OK
+	// OK
+	// output_format
+	// table
+	// OK
+	// x,y
+	// 42,"a,b"
+	// x
+	// 42
+	// quit
+	// node drained and shutdown: ok
+}
+
+func Example_dump() {
+	c := newCLITest()
+
+	c.RunWithArgs([]string{"sql", "-e", "create database d; create table d.parent (id int primary key, v char, constraint parent_v unique (v))"})
+	c.RunWithArgs([]string{"sql", "-e", "create table d.child (id int primary key, parent_id int references d.parent (id) on delete cascade, n int default 1, check (n > 0))"})
+	c.RunWithArgs([]string{"sql", "-e", "create view d.vals as select v from d.parent"})
+	c.RunWithArgs([]string{"sql", "-e", "insert into d.parent values (1, 'it''s'), (2, NULL); insert into d.child values (10, 1, 3)"})
+	c.Run("dump d")
+	c.Run("dump d parent")
+	c.Run("dump d missing")
+	c.Run("quit")
+
+	// Output:
+	// sql -e create database d; create table d.parent (id int primary key, v char, constraint parent_v unique (v))
+	// OK
+	// OK
+	// sql -e create table d.child (id int primary key, parent_id int references d.parent (id) on delete cascade, n int default 1, check (n > 0))
+	// OK
+	// sql -e create view d.vals as select v from d.parent
+	// OK
+	// sql -e insert into d.parent values (1, 'it''s'), (2, NULL); insert into d.child values (10, 1, 3)
+	// OK
+	// OK
+	// dump d
+	// CREATE DATABASE IF NOT EXISTS d;
+	// SET DATABASE = d;
+	//
+	// CREATE TABLE parent (
+	// 	id INT,
+	// 	v CHAR,
+	// 	PRIMARY KEY (id),
+	// 	CONSTRAINT parent_v UNIQUE (v)
+	// );
+	// INSERT INTO parent (id, v) VALUES
+	// 	(1, e'it\'s'),
+	// 	(2, NULL);
+	//
+	// CREATE TABLE child (
+	// 	id INT,
+	// 	parent_id INT,
+	// 	n INT DEFAULT 1,
+	// 	PRIMARY KEY (id),
+	// 	CONSTRAINT fk_parent_id_ref_parent FOREIGN KEY (parent_id) REFERENCES parent (id) ON DELETE CASCADE,
+	// 	CONSTRAINT check_n CHECK (n > 0)
+	// );
+	// INSERT INTO child (id, parent_id, n) VALUES
+	// 	(10, 1, 3);
+	//
+	// CREATE VIEW vals (v) AS SELECT v FROM d.parent;
+	// dump d parent
+	// CREATE DATABASE IF NOT EXISTS d;
+	// SET DATABASE = d;
+	//
+	// CREATE TABLE parent (
+	// 	id INT,
+	// 	v CHAR,
+	// 	PRIMARY KEY (id),
+	// 	CONSTRAINT parent_v UNIQUE (v)
+	// );
+	// INSERT INTO parent (id, v) VALUES
+	// 	(1, e'it\'s'),
+	// 	(2, NULL);
+	// dump d missing
+	// dump failed: table "d.missing" does not exist
+	// quit
+	// node drained and shutdown: ok
+}
+
+func Example_import() {
+	c := newCLITest()
+
+	dir, err := ioutil.TempDir("", "import")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// The file is imported from the temporary directory using a relative path
+	// so that the output does not depend on the directory.
+	wd, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		log.Fatal(err)
+	}
+	defer func() {
+		if err := os.Chdir(wd); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	if err := ioutil.WriteFile("f.csv", []byte("1,a\n2,\"b,c\"\n3,NULL\n"), 0600); err != nil {
+		log.Fatal(err)
+	}
+
+	c.RunWithArgs([]string{"sql", "-e", "create database t; create table t.f (x int primary key, y char)"})
+	c.Run("import csv --table=t.f f.csv")
+	c.RunWithArgs([]string{"sql", "--format=table", "-e", "select * from t.f"})
+	c.Run("import csv --table=t.g f.csv")
+	c.Run("quit")
+
+	// Output:
+	// sql -e create database t; create table t.f (x int primary key, y char)
+	// OK
+	// OK
+	// import csv --table=t.f f.csv
+	// imported 3 rows
+	// sql --format=table -e select * from t.f
+	// x	y
+	// 1	a
+	// 2	b,c
+	// 3	NULL
+	// import csv --table=t.g f.csv
+	// import failed: table "t.g" does not exist
+	// quit
+	// node drained and shutdown: ok
+}
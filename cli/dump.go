@@ -0,0 +1,58 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cockroachdb/cockroach/sql"
+
+	"github.com/spf13/cobra"
+)
+
+// A dumpCmd command dumps the tables of a database as SQL statements.
+var dumpCmd = &cobra.Command{
+	Use:   "dump [options] <database> [<table>...]",
+	Short: "dump sql tables",
+	Long: `
+Dumps the specified tables of <database>, or all of its tables, views and
+sequences if none are specified, as SQL statements which recreate them and
+their rows. All of the tables are read at a single timestamp, so the dump
+is transactionally consistent.
+
+The dump starts by creating and switching to <database>. It can be restored
+into another database by editing these statements, as all of the tables are
+referred to by their unqualified names.
+`,
+	Run: runDump,
+}
+
+func runDump(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		cmd.Usage()
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	if err := sql.Dump(kvDB, os.Stdout, args[0], args[1:]...); err != nil {
+		fmt.Fprintf(osStderr, "dump failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
@@ -67,6 +67,20 @@ var flagUsage = map[string]string{
 	"certs": `
         Directory containing RSA key and x509 certs. This flag is required if
         --insecure=false.
+`,
+	"closed-timestamp-lag": `
+        Adjusts how far behind the present timestamps are closed to further
+        writes. Reads below closed timestamps may be served by any replica.
+        Zero disables such follower reads.
+`,
+	"execute": `
+        Execute the SQL statements and exit instead of starting an
+        interactive shell.
+`,
+	"format": `
+        The format of the results of SQL statements: table, csv, tsv or json.
+        By default, the format is taken from the session's output_format,
+        which is table unless changed with SET output_format.
 `,
 	"gossip": `
         A comma-separated list of gossip addresses or resolvers for gossip
@@ -90,6 +104,11 @@ var flagUsage = map[string]string{
         Enables linearizable behaviour of operations on this node by making
         sure that no commit timestamp is reported back to the client until all
         other node clocks have necessarily passed it.
+`,
+	"range-concurrency": `
+        Adjusts the maximum number of ranges to which a read or
+        transactional write spanning ranges is sent in parallel. One
+        visits the ranges sequentially.
 `,
 	"insecure": `
         Run over plain HTTP. WARNING: this is strongly discouraged.
@@ -103,6 +122,9 @@ var flagUsage = map[string]string{
 `,
 	"metrics-frequency": `
         Adjust the frequency at which the server records its own internal metrics.
+`,
+	"pgaddr": `
+        The host:port to bind for PostgreSQL wire protocol traffic
 `,
 	"scan-interval": `
         Adjusts the target for the duration of a single scan through a store's
@@ -112,6 +134,10 @@ var flagUsage = map[string]string{
 	"scan-max-idle-time": `
         Adjusts the max idle time of the scanner. This speeds up the scanner on small
         clusters to be more responsive.
+`,
+	"slow-query-threshold": `
+        SQL statements taking longer than this duration are logged along
+        with their plans. Zero disables the logging.
 `,
 	"stores": `
         A comma-separated list of stores, specified by a colon-separated list
@@ -123,6 +149,15 @@ var flagUsage = map[string]string{
         200kiops, etc.). For example:
 
           --stores=hdd:7200rpm=/mnt/hda1,ssd=/mnt/ssd01,ssd=/mnt/ssd02,mem=1073741824.
+`,
+	"table": `
+        The table to import into, qualified with its database, e.g. db.t.
+`,
+	"txn-wait-timeout": `
+        Adjusts how long a transaction waits for a conflicting transaction
+        to finish before restarting. A wait ends at the latest when the
+        heartbeat of the conflicting transaction expires. Waiting
+        transactions detect deadlocks. Zero disables waiting.
 `,
 }
 
@@ -151,11 +186,14 @@ func initFlags(ctx *server.Context) {
 	if f := startCmd.Flags(); true {
 		// Server flags.
 		f.StringVar(&ctx.Addr, "addr", ctx.Addr, flagUsage["addr"])
+		f.StringVar(&ctx.PGAddr, "pgaddr", ctx.PGAddr, flagUsage["pgaddr"])
 		f.StringVar(&ctx.Attrs, "attrs", ctx.Attrs, flagUsage["attrs"])
 		f.StringVar(&ctx.Stores, "stores", ctx.Stores, flagUsage["stores"])
 		f.DurationVar(&ctx.MaxOffset, "max-offset", ctx.MaxOffset, flagUsage["max-offset"])
 		f.DurationVar(&ctx.MetricsFrequency, "metrics-frequency", ctx.MetricsFrequency,
 			flagUsage["metrics-frequency"])
+		f.DurationVar(&ctx.SlowQueryThreshold, "slow-query-threshold", ctx.SlowQueryThreshold,
+			flagUsage["slow-query-threshold"])
 
 		// Security flags.
 		f.StringVar(&ctx.Certs, "certs", ctx.Certs, flagUsage["certs"])
@@ -168,12 +206,18 @@ func initFlags(ctx *server.Context) {
 
 		// KV flags.
 		f.BoolVar(&ctx.Linearizable, "linearizable", ctx.Linearizable, flagUsage["linearizable"])
+		f.IntVar(&ctx.RangeConcurrency, "range-concurrency", ctx.RangeConcurrency,
+			flagUsage["range-concurrency"])
 
 		// Engine flags.
 		f.Int64Var(&ctx.CacheSize, "cache-size", ctx.CacheSize, flagUsage["cache-size"])
 		f.DurationVar(&ctx.ScanInterval, "scan-interval", ctx.ScanInterval, flagUsage["scan-interval"])
 		f.DurationVar(&ctx.ScanMaxIdleTime, "scan-max-idle-time", ctx.ScanMaxIdleTime,
 			flagUsage["scan-max-idle-time"])
+		f.DurationVar(&ctx.ClosedTimestampLag, "closed-timestamp-lag", ctx.ClosedTimestampLag,
+			flagUsage["closed-timestamp-lag"])
+		f.DurationVar(&ctx.TxnWaitTimeout, "txn-wait-timeout", ctx.TxnWaitTimeout,
+			flagUsage["txn-wait-timeout"])
 
 		if err := startCmd.MarkFlagRequired("gossip"); err != nil {
 			panic(err)
@@ -202,7 +246,19 @@ func initFlags(ctx *server.Context) {
 		}
 	}
 
-	clientCmds := []*cobra.Command{sqlShellCmd, kvCmd, rangeCmd, acctCmd, permCmd, userCmd, zoneCmd, quitCmd}
+	if f := sqlShellCmd.Flags(); true {
+		f.StringVarP(&sqlExecute, "execute", "e", sqlExecute, flagUsage["execute"])
+		f.StringVar(&sqlFormat, "format", sqlFormat, flagUsage["format"])
+	}
+
+	if f := importCSVCmd.Flags(); true {
+		f.StringVar(&importTable, "table", importTable, flagUsage["table"])
+		if err := importCSVCmd.MarkFlagRequired("table"); err != nil {
+			panic(err)
+		}
+	}
+
+	clientCmds := []*cobra.Command{sqlShellCmd, dumpCmd, importCmd, kvCmd, rangeCmd, acctCmd, permCmd, userCmd, zoneCmd, quitCmd}
 	for _, cmd := range clientCmds {
 		f := cmd.PersistentFlags()
 		f.StringVar(&ctx.Addr, "addr", ctx.Addr, flagUsage["addr"])
@@ -0,0 +1,93 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/cockroach/sql"
+
+	"github.com/spf13/cobra"
+)
+
+// importTable is the table into which rows are imported.
+var importTable string
+
+// An importCSVCmd command imports the rows of a CSV file into a table.
+var importCSVCmd = &cobra.Command{
+	Use:   "csv [options] --table=<db.table> <file>",
+	Short: "import the rows of a csv file into a table",
+	Long: `
+Imports the rows of <file> into the table. Each record of the file holds the
+values of all of the columns of the table, in order. Empty values of
+non-string columns and values spelled NULL are imported as NULL.
+
+The rows are written in large batches. If an import is interrupted, running
+it again with the same file and table resumes after the last rows known to
+have been written.
+`,
+	Run: runImportCSV,
+}
+
+func runImportCSV(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	path, err := filepath.Abs(args[0])
+	if err != nil {
+		fmt.Fprintf(osStderr, "import failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintf(osStderr, "import failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	defer f.Close()
+
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	rows, err := sql.Import(kvDB, f, importTable, path)
+	if err != nil {
+		fmt.Fprintf(osStderr, "import failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	fmt.Printf("imported %d rows\n", rows)
+}
+
+var importCmds = []*cobra.Command{
+	importCSVCmd,
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "import rows into sql tables",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+	},
+}
+
+func init() {
+	importCmd.AddCommand(importCmds...)
+}
@@ -18,20 +18,44 @@
 package cli
 
 import (
+	"bufio"
 	"database/sql"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"text/tabwriter"
+	"time"
+	"unicode"
 
 	// Import cockroach driver.
 	_ "github.com/cockroachdb/cockroach/sql/driver"
-	"github.com/cockroachdb/cockroach/util"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
+// Options of the sql command.
+var (
+	// sqlExecute holds the statements to execute instead of starting an
+	// interactive shell.
+	sqlExecute string
+	// sqlFormat is the format in which results are printed. If empty, the
+	// session's output_format is used.
+	sqlFormat = ""
+)
+
+// maxHistoryLines is the number of lines kept in the history file.
+const maxHistoryLines = 1000
+
+const (
+	promptStart    = "> "
+	promptContinue = "-> "
+)
+
 func makeSQLClient() *sql.DB {
 	// TODO(pmattis): Initialize the user to something more
 	// reasonable. Perhaps Context.Addr should be considered a URL.
@@ -53,60 +77,319 @@ var sqlShellCmd = &cobra.Command{
 	Short: "open a sql shell",
 	Long: `
 Open a sql shell running against the cockroach database at --addr.
+
+Statements are terminated by a semicolon and may span several lines. The
+shell also accepts the following meta-commands:
+
+  \d <table>  show the columns of a table
+  \dt [db]    show the tables of a database, by default the current one
+  \l          show the databases
+  \timing     toggle the display of the execution time of statements
+  \q          exit the shell
+
+Results are printed in the format given by --format or, by default, in the
+session's output_format, which SET output_format changes for the following
+input. With --execute, the statements are executed and the shell exits without
+reading any input. If the input is not a terminal, the statements are read
+from it without prompting.
 `,
 	Run: runTerm,
 }
 
-// processOneLine takes a line from the terminal, runs it,
-// and displays the result.
-// TODO(marc): handle multi-line, this will require ';' terminated statements.
-func processOneLine(db *sql.DB, line string, w io.Writer) (bool, error) {
-	// Look for the first word in the statement and use it to decide what to do.
-	words := strings.Split(line, " ")
-	if len(words) == 0 {
+// sqlShell reads statements, executes them and prints their results.
+type sqlShell struct {
+	db *sql.DB
+	w  io.Writer
+	// format is the format in which results are printed. If empty, the
+	// session's output_format is used.
+	format string
+	timing bool
+	// pending holds the lines of an incomplete statement.
+	pending []string
+}
+
+// prompt returns the prompt for the next line of input.
+func (s *sqlShell) prompt() string {
+	if len(s.pending) > 0 {
+		return promptContinue
+	}
+	return promptStart
+}
+
+// processLine adds a line of input to the pending statement, running the
+// statement once it is complete. Meta-commands are run immediately. The
+// returned bool is true if the shell should exit.
+func (s *sqlShell) processLine(line string) (bool, error) {
+	trimmed := strings.TrimSpace(line)
+	if len(s.pending) == 0 {
+		if trimmed == "" {
+			return false, nil
+		}
+		if strings.HasPrefix(trimmed, `\`) {
+			return s.runMetaCommand(trimmed)
+		}
+		switch strings.ToUpper(strings.TrimSuffix(trimmed, ";")) {
+		case "EXIT", "QUIT":
+			return true, nil
+		}
+	}
+	s.pending = append(s.pending, line)
+	stmts := strings.Join(s.pending, "\n")
+	if !statementComplete(stmts) {
 		return false, nil
 	}
+	s.pending = nil
+	return false, s.runStatements(stmts)
+}
 
-	// This is a really terrible way of figuring out how to handle
-	// the input.
-	command := strings.ToUpper(words[0])
-	if command == "EXIT" || command == "QUIT" {
+// runMetaCommand runs a meta-command. The returned bool is true if the
+// shell should exit.
+func (s *sqlShell) runMetaCommand(line string) (bool, error) {
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case `\q`:
 		return true, nil
+	case `\timing`:
+		s.timing = !s.timing
+		if s.timing {
+			fmt.Fprintf(s.w, "Timing is on.\n")
+		} else {
+			fmt.Fprintf(s.w, "Timing is off.\n")
+		}
+		return false, nil
+	case `\l`:
+		if len(fields) == 1 {
+			return false, s.runStatements("SHOW DATABASES")
+		}
+	case `\dt`:
+		switch len(fields) {
+		case 1:
+			return false, s.runStatements("SHOW TABLES")
+		case 2:
+			return false, s.runStatements("SHOW TABLES FROM " + fields[1])
+		}
+	case `\d`:
+		switch len(fields) {
+		case 1:
+			return false, s.runStatements("SHOW TABLES")
+		case 2:
+			return false, s.runStatements("SHOW COLUMNS FROM " + fields[1])
+		}
+	default:
+		return false, fmt.Errorf("unknown command: %s", fields[0])
+	}
+	return false, fmt.Errorf("invalid arguments: %s", line)
+}
+
+// runStatements executes statements and prints their results.
+func (s *sqlShell) runStatements(stmts string) error {
+	format := s.format
+	if format == "" {
+		if err := s.db.QueryRow("SHOW output_format").Scan(&format); err != nil {
+			return fmt.Errorf("query error: %s", err)
+		}
 	}
-	// Issues a query and examine returned Rows.
-	rows, err := db.Query(line)
+	start := time.Now()
+	rows, err := s.db.Query(stmts)
 	if err != nil {
-		return false, util.Errorf("query error: %s", err)
+		return fmt.Errorf("query error: %s", err)
 	}
-
 	defer rows.Close()
+	for {
+		if err := printQueryResult(s.w, rows, format); err != nil {
+			return err
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("query error: %s", err)
+	}
+	if s.timing {
+		fmt.Fprintf(s.w, "Time: %s\n", time.Since(start))
+	}
+	return nil
+}
+
+// printQueryResult prints the current result set of rows in the specified
+// format: table, csv, tsv or json.
+func printQueryResult(w io.Writer, rows *sql.Rows, format string) error {
 	cols, err := rows.Columns()
 	if err != nil {
-		return false, util.Errorf("rows.Columns() error: %s", err)
+		return fmt.Errorf("rows.Columns() error: %s", err)
 	}
-
 	if len(cols) == 0 {
-		// This operation did not return rows, just show success.
-		fmt.Fprintf(w, "OK\n")
-		return false, nil
+		// This statement did not return rows. Just show success in the table
+		// format; the other formats are meant to be processed by programs.
+		if format == "table" {
+			fmt.Fprintf(w, "OK\n")
+		}
+		return nil
 	}
 
-	// Format all rows using tabwriter.
-	tw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
-	fmt.Fprintf(tw, "%s\n", strings.Join(cols, "\t"))
-	strs := make([]string, len(cols))
-	vals := make([]interface{}, len(cols))
+	var allRows [][]interface{}
 	for rows.Next() {
+		vals := make([]interface{}, len(cols))
+		ptrs := make([]interface{}, len(cols))
 		for i := range vals {
-			vals[i] = &strs[i]
+			ptrs[i] = &vals[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return fmt.Errorf("scan error: %s", err)
+		}
+		for i, val := range vals {
+			if b, ok := val.([]byte); ok {
+				vals[i] = string(b)
+			}
+		}
+		allRows = append(allRows, vals)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("query error: %s", err)
+	}
+
+	switch format {
+	case "table":
+		tw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+		fmt.Fprintf(tw, "%s\n", strings.Join(cols, "\t"))
+		for _, row := range allRows {
+			fmt.Fprintf(tw, "%s\n", strings.Join(formatValues(row), "\t"))
+		}
+		return tw.Flush()
+
+	case "csv", "tsv":
+		cw := csv.NewWriter(w)
+		if format == "tsv" {
+			cw.Comma = '\t'
+		}
+		if err := cw.Write(cols); err != nil {
+			return err
+		}
+		for _, row := range allRows {
+			if err := cw.Write(formatValues(row)); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+
+	case "json":
+		// The objects are written field by field to preserve the column order.
+		fmt.Fprintf(w, "[")
+		for i, row := range allRows {
+			if i > 0 {
+				fmt.Fprintf(w, ",")
+			}
+			fmt.Fprintf(w, "\n  {")
+			for j, val := range row {
+				if j > 0 {
+					fmt.Fprintf(w, ", ")
+				}
+				name, err := json.Marshal(cols[j])
+				if err != nil {
+					return err
+				}
+				value, err := json.Marshal(val)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "%s: %s", name, value)
+			}
+			fmt.Fprintf(w, "}")
+		}
+		if len(allRows) > 0 {
+			fmt.Fprintf(w, "\n")
+		}
+		fmt.Fprintf(w, "]\n")
+		return nil
+	}
+	return fmt.Errorf("unknown format: %s", format)
+}
+
+// formatValues returns the text of the values of a row. NULL values are
+// printed as NULL.
+func formatValues(row []interface{}) []string {
+	strs := make([]string, len(row))
+	for i, val := range row {
+		if val == nil {
+			strs[i] = "NULL"
+		} else {
+			strs[i] = fmt.Sprint(val)
 		}
-		if err := rows.Scan(vals...); err != nil {
-			return false, util.Errorf("scan error: %s", err)
+	}
+	return strs
+}
+
+// statementComplete returns true if the input ends with a semicolon which
+// terminates a statement, i.e. one which is not part of a string, quoted
+// identifier or comment.
+func statementComplete(input string) bool {
+	complete := false
+	for i := 0; i < len(input); i++ {
+		switch c := input[i]; {
+		case c == '\'' || c == '"':
+			// Skip to the closing quote. Quotes are escaped by doubling them,
+			// which looks like two adjacent quoted sections.
+			end := strings.IndexByte(input[i+1:], c)
+			if end == -1 {
+				return false
+			}
+			i += end + 1
+			complete = false
+		case c == '-' && strings.HasPrefix(input[i:], "--"):
+			end := strings.IndexByte(input[i:], '\n')
+			if end == -1 {
+				return complete
+			}
+			i += end
+		case c == '/' && strings.HasPrefix(input[i:], "/*"):
+			end := strings.Index(input[i+2:], "*/")
+			if end == -1 {
+				return false
+			}
+			i += end + 3
+		case c == ';':
+			complete = true
+		case !unicode.IsSpace(rune(c)):
+			complete = false
 		}
-		fmt.Fprintf(tw, "%s\n", strings.Join(strs, "\t"))
 	}
-	_ = tw.Flush()
-	return false, nil
+	return complete
+}
+
+// historyPath returns the path of the file holding the lines entered in the
+// interactive shell, or "" if the home directory is unknown.
+func historyPath() string {
+	home := os.Getenv("HOME")
+	if home == "" {
+		return ""
+	}
+	return filepath.Join(home, ".cockroachdb_history")
+}
+
+// loadHistory returns the most recent lines of the history file.
+func loadHistory(path string) []string {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) > maxHistoryLines {
+		lines = lines[len(lines)-maxHistoryLines:]
+		// Trim the file so that it does not grow without bounds.
+		_ = ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+	}
+	return lines
+}
+
+// historyReadWriter is the terminal's input and output. It starts out
+// replaying the history without echoing it, so that the terminal adds the
+// history lines to its own history, and is then switched to the real
+// input and output.
+type historyReadWriter struct {
+	io.Reader
+	io.Writer
 }
 
 func runTerm(cmd *cobra.Command, args []string) {
@@ -114,16 +397,41 @@ func runTerm(cmd *cobra.Command, args []string) {
 		cmd.Usage()
 		return
 	}
+	switch sqlFormat {
+	case "", "table", "csv", "tsv", "json":
+	default:
+		fmt.Fprintf(osStderr, "unknown format %q: expected table, csv, tsv or json\n", sqlFormat)
+		osExit(1)
+		return
+	}
 
+	// The session variables are held by the connection, so the shell uses a
+	// single connection for all of its statements.
 	db := makeSQLClient()
+	db.SetMaxOpenConns(1)
+	shell := &sqlShell{db: db, w: os.Stdout, format: sqlFormat}
 
-	readWriter := struct {
-		io.Reader
-		io.Writer
-	}{
-		Reader: os.Stdin,
-		Writer: os.Stdout,
+	if sqlExecute != "" {
+		// Run the statements non-interactively; the final statement does not
+		// need to be terminated.
+		var err error
+		if strings.HasPrefix(strings.TrimSpace(sqlExecute), `\`) {
+			_, err = shell.runMetaCommand(strings.TrimSpace(sqlExecute))
+		} else {
+			err = shell.runStatements(sqlExecute)
+		}
+		if err != nil {
+			fmt.Fprintf(osStderr, "Error: %s\n", err)
+			osExit(1)
+		}
+		return
+	}
+
+	if !terminal.IsTerminal(0) {
+		runScript(shell, os.Stdin)
+		return
 	}
+	shell.timing = true
 
 	// We need to switch to raw mode. Unfortunately, this masks
 	// signals-from-keyboard, meaning that ctrl-C cannot be caught.
@@ -135,8 +443,33 @@ func runTerm(cmd *cobra.Command, args []string) {
 		_ = terminal.Restore(0, oldState)
 	}()
 
-	term := terminal.NewTerminal(readWriter, "> ")
+	// Replay the history into the terminal, then append the lines entered
+	// from now on to the history file.
+	path := historyPath()
+	history := loadHistory(path)
+	rw := &historyReadWriter{
+		Reader: strings.NewReader(strings.Join(history, "\r") + "\r"),
+		Writer: ioutil.Discard,
+	}
+	term := terminal.NewTerminal(rw, "")
+	for range history {
+		if _, err := term.ReadLine(); err != nil {
+			break
+		}
+	}
+	rw.Reader, rw.Writer = os.Stdin, os.Stdout
+	var historyFile *os.File
+	if path != "" {
+		if historyFile, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600); err != nil {
+			fmt.Fprintf(term, "Unable to save history: %s\n", err)
+		} else {
+			defer historyFile.Close()
+		}
+	}
+
+	shell.w = term
 	for {
+		term.SetPrompt(shell.prompt())
 		line, err := term.ReadLine()
 		if err != nil {
 			if err != io.EOF {
@@ -144,11 +477,11 @@ func runTerm(cmd *cobra.Command, args []string) {
 			}
 			break
 		}
-		if len(line) == 0 {
-			continue
+		if historyFile != nil && strings.TrimSpace(line) != "" {
+			fmt.Fprintf(historyFile, "%s\n", line)
 		}
 
-		shouldExit, err := processOneLine(db, line, term)
+		shouldExit, err := shell.processLine(line)
 		if err != nil {
 			fmt.Fprintf(term, "Error: %s\n", err)
 		}
@@ -157,3 +490,32 @@ func runTerm(cmd *cobra.Command, args []string) {
 		}
 	}
 }
+
+// runScript runs the statements read from a non-interactive input. It
+// exits on the first error.
+func runScript(shell *sqlShell, r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		shouldExit, err := shell.processLine(scanner.Text())
+		if err != nil {
+			fmt.Fprintf(osStderr, "Error: %s\n", err)
+			osExit(1)
+			return
+		}
+		if shouldExit {
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(osStderr, "Input error: %s\n", err)
+		osExit(1)
+		return
+	}
+	// Run a final statement which is not terminated by a semicolon.
+	if len(shell.pending) > 0 {
+		if err := shell.runStatements(strings.Join(shell.pending, "\n")); err != nil {
+			fmt.Fprintf(osStderr, "Error: %s\n", err)
+			osExit(1)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import "testing"
+
+func TestStatementComplete(t *testing.T) {
+	testCases := []struct {
+		input    string
+		complete bool
+	}{
+		{``, false},
+		{`SELECT 1`, false},
+		{`SELECT 1;`, true},
+		{"SELECT 1;  \n", true},
+		{"SELECT 1\n;", true},
+		{`SELECT 1; SELECT 2`, false},
+		{`SELECT 1; SELECT 2;`, true},
+		{`SELECT ';'`, false},
+		{`SELECT ';`, false},
+		{`SELECT 'it''s';`, true},
+		{`SELECT "a;b" FROM t;`, true},
+		{`SELECT 1; -- done`, true},
+		{"SELECT 1 -- done;", false},
+		{"SELECT 1 -- done;\n;", true},
+		{`SELECT 1 /* ; */`, false},
+		{`SELECT 1 /* ; */;`, true},
+		{`SELECT 1 /* ;`, false},
+	}
+	for _, tc := range testCases {
+		if complete := statementComplete(tc.input); complete != tc.complete {
+			t.Errorf("%q: expected %t, but found %t", tc.input, tc.complete, complete)
+		}
+	}
+}
+
+func TestProcessLinePending(t *testing.T) {
+	s := &sqlShell{format: "table"}
+	for _, line := range []string{"SELECT", "  1", "-- not yet;"} {
+		if exit, err := s.processLine(line); exit || err != nil {
+			t.Fatalf("%s: unexpected result %t, %v", line, exit, err)
+		}
+		if s.prompt() != promptContinue {
+			t.Fatalf("%s: expected the continuation prompt", line)
+		}
+	}
+	if len(s.pending) != 3 {
+		t.Fatalf("expected 3 pending lines, but found %q", s.pending)
+	}
+
+	// EXIT is only recognized at the start of a statement.
+	s.pending = nil
+	if exit, _ := s.processLine("exit;"); !exit {
+		t.Errorf("expected exit")
+	}
+	if exit, _ := s.processLine(`\q`); !exit {
+		t.Errorf("expected exit")
+	}
+	if _, err := s.processLine(`\x`); err == nil {
+		t.Errorf("expected an error for an unknown command")
+	}
+}
@@ -20,6 +20,7 @@ package client
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
 	gogoproto "github.com/gogo/protobuf/proto"
@@ -61,6 +62,28 @@ func (b *Batch) prepare() error {
 	return nil
 }
 
+// setReadOptions sets the timestamp, read consistency and maximum
+// staleness of each call in the batch.
+func (b *Batch) setReadOptions(timestamp proto.Timestamp, consistency proto.ReadConsistencyType, maxStaleness time.Duration) {
+	for _, call := range b.calls {
+		header := call.Args.Header()
+		header.Timestamp = timestamp
+		header.ReadConsistency = consistency
+		header.MaxStaleness = maxStaleness.Nanoseconds()
+	}
+}
+
+// setStaleReadOptions sets up each call in the batch to read as of
+// maxStaleness in the past. Without any staleness, the calls are
+// consistent reads.
+func (b *Batch) setStaleReadOptions(maxStaleness time.Duration) {
+	if maxStaleness == 0 {
+		b.setReadOptions(proto.ZeroTimestamp, proto.CONSISTENT, 0)
+		return
+	}
+	b.setReadOptions(proto.ZeroTimestamp, proto.INCONSISTENT, maxStaleness)
+}
+
 func (b *Batch) initResult(calls, numRows int, err error) {
 	r := Result{calls: calls, Err: err}
 	if numRows > 0 {
@@ -128,18 +151,36 @@ func (b *Batch) fillResults() error {
 					row.Key = kv.Key
 					row.setValue(&kv.Value)
 				}
+			case *proto.ReverseScanResponse:
+				result.Rows = make([]KeyValue, len(t.Rows))
+				for j, kv := range t.Rows {
+					row := &result.Rows[j]
+					row.Key = kv.Key
+					row.setValue(&kv.Value)
+				}
+			case *proto.ReapQueueResponse:
+				result.Rows = make([]KeyValue, len(t.Messages))
+				for j, kv := range t.Messages {
+					row := &result.Rows[j]
+					row.Key = kv.Key
+					row.setValue(&kv.Value)
+				}
 			case *proto.DeleteResponse:
 				row := &result.Rows[k]
 				row.Key = []byte(call.Args.(*proto.DeleteRequest).Key)
 
 			case *proto.AdminMergeResponse:
 			case *proto.AdminSplitResponse:
+			case *proto.ChangesResponse:
 			case *proto.DeleteRangeResponse:
 			case *proto.EndTransactionResponse:
+			case *proto.EnqueueMessageResponse:
+			case *proto.EnqueueUpdateResponse:
 			case *proto.InternalBatchResponse:
 			case *proto.InternalGCResponse:
 			case *proto.InternalMergeResponse:
 			case *proto.InternalPushTxnResponse:
+			case *proto.InternalQueryTxnResponse:
 			case *proto.InternalRangeLookupResponse:
 			case *proto.InternalResolveIntentResponse:
 			case *proto.InternalResolveIntentRangeResponse:
@@ -224,6 +265,23 @@ func (b *Batch) GetProto(key interface{}, msg gogoproto.Message) {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler. value can be any key type or a proto.Message.
 func (b *Batch) Put(key, value interface{}) {
+	b.put(key, value, nil)
+}
+
+// PutWithExpiration sets the value for a key, which expires at the
+// specified time. An expired value is no longer visible to reads and
+// is eventually removed by garbage collection.
+//
+// A new result will be appended to the batch which will contain a single row
+// and Result.Err will indicate success or failure.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (b *Batch) PutWithExpiration(key, value interface{}, expiration time.Time) {
+	b.put(key, value, &proto.Timestamp{WallTime: expiration.UnixNano()})
+}
+
+func (b *Batch) put(key, value interface{}, expiration *proto.Timestamp) {
 	k, err := marshalKey(key)
 	if err != nil {
 		b.initResult(0, 1, err)
@@ -234,6 +292,7 @@ func (b *Batch) Put(key, value interface{}) {
 		b.initResult(0, 1, err)
 		return
 	}
+	v.Expiration = expiration
 	b.calls = append(b.calls, proto.PutCall(proto.Key(k), v))
 	b.initResult(1, 1, nil)
 }
@@ -308,6 +367,29 @@ func (b *Batch) Scan(s, e interface{}, maxRows int64) {
 	b.initResult(1, 0, nil)
 }
 
+// ReverseScan retrieves the rows between begin (inclusive) and end
+// (exclusive) in descending key order.
+//
+// A new result will be appended to the batch which will contain up to maxRows
+// rows and Result.Err will indicate success or failure.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (b *Batch) ReverseScan(s, e interface{}, maxRows int64) {
+	begin, err := marshalKey(s)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	end, err := marshalKey(e)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	b.calls = append(b.calls, proto.ReverseScanCall(proto.Key(begin), proto.Key(end), maxRows))
+	b.initResult(1, 0, nil)
+}
+
 // Del deletes one or more keys.
 //
 // A new result will be appended to the batch and each key will have a
@@ -351,6 +433,79 @@ func (b *Batch) DelRange(s, e interface{}) {
 	b.initResult(1, 0, nil)
 }
 
+// EnqueueMessage enqueues value into the queue of the specified inbox.
+// Messages are delivered in the order they were enqueued and are
+// retrieved with ReapQueue.
+//
+// A new result will be appended to the batch which will contain 0 rows and
+// Result.Err will indicate success or failure.
+//
+// inbox can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (b *Batch) EnqueueMessage(inbox, value interface{}) {
+	k, err := marshalKey(inbox)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	v, err := marshalValue(reflect.ValueOf(value))
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	call := proto.EnqueueMessageCall(proto.Key(k), v)
+	// The client command ID determines the message key and makes the
+	// enqueue idempotent, so it must be set even when the call is sent
+	// as part of a larger batch.
+	resetClientCmdID(call.Args)
+	b.calls = append(b.calls, call)
+	b.initResult(1, 0, nil)
+}
+
+// ReapQueue retrieves and deletes up to maxRows messages, oldest first,
+// from the queue of the specified inbox. ReapQueue is only available
+// within a transaction so that messages are only removed if the
+// transaction which processes them commits.
+//
+// A new result will be appended to the batch which will contain up to
+// maxRows rows, one per message, and Result.Err will indicate success or
+// failure.
+//
+// inbox can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (b *Batch) ReapQueue(inbox interface{}, maxRows int64) {
+	k, err := marshalKey(inbox)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	b.calls = append(b.calls, proto.ReapQueueCall(proto.Key(k), maxRows))
+	b.initResult(1, 0, nil)
+}
+
+// EnqueueUpdate enqueues the operations of update for eventual
+// execution. The operations are stored with the range containing the
+// first key of update and are later applied in a single transaction by
+// that range's update queue. Only transactional write operations may be
+// part of an update.
+//
+// A new result will be appended to the batch which will contain 0 rows and
+// Result.Err will indicate success or failure.
+func (b *Batch) EnqueueUpdate(update *Batch) {
+	if err := update.prepare(); err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	var br proto.BatchRequest
+	for _, call := range update.calls {
+		br.Add(call.Args)
+	}
+	call := proto.EnqueueUpdateCall(br)
+	resetClientCmdID(call.Args)
+	b.calls = append(b.calls, call)
+	b.initResult(1, 0, nil)
+}
+
 // adminMerge is only exported on DB. It is here for symmetry with the
 // other operations.
 func (b *Batch) adminMerge(key interface{}) {
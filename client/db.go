@@ -155,6 +155,9 @@ type DB struct {
 	// ignored.
 	userPriority    int32
 	txnRetryOptions retry.Options
+	// ctx is the context with which calls are sent. Calls fail without
+	// being sent once the context is done.
+	ctx context.Context
 }
 
 // Option is the signature for a function which applies an option to a DB.
@@ -237,6 +240,15 @@ func Open(addr string, opts ...Option) (*DB, error) {
 	return db, nil
 }
 
+// WithContext returns a copy of the DB whose calls, including those made by
+// its transactions, are sent with the supplied context. Once the context is
+// done, calls fail with the context's error.
+func (db *DB) WithContext(ctx context.Context) *DB {
+	c := *db
+	c.ctx = ctx
+	return &c
+}
+
 // NewBatch creates and returns a new empty batch object for use with the DB.
 func (db *DB) NewBatch() *Batch {
 	return &Batch{DB: db}
@@ -280,6 +292,18 @@ func (db *DB) Put(key, value interface{}) error {
 	return err
 }
 
+// PutWithExpiration sets the value for a key, which expires at the
+// specified time.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (db *DB) PutWithExpiration(key, value interface{}, expiration time.Time) error {
+	b := db.NewBatch()
+	b.PutWithExpiration(key, value, expiration)
+	_, err := runOneResult(db, b)
+	return err
+}
+
 // CPut conditionally sets the value for a key if the existing value is equal
 // to expValue. To conditionally set a value only if there is no existing entry
 // pass nil for expValue.
@@ -318,6 +342,80 @@ func (db *DB) Scan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
 	return r.Rows, err
 }
 
+// ReverseScan retrieves the rows between begin (inclusive) and end
+// (exclusive) in descending key order.
+//
+// The returned []KeyValue will contain up to maxRows elements.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) ReverseScan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	b := db.NewBatch()
+	b.ReverseScan(begin, end, maxRows)
+	r, err := runOneResult(db, b)
+	return r.Rows, err
+}
+
+// GetAt retrieves the value for a key as of the specified timestamp,
+// returning the retrieved key/value or an error. The timestamp must
+// not be older than the GC TTL of the zone containing the key.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) GetAt(key interface{}, timestamp proto.Timestamp) (KeyValue, error) {
+	b := db.NewBatch()
+	b.Get(key)
+	b.setReadOptions(timestamp, proto.CONSISTENT, 0)
+	return runOneRow(db, b)
+}
+
+// ScanAt retrieves the rows between begin (inclusive) and end
+// (exclusive) as of the specified timestamp. The timestamp must not be
+// older than the GC TTL of the zone containing the keys.
+//
+// The returned []KeyValue will contain up to maxRows elements.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) ScanAt(begin, end interface{}, maxRows int64, timestamp proto.Timestamp) ([]KeyValue, error) {
+	b := db.NewBatch()
+	b.Scan(begin, end, maxRows)
+	b.setReadOptions(timestamp, proto.CONSISTENT, 0)
+	r, err := runOneResult(db, b)
+	return r.Rows, err
+}
+
+// GetStale retrieves the value for a key as of maxStaleness in the
+// past. The read ignores pending write intents and may be served by
+// any replica which has applied all writes up to that point in time;
+// other replicas redirect it to the leader. A zero maxStaleness reads
+// the latest value consistently.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) GetStale(key interface{}, maxStaleness time.Duration) (KeyValue, error) {
+	b := db.NewBatch()
+	b.Get(key)
+	b.setStaleReadOptions(maxStaleness)
+	return runOneRow(db, b)
+}
+
+// ScanStale retrieves the rows between begin (inclusive) and end
+// (exclusive) as of maxStaleness in the past. As with GetStale, the
+// read may be served by any replica which is sufficiently up to date.
+//
+// The returned []KeyValue will contain up to maxRows elements.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) ScanStale(begin, end interface{}, maxRows int64, maxStaleness time.Duration) ([]KeyValue, error) {
+	b := db.NewBatch()
+	b.Scan(begin, end, maxRows)
+	b.setStaleReadOptions(maxStaleness)
+	r, err := runOneResult(db, b)
+	return r.Rows, err
+}
+
 // Del deletes one or more keys.
 //
 // key can be either a byte slice, a string, a fmt.Stringer or an
@@ -342,6 +440,27 @@ func (db *DB) DelRange(begin, end interface{}) error {
 	return err
 }
 
+// EnqueueMessage enqueues value into the queue of the specified inbox.
+// Messages can only be reaped within a transaction; see Txn.ReapQueue.
+//
+// inbox can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (db *DB) EnqueueMessage(inbox, value interface{}) error {
+	b := db.NewBatch()
+	b.EnqueueMessage(inbox, value)
+	_, err := runOneResult(db, b)
+	return err
+}
+
+// EnqueueUpdate enqueues the operations of update for eventual
+// execution. See Batch.EnqueueUpdate.
+func (db *DB) EnqueueUpdate(update *Batch) error {
+	b := db.NewBatch()
+	b.EnqueueUpdate(update)
+	_, err := runOneResult(db, b)
+	return err
+}
+
 // AdminMerge merges the range containing key and the subsequent
 // range. After the merge operation is complete, the range containing
 // key will contain all of the key/value pairs of the subsequent range
@@ -394,8 +513,16 @@ func (db *DB) Run(b *Batch) error {
 // otherwise. The retryable function should have no side effects which could
 // cause problems in the event it must be run more than once.
 //
+// If db belongs to a transaction, such as the DB of one of its batches,
+// retryable runs as a nested transaction instead: its writes are rolled
+// back if it returns an error, and are committed along with the
+// enclosing transaction otherwise.
+//
 // TODO(pmattis): Allow transaction options to be specified.
 func (db *DB) Txn(retryable func(txn *Txn) error) error {
+	if ts, ok := db.Sender.(*txnSender); ok {
+		return (*Txn)(ts).subTxn(retryable)
+	}
 	return newTxn(*db, 1 /* depth */).exec(retryable)
 }
 
@@ -406,6 +533,13 @@ func (db *DB) send(calls ...proto.Call) (err error) {
 		return nil
 	}
 
+	ctx := db.ctx
+	if ctx == nil {
+		ctx = context.TODO()
+	} else if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// First check if any call contains an error. This allows the
 	// generation of a Call to create an error that is reported
 	// here. See PutProto for an example.
@@ -424,7 +558,7 @@ func (db *DB) send(calls ...proto.Call) (err error) {
 			c.Args.Header().UserPriority = gogoproto.Int32(db.userPriority)
 		}
 		resetClientCmdID(c.Args)
-		db.Sender.Send(context.TODO(), c)
+		db.Sender.Send(ctx, c)
 		err = c.Reply.Header().GoError()
 		if err != nil {
 			if log.V(1) {
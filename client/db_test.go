@@ -25,9 +25,11 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
@@ -308,6 +310,172 @@ func TestDebugName(t *testing.T) {
 	})
 }
 
+// TestHistoricalAndStaleReads verifies reads at a past timestamp and
+// reads with a maximum staleness.
+func TestHistoricalAndStaleReads(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	ts0 := s.Clock().Now()
+	if err := db.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	ts1 := s.Clock().Now()
+	if err := db.Put("a", "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if kv, err := db.GetAt("a", ts1); err != nil {
+		t.Fatal(err)
+	} else if v := string(kv.ValueBytes()); v != "1" {
+		t.Errorf("expected historical value \"1\"; got %q", v)
+	}
+	if rows, err := db.ScanAt("a", "b", 0, ts1); err != nil {
+		t.Fatal(err)
+	} else if len(rows) != 1 || string(rows[0].ValueBytes()) != "1" {
+		t.Errorf("expected historical scan to return a=1; got %+v", rows)
+	}
+	if kv, err := db.GetAt("a", ts0); err != nil {
+		t.Fatal(err)
+	} else if kv.Exists() {
+		t.Errorf("expected no value before first write; got %q", kv.ValueBytes())
+	}
+
+	// With no staleness, the latest value is read. The key didn't
+	// exist an hour ago.
+	if kv, err := db.GetStale("a", 0); err != nil {
+		t.Fatal(err)
+	} else if v := string(kv.ValueBytes()); v != "2" {
+		t.Errorf("expected latest value \"2\"; got %q", v)
+	}
+	if rows, err := db.ScanStale("a", "b", 0, time.Hour); err != nil {
+		t.Fatal(err)
+	} else if len(rows) != 0 {
+		t.Errorf("expected stale scan to return no rows; got %+v", rows)
+	}
+	if _, err := db.GetStale("a", -time.Second); err == nil {
+		t.Error("expected error for negative staleness")
+	}
+}
+
+// TestTxnSavepoints verifies that rolling back to a savepoint undoes
+// the writes performed since its creation, and only those.
+func TestTxnSavepoints(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	if err := db.Put("c", "3"); err != nil {
+		t.Fatal(err)
+	}
+	err := db.Txn(func(txn *client.Txn) error {
+		if err := txn.Put("a", "1"); err != nil {
+			return err
+		}
+		if err := txn.Savepoint("sp1"); err != nil {
+			return err
+		}
+		if err := txn.Put("a", "2"); err != nil {
+			return err
+		}
+		if err := txn.Put("b", "2"); err != nil {
+			return err
+		}
+		if err := txn.Savepoint("sp2"); err != nil {
+			return err
+		}
+		if err := txn.DelRange("a", "d"); err != nil {
+			return err
+		}
+		if err := txn.RollbackToSavepoint("sp2"); err != nil {
+			return err
+		}
+		if rows, err := txn.Scan("a", "d", 0); err != nil {
+			return err
+		} else if len(rows) != 3 {
+			return util.Errorf("expected 3 rows after rollback to sp2; got %d", len(rows))
+		}
+		if err := txn.RollbackToSavepoint("sp1"); err != nil {
+			return err
+		}
+		if err := txn.RollbackToSavepoint("sp2"); err == nil {
+			return util.Errorf("expected sp2 to be released by rollback to sp1")
+		}
+		return txn.ReleaseSavepoint("sp1")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Scan("a", "d", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual []string
+	for _, kv := range rows {
+		actual = append(actual, fmt.Sprintf("%s=%s", kv.Key, kv.ValueBytes()))
+	}
+	if expected := []string{"a=1", "c=3"}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v; got %v", expected, actual)
+	}
+}
+
+// TestNestedTxn verifies that a failing nested transaction rolls back
+// its writes without aborting the enclosing transaction.
+func TestNestedTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup()
+	defer s.Stop()
+
+	nestedErr := util.Errorf("nested failure")
+	err := db.Txn(func(txn *client.Txn) error {
+		// Transactions run on the DB of the transaction's batches are
+		// nested within it.
+		txnDB := txn.NewBatch().DB
+		if err := txn.Put("a", "1"); err != nil {
+			return err
+		}
+		if err := txnDB.Txn(func(txn *client.Txn) error {
+			if err := txn.Put("b", "2"); err != nil {
+				return err
+			}
+			return txnDB.Txn(func(txn *client.Txn) error {
+				return txn.Put("c", "3")
+			})
+		}); err != nil {
+			return err
+		}
+		if err := txnDB.Txn(func(txn *client.Txn) error {
+			if err := txn.Put("a", "4"); err != nil {
+				return err
+			}
+			if err := txn.Put("d", "4"); err != nil {
+				return err
+			}
+			return nestedErr
+		}); err != nestedErr {
+			return util.Errorf("expected nested error; got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Scan("a", "e", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual []string
+	for _, kv := range rows {
+		actual = append(actual, fmt.Sprintf("%s=%s", kv.Key, kv.ValueBytes()))
+	}
+	if expected := []string{"a=1", "b=2", "c=3"}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v; got %v", expected, actual)
+	}
+}
+
 func TestCommonMethods(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	batchType := reflect.TypeOf(&client.Batch{})
@@ -321,16 +489,28 @@ func TestCommonMethods(t *testing.T) {
 	}
 	blacklist := map[key]struct{}{
 		key{batchType, "InternalAddCall"}:    {},
+		key{batchType, "ReapQueue"}:          {},
 		key{dbType, "AdminMerge"}:            {},
 		key{dbType, "AdminSplit"}:            {},
+		key{dbType, "Changes"}:               {},
+		key{dbType, "GetAt"}:                 {},
+		key{dbType, "GetStale"}:              {},
 		key{dbType, "NewBatch"}:              {},
 		key{dbType, "Run"}:                   {},
+		key{dbType, "ScanAt"}:                {},
+		key{dbType, "ScanStale"}:             {},
 		key{dbType, "Txn"}:                   {},
+		key{dbType, "Watch"}:                 {},
+		key{dbType, "WithContext"}:           {},
 		key{txnType, "Commit"}:               {},
 		key{txnType, "DebugName"}:            {},
 		key{txnType, "InternalSetPriority"}:  {},
 		key{txnType, "NewBatch"}:             {},
+		key{txnType, "ReapQueue"}:            {},
+		key{txnType, "ReleaseSavepoint"}:     {},
+		key{txnType, "RollbackToSavepoint"}:  {},
 		key{txnType, "Run"}:                  {},
+		key{txnType, "Savepoint"}:            {},
 		key{txnType, "SetDebugName"}:         {},
 		key{txnType, "SetSnapshotIsolation"}: {},
 	}
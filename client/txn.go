@@ -21,9 +21,11 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/retry"
 	gogoproto "github.com/gogo/protobuf/proto"
@@ -69,6 +71,46 @@ type Txn struct {
 	txn          proto.Transaction
 	haveTxnWrite bool // True if there were transactional writes
 	haveEndTxn   bool // True if there was an explicit EndTransaction
+	savepoints   []savepoint
+	// writes logs the writes performed by the transaction in the order
+	// of their sequence numbers. Rolling back to a savepoint removes the
+	// intents of the later writes, which may have replaced intents of
+	// earlier writes; those intents are then restored in place.
+	writes []txnWrite
+}
+
+// A savepoint marks a point within a transaction to which its writes
+// can be rolled back. Rolling back removes the intents written since
+// the savepoint was created, keeping the earlier ones.
+type savepoint struct {
+	name string
+	// sequence is the transaction's sequence number at the creation of
+	// the savepoint. The intents of later writes carry higher sequence
+	// numbers.
+	sequence int32
+	// writes is the length of the write log at the creation of the
+	// savepoint.
+	writes int
+}
+
+// A txnWrite is an entry of a transaction's write log.
+type txnWrite struct {
+	// endKey is set for writes to a range of keys, which are always
+	// deletions.
+	key, endKey proto.Key
+	// value is the value written, nil for deletions.
+	value *proto.Value
+	// failed is set if the write returned an error. It may have laid
+	// down intents, but isn't replayed.
+	failed bool
+}
+
+// span returns the range of keys the write affected.
+func (w txnWrite) span() (proto.Key, proto.Key) {
+	if w.endKey != nil {
+		return w.key, w.endKey
+	}
+	return w.key, w.key.Next()
 }
 
 func newTxn(db DB, depth int) *Txn {
@@ -167,6 +209,18 @@ func (txn *Txn) Put(key, value interface{}) error {
 	return err
 }
 
+// PutWithExpiration sets the value for a key, which expires at the
+// specified time.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (txn *Txn) PutWithExpiration(key, value interface{}, expiration time.Time) error {
+	b := txn.NewBatch()
+	b.PutWithExpiration(key, value, expiration)
+	_, err := runOneResult(txn, b)
+	return err
+}
+
 // CPut conditionally sets the value for a key if the existing value is equal
 // to expValue. To conditionally set a value only if there is no existing entry
 // pass nil for expValue.
@@ -208,6 +262,20 @@ func (txn *Txn) Scan(begin, end interface{}, maxRows int64) ([]KeyValue, error)
 	return r.Rows, err
 }
 
+// ReverseScan retrieves the rows between begin (inclusive) and end
+// (exclusive) in descending key order.
+//
+// The returned []KeyValue will contain up to maxRows elements.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (txn *Txn) ReverseScan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	b := txn.NewBatch()
+	b.ReverseScan(begin, end, maxRows)
+	r, err := runOneResult(txn, b)
+	return r.Rows, err
+}
+
 // Del deletes one or more keys.
 //
 // key can be either a byte slice, a string, a fmt.Stringer or an
@@ -233,6 +301,42 @@ func (txn *Txn) DelRange(begin, end interface{}) error {
 	return err
 }
 
+// EnqueueMessage enqueues value into the queue of the specified inbox.
+// The message becomes visible to readers of the inbox when the
+// transaction commits.
+//
+// inbox can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (txn *Txn) EnqueueMessage(inbox, value interface{}) error {
+	b := txn.NewBatch()
+	b.EnqueueMessage(inbox, value)
+	_, err := runOneResult(txn, b)
+	return err
+}
+
+// ReapQueue retrieves and deletes up to maxRows messages, oldest first,
+// from the queue of the specified inbox. The messages are only removed
+// if the transaction commits. If fewer than maxRows messages are
+// returned, the queue is empty.
+//
+// inbox can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (txn *Txn) ReapQueue(inbox interface{}, maxRows int64) ([]KeyValue, error) {
+	b := txn.NewBatch()
+	b.ReapQueue(inbox, maxRows)
+	r, err := runOneResult(txn, b)
+	return r.Rows, err
+}
+
+// EnqueueUpdate enqueues the operations of update for eventual
+// execution once the transaction commits. See Batch.EnqueueUpdate.
+func (txn *Txn) EnqueueUpdate(update *Batch) error {
+	b := txn.NewBatch()
+	b.EnqueueUpdate(update)
+	_, err := runOneResult(txn, b)
+	return err
+}
+
 // Run executes the operations queued up within a batch. Before executing any
 // of the operations the batch is first checked to see if there were any errors
 // during its construction (e.g. failure to marshal a proto message).
@@ -266,11 +370,200 @@ func (txn *Txn) Commit(b *Batch) error {
 	return txn.Run(b)
 }
 
+// Savepoint creates a savepoint with the specified name. Writes
+// performed after its creation can be undone by RollbackToSavepoint.
+// If a savepoint of the same name exists, the new one hides it until it
+// is released.
+//
+// Only Put, CPut, Inc, Del and DelRange can be rolled back; other
+// writes fail while a savepoint exists.
+func (txn *Txn) Savepoint(name string) error {
+	txn.savepoints = append(txn.savepoints, savepoint{
+		name:     name,
+		sequence: txn.txn.Sequence,
+		writes:   len(txn.writes),
+	})
+	return nil
+}
+
+// RollbackToSavepoint undoes all writes performed since the creation of
+// the named savepoint, and releases any savepoints created after it.
+// The named savepoint remains in place. The intents written since the
+// savepoint are removed, and the intents they replaced are restored in
+// place. If the rollback fails, the transaction is restarted.
+func (txn *Txn) RollbackToSavepoint(name string) error {
+	i, err := txn.findSavepoint(name)
+	if err != nil {
+		return err
+	}
+	return txn.rollbackToSavepoint(i)
+}
+
+// ReleaseSavepoint releases the named savepoint and all savepoints
+// created after it, keeping the writes performed since their creation.
+// These writes can still be rolled back to an earlier savepoint.
+func (txn *Txn) ReleaseSavepoint(name string) error {
+	i, err := txn.findSavepoint(name)
+	if err != nil {
+		return err
+	}
+	txn.savepoints = txn.savepoints[:i]
+	return nil
+}
+
+// findSavepoint returns the index of the most recently created
+// savepoint with the specified name.
+func (txn *Txn) findSavepoint(name string) (int, error) {
+	for i := len(txn.savepoints) - 1; i >= 0; i-- {
+		if txn.savepoints[i].name == name {
+			return i, nil
+		}
+	}
+	return 0, util.Errorf("savepoint %q does not exist", name)
+}
+
+// subTxn executes retryable as a nested transaction within txn. If
+// retryable returns an error, the writes it performed are rolled back
+// and the error is returned, leaving the enclosing transaction free to
+// proceed. Errors which require the enclosing transaction to restart
+// are returned without rolling back; the enclosing transaction's
+// retryable is then retried in its entirety.
+func (txn *Txn) subTxn(retryable func(txn *Txn) error) error {
+	i := len(txn.savepoints)
+	if err := txn.Savepoint(""); err != nil {
+		return err
+	}
+	err := retryable(txn)
+	if _, ok := err.(proto.TransactionRestartError); err != nil && !ok {
+		if rbErr := txn.rollbackToSavepoint(i); rbErr != nil {
+			return rbErr
+		}
+	}
+	txn.savepoints = txn.savepoints[:i]
+	return err
+}
+
+func (txn *Txn) rollbackToSavepoint(i int) error {
+	sp := txn.savepoints[i]
+	txn.savepoints = txn.savepoints[:i+1]
+	undone := txn.writes[sp.writes:]
+	txn.writes = txn.writes[:sp.writes]
+	if len(undone) == 0 {
+		return nil
+	}
+
+	// Roll back the intents written after the savepoint. Where earlier
+	// writes left intents which the later ones replaced, the rollback
+	// restores them in place, so that each span is rolled back
+	// atomically.
+	var calls []proto.Call
+	for _, span := range txn.rollbackSpans(undone) {
+		args := &proto.InternalResolveIntentRangeRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:    span.key,
+				EndKey: span.endKey,
+			},
+			Rollback: true,
+		}
+		if w := span.restore; w != nil {
+			if w.value != nil {
+				args.Restore = w.value
+			} else {
+				args.RestoreDeleted = true
+			}
+		}
+		calls = append(calls, proto.Call{
+			Args:  args,
+			Reply: &proto.InternalResolveIntentRangeResponse{},
+		})
+	}
+	// The requests carry the transaction at the savepoint's sequence
+	// number, which the restored intents keep.
+	sequence := txn.txn.Sequence
+	txn.txn.Sequence = sp.sequence
+	err := txn.db.send(calls...)
+	txn.txn.Sequence = sequence
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(proto.TransactionRestartError); ok {
+		return err
+	}
+	// Some of the spans may have been rolled back and others not,
+	// which would leave the transaction with a mix of the writes. It
+	// is restarted instead, discarding all of its writes.
+	txn.txn.Restart(txn.db.userPriority, txn.txn.Priority, txn.txn.Timestamp)
+	return proto.NewTransactionRetryError(&txn.txn)
+}
+
+// A rollbackSpan is a span of keys to roll back to a savepoint. The
+// intents in the span are restored to the value of the write which
+// last affected the span before the savepoint, if any.
+type rollbackSpan struct {
+	key, endKey proto.Key
+	restore     *txnWrite
+}
+
+// rollbackSpans splits the keys affected by the undone writes into
+// disjoint spans, each of which the writes before the savepoint left
+// in a single state.
+func (txn *Txn) rollbackSpans(undone []txnWrite) []rollbackSpan {
+	var bounds proto.KeySlice
+	for _, w := range undone {
+		key, endKey := w.span()
+		bounds = append(bounds, key, endKey)
+	}
+	var earlier []txnWrite
+	for _, w := range txn.writes {
+		if !w.failed {
+			earlier = append(earlier, w)
+			key, endKey := w.span()
+			bounds = append(bounds, key, endKey)
+		}
+	}
+	sort.Sort(bounds)
+
+	var spans []rollbackSpan
+	for i := 0; i+1 < len(bounds); i++ {
+		key, endKey := bounds[i], bounds[i+1]
+		if !key.Less(endKey) || !overlaps(undone, key, endKey) {
+			continue
+		}
+		span := rollbackSpan{key: key, endKey: endKey}
+		// The earlier writes either cover the span entirely or not at
+		// all; the last one covering it determines its state.
+		for j := len(earlier) - 1; j >= 0; j-- {
+			if wKey, wEndKey := earlier[j].span(); !key.Less(wKey) && !wEndKey.Less(endKey) {
+				span.restore = &earlier[j]
+				break
+			}
+		}
+		if n := len(spans); n > 0 && spans[n-1].endKey.Equal(key) && spans[n-1].restore == span.restore {
+			spans[n-1].endKey = endKey
+			continue
+		}
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+// overlaps returns whether any of the writes affected keys in the span
+// [key, endKey).
+func overlaps(writes []txnWrite, key, endKey proto.Key) bool {
+	for _, w := range writes {
+		if wKey, wEndKey := w.span(); key.Less(wEndKey) && wKey.Less(endKey) {
+			return true
+		}
+	}
+	return false
+}
+
 func (txn *Txn) exec(retryable func(txn *Txn) error) (err error) {
 	// Run retryable in a retry loop until we encounter a success or
 	// error condition this loop isn't capable of handling.
 	for r := retry.Start(txn.db.txnRetryOptions); r.Next(); {
 		txn.haveTxnWrite, txn.haveEndTxn = false, false // always reset before [re]starting txn
+		txn.savepoints, txn.writes = nil, nil
 		if err = retryable(txn); err == nil {
 			if !txn.haveEndTxn && txn.haveTxnWrite {
 				// If there were no errors running retryable, commit the txn. This
@@ -297,6 +590,9 @@ func (txn *Txn) exec(retryable func(txn *Txn) error) (err error) {
 		break
 	}
 	if err != nil && txn.haveTxnWrite {
+		// Abort the transaction even if it failed because its context is
+		// done.
+		txn.db.ctx = nil
 		if replyErr := txn.send(proto.Call{
 			Args:  &proto.EndTransactionRequest{Commit: false},
 			Reply: &proto.EndTransactionResponse{},
@@ -308,24 +604,108 @@ func (txn *Txn) exec(retryable func(txn *Txn) error) (err error) {
 }
 
 // send runs the specified calls synchronously in a single batch and
-// returns any errors.
+// returns any errors. The writes among the calls are assigned the next
+// sequence number and logged.
 func (txn *Txn) send(calls ...proto.Call) error {
 	if len(calls) == 0 {
 		return nil
 	}
+	if len(txn.savepoints) > 0 {
+		for _, req := range flattenRequests(calls) {
+			if !proto.IsTransactionWrite(req) {
+				continue
+			}
+			switch req.(type) {
+			case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.IncrementRequest,
+				*proto.DeleteRequest, *proto.DeleteRangeRequest:
+			default:
+				return util.Errorf("%s cannot be rolled back to a savepoint", req.Method())
+			}
+		}
+	}
+	write := txn.nextSequence(calls)
 	txn.updateState(calls)
-	return txn.db.send(calls...)
+	err := txn.db.send(calls...)
+	if write {
+		txn.logWrites(calls, err != nil)
+	}
+	return err
 }
 
-func (txn *Txn) updateState(calls []proto.Call) {
+// nextSequence increments the transaction's sequence number if the
+// calls contain transactional writes, and returns whether they do.
+func (txn *Txn) nextSequence(calls []proto.Call) bool {
+	for _, req := range flattenRequests(calls) {
+		if proto.IsTransactionWrite(req) {
+			txn.txn.Sequence++
+			return true
+		}
+	}
+	return false
+}
+
+// logWrites appends the writes among the calls to the write log.
+func (txn *Txn) logWrites(calls []proto.Call, failed bool) {
+	for _, c := range calls {
+		if b, ok := c.Args.(*proto.BatchRequest); ok {
+			bReply := c.Reply.(*proto.BatchResponse)
+			for i := range b.Requests {
+				var reply proto.Response
+				if i < len(bReply.Responses) {
+					reply = bReply.Responses[i].GetValue().(proto.Response)
+				}
+				txn.logWrite(b.Requests[i].GetValue().(proto.Request), reply, failed)
+			}
+			continue
+		}
+		txn.logWrite(c.Args, c.Reply, failed)
+	}
+}
+
+func (txn *Txn) logWrite(req proto.Request, reply proto.Response, failed bool) {
+	w := txnWrite{
+		key:    req.Header().Key,
+		failed: failed,
+	}
+	switch t := req.(type) {
+	case *proto.PutRequest:
+		w.value = &t.Value
+	case *proto.ConditionalPutRequest:
+		w.value = &t.Value
+	case *proto.IncrementRequest:
+		if iReply, ok := reply.(*proto.IncrementResponse); ok && !failed {
+			w.value = &proto.Value{}
+			w.value.SetInteger(iReply.NewValue)
+		} else {
+			w.failed = true
+		}
+	case *proto.DeleteRequest:
+	case *proto.DeleteRangeRequest:
+		w.endKey = t.EndKey
+	default:
+		return
+	}
+	txn.writes = append(txn.writes, w)
+}
+
+// flattenRequests returns the requests of the calls, unrolling batches.
+func flattenRequests(calls []proto.Call) []proto.Request {
+	var reqs []proto.Request
 	for _, c := range calls {
 		if b, ok := c.Args.(*proto.BatchRequest); ok {
 			for _, br := range b.Requests {
-				txn.updateStateForRequest(br.GetValue().(proto.Request))
+				reqs = append(reqs, br.GetValue().(proto.Request))
 			}
 			continue
 		}
-		txn.updateStateForRequest(c.Args)
+		reqs = append(reqs, c.Args)
+	}
+	return reqs
+}
+
+func (txn *Txn) updateState(calls []proto.Call) {
+	for _, r := range flattenRequests(calls) {
+		txn.updateStateForRequest(r)
 	}
 }
 
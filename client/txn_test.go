@@ -247,6 +247,32 @@ func TestAbortMutatingTransaction(t *testing.T) {
 	}
 }
 
+// TestAbortCanceledTransaction verifies that calls made after the
+// transaction's context is canceled fail without being sent and that the
+// transaction is still aborted.
+func TestAbortCanceledTransaction(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var calls []proto.Method
+	ctx, cancel := context.WithCancel(context.Background())
+	db := newDB(newTestSender(func(call proto.Call) {
+		calls = append(calls, call.Method())
+	})).WithContext(ctx)
+
+	if err := db.Txn(func(txn *Txn) error {
+		if err := txn.Put("a", "b"); err != nil {
+			return err
+		}
+		cancel()
+		return txn.Put("c", "d")
+	}); err != context.Canceled {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+	expectedCalls := []proto.Method{proto.Put, proto.EndTransaction}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Errorf("expected %s, got %s", expectedCalls, calls)
+	}
+}
+
 // TestRunTransactionRetryOnErrors verifies that the transaction
 // is retried on the correct errors.
 func TestRunTransactionRetryOnErrors(t *testing.T) {
@@ -296,3 +322,100 @@ func TestRunTransactionRetryOnErrors(t *testing.T) {
 		}
 	}
 }
+
+// TestTxnRollbackToSavepointRestores verifies that rolling back to a
+// savepoint sends disjoint spans to roll back, restoring the intents of
+// the writes before the savepoint in place.
+func TestTxnRollbackToSavepointRestores(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var rollbacks []*proto.InternalResolveIntentRangeRequest
+	var sequences []int32
+	db := newDB(newTestSender(func(call proto.Call) {
+		for _, req := range flattenRequests([]proto.Call{call}) {
+			if args, ok := req.(*proto.InternalResolveIntentRangeRequest); ok {
+				rollbacks = append(rollbacks, args)
+				sequences = append(sequences, call.Args.Header().Txn.Sequence)
+			}
+		}
+	}))
+	if err := db.Txn(func(txn *Txn) error {
+		if err := txn.Put("a", "1"); err != nil {
+			return err
+		}
+		if err := txn.Savepoint("sp"); err != nil {
+			return err
+		}
+		if err := txn.Put("a", "2"); err != nil {
+			return err
+		}
+		if err := txn.DelRange("a", "c"); err != nil {
+			return err
+		}
+		return txn.RollbackToSavepoint("sp")
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rollbacks) != 2 {
+		t.Fatalf("expected 2 rollback spans; got %d", len(rollbacks))
+	}
+	for i, expected := range []struct {
+		key, endKey proto.Key
+		restore     string
+	}{
+		{proto.Key("a"), proto.Key("a").Next(), "1"},
+		{proto.Key("a").Next(), proto.Key("c"), ""},
+	} {
+		args := rollbacks[i]
+		if !args.Key.Equal(expected.key) || !args.EndKey.Equal(expected.endKey) {
+			t.Errorf("%d: expected span [%q, %q); got [%q, %q)", i, expected.key, expected.endKey, args.Key, args.EndKey)
+		}
+		if !args.Rollback || args.RestoreDeleted {
+			t.Errorf("%d: expected a rollback without deletion; got %+v", i, args)
+		}
+		var restore string
+		if args.Restore != nil {
+			restore = string(args.Restore.Bytes)
+		}
+		if restore != expected.restore {
+			t.Errorf("%d: expected to restore %q; got %q", i, expected.restore, restore)
+		}
+		if sequences[i] != 1 {
+			t.Errorf("%d: expected the savepoint's sequence number 1; got %d", i, sequences[i])
+		}
+	}
+}
+
+// TestTxnRollbackToSavepointFailureRestarts verifies that a failed
+// rollback to a savepoint restarts the transaction in a new epoch.
+func TestTxnRollbackToSavepointFailureRestarts(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var epochs []int32
+	rollbacks := 0
+	db := newDB(newTestSender(func(call proto.Call) {
+		switch call.Args.(type) {
+		case *proto.PutRequest:
+			epochs = append(epochs, call.Args.Header().Txn.Epoch)
+		case *proto.InternalResolveIntentRangeRequest:
+			rollbacks++
+			if rollbacks == 1 {
+				call.Reply.Header().SetGoError(errors.New("rollback failure"))
+			}
+		}
+	}))
+	db.txnRetryOptions.InitialBackoff = 1 * time.Millisecond
+	if err := db.Txn(func(txn *Txn) error {
+		if err := txn.Savepoint("sp"); err != nil {
+			return err
+		}
+		if err := txn.Put("a", "1"); err != nil {
+			return err
+		}
+		return txn.RollbackToSavepoint("sp")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int32{0, 1}; !reflect.DeepEqual(epochs, expected) {
+		t.Errorf("expected puts in epochs %v; got %v", expected, epochs)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+const (
+	// watchMaxWait is the maximum time the server waits for changes
+	// before replying to a request issued by Watch.
+	watchMaxWait = 5 * time.Second
+	// watchPollInterval is the interval between requests issued by
+	// Watch while no changes are available, for senders which reply
+	// without waiting for changes.
+	watchPollInterval = 50 * time.Millisecond
+	// watchCheckpointInterval is the minimum interval between calls
+	// made by Watch for a resolved timestamp advanced without changes.
+	watchCheckpointInterval = 1 * time.Second
+)
+
+// Changes retrieves the changes committed to keys between begin
+// (inclusive) and end (exclusive) after the since timestamp. It also
+// returns the resolved timestamp at or below which no further changes
+// will be committed to the keys; the changes returned are exactly
+// those up to and including the resolved timestamp, sorted by
+// timestamp and then by key. Deleted keys have a nil Value.
+//
+// The resolved timestamp should be passed as since to the next call
+// to continue where this one left off.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) Changes(begin, end interface{}, since proto.Timestamp) ([]proto.ChangeEvent, proto.Timestamp, error) {
+	return db.changes(begin, end, since, 0)
+}
+
+func (db *DB) changes(begin, end interface{}, since proto.Timestamp, maxWait time.Duration) ([]proto.ChangeEvent, proto.Timestamp, error) {
+	b, err := marshalKey(begin)
+	if err != nil {
+		return nil, since, err
+	}
+	e, err := marshalKey(end)
+	if err != nil {
+		return nil, since, err
+	}
+	call := proto.ChangesCall(proto.Key(b), proto.Key(e), since)
+	call.Args.(*proto.ChangesRequest).MaxWait = maxWait.Nanoseconds()
+	if err := db.send(call); err != nil {
+		return nil, since, err
+	}
+	reply := call.Reply.(*proto.ChangesResponse)
+	return reply.Events, reply.Resolved, nil
+}
+
+// Watch streams the changes committed to keys between begin
+// (inclusive) and end (exclusive) after the since timestamp. f is
+// called with each batch of changes, in timestamp order, and the
+// resolved timestamp up to which the changes are complete. f is also
+// called periodically without changes as the resolved timestamp
+// advances; callers may checkpoint the resolved timestamp and pass it
+// as since to resume watching later.
+//
+// Watch runs until f returns an error or a request fails, and returns
+// that error.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) Watch(begin, end interface{}, since proto.Timestamp,
+	f func(changes []proto.ChangeEvent, resolved proto.Timestamp) error) error {
+	lastCall := time.Now()
+	for {
+		start := time.Now()
+		changes, resolved, err := db.changes(begin, end, since, watchMaxWait)
+		if err != nil {
+			return err
+		}
+		if len(changes) > 0 || (since.Less(resolved) && time.Since(lastCall) >= watchCheckpointInterval) {
+			if err := f(changes, resolved); err != nil {
+				return err
+			}
+			lastCall = time.Now()
+			since = resolved
+		}
+		if len(changes) == 0 && time.Since(start) < watchPollInterval {
+			time.Sleep(watchPollInterval)
+		}
+	}
+}
@@ -48,8 +48,9 @@ var (
 	// key suffixes.
 	LocalSuffixLength = 4
 
-	// There are three types of local key data enumerated below:
-	// store-local, range-local by ID, and range-local by key.
+	// There are four types of local key data enumerated below:
+	// store-local, range-local by ID, range-local by key and queued
+	// updates.
 
 	// LocalStorePrefix is the prefix identifying per-store data.
 	LocalStorePrefix = MakeKey(LocalPrefix, proto.Key("s"))
@@ -89,6 +90,9 @@ var (
 	// LocalRangeLastVerificationTimestampSuffix is the suffix for a range's
 	// last verification timestamp (for checking integrity of on-disk data).
 	LocalRangeLastVerificationTimestampSuffix = proto.Key("rlvt")
+	// LocalRangeNextExpirationSuffix is the suffix for the earliest
+	// expiration of the values written to a range since its last GC.
+	LocalRangeNextExpirationSuffix = proto.Key("rnxe")
 	// LocalRangeStatsSuffix is the suffix for range statistics.
 	LocalRangeStatsSuffix = proto.Key("stat")
 
@@ -113,6 +117,18 @@ var (
 	// NOTE: if this value changes, it must be updated in C++
 	// (storage/engine/db.cc).
 	LocalTransactionSuffix = proto.Key("txn-")
+	// LocalQueueMessageSuffix specifies the key suffix for messages
+	// enqueued into the inbox named by the range key. The additional
+	// detail orders the messages by enqueue time.
+	LocalQueueMessageSuffix = proto.Key("qmsg")
+
+	// LocalQueueUpdatePrefix is the prefix identifying updates enqueued
+	// for eventual execution. The first key the update modifies is
+	// appended to this prefix, encoded using EncodeBytes, followed by
+	// detail which orders the updates by enqueue time. Keeping the
+	// updates apart from other range-local data lets the update queue
+	// scan a range's updates directly.
+	LocalQueueUpdatePrefix = MakeKey(LocalPrefix, proto.Key("u"))
 
 	// LocalMax is the end of the local key range.
 	LocalMax = LocalPrefix.PrefixEnd()
@@ -159,6 +175,11 @@ var (
 	DescIDGenerator = MakeKey(SystemPrefix, proto.Key("desc-idgen"))
 	// DescMetadataPrefix is the key prefix for all descriptor metadata.
 	DescMetadataPrefix = MakeKey(SystemPrefix, proto.Key("desc-"))
+	// DescLeasePrefix is the key prefix for all descriptor leases.
+	DescLeasePrefix = MakeKey(SystemPrefix, proto.Key("lease-"))
+	// ImportCheckpointPrefix is the key prefix for the checkpoints of imports
+	// into tables.
+	ImportCheckpointPrefix = MakeKey(SystemPrefix, proto.Key("import-"))
 	// NodeIDGenerator is the global node ID generator sequence.
 	NodeIDGenerator = MakeKey(SystemPrefix, proto.Key("node-idgen"))
 	// RaftIDGenerator is the global Raft consensus group ID generator sequence.
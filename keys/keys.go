@@ -73,6 +73,33 @@ func MakeDescMetadataKey(descID uint32) proto.Key {
 	return k
 }
 
+// MakeImportCheckpointPrefix returns the key prefix for the checkpoints of
+// the import of the named source into the table with the specified ID.
+func MakeImportCheckpointPrefix(tableID uint32, source string) proto.Key {
+	k := make([]byte, 0, len(ImportCheckpointPrefix)+encoding.MaxUvarintSize+len(source)+2)
+	k = append(k, ImportCheckpointPrefix...)
+	k = encoding.EncodeUvarint(k, uint64(tableID))
+	k = encoding.EncodeBytes(k, []byte(source))
+	return k
+}
+
+// MakeImportCheckpointKey returns the key recording that the batch of rows
+// of the source starting at the specified row has been imported.
+func MakeImportCheckpointKey(tableID uint32, source string, start int64) proto.Key {
+	k := MakeImportCheckpointPrefix(tableID, source)
+	return encoding.EncodeUvarint(k, uint64(start))
+}
+
+// MakeDescLeasePrefix returns the key prefix for the leases held on the
+// specified version of the descriptor.
+func MakeDescLeasePrefix(descID, version uint32) proto.Key {
+	k := make([]byte, 0, len(DescLeasePrefix)+2*encoding.MaxUvarintSize)
+	k = append(k, DescLeasePrefix...)
+	k = encoding.EncodeUvarint(k, uint64(descID))
+	k = encoding.EncodeUvarint(k, uint64(version))
+	return k
+}
+
 // indexKeyBufferWidth returns a likely cap on the width of the index key.
 // The buffer width can likely accomodate the encoded constant prefix, tableID,
 // indexID, and column values.
@@ -239,6 +266,12 @@ func RangeLastVerificationTimestampKey(raftID proto.RaftID) proto.Key {
 	return MakeRangeIDKey(raftID, LocalRangeLastVerificationTimestampSuffix, proto.Key{})
 }
 
+// RangeNextExpirationKey returns a range-local key for the earliest
+// expiration of the values written to the range since its last GC.
+func RangeNextExpirationKey(raftID proto.RaftID) proto.Key {
+	return MakeRangeIDKey(raftID, LocalRangeNextExpirationSuffix, proto.Key{})
+}
+
 // RangeTreeNodeKey returns a range-local key for the the range's
 // node in the range tree.
 func RangeTreeNodeKey(key proto.Key) proto.Key {
@@ -258,6 +291,39 @@ func TransactionKey(key proto.Key, id []byte) proto.Key {
 	return MakeRangeKey(key, LocalTransactionSuffix, proto.Key(id))
 }
 
+// QueueMessagePrefix returns the prefix of the keys of all messages
+// enqueued into the specified inbox.
+func QueueMessagePrefix(inbox proto.Key) proto.Key {
+	return MakeRangeKey(inbox, LocalQueueMessageSuffix, nil)
+}
+
+// QueueMessageKey returns the key of a message enqueued into the
+// specified inbox at the given timestamp by the command with the given
+// ID. Messages of an inbox sort by their enqueue timestamp.
+func QueueMessageKey(inbox proto.Key, timestamp proto.Timestamp, cmdID proto.ClientCmdID) proto.Key {
+	return MakeRangeKey(inbox, LocalQueueMessageSuffix, queueDetail(timestamp, cmdID))
+}
+
+// QueueUpdatePrefix returns the prefix of the keys of all updates
+// stored with the specified key.
+func QueueUpdatePrefix(key proto.Key) proto.Key {
+	return MakeKey(LocalQueueUpdatePrefix, encoding.EncodeBytes(nil, key))
+}
+
+// QueueUpdateKey returns the key of an update enqueued at the given
+// timestamp by the command with the given ID. The update is stored with
+// the range containing key.
+func QueueUpdateKey(key proto.Key, timestamp proto.Timestamp, cmdID proto.ClientCmdID) proto.Key {
+	return MakeKey(QueueUpdatePrefix(key), queueDetail(timestamp, cmdID))
+}
+
+func queueDetail(timestamp proto.Timestamp, cmdID proto.ClientCmdID) proto.Key {
+	detail := encoding.EncodeUint64(nil, uint64(timestamp.WallTime))
+	detail = encoding.EncodeUint32(detail, uint32(timestamp.Logical))
+	detail = encoding.EncodeUint64(detail, uint64(cmdID.WallTime))
+	return encoding.EncodeUint64(detail, uint64(cmdID.Random))
+}
+
 // KeyAddress returns the address for the key, used to lookup the
 // range containing the key. In the normal case, this is simply the
 // key's value. However, for local keys, such as transaction records,
@@ -285,8 +351,13 @@ func KeyAddress(k proto.Key) proto.Key {
 		_, k = encoding.DecodeBytes(k, nil)
 		return k
 	}
-	log.Fatalf("local key %q malformed; should contain prefix %q",
-		k, LocalRangePrefix)
+	if bytes.HasPrefix(k, LocalQueueUpdatePrefix) {
+		k = k[len(LocalQueueUpdatePrefix):]
+		_, k = encoding.DecodeBytes(k, nil)
+		return k
+	}
+	log.Fatalf("local key %q malformed; should contain prefix %q or %q",
+		k, LocalRangePrefix, LocalQueueUpdatePrefix)
 	return nil
 }
 
@@ -339,6 +410,29 @@ func ValidateRangeMetaKey(key proto.Key) error {
 	return NewInvalidRangeMetaKeyError("not a meta key", key)
 }
 
+// ValidateReverseRangeMetaKey validates that the given key is a valid Range
+// Metadata key for a reverse range lookup, which looks for the range
+// containing the keys preceding the key's address. Unlike for forward
+// lookups, the meta key of KeyMax is valid and KeyMin is not.
+func ValidateReverseRangeMetaKey(key proto.Key) error {
+	if key.Equal(Meta2KeyMax) {
+		return nil
+	}
+	if key.Equal(proto.KeyMin) {
+		return NewInvalidRangeMetaKeyError("no keys precede KeyMin", key)
+	}
+	return ValidateRangeMetaKey(key)
+}
+
+// MetaReverseScanBounds returns the start and end keys of the range within
+// which the meta record of the range containing the keys preceding the given
+// key's address can be found by means of an engine scan: it is the first
+// record at or after the start key. The given key must be valid as defined
+// by ValidateReverseRangeMetaKey.
+func MetaReverseScanBounds(key proto.Key) (proto.Key, proto.Key) {
+	return key, proto.Key(key[:len(Meta1Prefix)]).PrefixEnd()
+}
+
 // MetaScanBounds returns the start and end keys of the range within which the
 // desired meta record can be found by means of an engine scan. The given key
 // must be a valid RangeMetaKey as defined by ValidateRangeMetaKey.
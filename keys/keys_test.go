@@ -64,8 +64,11 @@ func TestKeyAddress(t *testing.T) {
 		{RangeDescriptorKey(proto.Key("foo")), proto.Key("foo")},
 		{TransactionKey(proto.Key("baz"), proto.Key(uuid.NewUUID4())), proto.Key("baz")},
 		{TransactionKey(proto.KeyMax, proto.Key(uuid.NewUUID4())), proto.KeyMax},
+		{QueueMessageKey(proto.Key("inbox"), proto.Timestamp{WallTime: 1}, proto.ClientCmdID{Random: 2}), proto.Key("inbox")},
+		{QueueUpdateKey(proto.Key("foo"), proto.Timestamp{WallTime: 1}, proto.ClientCmdID{Random: 2}), proto.Key("foo")},
 		{MakeNameMetadataKey(0, "foo"), proto.Key("\x00name-\bfoo")},
 		{MakeDescMetadataKey(123), proto.Key("\x00desc-\t{")},
+		{MakeDescLeasePrefix(123, 2), proto.Key("\x00lease-\t{\t\x02")},
 		{nil, nil},
 	}
 	for i, test := range testCases {
@@ -76,6 +79,30 @@ func TestKeyAddress(t *testing.T) {
 	}
 }
 
+// TestQueueMessageKeys verifies that the messages of an inbox sort by
+// their enqueue timestamp and do not interleave with those of another
+// inbox.
+func TestQueueMessageKeys(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	inbox := proto.Key("a")
+	k1 := QueueMessageKey(inbox, proto.Timestamp{WallTime: 1, Logical: 5}, proto.ClientCmdID{Random: 9})
+	k2 := QueueMessageKey(inbox, proto.Timestamp{WallTime: 2}, proto.ClientCmdID{Random: 1})
+	k3 := QueueMessageKey(inbox, proto.Timestamp{WallTime: 2}, proto.ClientCmdID{Random: 2})
+	other := QueueMessageKey(proto.Key("a\x00"), proto.Timestamp{}, proto.ClientCmdID{})
+	if !(k1.Less(k2) && k2.Less(k3)) {
+		t.Errorf("expected message keys to sort by timestamp and command ID")
+	}
+	prefix := QueueMessagePrefix(inbox)
+	for _, k := range []proto.Key{k1, k2, k3} {
+		if !bytes.HasPrefix(k, prefix) {
+			t.Errorf("expected key %q to have prefix %q", k, prefix)
+		}
+	}
+	if bytes.HasPrefix(other, prefix) || !prefix.PrefixEnd().Less(other) {
+		t.Errorf("expected key %q to sort after the messages of %q", other, inbox)
+	}
+}
+
 func TestMakeTableIndexKey(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	key := MakeTableIndexKey(12, 345, []byte("foo"), []byte("bar"))
@@ -242,3 +269,25 @@ func TestValidateRangeMetaKey(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateReverseRangeMetaKey(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		key    proto.Key
+		expErr bool
+	}{
+		{proto.KeyMin, true},
+		{proto.Key("\x00"), true},
+		{Meta1Prefix, false},
+		{proto.MakeKey(Meta1Prefix, proto.KeyMax), false},
+		{proto.MakeKey(Meta2Prefix, proto.Key("foo")), false},
+		{proto.MakeKey(Meta2Prefix, proto.KeyMax), false},
+		{proto.MakeKey(Meta2Prefix, proto.KeyMax.Next()), true},
+	}
+	for i, test := range testCases {
+		err := ValidateReverseRangeMetaKey(test.key)
+		if err != nil != test.expErr {
+			t.Errorf("%d: expected error? %t: %s", i, test.expErr, err)
+		}
+	}
+}
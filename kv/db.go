@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -39,6 +40,10 @@ const (
 	DBPrefix = client.KVDBEndpoint
 )
 
+// changesPollInterval is the interval at which long-polled Changes
+// requests are retried while no changes are available.
+const changesPollInterval = 50 * time.Millisecond
+
 var allowedEncodings = []util.EncodingType{util.JSONEncoding, util.ProtoEncoding}
 
 // verifyRequest checks for illegal inputs in request proto and
@@ -61,7 +66,12 @@ var allPublicMethods = map[string]proto.Method{
 	proto.Delete.String():         proto.Delete,
 	proto.DeleteRange.String():    proto.DeleteRange,
 	proto.Scan.String():           proto.Scan,
+	proto.ReverseScan.String():    proto.ReverseScan,
 	proto.EndTransaction.String(): proto.EndTransaction,
+	proto.ReapQueue.String():      proto.ReapQueue,
+	proto.EnqueueUpdate.String():  proto.EnqueueUpdate,
+	proto.EnqueueMessage.String(): proto.EnqueueMessage,
+	proto.Changes.String():        proto.Changes,
 	proto.Batch.String():          proto.Batch,
 	proto.AdminSplit.String():     proto.AdminSplit,
 	proto.AdminMerge.String():     proto.AdminMerge,
@@ -89,8 +99,18 @@ func createArgsAndReply(method string) (proto.Request, proto.Response) {
 			return &proto.DeleteRangeRequest{}, &proto.DeleteRangeResponse{}
 		case proto.Scan:
 			return &proto.ScanRequest{}, &proto.ScanResponse{}
+		case proto.ReverseScan:
+			return &proto.ReverseScanRequest{}, &proto.ReverseScanResponse{}
 		case proto.EndTransaction:
 			return &proto.EndTransactionRequest{}, &proto.EndTransactionResponse{}
+		case proto.ReapQueue:
+			return &proto.ReapQueueRequest{}, &proto.ReapQueueResponse{}
+		case proto.EnqueueUpdate:
+			return &proto.EnqueueUpdateRequest{}, &proto.EnqueueUpdateResponse{}
+		case proto.EnqueueMessage:
+			return &proto.EnqueueMessageRequest{}, &proto.EnqueueMessageResponse{}
+		case proto.Changes:
+			return &proto.ChangesRequest{}, &proto.ChangesResponse{}
 		case proto.Batch:
 			return &proto.BatchRequest{}, &proto.BatchResponse{}
 		case proto.AdminSplit:
@@ -167,7 +187,7 @@ func (s *DBServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create a call and invoke through sender.
-	s.sender.Send(context.TODO(), proto.Call{Args: args, Reply: reply})
+	s.send(proto.Call{Args: args, Reply: reply})
 
 	// Marshal the response.
 	body, contentType, err := util.MarshalResponse(r, reply, allowedEncodings)
@@ -189,7 +209,12 @@ func (s *DBServer) RegisterRPC(rpcServer *rpc.Server) error {
 		&proto.DeleteRequest{},
 		&proto.DeleteRangeRequest{},
 		&proto.ScanRequest{},
+		&proto.ReverseScanRequest{},
 		&proto.EndTransactionRequest{},
+		&proto.ReapQueueRequest{},
+		&proto.EnqueueUpdateRequest{},
+		&proto.EnqueueMessageRequest{},
+		&proto.ChangesRequest{},
 		&proto.BatchRequest{},
 		&proto.AdminSplitRequest{},
 		&proto.AdminMergeRequest{},
@@ -207,6 +232,29 @@ func (s *DBServer) RegisterRPC(rpcServer *rpc.Server) error {
 func (s *DBServer) executeCmd(argsI gogoproto.Message) (gogoproto.Message, error) {
 	args := argsI.(proto.Request)
 	reply := args.CreateReply()
-	s.sender.Send(context.TODO(), proto.Call{Args: args, Reply: reply})
+	s.send(proto.Call{Args: args, Reply: reply})
 	return reply, nil
 }
+
+// send invokes the call through our local sender. Changes requests
+// which specify a maximum wait are long-polled: they are retried until
+// changes become available, the call fails or the wait expires.
+func (s *DBServer) send(call proto.Call) {
+	args, ok := call.Args.(*proto.ChangesRequest)
+	if !ok || args.MaxWait <= 0 {
+		s.sender.Send(context.TODO(), call)
+		return
+	}
+	reply := call.Reply.(*proto.ChangesResponse)
+	deadline := time.Now().Add(time.Duration(args.MaxWait))
+	for {
+		// Each attempt must be assigned a fresh timestamp.
+		args.Timestamp = proto.ZeroTimestamp
+		reply.Reset()
+		s.sender.Send(context.TODO(), call)
+		if reply.Error != nil || len(reply.Events) > 0 || !time.Now().Before(deadline) {
+			return
+		}
+		time.Sleep(changesPollInterval)
+	}
+}
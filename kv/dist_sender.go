@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
@@ -59,6 +60,9 @@ const (
 	defaultLeaderCacheSize = 1 << 16
 	// The default size of the range descriptor cache.
 	defaultRangeDescriptorCacheSize = 1 << 20
+	// The default maximum number of ranges a range-spanning request
+	// is sent to in parallel.
+	defaultRangeConcurrency = 8
 )
 
 var defaultRPCRetryOptions = retry.Options{
@@ -116,10 +120,17 @@ type DistSender struct {
 	// leaderCache caches the last known leader replica for range
 	// consensus groups.
 	leaderCache *leaderCache
+	// rangeConcurrency is the maximum number of ranges a
+	// range-spanning request is sent to in parallel.
+	rangeConcurrency int
 	// rpcSend is used to send RPC calls and defaults to rpc.Send
 	// outside of tests.
 	rpcSend         rpcSendFn
 	rpcRetryOptions retry.Options
+	// closedTimestampLag is the lag behind the present at which leader
+	// lease holders close timestamps. Reads at closed timestamps may
+	// be served by any replica. Zero if timestamps are never closed.
+	closedTimestampLag time.Duration
 }
 
 var _ client.Sender = &DistSender{}
@@ -138,7 +149,15 @@ type DistSenderContext struct {
 	// range descriptor cache when dispatching a range lookup request.
 	RangeLookupMaxRanges int32
 	LeaderCacheSize      int32
-	RPCRetryOptions      *retry.Options
+	// RangeConcurrency sets the maximum number of ranges to which a
+	// range-spanning request is sent in parallel. Set to one to visit
+	// ranges sequentially.
+	RangeConcurrency int32
+	RPCRetryOptions  *retry.Options
+	// ClosedTimestampLag should match the lag with which the stores
+	// close timestamps. If set, reads sufficiently far in the past are
+	// sent to the nearest replica instead of the leader.
+	ClosedTimestampLag time.Duration
 	// nodeDescriptor, if provided, is used to describe which node the DistSender
 	// lives on, for instance when deciding where to send RPCs.
 	// Usually it is filled in from the Gossip network on demand.
@@ -162,8 +181,9 @@ func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
 		clock = hlc.NewClock(hlc.UnixNano)
 	}
 	ds := &DistSender{
-		clock:  clock,
-		gossip: gossip,
+		clock:              clock,
+		gossip:             gossip,
+		closedTimestampLag: ctx.ClosedTimestampLag,
 	}
 	if ctx.nodeDescriptor != nil {
 		atomic.StorePointer(&ds.nodeDescriptor, unsafe.Pointer(ctx.nodeDescriptor))
@@ -182,6 +202,10 @@ func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
 		lcSize = defaultLeaderCacheSize
 	}
 	ds.leaderCache = newLeaderCache(int(lcSize))
+	ds.rangeConcurrency = int(ctx.RangeConcurrency)
+	if ds.rangeConcurrency <= 0 {
+		ds.rangeConcurrency = defaultRangeConcurrency
+	}
 	if ctx.RangeLookupMaxRanges <= 0 {
 		ds.rangeLookupMaxRanges = defaultRangeLookupMaxRanges
 	}
@@ -209,6 +233,28 @@ func (ds *DistSender) verifyPermissions(args proto.Request) error {
 	if header.User == security.RootUser {
 		return nil
 	}
+	// The requests of an enqueued update are later executed on behalf
+	// of the node, so the user must be permitted to invoke each of them.
+	if eu, ok := args.(*proto.EnqueueUpdateRequest); ok {
+		for i := range eu.Update.Requests {
+			req := eu.Update.Requests[i].GetValue().(proto.Request)
+			if err := ds.verifyPermissionsAs(header.User, req); err != nil {
+				return err
+			}
+		}
+	}
+	// A batch is permitted if each of its requests is; its key range
+	// may well cover keys which none of them accesses. An empty batch
+	// is checked against its key range like any other request.
+	if b, ok := args.(*proto.InternalBatchRequest); ok && len(b.Requests) > 0 {
+		for i := range b.Requests {
+			req := b.Requests[i].GetValue().(proto.Request)
+			if err := ds.verifyPermissionsAs(header.User, req); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	// Check for admin methods.
 	if proto.IsAdmin(args) {
 		if header.User != security.RootUser {
@@ -266,9 +312,21 @@ func (ds *DistSender) verifyPermissions(args proto.Request) error {
 		})
 }
 
+// verifyPermissionsAs verifies that user is permitted to invoke req,
+// regardless of the user specified on req itself.
+func (ds *DistSender) verifyPermissionsAs(user string, req proto.Request) error {
+	reqUser := req.Header().User
+	req.Header().User = user
+	defer func() { req.Header().User = reqUser }()
+	return ds.verifyPermissions(req)
+}
+
 // lookupOptions capture additional options to pass to InternalRangeLookup.
 type lookupOptions struct {
 	ignoreIntents bool
+	// useReverseScan looks up the range containing the keys immediately
+	// preceding the key instead of the one containing the key.
+	useReverseScan bool
 }
 
 // internalRangeLookup dispatches an InternalRangeLookup request for the given
@@ -289,6 +347,7 @@ func (ds *DistSender) internalRangeLookup(key proto.Key, options lookupOptions,
 		},
 		MaxRanges:     ds.rangeLookupMaxRanges,
 		IgnoreIntents: options.ignoreIntents,
+		Reverse:       options.useReverseScan,
 	}
 	replicas := newReplicaSlice(ds.gossip, desc)
 	// TODO(tschottdorf) consider a Trace here, potentially that of the request
@@ -320,7 +379,9 @@ func (ds *DistSender) getFirstRangeDescriptor() (*proto.RangeDescriptor, error)
 // consecutive ranges, the first of which must contain the requested key. The
 // additional RangeDescriptors are returned with the intent of pre-caching
 // subsequent ranges which are likely to be requested soon by the current
-// workload.
+// workload. For reverse lookups, the first range contains the keys
+// immediately preceding the requested key and the additional ones precede
+// it in descending order.
 func (ds *DistSender) getRangeDescriptors(key proto.Key, options lookupOptions) ([]proto.RangeDescriptor, error) {
 	var (
 		// metadataKey is sent to internalRangeLookup to find the
@@ -348,8 +409,12 @@ func (ds *DistSender) getRangeDescriptors(key proto.Key, options lookupOptions)
 		}
 	} else {
 		// Look up desc from the cache, which will recursively call into
-		// ds.getRangeDescriptors if it is not cached.
-		desc, err = ds.rangeCache.LookupRangeDescriptor(metadataKey, options)
+		// ds.getRangeDescriptors if it is not cached. The meta record is
+		// found at or after metadataKey, so the lookup of the range
+		// holding it is a forward one even for reverse lookups.
+		metaOptions := options
+		metaOptions.useReverseScan = false
+		desc, err = ds.rangeCache.LookupRangeDescriptor(metadataKey, metaOptions)
 		if err != nil {
 			return nil, err
 		}
@@ -487,6 +552,10 @@ func (ds *DistSender) getDescriptors(call proto.Call) (*proto.RangeDescriptor, *
 		options.ignoreIntents = pushArgs.RangeLookup
 	}
 
+	if proto.IsReverse(call.Args) {
+		return ds.getReverseDescriptors(call, options)
+	}
+
 	desc, err := ds.rangeCache.LookupRangeDescriptor(call.Args.Header().Key, options)
 	if err != nil {
 		return nil, nil, err
@@ -496,14 +565,23 @@ func (ds *DistSender) getDescriptors(call proto.Call) (*proto.RangeDescriptor, *
 	// If the request accesses keys beyond the end of this range,
 	// get the descriptor of the adjacent range to address next.
 	if desc.EndKey.Less(call.Args.Header().EndKey) {
+		// A batch committing a transaction in one phase must be
+		// contained in a single range; the coordinator falls back to
+		// a regular commit.
+		if _, ok := call.Args.(*proto.InternalBatchRequest); ok {
+			return nil, nil, &proto.OpRequiresTxnError{}
+		}
 		if _, ok := call.Reply.(proto.Combinable); !ok {
 			return nil, nil, util.Error("illegal cross-range operation")
 		}
 		// If there's no transaction and op spans ranges, possibly
 		// re-run as part of a transaction for consistency. The
 		// case where we don't need to re-run is if the read
-		// consistency is not required.
-		if call.Args.Header().Txn == nil &&
+		// consistency is not required. Changes requests are never
+		// transactional; their combined resolved timestamp already
+		// accounts for each range they visit.
+		_, isChanges := call.Args.(*proto.ChangesRequest)
+		if call.Args.Header().Txn == nil && !isChanges &&
 			call.Args.Header().ReadConsistency != proto.INCONSISTENT {
 			return nil, nil, &proto.OpRequiresTxnError{}
 		}
@@ -518,12 +596,44 @@ func (ds *DistSender) getDescriptors(call proto.Call) (*proto.RangeDescriptor, *
 	return desc, descNext, nil
 }
 
+// getReverseDescriptors is the counterpart of getDescriptors for
+// requests which visit their key range in descending order. It returns
+// the descriptor of the range containing the keys immediately preceding
+// the end of the request's key range and, if the request extends to
+// earlier ranges, the descriptor of the range preceding it.
+func (ds *DistSender) getReverseDescriptors(call proto.Call, options lookupOptions) (*proto.RangeDescriptor, *proto.RangeDescriptor, error) {
+	header := call.Args.Header()
+	options.useReverseScan = true
+	desc, err := ds.rangeCache.LookupRangeDescriptor(header.EndKey, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !header.Key.Less(desc.StartKey) {
+		return desc, nil, nil
+	}
+	if _, ok := call.Reply.(proto.Combinable); !ok {
+		return nil, nil, util.Error("illegal cross-range operation")
+	}
+	if header.Txn == nil && header.ReadConsistency != proto.INCONSISTENT {
+		return nil, nil, &proto.OpRequiresTxnError{}
+	}
+	// This lookup is likely for free since reverse range lookups
+	// prefetch the descriptors of the preceding ranges.
+	descPrev, err := ds.rangeCache.LookupRangeDescriptor(desc.StartKey, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	return desc, descPrev, nil
+}
+
 // sendAttempt is invoked by Send. It temporarily truncates the arguments to
-// match the descriptor's EndKey (if necessary) and gathers and rearranges the
-// replicas before making a single attempt at sending the request. It returns
+// match the descriptor's EndKey (and, for reverse requests, StartKey) if
+// necessary, and gathers and rearranges the
+// replicas before making a single attempt at sending the request. Unless
+// followerRead is set, the cached leader is tried first. It returns
 // the result of sending the RPC; a potential error contained in the reply has
 // to be handled separately by the caller.
-func (ds *DistSender) sendAttempt(trace *tracer.Trace, args proto.Request, desc *proto.RangeDescriptor) (proto.Response, error) {
+func (ds *DistSender) sendAttempt(trace *tracer.Trace, args proto.Request, desc *proto.RangeDescriptor, followerRead bool) (proto.Response, error) {
 	defer trace.Epoch("sending RPC")()
 	// Truncate the request to our current range, making sure not to
 	// touch it unless we have to (it is illegal to send EndKey on
@@ -532,6 +642,12 @@ func (ds *DistSender) sendAttempt(trace *tracer.Trace, args proto.Request, desc
 		defer func(k proto.Key) { args.Header().EndKey = k }(endKey)
 		args.Header().EndKey = desc.EndKey
 	}
+	if proto.IsReverse(args) {
+		if key := args.Header().Key; key.Less(desc.StartKey) {
+			defer func(k proto.Key) { args.Header().Key = k }(key)
+			args.Header().Key = desc.StartKey
+		}
+	}
 	leader := ds.leaderCache.Lookup(proto.RaftID(desc.RaftID))
 
 	// Try to send the call.
@@ -543,9 +659,10 @@ func (ds *DistSender) sendAttempt(trace *tracer.Trace, args proto.Request, desc
 	order := ds.optimizeReplicaOrder(replicas)
 
 	// If this request needs to go to a leader and we know who that is, move
-	// it to the front.
+	// it to the front. Inconsistent reads and reads which any replica can
+	// serve go to the nearest replica instead.
 	if !(proto.IsRead(args) && args.Header().ReadConsistency == proto.INCONSISTENT) &&
-		leader.StoreID > 0 {
+		!followerRead && leader.StoreID > 0 {
 		if i := replicas.FindReplica(leader.StoreID); i >= 0 {
 			replicas.MoveToFront(i)
 			order = rpc.OrderStable
@@ -555,13 +672,44 @@ func (ds *DistSender) sendAttempt(trace *tracer.Trace, args proto.Request, desc
 	return ds.sendRPC(trace, desc.RaftID, replicas, order, args)
 }
 
+// isFollowerRead returns whether the request is a read at a timestamp
+// which the leader has likely closed, in which case it can be served
+// by any replica. A replica which hasn't yet caught up to the closed
+// timestamp redirects to the leader.
+func (ds *DistSender) isFollowerRead(args proto.Request) bool {
+	if ds.closedTimestampLag <= 0 {
+		return false
+	}
+	switch args.(type) {
+	case *proto.GetRequest, *proto.ScanRequest, *proto.ReverseScanRequest:
+	default:
+		return false
+	}
+	header := args.Header()
+	if header.Timestamp.Equal(proto.ZeroTimestamp) {
+		return false
+	}
+	timestamp := header.Timestamp
+	if header.Txn != nil {
+		timestamp.Forward(header.Txn.MaxTimestamp)
+	}
+	closed := ds.clock.Now()
+	closed.WallTime -= ds.closedTimestampLag.Nanoseconds()
+	return !closed.Less(timestamp)
+}
+
 // Send implements the client.Sender interface. It verifies
 // permissions and looks up the appropriate range based on the
 // supplied key and sends the RPC according to the specified options.
 //
 // If the request spans multiple ranges (which is possible for Scan or
-// DeleteRange requests), Send sends requests to the individual ranges
-// sequentially and combines the results transparently.
+// DeleteRange requests), Send sends requests to up to rangeConcurrency
+// of the individual ranges in parallel and combines the results
+// transparently, in the order in which the ranges would have been
+// visited sequentially. Reads and transactional writes are sent in
+// parallel; non-transactional writes visit the ranges one at a time.
+// Reverse requests (e.g. ReverseScan) visit the ranges in descending
+// order.
 //
 // This may temporarily adjust the request headers, so the proto.Call
 // must not be used concurrently until Send has returned.
@@ -574,17 +722,39 @@ func (ds *DistSender) Send(ctx context.Context, call proto.Call) {
 		return
 	}
 
-	trace := tracer.FromCtx(ctx)
-
 	// In the event that timestamp isn't set and read consistency isn't
-	// required, set the timestamp using the local clock.
+	// required, set the timestamp using the local clock, less the
+	// staleness the read tolerates.
 	if args.Header().ReadConsistency == proto.INCONSISTENT && args.Header().Timestamp.Equal(proto.ZeroTimestamp) {
 		// Make sure that after the call, args hasn't changed.
 		defer func(timestamp proto.Timestamp) {
 			args.Header().Timestamp = timestamp
 		}(args.Header().Timestamp)
 		args.Header().Timestamp = ds.clock.Now()
+		args.Header().Timestamp.WallTime -= args.Header().MaxStaleness
+	}
+
+	// Requests which span ranges are split at the range boundaries and
+	// sent in parallel. Requests which are illegal across ranges are
+	// left to sendSequential to reject. Non-transactional writes are
+	// sent sequentially, since parts sent to ranges past the first
+	// failing one could not be undone.
+	if ds.rangeConcurrency > 1 && args.Header().EndKey != nil &&
+		(proto.IsReadOnly(args) || args.Header().Txn != nil) {
+		if _, descNext, err := ds.getDescriptors(call); err == nil && descNext != nil {
+			ds.sendParallel(ctx, call)
+			return
+		}
 	}
+	ds.sendSequential(ctx, call)
+}
+
+// sendSequential sends the request to the ranges it spans one at a
+// time, retrying each as necessary, and combines the results. Reverse
+// requests visit the ranges in descending order.
+func (ds *DistSender) sendSequential(ctx context.Context, call proto.Call) {
+	args := call.Args
+	trace := tracer.FromCtx(ctx)
 
 	// If this is a bounded request, we will change its bound as we receive
 	// replies. This undoes that when we return.
@@ -596,10 +766,12 @@ func (ds *DistSender) Send(ctx context.Context, call proto.Call) {
 		}(boundedArgs.GetBound())
 	}
 
-	defer func(key proto.Key) {
+	defer func(key, endKey proto.Key) {
 		args.Header().Key = key
-	}(args.Header().Key)
+		args.Header().EndKey = endKey
+	}(args.Header().Key, args.Header().EndKey)
 
+	reverse := proto.IsReverse(args)
 	first := true
 
 	// Retry logic for lookup of range by key and RPCs to range replicas.
@@ -607,6 +779,7 @@ func (ds *DistSender) Send(ctx context.Context, call proto.Call) {
 		var curReply proto.Response
 		var desc, descNext *proto.RangeDescriptor
 		var err error
+		followerRead := ds.isFollowerRead(args)
 		for r := retry.Start(ds.rpcRetryOptions); r.Next(); {
 			// Get range descriptor (or, when spanning range, descriptors). Our
 			// error handling below may clear them on certain errors, so we
@@ -628,8 +801,14 @@ func (ds *DistSender) Send(ctx context.Context, call proto.Call) {
 				}
 				break
 			}
+			// The key used to evict desc from the cache must lie within
+			// desc, which a reverse request's start key may not.
+			descKey := args.Header().Key
+			if reverse && descKey.Less(desc.StartKey) {
+				descKey = desc.StartKey
+			}
 			// At this point reply.Header().Error may be non-nil!
-			curReply, err = ds.sendAttempt(trace, args, desc)
+			curReply, err = ds.sendAttempt(trace, args, desc, followerRead)
 			if err != nil {
 				trace.Event(fmt.Sprintf("send error: %T", err))
 				// For an RPC error to occur, we must've been unable to contact any
@@ -640,7 +819,7 @@ func (ds *DistSender) Send(ctx context.Context, call proto.Call) {
 				// TODO(tschottdorf): If a replica group goes dead, this will cause clients
 				// to put high read pressure on the first range, so there should be some
 				// rate limiting here.
-				ds.rangeCache.EvictCachedRangeDescriptor(args.Header().Key, desc)
+				ds.rangeCache.EvictCachedRangeDescriptor(descKey, desc)
 			} else {
 				err = curReply.Header().GoError()
 			}
@@ -660,7 +839,7 @@ func (ds *DistSender) Send(ctx context.Context, call proto.Call) {
 			case *proto.RangeNotFoundError, *proto.RangeKeyMismatchError:
 				trace.Event(fmt.Sprintf("reply error: %T", err))
 				// Range descriptor might be out of date - evict it.
-				ds.rangeCache.EvictCachedRangeDescriptor(args.Header().Key, desc)
+				ds.rangeCache.EvictCachedRangeDescriptor(descKey, desc)
 				// On addressing errors, don't backoff; retry immediately.
 				r.Reset()
 				if log.V(1) {
@@ -678,12 +857,15 @@ func (ds *DistSender) Send(ctx context.Context, call proto.Call) {
 						if log.V(1) {
 							log.Infof("error indicates unknown leader %s, expunging descriptor %s", newLeader, desc)
 						}
-						ds.rangeCache.EvictCachedRangeDescriptor(args.Header().Key, desc)
+						ds.rangeCache.EvictCachedRangeDescriptor(descKey, desc)
 					}
 				} else {
 					newLeader = &proto.Replica{}
 				}
 				ds.updateLeaderCache(proto.RaftID(desc.RaftID), *newLeader)
+				// The replica couldn't serve the read without the leader
+				// lease, so retry at the leader.
+				followerRead = false
 				if log.V(1) {
 					log.Warning(err)
 				}
@@ -756,11 +938,150 @@ func (ds *DistSender) Send(ctx context.Context, call proto.Call) {
 		// one, and unless both descriptors are stale, the next descriptor's
 		// StartKey would move us to the beginning of the current range,
 		// resulting in a duplicate scan.
+		if reverse {
+			// For reverse requests, the remainder of the key range ends
+			// where the current descriptor starts.
+			args.Header().EndKey = desc.StartKey
+			trace.Event("querying previous range")
+			continue
+		}
 		args.Header().Key = desc.EndKey
 		trace.Event("querying next range")
 	}
 }
 
+// keySpan is the key span [key, endKey) of the part of a request
+// which is sent to a single range.
+type keySpan struct {
+	key, endKey proto.Key
+}
+
+// sendParallel sends a request spanning multiple ranges by splitting
+// it at the range boundaries known to the range descriptor cache and
+// sending the parts in waves of up to rangeConcurrency in parallel.
+// Each part is sent via sendSequential, which retries it and splits it
+// further should its range have split in the meantime. The replies are
+// combined in the order in which sendSequential would have visited
+// the ranges; as in sendSequential, the first error in that order is
+// returned, and no further waves are sent once a part has failed.
+// Reverse requests are split from the end of their key range, and
+// their waves visit the ranges in descending order. For bounded
+// requests, each part of a wave is bounded by
+// the number of results still missing, and results beyond the bound
+// are discarded, as are the replies and errors of ranges
+// sendSequential wouldn't have reached.
+func (ds *DistSender) sendParallel(ctx context.Context, call proto.Call) {
+	args := call.Args
+	trace := tracer.FromCtx(ctx)
+	reverse := proto.IsReverse(args)
+	var bound int64
+	if boundedArgs, ok := args.(proto.Bounded); ok {
+		bound = boundedArgs.GetBound()
+	}
+
+	key, endKey := args.Header().Key, args.Header().EndKey
+	first, done := true, false
+	for {
+		var spans []keySpan
+		if reverse {
+			spans = ds.prevSpans(key, endKey, ds.rangeConcurrency)
+		} else {
+			spans = ds.nextSpans(key, endKey, ds.rangeConcurrency)
+		}
+		calls := make([]proto.Call, len(spans))
+		var wg sync.WaitGroup
+		for i, span := range spans {
+			partArgs := gogoproto.Clone(args).(proto.Request)
+			partArgs.Header().Key = span.key
+			partArgs.Header().EndKey = span.endKey
+			if bound > 0 {
+				partArgs.(proto.Bounded).SetBound(bound)
+			}
+			calls[i] = proto.Call{Args: partArgs, Reply: partArgs.CreateReply()}
+			ctx := tracer.ToCtx(ctx, trace.Fork())
+			wg.Add(1)
+			go func(call proto.Call) {
+				ds.sendSequential(ctx, call)
+				wg.Done()
+			}(calls[i])
+		}
+		wg.Wait()
+		trace.Event(fmt.Sprintf("sent to %d ranges in parallel", len(spans)))
+
+		for _, partCall := range calls {
+			curReply := partCall.Reply
+			if err := curReply.Header().GoError(); err != nil {
+				call.Reply.Header().SetGoError(err)
+				return
+			}
+			if bound > 0 {
+				if cReply, ok := curReply.(proto.Countable); ok {
+					cReply.Truncate(bound)
+					bound -= cReply.Count()
+					// Once enough results have been gathered, no further
+					// ranges would have been visited.
+					done = bound == 0
+				}
+			}
+			if first {
+				// Equivalent of `*call.Reply = curReply`. Generics!
+				dst := reflect.ValueOf(call.Reply).Elem()
+				dst.Set(reflect.ValueOf(curReply).Elem())
+			} else {
+				// All range-spanning requests have combinable responses;
+				// sendParallel is only used after getDescriptors checked.
+				call.Reply.(proto.Combinable).Combine(curReply)
+			}
+			first = false
+			if done {
+				return
+			}
+		}
+		if reverse {
+			if endKey = spans[len(spans)-1].key; !key.Less(endKey) {
+				return
+			}
+		} else if key = spans[len(spans)-1].endKey; !key.Less(endKey) {
+			return
+		}
+	}
+}
+
+// nextSpans splits off the key spans of up to limit ranges from the
+// start of [key, endKey), according to the range descriptor cache. The
+// last span ends at endKey if the spans cover all of [key, endKey). If
+// a descriptor lookup fails, the remainder is returned as a single
+// span, leaving it to sendSequential to retry the lookup.
+func (ds *DistSender) nextSpans(key, endKey proto.Key, limit int) []keySpan {
+	var spans []keySpan
+	for len(spans) < limit {
+		desc, err := ds.rangeCache.LookupRangeDescriptor(key, lookupOptions{})
+		if err != nil || !desc.EndKey.Less(endKey) {
+			return append(spans, keySpan{key: key, endKey: endKey})
+		}
+		spans = append(spans, keySpan{key: key, endKey: desc.EndKey})
+		key = desc.EndKey
+	}
+	return spans
+}
+
+// prevSpans is the counterpart of nextSpans for reverse requests. It
+// splits off the key spans of up to limit ranges from the end of
+// [key, endKey), in descending order. The last span starts at key if
+// the spans cover all of [key, endKey).
+func (ds *DistSender) prevSpans(key, endKey proto.Key, limit int) []keySpan {
+	var spans []keySpan
+	for len(spans) < limit {
+		desc, err := ds.rangeCache.LookupRangeDescriptor(endKey, lookupOptions{useReverseScan: true})
+		if err != nil || !key.Less(desc.StartKey) {
+			return append(spans, keySpan{key: key, endKey: endKey})
+		}
+		spans = append(spans, keySpan{key: desc.StartKey, endKey: endKey})
+		endKey = desc.StartKey
+	}
+	return spans
+}
+
 // updateLeaderCache updates the cached leader for the given Raft group,
 // evicting any previous value in the process.
 func (ds *DistSender) updateLeaderCache(rid proto.RaftID, leader proto.Replica) {
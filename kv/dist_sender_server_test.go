@@ -18,6 +18,7 @@
 package kv_test
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server"
 	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -134,6 +136,182 @@ func TestMultiRangeScanDeleteResolve(t *testing.T) {
 	}
 }
 
+// TestMultiRangeReverseScan verifies that ReverseScan visits ranges in
+// descending order and honors its bound across ranges.
+func TestMultiRangeReverseScan(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setupMultipleRanges(t, "b")
+	defer s.Stop()
+	if err := db.AdminSplit("d"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		if err := db.Put(key, "value"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		start, end string
+		max        int64
+		expKeys    []string
+	}{
+		{"a", "q", 0, []string{"e", "d", "c", "b", "a"}},
+		{"a", "d", 0, []string{"c", "b", "a"}},
+		{"a\x00", "d\x00", 0, []string{"d", "c", "b"}},
+		{"a", "q", 2, []string{"e", "d"}},
+		{"a", "q", 3, []string{"e", "d", "c"}},
+		{"c", "c\x00", 0, []string{"c"}},
+	}
+	for i, test := range testCases {
+		rows, err := db.ReverseScan(test.start, test.end, test.max)
+		if err != nil {
+			t.Fatalf("%d: unexpected error on ReverseScan: %s", i, err)
+		}
+		var keys []string
+		for _, row := range rows {
+			keys = append(keys, string(row.Key))
+		}
+		if !reflect.DeepEqual(keys, test.expKeys) {
+			t.Errorf("%d: expected keys %q; got %q", i, test.expKeys, keys)
+		}
+	}
+
+	// Within a transaction, the reverse scan sees the transaction's own
+	// writes.
+	if err := db.Txn(func(txn *client.Txn) error {
+		if err := txn.Put("f", "value"); err != nil {
+			return err
+		}
+		rows, err := txn.ReverseScan("a", "q", 1)
+		if err != nil {
+			return err
+		}
+		if len(rows) != 1 || string(rows[0].Key) != "f" {
+			return util.Errorf("unexpected rows %v", rows)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestMultiRangeWatch verifies that Watch streams the changes committed
+// across ranges, in timestamp order per key, including deletions.
+func TestMultiRangeWatch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setupMultipleRanges(t, "b")
+	defer s.Stop()
+	if err := db.AdminSplit("d"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A change committed before watching is not reported.
+	if err := db.Put("a", "old"); err != nil {
+		t.Fatal(err)
+	}
+	_, since, err := db.Changes("a", "q", proto.ZeroTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"a=1", "c=2", "e=3", "c=<deleted>"}
+	errDone := util.Errorf("done")
+	changesCh := make(chan string, len(expected))
+	errCh := make(chan error, 1)
+	go func() {
+		var n int
+		errCh <- db.Watch("a", "q", since, func(changes []proto.ChangeEvent, resolved proto.Timestamp) error {
+			for _, c := range changes {
+				if resolved.Less(c.Timestamp) {
+					t.Errorf("change %+v above resolved timestamp %s", c, resolved)
+				}
+				value := "<deleted>"
+				if c.Value != nil {
+					value = string(c.Value.Bytes)
+				}
+				changesCh <- string(c.Key) + "=" + value
+				if n++; n == len(expected) {
+					return errDone
+				}
+			}
+			return nil
+		})
+	}()
+
+	// Each change is committed after the previous one was reported, so
+	// that the changes are reported in order.
+	put := func(key, value string) func() error {
+		return func() error { return db.Put(key, value) }
+	}
+	ops := []func() error{put("a", "1"), put("c", "2"), put("e", "3"), func() error { return db.Del("c") }}
+	for i, op := range ops {
+		if err := op(); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case change := <-changesCh:
+			if change != expected[i] {
+				t.Errorf("%d: expected change %s; got %s", i, expected[i], change)
+			}
+		case err := <-errCh:
+			t.Fatalf("%d: watch failed: %v", i, err)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("%d: timed out waiting for change %s", i, expected[i])
+		}
+	}
+	if err := <-errCh; err != errDone {
+		t.Fatalf("expected watch to stop with %s; got %v", errDone, err)
+	}
+}
+
+// TestOnePhaseCommitWatch verifies that Watch reports the writes of a
+// transaction committed in one phase on a single range.
+func TestOnePhaseCommitWatch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setupMultipleRanges(t, "b")
+	defer s.Stop()
+
+	_, since, err := db.Changes("a", "b", proto.ZeroTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errDone := util.Errorf("done")
+	changesCh := make(chan string, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- db.Watch("a", "b", since, func(changes []proto.ChangeEvent, _ proto.Timestamp) error {
+			if len(changes) == 0 {
+				return nil
+			}
+			changesCh <- string(changes[0].Key) + "=" + string(changes[0].Value.Bytes)
+			return errDone
+		})
+	}()
+
+	if err := db.Txn(func(txn *client.Txn) error {
+		b := &client.Batch{}
+		b.Put("a", "1")
+		return txn.Commit(b)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case change := <-changesCh:
+		if change != "a=1" {
+			t.Errorf("expected change a=1; got %s", change)
+		}
+	case err := <-errCh:
+		t.Fatalf("watch failed: %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for change a=1")
+	}
+	if err := <-errCh; err != errDone {
+		t.Fatalf("expected watch to stop with %s; got %v", errDone, err)
+	}
+}
+
 // TestMultiRangeScanInconsistent verifies that a scan across ranges
 // that doesn't require read consistency will set a timestamp using
 // the clock local to the distributed sender.
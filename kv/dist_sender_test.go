@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -146,6 +147,9 @@ func TestSendRPCOrder(t *testing.T) {
 		// Likely a test setup here will never have a read lease, but good
 		// to keep in mind.
 		consistent bool
+		// If set, the request reads at a timestamp old enough to have
+		// been closed, so that any replica may serve it.
+		stale bool
 	}{
 		// Inconsistent Scan without matching attributes.
 		{
@@ -213,6 +217,27 @@ func TestSendRPCOrder(t *testing.T) {
 			expReplica: []int32{1, 2, 3, 4, 5},
 			leader:     2,
 		},
+		// Consistent Get with matching attributes that finds the leader.
+		// Should address the leader first.
+		{
+			args:       &proto.GetRequest{},
+			attrs:      nodeAttrs[5],
+			order:      rpc.OrderStable,
+			expReplica: []int32{2, 5, 4, 0, 0},
+			leader:     2,
+			consistent: true,
+		},
+		// Consistent Get below the closed timestamp with matching
+		// attributes and a leader. Should address the nearest replicas.
+		{
+			args:       &proto.GetRequest{},
+			attrs:      nodeAttrs[5],
+			order:      rpc.OrderStable,
+			expReplica: []int32{5, 4, 0, 0, 0},
+			leader:     2,
+			consistent: true,
+			stale:      true,
+		},
 	}
 
 	descriptor := proto.RangeDescriptor{
@@ -233,7 +258,8 @@ func TestSendRPCOrder(t *testing.T) {
 	}
 
 	ctx := &DistSenderContext{
-		rpcSend: testFn,
+		ClosedTimestampLag: time.Minute,
+		rpcSend:            testFn,
 		rangeDescriptorDB: mockRangeDescriptorDB(func(proto.Key, lookupOptions) ([]proto.RangeDescriptor, error) {
 			return []proto.RangeDescriptor{descriptor}, nil
 		}),
@@ -289,6 +315,10 @@ func TestSendRPCOrder(t *testing.T) {
 		if !tc.consistent {
 			args.Header().ReadConsistency = proto.INCONSISTENT
 		}
+		if tc.stale {
+			args.Header().Timestamp = ds.clock.Now()
+			args.Header().Timestamp.WallTime -= 2 * time.Minute.Nanoseconds()
+		}
 		// Kill the cached NodeDescriptor, enforcing a lookup from Gossip.
 		ds.nodeDescriptor = nil
 		call := proto.Call{Args: args, Reply: args.CreateReply()}
@@ -456,7 +486,7 @@ func TestEvictCacheOnError(t *testing.T) {
 		if cur := ds.leaderCache.Lookup(1); reflect.DeepEqual(cur, &proto.Replica{}) && !tc.shouldClearLeader {
 			t.Errorf("%d: leader cache eviction: shouldClearLeader=%t, but value is %v", i, tc.shouldClearLeader, cur)
 		}
-		_, cachedDesc := ds.rangeCache.getCachedRangeDescriptor(call.Args.Header().Key)
+		_, cachedDesc := ds.rangeCache.getCachedRangeDescriptor(call.Args.Header().Key, false)
 		if cachedDesc == nil != tc.shouldClearReplica {
 			t.Errorf("%d: unexpected second replica lookup behaviour: wanted=%t", i, tc.shouldClearReplica)
 		}
@@ -883,3 +913,163 @@ func TestMultiRangeMergeStaleDescriptor(t *testing.T) {
 		t.Fatalf("expect get %v, actual get %v", existingKVs, reply.Rows)
 	}
 }
+
+// TestParallelMultiRangeScan verifies that range-spanning scans are sent
+// to no more ranges in parallel than configured, and that the results
+// are combined in key order and limited to the scan's bound.
+func TestParallelMultiRangeScan(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	g, s := makeTestGossip(t)
+	defer s()
+	// Ranges [a,b), [b,c), [c,d), [d,e) and [e,KeyMax).
+	bounds := []proto.Key{proto.KeyMin, proto.Key("b"), proto.Key("c"),
+		proto.Key("d"), proto.Key("e"), proto.KeyMax}
+	var descs []proto.RangeDescriptor
+	for i := 1; i < len(bounds); i++ {
+		descs = append(descs, proto.RangeDescriptor{
+			RaftID:   proto.RaftID(i),
+			StartKey: bounds[i-1],
+			EndKey:   bounds[i],
+			Replicas: []proto.Replica{{NodeID: 1, StoreID: 1}},
+		})
+	}
+	var existingKVs []proto.KeyValue
+	for _, key := range []string{"a", "a1", "b", "c", "c1", "d", "e", "e1"} {
+		existingKVs = append(existingKVs, proto.KeyValue{Key: proto.Key(key)})
+	}
+
+	var mu sync.Mutex
+	var inflight, maxInflight int
+	var lookups []proto.Key
+	var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) gogoproto.Message, getReply func() gogoproto.Message, _ *rpc.Context) ([]gogoproto.Message, error) {
+		mu.Lock()
+		inflight++
+		if inflight > maxInflight {
+			maxInflight = inflight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inflight--
+			mu.Unlock()
+		}()
+		args := getArgs(testAddress).(proto.Request)
+		header := args.Header()
+		// Have ranges with larger keys reply first.
+		time.Sleep(time.Duration('z'-header.Key[0]) * 100 * time.Microsecond)
+		var rows []proto.KeyValue
+		for _, kv := range existingKVs {
+			if !kv.Key.Less(header.Key) && kv.Key.Less(header.EndKey) {
+				rows = append(rows, kv)
+			}
+		}
+		if bound := args.(proto.Bounded).GetBound(); bound > 0 && int64(len(rows)) > bound {
+			if proto.IsReverse(args) {
+				rows = rows[int64(len(rows))-bound:]
+			} else {
+				rows = rows[:bound]
+			}
+		}
+		switch reply := getReply().(type) {
+		case *proto.ScanResponse:
+			reply.Rows = rows
+			return []gogoproto.Message{reply}, nil
+		case *proto.ReverseScanResponse:
+			for i := len(rows) - 1; i >= 0; i-- {
+				reply.Rows = append(reply.Rows, rows[i])
+			}
+			return []gogoproto.Message{reply}, nil
+		default:
+			return nil, util.Errorf("unexpected method %s", method)
+		}
+	}
+	ctx := &DistSenderContext{
+		RangeConcurrency: 2,
+		rpcSend:          testFn,
+		rangeDescriptorDB: mockRangeDescriptorDB(func(key proto.Key, options lookupOptions) ([]proto.RangeDescriptor, error) {
+			mu.Lock()
+			lookups = append(lookups, key)
+			mu.Unlock()
+			for _, desc := range descs {
+				if options.useReverseScan && desc.ContainsExclusiveEndKey(key) ||
+					!options.useReverseScan && desc.ContainsKey(key) {
+					return []proto.RangeDescriptor{desc}, nil
+				}
+			}
+			return nil, util.Errorf("no range for key %s", key)
+		}),
+	}
+	ds := NewDistSender(ctx, g)
+
+	keysOf := func(rows []proto.KeyValue) []string {
+		var keys []string
+		for _, kv := range rows {
+			keys = append(keys, string(kv.Key))
+		}
+		return keys
+	}
+	testCases := []struct {
+		reverse  bool
+		max      int64
+		expected []string
+	}{
+		{false, 0, []string{"a", "a1", "b", "c", "c1", "d", "e", "e1"}},
+		{false, 3, []string{"a", "a1", "b"}},
+		{false, 5, []string{"a", "a1", "b", "c", "c1"}},
+		{true, 0, []string{"e1", "e", "d", "c1", "c", "b", "a1", "a"}},
+		{true, 4, []string{"e1", "e", "d", "c1"}},
+	}
+	for i, test := range testCases {
+		var call proto.Call
+		if test.reverse {
+			call = proto.ReverseScanCall(proto.Key("a"), proto.Key("z"), test.max)
+		} else {
+			call = proto.ScanCall(proto.Key("a"), proto.Key("z"), test.max)
+		}
+		// Set the Txn info to avoid an OpRequiresTxnError.
+		call.Args.Header().Txn = &proto.Transaction{}
+		ds.Send(context.Background(), call)
+		if err := call.Reply.Header().GoError(); err != nil {
+			t.Fatalf("%d: scan encountered error: %s", i, err)
+		}
+		var rows []proto.KeyValue
+		if test.reverse {
+			rows = call.Reply.(*proto.ReverseScanResponse).Rows
+		} else {
+			rows = call.Reply.(*proto.ScanResponse).Rows
+		}
+		if actual := keysOf(rows); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%d: expected %v, got %v", i, test.expected, actual)
+		}
+		if bound := call.Args.(proto.Bounded).GetBound(); bound != test.max {
+			t.Errorf("%d: expected bound %d to be restored, got %d", i, test.max, bound)
+		}
+	}
+
+	// A bounded reverse scan looks up the ranges wave by wave from the end
+	// of its key range, and stops once enough rows have been gathered.
+	ds = NewDistSender(ctx, g)
+	mu.Lock()
+	lookups = nil
+	mu.Unlock()
+	call := proto.ReverseScanCall(proto.Key("a"), proto.Key("z"), 3)
+	call.Args.Header().Txn = &proto.Transaction{}
+	ds.Send(context.Background(), call)
+	if err := call.Reply.Header().GoError(); err != nil {
+		t.Fatalf("reverse scan encountered error: %s", err)
+	}
+	if actual, expected := keysOf(call.Reply.(*proto.ReverseScanResponse).Rows), []string{"e1", "e", "d"}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, key := range lookups {
+		if key.Less(proto.Key("d")) {
+			t.Errorf("unexpected lookup of key %s", key)
+		}
+	}
+	if maxInflight > 2 {
+		t.Errorf("expected at most 2 ranges to be scanned in parallel, got %d", maxInflight)
+	}
+}
@@ -75,6 +75,12 @@ func (rls *retryableLocalSender) Send(_ context.Context, call proto.Call) {
 		// reset header.Replica and engage retry loop.
 		if err = call.Reply.Header().GoError(); err != nil {
 			if _, ok := err.(*proto.RangeKeyMismatchError); ok {
+				// A batch committing a transaction in one phase is not
+				// split up; the coordinator falls back to a regular
+				// commit if it spans ranges.
+				if _, ok := call.Args.(*proto.InternalBatchRequest); ok {
+					return
+				}
 				// Clear request replica.
 				call.Args.Header().Replica = proto.Replica{}
 				log.Warning(err)
@@ -111,10 +111,13 @@ func (rdc *rangeDescriptorCache) stringLocked() string {
 // cached for subsequent lookups.
 //
 // This method returns the RangeDescriptor for the range containing
-// the key's data, or an error if any occurred.
+// the key's data, or an error if any occurred. If options.useReverseScan
+// is set, it instead returns the descriptor of the range containing the
+// keys immediately preceding the key, and the descriptors cached along
+// with it are those of the preceding ranges.
 func (rdc *rangeDescriptorCache) LookupRangeDescriptor(key proto.Key,
 	options lookupOptions) (*proto.RangeDescriptor, error) {
-	if _, r := rdc.getCachedRangeDescriptor(key); r != nil {
+	if _, r := rdc.getCachedRangeDescriptor(key, options.useReverseScan); r != nil {
 		return r, nil
 	}
 
@@ -172,7 +175,7 @@ func (rdc *rangeDescriptorCache) EvictCachedRangeDescriptor(descKey proto.Key, s
 	rdc.rangeCacheMu.Lock()
 	defer rdc.rangeCacheMu.Unlock()
 
-	rngKey, cachedDesc := rdc.getCachedRangeDescriptorLocked(descKey)
+	rngKey, cachedDesc := rdc.getCachedRangeDescriptorLocked(descKey, false)
 	// Note that we're doing a "compare-and-erase": If seenDesc is not nil,
 	// we want to clean the cache only if it equals the cached range
 	// descriptor as a pointer. If not, then likely some other caller
@@ -194,30 +197,37 @@ func (rdc *rangeDescriptorCache) EvictCachedRangeDescriptor(descKey proto.Key, s
 		// evict that key as well. This loop ends after the meta1 range, which
 		// returns KeyMin as its metadata key.
 		descKey = keys.RangeMetaKey(descKey)
-		rngKey, cachedDesc = rdc.getCachedRangeDescriptorLocked(descKey)
+		rngKey, cachedDesc = rdc.getCachedRangeDescriptorLocked(descKey, false)
 	}
 }
 
 // getCachedRangeDescriptor is a helper function to retrieve the descriptor of
-// the range which contains the given key, if present in the cache. It
-// acquires a read lock on rdc.rangeCacheMu before delegating to
-// getCachedRangeDescriptorLocked.
-func (rdc *rangeDescriptorCache) getCachedRangeDescriptor(key proto.Key) (
+// the range which contains the given key, if present in the cache. If
+// inclusive is set, it is the range which contains the keys immediately
+// preceding the given key instead. It acquires a read lock on
+// rdc.rangeCacheMu before delegating to getCachedRangeDescriptorLocked.
+func (rdc *rangeDescriptorCache) getCachedRangeDescriptor(key proto.Key, inclusive bool) (
 	rangeCacheKey, *proto.RangeDescriptor) {
 	rdc.rangeCacheMu.RLock()
 	defer rdc.rangeCacheMu.RUnlock()
-	return rdc.getCachedRangeDescriptorLocked(key)
+	return rdc.getCachedRangeDescriptorLocked(key, inclusive)
 }
 
 // getCachedRangeDescriptorLocked is a helper function to retrieve the
 // descriptor of the range which contains the given key, if present in the
-// cache. It is assumed that the caller holds a read lock on rdc.rangeCacheMu.
-func (rdc *rangeDescriptorCache) getCachedRangeDescriptorLocked(key proto.Key) (
+// cache. If inclusive is set, it is the range which contains the keys
+// immediately preceding the given key instead, i.e. the range whose end key
+// is the given key or follows it. It is assumed that the caller holds a read
+// lock on rdc.rangeCacheMu.
+func (rdc *rangeDescriptorCache) getCachedRangeDescriptorLocked(key proto.Key, inclusive bool) (
 	rangeCacheKey, *proto.RangeDescriptor) {
 	// The cache is indexed using the end-key of the range, but the
 	// end-key is non-inclusive. If inclusive is false, we access the
 	// cache using key.Next().
-	metaKey := keys.RangeMetaKey(key.Next())
+	metaKey := keys.RangeMetaKey(key)
+	if !inclusive {
+		metaKey = keys.RangeMetaKey(key.Next())
+	}
 
 	k, v, ok := rdc.rangeCache.Ceil(rangeCacheKey(metaKey))
 	if !ok {
@@ -227,7 +237,11 @@ func (rdc *rangeDescriptorCache) getCachedRangeDescriptorLocked(key proto.Key) (
 	rd := v.(*proto.RangeDescriptor)
 
 	// Check that key actually belongs to range
-	if !rd.ContainsKey(keys.KeyAddress(key)) {
+	if inclusive {
+		if !rd.ContainsExclusiveEndKey(keys.KeyAddress(key)) {
+			return nil, nil
+		}
+	} else if !rd.ContainsKey(keys.KeyAddress(key)) {
 		return nil, nil
 	}
 	return metaEndKey, rd
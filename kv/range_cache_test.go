@@ -66,6 +66,28 @@ func (db *testDescriptorDB) getDescriptor(key proto.Key) []proto.RangeDescriptor
 	return response
 }
 
+// getReverseDescriptor returns the descriptor of the range containing the
+// keys preceding key, followed by those of up to two preceding ranges.
+func (db *testDescriptorDB) getReverseDescriptor(key proto.Key) []proto.RangeDescriptor {
+	log.Infof("getReverseDescriptor: %s", key)
+	response := make([]proto.RangeDescriptor, 0, 3)
+	v := db.data.Ceil(testDescriptorNode{
+		&proto.RangeDescriptor{
+			EndKey: key,
+		},
+	})
+	for i := 0; i < 3 && v != nil; i++ {
+		desc := v.(testDescriptorNode).RangeDescriptor
+		response = append(response, *desc)
+		v = db.data.Get(testDescriptorNode{
+			&proto.RangeDescriptor{
+				EndKey: desc.StartKey,
+			},
+		})
+	}
+	return response
+}
+
 func (db *testDescriptorDB) getRangeDescriptors(key proto.Key,
 	options lookupOptions) ([]proto.RangeDescriptor, error) {
 	db.lookupCount++
@@ -76,7 +98,12 @@ func (db *testDescriptorDB) getRangeDescriptors(key proto.Key,
 	// Recursively call into cache as the real DB would, terminating recursion
 	// when a meta1key is encountered.
 	if len(metadataKey) > 0 && !bytes.HasPrefix(metadataKey, keys.Meta1Prefix) {
-		_, err = db.cache.LookupRangeDescriptor(metadataKey, options)
+		metaOptions := options
+		metaOptions.useReverseScan = false
+		_, err = db.cache.LookupRangeDescriptor(metadataKey, metaOptions)
+	}
+	if options.useReverseScan {
+		return db.getReverseDescriptor(key), err
 	}
 	return db.getDescriptor(key), err
 }
@@ -220,6 +247,44 @@ func TestRangeCache(t *testing.T) {
 
 }
 
+// TestRangeCacheReverseLookup verifies that reverse lookups return the
+// range containing the keys preceding the looked up key and cache the
+// preceding ranges, which later reverse and forward lookups find.
+func TestRangeCacheReverseLookup(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	db := newTestDescriptorDB()
+	for _, char := range "abcdefgh" {
+		db.splitRange(t, proto.Key(string(char)))
+	}
+	db.cache = newRangeDescriptorCache(db, 2<<10)
+
+	doReverseLookup := func(key string) *proto.RangeDescriptor {
+		r, err := db.cache.LookupRangeDescriptor(proto.Key(key), lookupOptions{useReverseScan: true})
+		if err != nil {
+			t.Fatalf("Unexpected error from LookupRangeDescriptor: %s", err.Error())
+		}
+		if !r.ContainsExclusiveEndKey(keys.KeyAddress(proto.Key(key))) {
+			t.Fatalf("Returned range did not contain the keys preceding key: %s-%s, %s", r.StartKey, r.EndKey, key)
+		}
+		return r
+	}
+
+	// The range ending at the looked up key is found, not the one starting
+	// at it.
+	if r := doReverseLookup("e"); !r.StartKey.Equal(proto.Key("d")) {
+		t.Errorf("expected range [d,e), got [%s,%s)", r.StartKey, r.EndKey)
+	}
+	db.assertLookupCount(t, 2, "e")
+
+	// The preceding ranges were prefetched.
+	doReverseLookup("d")
+	db.assertLookupCount(t, 0, "d")
+	doReverseLookup("cc")
+	db.assertLookupCount(t, 0, "cc")
+	doLookup(t, db.cache, "c")
+	db.assertLookupCount(t, 0, "c")
+}
+
 // TestRangeCacheClearOverlapping verifies that existing, overlapping
 // cached entries are cleared when adding a new entry.
 func TestRangeCacheClearOverlapping(t *testing.T) {
@@ -244,12 +309,12 @@ func TestRangeCacheClearOverlapping(t *testing.T) {
 	}
 	cache.clearOverlappingCachedRangeDescriptors(proto.Key("b"), keys.RangeMetaKey(proto.Key("b")), minToBDesc)
 	cache.rangeCache.Add(rangeCacheKey(keys.RangeMetaKey(proto.Key("b"))), minToBDesc)
-	if _, desc := cache.getCachedRangeDescriptor(proto.Key("b")); desc != nil {
+	if _, desc := cache.getCachedRangeDescriptor(proto.Key("b"), false); desc != nil {
 		t.Errorf("descriptor unexpectedly non-nil: %s", desc)
 	}
 	cache.clearOverlappingCachedRangeDescriptors(proto.KeyMax, keys.RangeMetaKey(proto.KeyMax), bToMaxDesc)
 	cache.rangeCache.Add(rangeCacheKey(keys.RangeMetaKey(proto.KeyMax)), bToMaxDesc)
-	if _, desc := cache.getCachedRangeDescriptor(proto.Key("b")); desc != bToMaxDesc {
+	if _, desc := cache.getCachedRangeDescriptor(proto.Key("b"), false); desc != bToMaxDesc {
 		t.Errorf("expected descriptor %s; got %s", bToMaxDesc, desc)
 	}
 
@@ -257,7 +322,7 @@ func TestRangeCacheClearOverlapping(t *testing.T) {
 	cache.clearOverlappingCachedRangeDescriptors(proto.KeyMax, keys.RangeMetaKey(proto.KeyMax), defDesc)
 	cache.rangeCache.Add(rangeCacheKey(keys.RangeMetaKey(proto.KeyMax)), defDesc)
 	for _, key := range []proto.Key{proto.Key("a"), proto.Key("b")} {
-		if _, desc := cache.getCachedRangeDescriptor(key); desc != defDesc {
+		if _, desc := cache.getCachedRangeDescriptor(key, false); desc != defDesc {
 			t.Errorf("expected descriptor %s for key %s; got %s", defDesc, key, desc)
 		}
 	}
@@ -397,13 +397,22 @@ func (tc *TxnCoordSender) Send(ctx context.Context, call proto.Call) {
 func (tc *TxnCoordSender) maybeBeginTxn(header *proto.RequestHeader) {
 	if header.Txn != nil {
 		if len(header.Txn.ID) == 0 {
+			// A request which carries a timestamp (e.g. a read at a given
+			// timestamp which was wrapped in a transaction because it
+			// spans ranges) begins the transaction at that timestamp.
+			timestamp := header.Timestamp
+			if timestamp.Equal(proto.ZeroTimestamp) {
+				timestamp = tc.clock.Now()
+			}
 			newTxn := proto.NewTransaction(header.Txn.Name, keys.KeyAddress(header.Key), header.GetUserPriority(),
-				header.Txn.Isolation, tc.clock.Now(), tc.clock.MaxOffset().Nanoseconds())
+				header.Txn.Isolation, timestamp, tc.clock.MaxOffset().Nanoseconds())
 			// Use existing priority as a minimum. This is used on transaction
 			// aborts to ratchet priority when creating successor transaction.
 			if newTxn.Priority < header.Txn.Priority {
 				newTxn.Priority = header.Txn.Priority
 			}
+			// The client numbers its writes from the start.
+			newTxn.Sequence = header.Txn.Sequence
 			header.Txn = newTxn
 		}
 	}
@@ -491,7 +500,17 @@ func (tc *TxnCoordSender) sendOne(ctx context.Context, call proto.Call) {
 						return
 					}
 				}
-				txnMeta.addKeyRange(header.Key, header.EndKey)
+				start, end := header.Key, header.EndKey
+				switch call.Args.(type) {
+				case *proto.ReapQueueRequest, *proto.EnqueueMessageRequest:
+					// The intents are on the message keys of the inbox.
+					start = keys.QueueMessagePrefix(header.Key)
+					end = start.PrefixEnd()
+				case *proto.EnqueueUpdateRequest:
+					start = keys.QueueUpdatePrefix(header.Key)
+					end = start.PrefixEnd()
+				}
+				txnMeta.addKeyRange(start, end)
 			}
 			// Update our record of this transaction.
 			if txnMeta != nil {
@@ -571,6 +590,113 @@ func (tc *TxnCoordSender) sendOne(ctx context.Context, call proto.Call) {
 	}
 }
 
+// maybeSendOnePhase attempts to commit a transaction which has not
+// yet written any intents in a single round trip. This is possible if
+// the batch consists of point writes followed by a committing
+// EndTransaction and all of its keys are located in the same range.
+// The batch is then sent as a whole to that range, which applies the
+// writes atomically without intents or a transaction record. Returns
+// false if the batch must be sent the regular way, either because it
+// isn't eligible or because the range could not commit it in one
+// phase.
+func (tc *TxnCoordSender) maybeSendOnePhase(ctx context.Context, batchArgs *proto.InternalBatchRequest, batchReply *proto.InternalBatchResponse) bool {
+	txn := batchArgs.Txn
+	last := len(batchArgs.Requests) - 1
+	if txn == nil || last < 1 || len(batchReply.Responses) > 0 {
+		return false
+	}
+	if etArgs, ok := batchArgs.Requests[last].GetValue().(*proto.EndTransactionRequest); !ok ||
+		!etArgs.Commit || etArgs.InternalCommitTrigger != nil {
+		return false
+	}
+	tc.Lock()
+	_, ok := tc.txns[string(txn.ID)]
+	tc.Unlock()
+	if ok {
+		// The transaction has already laid down intents.
+		return false
+	}
+
+	var key, endKey proto.Key
+	written := map[string]struct{}{}
+	for i := range batchArgs.Requests {
+		args := batchArgs.Requests[i].GetValue().(proto.Request)
+		header := args.Header()
+		// Leave it to the regular path to reject inconsistent calls.
+		if (header.User != "" && header.User != batchArgs.User) ||
+			(header.UserPriority != nil && header.GetUserPriority() != batchArgs.GetUserPriority()) ||
+			(header.Txn != nil && !header.Txn.Equal(txn)) {
+			return false
+		}
+		if i == last {
+			break
+		}
+		switch args.(type) {
+		case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.IncrementRequest, *proto.DeleteRequest:
+		default:
+			return false
+		}
+		if _, ok := written[string(header.Key)]; ok {
+			return false
+		}
+		written[string(header.Key)] = struct{}{}
+		addr := keys.KeyAddress(header.Key)
+		if key == nil || addr.Less(key) {
+			key = addr
+		}
+		if endKey == nil || !addr.Less(endKey) {
+			endKey = addr.Next()
+		}
+	}
+
+	trace := tracer.FromCtx(ctx)
+	header := batchArgs.RequestHeader
+	header.Key, header.EndKey = key, endKey
+	header.Timestamp = txn.Timestamp
+	// The batch keeps its ClientCmdID so that the response cache makes
+	// retries of the one-phase commit idempotent; the regular path sends
+	// the constituent calls under their own IDs.
+	call := proto.Call{
+		Args:  &proto.InternalBatchRequest{RequestHeader: header, Requests: batchArgs.Requests},
+		Reply: &proto.InternalBatchResponse{},
+	}
+	startNS := tc.clock.PhysicalNow()
+	tc.wrapped.Send(ctx, call)
+	reply := call.Reply.(*proto.InternalBatchResponse)
+
+	switch reply.GoError().(type) {
+	case nil:
+	case *proto.OpRequiresTxnError, *proto.RangeKeyMismatchError, *proto.TransactionRetryError,
+		*proto.WriteTooOldError, *proto.WriteIntentError:
+		// The keys span ranges, or the writes conflict with others; the
+		// regular commit handles these cases.
+		trace.Event("one-phase commit not possible")
+		return false
+	default:
+		batchReply.Txn = gogoproto.Clone(txn).(*proto.Transaction)
+		batchReply.Error = reply.Error
+		return true
+	}
+	trace.Event("committed in one phase")
+	batchReply.Txn = reply.Txn
+	batchReply.Responses = reply.Responses
+
+	tc.Lock()
+	tc.txnStats.durations = append(tc.txnStats.durations, float64(tc.clock.PhysicalNow()-txn.OrigTimestamp.WallTime))
+	tc.txnStats.restarts = append(tc.txnStats.restarts, float64(reply.Txn.Epoch))
+	tc.txnStats.committed++
+	tc.Unlock()
+
+	// See sendOne for the linearizability guarantee on commit.
+	if tsNS := reply.Txn.Timestamp.WallTime; startNS > tsNS {
+		startNS = tsNS
+	}
+	if sleepNS := tc.clock.MaxOffset() - time.Duration(tc.clock.PhysicalNow()-startNS); tc.linearizable && sleepNS > 0 {
+		time.Sleep(sleepNS)
+	}
+	return true
+}
+
 // sendBatch unrolls a batched command and sends each constituent
 // command in parallel.
 // TODO(tschottdorf): modify sendBatch so that it sends truly parallel requests
@@ -581,6 +707,9 @@ func (tc *TxnCoordSender) sendBatch(ctx context.Context, batchArgs *proto.Intern
 	// pre-initialized with replies, use those; otherwise create replies
 	// as needed.
 	// TODO(spencer): send calls in parallel.
+	if tc.maybeSendOnePhase(ctx, batchArgs, batchReply) {
+		return
+	}
 	batchReply.Txn = batchArgs.Txn
 	for i := range batchArgs.Requests {
 		args := batchArgs.Requests[i].GetValue().(proto.Request)
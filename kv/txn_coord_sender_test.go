@@ -29,6 +29,7 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/storage/engine"
@@ -216,6 +217,42 @@ func TestTxnCoordSenderBeginTransactionMinPriority(t *testing.T) {
 	}
 }
 
+// TestTxnCoordSenderBeginTransactionTimestamp verifies that a new
+// transaction begins at the timestamp of the command which starts it,
+// if one is set.
+func TestTxnCoordSenderBeginTransactionTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := createTestDB(t)
+	defer s.Stop()
+	defer teardownHeartbeats(s.Sender)
+
+	s.Manual.Set(10)
+	timestamp := makeTS(5, 1)
+	reply := &proto.GetResponse{}
+	s.Sender.Send(context.Background(), proto.Call{
+		Args: &proto.GetRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       proto.Key("key"),
+				User:      security.RootUser,
+				Timestamp: timestamp,
+				Txn: &proto.Transaction{
+					Name: "test txn",
+				},
+			},
+		},
+		Reply: reply,
+	})
+	if reply.Error != nil {
+		t.Fatal(reply.GoError())
+	}
+	if !reply.Txn.OrigTimestamp.Equal(timestamp) {
+		t.Errorf("expected txn to begin at %s; got %s", timestamp, reply.Txn.OrigTimestamp)
+	}
+	if !reply.Txn.Timestamp.Equal(timestamp) {
+		t.Errorf("expected txn timestamp %s; got %s", timestamp, reply.Txn.Timestamp)
+	}
+}
+
 // TestTxnCoordSenderKeyRanges verifies that multiple requests to same or
 // overlapping key ranges causes the coordinator to keep track only of
 // the minimum number of ranges.
@@ -413,6 +450,67 @@ func TestTxnCoordSenderEndTxn(t *testing.T) {
 	verifyCleanup(key, s.Sender, s.Eng, t)
 }
 
+// TestTxnCoordSenderOnePhaseCommit verifies that a transaction whose
+// writes and commit arrive in a single batch for a single range is
+// committed without a transaction record or intents, and that other
+// batches fall back to a regular commit.
+func TestTxnCoordSenderOnePhaseCommit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := createTestDB(t)
+	defer s.Stop()
+	if err := s.DB.AdminSplit("m"); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		keys     []string
+		onePhase bool
+	}{
+		{[]string{"a", "b"}, true},
+		{[]string{"c"}, true},
+		{[]string{"d", "d"}, false}, // key written twice
+		{[]string{"e", "n"}, false}, // keys span ranges
+	}
+	for i, test := range testCases {
+		txn := newTxn(s.Clock, proto.Key(test.keys[0]))
+		bArgs := &proto.InternalBatchRequest{}
+		bArgs.Txn = txn
+		for _, key := range test.keys {
+			bArgs.Add(createPutRequest(proto.Key(key), []byte("value"), txn))
+		}
+		bArgs.Add(&proto.EndTransactionRequest{Commit: true})
+		bReply := &proto.InternalBatchResponse{}
+		if err := sendCall(s.Sender, proto.Call{Args: bArgs, Reply: bReply}); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if bReply.Txn == nil || bReply.Txn.Status != proto.COMMITTED {
+			t.Errorf("%d: expected committed transaction; got %s", i, bReply.Txn)
+		}
+		if l := len(bReply.Responses); l != len(bArgs.Requests) {
+			t.Errorf("%d: expected %d responses; got %d", i, len(bArgs.Requests), l)
+		}
+		for _, key := range test.keys {
+			verifyCleanup(proto.Key(key), s.Sender, s.Eng, t)
+			val, _, err := engine.MVCCGet(s.Eng, proto.Key(key), s.Clock.Now(), true, nil)
+			if err != nil {
+				t.Fatalf("%d: %s", i, err)
+			}
+			if val == nil || !bytes.Equal(val.Bytes, []byte("value")) {
+				t.Errorf("%d: expected value at %q; got %v", i, key, val)
+			}
+		}
+		// Only a regular commit persists the transaction record.
+		ok, err := engine.MVCCGetProto(s.Eng, keys.TransactionKey(txn.Key, txn.ID),
+			proto.ZeroTimestamp, true, nil, &proto.Transaction{})
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if ok == test.onePhase {
+			t.Errorf("%d: expected one-phase commit %t; found transaction record: %t", i, test.onePhase, ok)
+		}
+	}
+}
+
 // TestTxnCoordSenderCleanupOnAborted verifies that if a txn receives a
 // TransactionAbortedError, the coordinator cleans up the transaction.
 func TestTxnCoordSenderCleanupOnAborted(t *testing.T) {
@@ -20,6 +20,7 @@ package proto
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 
 	"github.com/cockroachdb/cockroach/util/retry"
 	gogoproto "github.com/gogo/protobuf/proto"
@@ -51,6 +52,7 @@ const (
 	isWrite
 	isTxnWrite
 	isRange
+	isReverse
 )
 
 // IsAdmin returns true if the request requires admin permissions.
@@ -86,6 +88,12 @@ func IsRange(args Request) bool {
 	return (args.flags() & isRange) != 0
 }
 
+// IsReverse returns true if the operation is range-based and visits
+// the range in descending key order.
+func IsReverse(args Request) bool {
+	return (args.flags() & isReverse) != 0
+}
+
 // Request is an interface for RPC requests.
 type Request interface {
 	gogoproto.Message
@@ -172,6 +180,37 @@ func (sr *ScanResponse) Combine(c Response) {
 	}
 }
 
+// Combine implements the Combinable interface for ReverseScanResponse.
+// Ranges are visited in descending key order, so the rows of c follow
+// those already gathered.
+func (sr *ReverseScanResponse) Combine(c Response) {
+	otherSR := c.(*ReverseScanResponse)
+	if sr != nil {
+		sr.Rows = append(sr.Rows, otherSR.GetRows()...)
+		sr.Header().Combine(otherSR.Header())
+	}
+}
+
+// Combine implements the Combinable interface for ChangesResponse.
+// The combined resolved timestamp is the lesser of the two; events
+// above it are dropped, as they will be returned again by a request
+// starting at the combined resolved timestamp.
+func (cr *ChangesResponse) Combine(c Response) {
+	otherCR := c.(*ChangesResponse)
+	if cr != nil {
+		cr.Resolved.Backward(otherCR.Resolved)
+		events := append(cr.Events, otherCR.GetEvents()...)
+		cr.Events = events[:0]
+		for _, e := range events {
+			if !cr.Resolved.Less(e.Timestamp) {
+				cr.Events = append(cr.Events, e)
+			}
+		}
+		sort.Sort(ChangeEvents(cr.Events))
+		cr.Header().Combine(otherCR.Header())
+	}
+}
+
 // Combine implements the Combinable interface for DeleteRangeResponse.
 func (dr *DeleteRangeResponse) Combine(c Response) {
 	otherDR := c.(*DeleteRangeResponse)
@@ -271,6 +310,54 @@ func (sr *ScanResponse) Verify(req Request) error {
 	return nil
 }
 
+// Verify verifies the integrity of every value returned in the
+// reverse scan.
+func (sr *ReverseScanResponse) Verify(req Request) error {
+	for _, kv := range sr.Rows {
+		if err := kv.Value.Verify(kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify verifies the integrity of every message reaped from the
+// queue.
+func (rr *ReapQueueResponse) Verify(req Request) error {
+	for _, kv := range rr.Messages {
+		if err := kv.Value.Verify(kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify verifies the integrity of every value changed.
+func (cr *ChangesResponse) Verify(req Request) error {
+	for _, e := range cr.Events {
+		if e.Value == nil {
+			continue
+		}
+		if err := e.Value.Verify(e.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChangeEvents implements sort.Interface, ordering events by
+// timestamp and then by key.
+type ChangeEvents []ChangeEvent
+
+func (ce ChangeEvents) Len() int      { return len(ce) }
+func (ce ChangeEvents) Swap(i, j int) { ce[i], ce[j] = ce[j], ce[i] }
+func (ce ChangeEvents) Less(i, j int) bool {
+	if !ce[i].Timestamp.Equal(ce[j].Timestamp) {
+		return ce[i].Timestamp.Less(ce[j].Timestamp)
+	}
+	return ce[i].Key.Less(ce[j].Key)
+}
+
 // Add adds a request to the batch request. The batch inherits
 // the key range of the first request added to it.
 //
@@ -316,10 +403,22 @@ func (sr *ScanRequest) SetBound(bound int64) {
 	sr.MaxResults = bound
 }
 
+// GetBound returns the MaxResults field in ReverseScanRequest.
+func (sr *ReverseScanRequest) GetBound() int64 {
+	return sr.GetMaxResults()
+}
+
+// SetBound sets the MaxResults field in ReverseScanRequest.
+func (sr *ReverseScanRequest) SetBound(bound int64) {
+	sr.MaxResults = bound
+}
+
 // Countable is implemented by response types which have a number of
 // result rows, such as Scan.
 type Countable interface {
 	Count() int64
+	// Truncate discards all result rows beyond the first count.
+	Truncate(count int64)
 }
 
 // Count returns the number of rows in ScanResponse.
@@ -327,6 +426,26 @@ func (sr *ScanResponse) Count() int64 {
 	return int64(len(sr.Rows))
 }
 
+// Truncate discards the rows in ScanResponse beyond the first count.
+func (sr *ScanResponse) Truncate(count int64) {
+	if count < int64(len(sr.Rows)) {
+		sr.Rows = sr.Rows[:count]
+	}
+}
+
+// Count returns the number of rows in ReverseScanResponse.
+func (sr *ReverseScanResponse) Count() int64 {
+	return int64(len(sr.Rows))
+}
+
+// Truncate discards the rows in ReverseScanResponse beyond the first
+// count.
+func (sr *ReverseScanResponse) Truncate(count int64) {
+	if count < int64(len(sr.Rows)) {
+		sr.Rows = sr.Rows[:count]
+	}
+}
+
 // Method implements the Request interface.
 func (*GetRequest) Method() Method { return Get }
 
@@ -348,9 +467,24 @@ func (*DeleteRangeRequest) Method() Method { return DeleteRange }
 // Method implements the Request interface.
 func (*ScanRequest) Method() Method { return Scan }
 
+// Method implements the Request interface.
+func (*ReverseScanRequest) Method() Method { return ReverseScan }
+
 // Method implements the Request interface.
 func (*EndTransactionRequest) Method() Method { return EndTransaction }
 
+// Method implements the Request interface.
+func (*ReapQueueRequest) Method() Method { return ReapQueue }
+
+// Method implements the Request interface.
+func (*EnqueueUpdateRequest) Method() Method { return EnqueueUpdate }
+
+// Method implements the Request interface.
+func (*EnqueueMessageRequest) Method() Method { return EnqueueMessage }
+
+// Method implements the Request interface.
+func (*ChangesRequest) Method() Method { return Changes }
+
 // Method implements the Request interface.
 func (*BatchRequest) Method() Method { return Batch }
 
@@ -369,6 +503,9 @@ func (*InternalGCRequest) Method() Method { return InternalGC }
 // Method implements the Request interface.
 func (*InternalPushTxnRequest) Method() Method { return InternalPushTxn }
 
+// Method implements the Request interface.
+func (*InternalQueryTxnRequest) Method() Method { return InternalQueryTxn }
+
 // Method implements the Request interface.
 func (*InternalRangeLookupRequest) Method() Method { return InternalRangeLookup }
 
@@ -411,9 +548,24 @@ func (*DeleteRangeRequest) CreateReply() Response { return &DeleteRangeResponse{
 // CreateReply implements the Request interface.
 func (*ScanRequest) CreateReply() Response { return &ScanResponse{} }
 
+// CreateReply implements the Request interface.
+func (*ReverseScanRequest) CreateReply() Response { return &ReverseScanResponse{} }
+
 // CreateReply implements the Request interface.
 func (*EndTransactionRequest) CreateReply() Response { return &EndTransactionResponse{} }
 
+// CreateReply implements the Request interface.
+func (*ReapQueueRequest) CreateReply() Response { return &ReapQueueResponse{} }
+
+// CreateReply implements the Request interface.
+func (*EnqueueUpdateRequest) CreateReply() Response { return &EnqueueUpdateResponse{} }
+
+// CreateReply implements the Request interface.
+func (*EnqueueMessageRequest) CreateReply() Response { return &EnqueueMessageResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ChangesRequest) CreateReply() Response { return &ChangesResponse{} }
+
 // CreateReply implements the Request interface.
 func (*BatchRequest) CreateReply() Response { return &BatchResponse{} }
 
@@ -432,6 +584,9 @@ func (*InternalGCRequest) CreateReply() Response { return &InternalGCResponse{}
 // CreateReply implements the Request interface.
 func (*InternalPushTxnRequest) CreateReply() Response { return &InternalPushTxnResponse{} }
 
+// CreateReply implements the Request interface.
+func (*InternalQueryTxnRequest) CreateReply() Response { return &InternalQueryTxnResponse{} }
+
 // CreateReply implements the Request interface.
 func (*InternalRangeLookupRequest) CreateReply() Response { return &InternalRangeLookupResponse{} }
 
@@ -462,17 +617,23 @@ func (*IncrementRequest) flags() int                  { return isRead | isWrite
 func (*DeleteRequest) flags() int                     { return isWrite | isTxnWrite }
 func (*DeleteRangeRequest) flags() int                { return isWrite | isTxnWrite | isRange }
 func (*ScanRequest) flags() int                       { return isRead | isRange }
+func (*ReverseScanRequest) flags() int                { return isRead | isRange | isReverse }
 func (*EndTransactionRequest) flags() int             { return isWrite }
+func (*ReapQueueRequest) flags() int                  { return isRead | isWrite | isTxnWrite }
+func (*EnqueueUpdateRequest) flags() int              { return isWrite | isTxnWrite }
+func (*EnqueueMessageRequest) flags() int             { return isWrite | isTxnWrite }
+func (*ChangesRequest) flags() int                    { return isRead | isRange }
 func (*BatchRequest) flags() int                      { return isWrite }
 func (*AdminSplitRequest) flags() int                 { return isAdmin }
 func (*AdminMergeRequest) flags() int                 { return isAdmin }
 func (*InternalHeartbeatTxnRequest) flags() int       { return isWrite }
 func (*InternalGCRequest) flags() int                 { return isWrite | isRange }
 func (*InternalPushTxnRequest) flags() int            { return isWrite }
+func (*InternalQueryTxnRequest) flags() int           { return isRead }
 func (*InternalRangeLookupRequest) flags() int        { return isRead }
 func (*InternalResolveIntentRequest) flags() int      { return isWrite }
 func (*InternalResolveIntentRangeRequest) flags() int { return isWrite | isRange }
 func (*InternalMergeRequest) flags() int              { return isWrite }
 func (*InternalTruncateLogRequest) flags() int        { return isWrite }
 func (*InternalLeaderLeaseRequest) flags() int        { return isWrite }
-func (*InternalBatchRequest) flags() int              { return isWrite }
+func (*InternalBatchRequest) flags() int              { return isWrite | isRange }
@@ -74,7 +74,7 @@ func (i YY) Run() {
 }
 
 // TestCombinable tests the correct behaviour of some types that implement
-// the Combinable interface, notably {Scan,DeleteRange}Response and
+// the Combinable interface, notably {Scan,ReverseScan,DeleteRange}Response and
 // ResponseHeader.
 func TestCombinable(t *testing.T) {
 	// Test that GetResponse doesn't have anything to do with Combinable.
@@ -113,6 +113,30 @@ func TestCombinable(t *testing.T) {
 		t.Errorf("wanted %v, got %v", wantedSR, sr1)
 	}
 
+	rsr1 := &ReverseScanResponse{
+		ResponseHeader: ResponseHeader{Timestamp: MinTimestamp},
+		Rows: []KeyValue{
+			{Key: Key("B"), Value: Value{Bytes: []byte("W")}},
+		},
+	}
+	if _, ok := interface{}(rsr1).(Combinable); !ok {
+		t.Fatalf("ReverseScanResponse does not implement Combinable")
+	}
+	rsr2 := &ReverseScanResponse{
+		ResponseHeader: ResponseHeader{Timestamp: MaxTimestamp},
+		Rows: []KeyValue{
+			{Key: Key("A"), Value: Value{Bytes: []byte("V")}},
+		},
+	}
+	wantedRSR := &ReverseScanResponse{
+		ResponseHeader: ResponseHeader{Timestamp: MaxTimestamp},
+		Rows:           append(append([]KeyValue(nil), rsr1.Rows...), rsr2.Rows...),
+	}
+	rsr1.Combine(rsr2)
+	if !reflect.DeepEqual(rsr1, wantedRSR) {
+		t.Errorf("wanted %v, got %v", wantedRSR, rsr1)
+	}
+
 	dr1 := &DeleteRangeResponse{
 		ResponseHeader: ResponseHeader{Timestamp: Timestamp{Logical: 100}},
 		NumDeleted:     5,
@@ -140,6 +164,41 @@ func TestCombinable(t *testing.T) {
 	}
 }
 
+// TestChangesResponseCombine verifies that combining ChangesResponses
+// yields the lesser resolved timestamp and drops the changes above it.
+func TestChangesResponseCombine(t *testing.T) {
+	ts := func(logical int32) Timestamp { return Timestamp{Logical: logical} }
+	cr1 := &ChangesResponse{
+		Events: []ChangeEvent{
+			{Key: Key("a"), Timestamp: ts(2)},
+			{Key: Key("b"), Timestamp: ts(5)},
+		},
+		Resolved: ts(5),
+	}
+	if _, ok := interface{}(cr1).(Combinable); !ok {
+		t.Fatalf("ChangesResponse does not implement Combinable")
+	}
+	cr2 := &ChangesResponse{
+		Events: []ChangeEvent{
+			{Key: Key("c"), Timestamp: ts(1)},
+			{Key: Key("d"), Timestamp: ts(3)},
+		},
+		Resolved: ts(4),
+	}
+	wantedCR := &ChangesResponse{
+		Events: []ChangeEvent{
+			{Key: Key("c"), Timestamp: ts(1)},
+			{Key: Key("a"), Timestamp: ts(2)},
+			{Key: Key("d"), Timestamp: ts(3)},
+		},
+		Resolved: ts(4),
+	}
+	cr1.Combine(cr2)
+	if !reflect.DeepEqual(cr1, wantedCR) {
+		t.Errorf("wanted %v, got %v", wantedCR, cr1)
+	}
+}
+
 func TestSetGoErrorCopy(t *testing.T) {
 	rh := ResponseHeader{}
 	err := &Error{Message: "test123"}
@@ -162,3 +162,76 @@ func ScanCall(key, endKey Key, maxResults int64) Call {
 		Reply: &ScanResponse{},
 	}
 }
+
+// ReverseScanCall returns a Call object initialized to scan from end
+// to start keys, in descending key order, with max results.
+func ReverseScanCall(key, endKey Key, maxResults int64) Call {
+	return Call{
+		Args: &ReverseScanRequest{
+			RequestHeader: RequestHeader{
+				Key:    key,
+				EndKey: endKey,
+			},
+			MaxResults: maxResults,
+		},
+		Reply: &ReverseScanResponse{},
+	}
+}
+
+// ChangesCall returns a Call object initialized to fetch the changes
+// committed to keys in [key, endKey) after the since timestamp.
+func ChangesCall(key, endKey Key, since Timestamp) Call {
+	return Call{
+		Args: &ChangesRequest{
+			RequestHeader: RequestHeader{
+				Key:    key,
+				EndKey: endKey,
+			},
+			Since: since,
+		},
+		Reply: &ChangesResponse{},
+	}
+}
+
+// ReapQueueCall returns a Call object initialized to reap up to
+// maxResults messages from the queue of the specified inbox.
+func ReapQueueCall(inbox Key, maxResults int64) Call {
+	return Call{
+		Args: &ReapQueueRequest{
+			RequestHeader: RequestHeader{
+				Key: inbox,
+			},
+			MaxResults: maxResults,
+		},
+		Reply: &ReapQueueResponse{},
+	}
+}
+
+// EnqueueMessageCall returns a Call object initialized to enqueue msg
+// into the queue of the specified inbox.
+func EnqueueMessageCall(inbox Key, msg Value) Call {
+	return Call{
+		Args: &EnqueueMessageRequest{
+			RequestHeader: RequestHeader{
+				Key: inbox,
+			},
+			Msg: msg,
+		},
+		Reply: &EnqueueMessageResponse{},
+	}
+}
+
+// EnqueueUpdateCall returns a Call object initialized to enqueue the
+// requests of update for eventual execution. The update is stored with
+// the range containing its first key.
+func EnqueueUpdateCall(update BatchRequest) Call {
+	return Call{
+		Args: &EnqueueUpdateRequest{
+			RequestHeader: RequestHeader{
+				Key: update.Key,
+			},
+			Update: update,
+		},
+		Reply: &EnqueueUpdateResponse{},
+	}
+}
@@ -115,6 +115,15 @@ func (r *RangeDescriptor) ContainsKey(key []byte) bool {
 	return bytes.Compare(key, r.StartKey) >= 0 && bytes.Compare(key, r.EndKey) < 0
 }
 
+// ContainsExclusiveEndKey returns whether this RangeDescriptor contains
+// the keys immediately preceding the specified key, i.e. whether the key
+// is greater than the start key and at most the end key. It is the
+// counterpart of ContainsKey for requests which visit ranges in reverse,
+// such as a reverse scan ending at the key.
+func (r *RangeDescriptor) ContainsExclusiveEndKey(key []byte) bool {
+	return bytes.Compare(key, r.StartKey) > 0 && bytes.Compare(key, r.EndKey) <= 0
+}
+
 // ContainsKeyRange returns whether this RangeDescriptor contains the specified
 // key range from start (inclusive) to end (exclusive).
 func (r *RangeDescriptor) ContainsKeyRange(start, end []byte) bool {
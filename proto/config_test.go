@@ -125,6 +125,21 @@ func TestRangeDescriptorContains(t *testing.T) {
 			t.Errorf("%d: expected key %q within range", i, test.start)
 		}
 	}
+
+	for i, test := range []struct {
+		key      []byte
+		contains bool
+	}{
+		{[]byte("`"), false},
+		{[]byte("a"), false},
+		{[]byte("aa"), true},
+		{[]byte("b"), true},
+		{[]byte("bb"), false},
+	} {
+		if desc.ContainsExclusiveEndKey(test.key) != test.contains {
+			t.Errorf("%d: expected keys preceding %q within range: %t", i, test.key, test.contains)
+		}
+	}
 }
 
 func TestPermConfig(t *testing.T) {
@@ -501,6 +501,9 @@ func (t *Transaction) Update(o *Transaction) {
 	if t.Epoch < o.Epoch {
 		t.Epoch = o.Epoch
 	}
+	if t.Sequence < o.Sequence {
+		t.Sequence = o.Sequence
+	}
 	if t.Timestamp.Less(o.Timestamp) {
 		t.Timestamp = o.Timestamp
 	}
@@ -185,8 +185,12 @@ type Value struct {
 	// Tag is an optional string value which can be used to add additional
 	// metadata to this value. For example, Tag might provide information on how
 	// the bytes in the "bytes" field should be interpreted.
-	Tag              *string `protobuf:"bytes,5,opt,name=tag" json:"tag,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Tag *string `protobuf:"bytes,5,opt,name=tag" json:"tag,omitempty"`
+	// Expiration, if set, is the timestamp at and after which the value
+	// is no longer visible to reads. Expired values are removed by
+	// garbage collection.
+	Expiration       *Timestamp `protobuf:"bytes,6,opt,name=expiration" json:"expiration,omitempty"`
+	XXX_unrecognized []byte     `json:"-"`
 }
 
 func (m *Value) Reset()         { *m = Value{} }
@@ -221,6 +225,13 @@ func (m *Value) GetTag() string {
 	return ""
 }
 
+func (m *Value) GetExpiration() *Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // KeyValue is a pair of Key and Value for returned Key/Value pairs
 // from ScanRequest/ScanResponse. It embeds a Key and a Value.
 type KeyValue struct {
@@ -468,8 +479,12 @@ type Transaction struct {
 	// Bits of this mechanism are found in the local sender, the range and the
 	// txn_coord_sender, with brief comments referring here.
 	// See https://github.com/cockroachdb/cockroach/pull/221.
-	CertainNodes     NodeList `protobuf:"bytes,12,opt,name=certain_nodes" json:"certain_nodes"`
-	XXX_unrecognized []byte   `json:"-"`
+	CertainNodes NodeList `protobuf:"bytes,12,opt,name=certain_nodes" json:"certain_nodes"`
+	// Incremented by the client for each batch of writes. Intents record
+	// the sequence number of the write which laid them down, so that a
+	// rollback to a savepoint can remove those written after it.
+	Sequence         int32  `protobuf:"varint,13,opt,name=sequence" json:"sequence"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -552,6 +567,13 @@ func (m *Transaction) GetCertainNodes() NodeList {
 	return NodeList{}
 }
 
+func (m *Transaction) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 // Lease contains information about leader leases including the
 // expiration and lease holder.
 type Lease struct {
@@ -609,7 +631,11 @@ type GCMetadata struct {
 	// The oldest unresolved write intent in nanoseconds since epoch.
 	// Null if there are no unresolved write intents.
 	OldestIntentNanos *int64 `protobuf:"varint,2,opt,name=oldest_intent_nanos" json:"oldest_intent_nanos,omitempty"`
-	XXX_unrecognized  []byte `json:"-"`
+	// The earliest expiration, in nanoseconds since epoch, of a value
+	// which had not yet expired at the last scan or which was written
+	// since. Null if no expiring values are known.
+	NextExpirationNanos *int64 `protobuf:"varint,3,opt,name=next_expiration_nanos" json:"next_expiration_nanos,omitempty"`
+	XXX_unrecognized    []byte `json:"-"`
 }
 
 func (m *GCMetadata) Reset()         { *m = GCMetadata{} }
@@ -630,6 +656,13 @@ func (m *GCMetadata) GetOldestIntentNanos() int64 {
 	return 0
 }
 
+func (m *GCMetadata) GetNextExpirationNanos() int64 {
+	if m != nil && m.NextExpirationNanos != nil {
+		return *m.NextExpirationNanos
+	}
+	return 0
+}
+
 func init() {
 	proto1.RegisterEnum("cockroach.proto.ReplicaChangeType", ReplicaChangeType_name, ReplicaChangeType_value)
 	proto1.RegisterEnum("cockroach.proto.IsolationType", IsolationType_name, IsolationType_value)
@@ -813,6 +846,33 @@ func (m *Value) Unmarshal(data []byte) error {
 			s := string(data[iNdEx:postIndex])
 			m.Tag = &s
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1902,6 +1962,21 @@ func (m *Transaction) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sequence |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			var sizeOfWire int
 			for {
@@ -2172,6 +2247,23 @@ func (m *GCMetadata) Unmarshal(data []byte) error {
 				}
 			}
 			m.OldestIntentNanos = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExpirationNanos", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NextExpirationNanos = &v
 		default:
 			var sizeOfWire int
 			for {
@@ -2309,6 +2401,10 @@ func (m *Value) Size() (n int) {
 		l = len(*m.Tag)
 		n += 1 + l + sovData(uint64(l))
 	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2475,6 +2571,7 @@ func (m *Transaction) Size() (n int) {
 	n += 1 + l + sovData(uint64(l))
 	l = m.CertainNodes.Size()
 	n += 1 + l + sovData(uint64(l))
+	n += 1 + sovData(uint64(m.Sequence))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2517,6 +2614,9 @@ func (m *GCMetadata) Size() (n int) {
 	if m.OldestIntentNanos != nil {
 		n += 1 + sovData(uint64(*m.OldestIntentNanos))
 	}
+	if m.NextExpirationNanos != nil {
+		n += 1 + sovData(uint64(*m.NextExpirationNanos))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2605,6 +2705,16 @@ func (m *Value) MarshalTo(data []byte) (n int, err error) {
 		i = encodeVarintData(data, i, uint64(len(*m.Tag)))
 		i += copy(data[i:], *m.Tag)
 	}
+	if m.Expiration != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintData(data, i, uint64(m.Expiration.Size()))
+		n2, err := m.Expiration.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -2635,11 +2745,11 @@ func (m *KeyValue) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x12
 	i++
 	i = encodeVarintData(data, i, uint64(m.Value.Size()))
-	n2, err := m.Value.MarshalTo(data[i:])
+	n3, err := m.Value.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n2
+	i += n3
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -2728,19 +2838,19 @@ func (m *SplitTrigger) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintData(data, i, uint64(m.UpdatedDesc.Size()))
-	n3, err := m.UpdatedDesc.MarshalTo(data[i:])
+	n4, err := m.UpdatedDesc.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	data[i] = 0x12
 	i++
 	i = encodeVarintData(data, i, uint64(m.NewDesc.Size()))
-	n4, err := m.NewDesc.MarshalTo(data[i:])
+	n5, err := m.NewDesc.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -2765,11 +2875,11 @@ func (m *MergeTrigger) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintData(data, i, uint64(m.UpdatedDesc.Size()))
-	n5, err := m.UpdatedDesc.MarshalTo(data[i:])
+	n6, err := m.UpdatedDesc.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	data[i] = 0x10
 	i++
 	i = encodeVarintData(data, i, uint64(m.SubsumedRaftID))
@@ -2840,31 +2950,31 @@ func (m *InternalCommitTrigger) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintData(data, i, uint64(m.SplitTrigger.Size()))
-		n6, err := m.SplitTrigger.MarshalTo(data[i:])
+		n7, err := m.SplitTrigger.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if m.MergeTrigger != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintData(data, i, uint64(m.MergeTrigger.Size()))
-		n7, err := m.MergeTrigger.MarshalTo(data[i:])
+		n8, err := m.MergeTrigger.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if m.ChangeReplicasTrigger != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintData(data, i, uint64(m.ChangeReplicasTrigger.Size()))
-		n8, err := m.ChangeReplicasTrigger.MarshalTo(data[i:])
+		n9, err := m.ChangeReplicasTrigger.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if len(m.Intents) > 0 {
 		for _, b := range m.Intents {
@@ -2896,22 +3006,22 @@ func (m *NodeList) MarshalTo(data []byte) (n int, err error) {
 	var l int
 	_ = l
 	if len(m.Nodes) > 0 {
-		data10 := make([]byte, len(m.Nodes)*10)
-		var j9 int
+		data11 := make([]byte, len(m.Nodes)*10)
+		var j10 int
 		for _, num1 := range m.Nodes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				data10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				data11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j10++
 			}
-			data10[j9] = uint8(num)
-			j9++
+			data11[j10] = uint8(num)
+			j10++
 		}
 		data[i] = 0xa
 		i++
-		i = encodeVarintData(data, i, uint64(j9))
-		i += copy(data[i:], data10[:j9])
+		i = encodeVarintData(data, i, uint64(j10))
+		i += copy(data[i:], data11[:j10])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -2966,44 +3076,47 @@ func (m *Transaction) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x42
 		i++
 		i = encodeVarintData(data, i, uint64(m.LastHeartbeat.Size()))
-		n11, err := m.LastHeartbeat.MarshalTo(data[i:])
+		n12, err := m.LastHeartbeat.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	data[i] = 0x4a
 	i++
 	i = encodeVarintData(data, i, uint64(m.Timestamp.Size()))
-	n12, err := m.Timestamp.MarshalTo(data[i:])
+	n13, err := m.Timestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	data[i] = 0x52
 	i++
 	i = encodeVarintData(data, i, uint64(m.OrigTimestamp.Size()))
-	n13, err := m.OrigTimestamp.MarshalTo(data[i:])
+	n14, err := m.OrigTimestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	data[i] = 0x5a
 	i++
 	i = encodeVarintData(data, i, uint64(m.MaxTimestamp.Size()))
-	n14, err := m.MaxTimestamp.MarshalTo(data[i:])
+	n15, err := m.MaxTimestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	data[i] = 0x62
 	i++
 	i = encodeVarintData(data, i, uint64(m.CertainNodes.Size()))
-	n15, err := m.CertainNodes.MarshalTo(data[i:])
+	n16, err := m.CertainNodes.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
+	data[i] = 0x68
+	i++
+	i = encodeVarintData(data, i, uint64(m.Sequence))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3028,19 +3141,19 @@ func (m *Lease) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintData(data, i, uint64(m.Start.Size()))
-	n16, err := m.Start.MarshalTo(data[i:])
+	n17, err := m.Start.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	data[i] = 0x12
 	i++
 	i = encodeVarintData(data, i, uint64(m.Expiration.Size()))
-	n17, err := m.Expiration.MarshalTo(data[i:])
+	n18, err := m.Expiration.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	data[i] = 0x18
 	i++
 	i = encodeVarintData(data, i, uint64(m.RaftNodeID))
@@ -3074,11 +3187,11 @@ func (m *Intent) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x12
 	i++
 	i = encodeVarintData(data, i, uint64(m.Txn.Size()))
-	n18, err := m.Txn.MarshalTo(data[i:])
+	n19, err := m.Txn.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3108,6 +3221,11 @@ func (m *GCMetadata) MarshalTo(data []byte) (n int, err error) {
 		i++
 		i = encodeVarintData(data, i, uint64(*m.OldestIntentNanos))
 	}
+	if m.NextExpirationNanos != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintData(data, i, uint64(*m.NextExpirationNanos))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -108,7 +108,12 @@ type InternalRangeLookupRequest struct {
 	// be false in general, except for the case where the lookup is
 	// already in service of pushing intents on meta records. Attempting
 	// to resolve intents in this case would lead to infinite recursion.
-	IgnoreIntents    bool   `protobuf:"varint,3,opt,name=ignore_intents" json:"ignore_intents"`
+	IgnoreIntents bool `protobuf:"varint,3,opt,name=ignore_intents" json:"ignore_intents"`
+	// Reverse indicates that the lookup is for the range containing the
+	// keys immediately preceding the requested key, i.e. the range whose
+	// start key is less than and whose end key is at least the requested
+	// key. The additional ranges returned precede that range.
+	Reverse          bool   `protobuf:"varint,4,opt,name=reverse" json:"reverse"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -130,6 +135,13 @@ func (m *InternalRangeLookupRequest) GetIgnoreIntents() bool {
 	return false
 }
 
+func (m *InternalRangeLookupRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
 // An InternalRangeLookupResponse is the return value from the
 // InternalRangeLookup() method. It returns metadata for the range
 // containing the requested key, optionally returning the metadata for
@@ -184,10 +196,14 @@ func (*InternalHeartbeatTxnResponse) ProtoMessage()    {}
 // sent by range leaders after scanning range data to find expired
 // MVCC values.
 type InternalGCRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	GCMeta           GCMetadata                `protobuf:"bytes,2,opt,name=gc_meta" json:"gc_meta"`
-	Keys             []InternalGCRequest_GCKey `protobuf:"bytes,3,rep,name=keys" json:"keys"`
-	XXX_unrecognized []byte                    `json:"-"`
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	GCMeta        GCMetadata                `protobuf:"bytes,2,opt,name=gc_meta" json:"gc_meta"`
+	Keys          []InternalGCRequest_GCKey `protobuf:"bytes,3,rep,name=keys" json:"keys"`
+	// The sequence number of the range's noted next expiration seen by
+	// the GC scan, or zero if none was seen. The noted expiration is
+	// cleared unless another expiration has been noted since.
+	NextExpirationSeq uint64 `protobuf:"varint,4,opt,name=next_expiration_seq" json:"next_expiration_seq"`
+	XXX_unrecognized  []byte `json:"-"`
 }
 
 func (m *InternalGCRequest) Reset()         { *m = InternalGCRequest{} }
@@ -208,6 +224,13 @@ func (m *InternalGCRequest) GetKeys() []InternalGCRequest_GCKey {
 	return nil
 }
 
+func (m *InternalGCRequest) GetNextExpirationSeq() uint64 {
+	if m != nil {
+		return m.NextExpirationSeq
+	}
+	return 0
+}
+
 type InternalGCRequest_GCKey struct {
 	Key              Key       `protobuf:"bytes,1,opt,name=key,casttype=Key" json:"key,omitempty"`
 	Timestamp        Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp"`
@@ -328,6 +351,98 @@ func (m *InternalPushTxnResponse) GetPusheeTxn() *Transaction {
 	return nil
 }
 
+// A TxnWaitEdge records that the pusher transaction is waiting for
+// the pushee transaction to finish. The edges make up the waits-for
+// graph which is used to detect deadlocks between transactions.
+type TxnWaitEdge struct {
+	PusherID []byte `protobuf:"bytes,1,opt,name=pusher_id" json:"pusher_id,omitempty"`
+	// pusher_priority is the priority of the pusher at the time it
+	// began waiting.
+	PusherPriority   int32  `protobuf:"varint,2,opt,name=pusher_priority" json:"pusher_priority"`
+	PusheeID         []byte `protobuf:"bytes,3,opt,name=pushee_id" json:"pushee_id,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *TxnWaitEdge) Reset()         { *m = TxnWaitEdge{} }
+func (m *TxnWaitEdge) String() string { return proto1.CompactTextString(m) }
+func (*TxnWaitEdge) ProtoMessage()    {}
+
+func (m *TxnWaitEdge) GetPusherID() []byte {
+	if m != nil {
+		return m.PusherID
+	}
+	return nil
+}
+
+func (m *TxnWaitEdge) GetPusherPriority() int32 {
+	if m != nil {
+		return m.PusherPriority
+	}
+	return 0
+}
+
+func (m *TxnWaitEdge) GetPusheeID() []byte {
+	if m != nil {
+		return m.PusheeID
+	}
+	return nil
+}
+
+// An InternalQueryTxnRequest is arguments to the InternalQueryTxn()
+// method. It is sent periodically by pushes waiting for a transaction
+// to finish in order to query the state of their own pusher
+// transaction. As with InternalPushTxn, args.Key should be set to
+// the key of args.QueriedTxn so that the request is addressed to the
+// range which owns the txn record.
+type InternalQueryTxnRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	QueriedTxn       Transaction `protobuf:"bytes,2,opt,name=queried_txn" json:"queried_txn"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *InternalQueryTxnRequest) Reset()         { *m = InternalQueryTxnRequest{} }
+func (m *InternalQueryTxnRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalQueryTxnRequest) ProtoMessage()    {}
+
+func (m *InternalQueryTxnRequest) GetQueriedTxn() Transaction {
+	if m != nil {
+		return m.QueriedTxn
+	}
+	return Transaction{}
+}
+
+// An InternalQueryTxnResponse is the return value from the
+// InternalQueryTxn() method.
+type InternalQueryTxnResponse struct {
+	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// queried_txn is the persisted record of the queried transaction or
+	// nil if there is none.
+	QueriedTxn *Transaction `protobuf:"bytes,2,opt,name=queried_txn" json:"queried_txn,omitempty"`
+	// waits holds the edges of the waits-for graph leading to the queried
+	// transaction, i.e. those of all transactions which are waiting for
+	// it to finish, directly or transitively.
+	Waits            []TxnWaitEdge `protobuf:"bytes,3,rep,name=waits" json:"waits"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *InternalQueryTxnResponse) Reset()         { *m = InternalQueryTxnResponse{} }
+func (m *InternalQueryTxnResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalQueryTxnResponse) ProtoMessage()    {}
+
+func (m *InternalQueryTxnResponse) GetQueriedTxn() *Transaction {
+	if m != nil {
+		return m.QueriedTxn
+	}
+	return nil
+}
+
+func (m *InternalQueryTxnResponse) GetWaits() []TxnWaitEdge {
+	if m != nil {
+		return m.Waits
+	}
+	return nil
+}
+
 // An InternalResolveIntentRequest is arguments to the
 // InternalResolveIntent() method. It is sent by transaction
 // coordinators and after success calling InternalPushTxn to clean up
@@ -356,7 +471,19 @@ func (*InternalResolveIntentResponse) ProtoMessage()    {}
 // InternalResolveIntentRange() method. This clear write intents
 // for a range of keys to resolve intents created by range ops.
 type InternalResolveIntentRangeRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// Rollback removes the transaction's intents which were written
+	// after its sequence number, rolling the transaction back to the
+	// savepoint created at that sequence number, instead of resolving
+	// the intents according to the transaction's status.
+	Rollback bool `protobuf:"varint,2,opt,name=rollback" json:"rollback"`
+	// Restore, if set along with Rollback, replaces each removed intent
+	// in place with an intent for this value, which the transaction
+	// wrote before the savepoint.
+	Restore *Value `protobuf:"bytes,3,opt,name=restore" json:"restore,omitempty"`
+	// RestoreDeleted, if set along with Rollback, replaces each removed
+	// intent in place with a deletion intent.
+	RestoreDeleted   bool   `protobuf:"varint,4,opt,name=restore_deleted" json:"restore_deleted"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -364,6 +491,27 @@ func (m *InternalResolveIntentRangeRequest) Reset()         { *m = InternalResol
 func (m *InternalResolveIntentRangeRequest) String() string { return proto1.CompactTextString(m) }
 func (*InternalResolveIntentRangeRequest) ProtoMessage()    {}
 
+func (m *InternalResolveIntentRangeRequest) GetRollback() bool {
+	if m != nil {
+		return m.Rollback
+	}
+	return false
+}
+
+func (m *InternalResolveIntentRangeRequest) GetRestore() *Value {
+	if m != nil {
+		return m.Restore
+	}
+	return nil
+}
+
+func (m *InternalResolveIntentRangeRequest) GetRestoreDeleted() bool {
+	if m != nil {
+		return m.RestoreDeleted
+	}
+	return false
+}
+
 // An InternalResolveIntentRangeResponse is the return value from the
 // InternalResolveIntent() method.
 type InternalResolveIntentRangeResponse struct {
@@ -480,6 +628,11 @@ type InternalRequestUnion struct {
 	DeleteRange                *DeleteRangeRequest                `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
 	Scan                       *ScanRequest                       `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
 	EndTransaction             *EndTransactionRequest             `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	ReverseScan                *ReverseScanRequest                `protobuf:"bytes,10,opt,name=reverse_scan" json:"reverse_scan,omitempty"`
+	ReapQueue                  *ReapQueueRequest                  `protobuf:"bytes,11,opt,name=reap_queue" json:"reap_queue,omitempty"`
+	EnqueueUpdate              *EnqueueUpdateRequest              `protobuf:"bytes,12,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
+	EnqueueMessage             *EnqueueMessageRequest             `protobuf:"bytes,13,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
+	Changes                    *ChangesRequest                    `protobuf:"bytes,14,opt,name=changes" json:"changes,omitempty"`
 	InternalPushTxn            *InternalPushTxnRequest            `protobuf:"bytes,30,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
 	InternalResolveIntent      *InternalResolveIntentRequest      `protobuf:"bytes,31,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
 	InternalResolveIntentRange *InternalResolveIntentRangeRequest `protobuf:"bytes,32,opt,name=internal_resolve_intent_range" json:"internal_resolve_intent_range,omitempty"`
@@ -546,6 +699,41 @@ func (m *InternalRequestUnion) GetEndTransaction() *EndTransactionRequest {
 	return nil
 }
 
+func (m *InternalRequestUnion) GetReverseScan() *ReverseScanRequest {
+	if m != nil {
+		return m.ReverseScan
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetReapQueue() *ReapQueueRequest {
+	if m != nil {
+		return m.ReapQueue
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetEnqueueUpdate() *EnqueueUpdateRequest {
+	if m != nil {
+		return m.EnqueueUpdate
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetEnqueueMessage() *EnqueueMessageRequest {
+	if m != nil {
+		return m.EnqueueMessage
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetChanges() *ChangesRequest {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 func (m *InternalRequestUnion) GetInternalPushTxn() *InternalPushTxnRequest {
 	if m != nil {
 		return m.InternalPushTxn
@@ -578,6 +766,11 @@ type InternalResponseUnion struct {
 	DeleteRange                *DeleteRangeResponse                `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
 	Scan                       *ScanResponse                       `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
 	EndTransaction             *EndTransactionResponse             `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	ReverseScan                *ReverseScanResponse                `protobuf:"bytes,10,opt,name=reverse_scan" json:"reverse_scan,omitempty"`
+	ReapQueue                  *ReapQueueResponse                  `protobuf:"bytes,11,opt,name=reap_queue" json:"reap_queue,omitempty"`
+	EnqueueUpdate              *EnqueueUpdateResponse              `protobuf:"bytes,12,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
+	EnqueueMessage             *EnqueueMessageResponse             `protobuf:"bytes,13,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
+	Changes                    *ChangesResponse                    `protobuf:"bytes,14,opt,name=changes" json:"changes,omitempty"`
 	InternalPushTxn            *InternalPushTxnResponse            `protobuf:"bytes,30,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
 	InternalResolveIntent      *InternalResolveIntentResponse      `protobuf:"bytes,31,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
 	InternalResolveIntentRange *InternalResolveIntentRangeResponse `protobuf:"bytes,32,opt,name=internal_resolve_intent_range" json:"internal_resolve_intent_range,omitempty"`
@@ -644,6 +837,41 @@ func (m *InternalResponseUnion) GetEndTransaction() *EndTransactionResponse {
 	return nil
 }
 
+func (m *InternalResponseUnion) GetReverseScan() *ReverseScanResponse {
+	if m != nil {
+		return m.ReverseScan
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetReapQueue() *ReapQueueResponse {
+	if m != nil {
+		return m.ReapQueue
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetEnqueueUpdate() *EnqueueUpdateResponse {
+	if m != nil {
+		return m.EnqueueUpdate
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetEnqueueMessage() *EnqueueMessageResponse {
+	if m != nil {
+		return m.EnqueueMessage
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetChanges() *ChangesResponse {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 func (m *InternalResponseUnion) GetInternalPushTxn() *InternalPushTxnResponse {
 	if m != nil {
 		return m.InternalPushTxn
@@ -668,7 +896,11 @@ func (m *InternalResponseUnion) GetInternalResolveIntentRange() *InternalResolve
 // An InternalBatchRequest contains a superset of commands from
 // BatchRequest and internal batchable commands.
 //
-// See comments for BatchRequest.
+// See comments for BatchRequest. When sent to a range, the batch must
+// consist of point writes followed by a committing EndTransaction; the
+// range then commits the transaction in one phase, without laying
+// down intents or a transaction record. Its key range must cover the
+// keys of all writes.
 type InternalBatchRequest struct {
 	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	Requests         []InternalRequestUnion `protobuf:"bytes,2,rep,name=requests" json:"requests"`
@@ -716,6 +948,9 @@ type ReadWriteCmdResponse struct {
 	Delete                     *DeleteResponse                     `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
 	DeleteRange                *DeleteRangeResponse                `protobuf:"bytes,5,opt,name=delete_range" json:"delete_range,omitempty"`
 	EndTransaction             *EndTransactionResponse             `protobuf:"bytes,6,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	ReapQueue                  *ReapQueueResponse                  `protobuf:"bytes,7,opt,name=reap_queue" json:"reap_queue,omitempty"`
+	EnqueueUpdate              *EnqueueUpdateResponse              `protobuf:"bytes,8,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
+	EnqueueMessage             *EnqueueMessageResponse             `protobuf:"bytes,9,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
 	InternalHeartbeatTxn       *InternalHeartbeatTxnResponse       `protobuf:"bytes,10,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
 	InternalPushTxn            *InternalPushTxnResponse            `protobuf:"bytes,11,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
 	InternalResolveIntent      *InternalResolveIntentResponse      `protobuf:"bytes,12,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
@@ -724,6 +959,7 @@ type ReadWriteCmdResponse struct {
 	InternalTruncateLog        *InternalTruncateLogResponse        `protobuf:"bytes,15,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
 	InternalGc                 *InternalGCResponse                 `protobuf:"bytes,16,opt,name=internal_gc" json:"internal_gc,omitempty"`
 	InternalLeaderLease        *InternalLeaderLeaseResponse        `protobuf:"bytes,17,opt,name=internal_leader_lease" json:"internal_leader_lease,omitempty"`
+	InternalBatch              *InternalBatchResponse              `protobuf:"bytes,18,opt,name=internal_batch" json:"internal_batch,omitempty"`
 	XXX_unrecognized           []byte                              `json:"-"`
 }
 
@@ -773,6 +1009,27 @@ func (m *ReadWriteCmdResponse) GetEndTransaction() *EndTransactionResponse {
 	return nil
 }
 
+func (m *ReadWriteCmdResponse) GetReapQueue() *ReapQueueResponse {
+	if m != nil {
+		return m.ReapQueue
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetEnqueueUpdate() *EnqueueUpdateResponse {
+	if m != nil {
+		return m.EnqueueUpdate
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetEnqueueMessage() *EnqueueMessageResponse {
+	if m != nil {
+		return m.EnqueueMessage
+	}
+	return nil
+}
+
 func (m *ReadWriteCmdResponse) GetInternalHeartbeatTxn() *InternalHeartbeatTxnResponse {
 	if m != nil {
 		return m.InternalHeartbeatTxn
@@ -829,6 +1086,13 @@ func (m *ReadWriteCmdResponse) GetInternalLeaderLease() *InternalLeaderLeaseResp
 	return nil
 }
 
+func (m *ReadWriteCmdResponse) GetInternalBatch() *InternalBatchResponse {
+	if m != nil {
+		return m.InternalBatch
+	}
+	return nil
+}
+
 // An InternalRaftCommandUnion is the union of all commands which can be
 // sent via raft.
 type InternalRaftCommandUnion struct {
@@ -841,6 +1105,11 @@ type InternalRaftCommandUnion struct {
 	DeleteRange    *DeleteRangeRequest    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
 	Scan           *ScanRequest           `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
 	EndTransaction *EndTransactionRequest `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	ReverseScan    *ReverseScanRequest    `protobuf:"bytes,10,opt,name=reverse_scan" json:"reverse_scan,omitempty"`
+	ReapQueue      *ReapQueueRequest      `protobuf:"bytes,11,opt,name=reap_queue" json:"reap_queue,omitempty"`
+	EnqueueUpdate  *EnqueueUpdateRequest  `protobuf:"bytes,12,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
+	EnqueueMessage *EnqueueMessageRequest `protobuf:"bytes,13,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
+	Changes        *ChangesRequest        `protobuf:"bytes,14,opt,name=changes" json:"changes,omitempty"`
 	// Other requests. Allow a gap in tag numbers so the previous list can
 	// be copy/pasted from RequestUnion.
 	Batch                      *BatchRequest                      `protobuf:"bytes,30,opt,name=batch" json:"batch,omitempty"`
@@ -917,6 +1186,41 @@ func (m *InternalRaftCommandUnion) GetEndTransaction() *EndTransactionRequest {
 	return nil
 }
 
+func (m *InternalRaftCommandUnion) GetReverseScan() *ReverseScanRequest {
+	if m != nil {
+		return m.ReverseScan
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetReapQueue() *ReapQueueRequest {
+	if m != nil {
+		return m.ReapQueue
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetEnqueueUpdate() *EnqueueUpdateRequest {
+	if m != nil {
+		return m.EnqueueUpdate
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetEnqueueMessage() *EnqueueMessageRequest {
+	if m != nil {
+		return m.EnqueueMessage
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetChanges() *ChangesRequest {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 func (m *InternalRaftCommandUnion) GetBatch() *BatchRequest {
 	if m != nil {
 		return m.Batch
@@ -997,10 +1301,15 @@ func (m *InternalRaftCommandUnion) GetInternalBatch() *InternalBatchRequest {
 // An InternalRaftCommand is a command which can be serialized and
 // sent via raft.
 type InternalRaftCommand struct {
-	RaftID           RaftID                   `protobuf:"varint,1,opt,name=raft_id,casttype=RaftID" json:"raft_id"`
-	OriginNodeID     RaftNodeID               `protobuf:"varint,2,opt,name=origin_node_id,casttype=RaftNodeID" json:"origin_node_id"`
-	Cmd              InternalRaftCommandUnion `protobuf:"bytes,3,opt,name=cmd" json:"cmd"`
-	XXX_unrecognized []byte                   `json:"-"`
+	RaftID       RaftID                   `protobuf:"varint,1,opt,name=raft_id,casttype=RaftID" json:"raft_id"`
+	OriginNodeID RaftNodeID               `protobuf:"varint,2,opt,name=origin_node_id,casttype=RaftNodeID" json:"origin_node_id"`
+	Cmd          InternalRaftCommandUnion `protobuf:"bytes,3,opt,name=cmd" json:"cmd"`
+	// ClosedTimestamp is a timestamp at or below which the proposing
+	// replica will not propose further writes. If the command is proposed
+	// under the leader lease, replicas which have applied it may serve
+	// reads at or below the closed timestamp.
+	ClosedTimestamp  Timestamp `protobuf:"bytes,4,opt,name=closed_timestamp" json:"closed_timestamp"`
+	XXX_unrecognized []byte    `json:"-"`
 }
 
 func (m *InternalRaftCommand) Reset()         { *m = InternalRaftCommand{} }
@@ -1014,6 +1323,13 @@ func (m *InternalRaftCommand) GetCmd() InternalRaftCommandUnion {
 	return InternalRaftCommandUnion{}
 }
 
+func (m *InternalRaftCommand) GetClosedTimestamp() Timestamp {
+	if m != nil {
+		return m.ClosedTimestamp
+	}
+	return Timestamp{}
+}
+
 // RaftMessageRequest is the request used to send raft messages using our
 // protobuf-based RPC codec. Unlike most of the requests defined in this file
 // and api.proto, this one is implemented in a separate service defined in
@@ -1337,6 +1653,23 @@ func (m *InternalRangeLookupRequest) Unmarshal(data []byte) error {
 				}
 			}
 			m.IgnoreIntents = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -1679,6 +2012,21 @@ func (m *InternalGCRequest) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExpirationSeq", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextExpirationSeq |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			var sizeOfWire int
 			for {
@@ -2100,7 +2448,7 @@ func (m *InternalPushTxnResponse) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *InternalResolveIntentRequest) Unmarshal(data []byte) error {
+func (m *TxnWaitEdge) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2121,27 +2469,62 @@ func (m *InternalResolveIntentRequest) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PusherID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestHeader.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.PusherID = append([]byte{}, data[iNdEx:postIndex]...)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PusherPriority", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.PusherPriority |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PusheeID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PusheeID = append([]byte{}, data[iNdEx:postIndex]...)
 			iNdEx = postIndex
 		default:
 			var sizeOfWire int
@@ -2167,7 +2550,7 @@ func (m *InternalResolveIntentRequest) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *InternalResolveIntentResponse) Unmarshal(data []byte) error {
+func (m *InternalQueryTxnRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2188,7 +2571,7 @@ func (m *InternalResolveIntentResponse) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2206,18 +2589,295 @@ func (m *InternalResolveIntentResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResponseHeader.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.RequestHeader.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
-				}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriedTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueriedTxn.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipInternal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *InternalQueryTxnResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriedTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueriedTxn == nil {
+				m.QueriedTxn = &Transaction{}
+			}
+			if err := m.QueriedTxn.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waits = append(m.Waits, TxnWaitEdge{})
+			if err := m.Waits[len(m.Waits)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipInternal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *InternalResolveIntentRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipInternal(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *InternalResolveIntentResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
 			}
 			iNdEx -= sizeOfWire
 			skippy, err := skipInternal(data[iNdEx:])
@@ -2276,7 +2936,68 @@ func (m *InternalResolveIntentRangeRequest) Unmarshal(data []byte) error {
 			if err := m.RequestHeader.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rollback = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restore == nil {
+				m.Restore = &Value{}
+			}
+			if err := m.Restore.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestoreDeleted = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -3068,6 +3789,141 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReverseScan == nil {
+				m.ReverseScan = &ReverseScanRequest{}
+			}
+			if err := m.ReverseScan.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReapQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReapQueue == nil {
+				m.ReapQueue = &ReapQueueRequest{}
+			}
+			if err := m.ReapQueue.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueUpdate == nil {
+				m.EnqueueUpdate = &EnqueueUpdateRequest{}
+			}
+			if err := m.EnqueueUpdate.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueMessage == nil {
+				m.EnqueueMessage = &EnqueueMessageRequest{}
+			}
+			if err := m.EnqueueMessage.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Changes == nil {
+				m.Changes = &ChangesRequest{}
+			}
+			if err := m.Changes.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 30:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
@@ -3293,16 +4149,151 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Increment == nil {
-				m.Increment = &IncrementResponse{}
+			if m.Increment == nil {
+				m.Increment = &IncrementResponse{}
+			}
+			if err := m.Increment.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteResponse{}
+			}
+			if err := m.Delete.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeResponse{}
+			}
+			if err := m.DeleteRange.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scan == nil {
+				m.Scan = &ScanResponse{}
+			}
+			if err := m.Scan.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTransaction == nil {
+				m.EndTransaction = &EndTransactionResponse{}
+			}
+			if err := m.EndTransaction.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReverseScan == nil {
+				m.ReverseScan = &ReverseScanResponse{}
 			}
-			if err := m.Increment.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.ReverseScan.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReapQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3320,16 +4311,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteResponse{}
+			if m.ReapQueue == nil {
+				m.ReapQueue = &ReapQueueResponse{}
 			}
-			if err := m.Delete.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.ReapQueue.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3347,16 +4338,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteRange == nil {
-				m.DeleteRange = &DeleteRangeResponse{}
+			if m.EnqueueUpdate == nil {
+				m.EnqueueUpdate = &EnqueueUpdateResponse{}
 			}
-			if err := m.DeleteRange.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.EnqueueUpdate.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueMessage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3374,16 +4365,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scan == nil {
-				m.Scan = &ScanResponse{}
+			if m.EnqueueMessage == nil {
+				m.EnqueueMessage = &EnqueueMessageResponse{}
 			}
-			if err := m.Scan.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.EnqueueMessage.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3401,10 +4392,10 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTransaction == nil {
-				m.EndTransaction = &EndTransactionResponse{}
+			if m.Changes == nil {
+				m.Changes = &ChangesResponse{}
 			}
-			if err := m.EndTransaction.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Changes.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3878,6 +4869,87 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReapQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReapQueue == nil {
+				m.ReapQueue = &ReapQueueResponse{}
+			}
+			if err := m.ReapQueue.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueUpdate == nil {
+				m.EnqueueUpdate = &EnqueueUpdateResponse{}
+			}
+			if err := m.EnqueueUpdate.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueMessage == nil {
+				m.EnqueueMessage = &EnqueueMessageResponse{}
+			}
+			if err := m.EnqueueMessage.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InternalHeartbeatTxn", wireType)
@@ -4094,6 +5166,33 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalBatch == nil {
+				m.InternalBatch = &InternalBatchResponse{}
+			}
+			if err := m.InternalBatch.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -4353,6 +5452,141 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReverseScan == nil {
+				m.ReverseScan = &ReverseScanRequest{}
+			}
+			if err := m.ReverseScan.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReapQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReapQueue == nil {
+				m.ReapQueue = &ReapQueueRequest{}
+			}
+			if err := m.ReapQueue.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueUpdate == nil {
+				m.EnqueueUpdate = &EnqueueUpdateRequest{}
+			}
+			if err := m.EnqueueUpdate.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueMessage == nil {
+				m.EnqueueMessage = &EnqueueMessageRequest{}
+			}
+			if err := m.EnqueueMessage.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Changes == nil {
+				m.Changes = &ChangesRequest{}
+			}
+			if err := m.Changes.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 30:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
@@ -4723,9 +5957,33 @@ func (m *InternalRaftCommand) Unmarshal(data []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cmd.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4743,7 +6001,7 @@ func (m *InternalRaftCommand) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cmd.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.ClosedTimestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5481,6 +6739,21 @@ func (this *InternalRequestUnion) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.ReverseScan != nil {
+		return this.ReverseScan
+	}
+	if this.ReapQueue != nil {
+		return this.ReapQueue
+	}
+	if this.EnqueueUpdate != nil {
+		return this.EnqueueUpdate
+	}
+	if this.EnqueueMessage != nil {
+		return this.EnqueueMessage
+	}
+	if this.Changes != nil {
+		return this.Changes
+	}
 	if this.InternalPushTxn != nil {
 		return this.InternalPushTxn
 	}
@@ -5511,6 +6784,16 @@ func (this *InternalRequestUnion) SetValue(value interface{}) bool {
 		this.Scan = vt
 	case *EndTransactionRequest:
 		this.EndTransaction = vt
+	case *ReverseScanRequest:
+		this.ReverseScan = vt
+	case *ReapQueueRequest:
+		this.ReapQueue = vt
+	case *EnqueueUpdateRequest:
+		this.EnqueueUpdate = vt
+	case *EnqueueMessageRequest:
+		this.EnqueueMessage = vt
+	case *ChangesRequest:
+		this.Changes = vt
 	case *InternalPushTxnRequest:
 		this.InternalPushTxn = vt
 	case *InternalResolveIntentRequest:
@@ -5547,6 +6830,21 @@ func (this *InternalResponseUnion) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.ReverseScan != nil {
+		return this.ReverseScan
+	}
+	if this.ReapQueue != nil {
+		return this.ReapQueue
+	}
+	if this.EnqueueUpdate != nil {
+		return this.EnqueueUpdate
+	}
+	if this.EnqueueMessage != nil {
+		return this.EnqueueMessage
+	}
+	if this.Changes != nil {
+		return this.Changes
+	}
 	if this.InternalPushTxn != nil {
 		return this.InternalPushTxn
 	}
@@ -5577,6 +6875,16 @@ func (this *InternalResponseUnion) SetValue(value interface{}) bool {
 		this.Scan = vt
 	case *EndTransactionResponse:
 		this.EndTransaction = vt
+	case *ReverseScanResponse:
+		this.ReverseScan = vt
+	case *ReapQueueResponse:
+		this.ReapQueue = vt
+	case *EnqueueUpdateResponse:
+		this.EnqueueUpdate = vt
+	case *EnqueueMessageResponse:
+		this.EnqueueMessage = vt
+	case *ChangesResponse:
+		this.Changes = vt
 	case *InternalPushTxnResponse:
 		this.InternalPushTxn = vt
 	case *InternalResolveIntentResponse:
@@ -5607,6 +6915,15 @@ func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.ReapQueue != nil {
+		return this.ReapQueue
+	}
+	if this.EnqueueUpdate != nil {
+		return this.EnqueueUpdate
+	}
+	if this.EnqueueMessage != nil {
+		return this.EnqueueMessage
+	}
 	if this.InternalHeartbeatTxn != nil {
 		return this.InternalHeartbeatTxn
 	}
@@ -5631,6 +6948,9 @@ func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.InternalLeaderLease != nil {
 		return this.InternalLeaderLease
 	}
+	if this.InternalBatch != nil {
+		return this.InternalBatch
+	}
 	return nil
 }
 
@@ -5648,6 +6968,12 @@ func (this *ReadWriteCmdResponse) SetValue(value interface{}) bool {
 		this.DeleteRange = vt
 	case *EndTransactionResponse:
 		this.EndTransaction = vt
+	case *ReapQueueResponse:
+		this.ReapQueue = vt
+	case *EnqueueUpdateResponse:
+		this.EnqueueUpdate = vt
+	case *EnqueueMessageResponse:
+		this.EnqueueMessage = vt
 	case *InternalHeartbeatTxnResponse:
 		this.InternalHeartbeatTxn = vt
 	case *InternalPushTxnResponse:
@@ -5664,6 +6990,8 @@ func (this *ReadWriteCmdResponse) SetValue(value interface{}) bool {
 		this.InternalGc = vt
 	case *InternalLeaderLeaseResponse:
 		this.InternalLeaderLease = vt
+	case *InternalBatchResponse:
+		this.InternalBatch = vt
 	default:
 		return false
 	}
@@ -5694,6 +7022,21 @@ func (this *InternalRaftCommandUnion) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.ReverseScan != nil {
+		return this.ReverseScan
+	}
+	if this.ReapQueue != nil {
+		return this.ReapQueue
+	}
+	if this.EnqueueUpdate != nil {
+		return this.EnqueueUpdate
+	}
+	if this.EnqueueMessage != nil {
+		return this.EnqueueMessage
+	}
+	if this.Changes != nil {
+		return this.Changes
+	}
 	if this.Batch != nil {
 		return this.Batch
 	}
@@ -5748,6 +7091,16 @@ func (this *InternalRaftCommandUnion) SetValue(value interface{}) bool {
 		this.Scan = vt
 	case *EndTransactionRequest:
 		this.EndTransaction = vt
+	case *ReverseScanRequest:
+		this.ReverseScan = vt
+	case *ReapQueueRequest:
+		this.ReapQueue = vt
+	case *EnqueueUpdateRequest:
+		this.EnqueueUpdate = vt
+	case *EnqueueMessageRequest:
+		this.EnqueueMessage = vt
+	case *ChangesRequest:
+		this.Changes = vt
 	case *BatchRequest:
 		this.Batch = vt
 	case *InternalRangeLookupRequest:
@@ -5782,6 +7135,7 @@ func (m *InternalRangeLookupRequest) Size() (n int) {
 	n += 1 + l + sovInternal(uint64(l))
 	n += 1 + sovInternal(uint64(m.MaxRanges))
 	n += 2
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5840,6 +7194,7 @@ func (m *InternalGCRequest) Size() (n int) {
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
+	n += 1 + sovInternal(uint64(m.NextExpirationSeq))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5904,6 +7259,58 @@ func (m *InternalPushTxnResponse) Size() (n int) {
 	return n
 }
 
+func (m *TxnWaitEdge) Size() (n int) {
+	var l int
+	_ = l
+	if m.PusherID != nil {
+		l = len(m.PusherID)
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	n += 1 + sovInternal(uint64(m.PusherPriority))
+	if m.PusheeID != nil {
+		l = len(m.PusheeID)
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalQueryTxnRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	l = m.QueriedTxn.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalQueryTxnResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.QueriedTxn != nil {
+		l = m.QueriedTxn.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Waits) > 0 {
+		for _, e := range m.Waits {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *InternalResolveIntentRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -5931,6 +7338,12 @@ func (m *InternalResolveIntentRangeRequest) Size() (n int) {
 	_ = l
 	l = m.RequestHeader.Size()
 	n += 1 + l + sovInternal(uint64(l))
+	n += 2
+	if m.Restore != nil {
+		l = m.Restore.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6054,6 +7467,26 @@ func (m *InternalRequestUnion) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.ReverseScan != nil {
+		l = m.ReverseScan.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReapQueue != nil {
+		l = m.ReapQueue.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.EnqueueUpdate != nil {
+		l = m.EnqueueUpdate.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.EnqueueMessage != nil {
+		l = m.EnqueueMessage.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Changes != nil {
+		l = m.Changes.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.InternalPushTxn != nil {
 		l = m.InternalPushTxn.Size()
 		n += 2 + l + sovInternal(uint64(l))
@@ -6107,6 +7540,26 @@ func (m *InternalResponseUnion) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.ReverseScan != nil {
+		l = m.ReverseScan.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReapQueue != nil {
+		l = m.ReapQueue.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.EnqueueUpdate != nil {
+		l = m.EnqueueUpdate.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.EnqueueMessage != nil {
+		l = m.EnqueueMessage.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Changes != nil {
+		l = m.Changes.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.InternalPushTxn != nil {
 		l = m.InternalPushTxn.Size()
 		n += 2 + l + sovInternal(uint64(l))
@@ -6186,6 +7639,18 @@ func (m *ReadWriteCmdResponse) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.ReapQueue != nil {
+		l = m.ReapQueue.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.EnqueueUpdate != nil {
+		l = m.EnqueueUpdate.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.EnqueueMessage != nil {
+		l = m.EnqueueMessage.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.InternalHeartbeatTxn != nil {
 		l = m.InternalHeartbeatTxn.Size()
 		n += 1 + l + sovInternal(uint64(l))
@@ -6218,6 +7683,10 @@ func (m *ReadWriteCmdResponse) Size() (n int) {
 		l = m.InternalLeaderLease.Size()
 		n += 2 + l + sovInternal(uint64(l))
 	}
+	if m.InternalBatch != nil {
+		l = m.InternalBatch.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6259,6 +7728,26 @@ func (m *InternalRaftCommandUnion) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.ReverseScan != nil {
+		l = m.ReverseScan.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReapQueue != nil {
+		l = m.ReapQueue.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.EnqueueUpdate != nil {
+		l = m.EnqueueUpdate.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.EnqueueMessage != nil {
+		l = m.EnqueueMessage.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Changes != nil {
+		l = m.Changes.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.Batch != nil {
 		l = m.Batch.Size()
 		n += 2 + l + sovInternal(uint64(l))
@@ -6316,6 +7805,8 @@ func (m *InternalRaftCommand) Size() (n int) {
 	n += 1 + sovInternal(uint64(m.OriginNodeID))
 	l = m.Cmd.Size()
 	n += 1 + l + sovInternal(uint64(l))
+	l = m.ClosedTimestamp.Size()
+	n += 1 + l + sovInternal(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6472,6 +7963,14 @@ func (m *InternalRangeLookupRequest) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0
 	}
 	i++
+	data[i] = 0x20
+	i++
+	if m.Reverse {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6620,6 +8119,9 @@ func (m *InternalGCRequest) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	data[i] = 0x20
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.NextExpirationSeq))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6785,7 +8287,7 @@ func (m *InternalPushTxnResponse) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
-func (m *InternalResolveIntentRequest) Marshal() (data []byte, err error) {
+func (m *TxnWaitEdge) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -6795,7 +8297,43 @@ func (m *InternalResolveIntentRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *InternalResolveIntentRequest) MarshalTo(data []byte) (n int, err error) {
+func (m *TxnWaitEdge) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PusherID != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintInternal(data, i, uint64(len(m.PusherID)))
+		i += copy(data[i:], m.PusherID)
+	}
+	data[i] = 0x10
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.PusherPriority))
+	if m.PusheeID != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintInternal(data, i, uint64(len(m.PusheeID)))
+		i += copy(data[i:], m.PusheeID)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalQueryTxnRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalQueryTxnRequest) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -6808,6 +8346,94 @@ func (m *InternalResolveIntentRequest) MarshalTo(data []byte) (n int, err error)
 		return 0, err
 	}
 	i += n14
+	data[i] = 0x12
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.QueriedTxn.Size()))
+	n15, err := m.QueriedTxn.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalQueryTxnResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalQueryTxnResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
+	n16, err := m.ResponseHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	if m.QueriedTxn != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.QueriedTxn.Size()))
+		n17, err := m.QueriedTxn.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.Waits) > 0 {
+		for _, msg := range m.Waits {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintInternal(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalResolveIntentRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalResolveIntentRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
+	n18, err := m.RequestHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6832,11 +8458,11 @@ func (m *InternalResolveIntentResponse) MarshalTo(data []byte) (n int, err error
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n15, err := m.ResponseHeader.MarshalTo(data[i:])
+	n19, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n19
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6861,11 +8487,37 @@ func (m *InternalResolveIntentRangeRequest) MarshalTo(data []byte) (n int, err e
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n16, err := m.RequestHeader.MarshalTo(data[i:])
+	n20, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n20
+	data[i] = 0x10
+	i++
+	if m.Rollback {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if m.Restore != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.Restore.Size()))
+		n21, err := m.Restore.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	data[i] = 0x20
+	i++
+	if m.RestoreDeleted {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6890,11 +8542,11 @@ func (m *InternalResolveIntentRangeResponse) MarshalTo(data []byte) (n int, err
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n17, err := m.ResponseHeader.MarshalTo(data[i:])
+	n22, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n22
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6919,19 +8571,19 @@ func (m *InternalMergeRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n18, err := m.RequestHeader.MarshalTo(data[i:])
+	n23, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n23
 	data[i] = 0x12
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.Value.Size()))
-	n19, err := m.Value.MarshalTo(data[i:])
+	n24, err := m.Value.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n24
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6956,11 +8608,11 @@ func (m *InternalMergeResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n20, err := m.ResponseHeader.MarshalTo(data[i:])
+	n25, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n25
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6985,11 +8637,11 @@ func (m *InternalTruncateLogRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n21, err := m.RequestHeader.MarshalTo(data[i:])
+	n26, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n26
 	data[i] = 0x10
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.Index))
@@ -7017,11 +8669,11 @@ func (m *InternalTruncateLogResponse) MarshalTo(data []byte) (n int, err error)
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n22, err := m.ResponseHeader.MarshalTo(data[i:])
+	n27, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n27
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -7046,19 +8698,19 @@ func (m *InternalLeaderLeaseRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n23, err := m.RequestHeader.MarshalTo(data[i:])
+	n28, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n28
 	data[i] = 0x12
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.Lease.Size()))
-	n24, err := m.Lease.MarshalTo(data[i:])
+	n29, err := m.Lease.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n29
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -7083,11 +8735,11 @@ func (m *InternalLeaderLeaseResponse) MarshalTo(data []byte) (n int, err error)
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n25, err := m.ResponseHeader.MarshalTo(data[i:])
+	n30, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n30
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -7113,81 +8765,131 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n26, err := m.Get.MarshalTo(data[i:])
+		n31, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n31
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n27, err := m.Put.MarshalTo(data[i:])
+		n32, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n32
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n28, err := m.ConditionalPut.MarshalTo(data[i:])
+		n33, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n33
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n29, err := m.Increment.MarshalTo(data[i:])
+		n34, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n34
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n30, err := m.Delete.MarshalTo(data[i:])
+		n35, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n35
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n31, err := m.DeleteRange.MarshalTo(data[i:])
+		n36, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n36
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n32, err := m.Scan.MarshalTo(data[i:])
+		n37, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n37
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n33, err := m.EndTransaction.MarshalTo(data[i:])
+		n38, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n38
+	}
+	if m.ReverseScan != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ReverseScan.Size()))
+		n39, err := m.ReverseScan.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.ReapQueue != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ReapQueue.Size()))
+		n40, err := m.ReapQueue.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if m.EnqueueUpdate != nil {
+		data[i] = 0x62
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.EnqueueUpdate.Size()))
+		n41, err := m.EnqueueUpdate.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if m.EnqueueMessage != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.EnqueueMessage.Size()))
+		n42, err := m.EnqueueMessage.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if m.Changes != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.Changes.Size()))
+		n43, err := m.Changes.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0xf2
@@ -7195,11 +8897,11 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n34, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n44, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n44
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0xfa
@@ -7207,11 +8909,11 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n35, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n45, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n45
 	}
 	if m.InternalResolveIntentRange != nil {
 		data[i] = 0x82
@@ -7219,11 +8921,11 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntentRange.Size()))
-		n36, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
+		n46, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n46
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7250,81 +8952,131 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n37, err := m.Get.MarshalTo(data[i:])
+		n47, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n47
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n38, err := m.Put.MarshalTo(data[i:])
+		n48, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n48
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n39, err := m.ConditionalPut.MarshalTo(data[i:])
+		n49, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n49
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n40, err := m.Increment.MarshalTo(data[i:])
+		n50, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n50
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n41, err := m.Delete.MarshalTo(data[i:])
+		n51, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n51
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n42, err := m.DeleteRange.MarshalTo(data[i:])
+		n52, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n52
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n43, err := m.Scan.MarshalTo(data[i:])
+		n53, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n53
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n44, err := m.EndTransaction.MarshalTo(data[i:])
+		n54, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n54
+	}
+	if m.ReverseScan != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ReverseScan.Size()))
+		n55, err := m.ReverseScan.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	if m.ReapQueue != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ReapQueue.Size()))
+		n56, err := m.ReapQueue.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if m.EnqueueUpdate != nil {
+		data[i] = 0x62
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.EnqueueUpdate.Size()))
+		n57, err := m.EnqueueUpdate.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	if m.EnqueueMessage != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.EnqueueMessage.Size()))
+		n58, err := m.EnqueueMessage.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
+	if m.Changes != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.Changes.Size()))
+		n59, err := m.Changes.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0xf2
@@ -7332,11 +9084,11 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n45, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n60, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n60
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0xfa
@@ -7344,11 +9096,11 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n46, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n61, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n61
 	}
 	if m.InternalResolveIntentRange != nil {
 		data[i] = 0x82
@@ -7356,11 +9108,11 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntentRange.Size()))
-		n47, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
+		n62, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n62
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7386,11 +9138,11 @@ func (m *InternalBatchRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n48, err := m.RequestHeader.MarshalTo(data[i:])
+	n63, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n48
+	i += n63
 	if len(m.Requests) > 0 {
 		for _, msg := range m.Requests {
 			data[i] = 0x12
@@ -7427,11 +9179,11 @@ func (m *InternalBatchResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n49, err := m.ResponseHeader.MarshalTo(data[i:])
+	n64, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n49
+	i += n64
 	if len(m.Responses) > 0 {
 		for _, msg := range m.Responses {
 			data[i] = 0x12
@@ -7469,121 +9221,151 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n50, err := m.Put.MarshalTo(data[i:])
+		n65, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n65
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n51, err := m.ConditionalPut.MarshalTo(data[i:])
+		n66, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n66
 	}
 	if m.Increment != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n52, err := m.Increment.MarshalTo(data[i:])
+		n67, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n67
 	}
 	if m.Delete != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n53, err := m.Delete.MarshalTo(data[i:])
+		n68, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n68
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n54, err := m.DeleteRange.MarshalTo(data[i:])
+		n69, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n69
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n55, err := m.EndTransaction.MarshalTo(data[i:])
+		n70, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n70
+	}
+	if m.ReapQueue != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ReapQueue.Size()))
+		n71, err := m.ReapQueue.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	if m.EnqueueUpdate != nil {
+		data[i] = 0x42
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.EnqueueUpdate.Size()))
+		n72, err := m.EnqueueUpdate.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	if m.EnqueueMessage != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.EnqueueMessage.Size()))
+		n73, err := m.EnqueueMessage.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
 	}
 	if m.InternalHeartbeatTxn != nil {
 		data[i] = 0x52
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalHeartbeatTxn.Size()))
-		n56, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
+		n74, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n74
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0x5a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n57, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n75, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n75
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0x62
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n58, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n76, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n76
 	}
 	if m.InternalResolveIntentRange != nil {
 		data[i] = 0x6a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntentRange.Size()))
-		n59, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
+		n77, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n77
 	}
 	if m.InternalMerge != nil {
 		data[i] = 0x72
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalMerge.Size()))
-		n60, err := m.InternalMerge.MarshalTo(data[i:])
+		n78, err := m.InternalMerge.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n78
 	}
 	if m.InternalTruncateLog != nil {
 		data[i] = 0x7a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalTruncateLog.Size()))
-		n61, err := m.InternalTruncateLog.MarshalTo(data[i:])
+		n79, err := m.InternalTruncateLog.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n79
 	}
 	if m.InternalGc != nil {
 		data[i] = 0x82
@@ -7591,11 +9373,11 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalGc.Size()))
-		n62, err := m.InternalGc.MarshalTo(data[i:])
+		n80, err := m.InternalGc.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n80
 	}
 	if m.InternalLeaderLease != nil {
 		data[i] = 0x8a
@@ -7603,11 +9385,23 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalLeaderLease.Size()))
-		n63, err := m.InternalLeaderLease.MarshalTo(data[i:])
+		n81, err := m.InternalLeaderLease.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	if m.InternalBatch != nil {
+		data[i] = 0x92
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.InternalBatch.Size()))
+		n82, err := m.InternalBatch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n82
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7634,81 +9428,131 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n64, err := m.Get.MarshalTo(data[i:])
+		n83, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n83
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n65, err := m.Put.MarshalTo(data[i:])
+		n84, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n84
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n66, err := m.ConditionalPut.MarshalTo(data[i:])
+		n85, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n85
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n67, err := m.Increment.MarshalTo(data[i:])
+		n86, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n86
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n68, err := m.Delete.MarshalTo(data[i:])
+		n87, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n87
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n69, err := m.DeleteRange.MarshalTo(data[i:])
+		n88, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n88
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n70, err := m.Scan.MarshalTo(data[i:])
+		n89, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n89
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n71, err := m.EndTransaction.MarshalTo(data[i:])
+		n90, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n90
+	}
+	if m.ReverseScan != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ReverseScan.Size()))
+		n91, err := m.ReverseScan.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n91
+	}
+	if m.ReapQueue != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ReapQueue.Size()))
+		n92, err := m.ReapQueue.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n92
+	}
+	if m.EnqueueUpdate != nil {
+		data[i] = 0x62
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.EnqueueUpdate.Size()))
+		n93, err := m.EnqueueUpdate.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n93
+	}
+	if m.EnqueueMessage != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.EnqueueMessage.Size()))
+		n94, err := m.EnqueueMessage.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n94
+	}
+	if m.Changes != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.Changes.Size()))
+		n95, err := m.Changes.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n95
 	}
 	if m.Batch != nil {
 		data[i] = 0xf2
@@ -7716,11 +9560,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Batch.Size()))
-		n72, err := m.Batch.MarshalTo(data[i:])
+		n96, err := m.Batch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n96
 	}
 	if m.InternalRangeLookup != nil {
 		data[i] = 0xfa
@@ -7728,11 +9572,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalRangeLookup.Size()))
-		n73, err := m.InternalRangeLookup.MarshalTo(data[i:])
+		n97, err := m.InternalRangeLookup.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n97
 	}
 	if m.InternalHeartbeatTxn != nil {
 		data[i] = 0x82
@@ -7740,11 +9584,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalHeartbeatTxn.Size()))
-		n74, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
+		n98, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n98
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0x8a
@@ -7752,11 +9596,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n75, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n99, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n99
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0x92
@@ -7764,11 +9608,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n76, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n100, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n100
 	}
 	if m.InternalResolveIntentRange != nil {
 		data[i] = 0x9a
@@ -7776,11 +9620,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntentRange.Size()))
-		n77, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
+		n101, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n101
 	}
 	if m.InternalMergeResponse != nil {
 		data[i] = 0xa2
@@ -7788,11 +9632,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalMergeResponse.Size()))
-		n78, err := m.InternalMergeResponse.MarshalTo(data[i:])
+		n102, err := m.InternalMergeResponse.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n102
 	}
 	if m.InternalTruncateLog != nil {
 		data[i] = 0xaa
@@ -7800,11 +9644,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalTruncateLog.Size()))
-		n79, err := m.InternalTruncateLog.MarshalTo(data[i:])
+		n103, err := m.InternalTruncateLog.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n103
 	}
 	if m.InternalGC != nil {
 		data[i] = 0xb2
@@ -7812,11 +9656,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalGC.Size()))
-		n80, err := m.InternalGC.MarshalTo(data[i:])
+		n104, err := m.InternalGC.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n104
 	}
 	if m.InternalLease != nil {
 		data[i] = 0xba
@@ -7824,11 +9668,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalLease.Size()))
-		n81, err := m.InternalLease.MarshalTo(data[i:])
+		n105, err := m.InternalLease.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n105
 	}
 	if m.InternalBatch != nil {
 		data[i] = 0xc2
@@ -7836,11 +9680,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalBatch.Size()))
-		n82, err := m.InternalBatch.MarshalTo(data[i:])
+		n106, err := m.InternalBatch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n106
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7872,11 +9716,19 @@ func (m *InternalRaftCommand) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.Cmd.Size()))
-	n83, err := m.Cmd.MarshalTo(data[i:])
+	n107, err := m.Cmd.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n107
+	data[i] = 0x22
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ClosedTimestamp.Size()))
+	n108, err := m.ClosedTimestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n83
+	i += n108
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -8058,11 +9910,11 @@ func (m *RaftSnapshotData) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RangeDescriptor.Size()))
-	n84, err := m.RangeDescriptor.MarshalTo(data[i:])
+	n109, err := m.RangeDescriptor.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n84
+	i += n109
 	if len(m.KV) > 0 {
 		for _, msg := range m.KV {
 			data[i] = 0x12
@@ -47,6 +47,10 @@ const (
 	// args.RequestHeader.Key and args.RequestHeader.EndKey, with
 	// the latter endpoint excluded.
 	Scan
+	// ReverseScan fetches the values for all keys which fall between
+	// args.RequestHeader.Key and args.RequestHeader.EndKey, with the
+	// latter endpoint excluded, in descending key order.
+	ReverseScan
 	// EndTransaction either commits or aborts an ongoing transaction.
 	EndTransaction
 	// ReapQueue scans and deletes messages from a recipient message
@@ -59,6 +63,11 @@ const (
 	EnqueueUpdate
 	// EnqueueMessage enqueues a message for delivery to an inbox.
 	EnqueueMessage
+	// Changes fetches the committed changes to all keys which fall
+	// between args.RequestHeader.Key and args.RequestHeader.EndKey
+	// since a given timestamp, along with the timestamp up to which
+	// the changes are complete.
+	Changes
 	// Batch executes a set of commands in parallel.
 	Batch
 	// AdminSplit is called to coordinate a split of a range.
@@ -87,6 +96,10 @@ const (
 	// an error code either indicating the pusher must retry or abort and
 	// restart the transaction.
 	InternalPushTxn
+	// InternalQueryTxn returns the record of a transaction and the
+	// transactions waiting for it to finish. Like InternalPushTxn,
+	// args.Key should be set to the key of the queried transaction.
+	InternalQueryTxn
 	// InternalResolveIntent resolves existing write intents for a key.
 	InternalResolveIntent
 	// InternalResolveIntentRange resolves existing write intents for a key range.
@@ -104,6 +117,7 @@ const (
 	// InternalLeaderLease requests a leader lease for a replica.
 	InternalLeaderLease
 	// InternalBatch implements batch processing of commands. This is a
-	// superset of the Batch method.
+	// superset of the Batch method. Executed by a range, it commits a
+	// transaction contained in that range in one phase.
 	InternalBatch
 )
@@ -28,6 +28,7 @@ import (
 	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/gossip/resolver"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -36,12 +37,19 @@ import (
 // Context defaults.
 const (
 	defaultAddr             = ":8080"
+	defaultPGAddr           = ":15432"
 	defaultMaxOffset        = 250 * time.Millisecond
 	defaultGossipInterval   = 2 * time.Second
 	defaultCacheSize        = 1 << 30 // GB
 	defaultScanInterval     = 10 * time.Minute
 	defaultScanMaxIdleTime  = 5 * time.Second
 	defaultMetricsFrequency = 10 * time.Second
+
+	defaultClosedTimestampLag = 5 * time.Second
+	defaultRangeConcurrency   = 8
+	// Waits for a conflicting transaction end with its heartbeat expiry
+	// at the latest.
+	defaultTxnWaitTimeout = 2 * storage.DefaultHeartbeatInterval
 )
 
 // Context holds parameters needed to setup a server.
@@ -54,6 +62,9 @@ type Context struct {
 	// Addr is the host:port to bind for HTTP/RPC traffic.
 	Addr string
 
+	// PGAddr is the host:port to bind for PostgreSQL wire protocol traffic.
+	PGAddr string
+
 	// Stores is specified to enable durable key-value storage.
 	// Memory-backed key value stores may be optionally specified
 	// via mem=<integer byte size>.
@@ -88,6 +99,11 @@ type Context struct {
 	// node clocks have necessarily passed it.
 	Linearizable bool
 
+	// RangeConcurrency is the maximum number of ranges to which a
+	// range-spanning read or transactional write is sent in parallel.
+	// One visits the ranges sequentially.
+	RangeConcurrency int
+
 	// Enables the experimental RPC server for use by the experimental
 	// RPC client.
 	ExperimentalRPCServer bool
@@ -120,18 +136,38 @@ type Context struct {
 	// MetricsFrequency determines the frequency at which the server should
 	// record internal metrics.
 	MetricsFrequency time.Duration
+
+	// SlowQueryThreshold is the latency above which SQL statements are
+	// logged along with their plans. Zero disables the logging.
+	SlowQueryThreshold time.Duration
+
+	// ClosedTimestampLag is how far behind the present leaders close
+	// timestamps to further writes, allowing reads below them to be
+	// served by any replica. Zero disables follower reads.
+	ClosedTimestampLag time.Duration
+
+	// TxnWaitTimeout is how long a transaction which failed to push a
+	// conflicting one waits for it to finish before restarting; a wait
+	// also ends once the heartbeat of the conflicting transaction
+	// expires. Zero disables waiting and deadlock detection.
+	TxnWaitTimeout time.Duration
 }
 
 // NewContext returns a Context with default values.
 func NewContext() *Context {
 	ctx := &Context{
 		Addr:             defaultAddr,
+		PGAddr:           defaultPGAddr,
 		MaxOffset:        defaultMaxOffset,
 		GossipInterval:   defaultGossipInterval,
 		CacheSize:        defaultCacheSize,
 		ScanInterval:     defaultScanInterval,
 		ScanMaxIdleTime:  defaultScanMaxIdleTime,
 		MetricsFrequency: defaultMetricsFrequency,
+
+		ClosedTimestampLag: defaultClosedTimestampLag,
+		RangeConcurrency:   defaultRangeConcurrency,
+		TxnWaitTimeout:     defaultTxnWaitTimeout,
 	}
 	// Initializes base context defaults.
 	ctx.InitDefaults()
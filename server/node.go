@@ -237,18 +237,25 @@ func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
 		&proto.DeleteRequest{},
 		&proto.DeleteRangeRequest{},
 		&proto.ScanRequest{},
+		&proto.ReverseScanRequest{},
 		&proto.EndTransactionRequest{},
+		&proto.ReapQueueRequest{},
+		&proto.EnqueueUpdateRequest{},
+		&proto.EnqueueMessageRequest{},
+		&proto.ChangesRequest{},
 		&proto.AdminSplitRequest{},
 		&proto.AdminMergeRequest{},
 		&proto.InternalRangeLookupRequest{},
 		&proto.InternalHeartbeatTxnRequest{},
 		&proto.InternalGCRequest{},
 		&proto.InternalPushTxnRequest{},
+		&proto.InternalQueryTxnRequest{},
 		&proto.InternalResolveIntentRequest{},
 		&proto.InternalResolveIntentRangeRequest{},
 		&proto.InternalMergeRequest{},
 		&proto.InternalTruncateLogRequest{},
 		&proto.InternalLeaderLeaseRequest{},
+		&proto.InternalBatchRequest{},
 	}
 	for _, r := range requests {
 		if err := rpcServer.Register("Node."+r.Method().String(), n.executeCmd, r); err != nil {
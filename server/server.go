@@ -38,6 +38,7 @@ import (
 	"github.com/cockroachdb/cockroach/server/status"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/pgwire"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/ui"
@@ -67,6 +68,7 @@ type Server struct {
 	db            *client.DB
 	kvDB          *kv.DBServer
 	sqlServer     *sql.Server
+	pgServer      *pgwire.Server
 	node          *Node
 	recorder      *status.NodeStatusRecorder
 	admin         *adminServer
@@ -120,7 +122,11 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 	feed := &util.Feed{}
 	tracer := tracer.NewTracer(feed, addr)
 
-	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.clock}, s.gossip)
+	ds := kv.NewDistSender(&kv.DistSenderContext{
+		Clock:              s.clock,
+		RangeConcurrency:   int32(ctx.RangeConcurrency),
+		ClosedTimestampLag: ctx.ClosedTimestampLag,
+	}, s.gossip)
 	sender := kv.NewTxnCoordSender(ds, s.clock, ctx.Linearizable, tracer, s.stopper)
 	if s.db, err = client.Open("//root@", client.SenderOpt(sender)); err != nil {
 		return nil, err
@@ -139,8 +145,6 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		}
 	}
 
-	s.sqlServer = sql.NewServer(&s.ctx.Context, s.db)
-
 	// TODO(bdarnell): make StoreConfig configurable.
 	nCtx := storage.StoreContext{
 		Clock:           s.clock,
@@ -151,6 +155,9 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		ScanMaxIdleTime: s.ctx.ScanMaxIdleTime,
 		EventFeed:       feed,
 		Tracer:          tracer,
+
+		ClosedTimestampLag: s.ctx.ClosedTimestampLag,
+		TxnWaitTimeout:     s.ctx.TxnWaitTimeout,
 	}
 	s.node = NewNode(nCtx)
 	s.admin = newAdminServer(s.db, s.stopper)
@@ -184,10 +191,25 @@ func (s *Server) Start(selfBootstrap bool) error {
 		return err
 	}
 
+	// The SQL server leases table descriptors on behalf of this node.
+	leaseMgr := sql.NewLeaseManager(uint32(s.node.Descriptor.NodeID), s.db, s.clock)
+	s.sqlServer = sql.NewServer(&s.ctx.Context, s.db, leaseMgr)
+	s.sqlServer.Start(s.stopper)
+	s.sqlServer.SetSlowQueryThreshold(s.ctx.SlowQueryThreshold)
+	s.status.sqlServer = s.sqlServer
+
+	s.pgServer = pgwire.NewServer(&s.ctx.Context, s.sqlServer)
+	if err := s.pgServer.Start(s.ctx.PGAddr, s.stopper); err != nil {
+		return util.Errorf("could not listen on %s: %s", s.ctx.PGAddr, err)
+	}
+
 	// Begin recording runtime statistics.
 	runtime := status.NewRuntimeStatRecorder(s.node.Descriptor.NodeID, s.clock)
 	s.tsDB.PollSource(runtime, s.ctx.MetricsFrequency, ts.Resolution10s, s.stopper)
 
+	// Begin recording SQL statement statistics.
+	s.tsDB.PollSource(s.sqlServer, s.ctx.MetricsFrequency, ts.Resolution10s, s.stopper)
+
 	// Begin recording time series data collected by the status monitor.
 	s.recorder = status.NewNodeStatusRecorder(s.node.status, s.clock)
 	s.tsDB.PollSource(s.recorder, s.ctx.MetricsFrequency, ts.Resolution10s, s.stopper)
@@ -196,6 +218,7 @@ func (s *Server) Start(selfBootstrap bool) error {
 	s.startWriteSummaries()
 
 	log.Infof("starting %s server at %s", s.ctx.RequestScheme(), s.rpc.Addr())
+	log.Infof("starting postgres server at %s", s.pgServer.Addr())
 	// TODO(spencer): go1.5 is supposed to allow shutdown of running http server.
 	s.initHTTP()
 	s.rpc.Serve(s)
@@ -31,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -52,6 +53,8 @@ const (
 										   goroutines
 		/_status/nodes				     - all nodes' status
 		/_status/nodes/:node_id		     - a specific node's status
+		/_status/queries/:node_id        - SQL statements running on a node
+		/_status/statements/:node_id     - statistics of a node's SQL statements
 		/_status/stores                  - all stores' status
 		/_status/stores/:store_id        - a specific store's status
 	*/
@@ -85,6 +88,13 @@ const (
 	// statusNodePattern exposes status for a single node.
 	statusNodePattern = "/_status/nodes/:node_id"
 
+	// statusQueriesPattern exposes the SQL statements running on a node.
+	statusQueriesPattern = "/_status/queries/:node_id"
+
+	// statusStatementsPattern exposes the statistics of the SQL statements
+	// executed by a node.
+	statusStatementsPattern = "/_status/statements/:node_id"
+
 	// statusStoresPrefix exposes status for all stores in the cluster.
 	statusStoresPrefix = "/_status/stores/"
 	// statusStorePattern exposes status for a single store.
@@ -105,6 +115,7 @@ type statusServer struct {
 	router      *httprouter.Router
 	ctx         *Context
 	proxyClient *http.Client
+	sqlServer   *sql.Server // set once the node has started
 }
 
 // newStatusServer allocates and returns a statusServer.
@@ -136,6 +147,8 @@ func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *status
 	server.router.GET(statusStacksPattern, server.handleStacks)
 	server.router.GET(statusNodesPrefix, server.handleNodesStatus)
 	server.router.GET(statusNodePattern, server.handleNodeStatus)
+	server.router.GET(statusQueriesPattern, server.handleQueries)
+	server.router.GET(statusStatementsPattern, server.handleStatements)
 	server.router.GET(statusStoresPrefix, server.handleStoresStatus)
 	server.router.GET(statusStorePattern, server.handleStoreStatus)
 
@@ -270,6 +283,76 @@ func (s *statusServer) handleDetails(w http.ResponseWriter, r *http.Request, ps
 	}
 }
 
+// handleQueriesLocal handles local requests for the running SQL statements.
+func (s *statusServer) handleQueriesLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	local := struct {
+		Queries []sql.RunningQuery `json:"queries"`
+	}{}
+	if s.sqlServer != nil {
+		local.Queries = s.sqlServer.RunningQueries()
+	}
+	b, contentType, err := util.MarshalResponse(r, local, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, contentType)
+	w.Write(b)
+}
+
+// handleQueries handles GET requests for the running SQL statements.
+func (s *statusServer) handleQueries(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if local {
+		s.handleQueriesLocal(w, r, ps)
+	} else {
+		s.proxyRequest(nodeID, w, r)
+	}
+}
+
+// handleStatementsLocal handles local requests for SQL statement statistics.
+func (s *statusServer) handleStatementsLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	local := struct {
+		LatencyBuckets []string             `json:"latencyBuckets"`
+		Statements     []sql.StatementStats `json:"statements"`
+	}{}
+	for _, bucket := range sql.StatementLatencyBuckets {
+		local.LatencyBuckets = append(local.LatencyBuckets, bucket.String())
+	}
+	if s.sqlServer != nil {
+		local.Statements = s.sqlServer.StatementStats()
+	}
+	b, contentType, err := util.MarshalResponse(r, local, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, contentType)
+	w.Write(b)
+}
+
+// handleStatements handles GET requests for SQL statement statistics.
+func (s *statusServer) handleStatements(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if local {
+		s.handleStatementsLocal(w, r, ps)
+	} else {
+		s.proxyRequest(nodeID, w, r)
+	}
+}
+
 // handleLogFilesList handles local requests for a list of available log files.
 func (s *statusServer) handleLogFilesListLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	log.Flush()
@@ -0,0 +1,186 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// AlterTable applies schema changes to a table. CHECK and FOREIGN KEY
+// constraints can be added, verifying the existing rows of the table.
+// Privileges: CREATE on table.
+func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
+	var err error
+	n.Table, err = p.normalizeTableName(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	dbDesc, err := p.getDatabaseDesc(n.Table.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	desc := structured.TableDescriptor{}
+	nameKey := keys.MakeNameMetadataKey(dbDesc.ID, n.Table.Table())
+	if found, err := p.getDescriptor(nameKey, &desc); err != nil {
+		return nil, err
+	} else if !found {
+		if n.IfExists {
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("table \"%s\" does not exist", n.Table)
+	}
+	if desc.IsSequence {
+		return nil, fmt.Errorf("\"%s\" is a sequence", desc.Name)
+	}
+	if err := p.checkPrivilege(&desc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
+	for _, cmd := range n.Cmds {
+		switch t := cmd.(type) {
+		case *parser.AlterTableAddConstraint:
+			switch d := t.ConstraintDef.(type) {
+			case *parser.CheckConstraintTableDef:
+				err = p.addCheck(desc.ID, d)
+			case *parser.ForeignKeyTableDef:
+				err = p.addForeignKey(desc.ID, d)
+			default:
+				err = fmt.Errorf("unsupported constraint: %s", d)
+			}
+		default:
+			err = fmt.Errorf("unsupported alter table command: %s", cmd)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// addCheck adds a CHECK constraint to a table. The constraint is published
+// before the existing rows are verified so that rows written concurrently
+// are checked as well. The constraint is removed again if an existing row
+// violates it.
+func (p *planner) addCheck(tableID uint32, d *parser.CheckConstraintTableDef) error {
+	var check structured.CheckConstraint
+	desc, err := p.leaseMgr.Publish(tableID, func(desc *structured.TableDescriptor) error {
+		if err := addCheckConstraint(desc, d.Name, d.Expr); err != nil {
+			return err
+		}
+		check = desc.Checks[len(desc.Checks)-1]
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := p.leaseMgr.WaitForOneVersion(tableID); err != nil {
+		return err
+	}
+
+	if err := p.validateCheck(desc, check); err != nil {
+		if _, rerr := p.leaseMgr.Publish(tableID, func(desc *structured.TableDescriptor) error {
+			if i := findCheck(desc, check.Name); i >= 0 {
+				desc.Checks = append(desc.Checks[:i], desc.Checks[i+1:]...)
+			}
+			return nil
+		}); rerr != nil {
+			return rerr
+		}
+		return err
+	}
+	return nil
+}
+
+// addForeignKey adds a FOREIGN KEY constraint to a table. The reference is
+// recorded in the referenced table and the foreign key published before the
+// existing rows are verified, and both are removed again if an existing row
+// violates the constraint.
+func (p *planner) addForeignKey(tableID uint32, d *parser.ForeignKeyTableDef) error {
+	refDesc, err := p.getTableDesc(d.Table)
+	if err != nil {
+		return err
+	}
+	if refDesc.IsSequence {
+		return fmt.Errorf("\"%s\" is a sequence", refDesc.Name)
+	}
+	if refDesc.ID == tableID {
+		return fmt.Errorf("foreign keys referencing the same table are not supported")
+	}
+
+	if err := p.updateReferencedBy(tableID, []uint32{refDesc.ID}, true); err != nil {
+		return err
+	}
+	published := false
+	desc, err := p.leaseMgr.Publish(tableID, func(desc *structured.TableDescriptor) error {
+		fk, err := makeForeignKey(desc, refDesc, d)
+		if err != nil {
+			return err
+		}
+		for _, other := range desc.ForeignKeys {
+			if other.Name == fk.Name {
+				return fmt.Errorf("foreign key \"%s\" already exists", fk.Name)
+			}
+		}
+		desc.ForeignKeys = append(desc.ForeignKeys, fk)
+		return nil
+	})
+	if err == nil {
+		published = true
+		if err = p.leaseMgr.WaitForOneVersion(tableID); err == nil {
+			err = p.validateForeignKeys(desc)
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	// Remove the foreign key if it was published, along with the reference to
+	// the referenced table unless another foreign key references it.
+	var rerr error
+	if published {
+		fkName := desc.ForeignKeys[len(desc.ForeignKeys)-1].Name
+		desc, rerr = p.leaseMgr.Publish(tableID, func(desc *structured.TableDescriptor) error {
+			for i := range desc.ForeignKeys {
+				if desc.ForeignKeys[i].Name == fkName {
+					desc.ForeignKeys = append(desc.ForeignKeys[:i], desc.ForeignKeys[i+1:]...)
+					break
+				}
+			}
+			return nil
+		})
+	} else {
+		desc = &structured.TableDescriptor{}
+		rerr = p.db.GetProto(keys.MakeDescMetadataKey(tableID), desc)
+	}
+	if rerr != nil {
+		return rerr
+	}
+	for _, id := range referencedTableIDs(desc) {
+		if id == refDesc.ID {
+			return err
+		}
+	}
+	if rerr := p.updateReferencedBy(tableID, []uint32{refDesc.ID}, false); rerr != nil {
+		return rerr
+	}
+	return err
+}
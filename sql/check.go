@@ -0,0 +1,163 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// addCheckConstraint adds a CHECK constraint to the table descriptor. The
+// expression may only reference the columns of the table. An unnamed
+// constraint is named after the columns it references.
+func addCheckConstraint(desc *structured.TableDescriptor, name parser.Name,
+	expr parser.Expr) error {
+	v := checkVisitor{desc: desc}
+	parser.WalkExpr(&v, expr)
+	if v.err != nil {
+		return v.err
+	}
+
+	check := structured.CheckConstraint{Name: string(name), Expr: expr.String()}
+	if check.Name == "" {
+		base := strings.Join(append([]string{"check"}, v.cols...), "_")
+		check.Name = base
+		for i := 1; findCheck(desc, check.Name) >= 0; i++ {
+			check.Name = fmt.Sprintf("%s_%d", base, i)
+		}
+	} else if findCheck(desc, check.Name) >= 0 {
+		return fmt.Errorf("check constraint \"%s\" already exists", check.Name)
+	}
+	desc.Checks = append(desc.Checks, check)
+	return nil
+}
+
+// findCheck returns the index of the named CHECK constraint of the table, or
+// -1 if there is no such constraint.
+func findCheck(desc *structured.TableDescriptor, name string) int {
+	for i := range desc.Checks {
+		if desc.Checks[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkVisitor verifies that the column references of a CHECK expression
+// are columns of the table and collects their names.
+type checkVisitor struct {
+	desc *structured.TableDescriptor
+	cols []string
+	err  error
+}
+
+var _ parser.Visitor = &checkVisitor{}
+
+func (v *checkVisitor) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	switch t := expr.(type) {
+	case parser.QualifiedName:
+		col, err := v.desc.FindColumnByName(t.String())
+		if err != nil {
+			v.err = err
+			return expr
+		}
+		for _, name := range v.cols {
+			if name == col.Name {
+				return expr
+			}
+		}
+		v.cols = append(v.cols, col.Name)
+	case *parser.Subquery, *parser.ExistsExpr:
+		v.err = fmt.Errorf("subqueries are not supported in check constraints")
+	}
+	return expr
+}
+
+// parseChecks parses the expressions of the CHECK constraints of the table.
+func parseChecks(desc *structured.TableDescriptor) ([]parser.Expr, error) {
+	exprs := make([]parser.Expr, len(desc.Checks))
+	for i, check := range desc.Checks {
+		expr, err := parser.ParseExpr(check.Expr)
+		if err != nil {
+			return nil, err
+		}
+		exprs[i] = expr
+	}
+	return exprs, nil
+}
+
+// checkRow verifies that a row satisfies the CHECK constraints of the table,
+// whose parsed expressions are supplied. colMap maps column IDs to the index
+// of their value within the row; the other columns are NULL. A constraint is
+// only violated if it evaluates to false.
+func checkRow(desc *structured.TableDescriptor, exprs []parser.Expr,
+	colMap map[uint32]int, row parser.DTuple) error {
+	vals := valMap{}
+	for _, col := range desc.Columns {
+		if i, ok := colMap[col.ID]; ok {
+			vals[col.Name] = row[i]
+		} else {
+			vals[col.Name] = parser.DNull{}
+		}
+	}
+	for i, expr := range exprs {
+		d, err := parser.EvalExpr(expr, vals)
+		if err != nil {
+			return err
+		}
+		if d == (parser.DNull{}) {
+			continue
+		}
+		v, ok := d.(parser.DBool)
+		if !ok {
+			return fmt.Errorf("check constraint \"%s\" did not evaluate to a boolean",
+				desc.Checks[i].Name)
+		}
+		if !v {
+			return fmt.Errorf("row violates check constraint \"%s\" (%s)",
+				desc.Checks[i].Name, desc.Checks[i].Expr)
+		}
+	}
+	return nil
+}
+
+// validateCheck verifies that the existing rows of the table satisfy the
+// CHECK constraint.
+func (p *planner) validateCheck(desc *structured.TableDescriptor,
+	check structured.CheckConstraint) error {
+	expr, err := parser.ParseExpr(check.Expr)
+	if err != nil {
+		return err
+	}
+	return p.db.Txn(func(txn *client.Txn) error {
+		plan, err := p.scanTable(txn, desc, &parser.NotExpr{Expr: expr})
+		if err != nil {
+			return err
+		}
+		if plan.Next() {
+			return fmt.Errorf("existing row violates check constraint \"%s\" (%s)",
+				check.Name, check.Expr)
+		}
+		return plan.Err()
+	})
+}
@@ -21,40 +21,38 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/structured"
 )
 
 // CreateDatabase creates a database.
+// Privileges: only the root user may create databases.
 func (p *planner) CreateDatabase(n *parser.CreateDatabase) (planNode, error) {
 	if n.Name == "" {
 		return nil, errEmptyDatabaseName
 	}
 
-	nameKey := keys.MakeNameMetadataKey(structured.RootNamespaceID, strings.ToLower(string(n.Name)))
-	if gr, err := p.db.Get(nameKey); err != nil {
-		return nil, err
-	} else if gr.Exists() {
-		if n.IfNotExists {
-			return &valuesNode{}, nil
-		}
-		return nil, fmt.Errorf("database \"%s\" already exists", n.Name)
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to create databases", security.RootUser)
 	}
-	ir, err := p.db.Inc(keys.DescIDGenerator, 1)
-	if err != nil {
-		return nil, err
+
+	desc := structured.DatabaseDescriptor{
+		Name:       strings.ToLower(string(n.Name)),
+		Privileges: structured.NewDefaultPrivilegeDescriptor(),
 	}
-	nsID := uint32(ir.ValueInt() - 1)
-	// TODO(pmattis): Need to handle if-not-exists here as well.
-	if err := p.db.CPut(nameKey, nsID, nil); err != nil {
+	nameKey := keys.MakeNameMetadataKey(structured.RootNamespaceID, desc.Name)
+	if _, err := p.createDescriptor(nameKey, &desc, n.IfNotExists); err != nil {
 		return nil, err
 	}
 	return &valuesNode{}, nil
 }
 
 // CreateTable creates a table.
+// Privileges: CREATE on database.
+// The new table inherits the privileges of its database.
 func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 	var err error
 	n.Table, err = p.normalizeTableName(n.Table)
@@ -62,52 +60,41 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 		return nil, err
 	}
 
-	dbID, err := p.lookupDatabase(n.Table.Database())
+	dbDesc, err := p.getDatabaseDesc(n.Table.Database())
 	if err != nil {
 		return nil, err
 	}
 
+	if err := p.checkPrivilege(dbDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
 	desc, err := makeTableDesc(n)
 	if err != nil {
 		return nil, err
 	}
+	// Inherit permissions from the database descriptor.
+	desc.Privileges = dbDesc.GetPrivileges()
 	if err := desc.AllocateIDs(); err != nil {
 		return nil, err
 	}
-
-	nameKey := keys.MakeNameMetadataKey(dbID, n.Table.Table())
-
-	// This isn't strictly necessary as the conditional put below will fail if
-	// the key already exists, but it seems good to avoid the table ID allocation
-	// in most cases when the table already exists.
-	if gr, err := p.db.Get(nameKey); err != nil {
-		return nil, err
-	} else if gr.Exists() {
-		if n.IfNotExists {
-			return &valuesNode{}, nil
-		}
-		return nil, fmt.Errorf("table \"%s\" already exists", n.Table)
-	}
-
-	ir, err := p.db.Inc(keys.DescIDGenerator, 1)
+	refIDs, err := p.resolveForeignKeys(n, &desc)
 	if err != nil {
 		return nil, err
 	}
-	desc.ID = uint32(ir.ValueInt() - 1)
 
-	// TODO(pmattis): Be cognizant of error messages when this is ported to the
-	// server. The error currently returned below is likely going to be difficult
-	// to interpret.
-	// TODO(pmattis): Need to handle if-not-exists here as well.
-	err = p.db.Txn(func(txn *client.Txn) error {
-		descKey := keys.MakeDescMetadataKey(desc.ID)
-		b := &client.Batch{}
-		b.CPut(nameKey, descKey, nil)
-		b.Put(descKey, &desc)
-		return txn.Commit(b)
-	})
+	nameKey := keys.MakeNameMetadataKey(dbDesc.ID, n.Table.Table())
+	created, err := p.createDescriptor(nameKey, &desc, n.IfNotExists)
 	if err != nil {
 		return nil, err
 	}
+	if created {
+		// Record the references to the new table in the descriptors of the
+		// tables it references, so that deletions from those tables can find the
+		// referencing rows.
+		if err := p.updateReferencedBy(desc.ID, refIDs, true); err != nil {
+			return nil, err
+		}
+	}
 	return &valuesNode{}, nil
 }
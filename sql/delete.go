@@ -21,15 +21,21 @@ import (
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 )
 
 // Delete deletes rows from a table.
+// Privileges: DELETE and SELECT on table.
 func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	tableDesc, err := p.getAliasedTableDesc(n.Table)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := p.checkPrivilege(tableDesc, privilege.DELETE); err != nil {
+		return nil, err
+	}
+
 	// TODO(tamird,pmattis): avoid going through Select to avoid encoding
 	// and decoding keys. Also, avoiding Select may provide more
 	// convenient access to index keys which we are not currently
@@ -57,26 +63,36 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	index := tableDesc.Indexes[0]
 	indexKey := encodeIndexKeyPrefix(tableDesc.ID, index.ID)
 
-	b := client.Batch{}
-
+	var rows []parser.DTuple
 	for node.Next() {
-		if err := node.Err(); err != nil {
-			return nil, err
-		}
-
-		// TODO(tamird/pmattis): delete the secondary indexes too
-		primaryKey, err := encodeIndexKey(index, colMap, node.Values(), indexKey)
-		if err != nil {
-			return nil, err
-		}
-		rowStartKey := proto.Key(primaryKey)
-		b.DelRange(rowStartKey, rowStartKey.PrefixEnd())
+		rows = append(rows, append(parser.DTuple(nil), node.Values()...))
 	}
-
-	if err := p.db.Run(&b); err != nil {
+	if err := node.Err(); err != nil {
 		return nil, err
 	}
 
-	// TODO(tamird/pmattis): return the number of affected rows
+	// The rows referencing the deleted rows are found and deleted or the
+	// deletion is refused within the transaction deleting the rows.
+	if err := p.txn(func(txn *client.Txn) error {
+		b := client.Batch{}
+		visited := map[string]struct{}{}
+		for _, row := range rows {
+			// TODO(tamird/pmattis): delete the secondary indexes too
+			primaryKey, err := encodeIndexKey(index, colMap, row, indexKey)
+			if err != nil {
+				return err
+			}
+			visited[string(primaryKey)] = struct{}{}
+			rowStartKey := proto.Key(primaryKey)
+			b.DelRange(rowStartKey, rowStartKey.PrefixEnd())
+			if err := p.deleteReferencingRows(txn, &b, tableDesc, colMap, row, visited); err != nil {
+				return err
+			}
+		}
+		return txn.Commit(&b)
+	}); err != nil {
+		return nil, err
+	}
+	p.rowsAffected = int64(len(rows))
 	return &valuesNode{}, nil
 }
@@ -0,0 +1,132 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+// distinctNode filters out duplicate rows. If the rows are ordered, equal
+// rows are adjacent to each other within a run of rows with equal values in
+// the ordering columns, and only the rows of the current run are
+// remembered.
+type distinctNode struct {
+	plan     planNode
+	ordering []columnOrderInfo
+	// inOrdering marks the columns which are part of the ordering.
+	inOrdering []bool
+	// prefix is the encoding of the ordering columns of the current run.
+	prefix string
+	seen   map[string]struct{}
+	err    error
+}
+
+var _ planNode = &distinctNode{}
+
+func newDistinctNode(plan planNode, ordering []columnOrderInfo) *distinctNode {
+	n := &distinctNode{
+		plan:       plan,
+		ordering:   ordering,
+		inOrdering: make([]bool, len(plan.Columns())),
+		seen:       map[string]struct{}{},
+	}
+	for _, o := range ordering {
+		n.inOrdering[o.colIdx] = true
+	}
+	return n
+}
+
+func (n *distinctNode) Columns() []string {
+	return n.plan.Columns()
+}
+
+func (n *distinctNode) Values() parser.DTuple {
+	return n.plan.Values()
+}
+
+func (n *distinctNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	for n.plan.Next() {
+		row := n.plan.Values()
+		if len(n.ordering) > 0 {
+			var prefix []byte
+			for _, o := range n.ordering {
+				if prefix, n.err = encodeDatumKey(prefix, row[o.colIdx]); n.err != nil {
+					return false
+				}
+			}
+			if string(prefix) != n.prefix {
+				n.prefix = string(prefix)
+				n.seen = map[string]struct{}{}
+			}
+		}
+		var key []byte
+		for i, d := range row {
+			if n.inOrdering[i] {
+				continue
+			}
+			if key, n.err = encodeDatumKey(key, d); n.err != nil {
+				return false
+			}
+		}
+		if _, ok := n.seen[string(key)]; ok {
+			continue
+		}
+		n.seen[string(key)] = struct{}{}
+		return true
+	}
+	n.err = n.plan.Err()
+	return false
+}
+
+func (n *distinctNode) Err() error {
+	return n.err
+}
+
+// Tags distinguishing the types of the values encoded by encodeDatumKey.
+const (
+	nullKeyTag = iota
+	boolKeyTag
+	intKeyTag
+	floatKeyTag
+	stringKeyTag
+)
+
+// encodeDatumKey appends an encoding of the value to the key such that the
+// encodings of rows are equal if and only if their values are.
+func encodeDatumKey(key []byte, d parser.Datum) ([]byte, error) {
+	switch t := d.(type) {
+	case parser.DNull:
+		return append(key, nullKeyTag), nil
+	case parser.DBool:
+		if t {
+			return encoding.EncodeVarint(append(key, boolKeyTag), 1), nil
+		}
+		return encoding.EncodeVarint(append(key, boolKeyTag), 0), nil
+	case parser.DInt:
+		return encoding.EncodeVarint(append(key, intKeyTag), int64(t)), nil
+	case parser.DFloat:
+		return encoding.EncodeNumericFloat(append(key, floatKeyTag), float64(t)), nil
+	case parser.DString:
+		return encoding.EncodeBytes(append(key, stringKeyTag), []byte(t)), nil
+	}
+	return nil, fmt.Errorf("unable to compare values of type %s", d.Type())
+}
@@ -58,37 +58,91 @@ func (c *conn) Exec(stmt string, args []driver.Value) (driver.Result, error) {
 	if err != nil {
 		return nil, err
 	}
-	return driver.RowsAffected(len(rows.rows)), nil
+	defer rows.Close()
+	// The result of the last statement determines the number of rows
+	// affected: the rows written by an INSERT, UPDATE or DELETE, or else
+	// the rows returned.
+	for {
+		n, err := rows.drain()
+		if err != nil {
+			return nil, err
+		}
+		if !rows.HasNextResultSet() {
+			if rows.rowsAffected > 0 {
+				return driver.RowsAffected(rows.rowsAffected), nil
+			}
+			return driver.RowsAffected(n), nil
+		}
+		if err := rows.NextResultSet(); err != nil {
+			return nil, err
+		}
+	}
 }
 
 func (c *conn) Query(stmt string, args []driver.Value) (*rows, error) {
-	// TODO(vivek): Add the args to the Call.
-	return c.send(Request{RequestHeader: RequestHeader{Session: c.session}, Sql: stmt})
+	params := make([]Datum, 0, len(args))
+	for _, arg := range args {
+		var param Datum
+		switch value := arg.(type) {
+		case nil:
+		case bool:
+			param.BoolVal = &value
+		case int64:
+			param.IntVal = &value
+		case float64:
+			param.FloatVal = &value
+		case []byte:
+			param.BytesVal = value
+		case string:
+			param.StringVal = &value
+		default:
+			return nil, fmt.Errorf("unsupported type %T for parameter", arg)
+		}
+		params = append(params, param)
+	}
+	resp, err := c.send(Request{RequestHeader: RequestHeader{Session: c.session}, Sql: stmt, Params: params})
+	if err != nil {
+		return nil, err
+	}
+	r := &rows{conn: c}
+	r.setResponse(resp)
+	if len(r.pending) > 0 {
+		r.setResult(r.pending[0])
+		r.pending = r.pending[1:]
+	}
+	return r, nil
 }
 
-// send sends the call to the server.
-func (c *conn) send(args Request) (*rows, error) {
+// send sends the call to the server. The session is updated once the
+// server has returned all of the results of a request.
+func (c *conn) send(args Request) (Response, error) {
 	resp, err := c.sender.Send(args)
 	if err != nil {
-		return nil, err
+		return Response{}, err
 	}
 	if resp.Error != nil {
-		return nil, resp.Error
-	}
-	c.session = resp.Session
-	// Translate into rows
-	r := &rows{}
-	// Only use the last result to populate the response
-	index := len(resp.Results) - 1
-	if index < 0 {
-		return r, nil
+		return Response{}, resp.Error
 	}
-	result := resp.Results[index]
-	r.columns = make([]string, len(result.Columns))
-	for i, column := range result.Columns {
-		r.columns[i] = column
+	if resp.Cursor == 0 && !args.Close {
+		c.session = resp.Session
 	}
-	r.rows = make([]row, len(result.Rows))
+	return resp, nil
+}
+
+// fetch requests the remaining results of a request from the server.
+func (c *conn) fetch(cursor int64) (Response, error) {
+	return c.send(Request{RequestHeader: RequestHeader{Session: c.session}, Cursor: cursor})
+}
+
+// closeCursor discards the remaining results of a request.
+func (c *conn) closeCursor(cursor int64) error {
+	_, err := c.send(Request{RequestHeader: RequestHeader{Session: c.session}, Cursor: cursor, Close: true})
+	return err
+}
+
+// decodeRows translates the rows of a result into driver values.
+func decodeRows(result Result) []row {
+	rows := make([]row, len(result.Rows))
 	for i, p := range result.Rows {
 		t := make(row, len(p.Values))
 		for j, datum := range p.Values {
@@ -107,7 +161,7 @@ func (c *conn) send(args Request) (*rows, error) {
 				panic(fmt.Sprintf("unsupported type %T returned by database", t[j]))
 			}
 		}
-		r.rows[i] = t
+		rows[i] = t
 	}
-	return r, nil
+	return rows
 }
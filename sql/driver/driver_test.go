@@ -18,12 +18,20 @@
 package driver_test
 
 import (
+	"bytes"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/server"
+	csql "github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
@@ -46,7 +54,11 @@ func cleanup(s *server.TestServer, db *sql.DB) {
 
 func readAll(t *testing.T, rows *sql.Rows) [][]string {
 	defer rows.Close()
+	return readResult(t, rows)
+}
 
+// readResult reads the rows of the current result set.
+func readResult(t *testing.T, rows *sql.Rows) [][]string {
 	cols, err := rows.Columns()
 	if err != nil {
 		t.Fatal(err)
@@ -343,8 +355,12 @@ func TestInsertSelectDelete(t *testing.T) {
 		if _, err := db.Exec(fmt.Sprintf(`INSERT INTO %s.kv (v) VALUES ('a')`, testcase.db)); !isError(err, "missing .* primary key column") {
 			t.Fatal(err)
 		}
-		if _, err := db.Exec(fmt.Sprintf(`INSERT INTO %s.kv (k,v) VALUES ('a', 'b'), ('c', 'd')`, testcase.db)); err != nil {
+		if res, err := db.Exec(fmt.Sprintf(`INSERT INTO %s.kv (k,v) VALUES ('a', 'b'), ('c', 'd')`, testcase.db)); err != nil {
+			t.Fatal(err)
+		} else if n, err := res.RowsAffected(); err != nil {
 			t.Fatal(err)
+		} else if n != 2 {
+			t.Fatalf("expected 2 rows affected, but found %d", n)
 		}
 		if _, err := db.Exec(fmt.Sprintf(`INSERT INTO %s.kv VALUES ('e', 'f')`, testcase.db)); err != nil {
 			t.Fatal(err)
@@ -381,8 +397,12 @@ func TestInsertSelectDelete(t *testing.T) {
 			}
 		}
 
-		if _, err := db.Exec(fmt.Sprintf(`DELETE FROM %s.kv WHERE k IN ('a', 'c')`, testcase.db)); err != nil {
+		if res, err := db.Exec(fmt.Sprintf(`DELETE FROM %s.kv WHERE k IN ('a', 'c')`, testcase.db)); err != nil {
 			t.Fatal(err)
+		} else if n, err := res.RowsAffected(); err != nil {
+			t.Fatal(err)
+		} else if n != 2 {
+			t.Fatalf("expected 2 rows affected, but found %d", n)
 		}
 
 		if rows, err := db.Query(fmt.Sprintf(`SELECT * FROM %s.kv WHERE k IN ('a', 'c')`, testcase.db)); err != nil {
@@ -533,3 +553,1205 @@ func TestInsecure(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDropTable(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('a', 'b');
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("DROP TABLE t.kv"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Query("SELECT * FROM t.kv"); !isError(err, "table .* does not exist") {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("DROP TABLE t.kv"); !isError(err, "table .* does not exist") {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("DROP TABLE IF EXISTS t.kv"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Recreating the table must not resurrect the old data.
+	if _, err := db.Exec("CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR)"); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := db.Query("SELECT * FROM t.kv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results := readAll(t, rows); len(results) != 1 {
+		t.Fatalf("expected no rows, but found %s", results[1:])
+	}
+
+	if _, err := db.Exec("DROP DATABASE t"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Query("SHOW TABLES FROM t"); !isError(err, "database .* does not exist") {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("DROP DATABASE IF EXISTS t"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Database names are lowercased when dropping just as when creating.
+	if _, err := db.Exec("CREATE DATABASE Mixed"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("DROP DATABASE MIXED"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGrantRevoke(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	userDB, err := sql.Open("cockroach", "https://test-user@"+s.ServingAddr()+"?certs=test_certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = userDB.Close()
+	}()
+
+	if _, err := userDB.Exec("CREATE DATABASE u"); !isError(err, "only root is allowed to create databases") {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := userDB.Query("SELECT * FROM t.kv"); !isError(err, "user test-user does not have SELECT privilege on table t.kv") {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Exec("INSERT INTO t.kv VALUES ('a', 'b')"); !isError(err, "user test-user does not have INSERT privilege on table t.kv") {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Exec("CREATE TABLE t.foo (k CHAR PRIMARY KEY)"); !isError(err, "user test-user does not have CREATE privilege on database t") {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Exec(`GRANT SELECT ON t.kv TO "test-user"`); !isError(err, "user test-user does not have GRANT privilege on table t.kv") {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`GRANT SELECT, INSERT ON t.kv TO "test-user"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Exec("INSERT INTO t.kv VALUES ('a', 'b')"); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := userDB.Query("SELECT * FROM t.kv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{{"k", "v"}, {"a", "b"}}
+	if results := readAll(t, rows); !reflect.DeepEqual(expected, results) {
+		t.Fatalf("expected %s, but got %s", expected, results)
+	}
+	if _, err := userDB.Exec("DELETE FROM t.kv"); !isError(err, "user test-user does not have DELETE privilege on table t.kv") {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Exec("DROP TABLE t.kv"); !isError(err, "user test-user does not have DROP privilege on table t.kv") {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`REVOKE INSERT ON t.kv FROM "test-user"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Exec("INSERT INTO t.kv VALUES ('c', 'd')"); !isError(err, "user test-user does not have INSERT privilege on table t.kv") {
+		t.Fatal(err)
+	}
+
+	// New tables inherit the privileges of their database.
+	if _, err := db.Exec(`GRANT CREATE, SELECT ON DATABASE t TO "test-user"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Exec("CREATE TABLE t.foo (k CHAR PRIMARY KEY)"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userDB.Query("SELECT * FROM t.foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The root user must retain ALL privileges.
+	if _, err := db.Exec("REVOKE SELECT ON t.kv FROM root"); !isError(err, "root user does not have ALL privileges") {
+		t.Fatal(err)
+	}
+}
+
+func TestShowGrants(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+GRANT SELECT, DELETE ON DATABASE t TO foo, bar;
+GRANT UPDATE, SELECT ON t.kv TO bar;
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	testData := []struct {
+		sql      string
+		expected [][]string
+	}{
+		{"SHOW GRANTS ON DATABASE t", [][]string{
+			{"Database", "User", "Privileges"},
+			{"t", "bar", "DELETE,SELECT"},
+			{"t", "foo", "DELETE,SELECT"},
+			{"t", "root", "ALL"},
+		}},
+		{"SHOW GRANTS ON t.kv", [][]string{
+			{"Table", "User", "Privileges"},
+			{"t.kv", "bar", "SELECT,UPDATE"},
+			{"t.kv", "root", "ALL"},
+		}},
+		{"SHOW GRANTS ON t.kv FOR bar, foo", [][]string{
+			{"Table", "User", "Privileges"},
+			{"t.kv", "bar", "SELECT,UPDATE"},
+		}},
+	}
+	for _, d := range testData {
+		rows, err := db.Query(d.sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results := readAll(t, rows); !reflect.DeepEqual(d.expected, results) {
+			t.Fatalf("%s: expected %s, but got %s", d.sql, d.expected, results)
+		}
+	}
+
+	if _, err := db.Query("SHOW GRANTS"); !isError(err, "no database specified") {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("SET DATABASE = t"); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := db.Query("SHOW GRANTS FOR root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{{"Database", "User", "Privileges"}, {"t", "root", "ALL"}}
+	if results := readAll(t, rows); !reflect.DeepEqual(expected, results) {
+		t.Fatalf("expected %s, but got %s", expected, results)
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE t.kv (k CHAR PRIMARY KEY, v INT)`); err != nil {
+		t.Fatal(err)
+	}
+	for i, k := range []string{"a", "b", "c"} {
+		if _, err := db.Exec(`INSERT INTO t.kv VALUES ($1, $2)`, k, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := db.Query(`SELECT k, v FROM t.kv WHERE k = $1 OR v = $2`, "a", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := readAll(t, rows)
+	expectedResults := [][]string{
+		{"k", "v"},
+		{"a", "0"},
+		{"c", "2"},
+	}
+	if !reflect.DeepEqual(expectedResults, results) {
+		t.Fatalf("expected %s, but got %s", expectedResults, results)
+	}
+
+	if _, err := db.Exec(`SELECT k FROM t.kv WHERE k = $2`, "a"); !isError(err, `arg \$2 not found`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+}
+
+func TestMultipleResults(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t; CREATE TABLE t.kv (k CHAR PRIMARY KEY, v INT)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv VALUES ('a', 1); INSERT INTO t.kv VALUES ('b', 2), ('c', 3)`); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(`SELECT k FROM t.kv WHERE v < 3; SELECT v FROM t.kv WHERE k = 'c'; SELECT k, v FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	expectedResults := [][][]string{
+		{{"k"}, {"a"}, {"b"}},
+		{{"v"}, {"3"}},
+		{{"k", "v"}, {"a", "1"}, {"b", "2"}, {"c", "3"}},
+	}
+	for i, expected := range expectedResults {
+		if i > 0 && !rows.NextResultSet() {
+			t.Fatalf("%d: expected another result set: %v", i, rows.Err())
+		}
+		if results := readResult(t, rows); !reflect.DeepEqual(expected, results) {
+			t.Fatalf("%d: expected %s, but got %s", i, expected, results)
+		}
+	}
+	if rows.NextResultSet() {
+		t.Fatal("expected no more result sets")
+	}
+}
+
+func TestResultChunks(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(n int) { csql.ResultChunkSize = n }(csql.ResultChunkSize)
+	csql.ResultChunkSize = 3
+
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t; CREATE TABLE t.kv (k INT PRIMARY KEY, v INT)`); err != nil {
+		t.Fatal(err)
+	}
+	const numRows = 10
+	for i := 0; i < numRows; i++ {
+		if _, err := db.Exec(`INSERT INTO t.kv VALUES ($1, $2)`, i, i*i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Each result is returned in several chunks, with the results of the
+	// statements sharing some of them.
+	rows, err := db.Query(`SELECT k, v FROM t.kv; SELECT k FROM t.kv WHERE k < 4; SELECT v FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for i, expectedRows := range []int{numRows, 4, numRows} {
+		if i > 0 && !rows.NextResultSet() {
+			t.Fatalf("%d: expected another result set: %v", i, rows.Err())
+		}
+		if results := readResult(t, rows); len(results)-1 != expectedRows {
+			t.Fatalf("%d: expected %d rows, but got %s", i, expectedRows, results)
+		}
+	}
+	if rows.NextResultSet() {
+		t.Fatal("expected no more result sets")
+	}
+
+	// Skipping over a partially read result set.
+	rows, err = db.Query(`SELECT k FROM t.kv; SELECT v FROM t.kv WHERE k = 9`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rows.Next() {
+		t.Fatal(rows.Err())
+	}
+	if !rows.NextResultSet() {
+		t.Fatalf("expected another result set: %v", rows.Err())
+	}
+	if results := readAll(t, rows); !reflect.DeepEqual([][]string{{"v"}, {"81"}}, results) {
+		t.Fatalf("expected 81, but got %s", results)
+	}
+
+	// Closing a partially read result set discards the remaining rows.
+	rows, err = db.Query(`SELECT k FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rows.Next() {
+		t.Fatal(rows.Err())
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`DELETE FROM t.kv WHERE k >= 5`); err != nil {
+		t.Fatal(err)
+	}
+	rows, err = db.Query(`SELECT k FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results := readAll(t, rows); len(results)-1 != 5 {
+		t.Fatalf("expected 5 rows, but got %s", results)
+	}
+}
+
+func TestSequences(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t; CREATE SEQUENCE t.s`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE SEQUENCE t.s`); !isError(err, `sequence "t.s" already exists`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+	if _, err := db.Exec(`CREATE SEQUENCE IF NOT EXISTS t.s`); err != nil {
+		t.Fatal(err)
+	}
+
+	// currval is not defined until nextval has been called.
+	if _, err := db.Exec(`SELECT currval('t.s')`); !isError(err, `currval of sequence "t.s" is not yet defined`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+
+	// The session is carried from one statement of a request to the next.
+	rows, err := db.Query(`SELECT nextval('t.s'), nextval('t.s'); SELECT currval('t.s')`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	expected := [][]string{{"nextval('t.s')", "nextval('t.s')"}, {"1", "2"}}
+	if results := readResult(t, rows); !reflect.DeepEqual(expected, results) {
+		t.Fatalf("expected %s, but got %s", expected, results)
+	}
+	if !rows.NextResultSet() {
+		t.Fatal(rows.Err())
+	}
+	expected = [][]string{{"currval('t.s')"}, {"2"}}
+	if results := readResult(t, rows); !reflect.DeepEqual(expected, results) {
+		t.Fatalf("expected %s, but got %s", expected, results)
+	}
+
+	// Sequence values can be used as primary keys.
+	if _, err := db.Exec(`CREATE TABLE t.kv (k INT PRIMARY KEY, v CHAR)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv VALUES (nextval('t.s'), 'a'), (nextval('t.s'), 'b')`); err != nil {
+		t.Fatal(err)
+	}
+	rows, err = db.Query(`SELECT k, v FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = [][]string{{"k", "v"}, {"3", "a"}, {"4", "b"}}
+	if results := readAll(t, rows); !reflect.DeepEqual(expected, results) {
+		t.Fatalf("expected %s, but got %s", expected, results)
+	}
+
+	// Sequences are not tables and tables are not sequences.
+	if _, err := db.Exec(`SELECT * FROM t.s`); !isError(err, `"t.s" is a sequence`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+	if _, err := db.Exec(`SELECT nextval('t.kv')`); !isError(err, `"t.kv" is not a sequence`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+	if _, err := db.Exec(`SELECT nextval('t.missing')`); !isError(err, `table "t.missing" does not exist`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+}
+
+func TestDefaultExprs(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE SEQUENCE t.s;
+CREATE TABLE t.kv (k SERIAL PRIMARY KEY, v INT DEFAULT nextval('t.s'), w CHAR DEFAULT 'w');
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv (w) VALUES ('a'), ('b')`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv (v) VALUES (10)`); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(`SELECT k, v, w FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := readAll(t, rows)
+	if len(results) != 4 {
+		t.Fatalf("expected 3 rows, but got %s", results)
+	}
+	// The generated keys are unique and increasing, so the rows are returned
+	// in the order in which they were inserted.
+	keys := map[string]bool{}
+	for _, r := range results[1:] {
+		keys[r[0]] = true
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected unique keys, but got %s", results)
+	}
+	expected := [][]string{{"1", "a"}, {"2", "b"}, {"10", "w"}}
+	for i, r := range results[1:] {
+		if !reflect.DeepEqual(expected[i], r[1:]) {
+			t.Fatalf("%d: expected %s, but got %s", i, expected[i], r[1:])
+		}
+	}
+
+	if _, err := db.Exec(`CREATE TABLE t.bad (k SERIAL PRIMARY KEY DEFAULT 1)`); !isError(err, `SERIAL column "k" cannot have a default`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+}
+
+func TestForeignKeys(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.customers (id INT PRIMARY KEY, email CHAR, CONSTRAINT email UNIQUE (email));
+CREATE TABLE t.orders (id INT PRIMARY KEY, customer INT REFERENCES t.customers ON DELETE CASCADE);
+CREATE TABLE t.items (id INT PRIMARY KEY, ord INT, FOREIGN KEY (ord) REFERENCES t.orders (id) ON DELETE CASCADE);
+CREATE TABLE t.reviews (id INT PRIMARY KEY, email CHAR REFERENCES t.customers (email));
+INSERT INTO t.customers VALUES (1, 'a'), (2, 'b');
+INSERT INTO t.orders VALUES (10, 1), (11, 2);
+INSERT INTO t.items VALUES (100, 10), (101, 11);
+INSERT INTO t.reviews VALUES (1000, 'b');
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`INSERT INTO t.orders VALUES (12, 3)`); !isError(err, `foreign key violation: value \(3\) not found in t.customers \(id\)`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+	// A NULL foreign key does not reference anything.
+	if _, err := db.Exec(`INSERT INTO t.orders (id) VALUES (12)`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The review references customer 2 without cascading.
+	if _, err := db.Exec(`DELETE FROM t.customers WHERE id = 2`); !isError(err, `foreign key violation: t.customers .* is referenced by t.reviews \(foreign key "fk_email_ref_customers"\)`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+	// Deleting customer 1 deletes its order and the items of the order.
+	if _, err := db.Exec(`DELETE FROM t.customers WHERE id = 1`); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		query    string
+		expected []string
+	}{
+		{`SELECT id FROM t.customers`, []string{"2"}},
+		{`SELECT id FROM t.orders`, []string{"11", "12"}},
+		{`SELECT id FROM t.items`, []string{"101"}},
+	} {
+		rows, err := db.Query(c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results := readAll(t, rows)
+		var ids []string
+		for _, r := range results[1:] {
+			ids = append(ids, r[0])
+		}
+		if !reflect.DeepEqual(c.expected, ids) {
+			t.Fatalf("%s: expected %s, but got %s", c.query, c.expected, ids)
+		}
+	}
+
+	// Deleting a row of a cycle of cascading foreign keys deletes each row of
+	// the cycle once.
+	if _, err := db.Exec(`
+CREATE TABLE t.a (id INT PRIMARY KEY, b INT);
+CREATE TABLE t.b (id INT PRIMARY KEY, a INT REFERENCES t.a ON DELETE CASCADE);
+INSERT INTO t.a VALUES (1, 1), (2, 2);
+INSERT INTO t.b VALUES (1, 1), (2, 2);
+ALTER TABLE t.a ADD FOREIGN KEY (b) REFERENCES t.b ON DELETE CASCADE;
+DELETE FROM t.a WHERE id = 1;
+`); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		query    string
+		expected []string
+	}{
+		{`SELECT id FROM t.a`, []string{"2"}},
+		{`SELECT id FROM t.b`, []string{"2"}},
+	} {
+		rows, err := db.Query(c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results := readAll(t, rows)
+		var ids []string
+		for _, r := range results[1:] {
+			ids = append(ids, r[0])
+		}
+		if !reflect.DeepEqual(c.expected, ids) {
+			t.Fatalf("%s: expected %s, but got %s", c.query, c.expected, ids)
+		}
+	}
+
+	if _, err := db.Exec(`CREATE TABLE t.bad (id INT PRIMARY KEY, customer INT REFERENCES t.orders (customer))`); !isError(err, `there is no unique index on columns \(customer\) of "t.orders"`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+
+	if _, err := db.Exec(`DROP TABLE t.customers`); !isError(err, `table "t.customers" is referenced by a foreign key from table "t.(orders|reviews)"`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+	// Dropping the referencing tables together with the referenced table
+	// succeeds.
+	if _, err := db.Exec(`DROP TABLE t.reviews, t.customers, t.orders, t.items, t.a, t.b`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`DROP DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckConstraints(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY CHECK (k > 0), v INT, CONSTRAINT ordered CHECK (k < v));
+INSERT INTO t.kv VALUES (1, 2);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		stmt     string
+		expected string
+	}{
+		{`INSERT INTO t.kv VALUES (0, 1)`, `row violates check constraint "check_k" \(k > 0\)`},
+		{`INSERT INTO t.kv VALUES (3, 2)`, `row violates check constraint "ordered" \(k < v\)`},
+		{`CREATE TABLE t.bad (k INT PRIMARY KEY CHECK (v > 0))`, `column "v" does not exist`},
+		{`ALTER TABLE t.kv ADD CHECK (v > 3)`, `existing row violates check constraint "check_v" \(v > 3\)`},
+		{`ALTER TABLE t.kv ADD CONSTRAINT ordered CHECK (v > 0)`, `check constraint "ordered" already exists`},
+	} {
+		if _, err := db.Exec(c.stmt); !isError(err, c.expected) {
+			t.Fatalf("%s: expected %s, but found %v", c.stmt, c.expected, err)
+		}
+	}
+
+	// A NULL value does not violate a constraint.
+	if _, err := db.Exec(`INSERT INTO t.kv (k) VALUES (2)`); err != nil {
+		t.Fatal(err)
+	}
+	// The failed constraint was removed, so adding it again after fixing the
+	// rows is allowed.
+	if _, err := db.Exec(`DELETE FROM t.kv WHERE k = 1`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`ALTER TABLE t.kv ADD CHECK (v > 3)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv VALUES (1, 3)`); !isError(err, `row violates check constraint "check_v"`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv VALUES (3, 4)`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Foreign keys can be added as well.
+	if _, err := db.Exec(`CREATE TABLE t.refs (id INT PRIMARY KEY, k INT)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.refs VALUES (1, 3), (2, 4)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`ALTER TABLE t.refs ADD FOREIGN KEY (k) REFERENCES t.kv`); !isError(err, `foreign key violation: value \(4\) not found in t.kv`) {
+		t.Fatalf("expected failure, but found %v", err)
+	}
+	if _, err := db.Exec(`DROP TABLE t.kv`); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestViews(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+SET DATABASE = t;
+CREATE TABLE kv (k INT PRIMARY KEY, v INT);
+INSERT INTO kv VALUES (1, 10), (2, 20), (3, 30);
+CREATE VIEW big (id, amount) AS SELECT k, v FROM kv WHERE v > 10;
+CREATE VIEW bigger AS SELECT id, amount * 2 AS double_amount FROM big;
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		query    string
+		expected [][]string
+	}{
+		{`SELECT * FROM big`, [][]string{{"id", "amount"}, {"2", "20"}, {"3", "30"}}},
+		{`SELECT amount FROM t.big WHERE id = 3`, [][]string{{"amount"}, {"30"}}},
+		{`SELECT * FROM bigger WHERE double_amount < 60`, [][]string{{"id", "double_amount"}, {"2", "40"}}},
+	} {
+		rows, err := db.Query(c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results := readAll(t, rows); !reflect.DeepEqual(c.expected, results) {
+			t.Fatalf("%s: expected %s, but got %s", c.query, c.expected, results)
+		}
+	}
+
+	for _, c := range []struct {
+		stmt     string
+		expected string
+	}{
+		{`INSERT INTO big VALUES (4, 40)`, `"big" is a view`},
+		{`CREATE VIEW bad (a) AS SELECT k, v FROM kv`, `view "t.bad" has 2 columns, but 1 column names were specified`},
+		{`CREATE VIEW big AS SELECT k FROM kv`, `view "t.big" already exists`},
+		{`DROP TABLE big`, `"t.big" is not a table`},
+		{`DROP VIEW kv`, `"t.kv" is not a view`},
+		{`DROP TABLE kv`, `table "t.kv" is referenced by view "t.big"`},
+		{`DROP VIEW big`, `view "t.big" is referenced by view "t.bigger"`},
+	} {
+		if _, err := db.Exec(c.stmt); !isError(err, c.expected) {
+			t.Fatalf("%s: expected %s, but found %v", c.stmt, c.expected, err)
+		}
+	}
+
+	if _, err := db.Exec(`DROP VIEW bigger, big`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`DROP TABLE kv`); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDistinctAndSetOperations(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+SET DATABASE = t;
+CREATE TABLE a (k INT PRIMARY KEY, v INT, w CHAR);
+CREATE TABLE b (k INT PRIMARY KEY, v INT);
+INSERT INTO a VALUES (1, 1, 'x'), (2, 1, 'y'), (3, 2, 'x'), (4, 3, 'y'), (5, 3, 'y');
+INSERT INTO b VALUES (1, 1), (2, 1), (3, 3), (4, 4);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		query    string
+		expected [][]string
+	}{
+		{`SELECT DISTINCT v FROM a`, [][]string{{"v"}, {"1"}, {"2"}, {"3"}}},
+		{`SELECT DISTINCT v, w FROM a ORDER BY w DESC, v`,
+			[][]string{{"v", "w"}, {"1", "y"}, {"3", "y"}, {"1", "x"}, {"2", "x"}}},
+		{`SELECT k FROM a ORDER BY v DESC, k LIMIT 3`, [][]string{{"k"}, {"4"}, {"5"}, {"3"}}},
+		{`SELECT k FROM a ORDER BY 1 DESC LIMIT 2 OFFSET 1`, [][]string{{"k"}, {"4"}, {"3"}}},
+		{`SELECT k * 2 AS d FROM a WHERE k < 3 ORDER BY d DESC`, [][]string{{"d"}, {"4"}, {"2"}}},
+		{`SELECT v FROM a UNION SELECT v FROM b ORDER BY v`,
+			[][]string{{"v"}, {"1"}, {"2"}, {"3"}, {"4"}}},
+		{`SELECT v FROM a UNION ALL SELECT v FROM b ORDER BY v DESC LIMIT 4`,
+			[][]string{{"v"}, {"4"}, {"3"}, {"3"}, {"3"}}},
+		{`SELECT v FROM a INTERSECT SELECT v FROM b ORDER BY v`, [][]string{{"v"}, {"1"}, {"3"}}},
+		{`SELECT v FROM a INTERSECT ALL SELECT v FROM b ORDER BY v`,
+			[][]string{{"v"}, {"1"}, {"1"}, {"3"}}},
+		{`SELECT v FROM a EXCEPT SELECT v FROM b`, [][]string{{"v"}, {"2"}}},
+		{`SELECT v FROM a EXCEPT ALL SELECT v FROM b ORDER BY v`, [][]string{{"v"}, {"2"}, {"3"}}},
+		{`VALUES (2), (1) UNION VALUES (1), (3) ORDER BY column1`,
+			[][]string{{"column1"}, {"1"}, {"2"}, {"3"}}},
+	} {
+		rows, err := db.Query(c.query)
+		if err != nil {
+			t.Fatalf("%s: %s", c.query, err)
+		}
+		if results := readAll(t, rows); !reflect.DeepEqual(c.expected, results) {
+			t.Fatalf("%s: expected %s, but got %s", c.query, c.expected, results)
+		}
+	}
+
+	for _, c := range []struct {
+		query    string
+		expected string
+	}{
+		{`SELECT k FROM a UNION SELECT k, v FROM b`, `each UNION query must have the same number of columns: 1 vs 2`},
+		{`SELECT DISTINCT v FROM a ORDER BY k`, `for SELECT DISTINCT, ORDER BY expressions must appear in select list`},
+		{`SELECT k FROM a ORDER BY 2`, `ORDER BY position 2 is not in select list`},
+		{`SELECT k FROM a LIMIT -1`, `LIMIT must not be negative`},
+	} {
+		if _, err := db.Query(c.query); !isError(err, c.expected) {
+			t.Fatalf("%s: expected %s, but found %v", c.query, c.expected, err)
+		}
+	}
+}
+
+func TestSessionVariables(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	// Each statement is sent in a separate request, so the settings must be
+	// carried in the session.
+	for _, stmt := range []string{
+		`CREATE DATABASE t`,
+		`SET DATABASE = t`,
+		`SET default_transaction_isolation = 'snapshot'`,
+		`SET statement_timeout = 1500`,
+		`SET TIME ZONE 'UTC'`,
+		`SET output_format = 'CSV'`,
+		`CREATE TABLE kv (k INT PRIMARY KEY, v INT)`,
+		`INSERT INTO kv VALUES (1, 2)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("%s: %s", stmt, err)
+		}
+	}
+
+	for _, c := range []struct {
+		query    string
+		expected [][]string
+	}{
+		{`SHOW database`, [][]string{{"database"}, {"t"}}},
+		{`SHOW default_transaction_isolation`, [][]string{{"default_transaction_isolation"}, {"SNAPSHOT"}}},
+		{`SHOW TIME ZONE`, [][]string{{"time_zone"}, {"UTC"}}},
+		{`SHOW ALL`, [][]string{
+			{"Variable", "Value"},
+			{"database", "t"},
+			{"default_transaction_isolation", "SNAPSHOT"},
+			{"output_format", "csv"},
+			{"statement_timeout", "1.5s"},
+			{"time_zone", "UTC"},
+		}},
+		{`SELECT v FROM kv`, [][]string{{"v"}, {"2"}}},
+	} {
+		rows, err := db.Query(c.query)
+		if err != nil {
+			t.Fatalf("%s: %s", c.query, err)
+		}
+		if results := readAll(t, rows); !reflect.DeepEqual(c.expected, results) {
+			t.Fatalf("%s: expected %s, but got %s", c.query, c.expected, results)
+		}
+	}
+
+	// DEFAULT restores the default values.
+	for _, stmt := range []string{
+		`SET default_transaction_isolation = DEFAULT`,
+		`SET statement_timeout = '2m'`,
+		`SET output_format TO DEFAULT`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("%s: %s", stmt, err)
+		}
+	}
+	rows, err := db.Query(`SHOW ALL`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{
+		{"Variable", "Value"},
+		{"database", "t"},
+		{"default_transaction_isolation", "SERIALIZABLE"},
+		{"output_format", "table"},
+		{"statement_timeout", "2m0s"},
+		{"time_zone", "UTC"},
+	}
+	if results := readAll(t, rows); !reflect.DeepEqual(expected, results) {
+		t.Fatalf("expected %s, but got %s", expected, results)
+	}
+
+	for _, c := range []struct {
+		stmt     string
+		expected string
+	}{
+		{`SET foo = 1`, `unknown variable: foo`},
+		{`SHOW foo`, `unknown variable: foo`},
+		{`SET default_transaction_isolation = 'read uncommitted'`, `unknown isolation level`},
+		{`SET statement_timeout = -1`, `statement_timeout: must not be negative`},
+		{`SET statement_timeout = 'soon'`, `statement_timeout: time: invalid duration`},
+		{`SET TIME ZONE 'Nowhere/Special'`, `time_zone: unknown time zone "Nowhere/Special"`},
+		{`SET output_format = 'xml'`, `output_format: unknown format "xml"`},
+		{`SET database = 1`, `database: requires a single string value`},
+	} {
+		if _, err := db.Exec(c.stmt); !isError(err, c.expected) {
+			t.Fatalf("%s: expected %s, but found %v", c.stmt, c.expected, err)
+		}
+	}
+}
+
+func TestStatementTimeoutAndCancel(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(n int) { csql.ResultChunkSize = n }(csql.ResultChunkSize)
+	csql.ResultChunkSize = 2
+
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (1, 1), (2, 2), (3, 3), (4, 4), (5, 5);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The timeout applies to the statements following the SET.
+	const timeoutErr = `query exceeded the statement timeout of 1ns`
+	if _, err := db.Exec(`SET statement_timeout = '1ns'; SELECT k FROM t.kv`); !isError(err, timeoutErr) {
+		t.Fatalf("expected %s, but found %v", timeoutErr, err)
+	}
+	if _, err := db.Exec(`SET statement_timeout = 0`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Suspend a statement after its first chunk of rows and cancel it from
+	// another connection.
+	rows, err := db.Query(`SELECT k FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		t.Fatalf("expected a row: %v", rows.Err())
+	}
+
+	queries := getRunningQueries(t, s)
+	if len(queries) != 1 || queries[0].SQL != `SELECT k FROM t.kv` {
+		t.Fatalf("expected the running SELECT, but found %+v", queries)
+	}
+	if _, err := db.Exec(fmt.Sprintf(`CANCEL QUERY %d`, queries[0].ID)); err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+	}
+	if err := rows.Err(); !isError(err, `query canceled`) {
+		t.Fatalf("expected query canceled, but found %v", err)
+	}
+	if queries := getRunningQueries(t, s); len(queries) != 0 {
+		t.Fatalf("expected no running queries, but found %+v", queries)
+	}
+
+	// Query IDs start with the ID of the node executing the statement.
+	nodeID := queries[0].ID >> 32
+	if nodeID == 0 {
+		t.Fatalf("expected the query ID %d to hold the node ID", queries[0].ID)
+	}
+	notFound := nodeID<<32 | 1000
+	if _, err := db.Exec(fmt.Sprintf(`CANCEL QUERY %d`, notFound)); !isError(err, fmt.Sprintf(`query %d not found`, notFound)) {
+		t.Fatalf("expected query not found, but found %v", err)
+	}
+	remote := (nodeID+1)<<32 | 1
+	if _, err := db.Exec(fmt.Sprintf(`CANCEL QUERY %d`, remote)); !isError(err, fmt.Sprintf(`query %d is running on node %d`, remote, nodeID+1)) {
+		t.Fatalf("expected query on another node, but found %v", err)
+	}
+}
+
+// getRunningQueries returns the SQL statements running on the test server.
+func getRunningQueries(t *testing.T, s *server.TestServer) []csql.RunningQuery {
+	var result struct {
+		Queries []csql.RunningQuery `json:"queries"`
+	}
+	getStatus(t, s, "queries", &result)
+	return result.Queries
+}
+
+// getStatus decodes the JSON returned by the test server's status endpoint
+// for the local node.
+func getStatus(t *testing.T, s *server.TestServer, endpoint string, result interface{}) {
+	client, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(s.Ctx.RequestScheme() + "://" + s.ServingAddr() + "/_status/" + endpoint + "/local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatementStats(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (1, 1), (2, 2), (3, 3);
+`); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		rows, err := db.Query(fmt.Sprintf(`SELECT v FROM t.kv WHERE k >= %d`, i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		readAll(t, rows)
+	}
+	if _, err := db.Exec(`SELECT v FROM t.kv WHERE k >= 'a'`); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	var result struct {
+		LatencyBuckets []string              `json:"latencyBuckets"`
+		Statements     []csql.StatementStats `json:"statements"`
+	}
+	getStatus(t, s, "statements", &result)
+	if len(result.LatencyBuckets) != len(csql.StatementLatencyBuckets) {
+		t.Fatalf("expected %d latency buckets, but found %s",
+			len(csql.StatementLatencyBuckets), result.LatencyBuckets)
+	}
+	var stats *csql.StatementStats
+	for i := range result.Statements {
+		if result.Statements[i].Fingerprint == `SELECT v FROM t.kv WHERE k >= _` {
+			stats = &result.Statements[i]
+		}
+	}
+	if stats == nil {
+		t.Fatalf("no statistics for the SELECT in %+v", result.Statements)
+	}
+	// The successful statements read the whole table and return 3, 2 and 1
+	// rows. The failed statement stops at the first row.
+	if stats.Count != 4 || stats.Errors != 1 || stats.RowsRead != 10 || stats.RowsReturned != 6 {
+		t.Errorf("unexpected statistics %+v", stats)
+	}
+	var histogramCount int64
+	for _, n := range stats.LatencyHistogram {
+		histogramCount += n
+	}
+	if histogramCount != stats.Count {
+		t.Errorf("expected %d statements in the latency histogram, but found %d",
+			stats.Count, histogramCount)
+	}
+}
+
+func TestDump(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	values := make([]string, 250)
+	for i := range values {
+		values[i] = fmt.Sprintf("(%d, 'v%d')", i, i)
+	}
+	if _, err := db.Exec(`
+CREATE DATABASE d;
+CREATE TABLE d.parent (id INT PRIMARY KEY, s CHAR, CONSTRAINT parent_s UNIQUE (s));
+CREATE TABLE d.child (
+  id INT PRIMARY KEY,
+  parent_id INT REFERENCES d.parent (id) ON DELETE CASCADE,
+  n INT DEFAULT 1 CHECK (n > 0)
+);
+CREATE TABLE d.kv (k INT PRIMARY KEY, v CHAR NOT NULL);
+CREATE VIEW d.vals AS SELECT s FROM d.parent;
+INSERT INTO d.parent VALUES (1, 'it''s; --'), (2, e'a\nb');
+INSERT INTO d.child VALUES (10, 1, 3);
+INSERT INTO d.child (id, parent_id) VALUES (11, 2);
+INSERT INTO d.kv VALUES ` + strings.Join(values, ", ")); err != nil {
+		t.Fatal(err)
+	}
+
+	kvDB, err := client.Open("https://root@" + s.ServingAddr() + "?certs=test_certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := csql.Dump(kvDB, &buf, "d"); err != nil {
+		t.Fatal(err)
+	}
+	dump := buf.String()
+	if n := strings.Count(dump, "INSERT INTO kv "); n != 3 {
+		t.Errorf("expected the rows of kv to be inserted by 3 statements, but found %d:\n%s", n, dump)
+	}
+	// The child table must be created after the parent table it references.
+	if strings.Index(dump, "CREATE TABLE child") < strings.Index(dump, "CREATE TABLE parent") {
+		t.Errorf("expected parent to be created before child:\n%s", dump)
+	}
+
+	// Restore the dump into another database and compare the contents of the
+	// tables.
+	restore := strings.Replace(dump, "DATABASE IF NOT EXISTS d;", "DATABASE IF NOT EXISTS d2;", 1)
+	restore = strings.Replace(restore, "SET DATABASE = d;", "SET DATABASE = d2;", 1)
+	if _, err := db.Exec(restore); err != nil {
+		t.Fatalf("%s\n%s", err, restore)
+	}
+	for _, table := range []string{"parent", "child", "kv", "vals"} {
+		rows, err := db.Query(fmt.Sprintf("SELECT * FROM d.%s", table))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := readAll(t, rows)
+		if rows, err = db.Query(fmt.Sprintf("SELECT * FROM d2.%s", table)); err != nil {
+			t.Fatal(err)
+		}
+		if results := readAll(t, rows); !reflect.DeepEqual(expected, results) {
+			t.Errorf("%s: expected %q, but found %q", table, expected, results)
+		}
+	}
+
+	// The constraints are restored along with the tables.
+	if _, err := db.Exec(`INSERT INTO d2.child VALUES (12, 3, 1)`); !isError(err, "foreign key") {
+		t.Fatalf("expected a foreign key violation, but found %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO d2.child VALUES (12, 1, 0)`); !isError(err, "check_n") {
+		t.Fatalf("expected a check violation, but found %v", err)
+	}
+
+	// Dumping a subset of the tables.
+	buf.Reset()
+	if err := csql.Dump(kvDB, &buf, "d", "kv"); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "parent") {
+		t.Errorf("expected only kv to be dumped:\n%s", buf.String())
+	}
+	if err := csql.Dump(kvDB, &buf, "d", "missing"); !isError(err, `table "d.missing" does not exist`) {
+		t.Errorf("expected error, but found %v", err)
+	}
+	if err := csql.Dump(kvDB, &buf, "missing"); !isError(err, `database "missing" does not exist`) {
+		t.Errorf("expected error, but found %v", err)
+	}
+}
+
+func TestImport(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v CHAR, f FLOAT, CHECK (k >= 0));
+CREATE TABLE t.fk (k INT PRIMARY KEY REFERENCES t.kv);
+CREATE VIEW t.kvs AS SELECT k FROM t.kv;
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "kv.csv")
+
+	const numRows = 2500
+	writeCSV := func(badRow int) {
+		var buf bytes.Buffer
+		for i := 0; i < numRows; i++ {
+			switch {
+			case i == badRow:
+				fmt.Fprintf(&buf, "x%d,v%d,1.5\n", i, i)
+			case i%2 == 0:
+				fmt.Fprintf(&buf, "%d,\"v%d, \"\"quoted\"\"\",%d.5\n", i, i, i)
+			default:
+				fmt.Fprintf(&buf, "%d,NULL,\n", i)
+			}
+		}
+		if err := ioutil.WriteFile(path, buf.Bytes(), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	importCSV := func() (int, error) {
+		var rows int
+		err := db.QueryRow(`IMPORT INTO t.kv CSV $1`, path).Scan(&rows)
+		return rows, err
+	}
+
+	// An invalid value interrupts the import. The batches preceding the row
+	// are written and checkpointed.
+	writeCSV(1500)
+	if _, err := importCSV(); !isError(err, `row 1501: could not parse "x1500" as type INT for column "k"`) {
+		t.Fatalf("expected error, but found %v", err)
+	}
+
+	// Importing the corrected file resumes after the checkpointed rows.
+	writeCSV(-1)
+	if rows, err := importCSV(); err != nil {
+		t.Fatal(err)
+	} else if rows != numRows-1000 {
+		t.Fatalf("expected %d rows to be imported, but found %d", numRows-1000, rows)
+	}
+
+	rows, err := db.Query(`SELECT * FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	n := 0
+	for ; rows.Next(); n++ {
+		var k int
+		var v sql.NullString
+		var f sql.NullFloat64
+		if err := rows.Scan(&k, &v, &f); err != nil {
+			t.Fatal(err)
+		}
+		if k != n {
+			t.Fatalf("expected row %d, but found %d", n, k)
+		}
+		if n%2 == 0 {
+			if expected := fmt.Sprintf(`v%d, "quoted"`, n); v.String != expected {
+				t.Errorf("%d: expected %q, but found %q", n, expected, v.String)
+			}
+			if expected := float64(n) + 0.5; f.Float64 != expected {
+				t.Errorf("%d: expected %f, but found %f", n, expected, f.Float64)
+			}
+		} else if v.Valid || f.Valid {
+			t.Errorf("%d: expected NULL values, but found %v, %v", n, v, f)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != numRows {
+		t.Fatalf("expected %d rows, but found %d", numRows, n)
+	}
+
+	// Once complete, importing the file again starts from the beginning,
+	// failing on the rows which already exist.
+	if _, err := importCSV(); !isError(err, "duplicate primary key value") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+
+	for _, tc := range []struct {
+		data     string
+		expected string
+	}{
+		{"1,a", "wrong number of fields"},
+		{"-1,a,1", `violates check constraint "check_k"`},
+		{"NULL,a,1", `primary key column "k" must not be NULL`},
+		{"1,a,b", `could not parse "b" as type FLOAT for column "f"`},
+	} {
+		if err := ioutil.WriteFile(path, []byte(tc.data+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := importCSV(); !isError(err, tc.expected) {
+			t.Errorf("%s: expected %q, but found %v", tc.data, tc.expected, err)
+		}
+	}
+	if _, err := db.Exec(`IMPORT INTO t.kv CSV 1`); !isError(err, "file name must be a string") {
+		t.Errorf("expected error, but found %v", err)
+	}
+	if _, err := db.Exec(`IMPORT INTO t.fk CSV $1`, path); !isError(err, "which has foreign keys") {
+		t.Errorf("expected error, but found %v", err)
+	}
+	if _, err := db.Exec(`IMPORT INTO t.kvs CSV $1`, path); !isError(err, `"t.kvs" is a view`) {
+		t.Errorf("expected error, but found %v", err)
+	}
+}
@@ -24,10 +24,27 @@ import (
 
 type row []driver.Value
 
+// rows implements the sql/driver.Rows interface, including support for
+// multiple result sets. The results of a request are returned by the server
+// in chunks: if the response to a request contains a cursor, the rows of its
+// last result are continued by the first result of the response to a request
+// for the cursor. Chunks are fetched lazily as the rows are iterated over.
 type rows struct {
+	conn    *conn
 	columns []string
 	rows    []row
 	pos     int // Next iteration index into rows.
+	// rowsAffected is the number of rows written by the statement of the
+	// current result.
+	rowsAffected int64
+
+	// pending are the results following the current one which have been
+	// returned by the server.
+	pending []Result
+	// cursor, if non-zero, identifies the remaining results of the request
+	// on the server. If pending is empty, the next chunk continues the
+	// current result.
+	cursor int64
 }
 
 // newSingleColumnRows returns a rows structure initialized with a single
@@ -44,17 +61,79 @@ func newSingleColumnRows(column string, vals []string) *rows {
 	}
 }
 
+// setResponse records the results and cursor of a response from the server.
+func (r *rows) setResponse(resp Response) {
+	r.pending = resp.Results
+	r.cursor = resp.Cursor
+}
+
+// setResult makes result the current result.
+func (r *rows) setResult(result Result) {
+	r.columns = result.Columns
+	r.rows = decodeRows(result)
+	r.pos = 0
+	r.rowsAffected = result.RowsAffected
+}
+
+// continueResult fetches the next chunk of the current result. It returns
+// false if the current result is complete.
+func (r *rows) continueResult() (bool, error) {
+	if len(r.pending) > 0 || r.cursor == 0 {
+		return false, nil
+	}
+	resp, err := r.conn.fetch(r.cursor)
+	if err != nil {
+		r.cursor = 0
+		return false, err
+	}
+	r.setResponse(resp)
+	if len(r.pending) > 0 {
+		r.rows = decodeRows(r.pending[0])
+		r.pos = 0
+		r.pending = r.pending[1:]
+	}
+	return true, nil
+}
+
+// drain skips over the remaining rows of the current result, returning the
+// total number of rows in the result.
+func (r *rows) drain() (int, error) {
+	n := len(r.rows)
+	r.pos = len(r.rows)
+	for {
+		ok, err := r.continueResult()
+		if err != nil || !ok {
+			return n, err
+		}
+		n += len(r.rows)
+		r.pos = len(r.rows)
+	}
+}
+
 func (r *rows) Columns() []string {
 	return r.columns
 }
 
 func (r *rows) Close() error {
-	return nil
+	r.pending = nil
+	r.rows = nil
+	if r.cursor == 0 {
+		return nil
+	}
+	cursor := r.cursor
+	r.cursor = 0
+	return r.conn.closeCursor(cursor)
 }
 
 func (r *rows) Next(dest []driver.Value) error {
-	if r.pos >= len(r.rows) {
-		return io.EOF
+	for r.pos >= len(r.rows) {
+		ok, err := r.continueResult()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return io.EOF
+		}
 	}
 	for i, v := range r.rows[r.pos] {
 		dest[i] = v
@@ -62,3 +141,21 @@ func (r *rows) Next(dest []driver.Value) error {
 	r.pos++
 	return nil
 }
+
+// HasNextResultSet implements the sql/driver.RowsNextResultSet interface.
+func (r *rows) HasNextResultSet() bool {
+	return len(r.pending) > 0 || r.cursor != 0
+}
+
+// NextResultSet implements the sql/driver.RowsNextResultSet interface.
+func (r *rows) NextResultSet() error {
+	if _, err := r.drain(); err != nil {
+		return err
+	}
+	if len(r.pending) == 0 {
+		return io.EOF
+	}
+	r.setResult(r.pending[0])
+	r.pending = r.pending[1:]
+	return nil
+}
@@ -174,8 +174,11 @@ type Result struct {
 	// values in each Row.
 	Columns []string `protobuf:"bytes,1,rep,name=columns" json:"columns,omitempty"`
 	// The rows in the result set.
-	Rows             []Result_Row `protobuf:"bytes,2,rep,name=rows" json:"rows"`
-	XXX_unrecognized []byte       `json:"-"`
+	Rows []Result_Row `protobuf:"bytes,2,rep,name=rows" json:"rows"`
+	// The number of rows affected by an INSERT, UPDATE or DELETE
+	// statement.
+	RowsAffected     int64  `protobuf:"varint,3,opt,name=rows_affected" json:"rows_affected"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Result) Reset()         { *m = Result{} }
@@ -196,6 +199,13 @@ func (m *Result) GetRows() []Result_Row {
 	return nil
 }
 
+func (m *Result) GetRowsAffected() int64 {
+	if m != nil {
+		return m.RowsAffected
+	}
+	return 0
+}
+
 // A Row is a collection of values representing a row in a result.
 type Result_Row struct {
 	Values           []Datum `protobuf:"bytes,1,rep,name=values" json:"values"`
@@ -222,8 +232,15 @@ type Request struct {
 	// statements are passed as a single string separated by semicolons.
 	Sql string `protobuf:"bytes,2,opt,name=sql" json:"sql"`
 	// Parameters referred to in the above SQL statement(s) using "?".
-	Params           []Datum `protobuf:"bytes,3,rep,name=params" json:"params"`
-	XXX_unrecognized []byte  `json:"-"`
+	Params []Datum `protobuf:"bytes,3,rep,name=params" json:"params"`
+	// Cursor identifies an execution which was suspended because its
+	// results did not fit in a single response. When set, the execution is
+	// continued and the sql and params fields are ignored.
+	Cursor int64 `protobuf:"varint,4,opt,name=cursor" json:"cursor"`
+	// Close discards the remainder of the execution identified by cursor
+	// without returning any further results.
+	Close            bool   `protobuf:"varint,5,opt,name=close" json:"close"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -244,12 +261,31 @@ func (m *Request) GetParams() []Datum {
 	return nil
 }
 
+func (m *Request) GetCursor() int64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
+func (m *Request) GetClose() bool {
+	if m != nil {
+		return m.Close
+	}
+	return false
+}
+
 type Response struct {
 	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	// The list of results. There is one result object per SQL statement in the
 	// request.
-	Results          []Result `protobuf:"bytes,2,rep,name=results" json:"results"`
-	XXX_unrecognized []byte   `json:"-"`
+	Results []Result `protobuf:"bytes,2,rep,name=results" json:"results"`
+	// Cursor is set when the response does not contain all of the results of
+	// the request. The remaining results are retrieved by sending a request
+	// with the cursor. The rows of the last result in this response continue
+	// in the first result of the next response.
+	Cursor           int64  `protobuf:"varint,3,opt,name=cursor" json:"cursor"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
@@ -263,6 +299,13 @@ func (m *Response) GetResults() []Result {
 	return nil
 }
 
+func (m *Response) GetCursor() int64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
 func init() {
 }
 func (m *RequestHeader) Unmarshal(data []byte) error {
@@ -720,6 +763,21 @@ func (m *Result) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsAffected", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RowsAffected |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			var sizeOfWire int
 			for {
@@ -902,6 +960,38 @@ func (m *Request) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Cursor |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Close = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -994,6 +1084,21 @@ func (m *Response) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Cursor |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			var sizeOfWire int
 			for {
@@ -1221,6 +1326,7 @@ func (m *Result) Size() (n int) {
 			n += 1 + l + sovWire(uint64(l))
 		}
 	}
+	n += 1 + sovWire(uint64(m.RowsAffected))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1255,6 +1361,8 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovWire(uint64(l))
 		}
 	}
+	n += 1 + sovWire(uint64(m.Cursor))
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1272,6 +1380,7 @@ func (m *Response) Size() (n int) {
 			n += 1 + l + sovWire(uint64(l))
 		}
 	}
+	n += 1 + sovWire(uint64(m.Cursor))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1474,6 +1583,9 @@ func (m *Result) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	data[i] = 0x18
+	i++
+	i = encodeVarintWire(data, i, uint64(m.RowsAffected))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1552,6 +1664,17 @@ func (m *Request) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	data[i] = 0x20
+	i++
+	i = encodeVarintWire(data, i, uint64(m.Cursor))
+	data[i] = 0x28
+	i++
+	if m.Close {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1593,6 +1716,9 @@ func (m *Response) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	data[i] = 0x18
+	i++
+	i = encodeVarintWire(data, i, uint64(m.Cursor))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
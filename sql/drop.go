@@ -0,0 +1,239 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// DropDatabase drops a database along with all of its tables.
+// Privileges: DROP on database and on all of its tables.
+func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
+	if n.Name == "" {
+		return nil, errEmptyDatabaseName
+	}
+
+	dbDesc := structured.DatabaseDescriptor{}
+	dbNameKey := keys.MakeNameMetadataKey(structured.RootNamespaceID, strings.ToLower(string(n.Name)))
+	if found, err := p.getDescriptor(dbNameKey, &dbDesc); err != nil {
+		return nil, err
+	} else if !found {
+		if n.IfExists {
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("database \"%s\" does not exist", n.Name)
+	}
+	if err := p.checkPrivilege(&dbDesc, privilege.DROP); err != nil {
+		return nil, err
+	}
+
+	prefix := keys.MakeNameMetadataKey(dbDesc.ID, "")
+	sr, err := p.db.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the privileges on all of the tables before dropping any of them.
+	tableDescs := make([]*structured.TableDescriptor, 0, len(sr))
+	tableIDs := make([]uint32, 0, len(sr))
+	dropping := map[uint32]bool{}
+	for _, row := range sr {
+		tableName := string(bytes.TrimPrefix(row.Key, prefix))
+		tableDesc, err := p.getTableDesc(parser.QualifiedName{dbDesc.Name, tableName})
+		if err != nil {
+			return nil, err
+		}
+		if err := p.checkPrivilege(tableDesc, privilege.DROP); err != nil {
+			return nil, err
+		}
+		tableDescs = append(tableDescs, tableDesc)
+		tableIDs = append(tableIDs, tableDesc.ID)
+		dropping[tableDesc.ID] = true
+	}
+
+	// Mark all of the table descriptors as deleted and delete the database
+	// in a single transaction, then wait for the leases on all of the tables
+	// at once before deleting them.
+	if err := p.markTablesDeleted(tableIDs, dropping, func(b *client.Batch) {
+		b.Del(dbNameKey, keys.MakeDescMetadataKey(dbDesc.ID))
+	}); err != nil {
+		return nil, err
+	}
+	p.leaseMgr.uncacheID(dbNameKey)
+	if err := p.leaseMgr.WaitForOneVersion(tableIDs...); err != nil {
+		return nil, err
+	}
+	for i, tableDesc := range tableDescs {
+		if err := p.deleteTable(sr[i].Key, tableDesc, dropping); err != nil {
+			return nil, err
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// DropTable drops one or more tables.
+// Privileges: DROP on table.
+func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
+	if err := p.dropTables(n.Names, n.IfExists, false); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// DropView drops one or more views.
+// Privileges: DROP on view.
+func (p *planner) DropView(n *parser.DropView) (planNode, error) {
+	if err := p.dropTables(n.Names, n.IfExists, true); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// dropTables drops the named tables, or views if views is true.
+func (p *planner) dropTables(names parser.QualifiedNames, ifExists, views bool) error {
+	typeName := "table"
+	if views {
+		typeName = "view"
+	}
+
+	// Check the privileges on all of the tables before dropping any of them.
+	var nameKeys []proto.Key
+	var tableDescs []*structured.TableDescriptor
+	dropping := map[uint32]bool{}
+	for _, name := range names {
+		qname, err := p.normalizeTableName(name)
+		if err != nil {
+			return err
+		}
+		dbDesc, err := p.getDatabaseDesc(qname.Database())
+		if err != nil {
+			return err
+		}
+
+		tableDesc := structured.TableDescriptor{}
+		nameKey := keys.MakeNameMetadataKey(dbDesc.ID, qname.Table())
+		if found, err := p.getDescriptor(nameKey, &tableDesc); err != nil {
+			return err
+		} else if !found {
+			if ifExists {
+				continue
+			}
+			return fmt.Errorf("%s \"%s\" does not exist", typeName, qname)
+		}
+		if tableDesc.IsView() != views {
+			return fmt.Errorf("\"%s\" is not a %s", qname, typeName)
+		}
+		if err := p.checkPrivilege(&tableDesc, privilege.DROP); err != nil {
+			return err
+		}
+		nameKeys = append(nameKeys, nameKey)
+		tableDescs = append(tableDescs, &tableDesc)
+		dropping[tableDesc.ID] = true
+	}
+	for i, tableDesc := range tableDescs {
+		if err := p.dropTable(nameKeys[i], tableDesc, dropping); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropTable marks the table descriptor as deleted so that no new leases are
+// granted on it, waits for the existing leases to be released or expire and
+// then deletes the name, descriptor and data of the table. A table can only
+// be dropped if the tables with foreign keys referencing it and the views
+// selecting from it are being dropped too.
+func (p *planner) dropTable(nameKey proto.Key, desc *structured.TableDescriptor,
+	dropping map[uint32]bool) error {
+	if err := p.markTablesDeleted([]uint32{desc.ID}, dropping, nil); err != nil {
+		return err
+	}
+	if err := p.leaseMgr.WaitForOneVersion(desc.ID); err != nil {
+		return err
+	}
+	return p.deleteTable(nameKey, desc, dropping)
+}
+
+// markTablesDeleted marks the descriptors of the tables as deleted in a
+// single transaction, to which batch (if not nil) adds further operations.
+// None of the tables may be referenced by a table or view which is not
+// being dropped.
+func (p *planner) markTablesDeleted(tableIDs []uint32, dropping map[uint32]bool,
+	batch func(*client.Batch)) error {
+	var desc *structured.TableDescriptor
+	var referencedBy uint32
+	if _, err := p.leaseMgr.PublishMultiple(tableIDs, func(d *structured.TableDescriptor) error {
+		for _, id := range d.ReferencedBy {
+			if !dropping[id] {
+				desc, referencedBy = d, id
+				return errTableReferenced
+			}
+		}
+		d.Deleted = true
+		return nil
+	}, batch); err != nil {
+		if err == errTableReferenced {
+			refDesc, err := p.getTableLeaseByID(referencedBy)
+			if err != nil {
+				return err
+			}
+			if refDesc.IsView() {
+				return fmt.Errorf("%s \"%s\" is referenced by view \"%s\"",
+					desc.TypeName(), desc.Name, refDesc.Name)
+			}
+			return fmt.Errorf("table \"%s\" is referenced by a foreign key from table \"%s\"",
+				desc.Name, refDesc.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+// deleteTable deletes the name, descriptor and data of a table whose
+// descriptor has been marked as deleted and removes the references to the
+// table from the tables it references which are not being dropped.
+func (p *planner) deleteTable(nameKey proto.Key, desc *structured.TableDescriptor,
+	dropping map[uint32]bool) error {
+	b := client.Batch{}
+	b.Del(nameKey, keys.MakeDescMetadataKey(desc.ID))
+	tablePrefix := proto.Key(encodeTablePrefix(desc.ID))
+	b.DelRange(tablePrefix, tablePrefix.PrefixEnd())
+	if err := p.db.Txn(func(txn *client.Txn) error {
+		return txn.Commit(&b)
+	}); err != nil {
+		return err
+	}
+	p.leaseMgr.uncacheID(nameKey)
+
+	// Remove the references to the table from the tables it references which
+	// are not being dropped.
+	var refIDs []uint32
+	for _, id := range referencedTableIDs(desc) {
+		if !dropping[id] {
+			refIDs = append(refIDs, id)
+		}
+	}
+	return p.updateReferencedBy(desc.ID, refIDs, false)
+}
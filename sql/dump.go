@@ -0,0 +1,285 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// dumpInsertBatchSize is the maximum number of rows inserted by each INSERT
+// statement of a dump.
+const dumpInsertBatchSize = 100
+
+// Dump writes SQL statements recreating the specified tables of a database,
+// along with their rows, to w. If no tables are specified, all of the tables,
+// views and sequences of the database are dumped. Tables are created before
+// the tables and views referencing them. The current values of sequences are
+// not dumped.
+//
+// All of the descriptors and rows are read in a single transaction so that
+// the dump reflects the database at a single timestamp. As the transaction
+// may be retried, the dump is assembled in memory and only written to w once
+// the transaction has committed.
+func Dump(db *client.DB, w io.Writer, database string, tables ...string) error {
+	var buf bytes.Buffer
+	if err := db.Txn(func(txn *client.Txn) error {
+		buf.Reset()
+		d := dumper{p: &planner{db: db}, txn: txn, buf: &buf}
+		return d.dump(database, tables)
+	}); err != nil {
+		return err
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type dumper struct {
+	p   *planner
+	txn *client.Txn
+	buf *bytes.Buffer
+	// names maps the IDs of the descriptors of the database to their names
+	// within the database.
+	names map[uint32]string
+}
+
+func (d *dumper) dump(database string, tables []string) error {
+	dbDesc := structured.DatabaseDescriptor{}
+	if found, err := readDescriptor(d.txn,
+		keys.MakeNameMetadataKey(structured.RootNamespaceID, database), &dbDesc); err != nil {
+		return err
+	} else if !found {
+		return fmt.Errorf("database \"%s\" does not exist", database)
+	}
+
+	// Read the descriptors of all of the tables of the database. References to
+	// them are written without the database name.
+	prefix := keys.MakeNameMetadataKey(dbDesc.ID, "")
+	sr, err := d.txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return err
+	}
+	d.names = map[uint32]string{}
+	descs := map[string]*structured.TableDescriptor{}
+	var all []string
+	for _, row := range sr {
+		name := string(bytes.TrimPrefix(row.Key, prefix))
+		desc := &structured.TableDescriptor{}
+		if err := d.txn.GetProto(row.ValueBytes(), desc); err != nil {
+			return err
+		}
+		if desc.Deleted {
+			continue
+		}
+		d.names[desc.ID] = name
+		descs[name] = desc
+		all = append(all, name)
+	}
+	if len(tables) == 0 {
+		tables = all
+	}
+
+	// Order the tables so that every table is created after the tables it
+	// references.
+	byID := map[uint32]*structured.TableDescriptor{}
+	for _, name := range tables {
+		desc, ok := descs[name]
+		if !ok {
+			return fmt.Errorf("table \"%s\" does not exist",
+				parser.QualifiedName{database, name})
+		}
+		byID[desc.ID] = desc
+	}
+	var ordered []*structured.TableDescriptor
+	visited := map[uint32]bool{}
+	var visit func(desc *structured.TableDescriptor)
+	visit = func(desc *structured.TableDescriptor) {
+		if visited[desc.ID] {
+			return
+		}
+		visited[desc.ID] = true
+		for _, id := range referencedTableIDs(desc) {
+			if ref, ok := byID[id]; ok {
+				visit(ref)
+			}
+		}
+		ordered = append(ordered, desc)
+	}
+	for _, name := range tables {
+		visit(descs[name])
+	}
+
+	fmt.Fprintf(d.buf, "CREATE DATABASE IF NOT EXISTS %s;\n", parser.Name(database))
+	fmt.Fprintf(d.buf, "SET DATABASE = %s;\n", parser.Name(database))
+	for _, desc := range ordered {
+		_ = d.buf.WriteByte('\n')
+		name := parser.Name(d.names[desc.ID])
+		switch {
+		case desc.IsSequence:
+			fmt.Fprintf(d.buf, "CREATE SEQUENCE %s;\n", name)
+		case desc.IsView():
+			fmt.Fprintf(d.buf, "CREATE VIEW %s (%s) AS %s;\n",
+				name, parser.NameList(desc.ViewColumns), desc.ViewQuery)
+		default:
+			if err := d.createTable(desc); err != nil {
+				return err
+			}
+			if err := d.insertRows(desc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// tableName returns the name under which the dump refers to the table with
+// the specified ID.
+func (d *dumper) tableName(id uint32) (parser.QualifiedName, error) {
+	if name, ok := d.names[id]; ok {
+		return parser.QualifiedName{name}, nil
+	}
+	desc := structured.TableDescriptor{}
+	if err := d.txn.GetProto(keys.MakeDescMetadataKey(id), &desc); err != nil {
+		return nil, err
+	}
+	return parser.QualifiedName(strings.Split(desc.Name, ".")), nil
+}
+
+// createTable writes the CREATE TABLE statement for the table.
+func (d *dumper) createTable(desc *structured.TableDescriptor) error {
+	var defs []string
+	for _, col := range desc.Columns {
+		def := fmt.Sprintf("%s %s", parser.Name(col.Name), col.Type.SQLString())
+		if !col.Nullable {
+			def += " NOT NULL"
+		}
+		if col.DefaultExpr != nil {
+			def += " DEFAULT " + *col.DefaultExpr
+		}
+		defs = append(defs, def)
+	}
+	for i, index := range desc.Indexes {
+		def := &parser.IndexTableDef{
+			Unique:  index.Unique,
+			Columns: parser.NameList(index.ColumnNames),
+		}
+		if i == 0 {
+			def.PrimaryKey = true
+		} else {
+			def.Name = parser.Name(index.Name)
+		}
+		defs = append(defs, def.String())
+	}
+	for _, fk := range desc.ForeignKeys {
+		def := &parser.ForeignKeyTableDef{Name: parser.Name(fk.Name)}
+		for _, id := range fk.ColumnIDs {
+			col, err := desc.FindColumnByID(id)
+			if err != nil {
+				return err
+			}
+			def.Columns = append(def.Columns, col.Name)
+		}
+		var err error
+		if def.Table, err = d.tableName(fk.ReferencedTableID); err != nil {
+			return err
+		}
+		refDesc := structured.TableDescriptor{}
+		if err := d.txn.GetProto(keys.MakeDescMetadataKey(fk.ReferencedTableID), &refDesc); err != nil {
+			return err
+		}
+		refIndex, err := findIndexByID(&refDesc, fk.ReferencedIndexID)
+		if err != nil {
+			return err
+		}
+		def.RefColumns = parser.NameList(refIndex.ColumnNames)
+		if fk.OnDelete == structured.ForeignKeyDescriptor_CASCADE {
+			def.Actions.Delete = parser.Cascade
+		}
+		defs = append(defs, def.String())
+	}
+	for _, check := range desc.Checks {
+		defs = append(defs, fmt.Sprintf("CONSTRAINT %s CHECK (%s)",
+			parser.Name(check.Name), check.Expr))
+	}
+	fmt.Fprintf(d.buf, "CREATE TABLE %s (\n\t%s\n);\n",
+		parser.Name(d.names[desc.ID]), strings.Join(defs, ",\n\t"))
+	return nil
+}
+
+// insertRows writes INSERT statements for the rows of the table, each
+// inserting at most dumpInsertBatchSize rows.
+func (d *dumper) insertRows(desc *structured.TableDescriptor) error {
+	// The scan renders no columns: the values of each row are taken directly
+	// from the decoded columns.
+	n := &scanNode{
+		db:   d.p.db,
+		txn:  d.txn,
+		p:    d.p,
+		desc: desc,
+	}
+	var columns parser.NameList
+	for _, col := range desc.Columns {
+		columns = append(columns, col.Name)
+	}
+
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES",
+		parser.Name(d.names[desc.ID]), columns)
+	row := make(parser.DTuple, len(desc.Columns))
+	rows := 0
+	for n.Next() {
+		for i, col := range desc.Columns {
+			row[i] = n.vals[col.Name]
+		}
+		if rows%dumpInsertBatchSize == 0 {
+			if rows > 0 {
+				_, _ = d.buf.WriteString(";\n")
+			}
+			_, _ = d.buf.WriteString(insert)
+		} else {
+			_ = d.buf.WriteByte(',')
+		}
+		fmt.Fprintf(d.buf, "\n\t%s", row)
+		rows++
+	}
+	if rows > 0 {
+		_, _ = d.buf.WriteString(";\n")
+	}
+	return n.Err()
+}
+
+// readDescriptor looks up the descriptor referenced by the name key within
+// the transaction and unmarshals it into desc. Returns false if the name key
+// does not exist.
+func readDescriptor(txn *client.Txn, nameKey proto.Key, desc descriptorProto) (bool, error) {
+	gr, err := txn.Get(nameKey)
+	if err != nil {
+		return false, err
+	} else if !gr.Exists() {
+		return false, nil
+	}
+	if err := txn.GetProto(gr.ValueBytes(), desc); err != nil {
+		return false, err
+	}
+	return true, desc.Validate()
+}
@@ -0,0 +1,103 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// explainPlan returns a description of a plan, with one line per node.
+// The sources of a node are indented below it.
+func explainPlan(plan planNode) string {
+	var buf bytes.Buffer
+	explainNode(&buf, plan, 0)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func explainNode(buf *bytes.Buffer, plan planNode, depth int) {
+	buf.WriteString(strings.Repeat("  ", depth))
+	var sources []planNode
+	switch n := plan.(type) {
+	case *scanNode:
+		if n.desc == nil {
+			buf.WriteString("scan")
+		} else {
+			fmt.Fprintf(buf, "scan %s", n.desc.Name)
+		}
+		fmt.Fprintf(buf, " (%s)", strings.Join(n.columns, ", "))
+		if n.filter != nil {
+			fmt.Fprintf(buf, " WHERE %s", n.filter)
+		}
+	case *renderNode:
+		fmt.Fprintf(buf, "render (%s)", strings.Join(n.columns, ", "))
+		if n.filter != nil {
+			fmt.Fprintf(buf, " WHERE %s", n.filter)
+		}
+		sources = []planNode{n.source}
+	case *sortNode:
+		fmt.Fprintf(buf, "sort %s", explainOrdering(n.ordering))
+		sources = []planNode{n.plan}
+	case *distinctNode:
+		buf.WriteString("distinct")
+		if len(n.ordering) > 0 {
+			fmt.Fprintf(buf, " %s", explainOrdering(n.ordering))
+		}
+		sources = []planNode{n.plan}
+	case *limitNode:
+		fmt.Fprintf(buf, "limit count=%d offset=%d", n.count, n.offset)
+		sources = []planNode{n.plan}
+	case *unionNode:
+		buf.WriteString("union")
+		if n.all {
+			buf.WriteString(" all")
+		}
+		sources = []planNode{n.left, n.right}
+	case *setOpNode:
+		if n.intersect {
+			buf.WriteString("intersect")
+		} else {
+			buf.WriteString("except")
+		}
+		if n.all {
+			buf.WriteString(" all")
+		}
+		sources = []planNode{n.left, n.right}
+	case *valuesNode:
+		fmt.Fprintf(buf, "values %d rows", len(n.rows))
+	default:
+		fmt.Fprintf(buf, "%T", plan)
+	}
+	buf.WriteString("\n")
+	for _, source := range sources {
+		explainNode(buf, source, depth+1)
+	}
+}
+
+// explainOrdering describes an ordering by the 1-based positions of its
+// columns, prefixed by + for ascending and - for descending order.
+func explainOrdering(ordering []columnOrderInfo) string {
+	parts := make([]string, len(ordering))
+	for i, o := range ordering {
+		dir := "+"
+		if o.desc {
+			dir = "-"
+		}
+		parts[i] = fmt.Sprintf("%s%d", dir, o.colIdx+1)
+	}
+	return strings.Join(parts, ",")
+}
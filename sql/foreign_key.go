@@ -0,0 +1,430 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+var errTableReferenced = errors.New("table is referenced by a foreign key")
+
+// resolveForeignKeys adds the foreign keys defined by a CREATE TABLE
+// statement to the descriptor of the new table, whose columns must have been
+// allocated IDs. The referenced columns must be those of a unique index of
+// the referenced table, the primary key if no columns are specified. Returns
+// the IDs of the referenced tables.
+func (p *planner) resolveForeignKeys(n *parser.CreateTable,
+	desc *structured.TableDescriptor) ([]uint32, error) {
+	var refIDs []uint32
+	for _, def := range n.Defs {
+		var fkDef parser.ForeignKeyTableDef
+		switch d := def.(type) {
+		case *parser.ColumnTableDef:
+			if d.References == nil {
+				continue
+			}
+			fkDef = parser.ForeignKeyTableDef{
+				Columns:    parser.NameList{string(d.Name)},
+				Table:      d.References.Table,
+				RefColumns: d.References.Columns,
+				Actions:    d.References.Actions,
+			}
+		case *parser.ForeignKeyTableDef:
+			fkDef = *d
+		default:
+			continue
+		}
+
+		refDesc, err := p.getTableDesc(fkDef.Table)
+		if err != nil {
+			return nil, err
+		}
+		if refDesc.IsSequence {
+			return nil, fmt.Errorf("\"%s\" is a sequence", refDesc.Name)
+		}
+		fk, err := makeForeignKey(desc, refDesc, &fkDef)
+		if err != nil {
+			return nil, err
+		}
+		desc.ForeignKeys = append(desc.ForeignKeys, fk)
+
+		found := false
+		for _, id := range refIDs {
+			found = found || id == refDesc.ID
+		}
+		if !found {
+			refIDs = append(refIDs, refDesc.ID)
+		}
+	}
+	return refIDs, nil
+}
+
+func makeForeignKey(desc, refDesc *structured.TableDescriptor,
+	d *parser.ForeignKeyTableDef) (structured.ForeignKeyDescriptor, error) {
+	fk := structured.ForeignKeyDescriptor{
+		Name:              string(d.Name),
+		ReferencedTableID: refDesc.ID,
+	}
+	if fk.Name == "" {
+		fk.Name = fmt.Sprintf("fk_%s_ref_%s", strings.Join(d.Columns, "_"), d.Table.Table())
+	}
+
+	switch d.Actions.Delete {
+	case parser.NoAction, parser.Restrict:
+		fk.OnDelete = structured.ForeignKeyDescriptor_RESTRICT
+	case parser.Cascade:
+		fk.OnDelete = structured.ForeignKeyDescriptor_CASCADE
+	default:
+		return fk, fmt.Errorf("ON DELETE %s is not supported", d.Actions.Delete)
+	}
+	switch d.Actions.Update {
+	case parser.NoAction, parser.Restrict:
+	default:
+		return fk, fmt.Errorf("ON UPDATE %s is not supported", d.Actions.Update)
+	}
+
+	refColumns := []string(d.RefColumns)
+	if len(refColumns) == 0 {
+		refColumns = refDesc.Indexes[0].ColumnNames
+	}
+	if len(d.Columns) != len(refColumns) {
+		return fk, fmt.Errorf("foreign key \"%s\" has %d columns but references %d columns",
+			fk.Name, len(d.Columns), len(refColumns))
+	}
+
+	// Find the unique index on the referenced columns, which may be listed in
+	// any order. The referencing columns are stored in the order of the
+	// columns of the index.
+	var refIndex *structured.IndexDescriptor
+	var positions []int
+	for i := range refDesc.Indexes {
+		index := &refDesc.Indexes[i]
+		if !index.Unique || len(index.ColumnNames) != len(refColumns) {
+			continue
+		}
+		positions = positions[:0]
+		for _, name := range index.ColumnNames {
+			for j, refName := range refColumns {
+				if name == refName {
+					positions = append(positions, j)
+					break
+				}
+			}
+		}
+		if len(positions) == len(refColumns) {
+			refIndex = index
+			break
+		}
+	}
+	if refIndex == nil {
+		return fk, fmt.Errorf("there is no unique index on columns (%s) of \"%s\"",
+			parser.NameList(refColumns), refDesc.Name)
+	}
+	fk.ReferencedIndexID = refIndex.ID
+
+	for i, j := range positions {
+		col, err := desc.FindColumnByName(d.Columns[j])
+		if err != nil {
+			return fk, err
+		}
+		refCol, err := refDesc.FindColumnByID(refIndex.ColumnIDs[i])
+		if err != nil {
+			return fk, err
+		}
+		if col.Type.Kind != refCol.Type.Kind {
+			return fk, fmt.Errorf("foreign key column \"%s\" of type %s cannot reference column \"%s\" of type %s",
+				col.Name, col.Type.SQLString(), refCol.Name, refCol.Type.SQLString())
+		}
+		fk.ColumnIDs = append(fk.ColumnIDs, col.ID)
+	}
+	return fk, nil
+}
+
+// updateReferencedBy adds the table to, or removes it from, the tables
+// referencing each of the specified tables.
+func (p *planner) updateReferencedBy(tableID uint32, refIDs []uint32, add bool) error {
+	for _, refID := range refIDs {
+		if _, err := p.leaseMgr.Publish(refID, func(desc *structured.TableDescriptor) error {
+			referencedBy := desc.ReferencedBy[:0]
+			for _, id := range desc.ReferencedBy {
+				if id != tableID {
+					referencedBy = append(referencedBy, id)
+				}
+			}
+			if add {
+				referencedBy = append(referencedBy, tableID)
+			}
+			desc.ReferencedBy = referencedBy
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referencedTableIDs returns the IDs of the tables referenced by the foreign
+// keys of the table, or selected from by the view.
+func referencedTableIDs(desc *structured.TableDescriptor) []uint32 {
+	ids := append([]uint32(nil), desc.DependsOn...)
+	for _, fk := range desc.ForeignKeys {
+		found := false
+		for _, id := range ids {
+			found = found || id == fk.ReferencedTableID
+		}
+		if !found {
+			ids = append(ids, fk.ReferencedTableID)
+		}
+	}
+	return ids
+}
+
+// getTableLeaseByID acquires a lease on the descriptor of the table with the
+// specified ID. See getTableLease.
+func (p *planner) getTableLeaseByID(id uint32) (*structured.TableDescriptor, error) {
+	lease, err := p.leaseMgr.Acquire(id)
+	if err != nil {
+		return nil, err
+	}
+	p.leases = append(p.leases, lease)
+	return &lease.TableDescriptor, nil
+}
+
+func findIndexByID(desc *structured.TableDescriptor, id uint32) (*structured.IndexDescriptor, error) {
+	for i := range desc.Indexes {
+		if desc.Indexes[i].ID == id {
+			return &desc.Indexes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("index-id \"%d\" does not exist", id)
+}
+
+// scanTable returns a plan iterating over all of the columns of the rows of
+// the table which match the filter, read within the transaction.
+func (p *planner) scanTable(txn *client.Txn, desc *structured.TableDescriptor,
+	filter parser.Expr) (planNode, error) {
+	startKey := proto.Key(encodeIndexKeyPrefix(desc.ID, desc.Indexes[0].ID))
+	kvs, err := txn.Scan(startKey, startKey.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	if kvs == nil {
+		kvs = []client.KeyValue{}
+	}
+	n := &scanNode{
+		p:      p,
+		desc:   desc,
+		kvs:    kvs,
+		filter: filter,
+	}
+	for _, col := range desc.Columns {
+		n.columns = append(n.columns, col.Name)
+		n.render = append(n.render, parser.QualifiedName{col.Name})
+	}
+	return n, nil
+}
+
+// makeEqualityFilter returns an expression matching the rows of a table whose
+// columns equal the specified values.
+func makeEqualityFilter(desc *structured.TableDescriptor, colIDs []uint32,
+	vals parser.DTuple) (parser.Expr, error) {
+	var filter parser.Expr
+	for i, id := range colIDs {
+		col, err := desc.FindColumnByID(id)
+		if err != nil {
+			return nil, err
+		}
+		var expr parser.Expr = &parser.ComparisonExpr{
+			Operator: parser.EQ,
+			Left:     parser.QualifiedName{col.Name},
+			Right:    vals[i],
+		}
+		if filter != nil {
+			expr = &parser.AndExpr{Left: filter, Right: expr}
+		}
+		filter = expr
+	}
+	return filter, nil
+}
+
+// checkForeignKeys verifies that the rows referenced by the foreign keys of
+// the table exist for an inserted or updated row. colMap maps column IDs to
+// the index of their value within the row. Rows with a NULL value in any of
+// the columns of a foreign key are not checked.
+func (p *planner) checkForeignKeys(txn *client.Txn, desc *structured.TableDescriptor,
+	colMap map[uint32]int, row parser.DTuple) error {
+	for _, fk := range desc.ForeignKeys {
+		vals := make(parser.DTuple, 0, len(fk.ColumnIDs))
+		for _, id := range fk.ColumnIDs {
+			i, ok := colMap[id]
+			if !ok || row[i] == (parser.DNull{}) {
+				break
+			}
+			vals = append(vals, row[i])
+		}
+		if len(vals) != len(fk.ColumnIDs) {
+			continue
+		}
+
+		refDesc, err := p.getTableLeaseByID(fk.ReferencedTableID)
+		if err != nil {
+			return err
+		}
+		refIndex, err := findIndexByID(refDesc, fk.ReferencedIndexID)
+		if err != nil {
+			return err
+		}
+		found, err := p.rowExists(txn, refDesc, refIndex, vals)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("foreign key violation: value %s not found in %s (%s)",
+				vals, refDesc.Name, parser.NameList(refIndex.ColumnNames))
+		}
+	}
+	return nil
+}
+
+// validateForeignKeys verifies that the rows referenced by the existing rows
+// of the table exist.
+func (p *planner) validateForeignKeys(desc *structured.TableDescriptor) error {
+	colMap := map[uint32]int{}
+	for i, col := range desc.Columns {
+		colMap[col.ID] = i
+	}
+	return p.db.Txn(func(txn *client.Txn) error {
+		plan, err := p.scanTable(txn, desc, nil)
+		if err != nil {
+			return err
+		}
+		for plan.Next() {
+			if err := p.checkForeignKeys(txn, desc, colMap, plan.Values()); err != nil {
+				return err
+			}
+		}
+		return plan.Err()
+	})
+}
+
+// rowExists returns true if the table contains a row with the specified values
+// for the columns of the index.
+func (p *planner) rowExists(txn *client.Txn, desc *structured.TableDescriptor,
+	index *structured.IndexDescriptor, vals parser.DTuple) (bool, error) {
+	if index.ID == desc.Indexes[0].ID {
+		// The values form the primary key of the row.
+		colMap := map[uint32]int{}
+		for i, id := range index.ColumnIDs {
+			colMap[id] = i
+		}
+		primaryKey, err := encodeIndexKey(*index, colMap, vals, encodeIndexKeyPrefix(desc.ID, index.ID))
+		if err != nil {
+			return false, err
+		}
+		rowStartKey := proto.Key(primaryKey)
+		kvs, err := txn.Scan(rowStartKey, rowStartKey.PrefixEnd(), 1)
+		return len(kvs) > 0, err
+	}
+
+	// Only the primary index is populated, so other indexes are checked by
+	// scanning the table.
+	filter, err := makeEqualityFilter(desc, index.ColumnIDs, vals)
+	if err != nil {
+		return false, err
+	}
+	plan, err := p.scanTable(txn, desc, filter)
+	if err != nil {
+		return false, err
+	}
+	found := plan.Next()
+	return found, plan.Err()
+}
+
+// deleteReferencingRows applies the ON DELETE actions of the foreign keys
+// referencing the table to the rows referencing a deleted row: RESTRICT fails
+// the deletion while CASCADE adds the deletion of the referencing rows, and of
+// the rows referencing them in turn, to the batch. The primary keys of the
+// rows already deleted are recorded in visited so that cyclic references
+// between tables do not delete a row, and follow its references, again.
+func (p *planner) deleteReferencingRows(txn *client.Txn, b *client.Batch,
+	desc *structured.TableDescriptor, colMap map[uint32]int, row parser.DTuple,
+	visited map[string]struct{}) error {
+	for _, childID := range desc.ReferencedBy {
+		childDesc, err := p.getTableLeaseByID(childID)
+		if err != nil {
+			return err
+		}
+		for _, fk := range childDesc.ForeignKeys {
+			if fk.ReferencedTableID != desc.ID {
+				continue
+			}
+			refIndex, err := findIndexByID(desc, fk.ReferencedIndexID)
+			if err != nil {
+				return err
+			}
+			vals := make(parser.DTuple, len(refIndex.ColumnIDs))
+			for i, id := range refIndex.ColumnIDs {
+				vals[i] = row[colMap[id]]
+			}
+			filter, err := makeEqualityFilter(childDesc, fk.ColumnIDs, vals)
+			if err != nil {
+				return err
+			}
+			plan, err := p.scanTable(txn, childDesc, filter)
+			if err != nil {
+				return err
+			}
+
+			childColMap := map[uint32]int{}
+			for i, col := range childDesc.Columns {
+				childColMap[col.ID] = i
+			}
+			childIndex := childDesc.Indexes[0]
+			indexKey := encodeIndexKeyPrefix(childDesc.ID, childIndex.ID)
+			for plan.Next() {
+				if fk.OnDelete != structured.ForeignKeyDescriptor_CASCADE {
+					return fmt.Errorf("foreign key violation: %s %s is referenced by %s (foreign key \"%s\")",
+						desc.Name, vals, childDesc.Name, fk.Name)
+				}
+				childRow := plan.Values()
+				primaryKey, err := encodeIndexKey(childIndex, childColMap, childRow, indexKey)
+				if err != nil {
+					return err
+				}
+				if _, ok := visited[string(primaryKey)]; ok {
+					continue
+				}
+				visited[string(primaryKey)] = struct{}{}
+				rowStartKey := proto.Key(primaryKey)
+				b.DelRange(rowStartKey, rowStartKey.PrefixEnd())
+				if err := p.deleteReferencingRows(txn, b, childDesc, childColMap, childRow, visited); err != nil {
+					return err
+				}
+			}
+			if err := plan.Err(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// Grant adds privileges to users.
+// Privileges: GRANT on database/table.
+func (p *planner) Grant(n *parser.Grant) (planNode, error) {
+	return p.changePrivileges(n.Targets, func(privs *structured.PrivilegeDescriptor) {
+		for _, grantee := range n.Grantees {
+			privs.Grant(grantee, n.Privileges)
+		}
+	})
+}
+
+// Revoke removes privileges from users.
+// Privileges: GRANT on database/table.
+func (p *planner) Revoke(n *parser.Revoke) (planNode, error) {
+	return p.changePrivileges(n.Targets, func(privs *structured.PrivilegeDescriptor) {
+		for _, grantee := range n.Grantees {
+			privs.Revoke(grantee, n.Privileges)
+		}
+	})
+}
+
+// changePrivileges applies changePrivilege to the privileges of each of the
+// targeted descriptors and writes the resulting descriptors back. Table
+// descriptors are leased and are therefore updated by publishing a new
+// version.
+func (p *planner) changePrivileges(targets parser.TargetList,
+	changePrivilege func(*structured.PrivilegeDescriptor)) (planNode, error) {
+	descriptors, err := p.getDescriptorsFromTargetList(targets)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the privileges on all of the targets before changing any of them.
+	for _, desc := range descriptors {
+		if err := p.checkPrivilege(desc, privilege.GRANT); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, desc := range descriptors {
+		switch d := desc.(type) {
+		case *structured.DatabaseDescriptor:
+			changePrivilege(d.Privileges)
+			if err := d.Validate(); err != nil {
+				return nil, err
+			}
+			// Note that a concurrent change to the descriptor between the read
+			// above and this write will be lost.
+			if err := p.db.Put(keys.MakeDescMetadataKey(d.ID), d); err != nil {
+				return nil, err
+			}
+
+		case *structured.TableDescriptor:
+			if _, err := p.leaseMgr.Publish(d.ID, func(desc *structured.TableDescriptor) error {
+				if err := p.checkPrivilege(desc, privilege.GRANT); err != nil {
+					return err
+				}
+				changePrivilege(desc.Privileges)
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// getDescriptorsFromTargetList examines a TargetList and fetches the
+// appropriate descriptors.
+func (p *planner) getDescriptorsFromTargetList(targets parser.TargetList) (
+	[]descriptorProto, error) {
+	if targets.Databases != nil {
+		if len(targets.Databases) == 0 {
+			return nil, errEmptyDatabaseName
+		}
+		descs := make([]descriptorProto, 0, len(targets.Databases))
+		for _, database := range targets.Databases {
+			desc, err := p.getDatabaseDesc(database)
+			if err != nil {
+				return nil, err
+			}
+			descs = append(descs, desc)
+		}
+		return descs, nil
+	}
+
+	if len(targets.Tables) == 0 {
+		return nil, fmt.Errorf("no targets specified")
+	}
+	descs := make([]descriptorProto, 0, len(targets.Tables))
+	for _, table := range targets.Tables {
+		desc, err := p.getTableDesc(table)
+		if err != nil {
+			return nil, err
+		}
+		descs = append(descs, desc)
+	}
+	return descs, nil
+}
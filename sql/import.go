@@ -0,0 +1,372 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+const (
+	// importBatchSize is the number of rows written by each batch of an
+	// import.
+	importBatchSize = 1000
+	// importConcurrency is the number of batches of an import written in
+	// parallel.
+	importConcurrency = 4
+)
+
+// Import loads the rows of the CSV file into a table. The batches of rows
+// are written in transactions using the session's default isolation level.
+// Privileges: root user, INSERT on table.
+func (p *planner) Import(n *parser.Import) (planNode, error) {
+	// The file is read from the file system of the node.
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to import files", security.RootUser)
+	}
+	desc, err := p.getTableLease(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkImportTable(desc, n.Table.String()); err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(desc, privilege.INSERT); err != nil {
+		return nil, err
+	}
+
+	val, err := parser.EvalExpr(n.File, nil)
+	if err != nil {
+		return nil, err
+	}
+	file, ok := val.(parser.DString)
+	if !ok {
+		return nil, fmt.Errorf("file name must be a string, not %s", val.Type())
+	}
+	path, err := filepath.Abs(string(file))
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := importCSV(p.db, p.txn, desc, f, path)
+	if err != nil {
+		return nil, err
+	}
+	return &valuesNode{
+		columns: []string{"Rows"},
+		rows:    []parser.DTuple{{parser.DInt(rows)}},
+	}, nil
+}
+
+// Import loads the rows of the CSV data read from r into the table, whose
+// name must be qualified with its database. See importCSV.
+func Import(db *client.DB, r io.Reader, table, source string) (int64, error) {
+	expr, err := parser.ParseExpr(table)
+	if err != nil {
+		return 0, err
+	}
+	qname, ok := expr.(parser.QualifiedName)
+	if !ok || len(qname) != 2 {
+		return 0, fmt.Errorf("invalid table name: %s", table)
+	}
+	p := &planner{db: db}
+	desc, err := p.getTableDesc(qname)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkImportTable(desc, qname.String()); err != nil {
+		return 0, err
+	}
+	return importCSV(db, db.Txn, desc, r, source)
+}
+
+// checkImportTable returns an error if rows can't be imported into the
+// table. Views and sequences have no rows of their own, and the foreign
+// keys of a table are not verified by an import.
+func checkImportTable(desc *structured.TableDescriptor, name string) error {
+	if desc.IsView() {
+		return fmt.Errorf("\"%s\" is a view", name)
+	} else if desc.IsSequence {
+		return fmt.Errorf("\"%s\" is a sequence", name)
+	} else if len(desc.ForeignKeys) > 0 {
+		return fmt.Errorf("cannot import into table \"%s\", which has foreign keys", name)
+	}
+	return nil
+}
+
+// importCSV writes the rows of the CSV data read from r into the table and
+// returns the number of rows written. Each record holds the values of all of
+// the columns of the table, in order. Values are converted to the type of
+// their column; empty values of non-string columns and values spelled NULL
+// are NULL. The CHECK constraints of the table are verified, and rows whose
+// primary key already exists are rejected.
+//
+// The key/value pairs of the rows are written in batches of importBatchSize
+// rows, several of them in parallel. Each batch is written in a transaction
+// along with a checkpoint recording that it has been written. An import of
+// the same source into the table skips the checkpointed batches. The
+// checkpoints are removed once the import completes. The transactions are
+// run using runTxn.
+func importCSV(db *client.DB, runTxn func(func(*client.Txn) error) error,
+	desc *structured.TableDescriptor, r io.Reader, source string) (int64, error) {
+	checkExprs, err := parseChecks(desc)
+	if err != nil {
+		return 0, err
+	}
+	colMap := map[uint32]int{}
+	for i, col := range desc.Columns {
+		colMap[col.ID] = i
+	}
+
+	progress := &importProgress{
+		runTxn:  runTxn,
+		tableID: desc.ID,
+		source:  source,
+		prefix:  keys.MakeImportCheckpointPrefix(desc.ID, source),
+		done:    map[int64]bool{},
+	}
+	checkpoints, err := db.Scan(progress.prefix, progress.prefix.PrefixEnd(), 0)
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range checkpoints {
+		_, start := encoding.DecodeUvarint(row.Key[len(progress.prefix):])
+		progress.done[int64(start)] = true
+	}
+
+	batches := make(chan importBatch)
+	var wg sync.WaitGroup
+	for i := 0; i < importConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				if err := progress.write(batch); err != nil {
+					progress.fail(err)
+				}
+			}
+		}()
+	}
+
+	err = func() error {
+		defer close(batches)
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = len(desc.Columns)
+		var batch importBatch
+		row := make(parser.DTuple, len(desc.Columns))
+		for line := int64(0); ; line++ {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+			if line%importBatchSize == 0 {
+				batch = importBatch{start: line, end: line}
+			}
+			if progress.done[batch.start] {
+				// The batch was written by an interrupted import.
+				continue
+			}
+			for i, col := range desc.Columns {
+				if row[i], err = importValue(col, record[i]); err != nil {
+					return fmt.Errorf("%s: row %d: %s", source, line+1, err)
+				}
+			}
+			if err := checkRow(desc, checkExprs, colMap, row); err != nil {
+				return fmt.Errorf("%s: row %d: %s", source, line+1, err)
+			}
+			if err := batch.addRow(desc, colMap, row); err != nil {
+				return fmt.Errorf("%s: row %d: %s", source, line+1, err)
+			}
+			batch.end = line + 1
+			if batch.end-batch.start == importBatchSize {
+				if err := progress.err(); err != nil {
+					return err
+				}
+				batches <- batch
+			}
+		}
+		if batch.end > batch.start && batch.end-batch.start < importBatchSize {
+			batches <- batch
+		}
+		return nil
+	}()
+	wg.Wait()
+	if err != nil {
+		return 0, err
+	}
+	if err := progress.err(); err != nil {
+		return 0, err
+	}
+	b := &client.Batch{}
+	b.DelRange(progress.prefix, progress.prefix.PrefixEnd())
+	if err := db.Run(b); err != nil {
+		return 0, err
+	}
+	return progress.rows, nil
+}
+
+// importBatch holds the key/value pairs of the rows [start, end) of the
+// source of an import.
+type importBatch struct {
+	start, end int64
+	keys       []proto.Key
+	values     []interface{}
+}
+
+// importProgress tracks the batches of an import which have been written.
+type importProgress struct {
+	runTxn  func(func(*client.Txn) error) error
+	tableID uint32
+	source  string
+	prefix  proto.Key
+	// done holds the start rows of the batches written by previous attempts
+	// at the import. It is not modified once the batches are written.
+	done map[int64]bool
+
+	mu       sync.Mutex
+	firstErr error
+	// rows is the number of rows written by this attempt at the import.
+	rows int64
+}
+
+// write writes the rows of a batch along with its checkpoint in a
+// transaction.
+func (ip *importProgress) write(batch importBatch) error {
+	if err := ip.runTxn(func(txn *client.Txn) error {
+		// The batch is built anew by each attempt at the transaction, as
+		// the calls of a committed batch can't be sent again.
+		b := &client.Batch{}
+		for i, key := range batch.keys {
+			b.CPut(key, batch.values[i], nil)
+		}
+		b.Put(keys.MakeImportCheckpointKey(ip.tableID, ip.source, batch.start), batch.end)
+		return txn.Commit(b)
+	}); err != nil {
+		if _, ok := err.(*proto.ConditionFailedError); ok {
+			return fmt.Errorf("%s: rows %d-%d: duplicate primary key value",
+				ip.source, batch.start+1, batch.end)
+		}
+		return err
+	}
+	ip.mu.Lock()
+	defer ip.mu.Unlock()
+	ip.rows += batch.end - batch.start
+	return nil
+}
+
+// fail records an error writing a batch. Only the first error is kept.
+func (ip *importProgress) fail(err error) {
+	ip.mu.Lock()
+	defer ip.mu.Unlock()
+	if ip.firstErr == nil {
+		ip.firstErr = err
+	}
+}
+
+func (ip *importProgress) err() error {
+	ip.mu.Lock()
+	defer ip.mu.Unlock()
+	return ip.firstErr
+}
+
+// importValue converts a value of a CSV record to the type of the column.
+func importValue(col structured.ColumnDescriptor, s string) (parser.Datum, error) {
+	if s == "NULL" {
+		return parser.DNull{}, nil
+	}
+	switch col.Type.Kind {
+	case structured.ColumnType_CHAR, structured.ColumnType_TEXT,
+		structured.ColumnType_BLOB:
+		return parser.DString(s), nil
+	}
+	if s == "" {
+		return parser.DNull{}, nil
+	}
+	switch col.Type.Kind {
+	case structured.ColumnType_BIT, structured.ColumnType_INT:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %q as type %s for column \"%s\"",
+				s, col.Type.SQLString(), col.Name)
+		}
+		return parser.DInt(i), nil
+	case structured.ColumnType_FLOAT:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %q as type %s for column \"%s\"",
+				s, col.Type.SQLString(), col.Name)
+		}
+		return parser.DFloat(f), nil
+	}
+	return nil, fmt.Errorf("cannot import values of type %s for column \"%s\"",
+		col.Type.SQLString(), col.Name)
+}
+
+// addRow adds the key/value pairs of the row, which holds the values of
+// all of the columns of the table, to the batch. The key/value pairs are
+// written conditionally on their keys not existing, so that a row whose
+// primary key already exists is rejected.
+func (ib *importBatch) addRow(desc *structured.TableDescriptor,
+	colMap map[uint32]int, row parser.DTuple) error {
+	for i, id := range desc.Indexes[0].ColumnIDs {
+		if row[colMap[id]] == (parser.DNull{}) {
+			return fmt.Errorf("primary key column \"%s\" must not be NULL",
+				desc.Indexes[0].ColumnNames[i])
+		}
+	}
+	indexKey := encodeIndexKeyPrefix(desc.ID, desc.Indexes[0].ID)
+	primaryKey, err := encodeIndexKey(desc.Indexes[0], colMap, row, indexKey)
+	if err != nil {
+		return err
+	}
+	for i, val := range row {
+		var value interface{}
+		switch t := val.(type) {
+		case parser.DInt:
+			value = int64(t)
+		case parser.DFloat:
+			value = float64(t)
+		case parser.DString:
+			value = string(t)
+		default:
+			continue
+		}
+		ib.keys = append(ib.keys, encodeColumnKey(desc.Columns[i], primaryKey))
+		ib.values = append(ib.values, value)
+	}
+	return nil
+}
@@ -22,17 +22,23 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/structured"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
 // Insert inserts rows into the database.
+// Privileges: INSERT on table.
 func (p *planner) Insert(n *parser.Insert) (planNode, error) {
-	desc, err := p.getTableDesc(n.Table)
+	desc, err := p.getTableLease(n.Table)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := p.checkPrivilege(desc, privilege.INSERT); err != nil {
+		return nil, err
+	}
+
 	// Determine which columns we're inserting into.
 	cols, err := p.processColumns(desc, n.Columns)
 	if err != nil {
@@ -46,6 +52,24 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		colMap[c.ID] = i
 	}
 
+	// The columns which are not inserted into but have a default expression
+	// are set to the value of the expression, evaluated for each row. Their
+	// values follow the inserted values within a row.
+	numInserted := len(cols)
+	var defaultExprs []parser.Expr
+	for _, col := range desc.Columns {
+		if _, ok := colMap[col.ID]; ok || col.DefaultExpr == nil {
+			continue
+		}
+		expr, err := parser.ParseExpr(*col.DefaultExpr)
+		if err != nil {
+			return nil, err
+		}
+		colMap[col.ID] = len(cols)
+		cols = append(cols, col)
+		defaultExprs = append(defaultExprs, expr)
+	}
+
 	// Verify we have at least the columns that are part of the primary key.
 	for i, id := range desc.Indexes[0].ColumnIDs {
 		if _, ok := colMap[id]; !ok {
@@ -53,6 +77,11 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		}
 	}
 
+	checkExprs, err := parseChecks(desc)
+	if err != nil {
+		return nil, err
+	}
+
 	// Transform the values into a rows object. This expands SELECT statements or
 	// generates rows from the values contained within the query.
 	rows, err := p.makePlan(n.Rows)
@@ -61,10 +90,29 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 	}
 
 	b := client.Batch{}
+	// The rows to check against the foreign keys of the table.
+	var fkRows []parser.DTuple
+	var inserted int
 	for rows.Next() {
 		values := rows.Values()
-		if len(values) != len(cols) {
-			return nil, fmt.Errorf("invalid values for columns: %d != %d", len(values), len(cols))
+		if len(values) != numInserted {
+			return nil, fmt.Errorf("invalid values for columns: %d != %d", len(values), numInserted)
+		}
+		if len(defaultExprs) > 0 {
+			values = append(parser.DTuple(nil), values...)
+			for _, expr := range defaultExprs {
+				d, err := parser.EvalExpr(expr, funcEnv{p: p})
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, d)
+			}
+		}
+		if err := checkRow(desc, checkExprs, colMap, values); err != nil {
+			return nil, err
+		}
+		if len(desc.ForeignKeys) > 0 {
+			fkRows = append(fkRows, append(parser.DTuple(nil), values...))
 		}
 		indexKey := encodeIndexKeyPrefix(desc.ID, desc.Indexes[0].ID)
 		primaryKey, err := encodeIndexKey(desc.Indexes[0], colMap, values, indexKey)
@@ -88,14 +136,24 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 				b.Put(key, string(t))
 			}
 		}
+		inserted++
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	if err := p.db.Run(&b); err != nil {
+	// The referenced rows are checked within the transaction writing the new
+	// rows.
+	if err := p.txn(func(txn *client.Txn) error {
+		for _, row := range fkRows {
+			if err := p.checkForeignKeys(txn, desc, colMap, row); err != nil {
+				return err
+			}
+		}
+		return txn.Commit(&b)
+	}); err != nil {
 		return nil, err
 	}
-	// TODO(tamird/pmattis): return the number of affected rows
+	p.rowsAffected = int64(inserted)
 	return &valuesNode{}, nil
 }
 
@@ -0,0 +1,444 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/retry"
+)
+
+var (
+	// LeaseDuration is the duration for which a lease on a table descriptor
+	// is granted.
+	LeaseDuration = 5 * time.Minute
+	// MinLeaseDuration is the minimum duration a lease must have remaining
+	// to be handed out. Leases closer to expiration are replaced by a new
+	// lease, which picks up the most recent version of the descriptor.
+	MinLeaseDuration = time.Minute
+
+	errTableDeleted = errors.New("table is being deleted")
+)
+
+// publishRetryOptions are the retry options used while waiting for the
+// leases on an old version of a descriptor to be released or expire.
+var publishRetryOptions = retry.Options{
+	InitialBackoff: 20 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+}
+
+// LeaseState holds the state for a lease on a table descriptor.
+type LeaseState struct {
+	structured.TableDescriptor
+	// expiration is the wall time in nanoseconds at which the lease expires.
+	expiration int64
+	// refcount is the number of statements currently using the lease.
+	refcount int
+}
+
+func (s *LeaseState) String() string {
+	return fmt.Sprintf("%d(%q) ver=%d:%d", s.ID, s.Name, s.Version, s.expiration)
+}
+
+// Expiration returns the expiration time of the lease.
+func (s *LeaseState) Expiration() time.Time {
+	return time.Unix(0, s.expiration)
+}
+
+// hasSomeLifeLeft returns true if the lease has at least MinLeaseDuration
+// left until expiration.
+func (s *LeaseState) hasSomeLifeLeft(now int64) bool {
+	return s.expiration-now > int64(MinLeaseDuration)
+}
+
+// makeLeaseKey returns the key under which the lease held by nodeID is
+// recorded.
+func makeLeaseKey(s *LeaseState, nodeID uint32) proto.Key {
+	k := keys.MakeDescLeasePrefix(s.ID, s.Version)
+	k = encoding.EncodeUvarint(k, uint64(s.expiration))
+	return encoding.EncodeUvarint(k, uint64(nodeID))
+}
+
+// LeaseStore implements the operations for acquiring and releasing leases
+// and publishing new versions of table descriptors. Leases are recorded in
+// the KV store so that nodes publishing a new version of a descriptor can
+// wait for the leases on older versions to be released or expire.
+type LeaseStore struct {
+	db     *client.DB
+	clock  *hlc.Clock
+	nodeID uint32
+}
+
+// Acquire a lease on the most recent version of a table descriptor.
+func (s LeaseStore) Acquire(tableID uint32) (*LeaseState, error) {
+	lease := &LeaseState{}
+	lease.expiration = s.clock.PhysicalNow() + int64(LeaseDuration)
+
+	err := s.db.Txn(func(txn *client.Txn) error {
+		descKey := keys.MakeDescMetadataKey(tableID)
+		if err := txn.GetProto(descKey, &lease.TableDescriptor); err != nil {
+			return err
+		}
+		if lease.ID == 0 {
+			return fmt.Errorf("descriptor %d does not exist", tableID)
+		}
+		if lease.Deleted {
+			return errTableDeleted
+		}
+		if err := lease.Validate(); err != nil {
+			return err
+		}
+		b := &client.Batch{}
+		b.Put(makeLeaseKey(lease, s.nodeID), int64(s.nodeID))
+		return txn.Commit(b)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// Release a previously acquired table descriptor lease.
+func (s LeaseStore) Release(lease *LeaseState) error {
+	return s.db.Del(makeLeaseKey(lease, s.nodeID))
+}
+
+// countLeases returns the number of unexpired leases on the specified
+// version of a descriptor. A lease is considered unexpired as long as
+// another node's clock could still consider it valid.
+func (s LeaseStore) countLeases(txn *client.Txn, descID, version uint32) (int, error) {
+	prefix := keys.MakeDescLeasePrefix(descID, version)
+	rows, err := txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return 0, err
+	}
+	now := s.clock.PhysicalNow()
+	count := 0
+	for _, row := range rows {
+		_, expiration := encoding.DecodeUvarint(row.Key[len(prefix):])
+		if int64(expiration)+int64(s.clock.MaxOffset()) > now {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Publish updates a table descriptor and increments its version. The
+// update is only performed once there are no outstanding leases on
+// versions older than the current one, ensuring that at most two versions
+// of a descriptor are in use at any time. The update closure may be
+// called multiple times and must only modify the supplied descriptor.
+func (s LeaseStore) Publish(tableID uint32,
+	update func(*structured.TableDescriptor) error) (*structured.TableDescriptor, error) {
+	descs, err := s.PublishMultiple([]uint32{tableID}, update, nil)
+	if err != nil {
+		return nil, err
+	}
+	return descs[0], nil
+}
+
+// PublishMultiple is like Publish, but updates the descriptors of several
+// tables in a single transaction, once none of them has outstanding leases
+// on older versions. If batch is not nil, it is called to add further
+// operations to the transaction.
+func (s LeaseStore) PublishMultiple(tableIDs []uint32,
+	update func(*structured.TableDescriptor) error,
+	batch func(*client.Batch)) ([]*structured.TableDescriptor, error) {
+	for r := retry.Start(publishRetryOptions); r.Next(); {
+		descs := make([]*structured.TableDescriptor, len(tableIDs))
+		waiting := false
+		err := s.db.Txn(func(txn *client.Txn) error {
+			waiting = false
+			for i, tableID := range tableIDs {
+				desc := &structured.TableDescriptor{}
+				if err := txn.GetProto(keys.MakeDescMetadataKey(tableID), desc); err != nil {
+					return err
+				}
+				if desc.ID == 0 {
+					return fmt.Errorf("descriptor %d does not exist", tableID)
+				}
+				if desc.Version > 0 {
+					count, err := s.countLeases(txn, tableID, desc.Version-1)
+					if err != nil {
+						return err
+					}
+					if count > 0 {
+						waiting = true
+						return nil
+					}
+				}
+				descs[i] = desc
+			}
+
+			b := &client.Batch{}
+			for _, desc := range descs {
+				if err := update(desc); err != nil {
+					return err
+				}
+				desc.Version++
+				if err := desc.Validate(); err != nil {
+					return err
+				}
+				b.Put(keys.MakeDescMetadataKey(desc.ID), desc)
+			}
+			if batch != nil {
+				batch(b)
+			}
+			return txn.Commit(b)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !waiting {
+			return descs, nil
+		}
+		if log.V(1) {
+			log.Infof("publish %v: waiting for leases on old versions to drain", tableIDs)
+		}
+	}
+	return nil, util.Errorf("unable to publish descriptors %v", tableIDs)
+}
+
+// WaitForOneVersion returns once there are no unexpired leases on versions
+// of the descriptors older than their current ones.
+func (s LeaseStore) WaitForOneVersion(tableIDs ...uint32) error {
+	for r := retry.Start(publishRetryOptions); r.Next(); {
+		count := 0
+		err := s.db.Txn(func(txn *client.Txn) error {
+			count = 0
+			for _, tableID := range tableIDs {
+				desc := structured.TableDescriptor{}
+				if err := txn.GetProto(keys.MakeDescMetadataKey(tableID), &desc); err != nil {
+					return err
+				}
+				if desc.ID == 0 || desc.Version == 0 {
+					continue
+				}
+				n, err := s.countLeases(txn, tableID, desc.Version-1)
+				if err != nil {
+					return err
+				}
+				count += n
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+	}
+	return util.Errorf("unable to wait for descriptors %v", tableIDs)
+}
+
+// tableState holds the leases held by this node on the versions of a
+// single table descriptor.
+type tableState struct {
+	id uint32
+	mu sync.Mutex
+	// active holds the leases, sorted by version and expiration.
+	active []*LeaseState
+	// minVersion is the minimum version which can be handed out. Versions
+	// older than this have been superseded by a descriptor published from
+	// this node.
+	minVersion uint32
+}
+
+func (t *tableState) acquire(store LeaseStore) (*LeaseState, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if n := len(t.active); n > 0 {
+		s := t.active[n-1]
+		if s.Version >= t.minVersion && s.hasSomeLifeLeft(store.clock.PhysicalNow()) {
+			s.refcount++
+			return s, nil
+		}
+	}
+
+	s, err := store.Acquire(t.id)
+	if err != nil {
+		return nil, err
+	}
+	s.refcount++
+	t.active = append(t.active, s)
+	t.releaseInactive(store, false)
+	return s, nil
+}
+
+func (t *tableState) release(lease *LeaseState, store LeaseStore) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if lease.refcount <= 0 {
+		return util.Errorf("table %d: lease %s released too many times", t.id, lease)
+	}
+	lease.refcount--
+	if lease.refcount == 0 {
+		t.releaseInactive(store, false)
+	}
+	return nil
+}
+
+// releaseInactive releases the leases which are no longer in use and which
+// will not be handed out again. If all is true, every lease which is not in
+// use is released, including the most recent one. The mutex must be held.
+func (t *tableState) releaseInactive(store LeaseStore, all bool) {
+	now := store.clock.PhysicalNow()
+	active := t.active[:0]
+	for i, s := range t.active {
+		newest := i == len(t.active)-1
+		usable := newest && !all && s.Version >= t.minVersion && s.hasSomeLifeLeft(now)
+		if s.refcount > 0 || usable {
+			active = append(active, s)
+			continue
+		}
+		if err := store.Release(s); err != nil {
+			log.Warningf("error releasing lease %s: %s", s, err)
+		}
+	}
+	t.active = active
+}
+
+// LeaseManager manages the leases held by a node on table descriptors and
+// caches the resolution of table and database names to descriptor IDs.
+// Acquiring a lease on a table the node already holds a lease on does not
+// require any KV operations.
+type LeaseManager struct {
+	LeaseStore
+	mu     sync.Mutex
+	tables map[uint32]*tableState
+	// names caches the descriptor ID each name metadata key refers to.
+	names map[string]uint32
+}
+
+// NewLeaseManager creates a new LeaseManager for the node with the
+// specified ID.
+func NewLeaseManager(nodeID uint32, db *client.DB, clock *hlc.Clock) *LeaseManager {
+	return &LeaseManager{
+		LeaseStore: LeaseStore{
+			db:     db,
+			clock:  clock,
+			nodeID: nodeID,
+		},
+		tables: make(map[uint32]*tableState),
+		names:  make(map[string]uint32),
+	}
+}
+
+// Acquire acquires a lease on the most recent version of the specified
+// table descriptor known to this node. The lease must be released with
+// Release once it is no longer needed.
+func (m *LeaseManager) Acquire(tableID uint32) (*LeaseState, error) {
+	return m.findTableState(tableID, true).acquire(m.LeaseStore)
+}
+
+// Release releases a previously acquired lease.
+func (m *LeaseManager) Release(lease *LeaseState) error {
+	t := m.findTableState(lease.ID, false)
+	if t == nil {
+		return util.Errorf("table %d not found", lease.ID)
+	}
+	return t.release(lease, m.LeaseStore)
+}
+
+// Publish updates a table descriptor and increments its version. See
+// LeaseStore.Publish. The leases held by this node which are not in use are
+// released first so that the node does not wait on itself, and leases on
+// older versions are not handed out once the new version is published.
+func (m *LeaseManager) Publish(tableID uint32,
+	update func(*structured.TableDescriptor) error) (*structured.TableDescriptor, error) {
+	descs, err := m.PublishMultiple([]uint32{tableID}, update, nil)
+	if err != nil {
+		return nil, err
+	}
+	return descs[0], nil
+}
+
+// PublishMultiple updates several table descriptors in a single
+// transaction. See LeaseStore.PublishMultiple and Publish.
+func (m *LeaseManager) PublishMultiple(tableIDs []uint32,
+	update func(*structured.TableDescriptor) error,
+	batch func(*client.Batch)) ([]*structured.TableDescriptor, error) {
+	tables := make([]*tableState, len(tableIDs))
+	for i, tableID := range tableIDs {
+		tables[i] = m.findTableState(tableID, true)
+		tables[i].mu.Lock()
+		tables[i].releaseInactive(m.LeaseStore, true)
+		tables[i].mu.Unlock()
+	}
+
+	descs, err := m.LeaseStore.PublishMultiple(tableIDs, update, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, t := range tables {
+		t.mu.Lock()
+		if descs[i].Version > t.minVersion {
+			t.minVersion = descs[i].Version
+		}
+		t.releaseInactive(m.LeaseStore, true)
+		t.mu.Unlock()
+	}
+	return descs, nil
+}
+
+func (m *LeaseManager) findTableState(tableID uint32, create bool) *tableState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t := m.tables[tableID]
+	if t == nil && create {
+		t = &tableState{id: tableID}
+		m.tables[tableID] = t
+	}
+	return t
+}
+
+// cachedID returns the cached descriptor ID for a name metadata key.
+func (m *LeaseManager) cachedID(nameKey proto.Key) (uint32, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id, ok := m.names[string(nameKey)]
+	return id, ok
+}
+
+// cacheID caches the descriptor ID for a name metadata key.
+func (m *LeaseManager) cacheID(nameKey proto.Key, id uint32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.names[string(nameKey)] = id
+}
+
+// uncacheID removes a name metadata key from the cache.
+func (m *LeaseManager) uncacheID(nameKey proto.Key) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.names, string(nameKey))
+}
@@ -0,0 +1,207 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func putTestTableDesc(t *testing.T, s *kv.LocalTestCluster, id uint32) {
+	desc := structured.TableDescriptor{
+		ID:   id,
+		Name: "test",
+		Columns: []structured.ColumnDescriptor{
+			{Name: "a", Type: structured.ColumnType{Kind: structured.ColumnType_INT}},
+		},
+		Indexes: []structured.IndexDescriptor{
+			{Name: "primary", Unique: true, ColumnNames: []string{"a"}},
+		},
+		Privileges: structured.NewDefaultPrivilegeDescriptor(),
+	}
+	if err := desc.AllocateIDs(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DB.Put(keys.MakeDescMetadataKey(id), &desc); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLeaseManager(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &kv.LocalTestCluster{}
+	s.Start(t)
+	defer s.Stop()
+
+	const id = 1000
+	putTestTableDesc(t, s, id)
+
+	m1 := NewLeaseManager(1, s.DB, s.Clock)
+	m2 := NewLeaseManager(2, s.DB, s.Clock)
+
+	// Leases are reused while they have some life left.
+	l1, err := m1.Acquire(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l2, err := m1.Acquire(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l1 != l2 {
+		t.Fatalf("expected same lease, but found %s != %s", l1, l2)
+	}
+	for _, l := range []*LeaseState{l1, l2} {
+		if err := m1.Release(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m1.Release(l1); err == nil {
+		t.Fatalf("expected error releasing lease %s too many times", l1)
+	}
+
+	// Node 2 holds a lease on version 0.
+	l3, err := m2.Acquire(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l3.Version != 0 {
+		t.Fatalf("expected version 0, but found %d", l3.Version)
+	}
+
+	// Publishing version 1 does not need to wait for the leases on version 0.
+	desc, err := m1.Publish(id, func(*structured.TableDescriptor) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Version != 1 {
+		t.Fatalf("expected version 1, but found %d", desc.Version)
+	}
+	l4, err := m1.Acquire(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l4.Version != 1 {
+		t.Fatalf("expected version 1, but found %d", l4.Version)
+	}
+	if err := m1.Release(l4); err != nil {
+		t.Fatal(err)
+	}
+
+	// Publishing version 2 waits for node 2's lease on version 0 to expire.
+	done := make(chan error, 1)
+	go func() {
+		_, err := m1.Publish(id, func(*structured.TableDescriptor) error { return nil })
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("publish did not wait for the lease on version 0: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := m2.Release(l3); err != nil {
+		t.Fatal(err)
+	}
+	s.Manual.Increment(int64(LeaseDuration + s.Clock.MaxOffset()))
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// Node 2 picks up the newest version once its lease has expired.
+	l5, err := m2.Acquire(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l5.Version != 2 {
+		t.Fatalf("expected version 2, but found %d", l5.Version)
+	}
+	if err := m2.Release(l5); err != nil {
+		t.Fatal(err)
+	}
+
+	// Leases cannot be acquired on a deleted table.
+	if _, err := m1.Publish(id, func(desc *structured.TableDescriptor) error {
+		desc.Deleted = true
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m1.Acquire(id); err != errTableDeleted {
+		t.Fatalf("expected %s, but found %v", errTableDeleted, err)
+	}
+}
+
+func TestLeaseManagerPublishMultiple(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &kv.LocalTestCluster{}
+	s.Start(t)
+	defer s.Stop()
+
+	ids := []uint32{1000, 1001}
+	for _, id := range ids {
+		putTestTableDesc(t, s, id)
+	}
+
+	m1 := NewLeaseManager(1, s.DB, s.Clock)
+	m2 := NewLeaseManager(2, s.DB, s.Clock)
+
+	// Node 2 holds a lease on version 0 of the second table.
+	l, err := m2.Acquire(ids[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Both descriptors are updated, along with the additional operations.
+	descs, err := m1.PublishMultiple(ids, func(*structured.TableDescriptor) error { return nil },
+		func(b *client.Batch) { b.Put("a", "b") })
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, desc := range descs {
+		if desc.ID != ids[i] || desc.Version != 1 {
+			t.Fatalf("%d: expected version 1 of %d, but found %d of %d", i, ids[i], desc.Version, desc.ID)
+		}
+	}
+	if gr, err := s.DB.Get("a"); err != nil {
+		t.Fatal(err)
+	} else if !gr.Exists() {
+		t.Fatal("expected the batch to be committed along with the descriptors")
+	}
+
+	// Waiting for a single version waits for node 2's lease on the second
+	// table.
+	done := make(chan error, 1)
+	go func() {
+		done <- m1.WaitForOneVersion(ids...)
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("wait did not wait for the lease on version 0: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := m2.Release(l); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
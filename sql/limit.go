@@ -0,0 +1,99 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// evalLimit evaluates the count and offset of a LIMIT clause. A count of -1
+// means that the number of rows is not limited.
+func (p *planner) evalLimit(limit *parser.Limit) (count, offset int64, err error) {
+	count = -1
+	if limit == nil {
+		return count, 0, nil
+	}
+	for _, l := range []struct {
+		name string
+		expr parser.Expr
+		val  *int64
+	}{
+		{"LIMIT", limit.Count, &count},
+		{"OFFSET", limit.Offset, &offset},
+	} {
+		if l.expr == nil {
+			continue
+		}
+		d, err := parser.EvalExpr(l.expr, funcEnv{p: p})
+		if err != nil {
+			return 0, 0, err
+		}
+		if d == (parser.DNull{}) {
+			continue
+		}
+		v, ok := d.(parser.DInt)
+		if !ok {
+			return 0, 0, fmt.Errorf("argument of %s must be an integer, not %s", l.name, d.Type())
+		}
+		if v < 0 {
+			return 0, 0, fmt.Errorf("%s must not be negative", l.name)
+		}
+		*l.val = int64(v)
+	}
+	return count, offset, nil
+}
+
+// limitNode skips the first offset rows of a plan and returns at most count
+// of the following rows, all of them if count is -1.
+type limitNode struct {
+	plan   planNode
+	count  int64
+	offset int64
+	index  int64 // The number of rows retrieved from plan.
+}
+
+var _ planNode = &limitNode{}
+
+func (n *limitNode) Columns() []string {
+	return n.plan.Columns()
+}
+
+func (n *limitNode) Values() parser.DTuple {
+	return n.plan.Values()
+}
+
+func (n *limitNode) Next() bool {
+	for n.index < n.offset {
+		if !n.plan.Next() {
+			return false
+		}
+		n.index++
+	}
+	if n.count >= 0 && n.index >= n.offset+n.count {
+		return false
+	}
+	if !n.plan.Next() {
+		return false
+	}
+	n.index++
+	return true
+}
+
+func (n *limitNode) Err() error {
+	return n.plan.Err()
+}
@@ -52,8 +52,10 @@ type TableDef interface {
 	tableDef()
 }
 
-func (*ColumnTableDef) tableDef() {}
-func (*IndexTableDef) tableDef()  {}
+func (*ColumnTableDef) tableDef()          {}
+func (*IndexTableDef) tableDef()           {}
+func (*ForeignKeyTableDef) tableDef()      {}
+func (*CheckConstraintTableDef) tableDef() {}
 
 // TableDefs represents a list of table definitions.
 type TableDefs []TableDef
@@ -82,11 +84,14 @@ const (
 // ColumnTableDef represents a column dlefinition within a CREATE TABLE
 // statement.
 type ColumnTableDef struct {
-	Name       Name
-	Type       ColumnType
-	Nullable   Nullability
-	PrimaryKey bool
-	Unique     bool
+	Name        Name
+	Type        ColumnType
+	Nullable    Nullability
+	PrimaryKey  bool
+	Unique      bool
+	DefaultExpr Expr
+	References  *ReferencesConstraint
+	Checks      []*CheckConstraint
 }
 
 func newColumnTableDef(name Name, typ ColumnType,
@@ -97,7 +102,13 @@ func newColumnTableDef(name Name, typ ColumnType,
 		Nullable: SilentNull,
 	}
 	for _, c := range constraints {
-		switch c.(type) {
+		switch t := c.(type) {
+		case *DefaultConstraint:
+			d.DefaultExpr = t.Expr
+		case *ReferencesConstraint:
+			d.References = t
+		case *CheckConstraint:
+			d.Checks = append(d.Checks, t)
 		case NotNullConstraint:
 			d.Nullable = NotNull
 		case NullConstraint:
@@ -125,6 +136,15 @@ func (node *ColumnTableDef) String() string {
 	} else if node.Unique {
 		_, _ = buf.WriteString(" UNIQUE")
 	}
+	if node.DefaultExpr != nil {
+		fmt.Fprintf(&buf, " DEFAULT %s", node.DefaultExpr)
+	}
+	if node.References != nil {
+		fmt.Fprintf(&buf, " %s", node.References)
+	}
+	for _, c := range node.Checks {
+		fmt.Fprintf(&buf, " %s", c)
+	}
 	return buf.String()
 }
 
@@ -133,10 +153,33 @@ type ColumnConstraint interface {
 	columnConstraint()
 }
 
-func (NotNullConstraint) columnConstraint()    {}
-func (NullConstraint) columnConstraint()       {}
-func (PrimaryKeyConstraint) columnConstraint() {}
-func (UniqueConstraint) columnConstraint()     {}
+func (*CheckConstraint) columnConstraint()      {}
+func (*DefaultConstraint) columnConstraint()    {}
+func (NotNullConstraint) columnConstraint()     {}
+func (NullConstraint) columnConstraint()        {}
+func (PrimaryKeyConstraint) columnConstraint()  {}
+func (*ReferencesConstraint) columnConstraint() {}
+func (UniqueConstraint) columnConstraint()      {}
+
+// CheckConstraint represents CHECK on a column.
+type CheckConstraint struct {
+	Name Name
+	Expr Expr
+}
+
+func (node *CheckConstraint) String() string {
+	var buf bytes.Buffer
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "CONSTRAINT %s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "CHECK (%s)", node.Expr)
+	return buf.String()
+}
+
+// DefaultConstraint represents DEFAULT on a column.
+type DefaultConstraint struct {
+	Expr Expr
+}
 
 // NotNullConstraint represents NOT NULL on a column.
 type NotNullConstraint struct{}
@@ -150,6 +193,67 @@ type PrimaryKeyConstraint struct{}
 // UniqueConstraint represents UNIQUE on a column.
 type UniqueConstraint struct{}
 
+// ReferencesConstraint represents REFERENCES on a column. If Columns is
+// empty, the column references the primary key of Table.
+type ReferencesConstraint struct {
+	Table   QualifiedName
+	Columns NameList
+	Actions ReferenceActions
+}
+
+func (node *ReferencesConstraint) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "REFERENCES %s", node.Table)
+	if len(node.Columns) > 0 {
+		fmt.Fprintf(&buf, " (%s)", node.Columns)
+	}
+	_, _ = buf.WriteString(node.Actions.String())
+	return buf.String()
+}
+
+// ReferenceAction is the action taken on the referencing rows of a foreign
+// key when the referenced row is deleted or updated.
+type ReferenceAction int
+
+// The values for ReferenceAction.
+const (
+	NoAction ReferenceAction = iota
+	Restrict
+	Cascade
+	SetNull
+	SetDefault
+)
+
+var referenceActionName = [...]string{
+	NoAction:   "NO ACTION",
+	Restrict:   "RESTRICT",
+	Cascade:    "CASCADE",
+	SetNull:    "SET NULL",
+	SetDefault: "SET DEFAULT",
+}
+
+func (a ReferenceAction) String() string {
+	return referenceActionName[a]
+}
+
+// ReferenceActions represents the ON DELETE and ON UPDATE actions of a
+// foreign key.
+type ReferenceActions struct {
+	Delete ReferenceAction
+	Update ReferenceAction
+}
+
+func (node ReferenceActions) String() string {
+	var buf bytes.Buffer
+	if node.Delete != NoAction {
+		fmt.Fprintf(&buf, " ON DELETE %s", node.Delete)
+	}
+	if node.Update != NoAction {
+		fmt.Fprintf(&buf, " ON UPDATE %s", node.Update)
+	}
+	return buf.String()
+}
+
 // IndexTableDef represents an index definition within a CREATE TABLE
 // statement.
 type IndexTableDef struct {
@@ -175,6 +279,46 @@ func (node *IndexTableDef) String() string {
 	return buf.String()
 }
 
+// ForeignKeyTableDef represents a FOREIGN KEY definition within a CREATE
+// TABLE statement. If RefColumns is empty, the columns reference the primary
+// key of Table.
+type ForeignKeyTableDef struct {
+	Name       Name
+	Columns    NameList
+	Table      QualifiedName
+	RefColumns NameList
+	Actions    ReferenceActions
+}
+
+func (node *ForeignKeyTableDef) String() string {
+	var buf bytes.Buffer
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "CONSTRAINT %s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "FOREIGN KEY (%s) REFERENCES %s", node.Columns, node.Table)
+	if len(node.RefColumns) > 0 {
+		fmt.Fprintf(&buf, " (%s)", node.RefColumns)
+	}
+	_, _ = buf.WriteString(node.Actions.String())
+	return buf.String()
+}
+
+// CheckConstraintTableDef represents a CHECK constraint within a CREATE
+// TABLE statement.
+type CheckConstraintTableDef struct {
+	Name Name
+	Expr Expr
+}
+
+func (node *CheckConstraintTableDef) String() string {
+	var buf bytes.Buffer
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "CONSTRAINT %s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "CHECK (%s)", node.Expr)
+	return buf.String()
+}
+
 // CreateTable represents a CREATE TABLE statement.
 type CreateTable struct {
 	IfNotExists bool
@@ -191,3 +335,37 @@ func (node *CreateTable) String() string {
 	fmt.Fprintf(&buf, " %s (%s)", node.Table, node.Defs)
 	return buf.String()
 }
+
+// CreateView represents a CREATE VIEW statement. If ColumnNames is empty,
+// the columns of the view are named after the columns of AsSource.
+type CreateView struct {
+	Name        QualifiedName
+	ColumnNames NameList
+	AsSource    SelectStatement
+}
+
+func (node *CreateView) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "CREATE VIEW %s ", node.Name)
+	if len(node.ColumnNames) > 0 {
+		fmt.Fprintf(&buf, "(%s) ", node.ColumnNames)
+	}
+	fmt.Fprintf(&buf, "AS %s", node.AsSource)
+	return buf.String()
+}
+
+// CreateSequence represents a CREATE SEQUENCE statement.
+type CreateSequence struct {
+	IfNotExists bool
+	Name        QualifiedName
+}
+
+func (node *CreateSequence) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("CREATE SEQUENCE ")
+	if node.IfNotExists {
+		_, _ = buf.WriteString("IF NOT EXISTS ")
+	}
+	_, _ = buf.WriteString(node.Name.String())
+	return buf.String()
+}
@@ -41,6 +41,22 @@ func (node *DropDatabase) String() string {
 	return buf.String()
 }
 
+// DropView represents a DROP VIEW statement.
+type DropView struct {
+	Names    QualifiedNames
+	IfExists bool
+}
+
+func (node *DropView) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("DROP VIEW ")
+	if node.IfExists {
+		_, _ = buf.WriteString("IF EXISTS ")
+	}
+	_, _ = buf.WriteString(node.Names.String())
+	return buf.String()
+}
+
 // DropTable represents a DROP TABLE statement.
 type DropTable struct {
 	Names    QualifiedNames
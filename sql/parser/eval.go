@@ -346,6 +346,16 @@ func (*nilEnv) Get(_ string) (Datum, bool) {
 
 var emptyEnv *nilEnv
 
+// FuncEnv is implemented by environments which provide functions in addition
+// to the builtins. This is used for functions whose results depend on
+// database or session state, such as nextval.
+type FuncEnv interface {
+	Env
+	// EvalFunc evaluates the function with the specified lower-cased name.
+	// Returns false if the environment does not provide the function.
+	EvalFunc(name string, args DTuple) (Datum, bool, error)
+}
+
 // EvalExpr evaluates an SQL expression in the context of an
 // environment. Expression evaluation is a mostly straightforward walk over the
 // parse tree. The only significant complexity is the handling of types and
@@ -665,10 +675,11 @@ func evalUnaryExpr(expr *UnaryExpr, env Env) (Datum, error) {
 func evalFuncExpr(expr *FuncExpr, env Env) (Datum, error) {
 	name := strings.ToLower(expr.Name.String())
 	b, ok := builtins[name]
-	if !ok {
+	fenv, isFuncEnv := env.(FuncEnv)
+	if !ok && !isFuncEnv {
 		return null, fmt.Errorf("%s: unknown function", expr.Name)
 	}
-	if b.nArgs != -1 && b.nArgs != len(expr.Exprs) {
+	if ok && b.nArgs != -1 && b.nArgs != len(expr.Exprs) {
 		return null, fmt.Errorf("%s: incorrect number of arguments: %d vs %d",
 			expr.Name, b.nArgs, len(expr.Exprs))
 	}
@@ -682,6 +693,15 @@ func evalFuncExpr(expr *FuncExpr, env Env) (Datum, error) {
 		args = append(args, arg)
 	}
 
+	if !ok {
+		res, found, err := fenv.EvalFunc(name, args)
+		if err != nil {
+			return null, fmt.Errorf("%s: %v", expr.Name, err)
+		} else if !found {
+			return null, fmt.Errorf("%s: unknown function", expr.Name)
+		}
+		return res, nil
+	}
 	res, err := b.fn(args)
 	if err != nil {
 		return null, fmt.Errorf("%s: %v", expr.Name, err)
@@ -241,3 +241,59 @@ func TestEvalExprError(t *testing.T) {
 		}
 	}
 }
+
+type testFuncEnv struct {
+	mapEnv
+}
+
+func (testFuncEnv) EvalFunc(name string, args DTuple) (Datum, bool, error) {
+	if name != "twice" {
+		return nil, false, nil
+	}
+	i, ok := args[0].(DInt)
+	if !ok {
+		return nil, true, argTypeError(args[0], "int")
+	}
+	return i * 2, true, nil
+}
+
+func TestEvalFuncEnv(t *testing.T) {
+	env := testFuncEnv{mapEnv{"a": DInt(3)}}
+	testData := []struct {
+		expr     string
+		expected string
+	}{
+		{`twice(a) + 1`, `7`},
+		{`TWICE(length('abc'))`, `6`},
+		{`upper('a')`, `'A'`},
+	}
+	for _, d := range testData {
+		expr, err := ParseExpr(d.expr)
+		if err != nil {
+			t.Fatalf("%s: %v", d.expr, err)
+		}
+		r, err := EvalExpr(expr, env)
+		if err != nil {
+			t.Fatalf("%s: %v", d.expr, err)
+		}
+		if s := r.String(); d.expected != s {
+			t.Errorf("%s: expected %s, but found %s", d.expr, d.expected, s)
+		}
+	}
+
+	for _, d := range []struct {
+		expr     string
+		expected string
+	}{
+		{`twice('a')`, `twice: argument type mismatch`},
+		{`triple(1)`, `triple: unknown function`},
+	} {
+		expr, err := ParseExpr(d.expr)
+		if err != nil {
+			t.Fatalf("%s: %v", d.expr, err)
+		}
+		if _, err := EvalExpr(expr, env); !testutils.IsError(err, d.expected) {
+			t.Errorf("%s: expected %s, but found %v", d.expr, d.expected, err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/privilege"
+)
+
+// TargetList represents a list of targets.
+// Only one field may be non-nil.
+type TargetList struct {
+	Databases NameList
+	Tables    QualifiedNames
+}
+
+func (tl TargetList) String() string {
+	if tl.Databases != nil {
+		return fmt.Sprintf("DATABASE %s", tl.Databases)
+	}
+	return tl.Tables.String()
+}
+
+// Grant represents a GRANT statement.
+type Grant struct {
+	Privileges privilege.List
+	Targets    TargetList
+	Grantees   NameList
+}
+
+func (node *Grant) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("GRANT ")
+	_, _ = buf.WriteString(node.Privileges.String())
+	fmt.Fprintf(&buf, " ON %s TO %s", node.Targets, node.Grantees)
+	return buf.String()
+}
+
+// Revoke represents a REVOKE statement.
+type Revoke struct {
+	Privileges privilege.List
+	Targets    TargetList
+	Grantees   NameList
+}
+
+func (node *Revoke) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("REVOKE ")
+	_, _ = buf.WriteString(node.Privileges.String())
+	fmt.Fprintf(&buf, " ON %s FROM %s", node.Targets, node.Grantees)
+	return buf.String()
+}
@@ -57,6 +57,7 @@ var keywords = map[string]int{
 	"BY":                BY,
 	"CACHE":             CACHE,
 	"CALLED":            CALLED,
+	"CANCEL":            CANCEL,
 	"CASCADE":           CASCADE,
 	"CASCADED":          CASCADED,
 	"CASE":              CASE,
@@ -170,6 +171,7 @@ var keywords = map[string]int{
 	"GLOBAL":            GLOBAL,
 	"GRANT":             GRANT,
 	"GRANTED":           GRANTED,
+	"GRANTS":            GRANTS,
 	"GREATEST":          GREATEST,
 	"GROUP":             GROUP,
 	"GROUPING":          GROUPING,
@@ -294,6 +296,7 @@ var keywords = map[string]int{
 	"PROCEDURAL":        PROCEDURAL,
 	"PROCEDURE":         PROCEDURE,
 	"PROGRAM":           PROGRAM,
+	"QUERY":             QUERY,
 	"QUOTE":             QUOTE,
 	"RANGE":             RANGE,
 	"READ":              READ,
@@ -333,6 +336,7 @@ var keywords = map[string]int{
 	"SELECT":            SELECT,
 	"SEQUENCE":          SEQUENCE,
 	"SEQUENCES":         SEQUENCES,
+	"SERIAL":            SERIAL,
 	"SERIALIZABLE":      SERIALIZABLE,
 	"SERVER":            SERVER,
 	"SESSION":           SESSION,
@@ -26,6 +26,7 @@ package parser
 import (
 	"bytes"
 	"errors"
+	"fmt"
 )
 
 //go:generate make
@@ -52,3 +53,19 @@ func Parse(sql string) (StatementList, error) {
 	}
 	return s.stmts, nil
 }
+
+// ParseExpr parses a single SQL expression.
+func ParseExpr(expr string) (Expr, error) {
+	stmts, err := Parse("SELECT " + expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) == 1 {
+		if sel, ok := stmts[0].(*Select); ok && len(sel.From) == 0 && len(sel.Exprs) == 1 {
+			if e, ok := sel.Exprs[0].(*NonStarExpr); ok && e.As == "" {
+				return e.Expr, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("invalid expression: %s", expr)
+}
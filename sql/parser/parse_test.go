@@ -34,6 +34,12 @@ func TestParse(t *testing.T) {
 		{``},
 		{`VALUES ("")`},
 
+		{`CANCEL QUERY 12`},
+		{`CANCEL QUERY $1`},
+
+		{`IMPORT INTO a.b CSV '/tmp/b.csv'`},
+		{`IMPORT INTO b CSV $1`},
+
 		{`CREATE DATABASE a`},
 		{`CREATE DATABASE IF NOT EXISTS a`},
 		{`CREATE TABLE a ()`},
@@ -54,6 +60,26 @@ func TestParse(t *testing.T) {
 		{`CREATE TABLE a (b INT, UNIQUE (b))`},
 		{`CREATE TABLE a.b (b INT)`},
 		{`CREATE TABLE IF NOT EXISTS a (b INT)`},
+		{`CREATE TABLE a (b INT DEFAULT 1)`},
+		{`CREATE TABLE a (b INT NOT NULL PRIMARY KEY DEFAULT unique_rowid())`},
+		{`CREATE TABLE a (b SERIAL PRIMARY KEY)`},
+		{`CREATE TABLE a (b INT REFERENCES c)`},
+		{`CREATE TABLE a (b INT NOT NULL REFERENCES c.d (e) ON DELETE CASCADE)`},
+		{`CREATE TABLE a (b INT, c INT, FOREIGN KEY (b, c) REFERENCES d)`},
+		{`CREATE TABLE a (b INT, c INT, CONSTRAINT e FOREIGN KEY (b, c) REFERENCES d (f, g) ON DELETE RESTRICT ON UPDATE CASCADE)`},
+		{`CREATE TABLE a (b INT CHECK (b > 0))`},
+		{`CREATE TABLE a (b INT CONSTRAINT c CHECK (b > 0) CHECK (b < 10))`},
+		{`CREATE TABLE a (b INT, c INT, CHECK (b < c))`},
+		{`CREATE TABLE a (b INT, c INT, CONSTRAINT d CHECK (b < c))`},
+		{`CREATE VIEW a AS SELECT b FROM c`},
+		{`CREATE VIEW a.b (c, d) AS SELECT e, f + 1 FROM g WHERE e > 1`},
+		{`CREATE VIEW a AS VALUES (1, 2)`},
+		{`ALTER TABLE a ADD CHECK (b > 0)`},
+		{`ALTER TABLE IF EXISTS a.b ADD CONSTRAINT c CHECK (d > 0), ADD CONSTRAINT e FOREIGN KEY (f) REFERENCES g`},
+
+		{`CREATE SEQUENCE a`},
+		{`CREATE SEQUENCE a.b`},
+		{`CREATE SEQUENCE IF NOT EXISTS a`},
 
 		{`DELETE FROM a`},
 		{`DELETE FROM a.b`},
@@ -65,6 +91,8 @@ func TestParse(t *testing.T) {
 		{`DROP TABLE a.b`},
 		{`DROP TABLE a, b`},
 		{`DROP TABLE IF EXISTS a`},
+		{`DROP VIEW a`},
+		{`DROP VIEW IF EXISTS a.b, c`},
 
 		{`SHOW DATABASES`},
 		{`SHOW TABLES`},
@@ -76,6 +104,27 @@ func TestParse(t *testing.T) {
 		{`SHOW INDEX FROM a.b.c`},
 		{`SHOW TABLES FROM a; SHOW COLUMNS FROM b`},
 
+		{`SHOW GRANTS`},
+		{`SHOW GRANTS ON foo`},
+		{`SHOW GRANTS ON foo, db.foo`},
+		{`SHOW GRANTS ON DATABASE foo, bar`},
+		{`SHOW GRANTS ON DATABASE foo FOR bar`},
+		{`SHOW GRANTS FOR bar, baz`},
+
+		{`GRANT SELECT ON foo TO root`},
+		{`GRANT SELECT, DELETE, UPDATE ON foo, db.foo TO root, bar`},
+		{`GRANT DROP ON DATABASE foo TO root`},
+		{`GRANT ALL ON DATABASE foo TO root, test`},
+		{`GRANT SELECT, INSERT ON DATABASE bar TO foo, bar, baz`},
+		{`GRANT SELECT, INSERT ON DATABASE db1, db2 TO foo, bar, baz`},
+
+		{`REVOKE SELECT ON foo FROM root`},
+		{`REVOKE UPDATE, DELETE ON foo, db.foo FROM root, bar`},
+		{`REVOKE INSERT ON DATABASE foo FROM root`},
+		{`REVOKE ALL ON DATABASE foo FROM root, test`},
+		{`REVOKE SELECT, INSERT ON DATABASE bar FROM foo, bar, baz`},
+		{`REVOKE SELECT, INSERT ON DATABASE db1, db2 FROM foo, bar, baz`},
+
 		{`INSERT INTO a VALUES (1)`},
 		{`INSERT INTO a.b VALUES (1)`},
 		{`INSERT INTO a VALUES (1, 2)`},
@@ -198,6 +247,12 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t UNION SELECT 1 FROM t UNION SELECT 1 FROM t`},
 		{`SELECT FROM t EXCEPT SELECT 1 FROM t`},
 		{`SELECT FROM t INTERSECT SELECT 1 FROM t`},
+		{`SELECT FROM t UNION ALL SELECT 1 FROM t`},
+		{`SELECT FROM t EXCEPT ALL SELECT 1 FROM t`},
+		{`SELECT FROM t INTERSECT ALL SELECT 1 FROM t`},
+		{`SELECT a FROM t UNION SELECT b FROM t ORDER BY a LIMIT 1`},
+		{`SELECT a FROM t ORDER BY a`},
+		{`SELECT a FROM t ORDER BY a ASC, b DESC LIMIT 1 OFFSET 2`},
 
 		{`SELECT FROM t1 JOIN t2 ON a = b`},
 		{`SELECT FROM t1 JOIN t2 USING (a)`},
@@ -217,6 +272,12 @@ func TestParse(t *testing.T) {
 		{`SET a = 3, 4`},
 		{`SET a = '3'`},
 		{`SET a = 3.0`},
+		{`SET a = DEFAULT`},
+		{`SET a.b = 'c'`},
+
+		{`SHOW a`},
+		{`SHOW a.b`},
+		{`SHOW ALL`},
 
 		{`TRUNCATE TABLE a`},
 		{`TRUNCATE TABLE a, b.c`},
@@ -287,8 +348,8 @@ func TestParse2(t *testing.T) {
 			`SELECT FROM t1 LEFT JOIN t2 ON a = b`},
 		{`SELECT FROM t1 RIGHT OUTER JOIN t2 ON a = b`,
 			`SELECT FROM t1 RIGHT JOIN t2 ON a = b`},
-		// TODO(pmattis): Handle UNION ALL.
-		{`SELECT FROM t UNION ALL SELECT 1 FROM t`,
+		// DISTINCT is the default for set operations.
+		{`SELECT FROM t UNION DISTINCT SELECT 1 FROM t`,
 			`SELECT FROM t UNION SELECT 1 FROM t`},
 		// We allow OFFSET before LIMIT, but always output LIMIT first.
 		{`SELECT FROM t OFFSET a LIMIT b`,
@@ -306,6 +367,22 @@ func TestParse2(t *testing.T) {
 		{`SELECT -0.-/*test*/-1`,
 			`SELECT - 0. - - 1`,
 		},
+		// SET and SHOW TIME ZONE use the time_zone session variable.
+		{`SET TIME ZONE 'UTC'`,
+			`SET time_zone = 'UTC'`},
+		{`SET TIME ZONE DEFAULT`,
+			`SET time_zone = DEFAULT`},
+		{`SET SESSION a TO 3`,
+			`SET a = 3`},
+		{`SHOW TIME ZONE`,
+			`SHOW time_zone`},
+		// The TABLE keyword and PRIVILEGES after ALL are optional.
+		{`GRANT SELECT ON TABLE foo TO root`,
+			`GRANT SELECT ON foo TO root`},
+		{`GRANT ALL PRIVILEGES ON DATABASE foo TO root`,
+			`GRANT ALL ON DATABASE foo TO root`},
+		{`REVOKE ALL PRIVILEGES ON TABLE foo FROM root`,
+			`REVOKE ALL ON foo FROM root`},
 	}
 	for _, d := range testData {
 		stmts, err := Parse(d.sql)
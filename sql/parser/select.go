@@ -70,6 +70,29 @@ func (node *Select) String() string {
 		node.Limit, node.Lock)
 }
 
+// withOrderByLimit sets the ORDER BY and LIMIT clauses of a SELECT
+// statement, which apply to the combined result of a UNION, INTERSECT or
+// EXCEPT.
+func withOrderByLimit(stmt Statement, orderBy OrderBy, limit *Limit) Statement {
+	switch t := stmt.(type) {
+	case *Select:
+		if orderBy != nil {
+			t.OrderBy = orderBy
+		}
+		if limit != nil {
+			t.Limit = limit
+		}
+	case *Union:
+		if orderBy != nil {
+			t.OrderBy = orderBy
+		}
+		if limit != nil {
+			t.Limit = limit
+		}
+	}
+	return stmt
+}
+
 // SelectExprs represents SELECT expressions.
 type SelectExprs []SelectExpr
 
@@ -48,6 +48,25 @@ func (node *ShowDatabases) String() string {
 	return "SHOW DATABASES"
 }
 
+// ShowGrants represents a SHOW GRANTS statement.
+// TargetList is defined in grant.go.
+type ShowGrants struct {
+	Targets  *TargetList
+	Grantees NameList
+}
+
+func (node *ShowGrants) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("SHOW GRANTS")
+	if node.Targets != nil {
+		fmt.Fprintf(&buf, " ON %s", *node.Targets)
+	}
+	if node.Grantees != nil {
+		fmt.Fprintf(&buf, " FOR %s", node.Grantees)
+	}
+	return buf.String()
+}
+
 // ShowIndex represents a SHOW INDEX statement.
 type ShowIndex struct {
 	Table QualifiedName
@@ -70,3 +89,13 @@ func (node *ShowTables) String() string {
 	}
 	return buf.String()
 }
+
+// Show represents a SHOW statement for a session variable. The name ALL
+// shows all of the session variables.
+type Show struct {
+	Name string
+}
+
+func (node *Show) String() string {
+	return fmt.Sprintf("SHOW %s", node.Name)
+}
@@ -5,9 +5,14 @@ package parser
 import __yyfmt__ "fmt"
 
 //line sql.y:22
-import "strings"
 
-//line sql.y:27
+import (
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/privilege"
+)
+
+//line sql.y:31
 type sqlSymType struct {
 	yys            int
 	id             int
@@ -37,6 +42,16 @@ type sqlSymType struct {
 	updateExpr     *UpdateExpr
 	updateExprs    []*UpdateExpr
 	limit          *Limit
+	targetList     TargetList
+	privilegeType  privilege.Kind
+	privilegeList  privilege.List
+	refAction      ReferenceAction
+	refActions     ReferenceActions
+	alterCmd       AlterTableCmd
+	alterCmds      AlterTableCmds
+	boolVal        bool
+	orderBy        OrderBy
+	order          *Order
 }
 
 const IDENT = 57346
@@ -90,380 +105,384 @@ const BOTH = 57393
 const BY = 57394
 const CACHE = 57395
 const CALLED = 57396
-const CASCADE = 57397
-const CASCADED = 57398
-const CASE = 57399
-const CAST = 57400
-const CATALOG = 57401
-const CHAIN = 57402
-const CHAR = 57403
-const CHARACTER = 57404
-const CHARACTERISTICS = 57405
-const CHECK = 57406
-const CHECKPOINT = 57407
-const CLASS = 57408
-const CLOSE = 57409
-const CLUSTER = 57410
-const COALESCE = 57411
-const COLLATE = 57412
-const COLLATION = 57413
-const COLUMN = 57414
-const COLUMNS = 57415
-const COMMENT = 57416
-const COMMENTS = 57417
-const COMMIT = 57418
-const COMMITTED = 57419
-const CONCAT = 57420
-const CONCURRENTLY = 57421
-const CONFIGURATION = 57422
-const CONFLICT = 57423
-const CONNECTION = 57424
-const CONSTRAINT = 57425
-const CONSTRAINTS = 57426
-const CONTENT = 57427
-const CONTINUE = 57428
-const CONVERSION = 57429
-const COPY = 57430
-const COST = 57431
-const CREATE = 57432
-const CROSS = 57433
-const CSV = 57434
-const CUBE = 57435
-const CURRENT = 57436
-const CURRENT_CATALOG = 57437
-const CURRENT_DATE = 57438
-const CURRENT_ROLE = 57439
-const CURRENT_SCHEMA = 57440
-const CURRENT_TIME = 57441
-const CURRENT_TIMESTAMP = 57442
-const CURRENT_USER = 57443
-const CURSOR = 57444
-const CYCLE = 57445
-const DATA = 57446
-const DATABASE = 57447
-const DATABASES = 57448
-const DATE = 57449
-const DAY = 57450
-const DEALLOCATE = 57451
-const DEC = 57452
-const DECIMAL = 57453
-const DECLARE = 57454
-const DEFAULT = 57455
-const DEFAULTS = 57456
-const DEFERRABLE = 57457
-const DEFERRED = 57458
-const DEFINER = 57459
-const DELETE = 57460
-const DELIMITER = 57461
-const DELIMITERS = 57462
-const DESC = 57463
-const DICTIONARY = 57464
-const DISABLE = 57465
-const DISCARD = 57466
-const DISTINCT = 57467
-const DO = 57468
-const DOCUMENT = 57469
-const DOMAIN = 57470
-const DOUBLE = 57471
-const DROP = 57472
-const EACH = 57473
-const ELSE = 57474
-const ENABLE = 57475
-const ENCODING = 57476
-const ENCRYPTED = 57477
-const END = 57478
-const ENUM = 57479
-const ESCAPE = 57480
-const EVENT = 57481
-const EXCEPT = 57482
-const EXCLUDE = 57483
-const EXCLUDING = 57484
-const EXCLUSIVE = 57485
-const EXECUTE = 57486
-const EXISTS = 57487
-const EXPLAIN = 57488
-const EXTENSION = 57489
-const EXTERNAL = 57490
-const EXTRACT = 57491
-const FALSE = 57492
-const FAMILY = 57493
-const FETCH = 57494
-const FILTER = 57495
-const FIRST = 57496
-const FLOAT = 57497
-const FOLLOWING = 57498
-const FOR = 57499
-const FORCE = 57500
-const FOREIGN = 57501
-const FORWARD = 57502
-const FREEZE = 57503
-const FROM = 57504
-const FULL = 57505
-const FUNCTION = 57506
-const FUNCTIONS = 57507
-const GLOBAL = 57508
-const GRANT = 57509
-const GRANTED = 57510
-const GREATEST = 57511
-const GROUP = 57512
-const GROUPING = 57513
-const HANDLER = 57514
-const HAVING = 57515
-const HEADER = 57516
-const HOLD = 57517
-const HOUR = 57518
-const IDENTITY = 57519
-const IF = 57520
-const IMMEDIATE = 57521
-const IMMUTABLE = 57522
-const IMPLICIT = 57523
-const IMPORT = 57524
-const IN = 57525
-const INCLUDING = 57526
-const INCREMENT = 57527
-const INDEX = 57528
-const INDEXES = 57529
-const INHERIT = 57530
-const INHERITS = 57531
-const INITIALLY = 57532
-const INLINE = 57533
-const INNER = 57534
-const INOUT = 57535
-const INPUT = 57536
-const INSENSITIVE = 57537
-const INSERT = 57538
-const INSTEAD = 57539
-const INT = 57540
-const INTEGER = 57541
-const INTERSECT = 57542
-const INTERVAL = 57543
-const INTO = 57544
-const INVOKER = 57545
-const IS = 57546
-const ISOLATION = 57547
-const JOIN = 57548
-const KEY = 57549
-const LABEL = 57550
-const LANGUAGE = 57551
-const LARGE = 57552
-const LAST = 57553
-const LATERAL = 57554
-const LEADING = 57555
-const LEAKPROOF = 57556
-const LEAST = 57557
-const LEFT = 57558
-const LEVEL = 57559
-const LIKE = 57560
-const LIMIT = 57561
-const LISTEN = 57562
-const LOAD = 57563
-const LOCAL = 57564
-const LOCALTIME = 57565
-const LOCALTIMESTAMP = 57566
-const LOCATION = 57567
-const LOCK = 57568
-const LOCKED = 57569
-const LOGGED = 57570
-const MAPPING = 57571
-const MATCH = 57572
-const MATERIALIZED = 57573
-const MAXVALUE = 57574
-const MINUTE = 57575
-const MINVALUE = 57576
-const MODE = 57577
-const MONTH = 57578
-const MOVE = 57579
-const NAME = 57580
-const NAMES = 57581
-const NATIONAL = 57582
-const NATURAL = 57583
-const NCHAR = 57584
-const NEXT = 57585
-const NO = 57586
-const NONE = 57587
-const NOT = 57588
-const NOTHING = 57589
-const NOTIFY = 57590
-const NOWAIT = 57591
-const NULL = 57592
-const NULLIF = 57593
-const NULLS = 57594
-const NUMERIC = 57595
-const OBJECT = 57596
-const OF = 57597
-const OFF = 57598
-const OFFSET = 57599
-const OIDS = 57600
-const ON = 57601
-const ONLY = 57602
-const OPTION = 57603
-const OPTIONS = 57604
-const OR = 57605
-const ORDER = 57606
-const ORDINALITY = 57607
-const OUT = 57608
-const OUTER = 57609
-const OVER = 57610
-const OVERLAPS = 57611
-const OVERLAY = 57612
-const OWNED = 57613
-const OWNER = 57614
-const PARSER = 57615
-const PARTIAL = 57616
-const PARTITION = 57617
-const PASSING = 57618
-const PASSWORD = 57619
-const PLACING = 57620
-const PLANS = 57621
-const POLICY = 57622
-const POSITION = 57623
-const PRECEDING = 57624
-const PRECISION = 57625
-const PRESERVE = 57626
-const PREPARE = 57627
-const PREPARED = 57628
-const PRIMARY = 57629
-const PRIOR = 57630
-const PRIVILEGES = 57631
-const PROCEDURAL = 57632
-const PROCEDURE = 57633
-const PROGRAM = 57634
-const QUOTE = 57635
-const RANGE = 57636
-const READ = 57637
-const REAL = 57638
-const REASSIGN = 57639
-const RECHECK = 57640
-const RECURSIVE = 57641
-const REF = 57642
-const REFERENCES = 57643
-const REFRESH = 57644
-const REINDEX = 57645
-const RELATIVE = 57646
-const RELEASE = 57647
-const RENAME = 57648
-const REPEATABLE = 57649
-const REPLACE = 57650
-const REPLICA = 57651
-const RESET = 57652
-const RESTART = 57653
-const RESTRICT = 57654
-const RETURNING = 57655
-const RETURNS = 57656
-const REVOKE = 57657
-const RIGHT = 57658
-const ROLE = 57659
-const ROLLBACK = 57660
-const ROLLUP = 57661
-const ROW = 57662
-const ROWS = 57663
-const RULE = 57664
-const SAVEPOINT = 57665
-const SCHEMA = 57666
-const SCROLL = 57667
-const SEARCH = 57668
-const SECOND = 57669
-const SECURITY = 57670
-const SELECT = 57671
-const SEQUENCE = 57672
-const SEQUENCES = 57673
-const SERIALIZABLE = 57674
-const SERVER = 57675
-const SESSION = 57676
-const SESSION_USER = 57677
-const SET = 57678
-const SETS = 57679
-const SETOF = 57680
-const SHARE = 57681
-const SHOW = 57682
-const SIMILAR = 57683
-const SIMPLE = 57684
-const SKIP = 57685
-const SMALLINT = 57686
-const SNAPSHOT = 57687
-const SOME = 57688
-const SQL = 57689
-const STABLE = 57690
-const STANDALONE = 57691
-const START = 57692
-const STATEMENT = 57693
-const STATISTICS = 57694
-const STDIN = 57695
-const STDOUT = 57696
-const STORAGE = 57697
-const STRICT = 57698
-const STRIP = 57699
-const SUBSTRING = 57700
-const SYMMETRIC = 57701
-const SYSID = 57702
-const SYSTEM = 57703
-const TABLE = 57704
-const TABLES = 57705
-const TABLESAMPLE = 57706
-const TABLESPACE = 57707
-const TEMP = 57708
-const TEMPLATE = 57709
-const TEMPORARY = 57710
-const TEXT = 57711
-const THEN = 57712
-const TIME = 57713
-const TIMESTAMP = 57714
-const TO = 57715
-const TRAILING = 57716
-const TRANSACTION = 57717
-const TRANSFORM = 57718
-const TREAT = 57719
-const TRIGGER = 57720
-const TRIM = 57721
-const TRUE = 57722
-const TRUNCATE = 57723
-const TRUSTED = 57724
-const TYPE = 57725
-const TYPES = 57726
-const UNBOUNDED = 57727
-const UNCOMMITTED = 57728
-const UNENCRYPTED = 57729
-const UNION = 57730
-const UNIQUE = 57731
-const UNKNOWN = 57732
-const UNLISTEN = 57733
-const UNLOGGED = 57734
-const UNTIL = 57735
-const UPDATE = 57736
-const USER = 57737
-const USING = 57738
-const VACUUM = 57739
-const VALID = 57740
-const VALIDATE = 57741
-const VALIDATOR = 57742
-const VALUE = 57743
-const VALUES = 57744
-const VARCHAR = 57745
-const VARIADIC = 57746
-const VARYING = 57747
-const VERBOSE = 57748
-const VERSION = 57749
-const VIEW = 57750
-const VIEWS = 57751
-const VOLATILE = 57752
-const WHEN = 57753
-const WHERE = 57754
-const WHITESPACE = 57755
-const WINDOW = 57756
-const WITH = 57757
-const WITHIN = 57758
-const WITHOUT = 57759
-const WORK = 57760
-const WRAPPER = 57761
-const WRITE = 57762
-const YEAR = 57763
-const YES = 57764
-const ZONE = 57765
-const NOT_LA = 57766
-const NULLS_LA = 57767
-const WITH_LA = 57768
-const POSTFIXOP = 57769
-const UMINUS = 57770
+const CANCEL = 57397
+const CASCADE = 57398
+const CASCADED = 57399
+const CASE = 57400
+const CAST = 57401
+const CATALOG = 57402
+const CHAIN = 57403
+const CHAR = 57404
+const CHARACTER = 57405
+const CHARACTERISTICS = 57406
+const CHECK = 57407
+const CHECKPOINT = 57408
+const CLASS = 57409
+const CLOSE = 57410
+const CLUSTER = 57411
+const COALESCE = 57412
+const COLLATE = 57413
+const COLLATION = 57414
+const COLUMN = 57415
+const COLUMNS = 57416
+const COMMENT = 57417
+const COMMENTS = 57418
+const COMMIT = 57419
+const COMMITTED = 57420
+const CONCAT = 57421
+const CONCURRENTLY = 57422
+const CONFIGURATION = 57423
+const CONFLICT = 57424
+const CONNECTION = 57425
+const CONSTRAINT = 57426
+const CONSTRAINTS = 57427
+const CONTENT = 57428
+const CONTINUE = 57429
+const CONVERSION = 57430
+const COPY = 57431
+const COST = 57432
+const CREATE = 57433
+const CROSS = 57434
+const CSV = 57435
+const CUBE = 57436
+const CURRENT = 57437
+const CURRENT_CATALOG = 57438
+const CURRENT_DATE = 57439
+const CURRENT_ROLE = 57440
+const CURRENT_SCHEMA = 57441
+const CURRENT_TIME = 57442
+const CURRENT_TIMESTAMP = 57443
+const CURRENT_USER = 57444
+const CURSOR = 57445
+const CYCLE = 57446
+const DATA = 57447
+const DATABASE = 57448
+const DATABASES = 57449
+const DATE = 57450
+const DAY = 57451
+const DEALLOCATE = 57452
+const DEC = 57453
+const DECIMAL = 57454
+const DECLARE = 57455
+const DEFAULT = 57456
+const DEFAULTS = 57457
+const DEFERRABLE = 57458
+const DEFERRED = 57459
+const DEFINER = 57460
+const DELETE = 57461
+const DELIMITER = 57462
+const DELIMITERS = 57463
+const DESC = 57464
+const DICTIONARY = 57465
+const DISABLE = 57466
+const DISCARD = 57467
+const DISTINCT = 57468
+const DO = 57469
+const DOCUMENT = 57470
+const DOMAIN = 57471
+const DOUBLE = 57472
+const DROP = 57473
+const EACH = 57474
+const ELSE = 57475
+const ENABLE = 57476
+const ENCODING = 57477
+const ENCRYPTED = 57478
+const END = 57479
+const ENUM = 57480
+const ESCAPE = 57481
+const EVENT = 57482
+const EXCEPT = 57483
+const EXCLUDE = 57484
+const EXCLUDING = 57485
+const EXCLUSIVE = 57486
+const EXECUTE = 57487
+const EXISTS = 57488
+const EXPLAIN = 57489
+const EXTENSION = 57490
+const EXTERNAL = 57491
+const EXTRACT = 57492
+const FALSE = 57493
+const FAMILY = 57494
+const FETCH = 57495
+const FILTER = 57496
+const FIRST = 57497
+const FLOAT = 57498
+const FOLLOWING = 57499
+const FOR = 57500
+const FORCE = 57501
+const FOREIGN = 57502
+const FORWARD = 57503
+const FREEZE = 57504
+const FROM = 57505
+const FULL = 57506
+const FUNCTION = 57507
+const FUNCTIONS = 57508
+const GLOBAL = 57509
+const GRANT = 57510
+const GRANTED = 57511
+const GRANTS = 57512
+const GREATEST = 57513
+const GROUP = 57514
+const GROUPING = 57515
+const HANDLER = 57516
+const HAVING = 57517
+const HEADER = 57518
+const HOLD = 57519
+const HOUR = 57520
+const IDENTITY = 57521
+const IF = 57522
+const IMMEDIATE = 57523
+const IMMUTABLE = 57524
+const IMPLICIT = 57525
+const IMPORT = 57526
+const IN = 57527
+const INCLUDING = 57528
+const INCREMENT = 57529
+const INDEX = 57530
+const INDEXES = 57531
+const INHERIT = 57532
+const INHERITS = 57533
+const INITIALLY = 57534
+const INLINE = 57535
+const INNER = 57536
+const INOUT = 57537
+const INPUT = 57538
+const INSENSITIVE = 57539
+const INSERT = 57540
+const INSTEAD = 57541
+const INT = 57542
+const INTEGER = 57543
+const INTERSECT = 57544
+const INTERVAL = 57545
+const INTO = 57546
+const INVOKER = 57547
+const IS = 57548
+const ISOLATION = 57549
+const JOIN = 57550
+const KEY = 57551
+const LABEL = 57552
+const LANGUAGE = 57553
+const LARGE = 57554
+const LAST = 57555
+const LATERAL = 57556
+const LEADING = 57557
+const LEAKPROOF = 57558
+const LEAST = 57559
+const LEFT = 57560
+const LEVEL = 57561
+const LIKE = 57562
+const LIMIT = 57563
+const LISTEN = 57564
+const LOAD = 57565
+const LOCAL = 57566
+const LOCALTIME = 57567
+const LOCALTIMESTAMP = 57568
+const LOCATION = 57569
+const LOCK = 57570
+const LOCKED = 57571
+const LOGGED = 57572
+const MAPPING = 57573
+const MATCH = 57574
+const MATERIALIZED = 57575
+const MAXVALUE = 57576
+const MINUTE = 57577
+const MINVALUE = 57578
+const MODE = 57579
+const MONTH = 57580
+const MOVE = 57581
+const NAME = 57582
+const NAMES = 57583
+const NATIONAL = 57584
+const NATURAL = 57585
+const NCHAR = 57586
+const NEXT = 57587
+const NO = 57588
+const NONE = 57589
+const NOT = 57590
+const NOTHING = 57591
+const NOTIFY = 57592
+const NOWAIT = 57593
+const NULL = 57594
+const NULLIF = 57595
+const NULLS = 57596
+const NUMERIC = 57597
+const OBJECT = 57598
+const OF = 57599
+const OFF = 57600
+const OFFSET = 57601
+const OIDS = 57602
+const ON = 57603
+const ONLY = 57604
+const OPTION = 57605
+const OPTIONS = 57606
+const OR = 57607
+const ORDER = 57608
+const ORDINALITY = 57609
+const OUT = 57610
+const OUTER = 57611
+const OVER = 57612
+const OVERLAPS = 57613
+const OVERLAY = 57614
+const OWNED = 57615
+const OWNER = 57616
+const PARSER = 57617
+const PARTIAL = 57618
+const PARTITION = 57619
+const PASSING = 57620
+const PASSWORD = 57621
+const PLACING = 57622
+const PLANS = 57623
+const POLICY = 57624
+const POSITION = 57625
+const PRECEDING = 57626
+const PRECISION = 57627
+const PRESERVE = 57628
+const PREPARE = 57629
+const PREPARED = 57630
+const PRIMARY = 57631
+const PRIOR = 57632
+const PRIVILEGES = 57633
+const PROCEDURAL = 57634
+const PROCEDURE = 57635
+const PROGRAM = 57636
+const QUERY = 57637
+const QUOTE = 57638
+const RANGE = 57639
+const READ = 57640
+const REAL = 57641
+const REASSIGN = 57642
+const RECHECK = 57643
+const RECURSIVE = 57644
+const REF = 57645
+const REFERENCES = 57646
+const REFRESH = 57647
+const REINDEX = 57648
+const RELATIVE = 57649
+const RELEASE = 57650
+const RENAME = 57651
+const REPEATABLE = 57652
+const REPLACE = 57653
+const REPLICA = 57654
+const RESET = 57655
+const RESTART = 57656
+const RESTRICT = 57657
+const RETURNING = 57658
+const RETURNS = 57659
+const REVOKE = 57660
+const RIGHT = 57661
+const ROLE = 57662
+const ROLLBACK = 57663
+const ROLLUP = 57664
+const ROW = 57665
+const ROWS = 57666
+const RULE = 57667
+const SAVEPOINT = 57668
+const SCHEMA = 57669
+const SCROLL = 57670
+const SEARCH = 57671
+const SECOND = 57672
+const SECURITY = 57673
+const SELECT = 57674
+const SEQUENCE = 57675
+const SEQUENCES = 57676
+const SERIAL = 57677
+const SERIALIZABLE = 57678
+const SERVER = 57679
+const SESSION = 57680
+const SESSION_USER = 57681
+const SET = 57682
+const SETS = 57683
+const SETOF = 57684
+const SHARE = 57685
+const SHOW = 57686
+const SIMILAR = 57687
+const SIMPLE = 57688
+const SKIP = 57689
+const SMALLINT = 57690
+const SNAPSHOT = 57691
+const SOME = 57692
+const SQL = 57693
+const STABLE = 57694
+const STANDALONE = 57695
+const START = 57696
+const STATEMENT = 57697
+const STATISTICS = 57698
+const STDIN = 57699
+const STDOUT = 57700
+const STORAGE = 57701
+const STRICT = 57702
+const STRIP = 57703
+const SUBSTRING = 57704
+const SYMMETRIC = 57705
+const SYSID = 57706
+const SYSTEM = 57707
+const TABLE = 57708
+const TABLES = 57709
+const TABLESAMPLE = 57710
+const TABLESPACE = 57711
+const TEMP = 57712
+const TEMPLATE = 57713
+const TEMPORARY = 57714
+const TEXT = 57715
+const THEN = 57716
+const TIME = 57717
+const TIMESTAMP = 57718
+const TO = 57719
+const TRAILING = 57720
+const TRANSACTION = 57721
+const TRANSFORM = 57722
+const TREAT = 57723
+const TRIGGER = 57724
+const TRIM = 57725
+const TRUE = 57726
+const TRUNCATE = 57727
+const TRUSTED = 57728
+const TYPE = 57729
+const TYPES = 57730
+const UNBOUNDED = 57731
+const UNCOMMITTED = 57732
+const UNENCRYPTED = 57733
+const UNION = 57734
+const UNIQUE = 57735
+const UNKNOWN = 57736
+const UNLISTEN = 57737
+const UNLOGGED = 57738
+const UNTIL = 57739
+const UPDATE = 57740
+const USER = 57741
+const USING = 57742
+const VACUUM = 57743
+const VALID = 57744
+const VALIDATE = 57745
+const VALIDATOR = 57746
+const VALUE = 57747
+const VALUES = 57748
+const VARCHAR = 57749
+const VARIADIC = 57750
+const VARYING = 57751
+const VERBOSE = 57752
+const VERSION = 57753
+const VIEW = 57754
+const VIEWS = 57755
+const VOLATILE = 57756
+const WHEN = 57757
+const WHERE = 57758
+const WHITESPACE = 57759
+const WINDOW = 57760
+const WITH = 57761
+const WITHIN = 57762
+const WITHOUT = 57763
+const WORK = 57764
+const WRAPPER = 57765
+const WRITE = 57766
+const YEAR = 57767
+const YES = 57768
+const ZONE = 57769
+const NOT_LA = 57770
+const NULLS_LA = 57771
+const WITH_LA = 57772
+const POSTFIXOP = 57773
+const UMINUS = 57774
 
 var sqlToknames = [...]string{
 	"$end",
@@ -520,6 +539,7 @@ var sqlToknames = [...]string{
 	"BY",
 	"CACHE",
 	"CALLED",
+	"CANCEL",
 	"CASCADE",
 	"CASCADED",
 	"CASE",
@@ -634,6 +654,7 @@ var sqlToknames = [...]string{
 	"GLOBAL",
 	"GRANT",
 	"GRANTED",
+	"GRANTS",
 	"GREATEST",
 	"GROUP",
 	"GROUPING",
@@ -758,6 +779,7 @@ var sqlToknames = [...]string{
 	"PROCEDURAL",
 	"PROCEDURE",
 	"PROGRAM",
+	"QUERY",
 	"QUOTE",
 	"RANGE",
 	"READ",
@@ -797,6 +819,7 @@ var sqlToknames = [...]string{
 	"SELECT",
 	"SEQUENCE",
 	"SEQUENCES",
+	"SERIAL",
 	"SERIALIZABLE",
 	"SERVER",
 	"SESSION",
@@ -922,3913 +945,4109 @@ const sqlEofCode = 1
 const sqlErrCode = 2
 const sqlMaxDepth = 200
 
-//line sql.y:4013
+//line sql.y:4324
 
 //line yacctab:1
 var sqlExca = [...]int{
 	-1, 0,
-	1, 17,
-	447, 17,
-	-2, 408,
+	1, 21,
+	451, 21,
+	-2, 444,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 29,
-	1, 377,
-	259, 377,
-	313, 377,
-	415, 377,
-	445, 377,
-	447, 377,
-	-2, 389,
-	-1, 42,
-	362, 165,
-	-2, 268,
-	-1, 44,
-	1, 380,
-	259, 380,
-	313, 380,
-	415, 380,
-	445, 380,
-	447, 380,
-	-2, 388,
-	-1, 53,
-	1, 17,
-	447, 17,
-	-2, 408,
-	-1, 82,
-	1, 144,
-	447, 144,
-	-2, 1057,
-	-1, 426,
-	152, 419,
-	157, 419,
-	219, 419,
-	257, 419,
-	-2, 384,
-	-1, 429,
-	152, 418,
-	157, 418,
-	219, 418,
-	257, 418,
-	-2, 381,
-	-1, 544,
-	152, 418,
-	157, 418,
-	219, 418,
-	257, 418,
-	-2, 385,
-	-1, 610,
-	6, 906,
-	444, 906,
-	-2, 901,
-	-1, 611,
-	6, 907,
-	444, 907,
-	-2, 902,
-	-1, 617,
-	6, 591,
-	444, 591,
-	-2, 1204,
-	-1, 629,
-	6, 1231,
-	444, 1231,
-	-2, 737,
-	-1, 642,
-	6, 557,
-	-2, 1187,
-	-1, 643,
-	6, 583,
-	444, 583,
-	-2, 1188,
-	-1, 644,
-	6, 564,
-	-2, 1189,
-	-1, 645,
-	6, 583,
-	62, 583,
-	444, 583,
-	-2, 1190,
-	-1, 646,
-	6, 583,
-	62, 583,
-	444, 583,
-	-2, 1191,
-	-1, 647,
-	6, 586,
-	-2, 1193,
-	-1, 648,
-	6, 553,
-	-2, 1194,
-	-1, 649,
-	6, 553,
-	-2, 1195,
-	-1, 650,
-	6, 566,
-	-2, 1198,
-	-1, 651,
-	6, 554,
-	-2, 1202,
-	-1, 652,
-	6, 555,
-	-2, 1203,
-	-1, 653,
-	6, 553,
-	-2, 1210,
-	-1, 654,
-	6, 558,
-	-2, 1215,
-	-1, 655,
-	6, 556,
-	-2, 1218,
-	-1, 656,
+	-1, 39,
+	1, 413,
+	261, 413,
+	316, 413,
+	419, 413,
+	449, 413,
+	451, 413,
+	-2, 425,
+	-1, 52,
+	188, 303,
+	-2, 200,
+	-1, 54,
+	1, 416,
+	261, 416,
+	316, 416,
+	419, 416,
+	449, 416,
+	451, 416,
+	-2, 424,
+	-1, 63,
+	1, 21,
+	451, 21,
+	-2, 444,
+	-1, 107,
+	1, 173,
+	451, 173,
+	-2, 1096,
+	-1, 457,
+	153, 455,
+	158, 455,
+	221, 455,
+	259, 455,
+	-2, 420,
+	-1, 460,
+	153, 454,
+	158, 454,
+	221, 454,
+	259, 454,
+	-2, 417,
+	-1, 523,
+	6, 943,
+	448, 943,
+	-2, 938,
+	-1, 524,
+	6, 944,
+	448, 944,
+	-2, 939,
+	-1, 530,
+	6, 628,
+	448, 628,
+	-2, 1244,
+	-1, 542,
+	6, 1272,
+	448, 1272,
+	-2, 774,
+	-1, 555,
 	6, 594,
-	-2, 1220,
-	-1, 657,
-	6, 594,
-	-2, 1221,
-	-1, 658,
-	6, 581,
-	62, 581,
-	444, 581,
-	-2, 1225,
-	-1, 862,
-	140, 389,
-	152, 389,
-	157, 389,
-	200, 389,
-	219, 389,
-	257, 389,
-	264, 389,
-	388, 389,
-	-2, 703,
-	-1, 872,
-	6, 884,
-	444, 884,
-	-2, 878,
-	-1, 1055,
-	444, 272,
-	-2, 993,
-	-1, 1184,
+	-2, 1227,
+	-1, 556,
+	6, 620,
+	448, 620,
+	-2, 1228,
+	-1, 557,
+	6, 601,
+	-2, 1229,
+	-1, 558,
+	6, 620,
+	63, 620,
+	448, 620,
+	-2, 1230,
+	-1, 559,
+	6, 620,
+	63, 620,
+	448, 620,
+	-2, 1231,
+	-1, 560,
+	6, 623,
+	-2, 1233,
+	-1, 561,
+	6, 590,
+	-2, 1234,
+	-1, 562,
+	6, 590,
+	-2, 1235,
+	-1, 563,
+	6, 603,
+	-2, 1238,
+	-1, 564,
+	6, 591,
+	-2, 1242,
+	-1, 565,
+	6, 592,
+	-2, 1243,
+	-1, 566,
+	6, 590,
+	-2, 1250,
+	-1, 567,
+	6, 595,
+	-2, 1255,
+	-1, 568,
+	6, 593,
+	-2, 1259,
+	-1, 569,
+	6, 631,
+	-2, 1261,
+	-1, 570,
+	6, 631,
+	-2, 1262,
+	-1, 571,
+	6, 618,
+	63, 618,
+	448, 618,
+	-2, 1266,
+	-1, 679,
+	153, 454,
+	158, 454,
+	221, 454,
+	259, 454,
+	-2, 421,
+	-1, 760,
+	141, 425,
+	153, 425,
+	158, 425,
+	202, 425,
+	221, 425,
+	259, 425,
+	266, 425,
+	392, 425,
+	-2, 740,
+	-1, 770,
+	6, 921,
+	448, 921,
+	-2, 915,
+	-1, 1113,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 627,
-	-1, 1185,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 664,
+	-1, 1114,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 628,
-	-1, 1186,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 665,
+	-1, 1115,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 629,
-	-1, 1188,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 666,
+	-1, 1117,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 631,
-	-1, 1189,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 668,
+	-1, 1118,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 632,
-	-1, 1190,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 669,
+	-1, 1119,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 633,
-	-1, 1193,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 670,
+	-1, 1122,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 638,
-	-1, 1231,
-	269, 780,
-	-2, 783,
-	-1, 1439,
-	91, 493,
-	163, 493,
-	192, 493,
-	206, 493,
-	216, 493,
-	241, 493,
-	316, 493,
-	-2, 389,
-	-1, 1453,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 675,
+	-1, 1159,
+	271, 817,
+	-2, 820,
+	-1, 1283,
+	448, 307,
+	-2, 1031,
+	-1, 1388,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 640,
-	-1, 1458,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 677,
+	-1, 1393,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 642,
-	-1, 1482,
-	269, 779,
-	-2, 782,
-	-1, 1665,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 679,
+	-1, 1417,
+	271, 816,
+	-2, 819,
+	-1, 1635,
+	92, 529,
+	164, 529,
+	194, 529,
+	208, 529,
+	218, 529,
+	243, 529,
+	319, 529,
+	-2, 425,
+	-1, 1651,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 639,
-	-1, 1667,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 676,
+	-1, 1653,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 644,
-	-1, 1673,
-	204, 0,
-	-2, 655,
-	-1, 1683,
-	269, 781,
-	-2, 784,
-	-1, 1723,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 681,
+	-1, 1659,
+	206, 0,
+	-2, 692,
+	-1, 1669,
+	271, 818,
+	-2, 821,
+	-1, 1709,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 684,
-	-1, 1724,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 721,
+	-1, 1710,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 685,
-	-1, 1725,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 722,
+	-1, 1711,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 686,
-	-1, 1727,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 723,
+	-1, 1713,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 688,
-	-1, 1728,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 725,
+	-1, 1714,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 689,
-	-1, 1729,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 726,
+	-1, 1715,
 	13, 0,
 	14, 0,
 	15, 0,
-	427, 0,
-	428, 0,
-	429, 0,
-	-2, 690,
-	-1, 1810,
-	446, 1151,
-	-2, 546,
-	-1, 1871,
+	431, 0,
+	432, 0,
+	433, 0,
+	-2, 727,
+	-1, 1835,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 641,
-	-1, 1875,
-	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 643,
-	-1, 1876,
-	204, 0,
-	-2, 656,
-	-1, 1880,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 678,
+	-1, 1839,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 659,
-	-1, 1881,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 680,
+	-1, 1840,
+	206, 0,
+	-2, 693,
+	-1, 1844,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 661,
-	-1, 1986,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 696,
+	-1, 1845,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 645,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 698,
+	-1, 1927,
+	450, 1191,
+	-2, 583,
 	-1, 1987,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 660,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 682,
 	-1, 1988,
 	45, 0,
-	183, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 662,
-	-1, 1996,
-	204, 0,
-	-2, 691,
-	-1, 2063,
-	204, 0,
-	-2, 692,
-	-1, 2126,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 697,
+	-1, 1989,
 	45, 0,
-	218, 0,
-	341, 0,
-	424, 0,
-	-2, 1186,
+	185, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 699,
+	-1, 1997,
+	206, 0,
+	-2, 728,
+	-1, 2080,
+	206, 0,
+	-2, 729,
+	-1, 2177,
+	45, 0,
+	220, 0,
+	345, 0,
+	428, 0,
+	-2, 1226,
 }
 
-const sqlNprod = 1323
+const sqlNprod = 1364
 const sqlPrivate = 57344
 
 var sqlTokenNames []string
 var sqlStates []string
 
-const sqlLast = 35169
+const sqlLast = 37090
 
 var sqlAct = [...]int{
 
-	595, 2125, 2102, 1009, 2119, 1135, 2152, 609, 2103, 1408,
-	1910, 1120, 2104, 1617, 2124, 2070, 1093, 1065, 1378, 1703,
-	2012, 2015, 1016, 1340, 1963, 1578, 1819, 1927, 1856, 1911,
-	853, 83, 83, 1863, 608, 1674, 1442, 1842, 430, 410,
-	413, 1622, 2022, 1615, 1857, 1825, 1778, 1375, 441, 441,
-	690, 1116, 451, 2031, 1848, 1142, 601, 451, 462, 463,
-	462, 1763, 1797, 865, 754, 64, 11, 612, 28, 928,
-	1368, 498, 451, 451, 686, 83, 83, 705, 672, 1838,
-	452, 1428, 712, 1351, 1372, 1542, 1309, 1634, 1541, 1485,
-	1352, 1128, 916, 778, 868, 1446, 1431, 1438, 781, 1420,
-	1057, 508, 1336, 86, 1643, 1050, 1017, 676, 570, 1286,
-	1416, 1248, 861, 1210, 910, 435, 1207, 1238, 1133, 11,
-	899, 752, 1130, 1110, 725, 1373, 753, 1244, 527, 903,
-	727, 820, 435, 437, 43, 429, 814, 1369, 582, 66,
-	16, 580, 571, 65, 9, 789, 67, 6, 787, 1129,
-	73, 550, 717, 440, 460, 706, 469, 551, 61, 790,
-	43, 552, 750, 457, 44, 511, 79, 475, 1010, 45,
-	788, 688, 774, 1014, 821, 2160, 1675, 1241, 2003, 2122,
-	2098, 2092, 1975, 1879, 1124, 2088, 2084, 43, 2003, 1389,
-	449, 434, 434, 16, 69, 449, 43, 9, 389, 2065,
-	6, 448, 1879, 49, 1318, 507, 458, 1289, 427, 2053,
-	499, 449, 1975, 426, 465, 821, 515, 2052, 823, 471,
-	1124, 467, 501, 1479, 2004, 503, 505, 2003, 1480, 1989,
-	1978, 69, 1879, 1979, 1977, 1974, 51, 1975, 1975, 32,
-	1477, 1972, 2048, 659, 1124, 1242, 825, 1950, 1931, 27,
-	1951, 1124, 1965, 1924, 1923, 509, 1925, 1124, 1904, 1883,
-	1878, 1477, 1477, 1879, 1775, 1773, 33, 1124, 1124, 1678,
-	1612, 1600, 1477, 1124, 1601, 1576, 52, 824, 1389, 1572,
-	1567, 1557, 1389, 1477, 1558, 461, 1928, 822, 1555, 47,
-	1791, 1477, 1554, 1735, 1553, 1477, 512, 1477, 35, 1790,
-	48, 1482, 1243, 1481, 1477, 1240, 1477, 476, 1478, 1682,
-	1613, 1125, 42, 1477, 1124, 1043, 1008, 694, 46, 1007,
-	695, 1418, 1602, 1389, 687, 1223, 674, 1118, 1083, 564,
-	673, 565, 2083, 447, 674, 2024, 53, 2073, 673, 1603,
-	708, 691, 912, 1025, 516, 510, 1025, 912, 49, 1025,
-	2123, 911, 25, 2060, 1063, 2043, 911, 1982, 36, 1907,
-	1905, 1896, 1895, 1890, 823, 1889, 1888, 1887, 26, 909,
-	1831, 1870, 1757, 1066, 913, 1484, 1477, 1748, 1745, 1744,
-	1743, 51, 1686, 1655, 1633, 1611, 1610, 1337, 1564, 49,
-	1563, 823, 825, 1560, 1559, 1549, 1245, 775, 1540, 1515,
-	1512, 1510, 1508, 1507, 1506, 1227, 1505, 1495, 1489, 1305,
-	823, 1092, 869, 532, 46, 1219, 451, 1084, 917, 825,
-	538, 52, 51, 824, 1337, 848, 564, 513, 689, 563,
-	2121, 838, 1786, 49, 1324, 1586, 876, 874, 825, 1705,
-	441, 2072, 2058, 1616, 1998, 1968, 1960, 1946, 1920, 1915,
-	824, 451, 1902, 1855, 1853, 1338, 451, 451, 838, 683,
-	1318, 1516, 52, 46, 1672, 545, 51, 1657, 1651, 824,
-	49, 1648, 1335, 1867, 1590, 47, 1588, 823, 1539, 839,
-	840, 841, 1503, 514, 1502, 1494, 48, 1473, 1472, 1467,
-	1239, 1872, 1212, 823, 668, 904, 907, 842, 744, 1445,
-	516, 822, 1334, 51, 1013, 825, 52, 40, 1294, 1253,
-	62, 848, 1123, 1450, 919, 671, 544, 758, 1064, 47,
-	897, 825, 896, 895, 83, 83, 83, 39, 667, 894,
-	48, 771, 893, 892, 773, 698, 824, 462, 1787, 1220,
-	37, 1789, 891, 52, 838, 38, 890, 889, 63, 888,
-	887, 49, 824, 665, 449, 886, 47, 1756, 30, 1830,
-	1869, 885, 31, 849, 687, 535, 441, 48, 884, 819,
-	1066, 883, 34, 871, 475, 870, 46, 453, 568, 2059,
-	1984, 1983, 763, 438, 51, 46, 1659, 869, 1660, 669,
-	546, 1942, 1516, 1760, 449, 681, 1319, 697, 844, 1041,
-	1091, 547, 427, 41, 912, 458, 557, 426, 1516, 745,
-	1530, 1531, 1532, 911, 782, 1443, 1409, 475, 475, 679,
-	708, 1562, 402, 1561, 52, 783, 739, 1451, 1874, 745,
-	1066, 1261, 442, 1268, 522, 517, 881, 47, 826, 827,
-	828, 829, 830, 832, 833, 831, 834, 2120, 48, 849,
-	1379, 1623, 1952, 1926, 435, 1839, 1010, 2010, 674, 872,
-	747, 1706, 673, 1249, 900, 401, 46, 1498, 1315, 1341,
-	847, 451, 2080, 54, 2138, 1529, 2137, 755, 1385, 526,
-	1363, 2116, 2082, 451, 844, 1022, 462, 1793, 462, 1059,
-	767, 768, 769, 1027, 462, 1077, 2002, 414, 1059, 404,
-	1609, 1944, 1943, 799, 1299, 785, 818, 1606, 798, 1075,
-	791, 462, 1605, 1604, 476, 1493, 786, 83, 1492, 816,
-	1491, 846, 427, 1490, 1454, 427, 427, 810, 1198, 1048,
-	811, 812, 1044, 1037, 451, 1264, 462, 409, 406, 451,
-	530, 1298, 451, 1174, 710, 543, 1012, 542, 1105, 70,
-	921, 55, 541, 536, 540, 1071, 1516, 476, 476, 416,
-	2066, 1302, 709, 1109, 922, 1209, 901, 902, 1209, 682,
-	1245, 1086, 914, 1592, 451, 1108, 1047, 926, 2042, 905,
-	1098, 2041, 1026, 908, 826, 827, 828, 829, 830, 832,
-	833, 831, 834, 864, 1036, 926, 1516, 918, 1114, 475,
-	915, 1533, 1310, 1265, 845, 797, 433, 846, 1241, 920,
-	451, 826, 827, 828, 829, 830, 832, 833, 831, 834,
-	748, 1020, 776, 1529, 1126, 462, 402, 402, 43, 1138,
-	71, 1060, 828, 829, 830, 832, 833, 831, 834, 2149,
-	1023, 1074, 795, 2001, 603, 661, 1078, 1087, 1028, 572,
-	572, 471, 1030, 1029, 1045, 1085, 1137, 688, 2106, 677,
-	1266, 1111, 1112, 1263, 1099, 2021, 432, 1039, 1038, 401,
-	401, 1364, 1067, 898, 877, 873, 1242, 1072, 1139, 797,
-	449, 1107, 1695, 1519, 1520, 1521, 1523, 1524, 1522, 1525,
-	845, 1076, 1115, 835, 836, 837, 58, 826, 827, 828,
-	829, 830, 832, 833, 831, 834, 859, 1866, 1216, 1501,
-	1994, 1656, 449, 1692, 1196, 1214, 795, 927, 832, 833,
-	831, 834, 1303, 1088, 1644, 2095, 434, 2040, 823, 1080,
-	434, 2148, 1102, 1243, 1101, 927, 1240, 796, 423, 476,
-	1221, 1081, 1217, 823, 921, 1954, 1249, 1627, 1117, 56,
-	2137, 921, 2096, 1594, 1267, 926, 825, 1953, 1103, 801,
-	449, 806, 2105, 1618, 1228, 1140, 1082, 793, 815, 2107,
-	1693, 825, 2136, 1024, 2134, 1961, 1245, 60, 511, 1593,
-	1382, 854, 855, 856, 857, 858, 1070, 824, 1313, 1362,
-	1607, 863, 794, 666, 422, 838, 1035, 555, 809, 1073,
-	1141, 1218, 824, 1933, 2164, 2155, 72, 1899, 1932, 1901,
-	838, 796, 1918, 879, 451, 1383, 1316, 1523, 1524, 1522,
-	1525, 1069, 451, 566, 1526, 1527, 1528, 1245, 1517, 1518,
-	1519, 1520, 1521, 1523, 1524, 1522, 1525, 2108, 1325, 560,
-	561, 1323, 709, 1109, 1094, 1330, 419, 1048, 1262, 784,
-	1333, 1051, 1197, 660, 431, 1670, 1833, 2147, 1343, 1344,
-	2101, 1346, 1348, 1349, 1245, 1314, 794, 1785, 509, 451,
-	1666, 1691, 2071, 1320, 1356, 1357, 1358, 1224, 1229, 1230,
-	1006, 1233, 435, 574, 528, 1388, 1456, 1194, 554, 1208,
-	554, 1042, 1371, 462, 746, 927, 804, 1919, 1321, 1281,
-	1342, 1384, 1272, 1291, 1292, 1293, 1423, 423, 554, 512,
-	1173, 1955, 1339, 549, 689, 1308, 1322, 1104, 1326, 1851,
-	1639, 1239, 1638, 456, 432, 1415, 1820, 1304, 1581, 926,
-	1430, 1434, 1437, 1430, 2132, 1426, 1068, 1900, 421, 1138,
-	420, 2163, 1138, 1584, 475, 537, 1056, 1090, 1317, 1642,
-	1391, 1631, 1117, 59, 1089, 553, 1948, 553, 510, 1424,
-	1117, 1381, 728, 422, 424, 1635, 1137, 1327, 729, 1137,
-	926, 1417, 1331, 1580, 1329, 553, 1517, 1518, 1519, 1520,
-	1521, 1523, 1524, 1522, 1525, 805, 1252, 475, 1139, 1411,
-	1997, 1139, 1898, 1419, 2153, 555, 1834, 1354, 1947, 1448,
-	1784, 1359, 1366, 435, 616, 1365, 1361, 1353, 1632, 1543,
-	1195, 1671, 1432, 555, 1511, 1466, 1517, 1518, 1519, 1520,
-	1521, 1523, 1524, 1522, 1525, 1332, 1441, 1427, 572, 1215,
-	1386, 1444, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
-	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
-	1193, 821, 525, 1440, 1435, 1390, 524, 43, 1730, 1425,
-	1733, 523, 2154, 1413, 1544, 1423, 1449, 1412, 455, 927,
-	1414, 730, 1483, 449, 1054, 549, 882, 792, 1251, 719,
-	1625, 435, 914, 1598, 476, 1596, 2156, 1204, 905, 1206,
-	908, 902, 901, 1258, 1426, 1270, 663, 1280, 1282, 1287,
-	1290, 1577, 1380, 1565, 1100, 741, 738, 421, 1421, 420,
-	927, 1457, 1202, 1455, 693, 761, 703, 760, 1424, 451,
-	708, 1573, 692, 685, 1700, 819, 1909, 476, 2138, 733,
-	425, 558, 445, 424, 749, 1475, 819, 1959, 520, 819,
-	757, 1912, 1589, 1422, 1062, 1571, 435, 662, 826, 827,
-	828, 829, 830, 832, 833, 831, 834, 720, 780, 1497,
-	1829, 1850, 762, 826, 827, 828, 829, 830, 832, 833,
-	831, 834, 1121, 2049, 823, 1059, 1052, 451, 1377, 1731,
-	1928, 462, 2023, 451, 613, 734, 1061, 736, 1732, 1059,
-	451, 2037, 417, 1546, 1547, 1548, 735, 1619, 742, 1570,
-	1058, 1630, 1516, 917, 1530, 1531, 1532, 823, 1200, 721,
-	68, 23, 1199, 677, 1566, 1981, 1311, 1205, 1425, 1569,
-	1470, 1597, 1873, 1599, 764, 1574, 1138, 1575, 1474, 1138,
-	1647, 559, 446, 824, 1649, 825, 1434, 1430, 3, 1579,
-	1430, 2036, 1587, 1487, 1488, 521, 1463, 1360, 1465, 2062,
-	2033, 737, 1636, 1137, 562, 1832, 1137, 1020, 1122, 1095,
-	743, 1608, 497, 745, 23, 1015, 824, 817, 1662, 1529,
-	400, 1461, 1447, 1583, 1621, 1139, 1585, 732, 1139, 2161,
-	2032, 2162, 1516, 1626, 823, 1538, 1031, 1985, 1033, 1868,
-	1032, 1749, 454, 1698, 1663, 1556, 1551, 1032, 1849, 1367,
-	722, 1654, 1704, 1301, 403, 1300, 405, 407, 408, 1297,
-	1637, 1296, 766, 1640, 1387, 1614, 1295, 1432, 1769, 1257,
-	1256, 1624, 1641, 1255, 815, 1688, 1689, 1690, 1629, 1645,
-	1646, 1254, 1652, 765, 1653, 1246, 1885, 1818, 1699, 875,
-	731, 435, 1201, 533, 1661, 531, 529, 723, 1332, 518,
-	1040, 415, 1203, 2034, 1221, 1892, 2094, 449, 1500, 1993,
-	449, 1962, 1250, 880, 24, 587, 1761, 1770, 1822, 1374,
-	759, 1459, 718, 702, 2100, 1685, 1464, 1260, 462, 664,
-	614, 1145, 615, 1146, 906, 1774, 602, 819, 468, 1780,
-	696, 819, 470, 1707, 1781, 1533, 1138, 1794, 1018, 1795,
-	1453, 1213, 1711, 1247, 1458, 1812, 1813, 1814, 1815, 1816,
-	1817, 1788, 1792, 1496, 1048, 878, 586, 1828, 1779, 1694,
-	1696, 1697, 592, 1137, 591, 1225, 1836, 1738, 1777, 1476,
-	1980, 1739, 1862, 1800, 1823, 2009, 583, 1826, 1758, 807,
-	1486, 713, 724, 77, 78, 1139, 1138, 1138, 1835, 819,
-	1138, 1858, 1860, 1658, 1753, 1499, 1430, 1752, 1437, 1504,
-	1759, 1312, 1755, 1864, 1827, 1138, 1620, 1801, 1148, 1163,
-	1804, 1621, 1799, 1137, 1137, 1750, 1011, 1137, 1751, 803,
-	1859, 1680, 1106, 863, 800, 1796, 1709, 57, 1595, 1287,
-	1287, 1287, 1137, 1713, 1811, 1139, 1139, 1865, 1821, 1139,
-	1765, 1460, 779, 418, 1766, 926, 1893, 926, 1877, 1837,
-	1513, 1462, 1279, 1568, 1139, 1271, 572, 1269, 1259, 808,
-	548, 2035, 1742, 556, 1776, 677, 772, 1798, 1783, 1844,
-	1846, 1847, 1841, 921, 1852, 611, 534, 1861, 1350, 675,
-	1582, 1768, 709, 704, 1019, 569, 1127, 1736, 567, 813,
-	443, 444, 1824, 1370, 519, 1771, 1079, 1780, 1746, 680,
-	850, 1096, 1781, 1916, 1138, 462, 85, 85, 1113, 715,
-	2079, 1591, 451, 50, 85, 85, 15, 449, 449, 1803,
-	14, 449, 13, 85, 85, 12, 1854, 85, 10, 1854,
-	1935, 1137, 85, 85, 85, 85, 1410, 1897, 8, 474,
-	7, 22, 21, 20, 5, 19, 85, 85, 85, 1929,
-	85, 85, 1516, 1139, 1530, 1531, 1532, 18, 1526, 1527,
-	1528, 17, 1517, 1518, 1519, 1520, 1521, 1523, 1524, 1522,
-	1525, 1371, 462, 1908, 4, 2, 1, 1949, 1945, 0,
-	1964, 1913, 0, 1767, 1654, 927, 0, 927, 0, 0,
-	0, 0, 0, 0, 819, 1940, 1860, 1664, 1665, 1138,
-	1667, 1448, 0, 1035, 0, 1934, 0, 0, 0, 1938,
-	1939, 0, 1673, 1941, 0, 0, 0, 1162, 1679, 1529,
-	0, 0, 0, 1684, 1973, 0, 1137, 0, 0, 0,
-	1684, 0, 0, 1148, 1163, 0, 0, 0, 1958, 0,
-	0, 1956, 1967, 0, 1701, 0, 0, 0, 1139, 0,
-	1922, 0, 728, 2005, 435, 0, 0, 1710, 729, 0,
-	1712, 0, 0, 0, 0, 1992, 1780, 2014, 462, 462,
-	462, 1781, 0, 1138, 0, 0, 0, 0, 1999, 2008,
-	0, 0, 0, 1970, 2027, 2028, 0, 451, 0, 1740,
-	1741, 2019, 1828, 0, 0, 1779, 0, 2013, 1747, 2029,
-	1137, 1780, 451, 0, 0, 0, 1781, 0, 1138, 819,
-	1937, 2046, 2011, 1800, 1273, 0, 1858, 0, 2006, 0,
-	1437, 0, 1139, 0, 435, 1864, 0, 1138, 1148, 1163,
-	449, 1971, 2025, 1971, 0, 1137, 0, 2039, 2030, 1827,
-	2045, 2044, 2038, 2050, 1780, 2026, 0, 1801, 2068, 1781,
-	1804, 1138, 1799, 2057, 1137, 2056, 0, 1139, 462, 2055,
-	2054, 730, 451, 0, 462, 0, 0, 2074, 1147, 0,
-	0, 2076, 2061, 2067, 2064, 1976, 1139, 1976, 1137, 0,
-	0, 1840, 1843, 0, 0, 0, 0, 0, 2077, 1964,
-	1917, 0, 0, 864, 0, 0, 1990, 0, 0, 1858,
-	1139, 0, 0, 2087, 0, 0, 2085, 451, 0, 2091,
-	2086, 2089, 1871, 2090, 2014, 1419, 1875, 1876, 462, 733,
-	0, 0, 1880, 1881, 2111, 1117, 2112, 2093, 1884, 2099,
-	0, 1769, 0, 1886, 2110, 2114, 0, 1764, 0, 2113,
-	2047, 2118, 1162, 2131, 2013, 2117, 2051, 0, 1891, 0,
-	1762, 2130, 1894, 2135, 0, 0, 2133, 1957, 0, 1803,
-	1165, 0, 1780, 2143, 0, 2140, 823, 1781, 2146, 1138,
-	2141, 2142, 2145, 0, 0, 734, 0, 736, 2144, 0,
-	1770, 1903, 0, 0, 2159, 2157, 735, 1423, 85, 0,
-	2158, 85, 0, 0, 825, 85, 1137, 0, 0, 0,
-	2078, 0, 0, 2166, 719, 2165, 0, 0, 0, 2167,
-	0, 1164, 0, 0, 0, 85, 1426, 0, 1139, 0,
-	0, 0, 0, 0, 0, 824, 85, 0, 1930, 0,
-	1421, 85, 85, 838, 85, 0, 1936, 1162, 0, 0,
-	1424, 737, 0, 0, 0, 1020, 474, 0, 1144, 0,
-	0, 0, 0, 2016, 2018, 2016, 1148, 1163, 0, 0,
-	0, 0, 0, 0, 0, 1422, 0, 732, 1526, 1527,
-	1528, 0, 1517, 1518, 1519, 1520, 1521, 1523, 1524, 1522,
-	1525, 0, 720, 0, 0, 0, 0, 0, 0, 474,
-	474, 863, 85, 1147, 0, 0, 1969, 0, 0, 85,
-	85, 85, 0, 0, 0, 823, 85, 839, 840, 841,
-	2097, 0, 85, 1765, 823, 0, 0, 1766, 1986, 1987,
-	1988, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	731, 1273, 1273, 825, 721, 0, 0, 0, 0, 848,
-	1425, 85, 825, 2075, 85, 0, 0, 0, 0, 2081,
-	1468, 1469, 1148, 1163, 1768, 823, 0, 839, 840, 841,
-	0, 677, 0, 0, 824, 0, 2007, 0, 1771, 0,
-	0, 0, 838, 824, 0, 0, 0, 0, 0, 0,
-	0, 838, 0, 825, 0, 1165, 0, 0, 1147, 848,
-	0, 0, 0, 0, 1148, 1163, 0, 0, 1273, 1273,
-	1273, 1148, 1163, 2016, 0, 0, 0, 0, 0, 0,
-	0, 1843, 0, 0, 824, 0, 0, 1535, 1536, 1537,
-	0, 0, 838, 0, 0, 722, 0, 0, 0, 0,
-	1148, 1163, 0, 0, 0, 0, 1164, 0, 0, 0,
-	0, 1452, 0, 0, 0, 0, 85, 0, 0, 925,
-	0, 0, 0, 0, 0, 0, 1767, 0, 85, 0,
-	85, 85, 0, 85, 0, 1162, 0, 925, 85, 85,
-	0, 474, 723, 1144, 0, 0, 0, 849, 0, 0,
-	1165, 588, 29, 0, 0, 0, 85, 1148, 1163, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 847, 0,
-	0, 0, 0, 0, 85, 0, 714, 0, 29, 85,
-	0, 85, 844, 0, 85, 0, 0, 85, 2109, 0,
-	0, 0, 0, 0, 0, 428, 2115, 849, 436, 0,
-	0, 1164, 0, 0, 0, 29, 716, 0, 0, 0,
-	0, 2129, 2129, 0, 29, 436, 85, 0, 0, 85,
-	0, 0, 0, 0, 0, 85, 0, 1148, 1163, 0,
-	0, 1162, 844, 1405, 1406, 1407, 0, 724, 1144, 0,
-	0, 0, 2129, 0, 0, 0, 0, 0, 0, 1273,
-	1273, 0, 0, 0, 0, 85, 826, 827, 828, 829,
-	830, 832, 833, 831, 834, 0, 728, 0, 1668, 1669,
-	85, 0, 729, 1162, 0, 0, 0, 2129, 0, 0,
-	1162, 0, 0, 0, 0, 711, 1147, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 925, 1404, 0,
-	0, 0, 0, 0, 0, 846, 0, 0, 0, 1162,
-	0, 1273, 1273, 1273, 1273, 1273, 1273, 1273, 1273, 1273,
-	1273, 1273, 1273, 1273, 1273, 1273, 1273, 0, 1273, 0,
-	1714, 1715, 1716, 1717, 1718, 1719, 1720, 1721, 1722, 1723,
-	1724, 1725, 1726, 1727, 1728, 1729, 0, 1734, 1148, 1163,
-	0, 0, 0, 0, 0, 846, 0, 0, 0, 0,
-	1148, 1163, 0, 0, 0, 0, 1162, 0, 0, 0,
-	0, 0, 0, 0, 0, 730, 0, 0, 1165, 0,
-	0, 0, 1147, 0, 0, 0, 0, 0, 845, 0,
-	0, 835, 836, 837, 0, 826, 827, 828, 829, 830,
-	832, 833, 831, 834, 826, 827, 828, 829, 830, 832,
-	833, 831, 834, 1148, 1163, 1148, 1163, 0, 0, 0,
-	0, 0, 0, 0, 1147, 0, 0, 0, 0, 1164,
-	0, 1147, 0, 733, 1148, 1163, 1162, 0, 845, 0,
-	0, 835, 836, 837, 0, 826, 827, 828, 829, 830,
-	832, 833, 831, 834, 0, 0, 0, 1148, 1163, 85,
-	1147, 85, 0, 0, 0, 0, 1144, 85, 0, 0,
-	728, 925, 0, 0, 1165, 0, 729, 0, 0, 0,
-	0, 0, 0, 85, 0, 0, 474, 0, 0, 734,
-	85, 736, 85, 0, 0, 85, 0, 1148, 1163, 0,
-	735, 0, 0, 85, 85, 0, 85, 85, 85, 0,
-	0, 0, 925, 0, 85, 0, 1165, 1147, 0, 85,
-	85, 85, 0, 1165, 0, 1164, 0, 0, 0, 474,
-	0, 0, 0, 0, 0, 0, 0, 85, 85, 0,
-	0, 1516, 0, 1530, 1531, 1532, 85, 0, 0, 0,
-	0, 1355, 1165, 1148, 1163, 737, 0, 1162, 0, 0,
-	0, 1677, 1144, 0, 1273, 0, 0, 1164, 0, 1162,
-	85, 0, 0, 0, 1164, 85, 85, 0, 85, 730,
-	0, 732, 0, 1921, 0, 0, 0, 1147, 0, 428,
+	508, 2202, 2176, 1231, 1605, 2185, 1293, 2228, 2186, 2003,
+	1354, 1787, 2187, 2150, 1326, 1238, 2094, 1689, 2097, 2175,
+	2056, 1748, 1533, 2020, 1936, 1973, 1574, 2004, 751, 1980,
+	1368, 2104, 1959, 1638, 1785, 1571, 1792, 918, 1974, 2113,
+	900, 108, 108, 525, 1660, 1565, 988, 1942, 827, 440,
+	443, 483, 38, 1661, 1955, 1895, 1880, 1070, 472, 472,
+	1914, 1965, 482, 843, 1568, 857, 1624, 1544, 482, 603,
+	604, 603, 603, 1803, 823, 850, 1217, 461, 1634, 1812,
+	1501, 930, 1627, 763, 1477, 1616, 1529, 639, 935, 720,
+	1285, 522, 1239, 1278, 482, 628, 482, 482, 808, 814,
+	108, 108, 705, 1476, 1420, 766, 1381, 1612, 1345, 466,
+	1366, 968, 1172, 1166, 1363, 917, 898, 468, 53, 1545,
+	1569, 660, 111, 78, 20, 872, 77, 12, 466, 521,
+	899, 716, 973, 79, 7, 1214, 572, 870, 1176, 1136,
+	1139, 706, 98, 759, 76, 15, 797, 801, 943, 862,
+	514, 53, 941, 493, 471, 844, 601, 612, 944, 73,
+	460, 104, 1362, 54, 685, 896, 687, 85, 55, 849,
+	1361, 686, 642, 598, 469, 42, 692, 942, 825, 924,
+	1236, 53, 1232, 1169, 465, 36, 59, 20, 81, 81,
+	12, 2236, 53, 2205, 2085, 465, 2068, 7, 618, 974,
+	1414, 2172, 43, 418, 1317, 1415, 2168, 2164, 15, 2085,
+	1260, 2145, 619, 24, 1843, 1412, 2135, 458, 1510, 2068,
+	61, 974, 457, 638, 479, 2130, 2134, 606, 608, 1317,
+	599, 614, 2058, 473, 610, 45, 2086, 2082, 2071, 2085,
+	1843, 2072, 634, 636, 2070, 646, 602, 2068, 723, 52,
+	2067, 2065, 1170, 2068, 1317, 2043, 2021, 975, 2044, 631,
+	62, 2024, 2017, 640, 1317, 2018, 2016, 1990, 723, 1317,
+	1843, 1892, 1908, 57, 1317, 1890, 725, 1868, 1317, 1783,
+	1412, 1907, 748, 1847, 58, 1842, 1412, 1770, 1843, 32,
+	1771, 1746, 723, 1271, 1260, 46, 725, 1742, 1664, 1721,
+	1260, 1412, 56, 723, 643, 33, 63, 1643, 724, 1171,
+	1412, 1581, 1168, 1668, 1317, 1492, 738, 1614, 1493, 1490,
+	725, 1489, 1412, 1260, 1412, 1317, 34, 1488, 724, 1417,
+	1412, 725, 1412, 1413, 1416, 824, 738, 1412, 1412, 1352,
+	1358, 1068, 35, 1317, 1067, 832, 1312, 1151, 833, 700,
+	626, 478, 724, 718, 641, 2163, 699, 717, 2106, 723,
+	738, 718, 2153, 724, 828, 717, 1247, 1772, 810, 1247,
+	1419, 738, 647, 846, 810, 59, 1291, 809, 1451, 1313,
+	1247, 1412, 2174, 809, 1773, 2125, 2077, 725, 2075, 1948,
+	1871, 1869, 1874, 1860, 1859, 807, 1836, 1854, 59, 1853,
+	1852, 811, 1325, 1851, 1173, 1834, 925, 1824, 1802, 61,
+	1782, 1780, 1530, 1734, 1731, 1903, 1730, 1729, 1672, 724,
+	1656, 1530, 749, 1499, 1498, 1495, 1494, 738, 1484, 59,
+	59, 1652, 61, 1475, 1450, 1447, 1445, 826, 1443, 1442,
+	1441, 1440, 1430, 665, 1424, 50, 482, 1377, 815, 62,
+	482, 673, 774, 767, 772, 56, 1294, 744, 1147, 644,
+	1756, 1831, 699, 61, 61, 698, 49, 2204, 934, 1528,
+	1691, 472, 62, 2152, 2140, 1786, 37, 2061, 2053, 47,
+	2039, 2013, 482, 2008, 48, 57, 1999, 1387, 1510, 1972,
+	59, 56, 1970, 1531, 1866, 1826, 58, 1820, 40, 1167,
+	1817, 1760, 41, 62, 62, 1758, 2076, 74, 890, 1658,
+	975, 1641, 44, 723, 1235, 645, 57, 57, 1527, 1474,
+	1438, 1437, 1385, 1904, 61, 1429, 1906, 58, 58, 723,
+	711, 1408, 647, 1407, 1402, 680, 1141, 802, 805, 1357,
+	1985, 725, 1292, 51, 923, 75, 56, 1222, 1181, 979,
+	484, 466, 795, 794, 793, 792, 791, 725, 790, 789,
+	788, 787, 679, 786, 62, 785, 715, 784, 783, 782,
+	712, 781, 769, 724, 824, 762, 768, 57, 709, 1873,
+	1947, 738, 746, 1148, 56, 703, 723, 1984, 58, 724,
+	1833, 1639, 770, 1324, 1294, 1645, 767, 482, 482, 1646,
+	820, 723, 2035, 659, 1877, 1451, 56, 1511, 668, 1269,
+	936, 1606, 893, 810, 725, 1294, 1451, 1169, 439, 432,
+	681, 937, 809, 1497, 482, 1496, 1386, 653, 846, 725,
+	648, 718, 458, 779, 482, 717, 2203, 457, 1575, 682,
+	695, 696, 1793, 2045, 2019, 701, 724, 1956, 904, 1232,
+	2092, 1692, 1433, 1177, 836, 108, 108, 108, 482, 919,
+	798, 724, 431, 69, 921, 745, 1507, 436, 1534, 812,
+	603, 603, 726, 727, 728, 729, 730, 732, 733, 731,
+	734, 2218, 2160, 1583, 516, 2084, 1170, 933, 1557, 909,
+	574, 2199, 726, 727, 728, 729, 730, 732, 733, 731,
+	734, 472, 2219, 2162, 919, 799, 800, 1451, 1910, 432,
+	803, 1306, 444, 434, 806, 482, 726, 727, 728, 729,
+	730, 732, 733, 731, 734, 603, 891, 726, 727, 728,
+	729, 730, 732, 733, 731, 734, 618, 1398, 2037, 1400,
+	2036, 972, 951, 1171, 835, 72, 1168, 1776, 891, 1775,
+	619, 1451, 431, 1465, 1466, 1467, 1227, 1774, 432, 599,
+	1428, 1287, 1396, 1427, 1426, 1425, 1389, 618, 618, 884,
+	816, 1838, 1779, 1272, 894, 1464, 1265, 1069, 951, 949,
+	1259, 619, 619, 726, 727, 728, 729, 730, 732, 733,
+	731, 734, 1127, 663, 854, 1226, 65, 1103, 848, 847,
+	1344, 431, 669, 887, 1454, 1455, 1456, 1458, 1459, 1457,
+	1460, 901, 1343, 446, 1349, 949, 913, 914, 915, 1464,
+	482, 1314, 1244, 603, 678, 603, 677, 916, 1138, 1340,
+	1249, 603, 603, 813, 2083, 670, 450, 982, 1173, 939,
+	986, 676, 978, 675, 1152, 1157, 1158, 981, 1161, 603,
+	945, 953, 482, 919, 987, 970, 458, 952, 940, 458,
+	458, 964, 108, 1394, 965, 966, 1209, 947, 1399, 454,
+	1219, 1220, 1221, 1156, 1276, 66, 950, 1138, 86, 482,
+	2146, 603, 1287, 1230, 482, 1145, 94, 482, 819, 1299,
+	1262, 1149, 1143, 1303, 775, 981, 771, 454, 1681, 1830,
+	1173, 2124, 981, 2231, 1346, 1347, 91, 2209, 2189, 1275,
+	1350, 1146, 950, 2123, 1502, 1558, 926, 928, 87, 2103,
+	2142, 1318, 1264, 70, 482, 825, 453, 1261, 1125, 64,
+	625, 948, 1331, 1167, 1177, 919, 1762, 726, 727, 728,
+	729, 730, 732, 733, 731, 734, 1468, 2143, 1248, 1678,
+	53, 986, 796, 1995, 453, 88, 618, 2208, 732, 733,
+	731, 734, 1234, 2047, 482, 987, 1288, 948, 95, 71,
+	619, 1102, 1333, 1813, 757, 2046, 1302, 1359, 1307, 1245,
+	1436, 2218, 1375, 1825, 1309, 90, 465, 1250, 1251, 614,
+	2122, 1253, 1252, 1173, 1273, 1395, 1310, 1319, 1797, 1338,
+	1556, 1334, 2188, 464, 1267, 1397, 1679, 1788, 2217, 2215,
+	1266, 524, 728, 729, 730, 732, 733, 731, 734, 642,
+	2190, 1342, 1311, 2054, 1578, 726, 727, 728, 729, 730,
+	732, 733, 731, 734, 1458, 1459, 1457, 1460, 661, 1351,
+	1452, 1453, 1454, 1455, 1456, 1458, 1459, 1457, 1460, 1304,
+	1505, 690, 110, 110, 847, 1344, 1376, 907, 841, 906,
+	110, 110, 846, 658, 463, 1777, 1371, 710, 1126, 110,
+	110, 1246, 452, 110, 451, 67, 864, 633, 623, 110,
+	110, 110, 110, 110, 2207, 1298, 1320, 617, 873, 1337,
+	2191, 689, 1336, 2229, 874, 1258, 1374, 1279, 455, 986,
+	452, 1173, 451, 1123, 1370, 110, 110, 110, 110, 963,
+	640, 110, 110, 987, 873, 2026, 2025, 1373, 1764, 89,
+	874, 1902, 2011, 456, 2240, 1372, 455, 1297, 465, 1383,
+	2048, 1452, 1453, 1454, 1455, 1456, 1458, 1459, 1457, 1460,
+	1579, 1716, 449, 1719, 573, 1763, 96, 1515, 1863, 1327,
+	1865, 643, 1323, 1391, 1189, 865, 1196, 1380, 689, 688,
+	938, 2184, 2230, 684, 1950, 1677, 657, 910, 2151, 1586,
+	1270, 1461, 1462, 1463, 1751, 1452, 1453, 1454, 1455, 1456,
+	1458, 1459, 1457, 1460, 826, 92, 1301, 2232, 892, 2012,
+	1535, 1384, 1284, 1800, 466, 812, 1532, 690, 875, 1619,
+	1886, 641, 1137, 1339, 1968, 958, 1881, 866, 689, 1808,
+	1144, 1807, 597, 93, 463, 1937, 2213, 1296, 1405, 1879,
+	1750, 1754, 864, 1322, 875, 1409, 688, 671, 1124, 1622,
+	1321, 1500, 1804, 1613, 1180, 803, 482, 806, 1508, 1418,
+	1422, 1423, 1998, 1811, 482, 1862, 800, 799, 1478, 1887,
+	83, 1801, 1657, 1620, 462, 1901, 912, 878, 466, 1192,
+	919, 482, 2239, 1717, 690, 1390, 1518, 1392, 1640, 2041,
+	1446, 1401, 1718, 1523, 1305, 1276, 688, 911, 1526, 1864,
+	974, 656, 1473, 878, 655, 1886, 1536, 1537, 466, 1539,
+	1541, 1542, 529, 1486, 1410, 959, 654, 482, 1479, 867,
+	596, 865, 1549, 1550, 1551, 1951, 919, 684, 1179, 1316,
+	946, 1506, 2040, 879, 1432, 881, 86, 919, 1563, 1512,
+	1513, 1282, 780, 1795, 880, 1567, 603, 1768, 1193, 1766,
+	1525, 1747, 1519, 908, 1887, 1576, 1582, 1564, 1335, 879,
+	886, 881, 883, 831, 91, 830, 868, 1481, 1482, 1483,
+	880, 829, 822, 866, 1621, 2002, 87, 693, 1686, 1355,
+	2219, 895, 1333, 1626, 1630, 1633, 1626, 1514, 476, 2052,
+	986, 1509, 903, 855, 2005, 1516, 1553, 1290, 651, 1287,
+	882, 1287, 1588, 1882, 987, 1194, 1741, 1883, 1191, 447,
+	1289, 618, 1286, 88, 576, 1524, 932, 1522, 1946, 1520,
+	2021, 2105, 1548, 723, 888, 619, 882, 877, 1077, 815,
+	80, 29, 2079, 1805, 1092, 697, 2131, 986, 2074, 1949,
+	466, 1554, 575, 90, 1885, 1280, 1648, 1560, 1555, 1559,
+	1628, 987, 1562, 877, 1332, 618, 1328, 1690, 1547, 1888,
+	3, 1623, 1552, 869, 889, 867, 1356, 1237, 971, 619,
+	1584, 1382, 2227, 2238, 110, 1451, 1371, 110, 694, 1371,
+	466, 110, 110, 724, 1587, 2119, 723, 1256, 1882, 477,
+	876, 622, 1883, 1577, 29, 53, 526, 1580, 1636, 1631,
+	1195, 1610, 110, 1642, 1609, 1666, 652, 1935, 847, 842,
+	1647, 1611, 868, 110, 1370, 82, 876, 1370, 1695, 1674,
+	1675, 1676, 1608, 723, 485, 1699, 1254, 1986, 1832, 1885,
+	1255, 482, 1735, 1743, 1684, 1372, 2118, 1649, 1372, 1255,
+	1561, 1671, 1491, 1229, 1888, 2115, 1228, 859, 1884, 919,
+	1225, 725, 919, 97, 1728, 1759, 1224, 1223, 1637, 1680,
+	1682, 1683, 1149, 1693, 430, 1185, 1184, 1183, 1182, 1174,
+	1849, 1722, 1697, 1685, 773, 2114, 666, 89, 861, 664,
+	662, 1724, 1732, 724, 649, 603, 1753, 1268, 445, 1755,
+	1615, 624, 1573, 482, 1856, 1190, 2141, 603, 1435, 482,
+	1994, 1725, 1789, 2055, 1178, 1740, 778, 31, 433, 869,
+	435, 437, 438, 1799, 1736, 500, 1878, 1737, 1939, 1738,
+	1570, 1745, 905, 1744, 863, 1739, 840, 2183, 110, 110,
+	1188, 110, 595, 1884, 1767, 527, 1769, 1074, 528, 1757,
+	1749, 1525, 1075, 92, 804, 617, 1816, 515, 611, 2116,
+	1818, 834, 1630, 1626, 613, 110, 1626, 856, 1240, 1142,
+	1175, 1431, 1619, 891, 1778, 110, 776, 499, 505, 504,
+	1153, 1894, 2073, 1979, 2091, 496, 617, 617, 1791, 110,
+	1943, 858, 102, 103, 1504, 1872, 110, 110, 110, 110,
+	110, 1796, 1622, 1233, 957, 110, 1341, 954, 68, 1615,
+	1765, 110, 110, 931, 448, 1448, 1617, 1207, 1199, 1197,
+	1187, 962, 1857, 683, 691, 922, 1620, 1915, 1628, 1155,
+	1814, 1815, 1961, 1841, 1810, 1958, 1371, 667, 1543, 1371,
+	719, 1822, 110, 1241, 1821, 110, 1828, 1823, 1827, 704,
+	1360, 1618, 84, 1315, 702, 967, 110, 474, 475, 985,
+	1941, 1566, 650, 1308, 817, 750, 110, 1329, 1348, 1967,
+	860, 2159, 1761, 60, 1370, 19, 18, 1370, 603, 17,
+	16, 1619, 13, 14, 1607, 1891, 1077, 919, 11, 1897,
+	10, 919, 1092, 9, 486, 1372, 8, 1911, 1372, 1912,
+	1905, 1909, 30, 28, 27, 1929, 1930, 1931, 1932, 1933,
+	1934, 1622, 1861, 26, 1276, 1806, 1896, 1945, 1809, 1898,
+	25, 6, 5, 23, 1893, 1617, 1953, 1621, 1900, 2117,
+	22, 1917, 21, 4, 2, 1620, 1, 0, 0, 0,
+	0, 1781, 1940, 1918, 0, 0, 1921, 1952, 919, 1916,
+	1975, 1977, 1944, 1790, 1876, 1626, 1920, 1633, 0, 1791,
+	1618, 110, 0, 110, 110, 0, 110, 0, 0, 1913,
+	985, 110, 110, 110, 0, 617, 1976, 0, 1938, 0,
+	1371, 0, 0, 0, 0, 1971, 0, 1981, 1971, 0,
+	110, 0, 1954, 110, 110, 0, 0, 0, 1091, 0,
+	0, 0, 0, 110, 1897, 0, 0, 0, 1966, 0,
+	2009, 0, 603, 1983, 0, 110, 0, 1875, 1370, 482,
+	110, 1978, 110, 1928, 986, 110, 986, 1991, 110, 1371,
+	1371, 0, 0, 1371, 1898, 1982, 1621, 2028, 987, 1372,
+	987, 2000, 0, 0, 0, 0, 0, 0, 1371, 0,
+	0, 0, 2022, 0, 0, 0, 0, 0, 1993, 2006,
+	0, 0, 110, 0, 0, 110, 0, 1370, 1370, 0,
+	0, 1370, 0, 110, 0, 0, 110, 0, 1567, 603,
+	0, 0, 2042, 0, 0, 981, 1370, 2057, 1372, 1372,
+	0, 1077, 1372, 2038, 2030, 1371, 2034, 1092, 2027, 0,
+	2033, 919, 0, 1977, 495, 110, 0, 1372, 1963, 1964,
+	1383, 1823, 1969, 0, 0, 1333, 2031, 2032, 985, 0,
+	0, 0, 0, 2049, 1258, 0, 0, 0, 2066, 0,
+	0, 0, 0, 1370, 0, 0, 2087, 0, 2064, 0,
+	2064, 0, 873, 0, 0, 466, 1076, 0, 874, 1897,
+	2096, 603, 603, 603, 1372, 0, 2060, 0, 0, 2069,
+	2090, 2069, 0, 0, 0, 0, 480, 2109, 2110, 762,
+	482, 2101, 480, 0, 2081, 1945, 1896, 0, 2095, 1898,
+	2111, 1602, 1603, 1604, 1897, 482, 0, 0, 0, 0,
+	1371, 466, 919, 2128, 1917, 0, 2088, 0, 627, 1975,
+	629, 480, 2078, 1633, 2093, 0, 1918, 0, 0, 1921,
+	1944, 2120, 1916, 2107, 1898, 2126, 1897, 1077, 2127, 1920,
+	2148, 2132, 2121, 1092, 2051, 0, 2108, 0, 1370, 2133,
+	603, 2137, 2139, 1981, 482, 2138, 603, 2136, 2154, 1200,
+	1371, 0, 2156, 2147, 0, 2112, 1898, 1601, 1094, 1372,
+	0, 0, 875, 0, 0, 0, 2157, 0, 2010, 1077,
+	0, 2057, 0, 0, 0, 1092, 1077, 0, 0, 2063,
+	0, 1975, 1092, 0, 2165, 1371, 1093, 482, 1370, 2171,
+	2166, 2170, 2169, 2167, 2096, 0, 2144, 0, 603, 2194,
+	0, 2195, 2182, 2181, 1371, 1077, 2173, 0, 0, 1372,
+	2193, 1092, 0, 2197, 707, 707, 2196, 1371, 0, 2201,
+	2206, 878, 2095, 1370, 721, 0, 2212, 0, 752, 753,
+	754, 755, 756, 2200, 0, 2050, 2216, 0, 761, 2214,
+	1072, 0, 1370, 0, 1372, 1897, 2224, 2221, 0, 0,
+	2225, 2222, 2223, 0, 2226, 1370, 1091, 0, 0, 0,
+	777, 0, 0, 1372, 0, 0, 2234, 2233, 2235, 487,
+	2237, 0, 0, 0, 0, 1898, 1372, 879, 2241, 881,
+	0, 1133, 110, 1135, 0, 2242, 0, 110, 880, 110,
+	0, 0, 1077, 0, 0, 110, 0, 0, 1092, 985,
+	0, 0, 0, 0, 0, 0, 1131, 2098, 2100, 2098,
+	0, 110, 110, 0, 0, 0, 0, 110, 0, 0,
+	617, 0, 0, 0, 110, 873, 110, 0, 0, 110,
+	0, 874, 0, 0, 0, 0, 1371, 110, 110, 0,
+	110, 110, 110, 0, 882, 0, 985, 0, 110, 0,
+	0, 0, 0, 110, 110, 110, 0, 110, 0, 0,
+	0, 0, 0, 0, 617, 0, 0, 0, 110, 110,
+	0, 877, 0, 0, 1370, 0, 110, 110, 0, 873,
+	0, 0, 0, 1077, 0, 874, 2155, 110, 0, 1092,
+	0, 0, 2161, 0, 0, 1372, 0, 0, 0, 0,
+	0, 0, 0, 1129, 1076, 0, 0, 1128, 0, 0,
+	0, 0, 1134, 0, 110, 110, 0, 110, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 955, 0,
+	960, 0, 0, 0, 876, 875, 0, 969, 0, 0,
+	0, 0, 0, 0, 2098, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1066, 0,
+	480, 1091, 0, 0, 672, 0, 1104, 1105, 1106, 1107,
+	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
+	1118, 1119, 1120, 1121, 1122, 0, 0, 0, 110, 875,
+	0, 0, 0, 0, 878, 0, 713, 1077, 0, 0,
+	0, 0, 0, 1092, 0, 0, 1094, 0, 0, 1598,
+	1599, 1600, 0, 1589, 1590, 1591, 1592, 1593, 1594, 1595,
+	1596, 1597, 0, 0, 0, 1186, 0, 1198, 0, 1208,
+	1210, 1215, 1218, 0, 1093, 0, 0, 0, 0, 1130,
+	0, 0, 0, 0, 501, 39, 0, 0, 878, 1132,
+	879, 0, 881, 0, 0, 1451, 0, 1465, 1466, 1467,
+	0, 880, 110, 0, 0, 0, 0, 0, 1077, 0,
+	0, 0, 0, 0, 1092, 1837, 0, 0, 39, 0,
+	110, 0, 0, 110, 0, 0, 110, 1091, 1072, 0,
+	0, 1077, 0, 0, 0, 1263, 0, 1092, 459, 0,
+	0, 467, 0, 0, 879, 0, 881, 0, 39, 1076,
+	0, 480, 818, 885, 0, 880, 110, 882, 0, 39,
+	467, 0, 0, 1464, 110, 0, 0, 0, 110, 1091,
+	110, 0, 0, 0, 0, 1077, 1091, 0, 853, 0,
+	0, 1092, 0, 0, 877, 0, 0, 1077, 853, 0,
+	0, 0, 0, 1092, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1091, 0, 871, 0, 0,
+	0, 882, 853, 0, 0, 0, 0, 110, 0, 0,
+	0, 110, 0, 110, 110, 0, 0, 110, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 877, 0,
+	1077, 0, 1077, 0, 0, 0, 1092, 876, 1092, 0,
+	0, 1094, 0, 0, 0, 707, 0, 0, 0, 0,
+	0, 0, 0, 0, 1077, 0, 0, 0, 0, 980,
+	1092, 0, 0, 0, 0, 1076, 0, 0, 0, 1093,
+	0, 0, 0, 110, 0, 0, 0, 0, 0, 0,
+	1468, 0, 1091, 0, 0, 0, 0, 0, 0, 0,
+	1077, 876, 0, 0, 0, 0, 1092, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1076, 0, 0,
+	0, 0, 0, 0, 1076, 0, 0, 0, 0, 0,
+	0, 0, 0, 1072, 0, 0, 0, 0, 0, 110,
+	0, 0, 0, 0, 0, 0, 110, 721, 110, 0,
+	0, 0, 110, 1076, 0, 0, 0, 0, 110, 0,
+	110, 0, 0, 985, 1926, 985, 110, 110, 110, 110,
+	110, 110, 0, 0, 1242, 110, 0, 1094, 110, 0,
+	0, 0, 0, 1091, 0, 0, 0, 110, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1164, 1144, 0, 0, 0, 1529, 1165,
-	0, 1144, 0, 728, 0, 0, 0, 0, 0, 729,
-	0, 0, 1162, 0, 1162, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 733, 0, 0,
-	1144, 0, 0, 1162, 731, 0, 0, 1401, 1402, 1403,
-	0, 1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400,
-	1164, 0, 0, 0, 0, 0, 1162, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1165,
-	0, 0, 0, 0, 0, 0, 0, 823, 0, 839,
-	840, 841, 1273, 734, 0, 736, 0, 1144, 0, 0,
-	0, 0, 0, 0, 735, 0, 1162, 842, 1147, 428,
-	0, 1996, 428, 428, 0, 825, 0, 0, 0, 0,
-	1147, 848, 730, 0, 0, 0, 0, 0, 0, 0,
-	1164, 0, 0, 860, 1533, 0, 0, 862, 0, 0,
-	0, 866, 867, 0, 0, 0, 824, 0, 85, 0,
-	0, 0, 0, 0, 838, 740, 0, 0, 0, 737,
-	0, 0, 1162, 0, 85, 0, 0, 1144, 0, 0,
-	85, 0, 0, 1147, 0, 1147, 0, 0, 0, 0,
-	733, 85, 0, 0, 85, 732, 0, 85, 0, 0,
-	0, 1273, 0, 0, 1147, 0, 0, 0, 0, 0,
-	1165, 0, 0, 0, 0, 823, 0, 839, 840, 841,
-	2063, 0, 1165, 0, 0, 0, 0, 1147, 0, 0,
-	0, 0, 85, 0, 0, 842, 85, 0, 85, 0,
-	0, 0, 29, 825, 0, 85, 734, 0, 736, 848,
-	0, 0, 0, 0, 0, 0, 29, 735, 731, 0,
-	0, 1164, 0, 0, 0, 0, 0, 1147, 0, 849,
-	0, 0, 0, 1164, 824, 1165, 0, 1165, 0, 0,
-	0, 0, 838, 0, 0, 85, 0, 0, 0, 85,
-	847, 85, 85, 0, 0, 85, 1165, 0, 1144, 0,
-	0, 0, 0, 0, 844, 0, 0, 0, 726, 0,
-	1144, 0, 737, 0, 0, 0, 0, 0, 0, 1165,
-	0, 0, 0, 1147, 0, 0, 1164, 0, 1164, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 732, 0,
-	0, 0, 0, 0, 0, 0, 0, 1164, 0, 843,
-	0, 0, 0, 0, 0, 0, 0, 85, 0, 1165,
-	0, 0, 0, 1144, 0, 1144, 0, 1526, 1527, 1528,
-	1164, 1517, 1518, 1519, 1520, 1521, 1523, 1524, 1522, 1525,
-	0, 0, 0, 1132, 1144, 0, 0, 849, 0, 0,
+	0, 0, 0, 1388, 0, 1093, 853, 1393, 0, 110,
+	0, 110, 110, 0, 0, 0, 110, 0, 0, 1094,
+	0, 0, 0, 0, 0, 0, 1094, 0, 0, 0,
+	0, 1411, 0, 1295, 0, 0, 0, 0, 1300, 0,
+	1076, 480, 1421, 0, 0, 0, 0, 1093, 0, 0,
+	0, 0, 0, 0, 1093, 1094, 0, 1434, 0, 1072,
+	0, 1439, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 110, 0, 110, 0, 0, 0, 0, 480, 0,
+	110, 0, 0, 1093, 0, 761, 0, 0, 0, 0,
+	0, 1215, 1215, 1215, 0, 0, 0, 1091, 110, 0,
+	961, 1072, 0, 0, 0, 0, 0, 0, 1072, 0,
+	0, 0, 1503, 0, 1926, 1461, 1462, 1463, 853, 1452,
+	1453, 1454, 1455, 1456, 1458, 1459, 1457, 1460, 0, 480,
+	0, 1076, 0, 0, 0, 0, 0, 1072, 0, 110,
+	110, 0, 1094, 0, 0, 0, 0, 0, 110, 0,
+	0, 0, 0, 459, 0, 0, 0, 0, 0, 0,
+	0, 0, 110, 0, 110, 0, 0, 0, 1091, 0,
+	1093, 0, 0, 0, 0, 0, 0, 0, 0, 758,
+	0, 0, 0, 760, 0, 0, 0, 764, 765, 0,
+	0, 1091, 0, 0, 0, 0, 0, 110, 1201, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 731, 0, 0, 0, 0, 0, 1144, 847, 0,
-	1164, 1211, 0, 0, 0, 1165, 0, 0, 0, 0,
-	0, 0, 844, 0, 0, 0, 0, 846, 0, 0,
-	0, 0, 0, 85, 0, 0, 0, 0, 0, 0,
-	85, 0, 85, 0, 0, 0, 85, 1144, 0, 0,
-	0, 0, 85, 0, 85, 0, 0, 925, 1809, 925,
-	85, 85, 85, 85, 85, 85, 1164, 843, 0, 85,
-	0, 0, 85, 0, 823, 0, 839, 840, 841, 0,
-	0, 85, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 842, 0, 0, 0, 0, 0,
-	0, 0, 825, 1144, 85, 0, 85, 85, 848, 0,
-	845, 85, 0, 835, 836, 837, 0, 826, 827, 828,
-	829, 830, 832, 833, 831, 834, 0, 0, 823, 1306,
-	839, 840, 841, 824, 0, 1307, 0, 0, 0, 0,
-	0, 838, 0, 0, 0, 846, 0, 0, 842, 0,
-	1516, 0, 1530, 1531, 1532, 0, 825, 0, 0, 0,
-	0, 85, 848, 0, 0, 0, 0, 0, 0, 0,
-	1676, 0, 0, 0, 0, 436, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 824, 823, 0,
-	839, 840, 841, 0, 0, 838, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 842, 0,
-	0, 0, 0, 0, 0, 0, 825, 1529, 85, 0,
-	85, 0, 848, 0, 0, 0, 0, 85, 845, 0,
-	0, 835, 836, 837, 0, 826, 827, 828, 829, 830,
-	832, 833, 831, 834, 0, 85, 849, 824, 0, 0,
-	0, 0, 1552, 0, 0, 838, 0, 29, 0, 0,
-	823, 1809, 839, 840, 841, 0, 0, 847, 0, 0,
+	0, 110, 110, 110, 110, 1451, 0, 1465, 1466, 1467,
+	0, 0, 0, 1585, 1072, 0, 0, 1926, 110, 110,
+	0, 110, 969, 1094, 0, 1091, 110, 0, 0, 0,
+	0, 0, 0, 0, 0, 1076, 110, 1091, 0, 0,
+	0, 0, 0, 110, 0, 0, 0, 0, 0, 1644,
+	110, 1093, 0, 0, 0, 0, 1650, 1651, 0, 1653,
+	0, 0, 0, 723, 0, 739, 740, 741, 0, 0,
+	0, 1659, 0, 1464, 0, 0, 0, 1665, 0, 0,
+	0, 110, 1670, 742, 0, 110, 0, 110, 0, 1670,
+	1091, 725, 1091, 0, 0, 0, 0, 748, 0, 0,
+	0, 0, 0, 1687, 0, 1072, 1076, 0, 0, 0,
+	0, 0, 110, 0, 1091, 0, 1696, 0, 0, 1698,
+	0, 0, 110, 724, 0, 0, 0, 0, 110, 1076,
+	0, 738, 0, 0, 0, 110, 0, 1094, 0, 110,
+	0, 0, 0, 0, 0, 0, 0, 0, 1726, 1727,
+	1091, 0, 0, 0, 0, 0, 0, 1733, 0, 0,
+	0, 0, 0, 0, 0, 1093, 0, 459, 0, 0,
+	459, 459, 707, 1076, 0, 0, 0, 0, 0, 1469,
+	853, 721, 0, 0, 0, 1076, 0, 0, 853, 0,
+	0, 0, 0, 0, 0, 0, 0, 1752, 0, 0,
+	1468, 0, 0, 0, 0, 1517, 0, 0, 1094, 0,
+	0, 0, 0, 0, 0, 0, 1403, 1404, 0, 1072,
+	0, 0, 0, 0, 1140, 0, 0, 0, 0, 0,
+	0, 1094, 0, 0, 0, 0, 1093, 749, 1076, 0,
+	1076, 1546, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 747, 1093,
+	0, 0, 1076, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 744, 0, 0, 1094, 1451, 0, 1465, 1466,
+	1467, 0, 1470, 1471, 1472, 0, 0, 1094, 0, 0,
+	1072, 0, 39, 0, 0, 0, 0, 0, 1076, 0,
+	0, 0, 0, 1093, 0, 0, 0, 39, 0, 0,
+	0, 0, 480, 1072, 0, 1093, 0, 743, 0, 0,
+	0, 0, 1835, 0, 0, 0, 1839, 1840, 0, 0,
+	0, 0, 1844, 1845, 0, 0, 1201, 1201, 1848, 0,
+	1094, 0, 1094, 1850, 1464, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1072, 1855, 0,
+	0, 0, 1858, 0, 1094, 0, 0, 0, 1093, 1072,
+	1093, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	842, 844, 0, 0, 0, 29, 85, 85, 825, 0,
-	0, 0, 0, 1436, 848, 85, 1439, 0, 0, 0,
-	849, 0, 0, 0, 0, 0, 0, 0, 0, 85,
-	0, 85, 0, 0, 0, 0, 0, 0, 0, 824,
-	0, 847, 0, 0, 0, 0, 843, 838, 0, 0,
-	0, 0, 0, 0, 0, 844, 0, 0, 0, 0,
-	0, 0, 0, 1533, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1211,
-	849, 0, 0, 0, 0, 0, 0, 1516, 85, 1530,
-	1531, 1532, 0, 0, 862, 1471, 0, 0, 0, 0,
-	843, 847, 85, 85, 85, 85, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 844, 0, 0, 1809, 85,
-	85, 0, 85, 0, 846, 0, 0, 85, 0, 1516,
-	0, 1530, 1531, 1532, 0, 0, 0, 85, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 85, 849, 0, 1529, 0, 0, 0, 0, 862,
-	843, 0, 0, 0, 0, 0, 823, 0, 839, 840,
-	841, 0, 0, 847, 0, 0, 0, 0, 846, 0,
-	0, 0, 0, 0, 0, 0, 842, 844, 0, 0,
-	0, 0, 0, 85, 825, 0, 1529, 85, 0, 85,
-	848, 0, 0, 0, 0, 0, 0, 845, 0, 0,
-	835, 836, 837, 0, 826, 827, 828, 829, 830, 832,
-	833, 831, 834, 0, 85, 824, 0, 0, 2139, 0,
-	0, 0, 843, 838, 85, 0, 0, 0, 846, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 85,
-	0, 0, 0, 85, 0, 0, 0, 0, 0, 1534,
-	0, 845, 0, 0, 835, 836, 837, 0, 826, 827,
-	828, 829, 830, 832, 833, 831, 834, 0, 0, 0,
-	1533, 823, 2069, 839, 840, 841, 1526, 1527, 1528, 0,
-	1517, 1518, 1519, 1520, 1521, 1523, 1524, 1522, 1525, 0,
-	1132, 842, 0, 1132, 0, 0, 0, 0, 0, 825,
-	846, 0, 0, 0, 0, 848, 0, 0, 0, 0,
-	0, 845, 1533, 0, 835, 836, 837, 0, 826, 827,
-	828, 829, 830, 832, 833, 831, 834, 0, 849, 0,
-	824, 0, 2020, 0, 0, 0, 0, 0, 838, 0,
-	0, 0, 0, 0, 862, 0, 0, 0, 0, 847,
-	0, 0, 0, 0, 1154, 0, 1169, 1149, 1161, 0,
-	0, 0, 0, 844, 0, 0, 0, 0, 0, 1171,
-	1170, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 845, 0, 0, 835, 836, 837, 0,
-	826, 827, 828, 829, 830, 832, 833, 831, 834, 0,
-	0, 0, 0, 0, 2000, 0, 0, 0, 843, 0,
-	0, 0, 0, 0, 0, 1166, 0, 0, 1159, 1158,
+	0, 1867, 1093, 0, 0, 0, 0, 746, 0, 0,
+	1094, 0, 1201, 1201, 1201, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1157, 0, 0,
-	0, 0, 0, 849, 29, 0, 0, 0, 0, 0,
+	0, 0, 1072, 0, 1072, 1461, 1462, 1463, 1093, 1452,
+	1453, 1454, 1455, 1456, 1458, 1459, 1457, 1460, 0, 0,
+	0, 0, 0, 0, 0, 0, 1072, 0, 0, 1365,
+	0, 0, 0, 0, 0, 1242, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1156, 847, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 844, 0,
-	0, 0, 0, 0, 0, 0, 846, 0, 0, 0,
-	0, 0, 0, 1526, 1527, 1528, 0, 1517, 1518, 1519,
-	1520, 1521, 1523, 1524, 1522, 1525, 1151, 1152, 0, 766,
-	1132, 1132, 0, 0, 1132, 0, 0, 0, 0, 0,
-	0, 0, 0, 843, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1526, 1527, 1528, 0, 1517,
-	1518, 1519, 1520, 1521, 1523, 1524, 1522, 1525, 0, 0,
+	1654, 1655, 0, 0, 1957, 1960, 0, 0, 0, 0,
+	745, 0, 1072, 735, 736, 737, 0, 726, 727, 728,
+	729, 730, 732, 733, 731, 734, 0, 0, 0, 1378,
+	0, 0, 0, 0, 0, 1379, 1987, 1988, 1989, 0,
+	0, 0, 0, 0, 0, 0, 0, 1784, 0, 0,
+	0, 0, 0, 1794, 0, 0, 0, 0, 0, 0,
+	0, 0, 1700, 1701, 1702, 1703, 1704, 1705, 1706, 1707,
+	1708, 1709, 1710, 1711, 1712, 1713, 1714, 1715, 0, 1720,
+	0, 723, 0, 739, 740, 741, 0, 0, 0, 480,
+	0, 0, 480, 0, 0, 0, 0, 0, 0, 2023,
+	0, 742, 0, 0, 0, 0, 0, 2029, 0, 725,
+	723, 0, 739, 740, 741, 748, 0, 0, 0, 0,
+	1201, 1201, 0, 0, 0, 0, 0, 0, 0, 0,
+	742, 1140, 0, 0, 0, 0, 0, 0, 725, 0,
+	0, 724, 0, 0, 748, 0, 760, 1406, 0, 738,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1160, 0, 0, 0, 0, 0, 0, 0, 845,
-	0, 0, 835, 836, 837, 0, 826, 827, 828, 829,
-	830, 832, 833, 831, 834, 0, 0, 0, 0, 0,
-	1995, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 846, 0, 0, 1155, 0, 0, 0, 0, 0,
+	0, 0, 761, 0, 0, 0, 0, 2062, 0, 0,
+	724, 0, 0, 0, 0, 0, 0, 0, 738, 0,
+	0, 0, 1201, 1201, 1201, 1201, 1201, 1201, 1201, 1201,
+	1201, 1201, 1201, 1201, 1201, 1201, 1201, 1201, 0, 1201,
+	0, 0, 0, 0, 0, 0, 0, 0, 721, 0,
+	760, 0, 0, 2089, 0, 0, 0, 0, 0, 723,
+	0, 739, 740, 741, 0, 0, 1461, 1462, 1463, 0,
+	1452, 1453, 1454, 1455, 1456, 1458, 1459, 1457, 1460, 742,
+	467, 0, 0, 0, 0, 0, 723, 725, 739, 740,
+	741, 0, 0, 748, 0, 749, 0, 0, 1960, 0,
+	0, 0, 0, 0, 0, 0, 742, 0, 0, 0,
+	0, 0, 0, 0, 725, 0, 747, 0, 0, 724,
+	748, 0, 480, 480, 749, 0, 480, 738, 0, 0,
+	744, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 747, 724, 0, 0, 0,
+	0, 0, 0, 0, 738, 0, 0, 0, 0, 744,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 39, 0, 743, 0, 39, 0, 0,
+	0, 0, 2180, 2180, 0, 0, 0, 0, 0, 0,
+	0, 723, 2192, 739, 740, 741, 0, 0, 0, 0,
+	2198, 0, 39, 2015, 743, 0, 0, 0, 0, 1632,
+	0, 742, 1635, 0, 0, 0, 0, 2180, 0, 725,
+	0, 0, 0, 0, 0, 748, 0, 0, 0, 0,
+	0, 0, 0, 749, 0, 0, 0, 0, 723, 0,
+	739, 740, 741, 0, 0, 0, 0, 0, 0, 0,
+	0, 724, 760, 0, 747, 746, 0, 0, 742, 738,
+	749, 0, 0, 0, 0, 0, 725, 2180, 744, 0,
+	0, 1451, 748, 1465, 1466, 1467, 0, 0, 0, 0,
+	0, 747, 0, 480, 746, 0, 0, 0, 0, 0,
+	0, 1663, 0, 0, 0, 744, 0, 0, 724, 0,
+	0, 0, 0, 0, 0, 0, 738, 0, 0, 0,
+	0, 0, 1997, 743, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1451, 0, 1465, 1466, 1467, 0,
+	0, 0, 0, 0, 0, 0, 0, 2014, 745, 1464,
+	743, 735, 736, 737, 1662, 726, 727, 728, 729, 730,
+	732, 733, 731, 734, 853, 0, 723, 0, 739, 740,
+	741, 0, 1487, 0, 0, 749, 0, 745, 0, 2129,
+	735, 736, 737, 0, 726, 727, 728, 729, 730, 732,
+	733, 731, 734, 0, 725, 0, 747, 0, 2220, 0,
+	748, 0, 1464, 746, 0, 723, 0, 739, 740, 741,
+	744, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 749, 0, 0, 742, 724, 0, 2158, 0,
+	746, 0, 0, 725, 738, 0, 0, 0, 0, 748,
+	0, 0, 1201, 747, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 743, 0, 744, 2080, 0,
+	0, 0, 0, 0, 0, 724, 0, 1201, 0, 1365,
+	0, 1242, 1365, 738, 0, 0, 1468, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 745, 0, 0, 735,
+	736, 737, 0, 726, 727, 728, 729, 730, 732, 733,
+	731, 734, 743, 0, 0, 0, 0, 2149, 0, 0,
+	0, 0, 0, 745, 0, 0, 735, 736, 737, 0,
+	726, 727, 728, 729, 730, 732, 733, 731, 734, 1468,
+	0, 0, 0, 0, 2102, 746, 0, 0, 0, 0,
+	749, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1914, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1143, 0, 0, 0,
-	0, 0, 1153, 0, 0, 0, 0, 0, 0, 0,
+	0, 747, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 744, 0, 0, 1201, 749,
+	0, 0, 746, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 39, 0, 0,
+	747, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 744, 0, 0, 0, 745, 0,
+	0, 735, 736, 737, 0, 726, 727, 728, 729, 730,
+	732, 733, 731, 734, 0, 0, 0, 0, 0, 2001,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1150, 0, 1168,
-	1167, 0, 0, 0, 845, 0, 0, 835, 836, 837,
-	0, 826, 827, 828, 829, 830, 832, 833, 831, 834,
-	0, 0, 0, 0, 29, 1991, 0, 0, 0, 0,
-	0, 1172, 0, 0, 0, 0, 0, 862, 0, 0,
-	0, 0, 0, 1132, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 743,
+	0, 0, 1365, 1365, 0, 745, 1365, 0, 735, 736,
+	737, 0, 726, 727, 728, 729, 730, 732, 733, 731,
+	734, 0, 0, 0, 0, 0, 1996, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1808, 703, 1802, 0,
-	0, 708, 0, 0, 0, 1405, 1406, 1407, 0, 87,
-	88, 89, 90, 91, 92, 93, 94, 929, 95, 96,
-	97, 930, 931, 932, 933, 934, 935, 936, 98, 99,
-	937, 100, 101, 477, 102, 103, 104, 862, 1154, 478,
-	1169, 1149, 1161, 938, 105, 106, 107, 108, 109, 939,
-	940, 395, 110, 1171, 1170, 111, 941, 112, 113, 114,
-	115, 0, 942, 479, 943, 116, 117, 118, 119, 120,
-	1404, 480, 121, 122, 123, 944, 124, 125, 126, 127,
-	128, 129, 945, 481, 130, 131, 132, 946, 947, 948,
-	482, 949, 950, 951, 133, 134, 135, 136, 137, 1166,
-	138, 139, 1159, 1158, 140, 952, 141, 953, 142, 143,
-	144, 145, 146, 954, 147, 148, 149, 955, 956, 150,
-	151, 641, 153, 154, 957, 155, 156, 157, 958, 158,
-	159, 160, 959, 161, 162, 163, 164, 0, 165, 166,
-	167, 0, 960, 168, 961, 169, 170, 1156, 171, 962,
-	172, 963, 173, 483, 964, 484, 174, 175, 176, 965,
-	177, 0, 966, 0, 178, 967, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 968, 188, 189, 190, 191,
-	192, 193, 969, 194, 485, 0, 195, 196, 197, 198,
-	1151, 1152, 970, 766, 971, 199, 486, 200, 487, 201,
-	202, 203, 204, 205, 972, 973, 206, 0, 488, 207,
-	489, 974, 208, 209, 396, 975, 976, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
-	223, 397, 0, 490, 0, 224, 225, 0, 977, 226,
-	227, 228, 978, 0, 229, 1160, 230, 231, 232, 979,
-	233, 980, 981, 234, 235, 982, 983, 236, 0, 491,
-	237, 492, 0, 238, 239, 240, 241, 242, 243, 244,
-	984, 245, 246, 0, 247, 0, 250, 248, 249, 985,
-	251, 252, 253, 254, 255, 256, 257, 258, 1155, 259,
-	260, 261, 262, 986, 263, 264, 265, 266, 267, 268,
-	269, 270, 271, 272, 273, 987, 274, 275, 493, 276,
-	277, 278, 0, 279, 280, 281, 282, 283, 284, 285,
-	286, 988, 287, 288, 289, 290, 398, 989, 291, 292,
-	1805, 293, 294, 494, 295, 296, 1153, 297, 990, 298,
-	299, 300, 301, 302, 303, 304, 305, 306, 307, 308,
-	0, 991, 309, 310, 992, 311, 495, 312, 313, 314,
-	315, 1810, 993, 1168, 1167, 994, 995, 399, 317, 0,
-	318, 0, 996, 319, 320, 321, 322, 323, 324, 325,
-	997, 998, 326, 327, 328, 329, 330, 999, 1000, 331,
-	332, 333, 334, 335, 0, 1172, 1001, 336, 496, 337,
-	338, 339, 340, 1002, 1003, 341, 1004, 1005, 342, 343,
-	344, 345, 346, 347, 348, 349, 0, 0, 0, 1401,
-	1402, 1403, 924, 1806, 1807, 1394, 1395, 1396, 1397, 1398,
-	1399, 1400, 0, 0, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 929, 95, 96, 97, 930, 931, 932,
-	933, 934, 935, 936, 98, 99, 937, 100, 101, 477,
-	102, 103, 104, 350, 351, 478, 352, 0, 353, 938,
-	105, 106, 107, 108, 109, 939, 940, 395, 110, 354,
-	355, 111, 941, 112, 113, 114, 115, 356, 942, 479,
-	943, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 944, 124, 125, 126, 127, 128, 129, 945, 481,
-	130, 131, 132, 946, 947, 948, 482, 949, 950, 951,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 952, 141, 953, 142, 143, 144, 145, 146, 954,
-	147, 148, 149, 955, 956, 150, 151, 152, 153, 154,
-	957, 155, 156, 157, 958, 158, 159, 160, 959, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 960, 168,
-	961, 169, 170, 362, 171, 962, 172, 963, 173, 483,
-	964, 484, 174, 175, 176, 965, 177, 363, 966, 364,
-	178, 967, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 968, 188, 189, 190, 191, 192, 193, 969, 194,
-	485, 365, 195, 196, 197, 198, 366, 367, 970, 368,
-	971, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	972, 973, 206, 369, 488, 207, 489, 974, 208, 209,
-	396, 975, 976, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 977, 226, 227, 228, 978, 373,
-	229, 374, 230, 231, 232, 979, 233, 980, 981, 234,
-	235, 982, 983, 236, 375, 491, 237, 492, 376, 238,
-	239, 240, 241, 242, 243, 244, 984, 245, 246, 377,
-	247, 378, 250, 248, 249, 985, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 986,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 987, 274, 275, 493, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 988, 287, 288,
-	289, 290, 398, 989, 291, 292, 381, 293, 294, 494,
-	295, 296, 382, 297, 990, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 991, 309, 310,
-	992, 311, 495, 312, 313, 314, 315, 316, 993, 411,
-	384, 994, 995, 399, 317, 385, 318, 386, 996, 319,
-	320, 321, 322, 323, 324, 325, 997, 998, 326, 327,
-	328, 329, 330, 999, 1000, 331, 332, 333, 334, 335,
-	387, 388, 1001, 336, 496, 337, 338, 339, 340, 1002,
-	1003, 341, 1004, 1005, 342, 343, 344, 345, 346, 347,
-	348, 349, 924, 0, 0, 0, 0, 0, 0, 0,
-	0, 923, 0, 0, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 929, 95, 96, 97, 930, 931, 932,
-	933, 934, 935, 936, 98, 99, 937, 100, 101, 477,
-	102, 103, 104, 350, 351, 478, 352, 0, 353, 938,
-	105, 106, 107, 108, 109, 939, 940, 395, 110, 354,
-	355, 111, 941, 112, 113, 114, 115, 356, 942, 479,
-	943, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 944, 124, 125, 126, 127, 128, 129, 945, 481,
-	130, 131, 132, 946, 947, 948, 482, 949, 950, 951,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 952, 141, 953, 142, 143, 144, 145, 146, 954,
-	147, 148, 149, 955, 956, 150, 151, 152, 153, 154,
-	957, 155, 156, 157, 958, 158, 159, 160, 959, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 960, 168,
-	961, 169, 170, 362, 171, 962, 172, 963, 173, 483,
-	964, 484, 174, 175, 176, 965, 177, 363, 966, 364,
-	178, 967, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 968, 188, 189, 190, 191, 192, 193, 969, 194,
-	485, 365, 195, 196, 197, 198, 366, 367, 970, 368,
-	971, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	972, 973, 206, 369, 488, 207, 489, 974, 208, 209,
-	396, 975, 976, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 977, 226, 227, 228, 978, 373,
-	229, 374, 230, 231, 232, 979, 233, 980, 981, 234,
-	235, 982, 983, 236, 375, 491, 237, 492, 376, 238,
-	239, 240, 241, 242, 243, 244, 984, 245, 246, 377,
-	247, 378, 250, 248, 249, 985, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 986,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 987, 274, 275, 493, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 988, 287, 288,
-	289, 290, 398, 989, 291, 292, 381, 293, 294, 494,
-	295, 296, 382, 297, 990, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 991, 309, 310,
-	992, 311, 495, 312, 313, 314, 315, 316, 993, 411,
-	384, 994, 995, 399, 317, 385, 318, 386, 996, 319,
-	320, 321, 322, 323, 324, 325, 997, 998, 326, 327,
-	328, 329, 330, 999, 1000, 331, 332, 333, 334, 335,
-	387, 388, 1001, 336, 496, 337, 338, 339, 340, 1002,
-	1003, 341, 1004, 1005, 342, 343, 344, 345, 346, 347,
-	348, 349, 610, 597, 598, 599, 600, 596, 584, 0,
-	0, 0, 0, 0, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 590, 0, 0, 98, 99, 0, 100, 101, 477,
-	102, 103, 104, 350, 642, 478, 643, 0, 644, 0,
-	105, 106, 107, 108, 109, 607, 630, 395, 110, 645,
-	646, 111, 0, 112, 113, 114, 115, 638, 0, 618,
-	0, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 481,
-	130, 131, 132, 628, 619, 624, 629, 620, 621, 625,
-	133, 134, 135, 136, 137, 647, 138, 139, 648, 649,
-	140, 0, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 641, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 589, 165, 166, 167, 631, 605, 168,
-	0, 169, 170, 650, 171, 0, 172, 0, 173, 483,
-	0, 484, 174, 175, 176, 0, 177, 639, 0, 593,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	485, 365, 195, 196, 197, 198, 651, 652, 0, 617,
-	0, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	0, 0, 206, 640, 488, 207, 489, 0, 208, 209,
-	396, 622, 623, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 578, 226, 227, 228, 606, 637,
-	229, 653, 230, 231, 232, 0, 233, 0, 0, 234,
-	235, 0, 0, 236, 375, 491, 237, 492, 632, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 633,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 654, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 493, 276, 277, 278, 594, 279,
-	280, 281, 282, 283, 284, 285, 286, 49, 287, 288,
-	289, 290, 398, 626, 291, 292, 381, 293, 294, 494,
-	295, 296, 655, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 634, 0, 309, 310,
-	51, 311, 495, 312, 313, 314, 315, 316, 0, 656,
-	657, 0, 0, 399, 317, 635, 318, 636, 604, 319,
-	320, 321, 322, 323, 324, 325, 0, 581, 326, 327,
-	328, 329, 330, 627, 0, 331, 332, 333, 334, 335,
-	472, 658, 0, 336, 496, 337, 338, 339, 340, 0,
-	0, 341, 0, 47, 342, 343, 344, 345, 346, 347,
-	348, 349, 579, 0, 48, 0, 0, 0, 0, 575,
-	576, 610, 597, 598, 599, 600, 596, 584, 0, 577,
-	0, 0, 585, 1966, 87, 88, 89, 90, 91, 92,
-	93, 94, 1235, 95, 96, 97, 0, 0, 0, 0,
-	590, 0, 0, 98, 99, 0, 100, 101, 477, 102,
-	103, 104, 350, 642, 478, 643, 0, 644, 0, 105,
-	106, 107, 108, 109, 607, 630, 395, 110, 645, 646,
-	111, 0, 112, 113, 114, 115, 638, 0, 618, 0,
-	116, 117, 118, 119, 120, 0, 480, 121, 122, 123,
-	0, 124, 125, 126, 127, 128, 129, 0, 481, 130,
-	131, 132, 628, 619, 624, 629, 620, 621, 625, 133,
-	134, 135, 136, 137, 647, 138, 139, 648, 649, 140,
-	0, 141, 0, 142, 143, 144, 145, 146, 0, 147,
-	148, 149, 1236, 0, 150, 151, 641, 153, 154, 0,
-	155, 156, 157, 0, 158, 159, 160, 0, 161, 162,
-	163, 164, 589, 165, 166, 167, 631, 605, 168, 0,
-	169, 170, 650, 171, 0, 172, 0, 173, 483, 0,
-	484, 174, 175, 176, 0, 177, 639, 0, 593, 178,
-	0, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	0, 188, 189, 190, 191, 192, 193, 0, 194, 485,
-	365, 195, 196, 197, 198, 651, 652, 0, 617, 0,
-	199, 486, 200, 487, 201, 202, 203, 204, 205, 0,
-	0, 206, 640, 488, 207, 489, 0, 208, 209, 396,
-	622, 623, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 221, 222, 223, 397, 370, 490, 371,
-	224, 225, 372, 578, 226, 227, 228, 606, 637, 229,
-	653, 230, 231, 232, 0, 233, 0, 0, 234, 235,
-	0, 0, 236, 375, 491, 237, 492, 632, 238, 239,
-	240, 241, 242, 243, 244, 0, 245, 246, 633, 247,
-	378, 250, 248, 249, 0, 251, 252, 253, 254, 255,
-	256, 257, 258, 654, 259, 260, 261, 262, 0, 263,
-	264, 265, 266, 267, 268, 269, 270, 271, 272, 273,
-	0, 274, 275, 493, 276, 277, 278, 594, 279, 280,
-	281, 282, 283, 284, 285, 286, 0, 287, 288, 289,
-	290, 398, 626, 291, 292, 381, 293, 294, 494, 295,
-	296, 655, 297, 0, 298, 299, 300, 301, 302, 303,
-	304, 305, 306, 307, 308, 634, 0, 309, 310, 0,
-	311, 495, 312, 313, 314, 315, 316, 0, 656, 657,
-	0, 0, 399, 317, 635, 318, 636, 604, 319, 320,
-	321, 322, 323, 324, 325, 0, 581, 326, 327, 328,
-	329, 330, 627, 0, 331, 332, 333, 334, 335, 387,
-	658, 1234, 336, 496, 337, 338, 339, 340, 0, 0,
-	341, 0, 0, 342, 343, 344, 345, 346, 347, 348,
-	349, 579, 0, 0, 0, 0, 0, 0, 575, 576,
-	1237, 610, 597, 598, 599, 600, 596, 584, 577, 0,
-	0, 585, 1232, 0, 87, 88, 89, 90, 91, 92,
-	93, 94, 0, 95, 96, 97, 0, 0, 0, 0,
-	590, 0, 0, 98, 99, 0, 100, 101, 477, 102,
-	103, 104, 350, 642, 478, 643, 0, 644, 0, 105,
-	106, 107, 108, 109, 607, 630, 395, 110, 645, 646,
-	111, 0, 112, 113, 114, 115, 638, 0, 618, 0,
-	116, 117, 118, 119, 120, 0, 480, 121, 122, 123,
-	0, 124, 125, 126, 127, 128, 129, 0, 481, 130,
-	131, 132, 628, 619, 624, 629, 620, 621, 625, 133,
-	134, 135, 136, 137, 647, 138, 139, 648, 649, 140,
-	678, 141, 0, 142, 143, 144, 145, 146, 0, 147,
-	148, 149, 0, 0, 150, 151, 641, 153, 154, 0,
-	155, 156, 157, 0, 158, 159, 160, 0, 161, 162,
-	163, 164, 589, 165, 166, 167, 631, 605, 168, 0,
-	169, 170, 650, 171, 0, 172, 0, 173, 483, 0,
-	484, 174, 175, 176, 0, 177, 639, 0, 593, 178,
-	0, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	0, 188, 189, 190, 191, 192, 193, 0, 194, 485,
-	365, 195, 196, 197, 198, 651, 652, 0, 617, 0,
-	199, 486, 200, 487, 201, 202, 203, 204, 205, 0,
-	0, 206, 640, 488, 207, 489, 0, 208, 209, 396,
-	622, 623, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 221, 222, 223, 397, 370, 490, 371,
-	224, 225, 372, 578, 226, 227, 228, 606, 637, 229,
-	653, 230, 231, 232, 0, 233, 0, 0, 234, 235,
-	0, 0, 236, 375, 491, 237, 492, 632, 238, 239,
-	240, 241, 242, 243, 244, 0, 245, 246, 633, 247,
-	378, 250, 248, 249, 0, 251, 252, 253, 254, 255,
-	256, 257, 258, 654, 259, 260, 261, 262, 0, 263,
-	264, 265, 266, 267, 268, 269, 270, 271, 272, 273,
-	0, 274, 275, 493, 276, 277, 278, 594, 279, 280,
-	281, 282, 283, 284, 285, 286, 49, 287, 288, 289,
-	290, 398, 626, 291, 292, 381, 293, 294, 494, 295,
-	296, 655, 297, 0, 298, 299, 300, 301, 302, 303,
-	304, 305, 306, 307, 308, 634, 0, 309, 310, 51,
-	311, 495, 312, 313, 314, 315, 316, 0, 656, 657,
-	0, 0, 399, 317, 635, 318, 636, 604, 319, 320,
-	321, 322, 323, 324, 325, 0, 581, 326, 327, 328,
-	329, 330, 627, 0, 331, 332, 333, 334, 335, 472,
-	658, 0, 336, 496, 337, 338, 339, 340, 0, 0,
-	341, 0, 47, 342, 343, 344, 345, 346, 347, 348,
-	349, 579, 0, 48, 0, 0, 0, 0, 575, 576,
-	610, 597, 598, 599, 600, 596, 584, 0, 577, 0,
-	0, 585, 0, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 590,
-	0, 0, 98, 99, 0, 100, 101, 477, 102, 103,
-	104, 350, 642, 478, 643, 0, 644, 0, 105, 106,
-	107, 108, 109, 607, 630, 395, 110, 645, 646, 111,
-	0, 112, 113, 114, 115, 638, 0, 618, 0, 116,
-	117, 118, 119, 120, 0, 480, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 481, 130, 131,
-	132, 628, 619, 624, 629, 620, 621, 625, 133, 134,
-	135, 136, 137, 647, 138, 139, 648, 649, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 641, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 589, 165, 166, 167, 631, 605, 168, 0, 169,
-	170, 650, 171, 0, 172, 0, 173, 483, 0, 484,
-	174, 175, 176, 0, 177, 639, 0, 593, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 485, 365,
-	195, 196, 197, 198, 651, 652, 0, 617, 0, 199,
-	486, 200, 487, 201, 202, 203, 204, 205, 0, 0,
-	206, 640, 488, 207, 489, 0, 208, 209, 396, 622,
-	623, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 397, 370, 490, 371, 224,
-	225, 372, 578, 226, 227, 228, 606, 637, 229, 653,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 491, 237, 492, 632, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 633, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 654, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 493, 276, 277, 278, 594, 279, 280, 281,
-	282, 283, 284, 285, 286, 49, 287, 288, 289, 290,
-	398, 626, 291, 292, 381, 293, 294, 494, 295, 296,
-	655, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 634, 0, 309, 310, 51, 311,
-	495, 312, 313, 314, 315, 316, 0, 656, 657, 0,
-	0, 399, 317, 635, 318, 636, 604, 319, 320, 321,
-	322, 323, 324, 325, 0, 581, 326, 327, 328, 329,
-	330, 627, 0, 331, 332, 333, 334, 335, 472, 658,
-	0, 336, 496, 337, 338, 339, 340, 0, 0, 341,
-	0, 47, 342, 343, 344, 345, 346, 347, 348, 349,
-	579, 0, 48, 0, 0, 0, 0, 575, 576, 610,
-	597, 598, 599, 600, 596, 584, 0, 577, 0, 0,
-	585, 0, 87, 88, 89, 90, 91, 92, 93, 94,
-	0, 95, 96, 97, 0, 0, 0, 0, 590, 0,
-	0, 98, 99, 0, 100, 101, 477, 102, 103, 104,
-	350, 642, 478, 643, 0, 644, 1283, 105, 106, 107,
-	108, 109, 607, 630, 395, 110, 645, 646, 111, 0,
-	112, 113, 114, 115, 638, 0, 618, 0, 116, 117,
-	118, 119, 120, 0, 480, 121, 122, 123, 0, 124,
-	125, 126, 127, 128, 129, 0, 481, 130, 131, 132,
-	628, 619, 624, 629, 620, 621, 625, 133, 134, 135,
-	136, 137, 647, 138, 139, 648, 649, 140, 0, 141,
-	0, 142, 143, 144, 145, 146, 0, 147, 148, 149,
-	0, 0, 150, 151, 641, 153, 154, 0, 155, 156,
-	157, 0, 158, 159, 160, 0, 161, 162, 163, 164,
-	589, 165, 166, 167, 631, 605, 168, 0, 169, 170,
-	650, 171, 0, 172, 0, 173, 483, 1288, 484, 174,
-	175, 176, 0, 177, 639, 0, 593, 178, 0, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 0, 188,
-	189, 190, 191, 192, 193, 0, 194, 485, 365, 195,
-	196, 197, 198, 651, 652, 0, 617, 0, 199, 486,
-	200, 487, 201, 202, 203, 204, 205, 0, 1284, 206,
-	640, 488, 207, 489, 0, 208, 209, 396, 622, 623,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 221, 222, 223, 397, 370, 490, 371, 224, 225,
-	372, 578, 226, 227, 228, 606, 637, 229, 653, 230,
-	231, 232, 0, 233, 0, 0, 234, 235, 0, 0,
-	236, 375, 491, 237, 492, 632, 238, 239, 240, 241,
-	242, 243, 244, 0, 245, 246, 633, 247, 378, 250,
-	248, 249, 0, 251, 252, 253, 254, 255, 256, 257,
-	258, 654, 259, 260, 261, 262, 0, 263, 264, 265,
-	266, 267, 268, 269, 270, 271, 272, 273, 0, 274,
-	275, 493, 276, 277, 278, 594, 279, 280, 281, 282,
-	283, 284, 285, 286, 0, 287, 288, 289, 290, 398,
-	626, 291, 292, 381, 293, 294, 494, 295, 296, 655,
-	297, 0, 298, 299, 300, 301, 302, 303, 304, 305,
-	306, 307, 308, 634, 0, 309, 310, 0, 311, 495,
-	312, 313, 314, 315, 316, 0, 656, 657, 0, 1285,
-	399, 317, 635, 318, 636, 604, 319, 320, 321, 322,
-	323, 324, 325, 0, 581, 326, 327, 328, 329, 330,
-	627, 0, 331, 332, 333, 334, 335, 387, 658, 0,
-	336, 496, 337, 338, 339, 340, 0, 0, 341, 0,
-	0, 342, 343, 344, 345, 346, 347, 348, 349, 579,
-	0, 0, 0, 0, 0, 0, 575, 576, 610, 597,
-	598, 599, 600, 596, 584, 0, 577, 0, 0, 585,
-	0, 87, 88, 89, 90, 91, 92, 93, 94, 0,
-	95, 96, 97, 0, 0, 0, 0, 590, 0, 0,
-	98, 99, 0, 100, 101, 477, 102, 103, 104, 350,
-	642, 478, 643, 0, 644, 0, 105, 106, 107, 108,
-	109, 607, 630, 395, 110, 645, 646, 111, 0, 112,
-	113, 114, 115, 638, 0, 618, 0, 116, 117, 118,
-	119, 120, 0, 480, 121, 122, 123, 0, 124, 125,
-	126, 127, 128, 129, 0, 481, 130, 131, 132, 628,
-	619, 624, 629, 620, 621, 625, 133, 134, 135, 136,
-	137, 647, 138, 139, 648, 649, 140, 0, 141, 0,
-	142, 143, 144, 145, 146, 0, 147, 148, 149, 0,
-	0, 150, 151, 641, 153, 154, 0, 155, 156, 157,
-	0, 158, 159, 160, 0, 161, 162, 163, 164, 589,
-	165, 166, 167, 631, 605, 168, 0, 169, 170, 650,
-	171, 0, 172, 0, 173, 483, 0, 484, 174, 175,
-	176, 0, 177, 639, 0, 593, 178, 0, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 0, 188, 189,
-	190, 191, 192, 193, 0, 194, 485, 365, 195, 196,
-	197, 198, 651, 652, 0, 617, 0, 199, 486, 200,
-	487, 201, 202, 203, 204, 205, 0, 0, 206, 640,
-	488, 207, 489, 0, 208, 209, 396, 622, 623, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 222, 223, 397, 370, 490, 371, 224, 225, 372,
-	578, 226, 227, 228, 606, 637, 229, 653, 230, 231,
-	232, 0, 233, 0, 0, 234, 235, 0, 0, 236,
-	375, 491, 237, 492, 632, 238, 239, 240, 241, 242,
-	243, 244, 0, 245, 246, 633, 247, 378, 250, 248,
-	249, 0, 251, 252, 253, 254, 255, 256, 257, 258,
-	654, 259, 260, 261, 262, 0, 263, 264, 265, 266,
-	267, 268, 269, 270, 271, 272, 273, 0, 274, 275,
-	493, 276, 277, 278, 594, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 289, 290, 398, 626,
-	291, 292, 381, 293, 294, 494, 295, 296, 655, 297,
-	0, 298, 299, 300, 301, 302, 303, 304, 305, 306,
-	307, 308, 634, 0, 309, 310, 0, 311, 495, 312,
-	313, 314, 315, 316, 0, 656, 657, 0, 0, 399,
-	317, 635, 318, 636, 604, 319, 320, 321, 322, 323,
-	324, 325, 0, 581, 326, 327, 328, 329, 330, 627,
-	0, 331, 332, 333, 334, 335, 387, 658, 0, 336,
-	496, 337, 338, 339, 340, 0, 0, 341, 0, 0,
-	342, 343, 344, 345, 346, 347, 348, 349, 579, 0,
-	0, 0, 0, 0, 0, 575, 576, 610, 597, 598,
-	599, 600, 596, 584, 0, 577, 0, 0, 585, 1737,
-	87, 88, 89, 90, 91, 92, 93, 94, 0, 95,
-	96, 97, 0, 0, 0, 0, 590, 0, 0, 98,
-	99, 0, 100, 101, 477, 102, 103, 104, 350, 642,
-	478, 643, 0, 644, 0, 105, 106, 107, 108, 109,
-	607, 630, 395, 110, 645, 646, 111, 0, 112, 113,
-	114, 115, 638, 0, 618, 0, 116, 117, 118, 119,
-	120, 0, 480, 121, 122, 123, 0, 124, 125, 126,
-	127, 128, 129, 0, 481, 130, 131, 132, 628, 619,
-	624, 629, 620, 621, 625, 133, 134, 135, 136, 137,
-	647, 138, 139, 648, 649, 140, 0, 141, 0, 142,
-	143, 144, 145, 146, 0, 147, 148, 149, 0, 0,
-	150, 151, 641, 153, 154, 0, 155, 156, 157, 0,
-	158, 159, 160, 0, 161, 162, 163, 164, 589, 165,
-	166, 167, 631, 605, 168, 0, 169, 170, 650, 171,
-	0, 172, 0, 173, 483, 0, 484, 174, 175, 176,
-	0, 177, 639, 0, 593, 178, 0, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 0, 188, 189, 190,
-	191, 192, 193, 0, 194, 485, 365, 195, 196, 197,
-	198, 651, 652, 0, 617, 0, 199, 486, 200, 487,
-	201, 202, 203, 204, 205, 0, 0, 206, 640, 488,
-	207, 489, 0, 208, 209, 396, 622, 623, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	222, 223, 397, 370, 490, 371, 224, 225, 372, 578,
-	226, 227, 228, 606, 637, 229, 653, 230, 231, 232,
-	0, 233, 0, 0, 234, 235, 0, 0, 236, 375,
-	491, 237, 492, 632, 238, 239, 240, 241, 242, 243,
-	244, 0, 245, 246, 633, 247, 378, 250, 248, 249,
-	0, 251, 252, 253, 254, 255, 256, 257, 258, 654,
-	259, 260, 261, 262, 0, 263, 264, 265, 266, 267,
-	268, 269, 270, 271, 272, 273, 0, 274, 275, 493,
-	276, 277, 278, 594, 279, 280, 281, 282, 283, 284,
-	285, 286, 0, 287, 288, 289, 290, 398, 626, 291,
-	292, 381, 293, 294, 494, 295, 296, 655, 297, 0,
-	298, 299, 300, 301, 302, 303, 304, 305, 306, 307,
-	308, 634, 0, 309, 310, 0, 311, 495, 312, 313,
-	314, 315, 316, 0, 656, 657, 0, 0, 399, 317,
-	635, 318, 636, 604, 319, 320, 321, 322, 323, 324,
-	325, 0, 581, 326, 327, 328, 329, 330, 627, 0,
-	331, 332, 333, 334, 335, 387, 658, 0, 336, 496,
-	337, 338, 339, 340, 0, 0, 341, 0, 0, 342,
-	343, 344, 345, 346, 347, 348, 349, 579, 0, 0,
-	0, 0, 0, 0, 575, 576, 610, 597, 598, 599,
-	600, 596, 584, 0, 577, 0, 0, 585, 1681, 87,
-	88, 89, 90, 91, 92, 93, 94, 0, 95, 96,
-	97, 0, 0, 0, 0, 590, 0, 0, 98, 99,
-	0, 100, 101, 477, 102, 103, 104, 350, 642, 478,
-	643, 0, 644, 0, 105, 106, 107, 108, 109, 607,
-	630, 395, 110, 645, 646, 111, 0, 112, 113, 114,
-	115, 638, 0, 618, 0, 116, 117, 118, 119, 120,
-	0, 480, 121, 122, 123, 0, 124, 125, 126, 127,
-	128, 129, 0, 481, 130, 131, 132, 628, 619, 624,
-	629, 620, 621, 625, 133, 134, 135, 136, 137, 647,
-	138, 139, 648, 649, 140, 0, 141, 0, 142, 143,
-	144, 145, 146, 0, 147, 148, 149, 0, 0, 150,
-	151, 641, 153, 154, 0, 155, 156, 157, 0, 158,
-	159, 160, 0, 161, 162, 163, 164, 589, 165, 166,
-	167, 631, 605, 168, 0, 169, 170, 650, 171, 0,
-	172, 0, 173, 483, 0, 484, 174, 175, 176, 0,
-	177, 639, 0, 593, 178, 0, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 0, 188, 189, 190, 191,
-	192, 193, 0, 194, 485, 365, 195, 196, 197, 198,
-	651, 652, 0, 617, 0, 199, 486, 200, 487, 201,
-	202, 203, 204, 205, 0, 0, 206, 640, 488, 207,
-	489, 0, 208, 209, 396, 622, 623, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
-	223, 397, 370, 490, 371, 224, 225, 372, 578, 226,
-	227, 228, 606, 637, 229, 653, 230, 231, 232, 0,
-	233, 0, 0, 234, 235, 0, 0, 236, 375, 491,
-	237, 492, 632, 238, 239, 240, 241, 242, 243, 244,
-	0, 245, 246, 633, 247, 378, 250, 248, 249, 0,
-	251, 252, 253, 254, 255, 256, 257, 258, 654, 259,
-	260, 261, 262, 0, 263, 264, 265, 266, 267, 268,
-	269, 270, 271, 272, 273, 0, 274, 275, 493, 276,
-	277, 278, 594, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 289, 290, 398, 626, 291, 292,
-	381, 293, 294, 494, 295, 296, 655, 297, 0, 298,
-	299, 300, 301, 302, 303, 304, 305, 306, 307, 308,
-	634, 0, 309, 310, 0, 311, 495, 312, 313, 314,
-	315, 316, 0, 656, 657, 0, 0, 399, 317, 635,
-	318, 636, 604, 319, 320, 321, 322, 323, 324, 325,
-	0, 581, 326, 327, 328, 329, 330, 627, 0, 331,
-	332, 333, 334, 335, 387, 658, 0, 336, 496, 337,
-	338, 339, 340, 0, 0, 341, 0, 0, 342, 343,
-	344, 345, 346, 347, 348, 349, 579, 0, 0, 0,
-	0, 0, 0, 575, 576, 610, 597, 598, 599, 600,
-	596, 584, 0, 577, 0, 0, 585, 1231, 87, 88,
-	89, 90, 91, 92, 93, 94, 0, 95, 96, 97,
-	0, 0, 0, 0, 590, 0, 0, 98, 99, 0,
-	100, 101, 477, 102, 103, 104, 350, 642, 478, 643,
-	0, 644, 0, 105, 106, 107, 108, 109, 607, 630,
-	395, 110, 645, 646, 111, 0, 112, 113, 114, 115,
-	638, 0, 618, 0, 116, 117, 118, 119, 120, 0,
-	480, 121, 122, 123, 0, 124, 125, 126, 127, 128,
-	129, 0, 481, 130, 131, 132, 628, 619, 624, 629,
-	620, 621, 625, 133, 134, 135, 136, 137, 647, 138,
-	139, 648, 649, 140, 0, 141, 0, 142, 143, 144,
-	145, 146, 0, 147, 148, 149, 0, 0, 150, 151,
-	641, 153, 154, 0, 155, 156, 157, 0, 158, 159,
-	160, 0, 161, 162, 163, 164, 589, 165, 166, 167,
-	631, 605, 168, 0, 169, 170, 650, 171, 0, 172,
-	0, 173, 483, 0, 484, 174, 175, 176, 0, 177,
-	639, 0, 593, 178, 0, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 0, 188, 189, 190, 191, 192,
-	193, 0, 194, 485, 365, 195, 196, 197, 198, 651,
-	652, 0, 617, 0, 199, 486, 200, 487, 201, 202,
-	203, 204, 205, 0, 0, 206, 640, 488, 207, 489,
-	0, 208, 209, 396, 622, 623, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
-	397, 370, 490, 371, 224, 225, 372, 578, 226, 227,
-	228, 606, 637, 229, 653, 230, 231, 232, 0, 233,
-	0, 0, 234, 235, 0, 0, 236, 375, 491, 237,
-	492, 632, 238, 239, 240, 241, 242, 243, 244, 0,
-	245, 246, 633, 247, 378, 250, 248, 249, 0, 251,
-	252, 253, 254, 255, 256, 257, 258, 654, 259, 260,
-	261, 262, 0, 263, 264, 265, 266, 267, 268, 269,
-	270, 271, 272, 273, 0, 274, 275, 493, 276, 277,
-	278, 594, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 289, 290, 398, 626, 291, 292, 381,
-	293, 294, 494, 295, 296, 655, 297, 0, 298, 299,
-	300, 301, 302, 303, 304, 305, 306, 307, 308, 634,
-	0, 309, 310, 0, 311, 495, 312, 313, 314, 315,
-	316, 0, 656, 657, 0, 0, 399, 317, 635, 318,
-	636, 604, 319, 320, 321, 322, 323, 324, 325, 0,
-	581, 326, 327, 328, 329, 330, 627, 0, 331, 332,
-	333, 334, 335, 387, 658, 0, 336, 496, 337, 338,
-	339, 340, 0, 0, 341, 0, 0, 342, 343, 344,
-	345, 346, 347, 348, 349, 579, 0, 0, 0, 0,
-	0, 0, 575, 576, 610, 597, 598, 599, 600, 596,
-	584, 0, 577, 869, 1226, 585, 0, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 590, 0, 0, 98, 99, 0, 100,
-	101, 477, 102, 103, 104, 350, 642, 478, 643, 0,
-	644, 0, 105, 106, 107, 108, 109, 607, 630, 395,
-	110, 645, 646, 111, 0, 112, 113, 114, 115, 638,
-	0, 618, 0, 116, 117, 118, 119, 120, 0, 480,
-	121, 122, 123, 0, 124, 125, 126, 127, 128, 129,
-	0, 481, 130, 131, 132, 628, 619, 624, 629, 620,
-	621, 625, 133, 134, 135, 136, 137, 647, 138, 139,
-	648, 649, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 641,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 161, 162, 163, 164, 589, 165, 166, 167, 631,
-	605, 168, 0, 169, 170, 650, 171, 0, 172, 0,
-	173, 483, 0, 484, 174, 175, 176, 0, 177, 639,
-	0, 593, 178, 0, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 0, 188, 189, 190, 191, 192, 193,
-	0, 194, 485, 365, 195, 196, 197, 198, 651, 652,
-	0, 617, 0, 199, 486, 200, 487, 201, 202, 203,
-	204, 205, 0, 0, 206, 640, 488, 207, 489, 0,
-	208, 209, 396, 622, 623, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 490, 371, 224, 225, 372, 578, 226, 227, 228,
-	606, 637, 229, 653, 230, 231, 232, 0, 233, 0,
-	0, 234, 235, 0, 0, 236, 375, 491, 237, 492,
-	632, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 633, 247, 378, 250, 248, 249, 0, 251, 252,
-	253, 254, 255, 256, 257, 258, 654, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 493, 276, 277, 278,
-	594, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 289, 290, 398, 626, 291, 292, 381, 293,
-	294, 494, 295, 296, 655, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 634, 0,
-	309, 310, 0, 311, 495, 312, 313, 314, 315, 316,
-	0, 656, 657, 0, 0, 399, 317, 635, 318, 636,
-	604, 319, 320, 321, 322, 323, 324, 325, 0, 581,
-	326, 327, 328, 329, 330, 627, 0, 331, 332, 333,
-	334, 335, 387, 658, 1687, 336, 496, 337, 338, 339,
-	340, 0, 0, 341, 0, 0, 342, 343, 344, 345,
-	346, 347, 348, 349, 579, 0, 0, 0, 0, 0,
-	0, 575, 576, 610, 597, 598, 599, 600, 596, 584,
-	0, 577, 0, 0, 585, 0, 87, 88, 89, 90,
-	91, 92, 93, 94, 0, 95, 96, 97, 0, 0,
-	0, 0, 590, 0, 0, 98, 99, 0, 100, 101,
-	477, 102, 103, 104, 350, 642, 478, 643, 0, 644,
-	0, 105, 106, 107, 108, 109, 607, 630, 395, 110,
-	645, 646, 111, 0, 112, 113, 114, 115, 638, 0,
-	618, 0, 116, 117, 118, 119, 120, 0, 480, 121,
-	122, 123, 0, 124, 125, 126, 127, 128, 129, 0,
-	481, 130, 131, 132, 628, 619, 624, 629, 620, 621,
-	625, 133, 134, 135, 136, 137, 647, 138, 139, 648,
-	649, 140, 678, 141, 0, 142, 143, 144, 145, 146,
-	0, 147, 148, 149, 0, 0, 150, 151, 641, 153,
-	154, 0, 155, 156, 157, 0, 158, 159, 160, 0,
-	161, 162, 163, 164, 589, 165, 166, 167, 631, 605,
-	168, 0, 169, 170, 650, 171, 0, 172, 0, 173,
-	483, 0, 484, 174, 175, 176, 0, 177, 639, 0,
-	593, 178, 0, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 0, 188, 189, 190, 191, 192, 193, 0,
-	194, 485, 365, 195, 196, 197, 198, 651, 652, 0,
-	617, 0, 199, 486, 200, 487, 201, 202, 203, 204,
-	205, 0, 0, 206, 640, 488, 207, 489, 0, 208,
-	209, 396, 622, 623, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 222, 223, 397, 370,
-	490, 371, 224, 225, 372, 578, 226, 227, 228, 606,
-	637, 229, 653, 230, 231, 232, 0, 233, 0, 0,
-	234, 235, 0, 0, 236, 375, 491, 237, 492, 632,
-	238, 239, 240, 241, 242, 243, 244, 0, 245, 246,
-	633, 247, 378, 250, 248, 249, 0, 251, 252, 253,
-	254, 255, 256, 257, 258, 654, 259, 260, 261, 262,
-	0, 263, 264, 265, 266, 267, 268, 269, 270, 271,
-	272, 273, 0, 274, 275, 493, 276, 277, 278, 594,
-	279, 280, 281, 282, 283, 284, 285, 286, 0, 287,
-	288, 289, 290, 398, 626, 291, 292, 381, 293, 294,
-	494, 295, 296, 655, 297, 0, 298, 299, 300, 301,
-	302, 303, 304, 305, 306, 307, 308, 634, 0, 309,
-	310, 0, 311, 495, 312, 313, 314, 315, 316, 0,
-	656, 657, 0, 0, 399, 317, 635, 318, 636, 604,
-	319, 320, 321, 322, 323, 324, 325, 0, 581, 326,
-	327, 328, 329, 330, 627, 0, 331, 332, 333, 334,
-	335, 387, 658, 0, 336, 496, 337, 338, 339, 340,
-	0, 0, 341, 0, 0, 342, 343, 344, 345, 346,
-	347, 348, 349, 579, 0, 0, 0, 0, 0, 0,
-	575, 576, 610, 597, 598, 599, 600, 596, 584, 0,
-	577, 0, 0, 585, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 590, 0, 0, 98, 99, 0, 100, 101, 477,
-	102, 103, 104, 350, 642, 478, 643, 0, 644, 0,
-	105, 106, 107, 108, 109, 607, 630, 395, 110, 645,
-	646, 111, 0, 112, 113, 114, 115, 638, 0, 618,
-	0, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 481,
-	130, 131, 132, 628, 619, 624, 629, 620, 621, 625,
-	133, 134, 135, 136, 137, 647, 138, 139, 648, 649,
-	140, 0, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 641, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 589, 165, 166, 167, 631, 605, 168,
-	0, 169, 170, 650, 171, 0, 172, 0, 173, 483,
-	0, 484, 174, 175, 176, 0, 177, 639, 0, 593,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	485, 365, 195, 196, 197, 198, 651, 652, 0, 617,
-	0, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	0, 0, 206, 640, 488, 207, 489, 0, 208, 209,
-	396, 622, 623, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 578, 226, 227, 228, 606, 637,
-	229, 653, 230, 231, 232, 0, 233, 0, 0, 234,
-	235, 0, 0, 236, 375, 491, 237, 492, 632, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 633,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 654, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 493, 276, 277, 278, 594, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 626, 291, 292, 381, 293, 294, 494,
-	295, 296, 655, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 634, 0, 309, 310,
-	0, 311, 495, 312, 313, 314, 315, 316, 0, 656,
-	657, 0, 0, 399, 317, 635, 318, 636, 604, 319,
-	320, 321, 322, 323, 324, 325, 0, 581, 326, 327,
-	328, 329, 330, 627, 0, 331, 332, 333, 334, 335,
-	387, 658, 0, 336, 496, 337, 338, 339, 340, 0,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 579, 0, 0, 0, 0, 0, 0, 575,
-	576, 573, 610, 597, 598, 599, 600, 596, 584, 577,
-	0, 0, 585, 0, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 590, 0, 0, 98, 99, 0, 100, 101, 477,
-	102, 103, 104, 350, 642, 478, 643, 0, 644, 0,
-	105, 106, 107, 108, 109, 607, 630, 395, 110, 645,
-	646, 111, 0, 112, 113, 114, 115, 638, 0, 618,
-	0, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 481,
-	130, 131, 132, 628, 619, 624, 629, 620, 621, 625,
-	133, 134, 135, 136, 137, 647, 138, 139, 648, 649,
-	140, 0, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 641, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 589, 165, 166, 167, 631, 605, 168,
-	0, 169, 170, 650, 171, 0, 172, 0, 173, 483,
-	1288, 484, 174, 175, 176, 0, 177, 639, 0, 593,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	485, 365, 195, 196, 197, 198, 651, 652, 0, 617,
-	0, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	0, 0, 206, 640, 488, 207, 489, 0, 208, 209,
-	396, 622, 623, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 578, 226, 227, 228, 606, 637,
-	229, 653, 230, 231, 232, 0, 233, 0, 0, 234,
-	235, 0, 0, 236, 375, 491, 237, 492, 632, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 633,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 654, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 493, 276, 277, 278, 594, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 626, 291, 292, 381, 293, 294, 494,
-	295, 296, 655, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 634, 0, 309, 310,
-	0, 311, 495, 312, 313, 314, 315, 316, 0, 656,
-	657, 0, 0, 399, 317, 635, 318, 636, 604, 319,
-	320, 321, 322, 323, 324, 325, 0, 581, 326, 327,
-	328, 329, 330, 627, 0, 331, 332, 333, 334, 335,
-	387, 658, 0, 336, 496, 337, 338, 339, 340, 0,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 579, 0, 0, 0, 0, 0, 0, 575,
-	576, 610, 597, 598, 599, 600, 596, 584, 0, 577,
-	0, 0, 585, 0, 87, 88, 89, 90, 91, 92,
-	93, 94, 802, 95, 96, 97, 0, 0, 0, 0,
-	590, 0, 0, 98, 99, 0, 100, 101, 477, 102,
-	103, 104, 350, 642, 478, 643, 0, 644, 0, 105,
-	106, 107, 108, 109, 607, 630, 395, 110, 645, 646,
-	111, 0, 112, 113, 114, 115, 638, 0, 618, 0,
-	116, 117, 118, 119, 120, 0, 480, 121, 122, 123,
-	0, 124, 125, 126, 127, 128, 129, 0, 481, 130,
-	131, 132, 628, 619, 624, 629, 620, 621, 625, 133,
-	134, 135, 136, 137, 647, 138, 139, 648, 649, 140,
-	0, 141, 0, 142, 143, 144, 145, 146, 0, 147,
-	148, 149, 0, 0, 150, 151, 641, 153, 154, 0,
-	155, 156, 157, 0, 158, 159, 160, 0, 161, 162,
-	163, 164, 589, 165, 166, 167, 631, 605, 168, 0,
-	169, 170, 650, 171, 0, 172, 0, 173, 483, 0,
-	484, 174, 175, 176, 0, 177, 639, 0, 593, 178,
-	0, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	0, 188, 189, 190, 191, 192, 193, 0, 194, 485,
-	365, 195, 196, 197, 198, 651, 652, 0, 617, 0,
-	199, 486, 200, 487, 201, 202, 203, 204, 205, 0,
-	0, 206, 640, 488, 207, 489, 0, 208, 209, 396,
-	622, 623, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 221, 222, 223, 397, 370, 490, 371,
-	224, 225, 372, 578, 226, 227, 228, 606, 637, 229,
-	653, 230, 231, 232, 0, 233, 0, 0, 234, 235,
-	0, 0, 236, 375, 491, 237, 492, 632, 238, 239,
-	240, 241, 242, 243, 244, 0, 245, 246, 633, 247,
-	378, 250, 248, 249, 0, 251, 252, 253, 254, 255,
-	256, 257, 258, 654, 259, 260, 261, 262, 0, 263,
-	264, 265, 266, 267, 268, 269, 270, 271, 272, 273,
-	0, 274, 275, 493, 276, 277, 278, 594, 279, 280,
-	281, 282, 283, 284, 285, 286, 0, 287, 288, 289,
-	290, 398, 626, 291, 292, 381, 293, 294, 494, 295,
-	296, 655, 297, 0, 298, 299, 300, 301, 302, 303,
-	304, 305, 306, 307, 308, 634, 0, 309, 310, 0,
-	311, 495, 312, 313, 314, 315, 316, 0, 656, 657,
-	0, 0, 399, 317, 635, 318, 636, 604, 319, 320,
-	321, 322, 323, 324, 325, 0, 581, 326, 327, 328,
-	329, 330, 627, 0, 331, 332, 333, 334, 335, 387,
-	658, 0, 336, 496, 337, 338, 339, 340, 0, 0,
-	341, 0, 0, 342, 343, 344, 345, 346, 347, 348,
-	349, 579, 0, 0, 0, 0, 0, 0, 575, 576,
-	610, 597, 598, 599, 600, 596, 584, 0, 577, 0,
-	0, 585, 0, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 590,
-	0, 0, 98, 99, 0, 100, 101, 477, 102, 103,
-	104, 350, 642, 478, 643, 0, 644, 0, 105, 106,
-	107, 108, 109, 607, 630, 395, 110, 645, 646, 111,
-	0, 112, 113, 114, 115, 638, 0, 618, 0, 116,
-	117, 118, 119, 120, 0, 480, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 481, 130, 131,
-	2128, 628, 619, 624, 629, 620, 621, 625, 133, 134,
-	135, 136, 137, 647, 138, 139, 648, 649, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 641, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 589, 165, 166, 167, 631, 605, 168, 0, 169,
-	170, 650, 171, 0, 172, 0, 173, 483, 0, 484,
-	174, 175, 176, 0, 177, 639, 0, 593, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 485, 365,
-	195, 196, 197, 198, 651, 652, 0, 617, 0, 199,
-	486, 200, 487, 201, 202, 203, 204, 205, 0, 0,
-	206, 640, 488, 207, 489, 0, 208, 209, 396, 622,
-	623, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 397, 370, 490, 371, 224,
-	225, 372, 578, 226, 227, 228, 606, 637, 229, 653,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 491, 237, 492, 632, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 633, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 654, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 493, 276, 277, 278, 594, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 289, 290,
-	398, 626, 291, 292, 381, 293, 294, 494, 295, 296,
-	655, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 634, 0, 309, 310, 0, 311,
-	495, 312, 313, 314, 315, 316, 0, 656, 657, 0,
-	0, 399, 317, 635, 318, 636, 604, 319, 320, 321,
-	322, 2127, 324, 325, 0, 581, 326, 327, 328, 329,
-	330, 627, 0, 331, 332, 333, 334, 335, 387, 658,
-	0, 336, 496, 337, 338, 339, 340, 0, 0, 341,
-	0, 0, 342, 343, 344, 345, 346, 347, 348, 349,
-	579, 0, 0, 0, 0, 0, 0, 575, 576, 610,
-	597, 598, 599, 600, 596, 584, 0, 577, 0, 0,
-	585, 0, 87, 88, 89, 90, 91, 92, 93, 94,
-	0, 95, 96, 97, 0, 0, 0, 0, 590, 0,
-	0, 98, 99, 0, 100, 101, 477, 102, 103, 104,
-	2126, 642, 478, 643, 0, 644, 0, 105, 106, 107,
-	108, 109, 607, 630, 395, 110, 645, 646, 111, 0,
-	112, 113, 114, 115, 638, 0, 618, 0, 116, 117,
-	118, 119, 120, 0, 480, 121, 122, 123, 0, 124,
-	125, 126, 127, 128, 129, 0, 481, 130, 131, 2128,
-	628, 619, 624, 629, 620, 621, 625, 133, 134, 135,
-	136, 137, 647, 138, 139, 648, 649, 140, 0, 141,
-	0, 142, 143, 144, 145, 146, 0, 147, 148, 149,
-	0, 0, 150, 151, 641, 153, 154, 0, 155, 156,
-	157, 0, 158, 159, 160, 0, 161, 162, 163, 164,
-	589, 165, 166, 167, 631, 605, 168, 0, 169, 170,
-	650, 171, 0, 172, 0, 173, 483, 0, 484, 174,
-	175, 176, 0, 177, 639, 0, 593, 178, 0, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 0, 188,
-	189, 190, 191, 192, 193, 0, 194, 485, 365, 195,
-	196, 197, 198, 651, 652, 0, 617, 0, 199, 486,
-	200, 487, 201, 202, 203, 204, 205, 0, 0, 206,
-	640, 488, 207, 489, 0, 208, 209, 396, 622, 623,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 221, 222, 223, 397, 370, 490, 371, 224, 225,
-	372, 578, 226, 227, 228, 606, 637, 229, 653, 230,
-	231, 232, 0, 233, 0, 0, 234, 235, 0, 0,
-	236, 375, 491, 237, 492, 632, 238, 239, 240, 241,
-	242, 243, 244, 0, 245, 246, 633, 247, 378, 250,
-	248, 249, 0, 251, 252, 253, 254, 255, 256, 257,
-	258, 654, 259, 260, 261, 262, 0, 263, 264, 265,
-	266, 267, 268, 269, 270, 271, 272, 273, 0, 274,
-	275, 493, 276, 277, 278, 594, 279, 280, 281, 282,
-	283, 284, 285, 286, 0, 287, 288, 289, 290, 398,
-	626, 291, 292, 381, 293, 294, 494, 295, 296, 655,
-	297, 0, 298, 299, 300, 301, 302, 303, 304, 305,
-	306, 307, 308, 634, 0, 309, 310, 0, 311, 495,
-	312, 313, 314, 315, 316, 0, 656, 657, 0, 0,
-	399, 317, 635, 318, 636, 604, 319, 320, 321, 322,
-	2127, 324, 325, 0, 581, 326, 327, 328, 329, 330,
-	627, 0, 331, 332, 333, 334, 335, 387, 658, 0,
-	336, 496, 337, 338, 339, 340, 0, 0, 341, 0,
-	0, 342, 343, 344, 345, 346, 347, 348, 349, 579,
-	0, 0, 0, 0, 0, 0, 575, 576, 610, 597,
-	598, 599, 600, 596, 584, 0, 577, 0, 0, 585,
-	0, 87, 88, 89, 90, 91, 92, 93, 94, 0,
-	95, 96, 97, 0, 0, 0, 0, 590, 0, 0,
-	98, 99, 0, 100, 101, 477, 102, 103, 104, 350,
-	642, 478, 643, 0, 644, 0, 105, 106, 107, 108,
-	109, 607, 630, 395, 110, 645, 646, 111, 0, 112,
-	113, 114, 115, 638, 0, 618, 0, 116, 117, 118,
-	119, 120, 0, 480, 121, 122, 123, 0, 124, 125,
-	126, 127, 128, 129, 0, 481, 130, 131, 132, 628,
-	619, 624, 629, 620, 621, 625, 133, 134, 135, 136,
-	137, 647, 138, 139, 648, 649, 140, 0, 141, 0,
-	142, 143, 144, 145, 146, 0, 147, 148, 149, 0,
-	0, 150, 151, 641, 153, 154, 0, 155, 156, 157,
-	0, 158, 159, 160, 0, 161, 162, 163, 164, 589,
-	165, 166, 167, 631, 605, 168, 0, 169, 170, 650,
-	171, 0, 172, 0, 173, 483, 0, 484, 174, 175,
-	176, 0, 177, 639, 0, 593, 178, 0, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 0, 188, 189,
-	190, 191, 192, 193, 0, 194, 485, 365, 195, 196,
-	197, 198, 651, 652, 0, 617, 0, 199, 486, 200,
-	487, 201, 202, 203, 204, 205, 0, 0, 206, 640,
-	488, 207, 489, 0, 208, 209, 396, 622, 623, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 222, 223, 397, 370, 490, 371, 224, 225, 372,
-	578, 226, 227, 228, 606, 637, 229, 653, 230, 231,
-	232, 0, 233, 0, 0, 234, 235, 0, 0, 236,
-	375, 491, 237, 492, 632, 238, 239, 240, 241, 242,
-	243, 244, 0, 245, 246, 633, 247, 378, 250, 248,
-	249, 0, 251, 252, 253, 254, 255, 256, 257, 258,
-	654, 259, 260, 261, 262, 0, 263, 264, 265, 266,
-	267, 268, 269, 270, 271, 272, 273, 0, 274, 275,
-	493, 276, 277, 278, 594, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 289, 290, 398, 626,
-	291, 292, 381, 293, 294, 494, 295, 296, 655, 297,
-	0, 298, 299, 300, 301, 302, 303, 304, 305, 306,
-	307, 308, 634, 0, 309, 310, 0, 311, 495, 312,
-	313, 314, 315, 316, 0, 656, 657, 0, 0, 399,
-	317, 635, 318, 636, 604, 319, 320, 321, 322, 323,
-	324, 325, 0, 581, 326, 327, 328, 329, 330, 627,
-	0, 331, 332, 333, 334, 335, 387, 658, 0, 336,
-	496, 337, 338, 339, 340, 0, 0, 341, 0, 0,
-	342, 343, 344, 345, 346, 347, 348, 349, 579, 0,
-	0, 0, 0, 0, 0, 575, 576, 610, 597, 598,
-	599, 600, 596, 584, 0, 577, 0, 0, 585, 0,
-	87, 88, 89, 90, 91, 92, 93, 94, 0, 95,
-	96, 97, 0, 0, 0, 0, 590, 0, 0, 98,
-	99, 0, 100, 101, 477, 102, 103, 104, 350, 642,
-	478, 643, 0, 644, 0, 105, 106, 107, 108, 109,
-	607, 630, 395, 110, 645, 646, 111, 0, 112, 113,
-	114, 115, 638, 0, 618, 0, 116, 117, 118, 119,
-	120, 0, 480, 121, 122, 123, 0, 124, 125, 126,
-	127, 128, 129, 0, 481, 130, 131, 132, 628, 619,
-	624, 629, 620, 621, 625, 133, 134, 135, 136, 137,
-	647, 138, 139, 648, 649, 140, 0, 141, 0, 142,
-	143, 144, 145, 146, 0, 147, 148, 149, 0, 0,
-	150, 151, 641, 153, 154, 0, 155, 156, 157, 0,
-	158, 159, 160, 0, 161, 162, 163, 164, 589, 165,
-	166, 167, 631, 605, 168, 0, 169, 170, 650, 171,
-	0, 172, 0, 173, 483, 0, 484, 174, 175, 176,
-	0, 177, 639, 0, 593, 178, 0, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 0, 188, 189, 190,
-	191, 192, 193, 0, 194, 485, 365, 195, 196, 197,
-	198, 651, 652, 0, 617, 0, 199, 486, 200, 487,
-	201, 202, 203, 204, 205, 0, 0, 206, 640, 488,
-	207, 489, 0, 208, 209, 396, 622, 623, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	222, 223, 397, 370, 490, 371, 224, 225, 372, 578,
-	226, 227, 228, 606, 637, 229, 653, 230, 231, 232,
-	0, 233, 0, 0, 234, 235, 0, 0, 236, 375,
-	491, 237, 492, 632, 238, 239, 240, 241, 242, 243,
-	244, 0, 245, 246, 633, 247, 378, 250, 248, 249,
-	0, 251, 252, 253, 254, 255, 256, 257, 258, 654,
-	259, 260, 261, 262, 0, 263, 264, 265, 266, 267,
-	268, 269, 270, 271, 272, 273, 0, 274, 275, 493,
-	276, 277, 278, 594, 279, 280, 281, 282, 283, 284,
-	285, 286, 0, 287, 288, 289, 290, 398, 626, 291,
-	292, 381, 293, 294, 494, 295, 296, 655, 297, 0,
-	298, 299, 300, 301, 302, 303, 304, 305, 306, 307,
-	308, 634, 0, 309, 310, 0, 311, 495, 312, 313,
-	314, 315, 316, 0, 656, 657, 0, 0, 399, 317,
-	635, 318, 636, 604, 319, 320, 321, 322, 323, 324,
-	325, 0, 581, 326, 327, 328, 329, 330, 627, 0,
-	331, 332, 333, 334, 335, 387, 658, 0, 336, 496,
-	337, 338, 339, 340, 0, 0, 341, 0, 0, 342,
-	343, 344, 345, 346, 347, 348, 349, 579, 0, 0,
-	0, 0, 0, 0, 575, 576, 610, 597, 598, 599,
-	600, 596, 584, 0, 577, 0, 0, 1845, 0, 87,
-	88, 89, 90, 91, 92, 93, 94, 0, 95, 96,
-	97, 0, 0, 0, 0, 590, 0, 0, 98, 99,
-	0, 100, 101, 477, 102, 103, 104, 350, 642, 478,
-	643, 0, 644, 0, 105, 106, 107, 108, 109, 607,
-	630, 395, 110, 645, 646, 111, 0, 112, 113, 114,
-	115, 638, 0, 618, 0, 116, 117, 118, 119, 120,
-	0, 480, 121, 122, 123, 0, 124, 125, 126, 127,
-	128, 129, 0, 481, 130, 131, 132, 628, 619, 624,
-	629, 620, 621, 625, 133, 134, 135, 136, 137, 647,
-	138, 139, 648, 649, 140, 0, 141, 0, 142, 143,
-	144, 145, 146, 0, 147, 148, 149, 0, 0, 150,
-	151, 641, 153, 154, 0, 155, 156, 157, 0, 158,
-	159, 160, 0, 161, 162, 163, 164, 589, 165, 166,
-	167, 631, 605, 168, 0, 169, 170, 650, 171, 0,
-	172, 0, 173, 483, 0, 484, 174, 175, 176, 0,
-	177, 639, 0, 593, 178, 0, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 0, 188, 189, 190, 191,
-	192, 193, 0, 194, 485, 365, 195, 196, 197, 198,
-	651, 652, 0, 617, 0, 199, 486, 200, 487, 201,
-	202, 203, 204, 205, 0, 0, 206, 640, 488, 207,
-	489, 0, 208, 209, 396, 622, 623, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
-	223, 397, 370, 490, 371, 224, 225, 372, 0, 226,
-	227, 228, 606, 637, 229, 653, 230, 231, 232, 0,
-	233, 0, 0, 234, 235, 0, 0, 236, 375, 491,
-	237, 492, 632, 238, 239, 240, 241, 242, 243, 244,
-	0, 245, 246, 633, 247, 378, 250, 248, 249, 0,
-	251, 252, 253, 254, 255, 256, 257, 258, 654, 259,
-	260, 261, 262, 0, 263, 264, 265, 266, 267, 268,
-	269, 270, 271, 272, 273, 0, 274, 275, 493, 276,
-	277, 278, 1278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 289, 290, 398, 626, 291, 292,
-	381, 293, 294, 494, 295, 296, 655, 297, 0, 298,
-	299, 300, 301, 302, 303, 304, 305, 306, 307, 308,
-	634, 0, 309, 310, 0, 311, 495, 312, 313, 314,
-	315, 316, 0, 656, 657, 0, 0, 399, 317, 635,
-	318, 636, 604, 319, 320, 321, 322, 323, 324, 325,
-	0, 0, 326, 327, 328, 329, 330, 627, 0, 331,
-	332, 333, 334, 335, 387, 658, 0, 336, 496, 337,
-	338, 339, 340, 0, 0, 341, 0, 0, 342, 343,
-	344, 345, 346, 347, 348, 349, 0, 0, 0, 0,
-	0, 0, 0, 1274, 1275, 610, 597, 598, 599, 600,
-	596, 584, 0, 1276, 0, 0, 1277, 0, 87, 88,
-	89, 90, 91, 92, 93, 94, 0, 95, 96, 97,
-	0, 0, 0, 0, 590, 0, 0, 98, 99, 0,
-	100, 101, 477, 102, 103, 104, 0, 642, 478, 643,
-	0, 644, 0, 105, 106, 107, 108, 109, 607, 630,
-	395, 110, 645, 646, 111, 0, 112, 113, 114, 115,
-	638, 0, 618, 0, 116, 117, 118, 119, 120, 0,
-	480, 121, 122, 123, 0, 124, 125, 126, 127, 128,
-	129, 0, 481, 130, 131, 2128, 628, 619, 624, 629,
-	620, 621, 625, 133, 134, 135, 136, 137, 647, 138,
-	139, 648, 649, 140, 0, 141, 0, 142, 143, 144,
-	145, 146, 0, 147, 148, 149, 0, 0, 150, 151,
-	641, 153, 154, 0, 155, 156, 157, 0, 158, 159,
-	160, 0, 161, 162, 163, 164, 589, 165, 166, 167,
-	631, 605, 168, 0, 169, 170, 650, 171, 0, 172,
-	0, 173, 483, 0, 484, 174, 175, 176, 0, 177,
-	639, 0, 593, 178, 0, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 0, 188, 189, 190, 191, 192,
-	193, 0, 194, 485, 365, 195, 196, 197, 198, 651,
-	652, 0, 617, 0, 199, 0, 200, 487, 201, 202,
-	203, 204, 205, 0, 0, 206, 640, 488, 207, 0,
-	0, 208, 209, 396, 622, 623, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
-	397, 370, 490, 371, 224, 225, 372, 578, 226, 227,
-	228, 606, 637, 229, 653, 230, 231, 232, 0, 233,
-	0, 0, 234, 235, 0, 0, 236, 375, 491, 237,
-	492, 632, 238, 239, 240, 241, 242, 243, 244, 0,
-	245, 246, 633, 247, 378, 250, 248, 249, 0, 251,
-	252, 253, 254, 255, 256, 257, 258, 654, 259, 260,
-	261, 262, 0, 263, 264, 265, 266, 267, 268, 269,
-	270, 271, 272, 273, 0, 274, 275, 493, 276, 277,
-	278, 594, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 289, 290, 398, 626, 291, 292, 381,
-	293, 294, 0, 295, 296, 655, 297, 0, 298, 299,
-	300, 301, 302, 303, 304, 305, 306, 307, 308, 634,
-	0, 309, 310, 0, 311, 495, 312, 313, 314, 315,
-	316, 0, 656, 657, 0, 0, 399, 317, 635, 318,
-	636, 604, 319, 320, 321, 322, 2127, 324, 325, 0,
-	581, 326, 327, 328, 329, 330, 627, 0, 331, 332,
-	333, 334, 335, 387, 658, 0, 336, 496, 337, 338,
-	339, 340, 0, 0, 341, 0, 0, 342, 343, 344,
-	345, 346, 347, 348, 349, 0, 0, 0, 0, 0,
-	0, 0, 575, 576, 610, 0, 0, 0, 0, 0,
-	0, 0, 577, 0, 0, 585, 0, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 0, 0, 0, 98, 99, 0, 100,
-	101, 477, 102, 103, 104, 350, 351, 478, 352, 0,
-	353, 0, 105, 106, 107, 108, 109, 0, 630, 395,
-	110, 354, 355, 111, 0, 112, 113, 114, 115, 638,
-	0, 618, 0, 116, 117, 118, 119, 120, 0, 480,
-	121, 122, 123, 0, 124, 125, 126, 127, 128, 129,
-	0, 481, 130, 131, 132, 628, 619, 624, 629, 620,
-	621, 625, 133, 134, 135, 136, 137, 357, 138, 139,
-	358, 359, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 152,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 161, 162, 163, 164, 360, 165, 166, 167, 631,
-	0, 168, 0, 169, 170, 362, 171, 0, 172, 0,
-	173, 483, 0, 484, 174, 175, 176, 0, 177, 639,
-	0, 364, 178, 0, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 0, 188, 189, 190, 191, 192, 193,
-	0, 194, 485, 365, 195, 196, 197, 198, 366, 367,
-	0, 368, 0, 199, 486, 200, 487, 201, 202, 203,
-	204, 205, 1131, 0, 206, 640, 488, 207, 489, 0,
-	208, 209, 396, 622, 623, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 490, 371, 224, 225, 372, 0, 226, 227, 228,
-	0, 637, 229, 374, 230, 231, 232, 0, 233, 0,
-	450, 234, 235, 0, 0, 236, 375, 491, 237, 492,
-	632, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 633, 247, 378, 250, 248, 249, 0, 251, 252,
-	253, 254, 255, 256, 257, 258, 379, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 493, 276, 277, 278,
-	380, 1136, 280, 281, 282, 283, 284, 285, 286, 49,
-	287, 288, 289, 290, 398, 626, 291, 292, 381, 293,
-	294, 494, 295, 296, 382, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 634, 0,
-	309, 310, 51, 311, 495, 312, 313, 314, 315, 316,
-	0, 411, 384, 0, 0, 399, 317, 635, 318, 636,
-	0, 319, 320, 321, 322, 323, 324, 325, 0, 0,
-	326, 327, 328, 329, 330, 627, 0, 331, 332, 333,
-	334, 335, 472, 388, 0, 336, 496, 337, 338, 339,
-	340, 0, 0, 341, 610, 47, 342, 343, 344, 345,
-	346, 347, 348, 349, 0, 0, 48, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 0, 1134, 0, 98, 99, 0, 100,
-	101, 477, 102, 103, 104, 350, 351, 478, 352, 0,
-	353, 0, 105, 106, 107, 108, 109, 0, 630, 395,
-	110, 354, 355, 111, 0, 112, 113, 114, 115, 638,
-	0, 618, 0, 116, 117, 118, 119, 120, 0, 480,
-	121, 122, 123, 0, 124, 125, 126, 127, 128, 129,
-	0, 481, 130, 131, 132, 628, 619, 624, 629, 620,
-	621, 625, 133, 134, 135, 136, 137, 357, 138, 139,
-	358, 359, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 152,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 161, 162, 163, 164, 360, 165, 166, 167, 631,
-	0, 168, 0, 169, 170, 362, 171, 0, 172, 0,
-	173, 483, 0, 484, 174, 175, 176, 0, 177, 639,
-	0, 364, 178, 0, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 0, 188, 189, 190, 191, 192, 193,
-	0, 194, 485, 365, 195, 196, 197, 198, 366, 367,
-	0, 368, 0, 199, 486, 200, 487, 201, 202, 203,
-	204, 205, 1131, 0, 206, 640, 488, 207, 489, 0,
-	208, 209, 396, 622, 623, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 490, 371, 224, 225, 372, 0, 226, 227, 228,
-	0, 637, 229, 374, 230, 231, 232, 0, 233, 0,
-	450, 234, 235, 0, 0, 236, 375, 491, 237, 492,
-	632, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 633, 247, 378, 250, 248, 249, 0, 251, 252,
-	253, 254, 255, 256, 257, 258, 379, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 493, 276, 277, 278,
-	380, 1136, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 289, 290, 398, 626, 291, 292, 381, 293,
-	294, 494, 295, 296, 382, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 634, 0,
-	309, 310, 0, 311, 495, 312, 313, 314, 315, 316,
-	0, 411, 384, 0, 0, 399, 317, 635, 318, 636,
-	0, 319, 320, 321, 322, 323, 324, 325, 0, 0,
-	326, 327, 328, 329, 330, 627, 0, 331, 332, 333,
-	334, 335, 387, 388, 0, 336, 496, 337, 338, 339,
-	340, 0, 0, 341, 610, 0, 342, 343, 344, 345,
-	346, 347, 348, 349, 0, 0, 0, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 0, 1134, 0, 98, 99, 0, 100,
-	101, 477, 102, 103, 104, 350, 351, 478, 352, 0,
-	353, 0, 105, 106, 107, 108, 109, 0, 630, 395,
-	110, 354, 355, 111, 0, 112, 113, 114, 115, 638,
-	0, 618, 0, 116, 117, 118, 119, 120, 0, 480,
-	121, 122, 123, 0, 124, 125, 126, 127, 128, 129,
-	0, 481, 130, 131, 132, 628, 619, 624, 629, 620,
-	621, 625, 133, 134, 135, 136, 137, 357, 138, 139,
-	358, 359, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 152,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 161, 162, 163, 164, 360, 165, 166, 167, 631,
-	0, 168, 0, 169, 170, 362, 171, 0, 172, 0,
-	173, 483, 0, 484, 174, 175, 176, 0, 177, 639,
-	0, 364, 178, 0, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 0, 188, 189, 190, 191, 192, 193,
-	0, 194, 485, 365, 195, 196, 197, 198, 366, 367,
-	0, 368, 0, 199, 486, 200, 487, 201, 202, 203,
-	204, 205, 0, 0, 206, 640, 488, 207, 489, 0,
-	208, 209, 396, 622, 623, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 490, 371, 224, 225, 372, 0, 226, 227, 228,
-	0, 637, 229, 374, 230, 231, 232, 0, 233, 0,
-	0, 234, 235, 0, 0, 236, 375, 491, 237, 492,
-	632, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 633, 247, 378, 250, 248, 249, 0, 251, 252,
-	253, 254, 255, 256, 257, 258, 379, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 493, 276, 277, 278,
-	380, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 289, 290, 398, 626, 291, 292, 381, 293,
-	294, 494, 295, 296, 382, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 634, 0,
-	309, 310, 0, 311, 495, 312, 313, 314, 315, 316,
-	0, 411, 384, 0, 0, 399, 317, 635, 318, 636,
-	0, 319, 320, 321, 322, 323, 324, 325, 0, 0,
-	326, 327, 328, 329, 330, 627, 0, 331, 332, 333,
-	334, 335, 387, 388, 0, 336, 496, 337, 338, 339,
-	340, 0, 0, 341, 610, 0, 342, 343, 344, 345,
-	346, 347, 348, 349, 0, 0, 0, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 0, 1782, 0, 98, 99, 0, 100,
-	101, 477, 102, 103, 104, 350, 351, 478, 352, 0,
-	353, 0, 105, 106, 107, 108, 109, 0, 630, 395,
-	110, 354, 355, 111, 0, 112, 113, 114, 115, 638,
-	0, 618, 0, 116, 117, 118, 119, 120, 0, 480,
-	121, 122, 123, 0, 124, 125, 126, 127, 128, 129,
-	0, 481, 130, 131, 132, 628, 619, 624, 629, 620,
-	621, 625, 133, 134, 135, 136, 137, 357, 138, 139,
-	358, 359, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 152,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 161, 162, 163, 164, 360, 165, 166, 167, 631,
-	0, 168, 0, 169, 170, 362, 171, 0, 172, 0,
-	173, 483, 0, 484, 174, 175, 176, 0, 177, 639,
-	0, 364, 178, 0, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 0, 188, 189, 190, 191, 192, 193,
-	0, 194, 485, 365, 195, 196, 197, 198, 366, 367,
-	0, 368, 0, 199, 486, 200, 487, 201, 202, 203,
-	204, 205, 0, 0, 206, 640, 488, 207, 489, 0,
-	208, 209, 396, 622, 623, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 490, 371, 224, 225, 372, 0, 226, 227, 228,
-	0, 637, 229, 374, 230, 231, 232, 0, 233, 0,
-	0, 234, 235, 0, 0, 236, 375, 491, 237, 492,
-	632, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 633, 247, 378, 250, 248, 249, 0, 251, 252,
-	253, 254, 255, 256, 257, 258, 379, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 493, 276, 277, 278,
-	380, 1136, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 289, 290, 398, 626, 291, 292, 381, 293,
-	294, 494, 295, 296, 382, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 634, 0,
-	309, 310, 0, 311, 495, 312, 313, 314, 315, 316,
-	0, 411, 384, 0, 0, 399, 317, 635, 318, 636,
-	0, 319, 320, 321, 322, 323, 324, 325, 0, 0,
-	326, 327, 328, 329, 330, 627, 0, 331, 332, 333,
-	334, 335, 387, 388, 0, 336, 496, 337, 338, 339,
-	340, 0, 0, 341, 473, 0, 342, 343, 344, 345,
-	346, 347, 348, 349, 0, 0, 0, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 0, 46, 0, 98, 99, 0, 100,
-	101, 477, 102, 103, 104, 350, 351, 478, 352, 0,
-	353, 0, 105, 106, 107, 108, 109, 0, 0, 395,
-	110, 354, 355, 111, 0, 112, 113, 114, 115, 356,
-	0, 479, 0, 116, 117, 118, 119, 120, 0, 480,
-	121, 122, 123, 0, 124, 125, 126, 127, 128, 129,
-	0, 481, 130, 131, 132, 0, 0, 0, 482, 0,
-	0, 0, 133, 134, 135, 136, 137, 357, 138, 139,
-	358, 359, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 152,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 161, 162, 163, 164, 360, 165, 166, 167, 361,
-	0, 168, 0, 169, 170, 362, 171, 0, 172, 0,
-	173, 483, 0, 484, 174, 175, 176, 0, 177, 363,
-	0, 364, 178, 0, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 0, 188, 189, 190, 191, 192, 193,
-	0, 194, 485, 365, 195, 196, 197, 198, 366, 367,
-	0, 368, 0, 199, 486, 200, 487, 201, 202, 203,
-	204, 205, 0, 0, 206, 369, 488, 207, 489, 0,
-	208, 209, 396, 0, 0, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 490, 371, 224, 225, 372, 0, 226, 227, 228,
-	0, 373, 229, 374, 230, 231, 232, 0, 233, 0,
-	0, 234, 235, 0, 0, 236, 375, 491, 237, 492,
-	376, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 377, 247, 378, 250, 248, 249, 0, 251, 252,
-	253, 254, 255, 256, 257, 258, 379, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 493, 276, 277, 278,
-	380, 279, 280, 281, 282, 283, 284, 285, 286, 49,
-	287, 288, 289, 290, 398, 0, 291, 292, 381, 293,
-	294, 494, 295, 296, 382, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 383, 0,
-	309, 310, 51, 311, 495, 312, 313, 314, 315, 316,
-	0, 411, 384, 0, 0, 399, 317, 385, 318, 386,
-	0, 319, 320, 321, 322, 323, 324, 325, 0, 0,
-	326, 327, 328, 329, 330, 0, 0, 331, 332, 333,
-	334, 335, 472, 388, 0, 336, 496, 337, 338, 339,
-	340, 0, 0, 341, 0, 47, 342, 343, 344, 345,
-	346, 347, 348, 349, 0, 0, 48, 0, 0, 0,
-	0, 0, 473, 703, 707, 0, 0, 708, 0, 0,
-	0, 0, 0, 0, 46, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 0, 0, 0, 98, 99, 0, 100, 101, 477,
-	102, 103, 104, 350, 351, 478, 352, 0, 353, 0,
-	105, 106, 107, 108, 109, 0, 0, 395, 110, 354,
-	355, 111, 0, 112, 113, 114, 115, 356, 0, 479,
-	0, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 481,
-	130, 131, 132, 0, 0, 0, 482, 0, 0, 0,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 756, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 152, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 700, 168,
-	0, 169, 170, 362, 171, 0, 172, 0, 173, 483,
-	0, 484, 174, 175, 176, 0, 177, 363, 0, 364,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	485, 365, 195, 196, 197, 198, 366, 367, 0, 368,
-	0, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	0, 0, 206, 369, 488, 207, 489, 0, 208, 209,
-	396, 0, 0, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 0, 226, 227, 228, 0, 373,
-	229, 374, 230, 231, 232, 0, 233, 701, 0, 234,
-	235, 0, 0, 236, 375, 491, 237, 492, 376, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 377,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 493, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 0, 291, 292, 381, 293, 294, 494,
-	295, 296, 382, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 0, 309, 310,
-	0, 311, 495, 312, 313, 314, 315, 316, 0, 411,
-	384, 0, 0, 399, 317, 385, 318, 386, 699, 319,
-	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
-	328, 329, 330, 0, 0, 331, 332, 333, 334, 335,
-	387, 388, 0, 336, 496, 337, 338, 339, 340, 0,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 473, 703, 707, 0, 0, 708, 0, 709,
-	704, 0, 0, 0, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 0, 0, 0, 98, 99, 0, 100, 101, 477,
-	102, 103, 104, 350, 351, 478, 352, 0, 353, 0,
-	105, 106, 107, 108, 109, 0, 0, 395, 110, 354,
-	355, 111, 0, 112, 113, 114, 115, 356, 0, 479,
-	0, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 481,
-	130, 131, 132, 0, 0, 0, 482, 0, 0, 0,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 751, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 152, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 700, 168,
-	0, 169, 170, 362, 171, 0, 172, 0, 173, 483,
-	0, 484, 174, 175, 176, 0, 177, 363, 0, 364,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	485, 365, 195, 196, 197, 198, 366, 367, 0, 368,
-	0, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	0, 0, 206, 369, 488, 207, 489, 0, 208, 209,
-	396, 0, 0, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 0, 226, 227, 228, 0, 373,
-	229, 374, 230, 231, 232, 0, 233, 701, 0, 234,
-	235, 0, 0, 236, 375, 491, 237, 492, 376, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 377,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 493, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 0, 291, 292, 381, 293, 294, 494,
-	295, 296, 382, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 0, 309, 310,
-	0, 311, 495, 312, 313, 314, 315, 316, 0, 411,
-	384, 0, 0, 399, 317, 385, 318, 386, 699, 319,
-	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
-	328, 329, 330, 0, 0, 331, 332, 333, 334, 335,
-	387, 388, 0, 336, 496, 337, 338, 339, 340, 0,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 473, 703, 707, 0, 0, 708, 0, 709,
-	704, 0, 0, 0, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 0, 0, 0, 98, 99, 0, 100, 101, 477,
-	102, 103, 104, 350, 351, 478, 352, 0, 353, 0,
-	105, 106, 107, 108, 109, 0, 0, 395, 110, 354,
-	355, 111, 0, 112, 113, 114, 115, 356, 0, 479,
-	0, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 481,
-	130, 131, 132, 0, 0, 0, 482, 0, 0, 0,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 0, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 152, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 700, 168,
-	0, 169, 170, 362, 171, 0, 172, 0, 173, 483,
-	0, 484, 174, 175, 176, 0, 177, 363, 0, 364,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	485, 365, 195, 196, 197, 198, 366, 367, 0, 368,
-	0, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	0, 0, 206, 369, 488, 207, 489, 0, 208, 209,
-	396, 0, 0, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 0, 226, 227, 228, 0, 373,
-	229, 374, 230, 231, 232, 0, 233, 701, 0, 234,
-	235, 0, 0, 236, 375, 491, 237, 492, 376, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 377,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 493, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 0, 291, 292, 381, 293, 294, 494,
-	295, 296, 382, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 0, 309, 310,
-	0, 311, 495, 312, 313, 314, 315, 316, 0, 411,
-	384, 0, 0, 399, 317, 385, 318, 386, 699, 319,
-	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
-	328, 329, 330, 0, 0, 331, 332, 333, 334, 335,
-	387, 388, 0, 336, 496, 337, 338, 339, 340, 0,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 473, 0, 707, 0, 0, 708, 0, 709,
-	704, 0, 0, 0, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 0, 0, 0, 98, 99, 0, 100, 101, 477,
-	102, 103, 104, 350, 351, 478, 352, 0, 353, 0,
-	105, 106, 107, 108, 109, 0, 0, 395, 110, 354,
-	355, 111, 0, 112, 113, 114, 115, 356, 0, 479,
-	0, 116, 117, 118, 119, 120, 0, 480, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 481,
-	130, 131, 132, 0, 0, 0, 482, 0, 0, 0,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 1328, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 152, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 700, 168,
-	0, 169, 170, 362, 171, 0, 172, 0, 173, 483,
-	0, 484, 174, 175, 176, 0, 177, 363, 0, 364,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	485, 365, 195, 196, 197, 198, 366, 367, 0, 368,
-	0, 199, 486, 200, 487, 201, 202, 203, 204, 205,
-	0, 0, 206, 369, 488, 207, 489, 0, 208, 209,
-	396, 0, 0, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 490,
-	371, 224, 225, 372, 0, 226, 227, 228, 0, 373,
-	229, 374, 230, 231, 232, 0, 233, 701, 0, 234,
-	235, 0, 0, 236, 375, 491, 237, 492, 376, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 377,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 493, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 0, 291, 292, 381, 293, 294, 494,
-	295, 296, 382, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 0, 309, 310,
-	0, 311, 495, 312, 313, 314, 315, 316, 0, 411,
-	384, 0, 0, 399, 317, 385, 318, 386, 699, 319,
-	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
-	328, 329, 330, 0, 0, 331, 332, 333, 334, 335,
-	387, 388, 0, 336, 496, 337, 338, 339, 340, 0,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 84, 0, 0, 0, 0, 0, 0, 709,
-	1109, 1405, 1406, 1407, 0, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 0, 0, 0, 98, 99, 0, 100, 101, 0,
-	102, 103, 104, 350, 351, 0, 352, 0, 353, 0,
-	105, 106, 107, 108, 109, 0, 0, 395, 110, 354,
-	355, 111, 0, 112, 113, 114, 115, 356, 0, 0,
-	0, 116, 117, 118, 119, 120, 1404, 0, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 0,
-	130, 131, 132, 0, 0, 0, 0, 0, 0, 0,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 0, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 152, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 0, 168,
-	0, 169, 170, 362, 171, 0, 172, 0, 173, 0,
-	0, 0, 174, 175, 176, 0, 177, 363, 0, 364,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	0, 365, 195, 196, 197, 198, 366, 367, 0, 368,
-	0, 199, 0, 200, 0, 201, 202, 203, 204, 205,
-	0, 0, 206, 369, 0, 207, 0, 0, 208, 209,
-	396, 0, 0, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 0,
-	371, 224, 225, 372, 0, 226, 227, 228, 0, 373,
-	229, 374, 230, 231, 232, 0, 233, 0, 0, 234,
-	235, 0, 0, 236, 375, 0, 237, 0, 376, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 377,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 0, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 0, 291, 292, 381, 293, 294, 0,
-	295, 296, 382, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 0, 309, 310,
-	0, 311, 0, 312, 313, 314, 315, 316, 0, 411,
-	384, 0, 0, 399, 317, 385, 318, 386, 0, 319,
-	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
-	328, 329, 330, 0, 0, 331, 332, 333, 334, 335,
-	387, 388, 0, 336, 0, 337, 338, 339, 340, 0,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 0, 0, 0, 1401, 1402, 1403, 610, 1392,
-	1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 0, 0,
-	0, 87, 88, 89, 90, 91, 92, 93, 94, 0,
-	95, 96, 97, 0, 0, 0, 0, 0, 0, 0,
-	98, 99, 0, 100, 101, 477, 102, 103, 104, 350,
-	351, 478, 352, 0, 353, 0, 105, 106, 107, 108,
-	109, 0, 630, 395, 110, 354, 355, 111, 0, 112,
-	113, 114, 115, 638, 0, 618, 0, 116, 117, 118,
-	119, 120, 0, 480, 121, 122, 123, 0, 124, 125,
-	126, 127, 128, 129, 0, 481, 130, 131, 132, 628,
-	619, 624, 629, 620, 621, 625, 133, 134, 135, 136,
-	137, 357, 138, 139, 358, 359, 140, 0, 141, 0,
-	142, 143, 144, 145, 146, 0, 147, 148, 149, 0,
-	0, 150, 151, 152, 153, 154, 0, 155, 156, 157,
-	0, 158, 159, 160, 0, 161, 162, 163, 164, 360,
-	165, 166, 167, 631, 0, 168, 0, 169, 170, 362,
-	171, 0, 172, 0, 173, 483, 0, 484, 174, 175,
-	176, 0, 177, 639, 0, 364, 178, 0, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 0, 188, 189,
-	190, 191, 192, 193, 0, 194, 485, 365, 195, 196,
-	197, 198, 366, 367, 0, 368, 0, 199, 486, 200,
-	487, 201, 202, 203, 204, 205, 0, 0, 206, 640,
-	488, 207, 489, 0, 208, 209, 396, 622, 623, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 222, 223, 397, 370, 490, 371, 224, 225, 372,
-	0, 226, 227, 228, 0, 637, 229, 374, 230, 231,
-	232, 0, 233, 0, 0, 234, 235, 0, 0, 236,
-	375, 491, 237, 492, 632, 238, 239, 240, 241, 242,
-	243, 244, 0, 245, 246, 633, 247, 378, 250, 248,
-	249, 0, 251, 252, 253, 254, 255, 256, 257, 258,
-	379, 259, 260, 261, 262, 0, 263, 264, 265, 266,
-	267, 268, 269, 270, 271, 272, 273, 0, 274, 275,
-	493, 276, 277, 278, 380, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 289, 290, 398, 626,
-	291, 292, 381, 293, 294, 494, 295, 296, 382, 297,
-	0, 298, 299, 300, 301, 302, 303, 304, 305, 306,
-	307, 308, 634, 0, 309, 310, 0, 311, 495, 312,
-	313, 314, 315, 316, 0, 411, 384, 0, 0, 399,
-	317, 635, 318, 636, 0, 319, 320, 321, 322, 323,
-	324, 325, 0, 0, 326, 327, 328, 329, 330, 627,
-	0, 331, 332, 333, 334, 335, 387, 388, 0, 336,
-	496, 337, 338, 339, 340, 84, 0, 341, 0, 0,
-	342, 343, 344, 345, 346, 347, 348, 349, 87, 88,
-	89, 90, 91, 92, 93, 94, 0, 95, 96, 97,
-	0, 0, 0, 0, 0, 0, 0, 98, 99, 0,
-	100, 101, 0, 102, 103, 104, 350, 351, 0, 352,
-	0, 353, 0, 105, 106, 107, 108, 109, 0, 0,
-	395, 110, 354, 355, 111, 0, 112, 113, 114, 115,
-	356, 0, 0, 0, 116, 117, 118, 119, 120, 0,
-	0, 121, 122, 123, 0, 124, 125, 126, 127, 128,
-	129, 0, 0, 130, 131, 132, 0, 0, 0, 0,
-	0, 0, 0, 133, 134, 135, 136, 137, 357, 138,
-	139, 358, 359, 140, 0, 141, 0, 142, 143, 144,
-	145, 146, 0, 147, 148, 149, 0, 0, 150, 151,
-	152, 153, 154, 0, 155, 156, 157, 0, 158, 159,
-	160, 0, 161, 162, 163, 164, 360, 165, 166, 167,
-	361, 0, 168, 0, 169, 170, 362, 171, 0, 172,
-	0, 173, 0, 0, 0, 174, 175, 176, 0, 177,
-	363, 0, 364, 178, 0, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 0, 188, 189, 190, 191, 192,
-	193, 0, 194, 0, 365, 195, 196, 197, 198, 366,
-	367, 0, 368, 0, 199, 0, 200, 0, 201, 202,
-	203, 204, 205, 0, 0, 206, 369, 0, 207, 0,
-	0, 208, 209, 396, 0, 0, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
-	397, 370, 0, 371, 224, 225, 372, 0, 226, 227,
-	228, 0, 373, 229, 374, 230, 231, 232, 0, 233,
-	0, 0, 234, 235, 0, 0, 236, 375, 0, 237,
-	0, 376, 238, 239, 240, 241, 242, 243, 244, 0,
-	245, 246, 377, 247, 378, 250, 248, 249, 0, 251,
-	252, 253, 254, 255, 256, 257, 258, 379, 259, 260,
-	261, 262, 0, 263, 264, 265, 266, 267, 268, 269,
-	270, 271, 272, 273, 0, 274, 275, 0, 276, 277,
-	278, 380, 279, 280, 281, 282, 283, 284, 285, 286,
-	49, 287, 288, 289, 290, 398, 0, 291, 292, 381,
-	293, 294, 0, 295, 296, 382, 297, 0, 298, 299,
-	300, 301, 302, 303, 304, 305, 306, 307, 308, 383,
-	0, 309, 310, 51, 311, 0, 312, 313, 314, 315,
-	316, 0, 411, 384, 0, 0, 399, 317, 385, 318,
-	386, 0, 319, 320, 321, 322, 323, 324, 325, 0,
-	0, 326, 327, 328, 329, 330, 0, 0, 331, 332,
-	333, 334, 335, 472, 388, 0, 336, 0, 337, 338,
-	339, 340, 0, 0, 341, 0, 47, 342, 343, 344,
-	345, 346, 347, 348, 349, 0, 0, 48, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 46, 87, 88, 89, 90,
-	91, 92, 93, 94, 0, 95, 96, 97, 0, 0,
-	0, 0, 0, 1429, 0, 98, 99, 0, 100, 101,
-	0, 102, 103, 104, 350, 351, 0, 352, 0, 353,
-	0, 105, 106, 107, 108, 109, 0, 0, 395, 110,
-	354, 355, 111, 0, 112, 113, 114, 115, 356, 0,
-	0, 0, 116, 117, 118, 119, 120, 0, 0, 121,
-	122, 123, 0, 124, 125, 126, 127, 128, 129, 0,
-	0, 130, 131, 132, 0, 0, 0, 0, 0, 0,
-	0, 133, 134, 135, 136, 137, 357, 138, 139, 358,
-	359, 140, 0, 141, 0, 142, 143, 144, 145, 146,
-	0, 147, 148, 149, 0, 0, 150, 151, 152, 153,
-	154, 0, 155, 156, 157, 0, 158, 159, 160, 0,
-	161, 162, 163, 164, 360, 165, 166, 167, 361, 0,
-	168, 0, 169, 170, 362, 171, 0, 172, 0, 173,
-	0, 0, 0, 174, 175, 176, 0, 177, 363, 0,
-	364, 178, 0, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 0, 188, 189, 190, 191, 192, 193, 0,
-	194, 0, 365, 195, 196, 197, 198, 366, 367, 0,
-	368, 0, 199, 0, 200, 0, 201, 202, 203, 204,
-	205, 0, 0, 206, 369, 0, 207, 0, 0, 208,
-	209, 396, 0, 0, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 222, 223, 397, 370,
-	0, 371, 224, 225, 372, 0, 226, 227, 228, 0,
-	373, 229, 374, 230, 231, 232, 0, 233, 0, 0,
-	234, 235, 0, 0, 236, 375, 0, 237, 0, 376,
-	238, 239, 240, 241, 242, 243, 244, 0, 245, 246,
-	377, 247, 378, 250, 248, 249, 0, 251, 252, 253,
-	254, 255, 256, 257, 258, 379, 259, 260, 261, 262,
-	0, 263, 264, 265, 266, 267, 268, 269, 270, 271,
-	272, 273, 0, 274, 275, 0, 276, 277, 278, 380,
-	279, 280, 281, 282, 283, 284, 285, 286, 0, 287,
-	288, 289, 290, 398, 0, 291, 292, 381, 293, 294,
-	0, 295, 296, 382, 297, 0, 298, 299, 300, 301,
-	302, 303, 304, 305, 306, 307, 308, 383, 0, 309,
-	310, 0, 311, 0, 312, 313, 314, 315, 316, 0,
-	411, 384, 0, 0, 399, 317, 385, 318, 386, 0,
-	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
-	327, 328, 329, 330, 0, 0, 331, 332, 333, 334,
-	335, 387, 388, 0, 336, 0, 337, 338, 339, 340,
-	84, 0, 341, 0, 0, 342, 343, 344, 345, 346,
-	347, 348, 349, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 0,
-	0, 0, 98, 99, 564, 100, 101, 0, 102, 103,
-	104, 350, 351, 0, 352, 0, 353, 0, 105, 106,
-	107, 108, 109, 0, 0, 395, 110, 354, 355, 111,
-	0, 112, 113, 114, 115, 356, 0, 0, 0, 116,
-	117, 118, 119, 120, 0, 0, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 0, 130, 131,
-	132, 0, 0, 0, 0, 0, 0, 0, 133, 134,
-	135, 136, 137, 357, 138, 139, 358, 359, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 152, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 360, 165, 166, 167, 361, 0, 168, 0, 169,
-	170, 362, 171, 0, 172, 0, 173, 0, 0, 0,
-	174, 175, 176, 0, 177, 363, 0, 364, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 0, 365,
-	195, 196, 197, 198, 366, 367, 0, 368, 0, 199,
-	0, 200, 0, 201, 202, 203, 204, 205, 0, 0,
-	206, 369, 0, 207, 0, 0, 208, 209, 396, 0,
-	0, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 397, 370, 0, 371, 224,
-	225, 372, 0, 226, 227, 228, 0, 373, 229, 374,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 0, 237, 0, 376, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 377, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 379, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 0, 276, 277, 278, 380, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 289, 290,
-	398, 0, 291, 292, 381, 293, 294, 0, 295, 296,
-	382, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 383, 0, 309, 310, 0, 311,
-	0, 312, 313, 314, 315, 316, 0, 411, 384, 0,
-	0, 399, 317, 385, 318, 386, 0, 319, 320, 321,
-	322, 323, 324, 325, 0, 0, 326, 327, 328, 329,
-	330, 0, 0, 331, 332, 333, 334, 335, 387, 388,
-	0, 336, 0, 337, 338, 339, 340, 0, 0, 341,
-	84, 0, 342, 343, 344, 345, 346, 347, 348, 349,
-	0, 0, 0, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 0,
-	1021, 0, 98, 99, 0, 100, 101, 0, 102, 103,
-	104, 350, 351, 0, 352, 0, 353, 0, 105, 106,
-	107, 108, 109, 0, 0, 395, 110, 354, 355, 111,
-	0, 112, 113, 114, 115, 356, 0, 0, 0, 116,
-	117, 118, 119, 120, 0, 0, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 0, 130, 131,
-	132, 0, 0, 0, 0, 0, 0, 0, 133, 134,
-	135, 136, 137, 357, 138, 139, 358, 359, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 152, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 360, 165, 166, 167, 361, 0, 168, 0, 169,
-	170, 362, 171, 0, 172, 0, 173, 0, 0, 0,
-	174, 175, 176, 0, 177, 363, 0, 364, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 0, 365,
-	195, 196, 197, 198, 366, 367, 0, 368, 0, 199,
-	0, 200, 0, 201, 202, 203, 204, 205, 0, 0,
-	206, 369, 0, 207, 0, 0, 208, 209, 396, 0,
-	0, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 397, 370, 0, 371, 224,
-	225, 372, 0, 226, 227, 228, 0, 373, 229, 374,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 0, 237, 0, 376, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 377, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 379, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 0, 276, 277, 278, 380, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 289, 290,
-	398, 0, 291, 292, 381, 293, 294, 0, 295, 296,
-	382, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 383, 0, 309, 310, 0, 311,
-	0, 312, 313, 314, 315, 316, 0, 411, 384, 0,
-	0, 399, 317, 385, 318, 386, 0, 319, 320, 321,
-	322, 323, 324, 325, 0, 0, 326, 327, 328, 329,
-	330, 0, 0, 331, 332, 333, 334, 335, 387, 388,
-	0, 336, 0, 337, 338, 339, 340, 0, 0, 341,
-	84, 0, 342, 343, 344, 345, 346, 347, 348, 349,
-	0, 0, 0, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 0,
-	1705, 0, 98, 99, 0, 100, 101, 0, 102, 103,
-	104, 350, 351, 0, 352, 0, 353, 0, 105, 106,
-	107, 108, 109, 0, 0, 395, 110, 354, 355, 111,
-	0, 112, 113, 114, 115, 356, 0, 0, 0, 116,
-	117, 118, 119, 120, 0, 0, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 0, 130, 131,
-	132, 0, 0, 0, 0, 0, 0, 0, 133, 134,
-	135, 136, 137, 357, 138, 139, 358, 359, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 152, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 360, 165, 166, 167, 361, 0, 168, 0, 169,
-	170, 362, 171, 0, 172, 0, 173, 0, 0, 0,
-	174, 175, 176, 0, 177, 363, 0, 364, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 0, 365,
-	195, 196, 197, 198, 366, 367, 0, 368, 0, 199,
-	0, 200, 0, 201, 202, 203, 204, 205, 0, 0,
-	206, 369, 0, 207, 0, 0, 208, 209, 396, 0,
-	0, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 397, 370, 0, 371, 224,
-	225, 372, 0, 226, 227, 228, 0, 373, 229, 374,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 0, 237, 0, 376, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 377, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 379, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 0, 276, 277, 278, 380, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 289, 290,
-	398, 0, 291, 292, 381, 293, 294, 0, 295, 296,
-	382, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 383, 0, 309, 310, 0, 311,
-	0, 312, 313, 314, 315, 316, 0, 411, 384, 0,
-	0, 399, 317, 385, 318, 386, 0, 319, 320, 321,
-	322, 323, 324, 325, 0, 0, 326, 327, 328, 329,
-	330, 0, 0, 331, 332, 333, 334, 335, 387, 388,
-	0, 336, 0, 337, 338, 339, 340, 0, 0, 341,
-	84, 0, 342, 343, 344, 345, 346, 347, 348, 349,
-	0, 0, 0, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 0,
-	1650, 0, 98, 99, 0, 100, 101, 0, 102, 103,
-	104, 350, 351, 0, 352, 0, 353, 0, 105, 106,
-	107, 108, 109, 0, 0, 395, 110, 354, 355, 111,
-	0, 112, 113, 114, 115, 356, 0, 0, 0, 116,
-	117, 118, 119, 120, 0, 0, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 0, 130, 131,
-	132, 0, 0, 0, 0, 0, 0, 0, 133, 134,
-	135, 136, 137, 357, 138, 139, 358, 359, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 152, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 360, 165, 166, 167, 361, 0, 168, 0, 169,
-	170, 362, 171, 0, 172, 0, 173, 0, 0, 0,
-	174, 175, 176, 0, 177, 363, 0, 364, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 0, 365,
-	195, 196, 197, 198, 366, 367, 0, 368, 0, 199,
-	0, 200, 0, 201, 202, 203, 204, 205, 0, 0,
-	206, 369, 0, 207, 0, 0, 208, 209, 396, 0,
-	0, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 397, 370, 0, 371, 224,
-	225, 372, 0, 226, 227, 228, 0, 373, 229, 374,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 0, 237, 0, 376, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 377, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 379, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 0, 276, 277, 278, 380, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 289, 290,
-	398, 0, 291, 292, 381, 293, 294, 0, 295, 296,
-	382, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 383, 0, 309, 310, 0, 311,
-	0, 312, 313, 314, 315, 316, 0, 411, 384, 0,
-	0, 399, 317, 385, 318, 386, 0, 319, 320, 321,
-	322, 323, 324, 325, 0, 0, 326, 327, 328, 329,
-	330, 0, 0, 331, 332, 333, 334, 335, 387, 388,
-	0, 336, 0, 337, 338, 339, 340, 0, 0, 341,
-	473, 0, 342, 343, 344, 345, 346, 347, 348, 349,
-	0, 0, 0, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 0,
-	670, 0, 98, 99, 0, 100, 101, 477, 102, 103,
-	104, 350, 351, 478, 352, 0, 353, 0, 105, 106,
-	107, 108, 109, 0, 0, 395, 110, 354, 355, 111,
-	0, 112, 113, 114, 115, 356, 0, 479, 0, 116,
-	117, 118, 119, 120, 0, 480, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 481, 130, 131,
-	132, 0, 0, 0, 482, 0, 0, 0, 133, 134,
-	135, 136, 137, 357, 138, 139, 358, 359, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 152, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 360, 165, 166, 167, 361, 0, 168, 0, 169,
-	170, 362, 171, 0, 172, 0, 173, 483, 0, 484,
-	174, 175, 176, 0, 177, 363, 0, 364, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 485, 365,
-	195, 196, 197, 198, 366, 367, 0, 368, 0, 199,
-	486, 200, 487, 201, 202, 203, 204, 205, 0, 0,
-	206, 369, 488, 207, 489, 0, 208, 209, 396, 0,
-	0, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 397, 370, 490, 371, 224,
-	225, 372, 0, 226, 227, 228, 0, 373, 229, 374,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 491, 237, 492, 376, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 377, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 379, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 493, 276, 277, 278, 380, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 289, 290,
-	398, 0, 291, 292, 381, 293, 294, 494, 295, 296,
-	382, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 383, 0, 309, 310, 0, 311,
-	495, 312, 313, 314, 315, 316, 0, 411, 384, 0,
-	0, 399, 317, 385, 318, 386, 0, 319, 320, 321,
-	322, 323, 324, 325, 0, 0, 326, 327, 328, 329,
-	330, 0, 0, 331, 332, 333, 334, 335, 387, 388,
-	0, 336, 496, 337, 338, 339, 340, 84, 0, 341,
-	0, 0, 342, 343, 344, 345, 346, 347, 348, 349,
-	87, 88, 89, 90, 91, 92, 93, 94, 0, 95,
-	96, 97, 0, 0, 0, 0, 0, 0, 0, 98,
-	99, 0, 100, 101, 0, 102, 103, 104, 350, 351,
-	0, 352, 0, 353, 0, 105, 106, 107, 108, 109,
-	0, 0, 395, 110, 354, 355, 111, 1051, 112, 113,
-	114, 115, 356, 0, 0, 0, 116, 117, 118, 119,
-	120, 0, 0, 121, 122, 123, 1049, 124, 125, 126,
-	127, 128, 129, 0, 0, 130, 131, 132, 0, 0,
-	0, 0, 0, 0, 0, 133, 134, 135, 136, 137,
-	357, 138, 139, 358, 359, 140, 0, 141, 0, 142,
-	143, 144, 145, 146, 0, 147, 148, 149, 0, 0,
-	150, 151, 152, 153, 154, 0, 155, 156, 157, 0,
-	158, 159, 160, 0, 1055, 162, 163, 164, 360, 165,
-	166, 167, 361, 0, 168, 0, 169, 170, 362, 171,
-	0, 172, 1056, 173, 0, 0, 0, 174, 175, 176,
-	0, 177, 363, 0, 364, 178, 0, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 0, 188, 189, 1053,
-	191, 192, 193, 0, 194, 0, 365, 195, 196, 197,
-	198, 366, 367, 0, 368, 0, 199, 0, 200, 0,
-	201, 202, 203, 204, 205, 0, 0, 206, 369, 0,
-	207, 1376, 0, 208, 209, 396, 0, 0, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	222, 223, 397, 370, 0, 371, 224, 225, 372, 0,
-	226, 227, 228, 0, 373, 229, 374, 230, 231, 232,
-	0, 233, 0, 0, 234, 235, 0, 0, 236, 375,
-	0, 237, 0, 376, 238, 239, 240, 241, 242, 243,
-	244, 0, 245, 246, 377, 247, 378, 250, 248, 249,
-	1054, 251, 252, 253, 254, 255, 256, 257, 258, 379,
-	259, 260, 261, 262, 0, 263, 264, 265, 266, 267,
-	268, 269, 270, 271, 272, 273, 0, 274, 275, 0,
-	276, 277, 278, 380, 279, 280, 281, 282, 283, 284,
-	285, 286, 0, 287, 288, 289, 290, 398, 0, 291,
-	292, 381, 293, 294, 0, 295, 296, 382, 297, 0,
-	298, 299, 300, 301, 302, 303, 304, 305, 306, 307,
-	308, 383, 0, 309, 310, 0, 311, 0, 312, 313,
-	314, 315, 316, 0, 411, 384, 0, 0, 399, 317,
-	385, 318, 386, 0, 319, 320, 321, 322, 323, 324,
-	325, 0, 1052, 326, 327, 328, 329, 330, 0, 0,
-	331, 332, 333, 334, 335, 387, 388, 0, 336, 0,
-	337, 338, 339, 340, 84, 0, 341, 0, 0, 342,
-	343, 344, 345, 346, 347, 348, 349, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 0, 0, 0, 98, 99, 0, 100,
-	101, 0, 102, 103, 104, 350, 351, 0, 352, 0,
-	353, 0, 105, 106, 107, 108, 109, 0, 0, 395,
-	110, 354, 355, 111, 1051, 112, 113, 114, 115, 356,
-	0, 0, 1046, 116, 117, 118, 119, 120, 0, 0,
-	121, 122, 123, 1049, 124, 125, 126, 127, 128, 129,
-	0, 0, 130, 131, 132, 0, 0, 0, 0, 0,
-	0, 0, 133, 134, 135, 136, 137, 357, 138, 139,
-	358, 359, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 152,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 1055, 162, 163, 164, 360, 165, 166, 167, 361,
-	0, 168, 0, 169, 170, 362, 171, 0, 172, 1056,
-	173, 0, 0, 0, 174, 175, 176, 0, 177, 363,
-	0, 364, 178, 0, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 0, 188, 189, 1053, 191, 192, 193,
-	0, 194, 0, 365, 195, 196, 197, 198, 366, 367,
-	0, 368, 0, 199, 0, 200, 0, 201, 202, 203,
-	204, 205, 0, 0, 206, 369, 0, 207, 0, 0,
-	208, 209, 396, 0, 0, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 0, 371, 224, 225, 372, 0, 226, 227, 228,
-	0, 373, 229, 374, 230, 231, 232, 0, 233, 0,
-	0, 234, 235, 0, 0, 236, 375, 0, 237, 0,
-	376, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 377, 247, 378, 250, 248, 249, 1054, 251, 252,
-	253, 254, 255, 256, 257, 258, 379, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 0, 276, 277, 278,
-	380, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 289, 290, 398, 0, 291, 292, 381, 293,
-	294, 0, 295, 296, 382, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 383, 0,
-	309, 310, 0, 311, 0, 312, 313, 314, 315, 316,
-	0, 411, 384, 0, 0, 399, 317, 385, 318, 386,
-	0, 319, 320, 321, 322, 323, 324, 325, 0, 1052,
-	326, 327, 328, 329, 330, 0, 0, 331, 332, 333,
-	334, 335, 387, 388, 0, 336, 0, 337, 338, 339,
-	340, 84, 0, 341, 0, 0, 342, 343, 344, 345,
-	346, 347, 348, 349, 87, 88, 89, 90, 91, 92,
-	93, 94, 0, 95, 96, 97, 0, 0, 0, 0,
-	0, 0, 0, 98, 99, 0, 100, 101, 0, 102,
-	103, 104, 350, 351, 0, 352, 0, 353, 0, 105,
-	106, 107, 108, 109, 0, 0, 395, 110, 354, 355,
-	111, 1051, 112, 113, 114, 115, 356, 0, 0, 0,
-	116, 117, 118, 119, 120, 0, 0, 121, 122, 123,
-	1049, 124, 125, 126, 127, 128, 129, 0, 0, 130,
-	131, 132, 0, 0, 0, 0, 0, 0, 0, 133,
-	134, 135, 136, 137, 357, 138, 139, 358, 359, 140,
-	0, 141, 0, 142, 143, 144, 145, 146, 0, 147,
-	148, 149, 0, 0, 150, 151, 152, 153, 154, 0,
-	155, 156, 157, 0, 158, 159, 160, 0, 1055, 162,
-	163, 164, 360, 165, 166, 167, 361, 0, 168, 0,
-	169, 170, 362, 171, 0, 172, 1056, 173, 0, 0,
-	0, 174, 175, 176, 0, 177, 363, 0, 364, 178,
-	0, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	0, 188, 189, 1053, 191, 192, 193, 0, 194, 0,
-	365, 195, 196, 197, 198, 366, 367, 0, 368, 0,
-	199, 0, 200, 0, 201, 202, 203, 204, 205, 0,
-	0, 206, 369, 0, 207, 0, 0, 208, 209, 396,
-	0, 0, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 221, 222, 223, 397, 370, 0, 371,
-	224, 225, 372, 0, 226, 227, 228, 0, 373, 229,
-	374, 230, 231, 232, 0, 233, 0, 0, 234, 235,
-	0, 0, 236, 375, 0, 237, 0, 376, 238, 239,
-	240, 241, 242, 243, 244, 0, 245, 246, 377, 247,
-	378, 250, 248, 249, 1054, 251, 252, 253, 254, 255,
-	256, 257, 258, 379, 259, 260, 261, 262, 0, 263,
-	264, 265, 266, 267, 268, 269, 270, 271, 272, 273,
-	0, 274, 275, 0, 276, 277, 278, 380, 279, 280,
-	281, 282, 283, 284, 285, 286, 0, 287, 288, 289,
-	290, 398, 0, 291, 292, 381, 293, 294, 0, 295,
-	296, 382, 297, 0, 298, 299, 300, 301, 302, 303,
-	304, 305, 306, 307, 308, 383, 0, 309, 310, 0,
-	311, 0, 312, 313, 314, 315, 316, 0, 411, 384,
-	0, 0, 399, 317, 385, 318, 386, 0, 319, 320,
-	321, 322, 323, 324, 325, 0, 1052, 326, 327, 328,
-	329, 330, 0, 0, 331, 332, 333, 334, 335, 387,
-	388, 0, 336, 0, 337, 338, 339, 340, 84, 0,
-	341, 0, 0, 342, 343, 344, 345, 346, 347, 348,
-	349, 87, 88, 89, 90, 91, 92, 93, 94, 0,
-	95, 96, 97, 0, 0, 0, 0, 0, 0, 0,
-	98, 99, 0, 100, 101, 0, 102, 103, 104, 350,
-	351, 0, 352, 0, 353, 0, 105, 106, 107, 108,
-	109, 0, 0, 395, 110, 354, 355, 111, 0, 112,
-	113, 114, 115, 356, 0, 0, 0, 116, 117, 118,
-	119, 120, 0, 0, 121, 122, 123, 0, 124, 125,
-	126, 127, 128, 129, 0, 0, 130, 131, 132, 0,
-	0, 0, 0, 0, 0, 0, 133, 134, 135, 136,
-	137, 357, 138, 139, 358, 359, 140, 0, 141, 0,
-	142, 143, 144, 145, 146, 0, 147, 148, 149, 0,
-	0, 150, 151, 152, 153, 154, 0, 155, 156, 157,
-	0, 158, 159, 160, 0, 161, 162, 163, 164, 360,
-	165, 166, 167, 361, 0, 168, 0, 169, 170, 362,
-	171, 0, 172, 0, 173, 0, 0, 0, 174, 175,
-	176, 0, 177, 363, 0, 364, 178, 0, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 0, 188, 189,
-	190, 191, 192, 193, 0, 194, 0, 365, 195, 196,
-	197, 198, 366, 367, 0, 368, 0, 199, 0, 200,
-	0, 201, 202, 203, 204, 205, 0, 0, 206, 369,
-	0, 207, 0, 0, 208, 209, 396, 0, 0, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 222, 223, 397, 370, 0, 371, 224, 225, 372,
-	0, 226, 227, 228, 0, 373, 229, 374, 230, 231,
-	232, 0, 233, 0, 0, 234, 235, 0, 0, 236,
-	375, 0, 237, 0, 376, 238, 239, 240, 241, 242,
-	243, 244, 0, 245, 246, 377, 247, 378, 250, 248,
-	249, 0, 251, 252, 253, 254, 255, 256, 257, 258,
-	379, 259, 260, 261, 262, 0, 263, 264, 265, 266,
-	267, 268, 269, 270, 271, 272, 273, 0, 274, 275,
-	0, 276, 277, 278, 380, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 289, 290, 398, 0,
-	291, 292, 381, 293, 294, 0, 295, 296, 382, 297,
-	0, 298, 299, 300, 301, 302, 303, 304, 305, 306,
-	307, 308, 383, 0, 309, 310, 0, 311, 0, 312,
-	313, 314, 315, 316, 0, 411, 384, 0, 0, 399,
-	317, 385, 318, 386, 0, 319, 320, 321, 322, 323,
-	324, 325, 0, 0, 326, 327, 328, 329, 330, 0,
-	2017, 331, 332, 333, 334, 335, 387, 388, 0, 336,
-	0, 337, 338, 339, 340, 84, 0, 341, 0, 0,
-	342, 343, 344, 345, 346, 347, 348, 349, 87, 88,
-	89, 90, 91, 92, 93, 94, 0, 95, 96, 97,
-	0, 0, 0, 0, 0, 1429, 0, 98, 99, 0,
-	100, 101, 0, 102, 103, 104, 350, 351, 0, 352,
-	0, 353, 0, 105, 106, 107, 108, 109, 0, 0,
-	395, 110, 354, 355, 111, 0, 112, 113, 114, 115,
-	356, 0, 0, 0, 116, 117, 118, 119, 120, 0,
-	0, 121, 122, 123, 0, 124, 125, 126, 127, 128,
-	129, 0, 0, 130, 131, 132, 0, 0, 0, 0,
-	0, 0, 0, 133, 134, 135, 136, 137, 357, 138,
-	139, 358, 359, 140, 0, 141, 0, 142, 143, 144,
-	145, 146, 0, 147, 148, 149, 0, 0, 150, 151,
-	152, 153, 154, 0, 155, 156, 157, 0, 158, 159,
-	160, 0, 161, 162, 163, 164, 360, 165, 166, 167,
-	361, 0, 168, 0, 169, 170, 362, 171, 0, 172,
-	0, 173, 0, 0, 0, 174, 175, 176, 0, 177,
-	363, 0, 364, 178, 0, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 0, 188, 189, 190, 191, 192,
-	193, 0, 194, 0, 365, 195, 196, 197, 198, 366,
-	367, 0, 368, 0, 199, 0, 200, 0, 201, 202,
-	203, 204, 205, 0, 0, 206, 369, 0, 207, 0,
-	0, 208, 209, 396, 0, 0, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
-	397, 370, 0, 371, 224, 225, 372, 0, 226, 227,
-	228, 0, 373, 229, 374, 230, 231, 232, 0, 233,
-	0, 0, 234, 235, 0, 0, 236, 375, 0, 237,
-	0, 376, 238, 239, 240, 241, 242, 243, 244, 0,
-	245, 246, 377, 247, 378, 250, 248, 249, 0, 251,
-	252, 253, 254, 255, 256, 257, 258, 379, 259, 260,
-	261, 262, 0, 263, 264, 265, 266, 267, 268, 269,
-	270, 271, 272, 273, 0, 274, 275, 0, 276, 277,
-	278, 380, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 289, 290, 398, 0, 291, 292, 381,
-	293, 294, 0, 295, 296, 382, 297, 0, 298, 299,
-	300, 301, 302, 303, 304, 305, 306, 307, 308, 383,
-	0, 309, 310, 0, 311, 0, 312, 313, 314, 315,
-	316, 0, 411, 384, 0, 0, 399, 317, 385, 318,
-	386, 0, 319, 320, 321, 322, 323, 324, 325, 0,
-	0, 326, 327, 328, 329, 330, 0, 0, 331, 332,
-	333, 334, 335, 387, 388, 0, 336, 0, 337, 338,
-	339, 340, 84, 0, 341, 0, 0, 342, 343, 344,
-	345, 346, 347, 348, 349, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 0, 1433, 0, 98, 99, 0, 100, 101, 0,
-	102, 103, 104, 350, 351, 0, 352, 0, 353, 0,
-	105, 106, 107, 108, 109, 0, 0, 395, 110, 354,
-	355, 111, 0, 112, 113, 114, 115, 356, 0, 0,
-	0, 116, 117, 118, 119, 120, 0, 0, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 0,
-	130, 131, 132, 0, 0, 0, 0, 0, 0, 0,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 0, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 152, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 0, 168,
-	0, 169, 170, 362, 171, 0, 172, 0, 173, 0,
-	0, 0, 174, 175, 176, 0, 177, 363, 0, 364,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	0, 365, 195, 196, 197, 198, 366, 367, 0, 368,
-	0, 199, 0, 200, 0, 201, 202, 203, 204, 205,
-	0, 0, 206, 369, 0, 207, 0, 0, 208, 209,
-	396, 0, 0, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 0,
-	371, 224, 225, 372, 0, 226, 227, 228, 0, 373,
-	229, 374, 230, 231, 232, 0, 233, 0, 0, 234,
-	235, 0, 0, 236, 375, 0, 237, 0, 376, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 377,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 0, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 0, 291, 292, 381, 293, 294, 0,
-	295, 296, 382, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 0, 309, 310,
-	0, 311, 0, 312, 313, 314, 315, 316, 0, 411,
-	384, 0, 0, 399, 317, 385, 318, 386, 0, 319,
-	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
-	328, 329, 330, 0, 0, 331, 332, 333, 334, 335,
-	387, 388, 0, 336, 0, 337, 338, 339, 340, 84,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 87, 88, 89, 90, 91, 92, 93, 94,
-	0, 95, 96, 97, 0, 0, 0, 0, 0, 0,
-	0, 98, 99, 0, 100, 101, 0, 102, 103, 104,
-	350, 351, 0, 352, 0, 353, 0, 105, 106, 107,
-	108, 109, 0, 0, 395, 110, 354, 355, 111, 0,
-	112, 113, 114, 115, 356, 0, 0, 0, 116, 117,
-	118, 119, 120, 0, 0, 121, 122, 123, 0, 124,
-	125, 126, 127, 128, 129, 0, 0, 130, 131, 132,
-	0, 0, 0, 0, 0, 0, 0, 133, 134, 135,
-	136, 137, 357, 138, 139, 358, 359, 140, 0, 141,
-	0, 142, 143, 144, 145, 146, 0, 147, 148, 149,
-	0, 0, 150, 151, 152, 153, 154, 0, 155, 156,
-	157, 0, 158, 159, 160, 0, 161, 162, 163, 164,
-	360, 165, 166, 167, 361, 0, 168, 0, 169, 170,
-	362, 171, 0, 172, 0, 173, 0, 0, 0, 174,
-	175, 176, 0, 177, 363, 0, 364, 178, 0, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 0, 188,
-	189, 190, 191, 192, 193, 0, 194, 0, 365, 195,
-	196, 197, 198, 366, 367, 0, 368, 0, 199, 0,
-	200, 0, 201, 202, 203, 204, 205, 0, 0, 206,
-	369, 0, 207, 0, 0, 208, 209, 396, 0, 0,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 221, 222, 223, 397, 370, 0, 371, 224, 225,
-	372, 0, 226, 227, 228, 0, 373, 229, 374, 230,
-	231, 232, 0, 233, 0, 450, 234, 235, 0, 0,
-	236, 375, 0, 237, 0, 376, 238, 239, 240, 241,
-	242, 243, 244, 0, 245, 246, 377, 247, 378, 250,
-	248, 249, 0, 251, 252, 253, 254, 255, 256, 257,
-	258, 379, 259, 260, 261, 262, 0, 263, 264, 265,
-	266, 267, 268, 269, 270, 271, 272, 273, 0, 274,
-	275, 0, 276, 277, 278, 380, 279, 280, 281, 282,
-	283, 284, 285, 286, 0, 287, 288, 289, 290, 398,
-	0, 291, 292, 381, 293, 294, 0, 295, 296, 382,
-	297, 0, 298, 299, 300, 301, 302, 303, 304, 305,
-	306, 307, 308, 383, 0, 309, 310, 0, 311, 0,
-	312, 313, 314, 315, 316, 0, 411, 384, 0, 0,
-	399, 317, 385, 318, 386, 0, 319, 320, 321, 322,
-	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
-	0, 0, 331, 332, 333, 334, 335, 387, 388, 0,
-	336, 0, 337, 338, 339, 340, 84, 0, 341, 0,
-	0, 342, 343, 344, 345, 346, 347, 348, 349, 87,
-	88, 89, 90, 91, 92, 93, 94, 0, 95, 96,
-	97, 0, 0, 0, 0, 0, 0, 0, 98, 99,
-	0, 100, 101, 0, 102, 103, 104, 350, 351, 0,
-	352, 0, 353, 0, 105, 106, 107, 108, 109, 0,
-	0, 395, 110, 354, 355, 111, 0, 112, 113, 114,
-	115, 356, 0, 0, 0, 116, 117, 118, 119, 120,
-	0, 0, 121, 122, 123, 0, 124, 125, 126, 127,
-	128, 129, 0, 0, 130, 131, 132, 0, 0, 0,
-	0, 0, 0, 0, 133, 134, 135, 136, 137, 357,
-	138, 139, 358, 359, 140, 0, 141, 0, 142, 143,
-	144, 145, 146, 0, 147, 148, 149, 0, 0, 150,
-	151, 152, 153, 154, 0, 155, 156, 157, 0, 158,
-	159, 160, 0, 161, 162, 163, 164, 360, 165, 166,
-	167, 361, 0, 168, 0, 169, 170, 362, 171, 0,
-	172, 0, 173, 0, 0, 0, 174, 175, 176, 0,
-	177, 363, 0, 364, 178, 0, 179, 180, 181, 182,
-	502, 184, 185, 186, 187, 0, 188, 189, 190, 191,
-	192, 193, 0, 194, 0, 365, 195, 196, 197, 198,
-	366, 367, 0, 368, 0, 199, 0, 200, 0, 201,
-	202, 203, 204, 205, 0, 0, 206, 369, 0, 207,
-	0, 0, 208, 209, 396, 0, 0, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
-	223, 397, 370, 0, 371, 224, 225, 372, 0, 226,
-	227, 228, 0, 373, 229, 374, 230, 231, 232, 0,
-	233, 0, 450, 234, 235, 0, 0, 236, 375, 0,
-	237, 0, 376, 238, 239, 240, 241, 242, 243, 244,
-	0, 245, 246, 377, 247, 378, 250, 248, 249, 0,
-	251, 252, 253, 254, 255, 256, 257, 258, 379, 259,
-	260, 261, 262, 0, 263, 264, 265, 266, 267, 268,
-	269, 270, 271, 272, 273, 0, 274, 275, 0, 276,
-	277, 278, 380, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 289, 290, 398, 0, 291, 292,
-	381, 293, 294, 0, 295, 296, 382, 297, 0, 298,
-	299, 300, 301, 302, 303, 304, 305, 306, 307, 308,
-	383, 0, 309, 310, 0, 311, 0, 312, 313, 314,
-	315, 316, 0, 411, 384, 0, 0, 399, 317, 385,
-	318, 386, 0, 319, 320, 321, 322, 323, 324, 325,
-	0, 0, 326, 327, 328, 329, 330, 0, 0, 331,
-	332, 333, 334, 335, 387, 388, 0, 336, 0, 337,
-	338, 339, 340, 84, 0, 341, 0, 0, 342, 343,
-	344, 345, 346, 347, 348, 349, 87, 88, 89, 90,
-	91, 92, 93, 94, 391, 95, 96, 97, 0, 0,
-	0, 0, 0, 0, 0, 98, 99, 0, 100, 101,
-	0, 102, 103, 104, 350, 351, 0, 352, 0, 353,
-	0, 105, 106, 107, 108, 109, 0, 0, 395, 110,
-	354, 355, 111, 0, 112, 113, 114, 115, 356, 0,
-	0, 0, 393, 117, 118, 119, 120, 0, 0, 121,
-	122, 123, 0, 124, 125, 126, 127, 128, 129, 0,
-	0, 130, 131, 132, 0, 0, 0, 0, 0, 0,
-	0, 133, 134, 135, 136, 137, 357, 138, 139, 358,
-	359, 140, 0, 141, 0, 142, 143, 144, 145, 146,
-	0, 147, 148, 149, 0, 0, 150, 151, 152, 153,
-	154, 0, 155, 156, 157, 0, 158, 159, 160, 0,
-	161, 162, 163, 164, 360, 165, 166, 167, 361, 0,
-	168, 0, 169, 170, 362, 171, 0, 172, 0, 173,
-	0, 0, 0, 174, 175, 176, 0, 177, 363, 0,
-	364, 178, 0, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 0, 188, 189, 394, 191, 192, 193, 0,
-	194, 0, 365, 195, 196, 197, 198, 366, 367, 0,
-	368, 0, 199, 0, 200, 0, 201, 202, 203, 204,
-	205, 0, 0, 206, 369, 0, 207, 0, 0, 208,
-	209, 396, 0, 0, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 222, 223, 397, 370,
-	0, 371, 224, 225, 372, 0, 226, 227, 228, 0,
-	373, 229, 374, 230, 231, 232, 0, 233, 0, 0,
-	234, 235, 0, 0, 236, 375, 0, 237, 0, 376,
-	238, 239, 240, 241, 242, 243, 244, 0, 245, 246,
-	377, 247, 378, 250, 248, 249, 0, 251, 252, 253,
-	254, 255, 256, 257, 258, 379, 259, 260, 261, 262,
-	0, 263, 264, 265, 266, 267, 268, 269, 270, 271,
-	272, 273, 0, 274, 275, 0, 276, 277, 278, 380,
-	279, 280, 281, 282, 283, 284, 285, 286, 0, 287,
-	288, 289, 290, 398, 0, 291, 292, 381, 293, 294,
-	0, 295, 296, 382, 297, 0, 298, 299, 300, 301,
-	302, 303, 304, 305, 306, 307, 308, 383, 0, 309,
-	310, 0, 392, 0, 312, 313, 314, 315, 316, 0,
-	390, 384, 0, 0, 399, 317, 385, 318, 386, 0,
-	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
-	327, 328, 329, 330, 0, 0, 331, 332, 333, 334,
-	335, 387, 388, 0, 336, 0, 337, 338, 339, 340,
-	84, 0, 341, 0, 0, 342, 343, 344, 345, 346,
-	347, 348, 349, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 0,
-	0, 0, 98, 99, 0, 100, 101, 0, 102, 103,
-	104, 350, 351, 0, 352, 0, 353, 0, 105, 106,
-	107, 108, 109, 0, 0, 395, 110, 354, 355, 111,
-	0, 112, 113, 114, 115, 356, 0, 0, 0, 116,
-	117, 118, 119, 120, 0, 0, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 0, 130, 131,
-	132, 0, 0, 0, 0, 0, 0, 0, 133, 134,
-	135, 136, 137, 357, 138, 139, 358, 359, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 152, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 360, 165, 166, 167, 361, 0, 168, 0, 169,
-	170, 362, 171, 0, 172, 0, 173, 0, 0, 0,
-	174, 175, 176, 0, 177, 363, 0, 364, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 0, 365,
-	195, 196, 197, 198, 366, 367, 0, 368, 0, 199,
-	0, 200, 0, 201, 202, 203, 204, 205, 0, 0,
-	206, 369, 0, 207, 0, 0, 208, 209, 396, 0,
-	0, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 397, 370, 0, 371, 224,
-	225, 372, 0, 226, 227, 228, 0, 373, 229, 374,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 0, 237, 0, 376, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 377, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 379, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 0, 276, 277, 278, 380, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 289, 290,
-	398, 0, 291, 292, 381, 293, 294, 0, 295, 296,
-	382, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 383, 0, 309, 310, 0, 311,
-	0, 312, 313, 314, 315, 316, 0, 411, 384, 0,
-	0, 399, 317, 385, 318, 386, 0, 319, 320, 321,
-	322, 323, 324, 325, 0, 0, 326, 327, 328, 329,
-	330, 0, 0, 331, 332, 333, 334, 335, 387, 388,
-	0, 336, 0, 337, 338, 339, 340, 84, 0, 341,
-	0, 0, 342, 343, 344, 345, 346, 347, 348, 349,
-	87, 88, 89, 90, 91, 92, 93, 94, 0, 95,
-	96, 97, 0, 0, 0, 0, 0, 0, 0, 98,
-	99, 0, 100, 101, 0, 102, 103, 104, 350, 351,
-	0, 352, 0, 353, 0, 105, 106, 107, 108, 109,
-	0, 0, 395, 110, 354, 355, 111, 0, 112, 113,
-	114, 115, 356, 0, 0, 0, 116, 117, 118, 119,
-	120, 0, 0, 121, 122, 123, 0, 124, 125, 126,
-	127, 128, 129, 0, 0, 130, 131, 132, 0, 0,
-	0, 0, 0, 0, 0, 133, 134, 135, 136, 137,
-	357, 138, 139, 358, 359, 140, 0, 141, 0, 142,
-	143, 144, 145, 146, 0, 147, 148, 149, 0, 0,
-	150, 151, 152, 153, 154, 0, 155, 156, 157, 0,
-	158, 159, 160, 0, 161, 162, 163, 164, 360, 165,
-	166, 167, 361, 0, 168, 0, 169, 170, 362, 171,
-	0, 172, 0, 173, 0, 0, 0, 174, 175, 176,
-	0, 177, 363, 0, 364, 178, 0, 179, 180, 181,
-	182, 1347, 184, 185, 186, 187, 0, 188, 189, 190,
-	191, 192, 193, 0, 194, 0, 365, 195, 196, 197,
-	198, 366, 367, 0, 368, 0, 199, 0, 200, 0,
-	201, 202, 203, 204, 205, 0, 0, 206, 369, 0,
-	207, 0, 0, 208, 209, 396, 0, 0, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	222, 223, 397, 370, 0, 371, 224, 225, 372, 0,
-	226, 227, 228, 0, 373, 229, 374, 230, 231, 232,
-	0, 233, 0, 0, 234, 235, 0, 0, 236, 375,
-	0, 237, 0, 376, 238, 239, 240, 241, 242, 243,
-	244, 0, 245, 246, 377, 247, 378, 250, 248, 249,
-	0, 251, 252, 253, 254, 255, 256, 257, 258, 379,
-	259, 260, 261, 262, 0, 263, 264, 265, 266, 267,
-	268, 269, 270, 271, 272, 273, 0, 274, 275, 0,
-	276, 277, 278, 380, 279, 280, 281, 282, 283, 284,
-	285, 286, 0, 287, 288, 289, 290, 398, 0, 291,
-	292, 381, 293, 294, 0, 295, 296, 382, 297, 0,
-	298, 299, 300, 301, 302, 303, 304, 305, 306, 307,
-	308, 383, 0, 309, 310, 0, 311, 0, 312, 313,
-	314, 315, 316, 0, 411, 384, 0, 0, 399, 317,
-	385, 318, 386, 0, 319, 320, 321, 322, 323, 324,
-	325, 0, 0, 326, 327, 328, 329, 330, 0, 0,
-	331, 332, 333, 334, 335, 387, 388, 0, 336, 0,
-	337, 338, 339, 340, 84, 0, 341, 0, 0, 342,
-	343, 344, 345, 346, 347, 348, 349, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 0, 0, 0, 98, 99, 0, 100,
-	101, 0, 102, 103, 104, 350, 351, 0, 352, 0,
-	353, 0, 105, 106, 107, 108, 109, 0, 0, 395,
-	110, 354, 355, 111, 0, 112, 113, 114, 115, 356,
-	0, 0, 0, 116, 117, 118, 119, 120, 0, 0,
-	121, 122, 123, 0, 124, 125, 126, 127, 128, 129,
-	0, 0, 130, 131, 132, 0, 0, 0, 0, 0,
-	0, 0, 133, 134, 135, 136, 137, 357, 138, 139,
-	358, 359, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 152,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 161, 162, 163, 164, 360, 165, 166, 167, 361,
-	0, 168, 0, 169, 170, 362, 171, 0, 172, 0,
-	173, 0, 0, 0, 174, 175, 176, 0, 177, 363,
-	0, 364, 178, 0, 179, 180, 181, 182, 1345, 184,
-	185, 186, 187, 0, 188, 189, 190, 191, 192, 193,
-	0, 194, 0, 365, 195, 196, 197, 198, 366, 367,
-	0, 368, 0, 199, 0, 200, 0, 201, 202, 203,
-	204, 205, 0, 0, 206, 369, 0, 207, 0, 0,
-	208, 209, 396, 0, 0, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 0, 371, 224, 225, 372, 0, 226, 227, 228,
-	0, 373, 229, 374, 230, 231, 232, 0, 233, 0,
-	0, 234, 235, 0, 0, 236, 375, 0, 237, 0,
-	376, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 377, 247, 378, 250, 248, 249, 0, 251, 252,
-	253, 254, 255, 256, 257, 258, 379, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 0, 276, 277, 278,
-	380, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 289, 290, 398, 0, 291, 292, 381, 293,
-	294, 0, 295, 296, 382, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 383, 0,
-	309, 310, 0, 311, 0, 312, 313, 314, 315, 316,
-	0, 411, 384, 0, 0, 399, 317, 385, 318, 386,
-	0, 319, 320, 321, 322, 323, 324, 325, 0, 0,
-	326, 327, 328, 329, 330, 0, 0, 331, 332, 333,
-	334, 335, 387, 388, 0, 336, 0, 337, 338, 339,
-	340, 84, 0, 341, 0, 0, 342, 343, 344, 345,
-	346, 347, 348, 349, 87, 88, 89, 90, 91, 92,
-	93, 94, 0, 95, 96, 97, 0, 0, 0, 0,
-	0, 0, 0, 98, 99, 0, 100, 101, 0, 102,
-	103, 104, 350, 351, 0, 352, 0, 353, 0, 105,
-	106, 107, 108, 109, 0, 0, 395, 110, 354, 355,
-	111, 0, 112, 113, 114, 115, 356, 0, 0, 0,
-	116, 117, 118, 119, 120, 0, 0, 121, 122, 123,
-	0, 124, 125, 126, 127, 128, 129, 0, 0, 130,
-	131, 132, 0, 0, 0, 0, 0, 0, 0, 133,
-	134, 135, 136, 137, 357, 138, 139, 358, 359, 140,
-	0, 141, 0, 142, 143, 144, 145, 146, 0, 147,
-	148, 149, 0, 0, 150, 151, 152, 153, 154, 0,
-	155, 156, 157, 0, 158, 159, 160, 0, 161, 162,
-	163, 164, 360, 165, 166, 167, 361, 0, 168, 0,
-	169, 170, 362, 171, 0, 172, 0, 173, 0, 0,
-	0, 174, 175, 176, 0, 177, 363, 0, 364, 178,
-	0, 179, 180, 181, 182, 1097, 184, 185, 186, 187,
-	0, 188, 189, 190, 191, 192, 193, 0, 194, 0,
-	365, 195, 196, 197, 198, 366, 367, 0, 368, 0,
-	199, 0, 200, 0, 201, 202, 203, 204, 205, 0,
-	0, 206, 369, 0, 207, 0, 0, 208, 209, 396,
-	0, 0, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 221, 222, 223, 397, 370, 0, 371,
-	224, 225, 372, 0, 226, 227, 228, 0, 373, 229,
-	374, 230, 231, 232, 0, 233, 0, 0, 234, 235,
-	0, 0, 236, 375, 0, 237, 0, 376, 238, 239,
-	240, 241, 242, 243, 244, 0, 245, 246, 377, 247,
-	378, 250, 248, 249, 0, 251, 252, 253, 254, 255,
-	256, 257, 258, 379, 259, 260, 261, 262, 0, 263,
-	264, 265, 266, 267, 268, 269, 270, 271, 272, 273,
-	0, 274, 275, 0, 276, 277, 278, 380, 279, 280,
-	281, 282, 283, 284, 285, 286, 0, 287, 288, 289,
-	290, 398, 0, 291, 292, 381, 293, 294, 0, 295,
-	296, 382, 297, 0, 298, 299, 300, 301, 302, 303,
-	304, 305, 306, 307, 308, 383, 0, 309, 310, 0,
-	311, 0, 312, 313, 314, 315, 316, 0, 411, 384,
-	0, 0, 399, 317, 385, 318, 386, 0, 319, 320,
-	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
-	329, 330, 0, 0, 331, 332, 333, 334, 335, 387,
-	388, 0, 336, 0, 337, 338, 339, 340, 84, 0,
-	341, 0, 0, 342, 343, 344, 345, 346, 347, 348,
-	349, 87, 88, 89, 90, 91, 92, 93, 94, 0,
-	95, 96, 97, 0, 0, 0, 0, 0, 0, 0,
-	98, 99, 0, 100, 101, 0, 102, 103, 104, 350,
-	351, 0, 352, 0, 353, 0, 105, 106, 107, 108,
-	109, 0, 0, 81, 110, 354, 355, 111, 0, 112,
-	113, 114, 115, 356, 0, 0, 0, 116, 117, 118,
-	119, 120, 0, 0, 121, 122, 123, 0, 124, 125,
-	126, 127, 128, 129, 0, 0, 130, 131, 132, 0,
-	0, 0, 0, 0, 0, 0, 133, 134, 135, 136,
-	137, 357, 138, 139, 358, 359, 140, 0, 141, 0,
-	142, 143, 144, 145, 146, 0, 147, 148, 149, 0,
-	0, 150, 151, 152, 153, 154, 0, 155, 156, 157,
-	0, 158, 159, 160, 0, 161, 162, 163, 164, 360,
-	165, 166, 167, 361, 0, 168, 0, 169, 170, 362,
-	171, 0, 172, 0, 173, 0, 0, 0, 174, 175,
-	176, 0, 177, 363, 0, 364, 178, 0, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 0, 188, 189,
-	190, 191, 192, 193, 0, 194, 0, 365, 195, 196,
-	197, 198, 366, 367, 0, 368, 0, 199, 0, 200,
-	0, 201, 202, 203, 204, 205, 0, 0, 206, 369,
-	0, 207, 0, 0, 208, 209, 396, 0, 0, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 222, 223, 82, 370, 0, 371, 224, 225, 372,
-	0, 226, 227, 228, 0, 373, 229, 374, 230, 231,
-	232, 0, 233, 0, 0, 234, 235, 0, 0, 236,
-	375, 0, 237, 0, 376, 238, 239, 240, 241, 242,
-	243, 244, 0, 245, 246, 377, 247, 378, 250, 248,
-	249, 0, 251, 252, 253, 254, 255, 256, 257, 258,
-	379, 259, 260, 261, 262, 0, 263, 264, 265, 266,
-	267, 268, 269, 270, 271, 272, 273, 0, 274, 275,
-	0, 276, 277, 278, 380, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 289, 290, 504, 0,
-	291, 292, 381, 293, 294, 0, 295, 296, 382, 297,
-	0, 298, 299, 300, 301, 302, 303, 304, 305, 306,
-	307, 308, 383, 0, 309, 310, 0, 311, 0, 312,
-	313, 314, 315, 316, 0, 80, 384, 0, 0, 76,
-	317, 385, 318, 386, 0, 319, 320, 321, 322, 323,
-	324, 325, 0, 0, 326, 327, 328, 329, 330, 0,
-	0, 331, 332, 333, 334, 335, 387, 388, 0, 336,
-	0, 337, 338, 339, 340, 84, 0, 341, 0, 0,
-	342, 343, 344, 345, 346, 347, 348, 349, 87, 88,
-	89, 90, 91, 92, 93, 94, 0, 95, 96, 97,
-	0, 0, 0, 0, 0, 0, 0, 98, 99, 0,
-	100, 101, 0, 102, 103, 104, 350, 351, 0, 352,
-	0, 353, 0, 105, 106, 107, 108, 109, 0, 0,
-	395, 110, 354, 355, 111, 0, 112, 113, 114, 115,
-	356, 0, 0, 0, 116, 117, 118, 119, 120, 0,
-	0, 121, 122, 123, 0, 124, 125, 126, 127, 128,
-	129, 0, 0, 130, 131, 132, 0, 0, 0, 0,
-	0, 0, 0, 133, 134, 135, 136, 137, 357, 138,
-	139, 358, 359, 140, 0, 141, 0, 142, 143, 144,
-	145, 146, 0, 147, 148, 149, 0, 0, 150, 151,
-	152, 153, 154, 0, 155, 156, 157, 0, 158, 159,
-	160, 0, 161, 162, 163, 164, 360, 165, 166, 167,
-	361, 0, 168, 0, 169, 170, 362, 171, 0, 172,
-	0, 173, 0, 0, 0, 174, 175, 176, 0, 177,
-	363, 0, 364, 178, 0, 179, 180, 181, 182, 1034,
-	184, 185, 186, 187, 0, 188, 189, 190, 191, 192,
-	193, 0, 194, 0, 365, 195, 196, 197, 198, 366,
-	367, 0, 368, 0, 199, 0, 200, 0, 201, 202,
-	203, 204, 205, 0, 0, 206, 369, 0, 207, 0,
-	0, 208, 209, 396, 0, 0, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
-	397, 370, 0, 371, 224, 225, 372, 0, 226, 227,
-	228, 0, 373, 229, 374, 230, 231, 232, 0, 233,
-	0, 0, 234, 235, 0, 0, 236, 375, 0, 237,
-	0, 376, 238, 239, 240, 241, 242, 243, 244, 0,
-	245, 246, 377, 247, 378, 250, 248, 249, 0, 251,
-	252, 253, 254, 255, 256, 257, 258, 379, 259, 260,
-	261, 262, 0, 263, 264, 265, 266, 267, 268, 269,
-	270, 271, 272, 273, 0, 274, 275, 0, 276, 277,
-	278, 380, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 289, 290, 398, 0, 291, 292, 381,
-	293, 294, 0, 295, 296, 382, 297, 0, 298, 299,
-	300, 301, 302, 303, 304, 305, 306, 307, 308, 383,
-	0, 309, 310, 0, 311, 0, 312, 313, 314, 315,
-	316, 0, 411, 384, 0, 0, 399, 317, 385, 318,
-	386, 0, 319, 320, 321, 322, 323, 324, 325, 0,
-	0, 326, 327, 328, 329, 330, 0, 0, 331, 332,
-	333, 334, 335, 387, 388, 0, 336, 0, 337, 338,
-	339, 340, 84, 0, 341, 0, 0, 342, 343, 344,
-	345, 346, 347, 348, 349, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 0, 0, 0, 98, 99, 0, 100, 101, 0,
-	102, 103, 104, 350, 351, 0, 352, 0, 353, 0,
-	105, 106, 107, 108, 109, 0, 0, 395, 110, 354,
-	355, 111, 0, 112, 113, 114, 115, 356, 0, 0,
-	0, 116, 117, 118, 119, 120, 0, 0, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 0,
-	130, 131, 132, 0, 0, 0, 0, 0, 0, 0,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 0, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 152, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 0, 168,
-	0, 169, 170, 362, 171, 0, 172, 0, 173, 0,
-	0, 0, 174, 175, 176, 0, 177, 363, 0, 364,
-	178, 0, 179, 180, 181, 182, 777, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	0, 365, 195, 196, 197, 198, 366, 367, 0, 368,
-	0, 199, 0, 200, 0, 201, 202, 203, 204, 205,
-	0, 0, 206, 369, 0, 207, 0, 0, 208, 209,
-	396, 0, 0, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 0,
-	371, 224, 225, 372, 0, 226, 227, 228, 0, 373,
-	229, 374, 230, 231, 232, 0, 233, 0, 0, 234,
-	235, 0, 0, 236, 375, 0, 237, 0, 376, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 377,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 0, 276, 277, 278, 380, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 0, 291, 292, 381, 293, 294, 0,
-	295, 296, 382, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 0, 309, 310,
-	0, 311, 0, 312, 313, 314, 315, 316, 0, 411,
-	384, 0, 0, 399, 317, 385, 318, 386, 0, 319,
-	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
-	328, 329, 330, 0, 0, 331, 332, 333, 334, 335,
-	387, 388, 0, 336, 0, 337, 338, 339, 340, 84,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 87, 88, 89, 90, 91, 92, 93, 94,
-	0, 95, 96, 97, 0, 0, 0, 0, 0, 0,
-	0, 98, 99, 0, 100, 101, 0, 102, 103, 104,
-	350, 351, 0, 352, 0, 353, 0, 105, 106, 107,
-	108, 109, 0, 0, 395, 110, 354, 355, 111, 0,
-	112, 113, 114, 115, 356, 0, 0, 0, 116, 117,
-	118, 119, 120, 0, 0, 121, 122, 123, 0, 124,
-	125, 126, 127, 128, 129, 0, 0, 130, 131, 132,
-	0, 0, 0, 0, 0, 0, 0, 133, 134, 135,
-	136, 137, 357, 138, 139, 358, 359, 140, 0, 141,
-	0, 142, 143, 144, 145, 146, 0, 147, 148, 149,
-	0, 0, 150, 151, 152, 153, 154, 0, 155, 156,
-	157, 0, 158, 159, 160, 0, 161, 162, 163, 164,
-	360, 165, 166, 167, 361, 0, 168, 0, 169, 170,
-	362, 171, 0, 172, 0, 173, 0, 0, 0, 174,
-	175, 176, 0, 177, 363, 0, 364, 178, 0, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 0, 188,
-	189, 190, 191, 192, 193, 0, 194, 0, 365, 195,
-	196, 197, 198, 366, 367, 0, 368, 0, 199, 0,
-	200, 0, 201, 202, 203, 204, 205, 0, 0, 206,
-	369, 0, 207, 0, 0, 208, 209, 396, 0, 0,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 221, 222, 223, 397, 370, 0, 371, 224, 225,
-	372, 0, 226, 227, 228, 0, 373, 229, 374, 230,
-	231, 232, 0, 233, 0, 0, 234, 235, 0, 0,
-	236, 375, 0, 237, 0, 376, 238, 239, 240, 241,
-	242, 243, 244, 0, 245, 246, 377, 247, 378, 250,
-	248, 249, 0, 251, 252, 253, 254, 255, 256, 257,
-	258, 379, 259, 260, 261, 262, 0, 263, 264, 265,
-	266, 267, 268, 269, 270, 271, 272, 273, 0, 274,
-	275, 0, 276, 277, 278, 380, 279, 280, 770, 282,
-	283, 284, 285, 286, 0, 287, 288, 289, 290, 398,
-	0, 291, 292, 381, 293, 294, 0, 295, 296, 382,
-	297, 0, 298, 299, 300, 301, 302, 303, 304, 305,
-	306, 307, 308, 383, 0, 309, 310, 0, 311, 0,
-	312, 313, 314, 315, 316, 0, 411, 384, 0, 0,
-	399, 317, 385, 318, 386, 0, 319, 320, 321, 322,
-	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
-	0, 0, 331, 332, 333, 334, 335, 387, 388, 0,
-	336, 0, 337, 338, 339, 340, 84, 0, 341, 0,
-	0, 342, 343, 344, 345, 346, 347, 348, 349, 87,
-	88, 89, 90, 91, 92, 93, 94, 0, 95, 96,
-	97, 0, 0, 0, 0, 0, 684, 0, 98, 99,
-	0, 100, 101, 0, 102, 103, 104, 350, 351, 0,
-	352, 0, 353, 0, 105, 106, 107, 108, 109, 0,
-	0, 395, 110, 354, 355, 111, 0, 112, 113, 114,
-	115, 356, 0, 0, 0, 116, 117, 118, 119, 120,
-	0, 0, 121, 122, 123, 0, 124, 125, 126, 127,
-	128, 129, 0, 0, 130, 131, 132, 0, 0, 0,
-	0, 0, 0, 0, 133, 134, 135, 136, 137, 357,
-	138, 139, 358, 359, 140, 0, 141, 0, 142, 143,
-	144, 145, 146, 0, 147, 148, 149, 0, 0, 150,
-	151, 152, 153, 154, 0, 155, 156, 157, 0, 158,
-	159, 160, 0, 161, 162, 163, 164, 360, 165, 166,
-	167, 361, 0, 168, 0, 169, 170, 362, 171, 0,
-	172, 0, 173, 0, 0, 0, 174, 175, 176, 0,
-	177, 363, 0, 364, 178, 0, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 0, 188, 189, 190, 191,
-	192, 193, 0, 194, 0, 365, 195, 196, 197, 198,
-	366, 367, 0, 368, 0, 199, 0, 200, 0, 201,
-	202, 203, 204, 205, 0, 0, 206, 369, 0, 207,
-	0, 0, 208, 209, 396, 0, 0, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
-	223, 397, 370, 0, 371, 224, 225, 372, 0, 226,
-	227, 228, 0, 373, 229, 374, 230, 231, 232, 0,
-	233, 0, 0, 234, 235, 0, 0, 236, 375, 0,
-	237, 0, 376, 238, 239, 240, 241, 242, 243, 244,
-	0, 245, 246, 377, 247, 378, 250, 248, 249, 0,
-	251, 252, 253, 254, 255, 256, 257, 258, 379, 259,
-	260, 261, 262, 0, 263, 264, 265, 266, 267, 268,
-	269, 270, 271, 272, 273, 0, 274, 275, 0, 276,
-	277, 278, 380, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 289, 290, 398, 0, 0, 292,
-	381, 293, 294, 0, 295, 296, 382, 297, 0, 298,
-	299, 300, 301, 302, 303, 304, 305, 306, 307, 308,
-	383, 0, 309, 310, 0, 311, 0, 312, 313, 314,
-	315, 316, 0, 411, 384, 0, 0, 399, 317, 385,
-	318, 386, 0, 319, 320, 321, 322, 323, 324, 325,
-	0, 0, 326, 327, 328, 329, 330, 0, 0, 331,
-	332, 333, 334, 335, 387, 388, 0, 336, 0, 337,
-	338, 339, 340, 84, 0, 341, 0, 0, 342, 343,
-	344, 345, 346, 347, 348, 349, 87, 88, 89, 90,
-	91, 92, 93, 94, 0, 95, 96, 97, 0, 0,
-	0, 0, 0, 0, 0, 98, 99, 0, 100, 101,
-	0, 102, 103, 104, 350, 351, 0, 352, 0, 353,
-	0, 105, 106, 107, 108, 109, 0, 0, 395, 110,
-	354, 355, 111, 0, 112, 113, 114, 115, 356, 0,
-	0, 0, 116, 117, 118, 119, 120, 0, 0, 121,
-	122, 123, 0, 124, 125, 126, 127, 128, 129, 0,
-	0, 130, 131, 132, 0, 0, 0, 0, 0, 0,
-	0, 133, 134, 135, 136, 137, 357, 138, 139, 358,
-	359, 140, 0, 141, 0, 142, 143, 144, 145, 146,
-	0, 147, 148, 149, 0, 0, 150, 151, 152, 153,
-	154, 0, 155, 156, 157, 0, 158, 159, 160, 0,
-	161, 162, 163, 164, 360, 165, 166, 167, 361, 0,
-	168, 0, 169, 170, 362, 171, 0, 172, 0, 173,
-	0, 0, 0, 174, 175, 176, 0, 177, 363, 0,
-	364, 178, 0, 179, 180, 181, 182, 539, 184, 185,
-	186, 187, 0, 188, 189, 190, 191, 192, 193, 0,
-	194, 0, 365, 195, 196, 197, 198, 366, 367, 0,
-	368, 0, 199, 0, 200, 0, 201, 202, 203, 204,
-	205, 0, 0, 206, 369, 0, 207, 0, 0, 208,
-	209, 396, 0, 0, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 222, 223, 397, 370,
-	0, 371, 224, 225, 372, 0, 226, 227, 228, 0,
-	373, 229, 374, 230, 231, 232, 0, 233, 0, 0,
-	234, 235, 0, 0, 236, 375, 0, 237, 0, 376,
-	238, 239, 240, 241, 242, 243, 244, 0, 245, 246,
-	377, 247, 378, 250, 248, 249, 0, 251, 252, 253,
-	254, 255, 256, 257, 258, 379, 259, 260, 261, 262,
-	0, 263, 264, 265, 266, 267, 268, 269, 270, 271,
-	272, 273, 0, 274, 275, 0, 276, 277, 278, 380,
-	279, 280, 281, 282, 283, 284, 285, 286, 0, 287,
-	288, 289, 290, 398, 0, 291, 292, 381, 293, 294,
-	0, 295, 296, 382, 297, 0, 298, 299, 300, 301,
-	302, 303, 304, 305, 306, 307, 308, 383, 0, 309,
-	310, 0, 311, 0, 312, 313, 314, 315, 316, 0,
-	411, 384, 0, 0, 399, 317, 385, 318, 386, 0,
-	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
-	327, 328, 329, 330, 0, 0, 331, 332, 333, 334,
-	335, 387, 388, 0, 336, 0, 337, 338, 339, 340,
-	84, 0, 341, 0, 0, 342, 343, 344, 345, 346,
-	347, 348, 349, 87, 88, 89, 90, 91, 92, 93,
-	94, 0, 95, 96, 97, 0, 0, 0, 0, 0,
-	0, 0, 98, 99, 0, 100, 101, 0, 102, 103,
-	104, 350, 351, 0, 352, 0, 353, 0, 105, 106,
-	107, 108, 109, 0, 0, 81, 110, 354, 355, 506,
-	0, 112, 113, 114, 115, 356, 0, 0, 0, 116,
-	117, 118, 119, 120, 0, 0, 121, 122, 123, 0,
-	124, 125, 126, 127, 128, 129, 0, 0, 130, 131,
-	132, 0, 0, 0, 0, 0, 0, 0, 133, 134,
-	135, 136, 137, 357, 138, 139, 358, 359, 140, 0,
-	141, 0, 142, 143, 144, 145, 146, 0, 147, 148,
-	149, 0, 0, 150, 151, 152, 153, 154, 0, 155,
-	156, 157, 0, 158, 159, 160, 0, 161, 162, 163,
-	164, 360, 165, 166, 167, 361, 0, 168, 0, 169,
-	170, 362, 171, 0, 172, 0, 173, 0, 0, 0,
-	174, 175, 176, 0, 177, 363, 0, 364, 178, 0,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 0,
-	188, 189, 190, 191, 192, 193, 0, 194, 0, 365,
-	195, 196, 197, 198, 366, 367, 0, 368, 0, 199,
-	0, 200, 0, 201, 202, 203, 204, 205, 0, 0,
-	206, 369, 0, 207, 0, 0, 208, 209, 396, 0,
-	0, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 82, 370, 0, 371, 224,
-	225, 372, 0, 226, 227, 228, 0, 373, 229, 374,
-	230, 231, 232, 0, 233, 0, 0, 234, 235, 0,
-	0, 236, 375, 0, 237, 0, 376, 238, 239, 240,
-	241, 242, 243, 244, 0, 245, 246, 377, 247, 378,
-	250, 248, 249, 0, 251, 252, 253, 254, 255, 256,
-	257, 258, 379, 259, 260, 261, 262, 0, 263, 264,
-	265, 266, 267, 268, 269, 270, 271, 272, 273, 0,
-	274, 275, 0, 276, 277, 278, 380, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 289, 290,
-	504, 0, 291, 292, 381, 293, 294, 0, 295, 296,
-	382, 297, 0, 298, 299, 300, 301, 302, 303, 304,
-	305, 306, 307, 308, 383, 0, 309, 310, 0, 311,
-	0, 312, 313, 314, 315, 316, 0, 80, 384, 0,
-	0, 76, 317, 385, 318, 386, 0, 319, 320, 321,
-	322, 323, 324, 325, 0, 0, 326, 327, 328, 329,
-	330, 0, 0, 331, 332, 333, 334, 335, 387, 388,
-	0, 336, 0, 337, 338, 339, 340, 84, 0, 341,
-	0, 0, 342, 343, 344, 345, 346, 347, 348, 349,
-	87, 88, 89, 90, 91, 92, 93, 94, 0, 95,
-	96, 97, 0, 0, 0, 0, 0, 0, 0, 98,
-	99, 0, 100, 101, 0, 102, 103, 104, 350, 351,
-	0, 352, 0, 353, 0, 105, 106, 107, 108, 109,
-	0, 0, 395, 110, 354, 355, 111, 0, 112, 113,
-	114, 115, 356, 0, 0, 0, 116, 117, 118, 119,
-	120, 0, 0, 121, 122, 123, 0, 124, 125, 126,
-	127, 128, 129, 0, 0, 130, 131, 132, 0, 0,
-	0, 0, 0, 0, 0, 133, 134, 135, 136, 137,
-	357, 138, 139, 358, 359, 140, 0, 141, 0, 142,
-	143, 144, 145, 146, 0, 147, 148, 149, 0, 0,
-	150, 151, 152, 153, 154, 0, 155, 156, 157, 0,
-	158, 159, 160, 0, 161, 162, 163, 164, 360, 165,
-	166, 167, 361, 0, 168, 0, 169, 170, 362, 171,
-	0, 172, 0, 173, 0, 0, 0, 174, 175, 176,
-	0, 177, 363, 0, 364, 178, 0, 179, 180, 181,
-	182, 500, 184, 185, 186, 187, 0, 188, 189, 190,
-	191, 192, 193, 0, 194, 0, 365, 195, 196, 197,
-	198, 366, 367, 0, 368, 0, 199, 0, 200, 0,
-	201, 202, 203, 204, 205, 0, 0, 206, 369, 0,
-	207, 0, 0, 208, 209, 396, 0, 0, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
-	222, 223, 397, 370, 0, 371, 224, 225, 372, 0,
-	226, 227, 228, 0, 373, 229, 374, 230, 231, 232,
-	0, 233, 0, 0, 234, 235, 0, 0, 236, 375,
-	0, 237, 0, 376, 238, 239, 240, 241, 242, 243,
-	244, 0, 245, 246, 377, 247, 378, 250, 248, 249,
-	0, 251, 252, 253, 254, 255, 256, 257, 258, 379,
-	259, 260, 261, 262, 0, 263, 264, 265, 266, 267,
-	268, 269, 270, 271, 272, 273, 0, 274, 275, 0,
-	276, 277, 278, 380, 279, 280, 281, 282, 283, 284,
-	285, 286, 0, 287, 288, 289, 290, 398, 0, 291,
-	292, 381, 293, 294, 0, 295, 296, 382, 297, 0,
-	298, 299, 300, 301, 302, 303, 304, 305, 306, 307,
-	308, 383, 0, 309, 310, 0, 311, 0, 312, 313,
-	314, 315, 316, 0, 411, 384, 0, 0, 399, 317,
-	385, 318, 386, 0, 319, 320, 321, 322, 323, 324,
-	325, 0, 0, 326, 327, 328, 329, 330, 0, 0,
-	331, 332, 333, 334, 335, 387, 388, 0, 336, 0,
-	337, 338, 339, 340, 84, 0, 341, 0, 0, 342,
-	343, 344, 345, 346, 347, 348, 349, 87, 88, 89,
-	90, 91, 92, 93, 94, 0, 95, 96, 97, 0,
-	0, 0, 0, 0, 0, 0, 98, 99, 0, 100,
-	101, 0, 102, 103, 104, 350, 351, 0, 352, 0,
-	353, 0, 105, 106, 107, 108, 109, 0, 0, 395,
-	110, 354, 355, 111, 0, 112, 113, 114, 115, 356,
-	0, 0, 0, 116, 117, 118, 119, 120, 0, 0,
-	121, 122, 123, 0, 124, 125, 126, 127, 128, 129,
-	0, 0, 130, 131, 132, 0, 0, 0, 0, 0,
-	0, 0, 133, 134, 135, 136, 137, 357, 138, 139,
-	358, 359, 140, 0, 141, 0, 142, 143, 144, 145,
-	146, 0, 147, 148, 149, 0, 0, 150, 151, 152,
-	153, 154, 0, 155, 156, 157, 0, 158, 159, 160,
-	0, 161, 162, 163, 164, 360, 165, 166, 167, 361,
-	0, 168, 0, 169, 170, 362, 171, 0, 172, 0,
-	173, 0, 0, 0, 174, 175, 176, 0, 177, 363,
-	0, 364, 178, 0, 179, 180, 181, 182, 466, 184,
-	185, 186, 187, 0, 188, 189, 190, 191, 192, 193,
-	0, 194, 0, 365, 195, 196, 197, 198, 366, 367,
-	0, 368, 0, 199, 0, 200, 0, 201, 202, 203,
-	204, 205, 0, 0, 206, 369, 0, 207, 0, 0,
-	208, 209, 396, 0, 0, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 221, 222, 223, 397,
-	370, 0, 371, 224, 225, 372, 0, 226, 227, 228,
-	0, 373, 229, 374, 230, 231, 232, 0, 233, 0,
-	0, 234, 235, 0, 0, 236, 375, 0, 237, 0,
-	376, 238, 239, 240, 241, 242, 243, 244, 0, 245,
-	246, 377, 247, 378, 250, 248, 249, 0, 251, 252,
-	253, 254, 255, 256, 257, 258, 379, 259, 260, 261,
-	262, 0, 263, 264, 265, 266, 267, 268, 269, 270,
-	271, 272, 273, 0, 274, 275, 0, 276, 277, 278,
-	380, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 289, 290, 398, 0, 291, 292, 381, 293,
-	294, 0, 295, 296, 382, 297, 0, 298, 299, 300,
-	301, 302, 303, 304, 305, 306, 307, 308, 383, 0,
-	309, 310, 0, 311, 0, 312, 313, 314, 315, 316,
-	0, 411, 384, 0, 0, 399, 317, 385, 318, 386,
-	0, 319, 320, 321, 322, 323, 324, 325, 0, 0,
-	326, 327, 328, 329, 330, 0, 0, 331, 332, 333,
-	334, 335, 387, 388, 0, 336, 0, 337, 338, 339,
-	340, 84, 0, 341, 0, 0, 342, 343, 344, 345,
-	346, 347, 348, 349, 87, 88, 89, 90, 91, 92,
-	93, 94, 0, 95, 96, 97, 0, 0, 0, 0,
-	0, 0, 0, 98, 99, 0, 100, 101, 0, 102,
-	103, 104, 350, 351, 0, 352, 0, 353, 0, 105,
-	106, 107, 108, 109, 0, 0, 395, 110, 354, 355,
-	111, 0, 112, 113, 114, 115, 356, 0, 0, 0,
-	116, 117, 118, 119, 120, 0, 0, 121, 122, 123,
-	0, 124, 125, 126, 127, 128, 129, 0, 0, 130,
-	131, 132, 0, 0, 0, 0, 0, 0, 0, 133,
-	134, 135, 136, 137, 357, 138, 139, 358, 359, 140,
-	0, 141, 0, 142, 143, 144, 145, 146, 0, 147,
-	148, 149, 0, 0, 150, 151, 152, 153, 154, 0,
-	155, 156, 157, 0, 158, 159, 160, 0, 161, 162,
-	163, 164, 360, 165, 166, 167, 361, 0, 168, 0,
-	169, 170, 362, 171, 0, 172, 0, 173, 0, 0,
-	0, 174, 175, 176, 0, 177, 363, 0, 364, 178,
-	0, 179, 180, 181, 182, 464, 184, 185, 186, 187,
-	0, 188, 189, 190, 191, 192, 193, 0, 194, 0,
-	365, 195, 196, 197, 198, 366, 367, 0, 368, 0,
-	199, 0, 200, 0, 201, 202, 203, 204, 205, 0,
-	0, 206, 369, 0, 207, 0, 0, 208, 209, 396,
-	0, 0, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 221, 222, 223, 397, 370, 0, 371,
-	224, 225, 372, 0, 226, 227, 228, 0, 373, 229,
-	374, 230, 231, 232, 0, 233, 0, 0, 234, 235,
-	0, 0, 236, 375, 0, 237, 0, 376, 238, 239,
-	240, 241, 242, 243, 244, 0, 245, 246, 377, 247,
-	378, 250, 248, 249, 0, 251, 252, 253, 254, 255,
-	256, 257, 258, 379, 259, 260, 261, 262, 0, 263,
-	264, 265, 266, 267, 268, 269, 270, 271, 272, 273,
-	0, 274, 275, 0, 276, 277, 278, 380, 279, 280,
-	281, 282, 283, 284, 285, 286, 0, 287, 288, 289,
-	290, 398, 0, 291, 292, 381, 293, 294, 0, 295,
-	296, 382, 297, 0, 298, 299, 300, 301, 302, 303,
-	304, 305, 306, 307, 308, 383, 0, 309, 310, 0,
-	311, 0, 312, 313, 314, 315, 316, 0, 411, 384,
-	0, 0, 399, 317, 385, 318, 386, 0, 319, 320,
-	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
-	329, 330, 0, 0, 331, 332, 333, 334, 335, 387,
-	388, 0, 336, 0, 337, 338, 339, 340, 84, 0,
-	341, 0, 0, 342, 343, 344, 345, 346, 347, 348,
-	349, 87, 88, 89, 90, 91, 92, 93, 94, 0,
-	95, 96, 97, 0, 0, 0, 0, 0, 0, 0,
-	98, 99, 0, 100, 101, 0, 102, 103, 104, 350,
-	351, 0, 352, 0, 353, 0, 105, 106, 107, 108,
-	109, 0, 0, 395, 110, 354, 355, 111, 0, 112,
-	113, 114, 115, 356, 0, 0, 0, 116, 117, 118,
-	119, 120, 0, 0, 121, 122, 123, 0, 124, 125,
-	126, 127, 128, 129, 0, 0, 130, 131, 132, 0,
-	0, 0, 0, 0, 0, 0, 133, 134, 135, 136,
-	137, 357, 138, 139, 358, 359, 140, 0, 141, 0,
-	142, 143, 144, 145, 146, 0, 147, 148, 149, 0,
-	0, 150, 151, 152, 153, 154, 0, 155, 156, 157,
-	0, 158, 159, 160, 0, 161, 162, 163, 164, 360,
-	165, 166, 167, 361, 0, 168, 0, 169, 170, 362,
-	171, 0, 172, 0, 173, 0, 0, 0, 174, 175,
-	176, 0, 177, 363, 0, 364, 178, 0, 179, 180,
-	181, 182, 459, 184, 185, 186, 187, 0, 188, 189,
-	190, 191, 192, 193, 0, 194, 0, 365, 195, 196,
-	197, 198, 366, 367, 0, 368, 0, 199, 0, 200,
-	0, 201, 202, 203, 204, 205, 0, 0, 206, 369,
-	0, 207, 0, 0, 208, 209, 396, 0, 0, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	221, 222, 223, 397, 370, 0, 371, 224, 225, 372,
-	0, 226, 227, 228, 0, 373, 229, 374, 230, 231,
-	232, 0, 233, 0, 0, 234, 235, 0, 0, 236,
-	375, 0, 237, 0, 376, 238, 239, 240, 241, 242,
-	243, 244, 0, 245, 246, 377, 247, 378, 250, 248,
-	249, 0, 251, 252, 253, 254, 255, 256, 257, 258,
-	379, 259, 260, 261, 262, 0, 263, 264, 265, 266,
-	267, 268, 269, 270, 271, 272, 273, 0, 274, 275,
-	0, 276, 277, 278, 380, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 289, 290, 398, 0,
-	291, 292, 381, 293, 294, 0, 295, 296, 382, 297,
-	0, 298, 299, 300, 301, 302, 303, 304, 305, 306,
-	307, 308, 383, 0, 309, 310, 0, 311, 0, 312,
-	313, 314, 315, 316, 0, 411, 384, 0, 0, 399,
-	317, 385, 318, 386, 0, 319, 320, 321, 322, 323,
-	324, 325, 0, 0, 326, 327, 328, 329, 330, 0,
-	0, 331, 332, 333, 334, 335, 387, 388, 0, 336,
-	0, 337, 338, 339, 340, 84, 0, 341, 0, 0,
-	342, 343, 344, 345, 346, 347, 348, 349, 87, 88,
-	89, 90, 91, 92, 93, 94, 0, 95, 96, 97,
-	0, 0, 0, 0, 0, 0, 0, 98, 99, 0,
-	100, 101, 0, 102, 103, 104, 350, 351, 0, 352,
-	0, 353, 0, 105, 106, 107, 108, 109, 0, 0,
-	395, 110, 354, 355, 111, 0, 112, 113, 114, 115,
-	356, 0, 0, 0, 116, 117, 118, 119, 120, 0,
-	0, 121, 122, 123, 0, 124, 125, 126, 127, 128,
-	129, 0, 0, 130, 131, 132, 0, 0, 0, 0,
-	0, 0, 0, 133, 134, 135, 136, 137, 357, 138,
-	139, 358, 359, 140, 0, 141, 0, 142, 143, 144,
-	145, 146, 0, 147, 148, 149, 0, 0, 150, 151,
-	152, 153, 154, 0, 155, 156, 157, 0, 158, 159,
-	160, 0, 161, 162, 163, 164, 360, 165, 166, 167,
-	361, 0, 168, 0, 169, 170, 362, 171, 0, 172,
-	0, 173, 0, 0, 0, 174, 175, 176, 0, 177,
-	363, 0, 364, 178, 0, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 0, 188, 189, 190, 191, 192,
-	193, 0, 194, 0, 365, 195, 196, 197, 198, 366,
-	367, 0, 368, 0, 199, 0, 200, 0, 201, 202,
-	203, 204, 205, 0, 0, 206, 369, 0, 207, 0,
-	0, 208, 209, 396, 0, 0, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
-	397, 370, 0, 371, 224, 225, 372, 0, 226, 227,
-	228, 0, 373, 229, 374, 230, 231, 232, 0, 233,
-	0, 0, 234, 235, 0, 0, 236, 375, 0, 237,
-	0, 376, 238, 239, 240, 241, 242, 243, 244, 0,
-	245, 246, 377, 247, 378, 250, 248, 249, 0, 251,
-	252, 253, 254, 255, 256, 257, 258, 379, 259, 260,
-	439, 262, 0, 263, 264, 265, 266, 267, 268, 269,
-	270, 271, 272, 273, 0, 274, 275, 0, 276, 277,
-	278, 380, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 289, 290, 398, 0, 291, 292, 381,
-	293, 294, 0, 295, 296, 382, 297, 0, 298, 299,
-	300, 301, 302, 303, 304, 305, 306, 307, 308, 383,
-	0, 309, 310, 0, 311, 0, 312, 313, 314, 315,
-	316, 0, 411, 384, 0, 0, 399, 317, 385, 318,
-	386, 0, 319, 320, 321, 322, 323, 324, 325, 0,
-	0, 326, 327, 328, 329, 330, 0, 0, 331, 332,
-	333, 334, 335, 387, 388, 0, 336, 0, 337, 338,
-	339, 340, 84, 0, 341, 0, 0, 342, 343, 344,
-	345, 346, 347, 348, 349, 87, 88, 89, 90, 91,
-	92, 93, 94, 0, 95, 96, 97, 0, 0, 0,
-	0, 0, 0, 0, 98, 99, 0, 100, 101, 0,
-	102, 103, 104, 350, 351, 0, 352, 0, 353, 0,
-	105, 106, 107, 108, 109, 0, 0, 395, 110, 354,
-	355, 111, 0, 112, 113, 114, 115, 356, 0, 0,
-	0, 116, 117, 118, 119, 120, 0, 0, 121, 122,
-	123, 0, 124, 125, 126, 127, 128, 129, 0, 0,
-	130, 131, 132, 0, 0, 0, 0, 0, 0, 0,
-	133, 134, 135, 136, 137, 357, 138, 139, 358, 359,
-	140, 0, 141, 0, 142, 143, 144, 145, 146, 0,
-	147, 148, 149, 0, 0, 150, 151, 152, 153, 154,
-	0, 155, 156, 157, 0, 158, 159, 160, 0, 161,
-	162, 163, 164, 360, 165, 166, 167, 361, 0, 168,
-	0, 169, 170, 362, 171, 0, 172, 0, 173, 0,
-	0, 0, 174, 175, 176, 0, 177, 363, 0, 364,
-	178, 0, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 0, 188, 189, 190, 191, 192, 193, 0, 194,
-	0, 365, 195, 196, 197, 198, 366, 367, 0, 368,
-	0, 199, 0, 200, 0, 201, 202, 203, 204, 205,
-	0, 0, 206, 369, 0, 207, 0, 0, 208, 209,
-	396, 0, 0, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 221, 222, 223, 397, 370, 0,
-	371, 224, 225, 372, 0, 226, 227, 228, 0, 373,
-	229, 374, 230, 231, 232, 0, 233, 0, 0, 234,
-	235, 0, 0, 236, 375, 0, 237, 0, 376, 238,
-	239, 240, 241, 242, 243, 244, 0, 245, 246, 377,
-	247, 378, 250, 248, 249, 0, 251, 252, 253, 254,
-	255, 256, 257, 258, 379, 259, 260, 261, 262, 0,
-	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
-	273, 0, 274, 275, 0, 276, 277, 278, 380, 279,
-	280, 412, 282, 283, 284, 285, 286, 0, 287, 288,
-	289, 290, 398, 0, 291, 292, 381, 293, 294, 0,
-	295, 296, 382, 297, 0, 298, 299, 300, 301, 302,
-	303, 304, 305, 306, 307, 308, 383, 0, 309, 310,
-	0, 311, 0, 312, 313, 314, 315, 316, 0, 411,
-	384, 0, 0, 399, 317, 385, 318, 386, 0, 319,
-	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
-	328, 329, 330, 0, 0, 331, 332, 333, 334, 335,
-	387, 388, 0, 336, 0, 337, 338, 339, 340, 84,
-	0, 341, 0, 0, 342, 343, 344, 345, 346, 347,
-	348, 349, 87, 88, 89, 90, 91, 92, 93, 94,
-	0, 95, 96, 97, 0, 0, 0, 0, 0, 0,
-	0, 98, 99, 0, 100, 101, 0, 102, 103, 104,
-	350, 351, 0, 352, 0, 353, 0, 105, 106, 107,
-	108, 109, 0, 0, 81, 110, 354, 355, 111, 0,
-	112, 113, 114, 115, 356, 0, 0, 0, 116, 117,
-	118, 119, 120, 0, 0, 121, 122, 123, 0, 124,
-	125, 126, 127, 128, 129, 0, 0, 130, 131, 132,
-	0, 0, 0, 0, 0, 0, 0, 133, 134, 135,
-	136, 137, 357, 138, 139, 358, 359, 140, 0, 141,
-	0, 142, 143, 144, 145, 146, 0, 147, 148, 149,
-	0, 0, 150, 151, 152, 153, 154, 0, 155, 156,
-	157, 0, 158, 159, 160, 0, 161, 162, 163, 164,
-	360, 165, 166, 167, 361, 0, 168, 0, 169, 170,
-	362, 171, 0, 172, 0, 173, 0, 0, 0, 174,
-	175, 176, 0, 177, 363, 0, 364, 178, 0, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 0, 188,
-	189, 190, 191, 192, 193, 0, 194, 0, 365, 195,
-	196, 197, 198, 366, 367, 0, 368, 0, 199, 0,
-	200, 0, 201, 202, 203, 204, 205, 0, 0, 206,
-	369, 0, 207, 0, 0, 208, 209, 74, 0, 0,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 221, 222, 223, 82, 370, 0, 371, 224, 225,
-	372, 0, 226, 227, 228, 0, 373, 229, 374, 230,
-	231, 232, 0, 233, 0, 0, 234, 235, 0, 0,
-	236, 375, 0, 237, 0, 376, 238, 239, 240, 241,
-	242, 243, 244, 0, 245, 246, 377, 247, 378, 250,
-	248, 249, 0, 251, 252, 253, 254, 255, 256, 257,
-	258, 379, 259, 260, 261, 262, 0, 263, 264, 265,
-	266, 267, 268, 269, 270, 271, 272, 273, 0, 274,
-	275, 0, 276, 277, 278, 380, 279, 280, 281, 282,
-	283, 284, 285, 286, 0, 287, 288, 289, 290, 75,
-	0, 291, 292, 381, 293, 294, 0, 295, 296, 382,
-	297, 0, 298, 299, 300, 301, 302, 303, 304, 305,
-	306, 307, 308, 383, 0, 309, 310, 0, 311, 0,
-	312, 313, 314, 315, 316, 0, 80, 384, 0, 0,
-	76, 317, 385, 318, 386, 0, 319, 320, 321, 322,
-	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
-	0, 0, 331, 332, 333, 334, 335, 387, 388, 0,
-	336, 0, 337, 338, 339, 340, 1808, 0, 341, 0,
-	0, 342, 343, 344, 345, 346, 347, 348, 349, 87,
-	88, 89, 90, 91, 92, 93, 94, 0, 95, 96,
-	97, 0, 0, 0, 0, 0, 0, 0, 98, 99,
-	0, 100, 101, 477, 102, 103, 104, 0, 1154, 478,
-	1169, 1149, 1161, 0, 105, 106, 107, 108, 109, 0,
-	0, 395, 110, 1171, 1170, 111, 0, 112, 113, 114,
-	115, 0, 0, 479, 0, 116, 117, 118, 119, 120,
-	0, 480, 121, 122, 123, 0, 124, 125, 126, 127,
-	128, 129, 0, 481, 130, 131, 132, 0, 0, 0,
-	482, 0, 0, 0, 133, 134, 135, 136, 137, 1166,
-	138, 139, 1159, 1158, 140, 0, 141, 0, 142, 143,
-	144, 145, 146, 0, 147, 148, 149, 0, 0, 150,
-	151, 641, 153, 154, 0, 155, 156, 157, 0, 158,
-	159, 160, 0, 161, 162, 163, 164, 0, 165, 166,
-	167, 0, 0, 168, 0, 169, 170, 1156, 171, 0,
-	172, 0, 173, 483, 0, 484, 174, 175, 176, 0,
-	177, 0, 0, 0, 178, 0, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 0, 188, 189, 190, 191,
-	192, 193, 0, 194, 485, 0, 195, 196, 197, 198,
-	1151, 1152, 0, 766, 0, 199, 486, 200, 487, 201,
-	202, 203, 204, 205, 0, 0, 206, 0, 488, 207,
-	489, 0, 208, 209, 396, 0, 0, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
-	223, 397, 0, 490, 0, 224, 225, 0, 0, 226,
-	227, 228, 0, 0, 229, 1160, 230, 231, 232, 0,
-	233, 0, 0, 234, 235, 0, 0, 236, 0, 491,
-	237, 492, 0, 238, 239, 240, 241, 242, 243, 244,
-	0, 245, 246, 0, 247, 0, 250, 248, 249, 0,
-	251, 252, 253, 254, 255, 256, 257, 258, 1155, 259,
-	260, 261, 262, 0, 263, 264, 265, 266, 267, 268,
-	269, 270, 271, 272, 273, 0, 274, 275, 493, 276,
-	277, 278, 0, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 289, 290, 398, 0, 291, 292,
-	0, 293, 294, 494, 295, 296, 1153, 297, 0, 298,
-	299, 300, 301, 302, 303, 304, 305, 306, 307, 308,
-	0, 0, 309, 310, 0, 311, 495, 312, 313, 314,
-	315, 1810, 0, 1168, 1167, 0, 0, 399, 317, 0,
-	318, 0, 0, 319, 320, 321, 322, 323, 324, 325,
-	0, 0, 326, 327, 328, 329, 330, 0, 0, 331,
-	332, 333, 334, 335, 0, 1172, 0, 336, 496, 337,
-	338, 339, 340, 84, 0, 341, 0, 0, 342, 343,
-	344, 345, 346, 347, 348, 349, 87, 88, 89, 90,
-	91, 92, 93, 94, 0, 95, 96, 97, 0, 0,
-	0, 0, 0, 0, 0, 98, 99, 0, 100, 101,
-	0, 102, 103, 104, 350, 351, 0, 352, 0, 353,
-	0, 105, 106, 107, 108, 109, 0, 0, 395, 110,
-	354, 355, 111, 0, 112, 113, 114, 115, 356, 0,
-	0, 0, 116, 117, 118, 119, 120, 0, 0, 121,
-	122, 123, 0, 124, 125, 126, 127, 128, 129, 0,
-	0, 130, 131, 132, 0, 0, 0, 0, 0, 0,
-	0, 133, 134, 135, 136, 137, 357, 138, 139, 358,
-	359, 140, 0, 141, 0, 142, 143, 144, 145, 146,
-	0, 147, 148, 149, 0, 0, 150, 151, 152, 153,
-	154, 0, 155, 156, 157, 0, 158, 159, 160, 0,
-	161, 162, 163, 164, 360, 165, 166, 167, 361, 0,
-	168, 0, 169, 170, 362, 171, 0, 172, 0, 173,
-	0, 0, 0, 174, 175, 176, 0, 177, 363, 0,
-	364, 178, 0, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 0, 188, 189, 190, 191, 192, 193, 0,
-	194, 0, 365, 195, 196, 197, 198, 366, 367, 0,
-	368, 0, 199, 0, 200, 0, 201, 202, 203, 204,
-	205, 0, 0, 206, 369, 0, 207, 0, 0, 208,
-	209, 396, 0, 0, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 222, 223, 397, 370,
-	0, 371, 224, 225, 372, 0, 226, 227, 228, 0,
-	373, 229, 374, 230, 231, 232, 0, 233, 0, 0,
-	234, 235, 0, 0, 236, 375, 0, 237, 0, 376,
-	238, 239, 240, 241, 0, 243, 244, 0, 245, 246,
-	377, 247, 378, 250, 248, 249, 0, 251, 252, 253,
-	254, 255, 256, 0, 258, 379, 259, 260, 261, 262,
-	0, 263, 264, 265, 266, 267, 268, 269, 270, 271,
-	272, 273, 0, 274, 275, 0, 276, 277, 278, 380,
-	0, 280, 281, 282, 283, 284, 285, 286, 0, 287,
-	288, 289, 290, 398, 0, 291, 292, 381, 293, 294,
-	0, 295, 296, 382, 297, 0, 298, 299, 300, 301,
-	302, 303, 304, 305, 306, 307, 308, 383, 0, 309,
-	310, 0, 311, 0, 312, 313, 314, 315, 316, 0,
-	411, 384, 0, 0, 399, 317, 385, 318, 386, 0,
-	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
-	327, 328, 329, 330, 0, 0, 331, 332, 333, 334,
-	335, 387, 388, 0, 336, 0, 337, 338, 339, 340,
-	0, 0, 341, 0, 0, 342, 343, 344, 345, 346,
-	347, 348, 349, 823, 0, 839, 840, 841, 0, 0,
+	746, 1461, 1462, 1463, 0, 1452, 1453, 1454, 1455, 1456,
+	1458, 1459, 1457, 1460, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2007, 0, 0, 0, 0, 0, 0, 746,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1461, 1462, 1463, 0, 1452, 1453,
+	1454, 1455, 1456, 1458, 1459, 1457, 1460, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 745, 0, 0, 735, 736, 737, 0,
+	726, 727, 728, 729, 730, 732, 733, 731, 734, 0,
+	0, 0, 0, 0, 39, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 760, 0, 0,
+	0, 0, 745, 1365, 0, 735, 736, 737, 0, 726,
+	727, 728, 729, 730, 732, 733, 731, 734, 0, 0,
+	0, 0, 0, 1992, 0, 0, 0, 0, 0, 0,
+	0, 1925, 841, 1919, 0, 0, 846, 0, 0, 0,
+	1602, 1603, 1604, 760, 112, 113, 114, 115, 116, 117,
+	118, 119, 989, 120, 121, 122, 990, 991, 992, 993,
+	994, 995, 996, 123, 124, 997, 125, 126, 577, 127,
+	128, 129, 0, 1083, 578, 1098, 1078, 1090, 998, 130,
+	131, 132, 133, 134, 135, 999, 1000, 425, 136, 1100,
+	1099, 137, 1001, 138, 139, 140, 141, 0, 1002, 620,
+	1003, 142, 143, 144, 145, 146, 1601, 579, 147, 148,
+	149, 1004, 150, 151, 152, 153, 154, 155, 1005, 580,
+	156, 157, 158, 1006, 1007, 1008, 621, 1009, 1010, 1011,
+	159, 160, 161, 162, 163, 1095, 164, 165, 1088, 1087,
+	166, 1012, 167, 1013, 168, 169, 170, 171, 172, 1014,
+	173, 174, 175, 1015, 1016, 176, 177, 554, 179, 180,
+	1017, 181, 182, 183, 1018, 184, 185, 186, 1019, 187,
+	188, 189, 190, 0, 191, 192, 193, 0, 1020, 194,
+	1021, 195, 196, 1085, 197, 1022, 198, 1023, 199, 581,
+	1024, 582, 200, 201, 202, 1025, 203, 204, 0, 1026,
+	0, 205, 1027, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 1028, 215, 216, 217, 218, 219, 220, 1029,
+	221, 583, 0, 222, 223, 224, 225, 1080, 1081, 1030,
+	912, 1031, 226, 584, 227, 585, 228, 229, 230, 231,
+	232, 1032, 1033, 233, 0, 586, 234, 587, 1034, 235,
+	236, 426, 1035, 1036, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 0,
+	588, 0, 251, 252, 0, 1037, 253, 254, 255, 1038,
+	0, 256, 1089, 257, 258, 259, 1039, 260, 1040, 1041,
+	261, 262, 1042, 1043, 263, 0, 589, 264, 590, 0,
+	265, 266, 267, 268, 269, 270, 271, 1044, 272, 273,
+	0, 274, 0, 277, 275, 276, 1045, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 1084, 287, 288, 289,
+	290, 1046, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 1047, 302, 303, 591, 304, 305, 306,
+	0, 307, 308, 309, 310, 311, 312, 313, 314, 1048,
+	315, 316, 1073, 317, 318, 428, 1049, 319, 320, 1922,
+	321, 322, 592, 323, 324, 1082, 325, 1050, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 0,
+	1051, 337, 338, 1052, 339, 593, 340, 341, 342, 343,
+	1927, 1053, 1097, 1096, 1054, 1055, 429, 345, 0, 346,
+	0, 1056, 347, 348, 349, 350, 351, 352, 353, 1057,
+	1058, 354, 355, 356, 357, 358, 1059, 1060, 359, 360,
+	361, 362, 363, 0, 1101, 1061, 364, 594, 365, 366,
+	367, 368, 1062, 1063, 369, 1064, 1065, 370, 371, 372,
+	373, 374, 375, 376, 377, 0, 0, 0, 1598, 1599,
+	1600, 984, 1923, 1924, 1591, 1592, 1593, 1594, 1595, 1596,
+	1597, 0, 0, 0, 112, 113, 114, 115, 116, 117,
+	118, 119, 989, 120, 121, 122, 990, 991, 992, 993,
+	994, 995, 996, 123, 124, 997, 125, 126, 577, 127,
+	128, 129, 378, 379, 578, 380, 0, 381, 998, 130,
+	131, 132, 133, 134, 135, 999, 1000, 425, 136, 382,
+	383, 137, 1001, 138, 139, 140, 141, 384, 1002, 620,
+	1003, 142, 143, 144, 145, 146, 0, 579, 147, 148,
+	149, 1004, 150, 151, 152, 153, 154, 155, 1005, 580,
+	156, 157, 158, 1006, 1007, 1008, 621, 1009, 1010, 1011,
+	159, 160, 161, 162, 163, 385, 164, 165, 386, 387,
+	166, 1012, 167, 1013, 168, 169, 170, 171, 172, 1014,
+	173, 174, 175, 1015, 1016, 176, 177, 178, 179, 180,
+	1017, 181, 182, 183, 1018, 184, 185, 186, 1019, 187,
+	188, 189, 190, 388, 191, 192, 193, 389, 1020, 194,
+	1021, 195, 196, 390, 197, 1022, 198, 1023, 199, 581,
+	1024, 582, 200, 201, 202, 1025, 203, 204, 391, 1026,
+	392, 205, 1027, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 1028, 215, 216, 217, 218, 219, 220, 1029,
+	221, 583, 393, 222, 223, 224, 225, 394, 395, 1030,
+	396, 1031, 226, 584, 227, 585, 228, 229, 230, 231,
+	232, 1032, 1033, 233, 397, 586, 234, 587, 1034, 235,
+	236, 426, 1035, 1036, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 398,
+	588, 399, 251, 252, 400, 1037, 253, 254, 255, 1038,
+	401, 256, 402, 257, 258, 259, 1039, 260, 1040, 1041,
+	261, 262, 1042, 1043, 263, 403, 589, 264, 590, 404,
+	265, 266, 267, 268, 269, 270, 271, 1044, 272, 273,
+	405, 274, 406, 277, 275, 276, 1045, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 407, 287, 288, 289,
+	290, 1046, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 1047, 302, 303, 591, 304, 305, 306,
+	408, 307, 308, 309, 310, 311, 312, 313, 314, 1048,
+	315, 316, 409, 317, 318, 428, 1049, 319, 320, 410,
+	321, 322, 592, 323, 324, 411, 325, 1050, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 412,
+	1051, 337, 338, 1052, 339, 593, 340, 341, 342, 343,
+	344, 1053, 441, 413, 1054, 1055, 429, 345, 414, 346,
+	415, 1056, 347, 348, 349, 350, 351, 352, 353, 1057,
+	1058, 354, 355, 356, 357, 358, 1059, 1060, 359, 360,
+	361, 362, 363, 416, 417, 1061, 364, 594, 365, 366,
+	367, 368, 1062, 1063, 369, 1064, 1065, 370, 371, 372,
+	373, 374, 375, 376, 377, 984, 0, 0, 0, 0,
+	0, 0, 0, 0, 983, 0, 0, 0, 112, 113,
+	114, 115, 116, 117, 118, 119, 989, 120, 121, 122,
+	990, 991, 992, 993, 994, 995, 996, 123, 124, 997,
+	125, 126, 577, 127, 128, 129, 378, 379, 578, 380,
+	0, 381, 998, 130, 131, 132, 133, 134, 135, 999,
+	1000, 425, 136, 382, 383, 137, 1001, 138, 139, 140,
+	141, 384, 1002, 620, 1003, 142, 143, 144, 145, 146,
+	0, 579, 147, 148, 149, 1004, 150, 151, 152, 153,
+	154, 155, 1005, 580, 156, 157, 158, 1006, 1007, 1008,
+	621, 1009, 1010, 1011, 159, 160, 161, 162, 163, 385,
+	164, 165, 386, 387, 166, 1012, 167, 1013, 168, 169,
+	170, 171, 172, 1014, 173, 174, 175, 1015, 1016, 176,
+	177, 178, 179, 180, 1017, 181, 182, 183, 1018, 184,
+	185, 186, 1019, 187, 188, 189, 190, 388, 191, 192,
+	193, 389, 1020, 194, 1021, 195, 196, 390, 197, 1022,
+	198, 1023, 199, 581, 1024, 582, 200, 201, 202, 1025,
+	203, 204, 391, 1026, 392, 205, 1027, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 1028, 215, 216, 217,
+	218, 219, 220, 1029, 221, 583, 393, 222, 223, 224,
+	225, 394, 395, 1030, 396, 1031, 226, 584, 227, 585,
+	228, 229, 230, 231, 232, 1032, 1033, 233, 397, 586,
+	234, 587, 1034, 235, 236, 426, 1035, 1036, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 588, 399, 251, 252, 400, 1037,
+	253, 254, 255, 1038, 401, 256, 402, 257, 258, 259,
+	1039, 260, 1040, 1041, 261, 262, 1042, 1043, 263, 403,
+	589, 264, 590, 404, 265, 266, 267, 268, 269, 270,
+	271, 1044, 272, 273, 405, 274, 406, 277, 275, 276,
+	1045, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	407, 287, 288, 289, 290, 1046, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 1047, 302, 303,
+	591, 304, 305, 306, 408, 307, 308, 309, 310, 311,
+	312, 313, 314, 1048, 315, 316, 409, 317, 318, 428,
+	1049, 319, 320, 410, 321, 322, 592, 323, 324, 411,
+	325, 1050, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 412, 1051, 337, 338, 1052, 339, 593,
+	340, 341, 342, 343, 344, 1053, 441, 413, 1054, 1055,
+	429, 345, 414, 346, 415, 1056, 347, 348, 349, 350,
+	351, 352, 353, 1057, 1058, 354, 355, 356, 357, 358,
+	1059, 1060, 359, 360, 361, 362, 363, 416, 417, 1061,
+	364, 594, 365, 366, 367, 368, 1062, 1063, 369, 1064,
+	1065, 370, 371, 372, 373, 374, 375, 376, 377, 523,
+	510, 511, 512, 513, 509, 497, 0, 0, 0, 0,
+	0, 0, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 503, 0,
+	0, 123, 124, 0, 125, 126, 577, 127, 128, 129,
+	378, 555, 578, 556, 0, 557, 0, 130, 131, 132,
+	133, 134, 135, 520, 543, 425, 136, 558, 559, 137,
+	0, 138, 139, 140, 141, 551, 0, 531, 0, 142,
+	143, 144, 145, 146, 0, 579, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 580, 156, 157,
+	158, 541, 532, 537, 542, 533, 534, 538, 159, 160,
+	161, 162, 163, 560, 164, 165, 561, 562, 166, 0,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 554, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 502, 191, 192, 193, 544, 518, 194, 0, 195,
+	196, 563, 197, 0, 198, 0, 199, 581, 0, 582,
+	200, 201, 202, 0, 203, 204, 552, 0, 506, 205,
+	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 583,
+	393, 222, 223, 224, 225, 564, 565, 0, 530, 0,
+	226, 584, 227, 585, 228, 229, 230, 231, 232, 0,
+	0, 233, 553, 586, 234, 587, 0, 235, 236, 426,
+	535, 536, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 588, 399,
+	251, 252, 400, 491, 253, 254, 255, 519, 550, 256,
+	566, 257, 258, 259, 0, 260, 0, 0, 261, 262,
+	0, 0, 263, 403, 589, 264, 590, 545, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 546, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 567, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 591, 304, 305, 306, 507, 307,
+	308, 309, 310, 311, 312, 313, 314, 59, 315, 316,
+	409, 317, 318, 428, 539, 319, 320, 410, 321, 322,
+	592, 323, 324, 568, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 547, 0, 337,
+	338, 61, 339, 593, 340, 341, 342, 343, 344, 0,
+	569, 570, 0, 0, 429, 345, 548, 346, 549, 517,
+	347, 348, 349, 350, 351, 352, 353, 0, 494, 354,
+	355, 356, 357, 358, 540, 0, 359, 360, 361, 362,
+	363, 615, 571, 0, 364, 594, 365, 366, 367, 368,
+	0, 0, 369, 0, 57, 370, 371, 372, 373, 374,
+	375, 376, 377, 492, 0, 58, 0, 0, 0, 0,
+	488, 489, 523, 510, 511, 512, 513, 509, 497, 0,
+	490, 0, 0, 498, 2059, 112, 113, 114, 115, 116,
+	117, 118, 119, 1163, 120, 121, 122, 0, 0, 0,
+	0, 503, 0, 0, 123, 124, 0, 125, 126, 577,
+	127, 128, 129, 378, 555, 578, 556, 0, 557, 0,
+	130, 131, 132, 133, 134, 135, 520, 543, 425, 136,
+	558, 559, 137, 0, 138, 139, 140, 141, 551, 0,
+	531, 0, 142, 143, 144, 145, 146, 0, 579, 147,
+	148, 149, 0, 150, 151, 152, 153, 154, 155, 0,
+	580, 156, 157, 158, 541, 532, 537, 542, 533, 534,
+	538, 159, 160, 161, 162, 163, 560, 164, 165, 561,
+	562, 166, 0, 167, 0, 168, 169, 170, 171, 172,
+	0, 173, 174, 175, 1164, 0, 176, 177, 554, 179,
+	180, 0, 181, 182, 183, 0, 184, 185, 186, 0,
+	187, 188, 189, 190, 502, 191, 192, 193, 544, 518,
+	194, 0, 195, 196, 563, 197, 0, 198, 0, 199,
+	581, 0, 582, 200, 201, 202, 0, 203, 204, 552,
+	0, 506, 205, 0, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 0, 215, 216, 217, 218, 219, 220,
+	0, 221, 583, 393, 222, 223, 224, 225, 564, 565,
+	0, 530, 0, 226, 584, 227, 585, 228, 229, 230,
+	231, 232, 0, 0, 233, 553, 586, 234, 587, 0,
+	235, 236, 426, 535, 536, 237, 238, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 427,
+	398, 588, 399, 251, 252, 400, 491, 253, 254, 255,
+	519, 550, 256, 566, 257, 258, 259, 0, 260, 0,
+	0, 261, 262, 0, 0, 263, 403, 589, 264, 590,
+	545, 265, 266, 267, 268, 269, 270, 271, 0, 272,
+	273, 546, 274, 406, 277, 275, 276, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 567, 287, 288,
+	289, 290, 0, 291, 292, 293, 294, 295, 296, 297,
+	298, 299, 300, 301, 0, 302, 303, 591, 304, 305,
+	306, 507, 307, 308, 309, 310, 311, 312, 313, 314,
+	0, 315, 316, 409, 317, 318, 428, 539, 319, 320,
+	410, 321, 322, 592, 323, 324, 568, 325, 0, 326,
+	327, 328, 329, 330, 331, 332, 333, 334, 335, 336,
+	547, 0, 337, 338, 0, 339, 593, 340, 341, 342,
+	343, 344, 0, 569, 570, 0, 0, 429, 345, 548,
+	346, 549, 517, 347, 348, 349, 350, 351, 352, 353,
+	0, 494, 354, 355, 356, 357, 358, 540, 0, 359,
+	360, 361, 362, 363, 416, 571, 1162, 364, 594, 365,
+	366, 367, 368, 0, 0, 369, 0, 0, 370, 371,
+	372, 373, 374, 375, 376, 377, 492, 0, 0, 0,
+	0, 0, 0, 488, 489, 1165, 523, 510, 511, 512,
+	513, 509, 497, 490, 0, 0, 498, 1160, 0, 112,
+	113, 114, 115, 116, 117, 118, 119, 0, 120, 121,
+	122, 0, 0, 0, 0, 503, 0, 0, 123, 124,
+	0, 125, 126, 577, 127, 128, 129, 378, 555, 578,
+	556, 0, 557, 0, 130, 131, 132, 133, 134, 135,
+	520, 543, 425, 136, 558, 559, 137, 0, 138, 139,
+	140, 141, 551, 0, 531, 0, 142, 143, 144, 145,
+	146, 0, 579, 147, 148, 149, 0, 150, 151, 152,
+	153, 154, 155, 0, 580, 156, 157, 158, 541, 532,
+	537, 542, 533, 534, 538, 159, 160, 161, 162, 163,
+	560, 164, 165, 561, 562, 166, 722, 167, 0, 168,
+	169, 170, 171, 172, 0, 173, 174, 175, 0, 0,
+	176, 177, 554, 179, 180, 0, 181, 182, 183, 0,
+	184, 185, 186, 0, 187, 188, 189, 190, 502, 191,
+	192, 193, 544, 518, 194, 0, 195, 196, 563, 197,
+	0, 198, 0, 199, 581, 0, 582, 200, 201, 202,
+	0, 203, 204, 552, 0, 506, 205, 0, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 0, 215, 216,
+	217, 218, 219, 220, 0, 221, 583, 393, 222, 223,
+	224, 225, 564, 565, 0, 530, 0, 226, 584, 227,
+	585, 228, 229, 230, 231, 232, 0, 0, 233, 553,
+	586, 234, 587, 0, 235, 236, 426, 535, 536, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+	248, 249, 250, 427, 398, 588, 399, 251, 252, 400,
+	491, 253, 254, 255, 519, 550, 256, 566, 257, 258,
+	259, 0, 260, 0, 0, 261, 262, 0, 0, 263,
+	403, 589, 264, 590, 545, 265, 266, 267, 268, 269,
+	270, 271, 0, 272, 273, 546, 274, 406, 277, 275,
+	276, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 567, 287, 288, 289, 290, 0, 291, 292, 293,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 591, 304, 305, 306, 507, 307, 308, 309, 310,
+	311, 312, 313, 314, 59, 315, 316, 409, 317, 318,
+	428, 539, 319, 320, 410, 321, 322, 592, 323, 324,
+	568, 325, 0, 326, 327, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 547, 0, 337, 338, 61, 339,
+	593, 340, 341, 342, 343, 344, 0, 569, 570, 0,
+	0, 429, 345, 548, 346, 549, 517, 347, 348, 349,
+	350, 351, 352, 353, 0, 494, 354, 355, 356, 357,
+	358, 540, 0, 359, 360, 361, 362, 363, 615, 571,
+	0, 364, 594, 365, 366, 367, 368, 0, 0, 369,
+	0, 57, 370, 371, 372, 373, 374, 375, 376, 377,
+	492, 0, 58, 0, 0, 0, 0, 488, 489, 523,
+	510, 511, 512, 513, 509, 497, 0, 490, 0, 0,
+	498, 0, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 503, 0,
+	0, 123, 124, 0, 125, 126, 577, 127, 128, 129,
+	378, 555, 578, 556, 0, 557, 0, 130, 131, 132,
+	133, 134, 135, 520, 543, 425, 136, 558, 559, 137,
+	0, 138, 139, 140, 141, 551, 0, 531, 0, 142,
+	143, 144, 145, 146, 0, 579, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 580, 156, 157,
+	158, 541, 532, 537, 542, 533, 534, 538, 159, 160,
+	161, 162, 163, 560, 164, 165, 561, 562, 166, 0,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 554, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 502, 191, 192, 193, 544, 518, 194, 0, 195,
+	196, 563, 197, 0, 198, 0, 199, 581, 0, 582,
+	200, 201, 202, 0, 203, 204, 552, 0, 506, 205,
+	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 583,
+	393, 222, 223, 224, 225, 564, 565, 0, 530, 0,
+	226, 584, 227, 585, 228, 229, 230, 231, 232, 0,
+	0, 233, 553, 586, 234, 587, 0, 235, 236, 426,
+	535, 536, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 588, 399,
+	251, 252, 400, 491, 253, 254, 255, 519, 550, 256,
+	566, 257, 258, 259, 0, 260, 0, 0, 261, 262,
+	0, 0, 263, 403, 589, 264, 590, 545, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 546, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 567, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 591, 304, 305, 306, 507, 307,
+	308, 309, 310, 311, 312, 313, 314, 59, 315, 316,
+	409, 317, 318, 428, 539, 319, 320, 410, 321, 322,
+	592, 323, 324, 568, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 547, 0, 337,
+	338, 61, 339, 593, 340, 341, 342, 343, 344, 0,
+	569, 570, 0, 0, 429, 345, 548, 346, 549, 517,
+	347, 348, 349, 350, 351, 352, 353, 0, 494, 354,
+	355, 356, 357, 358, 540, 0, 359, 360, 361, 362,
+	363, 615, 571, 0, 364, 594, 365, 366, 367, 368,
+	0, 0, 369, 0, 57, 370, 371, 372, 373, 374,
+	375, 376, 377, 492, 0, 58, 0, 0, 0, 0,
+	488, 489, 523, 510, 511, 512, 513, 509, 497, 0,
+	490, 0, 0, 498, 0, 112, 113, 114, 115, 116,
+	117, 118, 119, 0, 120, 121, 122, 0, 0, 0,
+	0, 503, 0, 0, 123, 124, 0, 125, 126, 577,
+	127, 128, 129, 378, 555, 578, 556, 0, 557, 1211,
+	130, 131, 132, 133, 134, 135, 520, 543, 425, 136,
+	558, 559, 137, 0, 138, 139, 140, 141, 551, 0,
+	531, 0, 142, 143, 144, 145, 146, 0, 579, 147,
+	148, 149, 0, 150, 151, 152, 153, 154, 155, 0,
+	580, 156, 157, 158, 541, 532, 537, 542, 533, 534,
+	538, 159, 160, 161, 162, 163, 560, 164, 165, 561,
+	562, 166, 0, 167, 0, 168, 169, 170, 171, 172,
+	0, 173, 174, 175, 0, 0, 176, 177, 554, 179,
+	180, 0, 181, 182, 183, 0, 184, 185, 186, 0,
+	187, 188, 189, 190, 502, 191, 192, 193, 544, 518,
+	194, 0, 195, 196, 563, 197, 0, 198, 0, 199,
+	581, 1216, 582, 200, 201, 202, 0, 203, 204, 552,
+	0, 506, 205, 0, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 0, 215, 216, 217, 218, 219, 220,
+	0, 221, 583, 393, 222, 223, 224, 225, 564, 565,
+	0, 530, 0, 226, 584, 227, 585, 228, 229, 230,
+	231, 232, 0, 1212, 233, 553, 586, 234, 587, 0,
+	235, 236, 426, 535, 536, 237, 238, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 427,
+	398, 588, 399, 251, 252, 400, 491, 253, 254, 255,
+	519, 550, 256, 566, 257, 258, 259, 0, 260, 0,
+	0, 261, 262, 0, 0, 263, 403, 589, 264, 590,
+	545, 265, 266, 267, 268, 269, 270, 271, 0, 272,
+	273, 546, 274, 406, 277, 275, 276, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 567, 287, 288,
+	289, 290, 0, 291, 292, 293, 294, 295, 296, 297,
+	298, 299, 300, 301, 0, 302, 303, 591, 304, 305,
+	306, 507, 307, 308, 309, 310, 311, 312, 313, 314,
+	0, 315, 316, 409, 317, 318, 428, 539, 319, 320,
+	410, 321, 322, 592, 323, 324, 568, 325, 0, 326,
+	327, 328, 329, 330, 331, 332, 333, 334, 335, 336,
+	547, 0, 337, 338, 0, 339, 593, 340, 341, 342,
+	343, 344, 0, 569, 570, 0, 1213, 429, 345, 548,
+	346, 549, 517, 347, 348, 349, 350, 351, 352, 353,
+	0, 494, 354, 355, 356, 357, 358, 540, 0, 359,
+	360, 361, 362, 363, 416, 571, 0, 364, 594, 365,
+	366, 367, 368, 0, 0, 369, 0, 0, 370, 371,
+	372, 373, 374, 375, 376, 377, 492, 0, 0, 0,
+	0, 0, 0, 488, 489, 523, 510, 511, 512, 513,
+	509, 497, 0, 490, 0, 0, 498, 0, 112, 113,
+	114, 115, 116, 117, 118, 119, 0, 120, 121, 122,
+	0, 0, 0, 0, 503, 0, 0, 123, 124, 0,
+	125, 126, 577, 127, 128, 129, 378, 555, 578, 556,
+	0, 557, 0, 130, 131, 132, 133, 134, 135, 520,
+	543, 425, 136, 558, 559, 137, 0, 138, 139, 140,
+	141, 551, 0, 531, 0, 142, 143, 144, 145, 146,
+	0, 579, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 580, 156, 157, 158, 541, 532, 537,
+	542, 533, 534, 538, 159, 160, 161, 162, 163, 560,
+	164, 165, 561, 562, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 554, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 502, 191, 192,
+	193, 544, 518, 194, 0, 195, 196, 563, 197, 0,
+	198, 0, 199, 581, 0, 582, 200, 201, 202, 0,
+	203, 204, 552, 0, 506, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 217,
+	218, 219, 220, 0, 221, 583, 393, 222, 223, 224,
+	225, 564, 565, 0, 530, 0, 226, 584, 227, 585,
+	228, 229, 230, 231, 232, 0, 0, 233, 553, 586,
+	234, 587, 0, 235, 236, 426, 535, 536, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 588, 399, 251, 252, 400, 491,
+	253, 254, 255, 519, 550, 256, 566, 257, 258, 259,
+	0, 260, 0, 0, 261, 262, 0, 0, 263, 403,
+	589, 264, 590, 545, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 546, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	567, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	591, 304, 305, 306, 507, 307, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 428,
+	539, 319, 320, 410, 321, 322, 592, 323, 324, 568,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 547, 0, 337, 338, 0, 339, 593,
+	340, 341, 342, 343, 344, 0, 569, 570, 0, 0,
+	429, 345, 548, 346, 549, 517, 347, 348, 349, 350,
+	351, 352, 353, 0, 494, 354, 355, 356, 357, 358,
+	540, 0, 359, 360, 361, 362, 363, 416, 571, 0,
+	364, 594, 365, 366, 367, 368, 0, 0, 369, 0,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 492,
+	0, 0, 0, 0, 0, 0, 488, 489, 523, 510,
+	511, 512, 513, 509, 497, 0, 490, 0, 0, 498,
+	1723, 112, 113, 114, 115, 116, 117, 118, 119, 0,
+	120, 121, 122, 0, 0, 0, 0, 503, 0, 0,
+	123, 124, 0, 125, 126, 577, 127, 128, 129, 378,
+	555, 578, 556, 0, 557, 0, 130, 131, 132, 133,
+	134, 135, 520, 543, 425, 136, 558, 559, 137, 0,
+	138, 139, 140, 141, 551, 0, 531, 0, 142, 143,
+	144, 145, 146, 0, 579, 147, 148, 149, 0, 150,
+	151, 152, 153, 154, 155, 0, 580, 156, 157, 158,
+	541, 532, 537, 542, 533, 534, 538, 159, 160, 161,
+	162, 163, 560, 164, 165, 561, 562, 166, 0, 167,
+	0, 168, 169, 170, 171, 172, 0, 173, 174, 175,
+	0, 0, 176, 177, 554, 179, 180, 0, 181, 182,
+	183, 0, 184, 185, 186, 0, 187, 188, 189, 190,
+	502, 191, 192, 193, 544, 518, 194, 0, 195, 196,
+	563, 197, 0, 198, 0, 199, 581, 0, 582, 200,
+	201, 202, 0, 203, 204, 552, 0, 506, 205, 0,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 0,
+	215, 216, 217, 218, 219, 220, 0, 221, 583, 393,
+	222, 223, 224, 225, 564, 565, 0, 530, 0, 226,
+	584, 227, 585, 228, 229, 230, 231, 232, 0, 0,
+	233, 553, 586, 234, 587, 0, 235, 236, 426, 535,
+	536, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	246, 247, 248, 249, 250, 427, 398, 588, 399, 251,
+	252, 400, 491, 253, 254, 255, 519, 550, 256, 566,
+	257, 258, 259, 0, 260, 0, 0, 261, 262, 0,
+	0, 263, 403, 589, 264, 590, 545, 265, 266, 267,
+	268, 269, 270, 271, 0, 272, 273, 546, 274, 406,
+	277, 275, 276, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 567, 287, 288, 289, 290, 0, 291,
+	292, 293, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 591, 304, 305, 306, 507, 307, 308,
+	309, 310, 311, 312, 313, 314, 0, 315, 316, 409,
+	317, 318, 428, 539, 319, 320, 410, 321, 322, 592,
+	323, 324, 568, 325, 0, 326, 327, 328, 329, 330,
+	331, 332, 333, 334, 335, 336, 547, 0, 337, 338,
+	0, 339, 593, 340, 341, 342, 343, 344, 0, 569,
+	570, 0, 0, 429, 345, 548, 346, 549, 517, 347,
+	348, 349, 350, 351, 352, 353, 0, 494, 354, 355,
+	356, 357, 358, 540, 0, 359, 360, 361, 362, 363,
+	416, 571, 0, 364, 594, 365, 366, 367, 368, 0,
+	0, 369, 0, 0, 370, 371, 372, 373, 374, 375,
+	376, 377, 492, 0, 0, 0, 0, 0, 0, 488,
+	489, 523, 510, 511, 512, 513, 509, 497, 0, 490,
+	0, 0, 498, 1667, 112, 113, 114, 115, 116, 117,
+	118, 119, 0, 120, 121, 122, 0, 0, 0, 0,
+	503, 0, 0, 123, 124, 0, 125, 126, 577, 127,
+	128, 129, 378, 555, 578, 556, 0, 557, 0, 130,
+	131, 132, 133, 134, 135, 520, 543, 425, 136, 558,
+	559, 137, 0, 138, 139, 140, 141, 551, 0, 531,
+	0, 142, 143, 144, 145, 146, 0, 579, 147, 148,
+	149, 0, 150, 151, 152, 153, 154, 155, 0, 580,
+	156, 157, 158, 541, 532, 537, 542, 533, 534, 538,
+	159, 160, 161, 162, 163, 560, 164, 165, 561, 562,
+	166, 0, 167, 0, 168, 169, 170, 171, 172, 0,
+	173, 174, 175, 0, 0, 176, 177, 554, 179, 180,
+	0, 181, 182, 183, 0, 184, 185, 186, 0, 187,
+	188, 189, 190, 502, 191, 192, 193, 544, 518, 194,
+	0, 195, 196, 563, 197, 0, 198, 0, 199, 581,
+	0, 582, 200, 201, 202, 0, 203, 204, 552, 0,
+	506, 205, 0, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 0, 215, 216, 217, 218, 219, 220, 0,
+	221, 583, 393, 222, 223, 224, 225, 564, 565, 0,
+	530, 0, 226, 584, 227, 585, 228, 229, 230, 231,
+	232, 0, 0, 233, 553, 586, 234, 587, 0, 235,
+	236, 426, 535, 536, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 398,
+	588, 399, 251, 252, 400, 491, 253, 254, 255, 519,
+	550, 256, 566, 257, 258, 259, 0, 260, 0, 0,
+	261, 262, 0, 0, 263, 403, 589, 264, 590, 545,
+	265, 266, 267, 268, 269, 270, 271, 0, 272, 273,
+	546, 274, 406, 277, 275, 276, 0, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 567, 287, 288, 289,
+	290, 0, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 591, 304, 305, 306,
+	507, 307, 308, 309, 310, 311, 312, 313, 314, 0,
+	315, 316, 409, 317, 318, 428, 539, 319, 320, 410,
+	321, 322, 592, 323, 324, 568, 325, 0, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 547,
+	0, 337, 338, 0, 339, 593, 340, 341, 342, 343,
+	344, 0, 569, 570, 0, 0, 429, 345, 548, 346,
+	549, 517, 347, 348, 349, 350, 351, 352, 353, 0,
+	494, 354, 355, 356, 357, 358, 540, 0, 359, 360,
+	361, 362, 363, 416, 571, 0, 364, 594, 365, 366,
+	367, 368, 0, 0, 369, 0, 0, 370, 371, 372,
+	373, 374, 375, 376, 377, 492, 0, 0, 0, 0,
+	0, 0, 488, 489, 523, 510, 511, 512, 513, 509,
+	497, 0, 490, 0, 0, 498, 1159, 112, 113, 114,
+	115, 116, 117, 118, 119, 0, 120, 121, 122, 0,
+	0, 0, 0, 503, 0, 0, 123, 124, 0, 125,
+	126, 577, 127, 128, 129, 378, 555, 578, 556, 0,
+	557, 0, 130, 131, 132, 133, 134, 135, 520, 543,
+	425, 136, 558, 559, 137, 0, 138, 139, 140, 141,
+	551, 0, 531, 0, 142, 143, 144, 145, 146, 0,
+	579, 147, 148, 149, 0, 150, 151, 152, 153, 154,
+	155, 0, 580, 156, 157, 158, 541, 532, 537, 542,
+	533, 534, 538, 159, 160, 161, 162, 163, 560, 164,
+	165, 561, 562, 166, 0, 167, 0, 168, 169, 170,
+	171, 172, 0, 173, 174, 175, 0, 0, 176, 177,
+	554, 179, 180, 0, 181, 182, 183, 0, 184, 185,
+	186, 0, 187, 188, 189, 190, 502, 191, 192, 193,
+	544, 518, 194, 0, 195, 196, 563, 197, 0, 198,
+	0, 199, 581, 0, 582, 200, 201, 202, 0, 203,
+	204, 552, 0, 506, 205, 0, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 0, 215, 216, 217, 218,
+	219, 220, 0, 221, 583, 393, 222, 223, 224, 225,
+	564, 565, 0, 530, 0, 226, 584, 227, 585, 228,
+	229, 230, 231, 232, 0, 0, 233, 553, 586, 234,
+	587, 0, 235, 236, 426, 535, 536, 237, 238, 239,
+	240, 241, 242, 243, 244, 245, 246, 247, 248, 249,
+	250, 427, 398, 588, 399, 251, 252, 400, 491, 253,
+	254, 255, 519, 550, 256, 566, 257, 258, 259, 0,
+	260, 0, 0, 261, 262, 0, 0, 263, 403, 589,
+	264, 590, 545, 265, 266, 267, 268, 269, 270, 271,
+	0, 272, 273, 546, 274, 406, 277, 275, 276, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 567,
+	287, 288, 289, 290, 0, 291, 292, 293, 294, 295,
+	296, 297, 298, 299, 300, 301, 0, 302, 303, 591,
+	304, 305, 306, 507, 307, 308, 309, 310, 311, 312,
+	313, 314, 0, 315, 316, 409, 317, 318, 428, 539,
+	319, 320, 410, 321, 322, 592, 323, 324, 568, 325,
+	0, 326, 327, 328, 329, 330, 331, 332, 333, 334,
+	335, 336, 547, 0, 337, 338, 0, 339, 593, 340,
+	341, 342, 343, 344, 0, 569, 570, 0, 0, 429,
+	345, 548, 346, 549, 517, 347, 348, 349, 350, 351,
+	352, 353, 0, 494, 354, 355, 356, 357, 358, 540,
+	0, 359, 360, 361, 362, 363, 416, 571, 0, 364,
+	594, 365, 366, 367, 368, 0, 0, 369, 0, 0,
+	370, 371, 372, 373, 374, 375, 376, 377, 492, 0,
+	0, 0, 0, 0, 0, 488, 489, 523, 510, 511,
+	512, 513, 509, 497, 0, 490, 767, 1154, 498, 0,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 503, 0, 0, 123,
+	124, 0, 125, 126, 577, 127, 128, 129, 378, 555,
+	578, 556, 0, 557, 0, 130, 131, 132, 133, 134,
+	135, 520, 543, 425, 136, 558, 559, 137, 0, 138,
+	139, 140, 141, 551, 0, 531, 0, 142, 143, 144,
+	145, 146, 0, 579, 147, 148, 149, 0, 150, 151,
+	152, 153, 154, 155, 0, 580, 156, 157, 158, 541,
+	532, 537, 542, 533, 534, 538, 159, 160, 161, 162,
+	163, 560, 164, 165, 561, 562, 166, 722, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 554, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 187, 188, 189, 190, 502,
+	191, 192, 193, 544, 518, 194, 0, 195, 196, 563,
+	197, 0, 198, 0, 199, 581, 0, 582, 200, 201,
+	202, 0, 203, 204, 552, 0, 506, 205, 0, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 0, 215,
+	216, 217, 218, 219, 220, 0, 221, 583, 393, 222,
+	223, 224, 225, 564, 565, 0, 530, 0, 226, 584,
+	227, 585, 228, 229, 230, 231, 232, 0, 0, 233,
+	553, 586, 234, 587, 0, 235, 236, 426, 535, 536,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 427, 398, 588, 399, 251, 252,
+	400, 491, 253, 254, 255, 519, 550, 256, 566, 257,
+	258, 259, 0, 260, 0, 0, 261, 262, 0, 0,
+	263, 403, 589, 264, 590, 545, 265, 266, 267, 268,
+	269, 270, 271, 0, 272, 273, 546, 274, 406, 277,
+	275, 276, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 567, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 591, 304, 305, 306, 507, 307, 308, 309,
+	310, 311, 312, 313, 314, 0, 315, 316, 409, 317,
+	318, 428, 539, 319, 320, 410, 321, 322, 592, 323,
+	324, 568, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 547, 0, 337, 338, 0,
+	339, 593, 340, 341, 342, 343, 344, 0, 569, 570,
+	0, 0, 429, 345, 548, 346, 549, 517, 347, 348,
+	349, 350, 351, 352, 353, 0, 494, 354, 355, 356,
+	357, 358, 540, 0, 359, 360, 361, 362, 363, 416,
+	571, 0, 364, 594, 365, 366, 367, 368, 0, 0,
+	369, 0, 0, 370, 371, 372, 373, 374, 375, 376,
+	377, 492, 0, 0, 0, 0, 0, 0, 488, 489,
+	523, 510, 511, 512, 513, 509, 497, 0, 490, 0,
+	0, 498, 0, 112, 113, 114, 115, 116, 117, 118,
+	119, 0, 120, 121, 122, 0, 0, 0, 0, 503,
+	0, 0, 123, 124, 0, 125, 126, 577, 127, 128,
+	129, 378, 555, 578, 556, 0, 557, 0, 130, 131,
+	132, 133, 134, 135, 520, 543, 425, 136, 558, 559,
+	137, 0, 138, 139, 140, 141, 551, 0, 531, 0,
+	142, 143, 144, 145, 146, 0, 579, 147, 148, 149,
+	0, 150, 151, 152, 153, 154, 155, 0, 580, 156,
+	157, 158, 541, 532, 537, 542, 533, 534, 538, 159,
+	160, 161, 162, 163, 560, 164, 165, 561, 562, 166,
+	0, 167, 0, 168, 169, 170, 171, 172, 0, 173,
+	174, 175, 0, 0, 176, 177, 554, 179, 180, 0,
+	181, 182, 183, 0, 184, 185, 186, 0, 187, 188,
+	189, 190, 502, 191, 192, 193, 544, 518, 194, 0,
+	195, 196, 563, 197, 0, 198, 0, 199, 581, 0,
+	582, 200, 201, 202, 0, 203, 204, 552, 0, 506,
+	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 0, 215, 216, 217, 218, 219, 220, 0, 221,
+	583, 393, 222, 223, 224, 225, 564, 565, 0, 530,
+	0, 226, 584, 227, 585, 228, 229, 230, 231, 232,
+	0, 0, 233, 553, 586, 234, 587, 0, 235, 236,
+	426, 535, 536, 237, 238, 239, 240, 241, 242, 243,
+	244, 245, 246, 247, 248, 249, 250, 427, 398, 588,
+	399, 251, 252, 400, 491, 253, 254, 255, 519, 550,
+	256, 566, 257, 258, 259, 0, 260, 0, 0, 261,
+	262, 0, 0, 263, 403, 589, 264, 590, 545, 265,
+	266, 267, 268, 269, 270, 271, 0, 272, 273, 546,
+	274, 406, 277, 275, 276, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 567, 287, 288, 289, 290,
+	0, 291, 292, 293, 294, 295, 296, 297, 298, 299,
+	300, 301, 0, 302, 303, 591, 304, 305, 306, 507,
+	307, 308, 309, 310, 311, 312, 313, 314, 0, 315,
+	316, 409, 317, 318, 428, 539, 319, 320, 410, 321,
+	322, 592, 323, 324, 568, 325, 0, 326, 327, 328,
+	329, 330, 331, 332, 333, 334, 335, 336, 547, 0,
+	337, 338, 0, 339, 593, 340, 341, 342, 343, 344,
+	0, 569, 570, 0, 0, 429, 345, 548, 346, 549,
+	517, 347, 348, 349, 350, 351, 352, 353, 0, 494,
+	354, 355, 356, 357, 358, 540, 0, 359, 360, 361,
+	362, 363, 416, 571, 0, 364, 594, 365, 366, 367,
+	368, 0, 0, 369, 0, 0, 370, 371, 372, 373,
+	374, 375, 376, 377, 492, 0, 0, 0, 0, 0,
+	0, 488, 489, 708, 523, 510, 511, 512, 513, 509,
+	497, 490, 0, 0, 498, 0, 0, 112, 113, 114,
+	115, 116, 117, 118, 119, 0, 120, 121, 122, 0,
+	0, 0, 0, 503, 0, 0, 123, 124, 0, 125,
+	126, 577, 127, 128, 129, 378, 555, 578, 556, 0,
+	557, 0, 130, 131, 132, 133, 134, 135, 520, 543,
+	425, 136, 558, 559, 137, 0, 138, 139, 140, 141,
+	551, 0, 531, 0, 142, 143, 144, 145, 146, 0,
+	579, 147, 148, 149, 0, 150, 151, 152, 153, 154,
+	155, 0, 580, 156, 157, 158, 541, 532, 537, 542,
+	533, 534, 538, 159, 160, 161, 162, 163, 560, 164,
+	165, 561, 562, 166, 0, 167, 0, 168, 169, 170,
+	171, 172, 0, 173, 174, 175, 0, 0, 176, 177,
+	554, 179, 180, 0, 181, 182, 183, 0, 184, 185,
+	186, 0, 187, 188, 189, 190, 502, 191, 192, 193,
+	544, 518, 194, 0, 195, 196, 563, 197, 0, 198,
+	0, 199, 581, 0, 582, 200, 201, 202, 0, 203,
+	204, 552, 0, 506, 205, 0, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 0, 215, 216, 217, 218,
+	219, 220, 0, 221, 583, 393, 222, 223, 224, 225,
+	564, 565, 0, 530, 0, 226, 584, 227, 585, 228,
+	229, 230, 231, 232, 0, 0, 233, 553, 586, 234,
+	587, 0, 235, 236, 426, 535, 536, 237, 238, 239,
+	240, 241, 242, 243, 244, 245, 246, 247, 248, 249,
+	250, 427, 398, 588, 399, 251, 252, 400, 491, 253,
+	254, 255, 519, 550, 256, 566, 257, 258, 259, 0,
+	260, 0, 0, 261, 262, 0, 0, 263, 403, 589,
+	264, 590, 545, 265, 266, 267, 268, 269, 270, 271,
+	0, 272, 273, 546, 274, 406, 277, 275, 276, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 567,
+	287, 288, 289, 290, 0, 291, 292, 293, 294, 295,
+	296, 297, 298, 299, 300, 301, 0, 302, 303, 591,
+	304, 305, 306, 507, 307, 308, 309, 310, 311, 312,
+	313, 314, 0, 315, 316, 409, 317, 318, 428, 539,
+	319, 320, 410, 321, 322, 592, 323, 324, 568, 325,
+	0, 326, 327, 328, 329, 330, 331, 332, 333, 334,
+	335, 336, 547, 0, 337, 338, 0, 339, 593, 340,
+	341, 342, 343, 344, 0, 569, 570, 0, 0, 429,
+	345, 548, 346, 549, 517, 347, 348, 349, 350, 351,
+	352, 353, 0, 494, 354, 355, 356, 357, 358, 540,
+	0, 359, 360, 361, 362, 363, 416, 571, 1673, 364,
+	594, 365, 366, 367, 368, 0, 0, 369, 0, 0,
+	370, 371, 372, 373, 374, 375, 376, 377, 492, 0,
+	0, 0, 0, 0, 0, 488, 489, 523, 510, 511,
+	512, 513, 509, 497, 0, 490, 0, 0, 498, 0,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 503, 0, 0, 123,
+	124, 0, 125, 126, 577, 127, 128, 129, 378, 555,
+	578, 556, 0, 557, 0, 130, 131, 132, 133, 134,
+	135, 520, 543, 425, 136, 558, 559, 137, 0, 138,
+	139, 140, 141, 551, 0, 531, 0, 142, 143, 144,
+	145, 146, 0, 579, 147, 148, 149, 0, 150, 151,
+	152, 153, 154, 155, 0, 580, 156, 157, 158, 541,
+	532, 537, 542, 533, 534, 538, 159, 160, 161, 162,
+	163, 560, 164, 165, 561, 562, 166, 0, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 554, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 187, 188, 189, 190, 502,
+	191, 192, 193, 544, 518, 194, 0, 195, 196, 563,
+	197, 0, 198, 0, 199, 581, 1216, 582, 200, 201,
+	202, 0, 203, 204, 552, 0, 506, 205, 0, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 0, 215,
+	216, 217, 218, 219, 220, 0, 221, 583, 393, 222,
+	223, 224, 225, 564, 565, 0, 530, 0, 226, 584,
+	227, 585, 228, 229, 230, 231, 232, 0, 0, 233,
+	553, 586, 234, 587, 0, 235, 236, 426, 535, 536,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 427, 398, 588, 399, 251, 252,
+	400, 491, 253, 254, 255, 519, 550, 256, 566, 257,
+	258, 259, 0, 260, 0, 0, 261, 262, 0, 0,
+	263, 403, 589, 264, 590, 545, 265, 266, 267, 268,
+	269, 270, 271, 0, 272, 273, 546, 274, 406, 277,
+	275, 276, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 567, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 591, 304, 305, 306, 507, 307, 308, 309,
+	310, 311, 312, 313, 314, 0, 315, 316, 409, 317,
+	318, 428, 539, 319, 320, 410, 321, 322, 592, 323,
+	324, 568, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 547, 0, 337, 338, 0,
+	339, 593, 340, 341, 342, 343, 344, 0, 569, 570,
+	0, 0, 429, 345, 548, 346, 549, 517, 347, 348,
+	349, 350, 351, 352, 353, 0, 494, 354, 355, 356,
+	357, 358, 540, 0, 359, 360, 361, 362, 363, 416,
+	571, 0, 364, 594, 365, 366, 367, 368, 0, 0,
+	369, 0, 0, 370, 371, 372, 373, 374, 375, 376,
+	377, 492, 0, 0, 0, 0, 0, 0, 488, 489,
+	523, 510, 511, 512, 513, 509, 497, 0, 490, 0,
+	0, 498, 0, 112, 113, 114, 115, 116, 117, 118,
+	119, 956, 120, 121, 122, 0, 0, 0, 0, 503,
+	0, 0, 123, 124, 0, 125, 126, 577, 127, 128,
+	129, 378, 555, 578, 556, 0, 557, 0, 130, 131,
+	132, 133, 134, 135, 520, 543, 425, 136, 558, 559,
+	137, 0, 138, 139, 140, 141, 551, 0, 531, 0,
+	142, 143, 144, 145, 146, 0, 579, 147, 148, 149,
+	0, 150, 151, 152, 153, 154, 155, 0, 580, 156,
+	157, 158, 541, 532, 537, 542, 533, 534, 538, 159,
+	160, 161, 162, 163, 560, 164, 165, 561, 562, 166,
+	0, 167, 0, 168, 169, 170, 171, 172, 0, 173,
+	174, 175, 0, 0, 176, 177, 554, 179, 180, 0,
+	181, 182, 183, 0, 184, 185, 186, 0, 187, 188,
+	189, 190, 502, 191, 192, 193, 544, 518, 194, 0,
+	195, 196, 563, 197, 0, 198, 0, 199, 581, 0,
+	582, 200, 201, 202, 0, 203, 204, 552, 0, 506,
+	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 0, 215, 216, 217, 218, 219, 220, 0, 221,
+	583, 393, 222, 223, 224, 225, 564, 565, 0, 530,
+	0, 226, 584, 227, 585, 228, 229, 230, 231, 232,
+	0, 0, 233, 553, 586, 234, 587, 0, 235, 236,
+	426, 535, 536, 237, 238, 239, 240, 241, 242, 243,
+	244, 245, 246, 247, 248, 249, 250, 427, 398, 588,
+	399, 251, 252, 400, 491, 253, 254, 255, 519, 550,
+	256, 566, 257, 258, 259, 0, 260, 0, 0, 261,
+	262, 0, 0, 263, 403, 589, 264, 590, 545, 265,
+	266, 267, 268, 269, 270, 271, 0, 272, 273, 546,
+	274, 406, 277, 275, 276, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 567, 287, 288, 289, 290,
+	0, 291, 292, 293, 294, 295, 296, 297, 298, 299,
+	300, 301, 0, 302, 303, 591, 304, 305, 306, 507,
+	307, 308, 309, 310, 311, 312, 313, 314, 0, 315,
+	316, 409, 317, 318, 428, 539, 319, 320, 410, 321,
+	322, 592, 323, 324, 568, 325, 0, 326, 327, 328,
+	329, 330, 331, 332, 333, 334, 335, 336, 547, 0,
+	337, 338, 0, 339, 593, 340, 341, 342, 343, 344,
+	0, 569, 570, 0, 0, 429, 345, 548, 346, 549,
+	517, 347, 348, 349, 350, 351, 352, 353, 0, 494,
+	354, 355, 356, 357, 358, 540, 0, 359, 360, 361,
+	362, 363, 416, 571, 0, 364, 594, 365, 366, 367,
+	368, 0, 0, 369, 0, 0, 370, 371, 372, 373,
+	374, 375, 376, 377, 492, 0, 0, 0, 0, 0,
+	0, 488, 489, 523, 510, 511, 512, 513, 509, 497,
+	0, 490, 0, 0, 498, 0, 112, 113, 114, 115,
+	116, 117, 118, 119, 0, 120, 121, 122, 0, 0,
+	0, 0, 503, 0, 0, 123, 124, 0, 125, 126,
+	577, 127, 128, 129, 378, 555, 578, 556, 0, 557,
+	0, 130, 131, 132, 133, 134, 135, 520, 543, 425,
+	136, 558, 559, 137, 0, 138, 139, 140, 141, 551,
+	0, 531, 0, 142, 143, 144, 145, 146, 0, 579,
+	147, 148, 149, 0, 150, 151, 152, 153, 154, 155,
+	0, 580, 156, 157, 2179, 541, 532, 537, 542, 533,
+	534, 538, 159, 160, 161, 162, 163, 560, 164, 165,
+	561, 562, 166, 0, 167, 0, 168, 169, 170, 171,
+	172, 0, 173, 174, 175, 0, 0, 176, 177, 554,
+	179, 180, 0, 181, 182, 183, 0, 184, 185, 186,
+	0, 187, 188, 189, 190, 502, 191, 192, 193, 544,
+	518, 194, 0, 195, 196, 563, 197, 0, 198, 0,
+	199, 581, 0, 582, 200, 201, 202, 0, 203, 204,
+	552, 0, 506, 205, 0, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 0, 215, 216, 217, 218, 219,
+	220, 0, 221, 583, 393, 222, 223, 224, 225, 564,
+	565, 0, 530, 0, 226, 584, 227, 585, 228, 229,
+	230, 231, 232, 0, 0, 233, 553, 586, 234, 587,
+	0, 235, 236, 426, 535, 536, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	427, 398, 588, 399, 251, 252, 400, 491, 253, 254,
+	255, 519, 550, 256, 566, 257, 258, 259, 0, 260,
+	0, 0, 261, 262, 0, 0, 263, 403, 589, 264,
+	590, 545, 265, 266, 267, 268, 269, 270, 271, 0,
+	272, 273, 546, 274, 406, 277, 275, 276, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 567, 287,
+	288, 289, 290, 0, 291, 292, 293, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 591, 304,
+	305, 306, 507, 307, 308, 309, 310, 311, 312, 313,
+	314, 0, 315, 316, 409, 317, 318, 428, 539, 319,
+	320, 410, 321, 322, 592, 323, 324, 568, 325, 0,
+	326, 327, 328, 329, 330, 331, 332, 333, 334, 335,
+	336, 547, 0, 337, 338, 0, 339, 593, 340, 341,
+	342, 343, 344, 0, 569, 570, 0, 0, 429, 345,
+	548, 346, 549, 517, 347, 348, 349, 350, 2178, 352,
+	353, 0, 494, 354, 355, 356, 357, 358, 540, 0,
+	359, 360, 361, 362, 363, 416, 571, 0, 364, 594,
+	365, 366, 367, 368, 0, 0, 369, 0, 0, 370,
+	371, 372, 373, 374, 375, 376, 377, 492, 0, 0,
+	0, 0, 0, 0, 488, 489, 523, 510, 511, 512,
+	513, 509, 497, 0, 490, 0, 0, 498, 0, 112,
+	113, 114, 115, 116, 117, 118, 119, 0, 120, 121,
+	122, 0, 0, 0, 0, 503, 0, 0, 123, 124,
+	0, 125, 126, 577, 127, 128, 129, 378, 555, 578,
+	556, 0, 557, 0, 130, 131, 132, 133, 134, 135,
+	520, 543, 425, 136, 558, 559, 137, 0, 138, 139,
+	140, 141, 551, 0, 531, 0, 142, 143, 144, 145,
+	146, 0, 579, 147, 148, 149, 0, 150, 151, 152,
+	153, 154, 155, 0, 580, 156, 157, 158, 541, 532,
+	537, 542, 533, 534, 538, 159, 160, 161, 162, 163,
+	560, 164, 165, 561, 562, 166, 0, 167, 0, 168,
+	169, 170, 171, 172, 0, 173, 174, 175, 0, 0,
+	176, 177, 554, 179, 180, 0, 181, 182, 183, 0,
+	184, 185, 186, 0, 187, 188, 189, 190, 502, 191,
+	192, 193, 544, 518, 194, 0, 195, 196, 563, 197,
+	0, 198, 0, 199, 581, 0, 582, 200, 201, 202,
+	0, 203, 204, 552, 0, 506, 205, 0, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 0, 215, 216,
+	217, 218, 219, 220, 0, 221, 583, 393, 222, 223,
+	224, 225, 564, 565, 0, 530, 0, 226, 584, 227,
+	585, 228, 229, 230, 231, 232, 0, 0, 233, 553,
+	586, 234, 587, 0, 235, 236, 426, 535, 536, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+	248, 249, 250, 427, 398, 588, 399, 251, 252, 400,
+	491, 253, 254, 255, 519, 550, 256, 566, 257, 258,
+	259, 0, 260, 0, 0, 261, 262, 0, 0, 263,
+	403, 589, 264, 590, 545, 265, 266, 267, 268, 269,
+	270, 271, 0, 272, 273, 546, 274, 406, 277, 275,
+	276, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 567, 287, 288, 289, 290, 0, 291, 292, 293,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 591, 304, 305, 306, 507, 307, 308, 309, 310,
+	311, 312, 313, 314, 0, 315, 316, 409, 317, 318,
+	428, 539, 319, 320, 410, 321, 322, 592, 323, 324,
+	568, 325, 0, 326, 327, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 547, 0, 337, 338, 0, 339,
+	593, 340, 341, 342, 343, 344, 0, 569, 570, 0,
+	0, 429, 345, 548, 346, 549, 517, 347, 348, 349,
+	350, 351, 352, 353, 0, 494, 354, 355, 356, 357,
+	358, 540, 0, 359, 360, 361, 362, 363, 416, 571,
+	0, 364, 594, 365, 366, 367, 368, 0, 0, 369,
+	0, 0, 370, 371, 372, 373, 374, 375, 376, 377,
+	492, 0, 0, 0, 0, 0, 0, 488, 489, 523,
+	510, 511, 512, 513, 509, 497, 0, 490, 0, 0,
+	498, 0, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 503, 0,
+	0, 123, 124, 0, 125, 126, 577, 127, 128, 129,
+	2177, 555, 578, 556, 0, 557, 0, 130, 131, 132,
+	133, 134, 135, 520, 543, 425, 136, 558, 559, 137,
+	0, 138, 139, 140, 141, 551, 0, 531, 0, 142,
+	143, 144, 145, 146, 0, 579, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 580, 156, 157,
+	2179, 541, 532, 537, 542, 533, 534, 538, 159, 160,
+	161, 162, 163, 560, 164, 165, 561, 562, 166, 0,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 554, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 502, 191, 192, 193, 544, 518, 194, 0, 195,
+	196, 563, 197, 0, 198, 0, 199, 581, 0, 582,
+	200, 201, 202, 0, 203, 204, 552, 0, 506, 205,
+	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 583,
+	393, 222, 223, 224, 225, 564, 565, 0, 530, 0,
+	226, 584, 227, 585, 228, 229, 230, 231, 232, 0,
+	0, 233, 553, 586, 234, 587, 0, 235, 236, 426,
+	535, 536, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 588, 399,
+	251, 252, 400, 491, 253, 254, 255, 519, 550, 256,
+	566, 257, 258, 259, 0, 260, 0, 0, 261, 262,
+	0, 0, 263, 403, 589, 264, 590, 545, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 546, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 567, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 591, 304, 305, 306, 507, 307,
+	308, 309, 310, 311, 312, 313, 314, 0, 315, 316,
+	409, 317, 318, 428, 539, 319, 320, 410, 321, 322,
+	592, 323, 324, 568, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 547, 0, 337,
+	338, 0, 339, 593, 340, 341, 342, 343, 344, 0,
+	569, 570, 0, 0, 429, 345, 548, 346, 549, 517,
+	347, 348, 349, 350, 2178, 352, 353, 0, 494, 354,
+	355, 356, 357, 358, 540, 0, 359, 360, 361, 362,
+	363, 416, 571, 0, 364, 594, 365, 366, 367, 368,
+	0, 0, 369, 0, 0, 370, 371, 372, 373, 374,
+	375, 376, 377, 492, 0, 0, 0, 0, 0, 0,
+	488, 489, 523, 510, 511, 512, 513, 509, 497, 0,
+	490, 0, 0, 498, 0, 112, 113, 114, 115, 116,
+	117, 118, 119, 0, 120, 121, 122, 0, 0, 0,
+	0, 503, 0, 0, 123, 124, 0, 125, 126, 577,
+	127, 128, 129, 378, 555, 578, 556, 0, 557, 0,
+	130, 131, 132, 133, 134, 135, 520, 543, 425, 136,
+	558, 559, 137, 0, 138, 139, 140, 141, 551, 0,
+	531, 0, 142, 143, 144, 145, 146, 0, 579, 147,
+	148, 149, 0, 150, 151, 152, 153, 154, 155, 0,
+	580, 156, 157, 158, 541, 532, 537, 542, 533, 534,
+	538, 159, 160, 161, 162, 163, 560, 164, 165, 561,
+	562, 166, 0, 167, 0, 168, 169, 170, 171, 172,
+	0, 173, 174, 175, 0, 0, 176, 177, 554, 179,
+	180, 0, 181, 182, 183, 0, 184, 185, 186, 0,
+	187, 188, 189, 190, 502, 191, 192, 193, 544, 518,
+	194, 0, 195, 196, 563, 197, 0, 198, 0, 199,
+	581, 0, 582, 200, 201, 202, 0, 203, 204, 552,
+	0, 506, 205, 0, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 0, 215, 216, 217, 218, 219, 220,
+	0, 221, 583, 393, 222, 223, 224, 225, 564, 565,
+	0, 530, 0, 226, 584, 227, 585, 228, 229, 230,
+	231, 232, 0, 0, 233, 553, 586, 234, 587, 0,
+	235, 236, 426, 535, 536, 237, 238, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 427,
+	398, 588, 399, 251, 252, 400, 491, 253, 254, 255,
+	519, 550, 256, 566, 257, 258, 259, 0, 260, 0,
+	0, 261, 262, 0, 0, 263, 403, 589, 264, 590,
+	545, 265, 266, 267, 268, 269, 270, 271, 0, 272,
+	273, 546, 274, 406, 277, 275, 276, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 567, 287, 288,
+	289, 290, 0, 291, 292, 293, 294, 295, 296, 297,
+	298, 299, 300, 301, 0, 302, 303, 591, 304, 305,
+	306, 507, 307, 308, 309, 310, 311, 312, 313, 314,
+	0, 315, 316, 409, 317, 318, 428, 539, 319, 320,
+	410, 321, 322, 592, 323, 324, 568, 325, 0, 326,
+	327, 328, 329, 330, 331, 332, 333, 334, 335, 336,
+	547, 0, 337, 338, 0, 339, 593, 340, 341, 342,
+	343, 344, 0, 569, 570, 0, 0, 429, 345, 548,
+	346, 549, 517, 347, 348, 349, 350, 351, 352, 353,
+	0, 494, 354, 355, 356, 357, 358, 540, 0, 359,
+	360, 361, 362, 363, 416, 571, 0, 364, 594, 365,
+	366, 367, 368, 0, 0, 369, 0, 0, 370, 371,
+	372, 373, 374, 375, 376, 377, 492, 0, 0, 0,
+	0, 0, 0, 488, 489, 523, 510, 511, 512, 513,
+	509, 497, 0, 490, 0, 0, 1962, 0, 112, 113,
+	114, 115, 116, 117, 118, 119, 0, 120, 121, 122,
+	0, 0, 0, 0, 503, 0, 0, 123, 124, 0,
+	125, 126, 577, 127, 128, 129, 378, 555, 578, 556,
+	0, 557, 0, 130, 131, 132, 133, 134, 135, 520,
+	543, 425, 136, 558, 559, 137, 0, 138, 139, 140,
+	141, 551, 0, 531, 0, 142, 143, 144, 145, 146,
+	0, 579, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 580, 156, 157, 158, 541, 532, 537,
+	542, 533, 534, 538, 159, 160, 161, 162, 163, 560,
+	164, 165, 561, 562, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 554, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 502, 191, 192,
+	193, 544, 518, 194, 0, 195, 196, 563, 197, 0,
+	198, 0, 199, 581, 0, 582, 200, 201, 202, 0,
+	203, 204, 552, 0, 506, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 217,
+	218, 219, 220, 0, 221, 583, 393, 222, 223, 224,
+	225, 564, 565, 0, 530, 0, 226, 584, 227, 585,
+	228, 229, 230, 231, 232, 0, 0, 233, 553, 586,
+	234, 587, 0, 235, 236, 426, 535, 536, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 588, 399, 251, 252, 400, 0,
+	253, 254, 255, 519, 550, 256, 566, 257, 258, 259,
+	0, 260, 0, 0, 261, 262, 0, 0, 263, 403,
+	589, 264, 590, 545, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 546, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	567, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	591, 304, 305, 306, 1206, 307, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 428,
+	539, 319, 320, 410, 321, 322, 592, 323, 324, 568,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 547, 0, 337, 338, 0, 339, 593,
+	340, 341, 342, 343, 344, 0, 569, 570, 0, 0,
+	429, 345, 548, 346, 549, 517, 347, 348, 349, 350,
+	351, 352, 353, 0, 0, 354, 355, 356, 357, 358,
+	540, 0, 359, 360, 361, 362, 363, 416, 571, 0,
+	364, 594, 365, 366, 367, 368, 0, 0, 369, 0,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 0,
+	0, 0, 0, 0, 0, 0, 1202, 1203, 523, 510,
+	511, 512, 513, 509, 497, 0, 1204, 0, 0, 1205,
+	0, 112, 113, 114, 115, 116, 117, 118, 119, 0,
+	120, 121, 122, 0, 0, 0, 0, 503, 0, 0,
+	123, 124, 0, 125, 126, 577, 127, 128, 129, 0,
+	555, 578, 556, 0, 557, 0, 130, 131, 132, 133,
+	134, 135, 520, 543, 425, 136, 558, 559, 137, 0,
+	138, 139, 140, 141, 551, 0, 531, 0, 142, 143,
+	144, 145, 146, 0, 579, 147, 148, 149, 0, 150,
+	151, 152, 153, 154, 155, 0, 580, 156, 157, 2179,
+	541, 532, 537, 542, 533, 534, 538, 159, 160, 161,
+	162, 163, 560, 164, 165, 561, 562, 166, 0, 167,
+	0, 168, 169, 170, 171, 172, 0, 173, 174, 175,
+	0, 0, 176, 177, 554, 179, 180, 0, 181, 182,
+	183, 0, 184, 185, 186, 0, 187, 188, 189, 190,
+	502, 191, 192, 193, 544, 518, 194, 0, 195, 196,
+	563, 197, 0, 198, 0, 199, 581, 0, 582, 200,
+	201, 202, 0, 203, 204, 552, 0, 506, 205, 0,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 0,
+	215, 216, 217, 218, 219, 220, 0, 221, 583, 393,
+	222, 223, 224, 225, 564, 565, 0, 530, 0, 226,
+	0, 227, 585, 228, 229, 230, 231, 232, 0, 0,
+	233, 553, 586, 234, 0, 0, 235, 236, 426, 535,
+	536, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	246, 247, 248, 249, 250, 427, 398, 588, 399, 251,
+	252, 400, 491, 253, 254, 255, 519, 550, 256, 566,
+	257, 258, 259, 0, 260, 0, 0, 261, 262, 0,
+	0, 263, 403, 589, 264, 590, 545, 265, 266, 267,
+	268, 269, 270, 271, 0, 272, 273, 546, 274, 406,
+	277, 275, 276, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 567, 287, 288, 289, 290, 0, 291,
+	292, 293, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 591, 304, 305, 306, 507, 307, 308,
+	309, 310, 311, 312, 313, 314, 0, 315, 316, 409,
+	317, 318, 428, 539, 319, 320, 410, 321, 322, 0,
+	323, 324, 568, 325, 0, 326, 327, 328, 329, 330,
+	331, 332, 333, 334, 335, 336, 547, 0, 337, 338,
+	0, 339, 593, 340, 341, 342, 343, 344, 0, 569,
+	570, 0, 0, 429, 345, 548, 346, 549, 517, 347,
+	348, 349, 350, 2178, 352, 353, 0, 494, 354, 355,
+	356, 357, 358, 540, 0, 359, 360, 361, 362, 363,
+	416, 571, 0, 364, 594, 365, 366, 367, 368, 0,
+	0, 369, 0, 0, 370, 371, 372, 373, 374, 375,
+	376, 377, 0, 0, 0, 0, 0, 0, 0, 488,
+	489, 523, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 0, 498, 0, 112, 113, 114, 115, 116, 117,
+	118, 119, 0, 120, 121, 122, 0, 0, 0, 0,
+	0, 0, 0, 123, 124, 0, 125, 126, 577, 127,
+	128, 129, 378, 379, 578, 380, 0, 381, 0, 130,
+	131, 132, 133, 134, 135, 0, 543, 425, 136, 382,
+	383, 137, 0, 138, 139, 140, 141, 551, 0, 531,
+	0, 142, 143, 144, 145, 146, 0, 579, 147, 148,
+	149, 0, 150, 151, 152, 153, 154, 155, 0, 580,
+	156, 157, 158, 541, 532, 537, 542, 533, 534, 538,
+	159, 160, 161, 162, 163, 385, 164, 165, 386, 387,
+	166, 0, 167, 0, 168, 169, 170, 171, 172, 0,
+	173, 174, 175, 0, 0, 176, 177, 178, 179, 180,
+	0, 181, 182, 183, 0, 184, 185, 186, 0, 187,
+	188, 189, 190, 388, 191, 192, 193, 544, 0, 194,
+	0, 195, 196, 390, 197, 0, 198, 0, 199, 581,
+	0, 582, 200, 201, 202, 0, 203, 204, 552, 0,
+	392, 205, 0, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 0, 215, 216, 217, 218, 219, 220, 0,
+	221, 583, 393, 222, 223, 224, 225, 394, 395, 0,
+	396, 0, 226, 584, 227, 585, 228, 229, 230, 231,
+	232, 1364, 0, 233, 553, 586, 234, 587, 0, 235,
+	236, 426, 535, 536, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 398,
+	588, 399, 251, 252, 400, 0, 253, 254, 255, 0,
+	550, 256, 402, 257, 258, 259, 0, 260, 0, 481,
+	261, 262, 0, 0, 263, 403, 589, 264, 590, 545,
+	265, 266, 267, 268, 269, 270, 271, 0, 272, 273,
+	546, 274, 406, 277, 275, 276, 0, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 407, 287, 288, 289,
+	290, 0, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 591, 304, 305, 306,
+	408, 1369, 308, 309, 310, 311, 312, 313, 314, 59,
+	315, 316, 409, 317, 318, 428, 539, 319, 320, 410,
+	321, 322, 592, 323, 324, 411, 325, 0, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 547,
+	0, 337, 338, 61, 339, 593, 340, 341, 342, 343,
+	344, 0, 441, 413, 0, 0, 429, 345, 548, 346,
+	549, 0, 347, 348, 349, 350, 351, 352, 353, 0,
+	0, 354, 355, 356, 357, 358, 540, 0, 359, 360,
+	361, 362, 363, 615, 417, 0, 364, 594, 365, 366,
+	367, 368, 0, 0, 369, 523, 57, 370, 371, 372,
+	373, 374, 375, 376, 377, 0, 0, 58, 112, 113,
+	114, 115, 116, 117, 118, 119, 0, 120, 121, 122,
+	0, 0, 0, 0, 0, 1367, 0, 123, 124, 0,
+	125, 126, 577, 127, 128, 129, 378, 379, 578, 380,
+	0, 381, 0, 130, 131, 132, 133, 134, 135, 0,
+	543, 425, 136, 382, 383, 137, 0, 138, 139, 140,
+	141, 551, 0, 531, 0, 142, 143, 144, 145, 146,
+	0, 579, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 580, 156, 157, 158, 541, 532, 537,
+	542, 533, 534, 538, 159, 160, 161, 162, 163, 385,
+	164, 165, 386, 387, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 178, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 388, 191, 192,
+	193, 544, 0, 194, 0, 195, 196, 390, 197, 0,
+	198, 0, 199, 581, 0, 582, 200, 201, 202, 0,
+	203, 204, 552, 0, 392, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 217,
+	218, 219, 220, 0, 221, 583, 393, 222, 223, 224,
+	225, 394, 395, 0, 396, 0, 226, 584, 227, 585,
+	228, 229, 230, 231, 232, 1364, 0, 233, 553, 586,
+	234, 587, 0, 235, 236, 426, 535, 536, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 588, 399, 251, 252, 400, 0,
+	253, 254, 255, 0, 550, 256, 402, 257, 258, 259,
+	0, 260, 0, 481, 261, 262, 0, 0, 263, 403,
+	589, 264, 590, 545, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 546, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	407, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	591, 304, 305, 306, 408, 1369, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 428,
+	539, 319, 320, 410, 321, 322, 592, 323, 324, 411,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 547, 0, 337, 338, 0, 339, 593,
+	340, 341, 342, 343, 344, 0, 441, 413, 0, 0,
+	429, 345, 548, 346, 549, 0, 347, 348, 349, 350,
+	351, 352, 353, 0, 0, 354, 355, 356, 357, 358,
+	540, 0, 359, 360, 361, 362, 363, 416, 417, 0,
+	364, 594, 365, 366, 367, 368, 0, 0, 369, 523,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 0,
+	0, 0, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 0, 1367,
+	0, 123, 124, 0, 125, 126, 577, 127, 128, 129,
+	378, 379, 578, 380, 0, 381, 0, 130, 131, 132,
+	133, 134, 135, 0, 543, 425, 136, 382, 383, 137,
+	0, 138, 139, 140, 141, 551, 0, 531, 0, 142,
+	143, 144, 145, 146, 0, 579, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 580, 156, 157,
+	158, 541, 532, 537, 542, 533, 534, 538, 159, 160,
+	161, 162, 163, 385, 164, 165, 386, 387, 166, 0,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 178, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 388, 191, 192, 193, 544, 0, 194, 0, 195,
+	196, 390, 197, 0, 198, 0, 199, 581, 0, 582,
+	200, 201, 202, 0, 203, 204, 552, 0, 392, 205,
+	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 583,
+	393, 222, 223, 224, 225, 394, 395, 0, 396, 0,
+	226, 584, 227, 585, 228, 229, 230, 231, 232, 0,
+	0, 233, 553, 586, 234, 587, 0, 235, 236, 426,
+	535, 536, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 588, 399,
+	251, 252, 400, 0, 253, 254, 255, 0, 550, 256,
+	402, 257, 258, 259, 0, 260, 0, 0, 261, 262,
+	0, 0, 263, 403, 589, 264, 590, 545, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 546, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 407, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 591, 304, 305, 306, 408, 307,
+	308, 309, 310, 311, 312, 313, 314, 0, 315, 316,
+	409, 317, 318, 428, 539, 319, 320, 410, 321, 322,
+	592, 323, 324, 411, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 547, 0, 337,
+	338, 0, 339, 593, 340, 341, 342, 343, 344, 0,
+	441, 413, 0, 0, 429, 345, 548, 346, 549, 0,
+	347, 348, 349, 350, 351, 352, 353, 0, 0, 354,
+	355, 356, 357, 358, 540, 0, 359, 360, 361, 362,
+	363, 416, 417, 0, 364, 594, 365, 366, 367, 368,
+	0, 0, 369, 523, 0, 370, 371, 372, 373, 374,
+	375, 376, 377, 0, 0, 0, 112, 113, 114, 115,
+	116, 117, 118, 119, 0, 120, 121, 122, 0, 0,
+	0, 0, 0, 1899, 0, 123, 124, 0, 125, 126,
+	577, 127, 128, 129, 378, 379, 578, 380, 0, 381,
+	0, 130, 131, 132, 133, 134, 135, 0, 543, 425,
+	136, 382, 383, 137, 0, 138, 139, 140, 141, 551,
+	0, 531, 0, 142, 143, 144, 145, 146, 0, 579,
+	147, 148, 149, 0, 150, 151, 152, 153, 154, 155,
+	0, 580, 156, 157, 158, 541, 532, 537, 542, 533,
+	534, 538, 159, 160, 161, 162, 163, 385, 164, 165,
+	386, 387, 166, 0, 167, 0, 168, 169, 170, 171,
+	172, 0, 173, 174, 175, 0, 0, 176, 177, 178,
+	179, 180, 0, 181, 182, 183, 0, 184, 185, 186,
+	0, 187, 188, 189, 190, 388, 191, 192, 193, 544,
+	0, 194, 0, 195, 196, 390, 197, 0, 198, 0,
+	199, 581, 0, 582, 200, 201, 202, 0, 203, 204,
+	552, 0, 392, 205, 0, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 0, 215, 216, 217, 218, 219,
+	220, 0, 221, 583, 393, 222, 223, 224, 225, 394,
+	395, 0, 396, 0, 226, 584, 227, 585, 228, 229,
+	230, 231, 232, 0, 0, 233, 553, 586, 234, 587,
+	0, 235, 236, 426, 535, 536, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	427, 398, 588, 399, 251, 252, 400, 0, 253, 254,
+	255, 0, 550, 256, 402, 257, 258, 259, 0, 260,
+	0, 0, 261, 262, 0, 0, 263, 403, 589, 264,
+	590, 545, 265, 266, 267, 268, 269, 270, 271, 0,
+	272, 273, 546, 274, 406, 277, 275, 276, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 407, 287,
+	288, 289, 290, 0, 291, 292, 293, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 591, 304,
+	305, 306, 408, 1369, 308, 309, 310, 311, 312, 313,
+	314, 0, 315, 316, 409, 317, 318, 428, 539, 319,
+	320, 410, 321, 322, 592, 323, 324, 411, 325, 0,
+	326, 327, 328, 329, 330, 331, 332, 333, 334, 335,
+	336, 547, 0, 337, 338, 0, 339, 593, 340, 341,
+	342, 343, 344, 0, 441, 413, 0, 0, 429, 345,
+	548, 346, 549, 0, 347, 348, 349, 350, 351, 352,
+	353, 0, 0, 354, 355, 356, 357, 358, 540, 0,
+	359, 360, 361, 362, 363, 416, 417, 0, 364, 594,
+	365, 366, 367, 368, 0, 0, 369, 616, 0, 370,
+	371, 372, 373, 374, 375, 376, 377, 0, 0, 0,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 0, 56, 0, 123,
+	124, 0, 125, 126, 577, 127, 128, 129, 378, 379,
+	578, 380, 0, 381, 0, 130, 131, 132, 133, 134,
+	135, 0, 0, 425, 136, 382, 383, 137, 0, 138,
+	139, 140, 141, 384, 0, 620, 0, 142, 143, 144,
+	145, 146, 0, 579, 147, 148, 149, 0, 150, 151,
+	152, 153, 154, 155, 0, 580, 156, 157, 158, 0,
+	0, 0, 621, 0, 0, 0, 159, 160, 161, 162,
+	163, 385, 164, 165, 386, 387, 166, 0, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 178, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 187, 188, 189, 190, 388,
+	191, 192, 193, 389, 0, 194, 0, 195, 196, 390,
+	197, 0, 198, 0, 199, 581, 0, 582, 200, 201,
+	202, 0, 203, 204, 391, 0, 392, 205, 0, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 0, 215,
+	216, 217, 218, 219, 220, 0, 221, 583, 393, 222,
+	223, 224, 225, 394, 395, 0, 396, 0, 226, 584,
+	227, 585, 228, 229, 230, 231, 232, 0, 0, 233,
+	397, 586, 234, 587, 0, 235, 236, 426, 0, 0,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 427, 398, 588, 399, 251, 252,
+	400, 0, 253, 254, 255, 0, 401, 256, 402, 257,
+	258, 259, 0, 260, 0, 0, 261, 262, 0, 0,
+	263, 403, 589, 264, 590, 404, 265, 266, 267, 268,
+	269, 270, 271, 0, 272, 273, 405, 274, 406, 277,
+	275, 276, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 407, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 591, 304, 305, 306, 408, 307, 308, 309,
+	310, 311, 312, 313, 314, 59, 315, 316, 409, 317,
+	318, 428, 0, 319, 320, 410, 321, 322, 592, 323,
+	324, 411, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 412, 0, 337, 338, 61,
+	339, 593, 340, 341, 342, 343, 344, 0, 441, 413,
+	0, 0, 429, 345, 414, 346, 415, 0, 347, 348,
+	349, 350, 351, 352, 353, 0, 0, 354, 355, 356,
+	357, 358, 0, 0, 359, 360, 361, 362, 363, 615,
+	417, 0, 364, 594, 365, 366, 367, 368, 0, 0,
+	369, 0, 57, 370, 371, 372, 373, 374, 375, 376,
+	377, 0, 0, 58, 0, 0, 0, 0, 0, 616,
+	841, 845, 0, 0, 846, 0, 0, 0, 0, 0,
+	0, 56, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 0, 0,
+	0, 123, 124, 0, 125, 126, 577, 127, 128, 129,
+	378, 379, 578, 380, 0, 381, 0, 130, 131, 132,
+	133, 134, 135, 0, 0, 425, 136, 382, 383, 137,
+	0, 138, 139, 140, 141, 384, 0, 620, 0, 142,
+	143, 144, 145, 146, 0, 579, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 580, 156, 157,
+	158, 0, 0, 0, 621, 0, 0, 0, 159, 160,
+	161, 162, 163, 385, 164, 165, 386, 387, 166, 902,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 178, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 388, 191, 192, 193, 389, 838, 194, 0, 195,
+	196, 390, 197, 0, 198, 0, 199, 581, 0, 582,
+	200, 201, 202, 0, 203, 204, 391, 0, 392, 205,
+	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 583,
+	393, 222, 223, 224, 225, 394, 395, 0, 396, 0,
+	226, 584, 227, 585, 228, 229, 230, 231, 232, 0,
+	0, 233, 397, 586, 234, 587, 0, 235, 236, 426,
+	0, 0, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 588, 399,
+	251, 252, 400, 0, 253, 254, 255, 0, 401, 256,
+	402, 257, 258, 259, 0, 260, 839, 0, 261, 262,
+	0, 0, 263, 403, 589, 264, 590, 404, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 405, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 407, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 591, 304, 305, 306, 408, 307,
+	308, 309, 310, 311, 312, 313, 314, 0, 315, 316,
+	409, 317, 318, 428, 0, 319, 320, 410, 321, 322,
+	592, 323, 324, 411, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 412, 0, 337,
+	338, 0, 339, 593, 340, 341, 342, 343, 344, 0,
+	441, 413, 0, 0, 429, 345, 414, 346, 415, 837,
+	347, 348, 349, 350, 351, 352, 353, 0, 0, 354,
+	355, 356, 357, 358, 0, 0, 359, 360, 361, 362,
+	363, 416, 417, 0, 364, 594, 365, 366, 367, 368,
+	0, 0, 369, 0, 0, 370, 371, 372, 373, 374,
+	375, 376, 377, 616, 841, 845, 0, 0, 846, 0,
+	847, 842, 0, 0, 0, 0, 112, 113, 114, 115,
+	116, 117, 118, 119, 0, 120, 121, 122, 0, 0,
+	0, 0, 0, 0, 0, 123, 124, 0, 125, 126,
+	577, 127, 128, 129, 378, 379, 578, 380, 0, 381,
+	0, 130, 131, 132, 133, 134, 135, 0, 0, 425,
+	136, 382, 383, 137, 0, 138, 139, 140, 141, 384,
+	0, 620, 0, 142, 143, 144, 145, 146, 0, 579,
+	147, 148, 149, 0, 150, 151, 152, 153, 154, 155,
+	0, 580, 156, 157, 158, 0, 0, 0, 621, 0,
+	0, 0, 159, 160, 161, 162, 163, 385, 164, 165,
+	386, 387, 166, 897, 167, 0, 168, 169, 170, 171,
+	172, 0, 173, 174, 175, 0, 0, 176, 177, 178,
+	179, 180, 0, 181, 182, 183, 0, 184, 185, 186,
+	0, 187, 188, 189, 190, 388, 191, 192, 193, 389,
+	838, 194, 0, 195, 196, 390, 197, 0, 198, 0,
+	199, 581, 0, 582, 200, 201, 202, 0, 203, 204,
+	391, 0, 392, 205, 0, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 0, 215, 216, 217, 218, 219,
+	220, 0, 221, 583, 393, 222, 223, 224, 225, 394,
+	395, 0, 396, 0, 226, 584, 227, 585, 228, 229,
+	230, 231, 232, 0, 0, 233, 397, 586, 234, 587,
+	0, 235, 236, 426, 0, 0, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	427, 398, 588, 399, 251, 252, 400, 0, 253, 254,
+	255, 0, 401, 256, 402, 257, 258, 259, 0, 260,
+	839, 0, 261, 262, 0, 0, 263, 403, 589, 264,
+	590, 404, 265, 266, 267, 268, 269, 270, 271, 0,
+	272, 273, 405, 274, 406, 277, 275, 276, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 407, 287,
+	288, 289, 290, 0, 291, 292, 293, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 591, 304,
+	305, 306, 408, 307, 308, 309, 310, 311, 312, 313,
+	314, 0, 315, 316, 409, 317, 318, 428, 0, 319,
+	320, 410, 321, 322, 592, 323, 324, 411, 325, 0,
+	326, 327, 328, 329, 330, 331, 332, 333, 334, 335,
+	336, 412, 0, 337, 338, 0, 339, 593, 340, 341,
+	342, 343, 344, 0, 441, 413, 0, 0, 429, 345,
+	414, 346, 415, 837, 347, 348, 349, 350, 351, 352,
+	353, 0, 0, 354, 355, 356, 357, 358, 0, 0,
+	359, 360, 361, 362, 363, 416, 417, 0, 364, 594,
+	365, 366, 367, 368, 0, 0, 369, 0, 0, 370,
+	371, 372, 373, 374, 375, 376, 377, 616, 841, 845,
+	0, 0, 846, 0, 847, 842, 0, 0, 0, 0,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 123,
+	124, 0, 125, 126, 577, 127, 128, 129, 378, 379,
+	578, 380, 0, 381, 0, 130, 131, 132, 133, 134,
+	135, 0, 0, 425, 136, 382, 383, 137, 0, 138,
+	139, 140, 141, 384, 0, 620, 0, 142, 143, 144,
+	145, 146, 0, 579, 147, 148, 149, 0, 150, 151,
+	152, 153, 154, 155, 0, 580, 156, 157, 158, 0,
+	0, 0, 621, 0, 0, 0, 159, 160, 161, 162,
+	163, 385, 164, 165, 386, 387, 166, 0, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 178, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 187, 188, 189, 190, 388,
+	191, 192, 193, 389, 838, 194, 0, 195, 196, 390,
+	197, 0, 198, 0, 199, 581, 0, 582, 200, 201,
+	202, 0, 203, 204, 391, 0, 392, 205, 0, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 0, 215,
+	216, 217, 218, 219, 220, 0, 221, 583, 393, 222,
+	223, 224, 225, 394, 395, 0, 396, 0, 226, 584,
+	227, 585, 228, 229, 230, 231, 232, 0, 0, 233,
+	397, 586, 234, 587, 0, 235, 236, 426, 0, 0,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 427, 398, 588, 399, 251, 252,
+	400, 0, 253, 254, 255, 0, 401, 256, 402, 257,
+	258, 259, 0, 260, 839, 0, 261, 262, 0, 0,
+	263, 403, 589, 264, 590, 404, 265, 266, 267, 268,
+	269, 270, 271, 0, 272, 273, 405, 274, 406, 277,
+	275, 276, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 407, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 591, 304, 305, 306, 408, 307, 308, 309,
+	310, 311, 312, 313, 314, 0, 315, 316, 409, 317,
+	318, 428, 0, 319, 320, 410, 321, 322, 592, 323,
+	324, 411, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 412, 0, 337, 338, 0,
+	339, 593, 340, 341, 342, 343, 344, 0, 441, 413,
+	0, 0, 429, 345, 414, 346, 415, 837, 347, 348,
+	349, 350, 351, 352, 353, 0, 0, 354, 355, 356,
+	357, 358, 0, 0, 359, 360, 361, 362, 363, 416,
+	417, 0, 364, 594, 365, 366, 367, 368, 0, 0,
+	369, 0, 0, 370, 371, 372, 373, 374, 375, 376,
+	377, 616, 0, 845, 0, 0, 846, 0, 847, 842,
+	0, 0, 0, 0, 112, 113, 114, 115, 116, 117,
+	118, 119, 0, 120, 121, 122, 0, 0, 0, 0,
+	0, 0, 0, 123, 124, 0, 125, 126, 577, 127,
+	128, 129, 378, 379, 578, 380, 0, 381, 0, 130,
+	131, 132, 133, 134, 135, 0, 0, 425, 136, 382,
+	383, 137, 0, 138, 139, 140, 141, 384, 0, 620,
+	0, 142, 143, 144, 145, 146, 0, 579, 147, 148,
+	149, 0, 150, 151, 152, 153, 154, 155, 0, 580,
+	156, 157, 158, 0, 0, 0, 621, 0, 0, 0,
+	159, 160, 161, 162, 163, 385, 164, 165, 386, 387,
+	166, 1521, 167, 0, 168, 169, 170, 171, 172, 0,
+	173, 174, 175, 0, 0, 176, 177, 178, 179, 180,
+	0, 181, 182, 183, 0, 184, 185, 186, 0, 187,
+	188, 189, 190, 388, 191, 192, 193, 389, 838, 194,
+	0, 195, 196, 390, 197, 0, 198, 0, 199, 581,
+	0, 582, 200, 201, 202, 0, 203, 204, 391, 0,
+	392, 205, 0, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 0, 215, 216, 217, 218, 219, 220, 0,
+	221, 583, 393, 222, 223, 224, 225, 394, 395, 0,
+	396, 0, 226, 584, 227, 585, 228, 229, 230, 231,
+	232, 0, 0, 233, 397, 586, 234, 587, 0, 235,
+	236, 426, 0, 0, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 398,
+	588, 399, 251, 252, 400, 0, 253, 254, 255, 0,
+	401, 256, 402, 257, 258, 259, 0, 260, 839, 0,
+	261, 262, 0, 0, 263, 403, 589, 264, 590, 404,
+	265, 266, 267, 268, 269, 270, 271, 0, 272, 273,
+	405, 274, 406, 277, 275, 276, 0, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 407, 287, 288, 289,
+	290, 0, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 591, 304, 305, 306,
+	408, 307, 308, 309, 310, 311, 312, 313, 314, 0,
+	315, 316, 409, 317, 318, 428, 0, 319, 320, 410,
+	321, 322, 592, 323, 324, 411, 325, 0, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 412,
+	0, 337, 338, 0, 339, 593, 340, 341, 342, 343,
+	344, 0, 441, 413, 0, 0, 429, 345, 414, 346,
+	415, 837, 347, 348, 349, 350, 351, 352, 353, 0,
+	0, 354, 355, 356, 357, 358, 0, 0, 359, 360,
+	361, 362, 363, 416, 417, 0, 364, 594, 365, 366,
+	367, 368, 0, 0, 369, 0, 0, 370, 371, 372,
+	373, 374, 375, 376, 377, 109, 0, 0, 0, 0,
+	0, 0, 847, 1344, 1602, 1603, 1604, 0, 112, 113,
+	114, 115, 116, 117, 118, 119, 0, 120, 121, 122,
+	0, 0, 0, 0, 0, 0, 0, 123, 124, 0,
+	125, 126, 0, 127, 128, 129, 378, 379, 0, 380,
+	0, 381, 0, 130, 131, 132, 133, 134, 135, 0,
+	0, 425, 136, 382, 383, 137, 0, 138, 139, 140,
+	141, 384, 0, 0, 0, 142, 143, 144, 145, 146,
+	1601, 0, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 0, 156, 157, 158, 0, 0, 0,
+	0, 0, 0, 0, 159, 160, 161, 162, 163, 385,
+	164, 165, 386, 387, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 178, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 388, 191, 192,
+	193, 389, 0, 194, 0, 195, 196, 390, 197, 0,
+	198, 0, 199, 0, 0, 0, 200, 201, 202, 0,
+	203, 204, 391, 0, 392, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 217,
+	218, 219, 220, 0, 221, 0, 393, 222, 223, 224,
+	225, 394, 395, 0, 396, 0, 226, 0, 227, 0,
+	228, 229, 230, 231, 232, 0, 0, 233, 397, 0,
+	234, 0, 0, 235, 236, 426, 0, 0, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 0, 399, 251, 252, 400, 0,
+	253, 254, 255, 0, 401, 256, 402, 257, 258, 259,
+	0, 260, 0, 0, 261, 262, 0, 0, 263, 403,
+	0, 264, 0, 404, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 405, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	407, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	0, 304, 305, 306, 408, 307, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 428,
+	0, 319, 320, 410, 321, 322, 0, 323, 324, 411,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 412, 0, 337, 338, 0, 339, 0,
+	340, 341, 342, 343, 344, 0, 441, 413, 0, 0,
+	429, 345, 414, 346, 415, 0, 347, 348, 349, 350,
+	351, 352, 353, 0, 0, 354, 355, 356, 357, 358,
+	0, 0, 359, 360, 361, 362, 363, 416, 417, 0,
+	364, 0, 365, 366, 367, 368, 0, 0, 369, 0,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 0,
+	0, 0, 1598, 1599, 1600, 523, 1589, 1590, 1591, 1592,
+	1593, 1594, 1595, 1596, 1597, 0, 0, 0, 112, 113,
+	114, 115, 116, 117, 118, 119, 0, 120, 121, 122,
+	0, 0, 0, 0, 0, 0, 0, 123, 124, 0,
+	125, 126, 577, 127, 128, 129, 378, 379, 578, 380,
+	0, 381, 0, 130, 131, 132, 133, 134, 135, 0,
+	543, 425, 136, 382, 383, 137, 0, 138, 139, 140,
+	141, 551, 0, 531, 0, 142, 143, 144, 145, 146,
+	0, 579, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 580, 156, 157, 158, 541, 532, 537,
+	542, 533, 534, 538, 159, 160, 161, 162, 163, 385,
+	164, 165, 386, 387, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 178, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 388, 191, 192,
+	193, 544, 0, 194, 0, 195, 196, 390, 197, 0,
+	198, 0, 199, 581, 0, 582, 200, 201, 202, 0,
+	203, 204, 552, 0, 392, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 217,
+	218, 219, 220, 0, 221, 583, 393, 222, 223, 224,
+	225, 394, 395, 0, 396, 0, 226, 584, 227, 585,
+	228, 229, 230, 231, 232, 0, 0, 233, 553, 586,
+	234, 587, 0, 235, 236, 426, 535, 536, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 588, 399, 251, 252, 400, 0,
+	253, 254, 255, 0, 550, 256, 402, 257, 258, 259,
+	0, 260, 0, 0, 261, 262, 0, 0, 263, 403,
+	589, 264, 590, 545, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 546, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	407, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	591, 304, 305, 306, 408, 307, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 428,
+	539, 319, 320, 410, 321, 322, 592, 323, 324, 411,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 547, 0, 337, 338, 0, 339, 593,
+	340, 341, 342, 343, 344, 0, 441, 413, 0, 0,
+	429, 345, 548, 346, 549, 0, 347, 348, 349, 350,
+	351, 352, 353, 0, 0, 354, 355, 356, 357, 358,
+	540, 0, 359, 360, 361, 362, 363, 416, 417, 0,
+	364, 594, 365, 366, 367, 368, 109, 0, 369, 0,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 112,
+	113, 114, 115, 116, 117, 118, 119, 0, 120, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 123, 124,
+	0, 125, 126, 0, 127, 128, 129, 378, 379, 0,
+	380, 0, 381, 0, 130, 131, 132, 133, 134, 135,
+	0, 0, 425, 136, 382, 383, 137, 0, 138, 139,
+	140, 141, 384, 0, 0, 0, 142, 143, 144, 145,
+	146, 0, 0, 147, 148, 149, 0, 150, 151, 152,
+	153, 154, 155, 0, 0, 156, 157, 158, 0, 0,
+	0, 0, 0, 0, 0, 159, 160, 161, 162, 163,
+	385, 164, 165, 386, 387, 166, 0, 167, 0, 168,
+	169, 170, 171, 172, 0, 173, 174, 175, 0, 0,
+	176, 177, 178, 179, 180, 0, 181, 182, 183, 0,
+	184, 185, 186, 0, 187, 188, 189, 190, 388, 191,
+	192, 193, 389, 0, 194, 0, 195, 196, 390, 197,
+	0, 198, 0, 199, 0, 0, 0, 200, 201, 202,
+	0, 203, 204, 391, 0, 392, 205, 0, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 0, 215, 216,
+	217, 218, 219, 220, 0, 221, 0, 393, 222, 223,
+	224, 225, 394, 395, 0, 396, 0, 226, 0, 227,
+	0, 228, 229, 230, 231, 232, 0, 0, 233, 397,
+	0, 234, 0, 0, 235, 236, 426, 0, 0, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+	248, 249, 250, 427, 398, 0, 399, 251, 252, 400,
+	0, 253, 254, 255, 0, 401, 256, 402, 257, 258,
+	259, 0, 260, 0, 0, 261, 262, 0, 0, 263,
+	403, 0, 264, 0, 404, 265, 266, 267, 268, 269,
+	270, 271, 0, 272, 273, 405, 274, 406, 277, 275,
+	276, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 407, 287, 288, 289, 290, 0, 291, 292, 293,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 0, 304, 305, 306, 408, 307, 308, 309, 310,
+	311, 312, 313, 314, 59, 315, 316, 409, 317, 318,
+	428, 0, 319, 320, 410, 321, 322, 0, 323, 324,
+	411, 325, 0, 326, 327, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 412, 0, 337, 338, 61, 339,
+	0, 340, 341, 342, 343, 344, 0, 441, 413, 0,
+	0, 429, 345, 414, 346, 415, 0, 347, 348, 349,
+	350, 351, 352, 353, 0, 0, 354, 355, 356, 357,
+	358, 0, 0, 359, 360, 361, 362, 363, 615, 417,
+	0, 364, 0, 365, 366, 367, 368, 0, 0, 369,
+	0, 57, 370, 371, 372, 373, 374, 375, 376, 377,
+	0, 0, 58, 0, 0, 0, 0, 0, 109, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	56, 112, 113, 114, 115, 116, 117, 118, 119, 0,
+	120, 121, 122, 0, 0, 0, 0, 0, 1625, 0,
+	123, 124, 0, 125, 126, 0, 127, 128, 129, 378,
+	379, 0, 380, 0, 381, 0, 130, 131, 132, 133,
+	134, 135, 0, 0, 425, 136, 382, 383, 137, 0,
+	138, 139, 140, 141, 384, 0, 0, 0, 142, 143,
+	144, 145, 146, 0, 0, 147, 148, 149, 0, 150,
+	151, 152, 153, 154, 155, 0, 0, 156, 157, 158,
+	0, 0, 0, 0, 0, 0, 0, 159, 160, 161,
+	162, 163, 385, 164, 165, 386, 387, 166, 0, 167,
+	0, 168, 169, 170, 171, 172, 0, 173, 174, 175,
+	0, 0, 176, 177, 178, 179, 180, 0, 181, 182,
+	183, 0, 184, 185, 186, 0, 187, 188, 189, 190,
+	388, 191, 192, 193, 389, 0, 194, 0, 195, 196,
+	390, 197, 0, 198, 0, 199, 0, 0, 0, 200,
+	201, 202, 0, 203, 204, 391, 0, 392, 205, 0,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 0,
+	215, 216, 217, 218, 219, 220, 0, 221, 0, 393,
+	222, 223, 224, 225, 394, 395, 0, 396, 0, 226,
+	0, 227, 0, 228, 229, 230, 231, 232, 0, 0,
+	233, 397, 0, 234, 0, 0, 235, 236, 426, 0,
+	0, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	246, 247, 248, 249, 250, 427, 398, 0, 399, 251,
+	252, 400, 0, 253, 254, 255, 0, 401, 256, 402,
+	257, 258, 259, 0, 260, 0, 0, 261, 262, 0,
+	0, 263, 403, 0, 264, 0, 404, 265, 266, 267,
+	268, 269, 270, 271, 0, 272, 273, 405, 274, 406,
+	277, 275, 276, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 407, 287, 288, 289, 290, 0, 291,
+	292, 293, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 0, 304, 305, 306, 408, 307, 308,
+	309, 310, 311, 312, 313, 314, 0, 315, 316, 409,
+	317, 318, 428, 0, 319, 320, 410, 321, 322, 0,
+	323, 324, 411, 325, 0, 326, 327, 328, 329, 330,
+	331, 332, 333, 334, 335, 336, 412, 0, 337, 338,
+	0, 339, 0, 340, 341, 342, 343, 344, 0, 441,
+	413, 0, 0, 429, 345, 414, 346, 415, 0, 347,
+	348, 349, 350, 351, 352, 353, 0, 0, 354, 355,
+	356, 357, 358, 0, 0, 359, 360, 361, 362, 363,
+	416, 417, 0, 364, 0, 365, 366, 367, 368, 109,
+	0, 369, 0, 0, 370, 371, 372, 373, 374, 375,
+	376, 377, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 0, 0,
+	0, 123, 124, 699, 125, 126, 0, 127, 128, 129,
+	378, 379, 0, 380, 0, 381, 0, 130, 131, 132,
+	133, 134, 135, 0, 0, 425, 136, 382, 383, 137,
+	0, 138, 139, 140, 141, 384, 0, 0, 0, 142,
+	143, 144, 145, 146, 0, 0, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 0, 156, 157,
+	158, 0, 0, 0, 0, 0, 0, 0, 159, 160,
+	161, 162, 163, 385, 164, 165, 386, 387, 166, 0,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 178, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 388, 191, 192, 193, 389, 0, 194, 0, 195,
+	196, 390, 197, 0, 198, 0, 199, 0, 0, 0,
+	200, 201, 202, 0, 203, 204, 391, 0, 392, 205,
+	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 0,
+	393, 222, 223, 224, 225, 394, 395, 0, 396, 0,
+	226, 0, 227, 0, 228, 229, 230, 231, 232, 0,
+	0, 233, 397, 0, 234, 0, 0, 235, 236, 426,
+	0, 0, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 0, 399,
+	251, 252, 400, 0, 253, 254, 255, 0, 401, 256,
+	402, 257, 258, 259, 0, 260, 0, 0, 261, 262,
+	0, 0, 263, 403, 0, 264, 0, 404, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 405, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 407, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 0, 304, 305, 306, 408, 307,
+	308, 309, 310, 311, 312, 313, 314, 0, 315, 316,
+	409, 317, 318, 428, 0, 319, 320, 410, 321, 322,
+	0, 323, 324, 411, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 412, 0, 337,
+	338, 0, 339, 0, 340, 341, 342, 343, 344, 0,
+	441, 413, 0, 0, 429, 345, 414, 346, 415, 0,
+	347, 348, 349, 350, 351, 352, 353, 0, 0, 354,
+	355, 356, 357, 358, 0, 0, 359, 360, 361, 362,
+	363, 416, 417, 0, 364, 0, 365, 366, 367, 368,
+	0, 0, 369, 109, 0, 370, 371, 372, 373, 374,
+	375, 376, 377, 0, 0, 0, 112, 113, 114, 115,
+	116, 117, 118, 119, 0, 120, 121, 122, 0, 0,
+	0, 0, 0, 1243, 0, 123, 124, 0, 125, 126,
+	0, 127, 128, 129, 378, 379, 0, 380, 0, 381,
+	0, 130, 131, 132, 133, 134, 135, 0, 0, 425,
+	136, 382, 383, 137, 0, 138, 139, 140, 141, 384,
+	0, 0, 0, 142, 143, 144, 145, 146, 0, 0,
+	147, 148, 149, 0, 150, 151, 152, 153, 154, 155,
+	0, 0, 156, 157, 158, 0, 0, 0, 0, 0,
+	0, 0, 159, 160, 161, 162, 163, 385, 164, 165,
+	386, 387, 166, 0, 167, 0, 168, 169, 170, 171,
+	172, 0, 173, 174, 175, 0, 0, 176, 177, 178,
+	179, 180, 0, 181, 182, 183, 0, 184, 185, 186,
+	0, 187, 188, 189, 190, 388, 191, 192, 193, 389,
+	0, 194, 0, 195, 196, 390, 197, 0, 198, 0,
+	199, 0, 0, 0, 200, 201, 202, 0, 203, 204,
+	391, 0, 392, 205, 0, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 0, 215, 216, 217, 218, 219,
+	220, 0, 221, 0, 393, 222, 223, 224, 225, 394,
+	395, 0, 396, 0, 226, 0, 227, 0, 228, 229,
+	230, 231, 232, 0, 0, 233, 397, 0, 234, 0,
+	0, 235, 236, 426, 0, 0, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	427, 398, 0, 399, 251, 252, 400, 0, 253, 254,
+	255, 0, 401, 256, 402, 257, 258, 259, 0, 260,
+	0, 0, 261, 262, 0, 0, 263, 403, 0, 264,
+	0, 404, 265, 266, 267, 268, 269, 270, 271, 0,
+	272, 273, 405, 274, 406, 277, 275, 276, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 407, 287,
+	288, 289, 290, 0, 291, 292, 293, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 0, 304,
+	305, 306, 408, 307, 308, 309, 310, 311, 312, 313,
+	314, 0, 315, 316, 409, 317, 318, 428, 0, 319,
+	320, 410, 321, 322, 0, 323, 324, 411, 325, 0,
+	326, 327, 328, 329, 330, 331, 332, 333, 334, 335,
+	336, 412, 0, 337, 338, 0, 339, 0, 340, 341,
+	342, 343, 344, 0, 441, 413, 0, 0, 429, 345,
+	414, 346, 415, 0, 347, 348, 349, 350, 351, 352,
+	353, 0, 0, 354, 355, 356, 357, 358, 0, 0,
+	359, 360, 361, 362, 363, 416, 417, 0, 364, 0,
+	365, 366, 367, 368, 0, 0, 369, 109, 0, 370,
+	371, 372, 373, 374, 375, 376, 377, 0, 0, 0,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 0, 1819, 0, 123,
+	124, 0, 125, 126, 0, 127, 128, 129, 378, 379,
+	0, 380, 0, 381, 0, 130, 131, 132, 133, 134,
+	135, 0, 0, 425, 136, 382, 383, 137, 0, 138,
+	139, 140, 141, 384, 0, 0, 0, 142, 143, 144,
+	145, 146, 0, 0, 147, 148, 149, 0, 150, 151,
+	152, 153, 154, 155, 0, 0, 156, 157, 158, 0,
+	0, 0, 0, 0, 0, 0, 159, 160, 161, 162,
+	163, 385, 164, 165, 386, 387, 166, 0, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 178, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 187, 188, 189, 190, 388,
+	191, 192, 193, 389, 0, 194, 0, 195, 196, 390,
+	197, 0, 198, 0, 199, 0, 0, 0, 200, 201,
+	202, 0, 203, 204, 391, 0, 392, 205, 0, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 0, 215,
+	216, 217, 218, 219, 220, 0, 221, 0, 393, 222,
+	223, 224, 225, 394, 395, 0, 396, 0, 226, 0,
+	227, 0, 228, 229, 230, 231, 232, 0, 0, 233,
+	397, 0, 234, 0, 0, 235, 236, 426, 0, 0,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 427, 398, 0, 399, 251, 252,
+	400, 0, 253, 254, 255, 0, 401, 256, 402, 257,
+	258, 259, 0, 260, 0, 0, 261, 262, 0, 0,
+	263, 403, 0, 264, 0, 404, 265, 266, 267, 268,
+	269, 270, 271, 0, 272, 273, 405, 274, 406, 277,
+	275, 276, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 407, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 0, 304, 305, 306, 408, 307, 308, 309,
+	310, 311, 312, 313, 314, 0, 315, 316, 409, 317,
+	318, 428, 0, 319, 320, 410, 321, 322, 0, 323,
+	324, 411, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 412, 0, 337, 338, 0,
+	339, 0, 340, 341, 342, 343, 344, 0, 441, 413,
+	0, 0, 429, 345, 414, 346, 415, 0, 347, 348,
+	349, 350, 351, 352, 353, 0, 0, 354, 355, 356,
+	357, 358, 0, 0, 359, 360, 361, 362, 363, 416,
+	417, 0, 364, 0, 365, 366, 367, 368, 0, 0,
+	369, 109, 0, 370, 371, 372, 373, 374, 375, 376,
+	377, 0, 0, 0, 112, 113, 114, 115, 116, 117,
+	118, 119, 0, 120, 121, 122, 0, 0, 0, 0,
+	0, 1691, 0, 123, 124, 0, 125, 126, 0, 127,
+	128, 129, 378, 379, 0, 380, 0, 381, 0, 130,
+	131, 132, 133, 134, 135, 0, 0, 425, 136, 382,
+	383, 137, 0, 138, 139, 140, 141, 384, 0, 0,
+	0, 142, 143, 144, 145, 146, 0, 0, 147, 148,
+	149, 0, 150, 151, 152, 153, 154, 155, 0, 0,
+	156, 157, 158, 0, 0, 0, 0, 0, 0, 0,
+	159, 160, 161, 162, 163, 385, 164, 165, 386, 387,
+	166, 0, 167, 0, 168, 169, 170, 171, 172, 0,
+	173, 174, 175, 0, 0, 176, 177, 178, 179, 180,
+	0, 181, 182, 183, 0, 184, 185, 186, 0, 187,
+	188, 189, 190, 388, 191, 192, 193, 389, 0, 194,
+	0, 195, 196, 390, 197, 0, 198, 0, 199, 0,
+	0, 0, 200, 201, 202, 0, 203, 204, 391, 0,
+	392, 205, 0, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 0, 215, 216, 217, 218, 219, 220, 0,
+	221, 0, 393, 222, 223, 224, 225, 394, 395, 0,
+	396, 0, 226, 0, 227, 0, 228, 229, 230, 231,
+	232, 0, 0, 233, 397, 0, 234, 0, 0, 235,
+	236, 426, 0, 0, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 398,
+	0, 399, 251, 252, 400, 0, 253, 254, 255, 0,
+	401, 256, 402, 257, 258, 259, 0, 260, 0, 0,
+	261, 262, 0, 0, 263, 403, 0, 264, 0, 404,
+	265, 266, 267, 268, 269, 270, 271, 0, 272, 273,
+	405, 274, 406, 277, 275, 276, 0, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 407, 287, 288, 289,
+	290, 0, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 0, 304, 305, 306,
+	408, 307, 308, 309, 310, 311, 312, 313, 314, 0,
+	315, 316, 409, 317, 318, 428, 0, 319, 320, 410,
+	321, 322, 0, 323, 324, 411, 325, 0, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 412,
+	0, 337, 338, 0, 339, 0, 340, 341, 342, 343,
+	344, 0, 441, 413, 0, 0, 429, 345, 414, 346,
+	415, 0, 347, 348, 349, 350, 351, 352, 353, 0,
+	0, 354, 355, 356, 357, 358, 0, 0, 359, 360,
+	361, 362, 363, 416, 417, 0, 364, 0, 365, 366,
+	367, 368, 0, 0, 369, 616, 0, 370, 371, 372,
+	373, 374, 375, 376, 377, 0, 0, 0, 112, 113,
+	114, 115, 116, 117, 118, 119, 0, 120, 121, 122,
+	0, 0, 0, 0, 0, 714, 0, 123, 124, 0,
+	125, 126, 577, 127, 128, 129, 378, 379, 578, 380,
+	0, 381, 0, 130, 131, 132, 133, 134, 135, 0,
+	0, 425, 136, 382, 383, 137, 0, 138, 139, 140,
+	141, 384, 0, 620, 0, 142, 143, 144, 145, 146,
+	0, 579, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 580, 156, 157, 158, 0, 0, 0,
+	621, 0, 0, 0, 159, 160, 161, 162, 163, 385,
+	164, 165, 386, 387, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 178, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 388, 191, 192,
+	193, 389, 0, 194, 0, 195, 196, 390, 197, 0,
+	198, 0, 199, 581, 0, 582, 200, 201, 202, 0,
+	203, 204, 391, 0, 392, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 217,
+	218, 219, 220, 0, 221, 583, 393, 222, 223, 224,
+	225, 394, 395, 0, 396, 0, 226, 584, 227, 585,
+	228, 229, 230, 231, 232, 0, 0, 233, 397, 586,
+	234, 587, 0, 235, 236, 426, 0, 0, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 588, 399, 251, 252, 400, 0,
+	253, 254, 255, 0, 401, 256, 402, 257, 258, 259,
+	0, 260, 0, 0, 261, 262, 0, 0, 263, 403,
+	589, 264, 590, 404, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 405, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	407, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	591, 304, 305, 306, 408, 307, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 428,
+	0, 319, 320, 410, 321, 322, 592, 323, 324, 411,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 412, 0, 337, 338, 0, 339, 593,
+	340, 341, 342, 343, 344, 0, 441, 413, 0, 0,
+	429, 345, 414, 346, 415, 0, 347, 348, 349, 350,
+	351, 352, 353, 0, 0, 354, 355, 356, 357, 358,
+	0, 0, 359, 360, 361, 362, 363, 416, 417, 0,
+	364, 594, 365, 366, 367, 368, 109, 0, 369, 0,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 112,
+	113, 114, 115, 116, 117, 118, 119, 0, 120, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 123, 124,
+	0, 125, 126, 0, 127, 128, 129, 378, 379, 0,
+	380, 0, 381, 0, 130, 131, 132, 133, 134, 135,
+	0, 0, 425, 136, 382, 383, 137, 1279, 138, 139,
+	140, 141, 384, 0, 0, 0, 142, 143, 144, 145,
+	146, 0, 0, 147, 148, 149, 1277, 150, 151, 152,
+	153, 154, 155, 0, 0, 156, 157, 158, 0, 0,
+	0, 0, 0, 0, 0, 159, 160, 161, 162, 163,
+	385, 164, 165, 386, 387, 166, 0, 167, 0, 168,
+	169, 170, 171, 172, 0, 173, 174, 175, 0, 0,
+	176, 177, 178, 179, 180, 0, 181, 182, 183, 0,
+	184, 185, 186, 0, 1283, 188, 189, 190, 388, 191,
+	192, 193, 389, 0, 194, 0, 195, 196, 390, 197,
+	0, 198, 1284, 199, 0, 0, 0, 200, 201, 202,
+	0, 203, 204, 391, 0, 392, 205, 0, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 0, 215, 216,
+	1281, 218, 219, 220, 0, 221, 0, 393, 222, 223,
+	224, 225, 394, 395, 0, 396, 0, 226, 0, 227,
+	0, 228, 229, 230, 231, 232, 0, 0, 233, 397,
+	0, 234, 1572, 0, 235, 236, 426, 0, 0, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+	248, 249, 250, 427, 398, 0, 399, 251, 252, 400,
+	0, 253, 254, 255, 0, 401, 256, 402, 257, 258,
+	259, 0, 260, 0, 0, 261, 262, 0, 0, 263,
+	403, 0, 264, 0, 404, 265, 266, 267, 268, 269,
+	270, 271, 0, 272, 273, 405, 274, 406, 277, 275,
+	276, 1282, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 407, 287, 288, 289, 290, 0, 291, 292, 293,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 0, 304, 305, 306, 408, 307, 308, 309, 310,
+	311, 312, 313, 314, 0, 315, 316, 409, 317, 318,
+	428, 0, 319, 320, 410, 321, 322, 0, 323, 324,
+	411, 325, 0, 326, 327, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 412, 0, 337, 338, 0, 339,
+	0, 340, 341, 342, 343, 344, 0, 441, 413, 0,
+	0, 429, 345, 414, 346, 415, 0, 347, 348, 349,
+	350, 351, 352, 353, 0, 1280, 354, 355, 356, 357,
+	358, 0, 0, 359, 360, 361, 362, 363, 416, 417,
+	0, 364, 0, 365, 366, 367, 368, 109, 0, 369,
+	0, 0, 370, 371, 372, 373, 374, 375, 376, 377,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 123,
+	124, 0, 125, 126, 0, 127, 128, 129, 378, 379,
+	0, 380, 0, 381, 0, 130, 131, 132, 133, 134,
+	135, 0, 0, 425, 136, 382, 383, 137, 1279, 138,
+	139, 140, 141, 384, 0, 0, 1274, 142, 143, 144,
+	145, 146, 0, 0, 147, 148, 149, 1277, 150, 151,
+	152, 153, 154, 155, 0, 0, 156, 157, 158, 0,
+	0, 0, 0, 0, 0, 0, 159, 160, 161, 162,
+	163, 385, 164, 165, 386, 387, 166, 0, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 178, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 1283, 188, 189, 190, 388,
+	191, 192, 193, 389, 0, 194, 0, 195, 196, 390,
+	197, 0, 198, 1284, 199, 0, 0, 0, 200, 201,
+	202, 0, 203, 204, 391, 0, 392, 205, 0, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 0, 215,
+	216, 1281, 218, 219, 220, 0, 221, 0, 393, 222,
+	223, 224, 225, 394, 395, 0, 396, 0, 226, 0,
+	227, 0, 228, 229, 230, 231, 232, 0, 0, 233,
+	397, 0, 234, 0, 0, 235, 236, 426, 0, 0,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 427, 398, 0, 399, 251, 252,
+	400, 0, 253, 254, 255, 0, 401, 256, 402, 257,
+	258, 259, 0, 260, 0, 0, 261, 262, 0, 0,
+	263, 403, 0, 264, 0, 404, 265, 266, 267, 268,
+	269, 270, 271, 0, 272, 273, 405, 274, 406, 277,
+	275, 276, 1282, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 407, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 0, 304, 305, 306, 408, 307, 308, 309,
+	310, 311, 312, 313, 314, 0, 315, 316, 409, 317,
+	318, 428, 0, 319, 320, 410, 321, 322, 0, 323,
+	324, 411, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 412, 0, 337, 338, 0,
+	339, 0, 340, 341, 342, 343, 344, 0, 441, 413,
+	0, 0, 429, 345, 414, 346, 415, 0, 347, 348,
+	349, 350, 351, 352, 353, 0, 1280, 354, 355, 356,
+	357, 358, 0, 0, 359, 360, 361, 362, 363, 416,
+	417, 0, 364, 0, 365, 366, 367, 368, 109, 0,
+	369, 0, 0, 370, 371, 372, 373, 374, 375, 376,
+	377, 112, 113, 114, 115, 116, 117, 118, 119, 0,
+	120, 121, 122, 0, 0, 0, 0, 0, 0, 0,
+	123, 124, 0, 125, 126, 0, 127, 128, 129, 378,
+	379, 0, 380, 0, 381, 0, 130, 131, 132, 133,
+	134, 135, 0, 0, 425, 136, 382, 383, 137, 1279,
+	138, 139, 140, 141, 384, 0, 0, 0, 142, 143,
+	144, 145, 146, 0, 0, 147, 148, 149, 1277, 150,
+	151, 152, 153, 154, 155, 0, 0, 156, 157, 158,
+	0, 0, 0, 0, 0, 0, 0, 159, 160, 161,
+	162, 163, 385, 164, 165, 386, 387, 166, 0, 167,
+	0, 168, 169, 170, 171, 172, 0, 173, 174, 175,
+	0, 0, 176, 177, 178, 179, 180, 0, 181, 182,
+	183, 0, 184, 185, 186, 0, 1283, 188, 189, 190,
+	388, 191, 192, 193, 389, 0, 194, 0, 195, 196,
+	390, 197, 0, 198, 1284, 199, 0, 0, 0, 200,
+	201, 202, 0, 203, 204, 391, 0, 392, 205, 0,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 0,
+	215, 216, 1281, 218, 219, 220, 0, 221, 0, 393,
+	222, 223, 224, 225, 394, 395, 0, 396, 0, 226,
+	0, 227, 0, 228, 229, 230, 231, 232, 0, 0,
+	233, 397, 0, 234, 0, 0, 235, 236, 426, 0,
+	0, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	246, 247, 248, 249, 250, 427, 398, 0, 399, 251,
+	252, 400, 0, 253, 254, 255, 0, 401, 256, 402,
+	257, 258, 259, 0, 260, 0, 0, 261, 262, 0,
+	0, 263, 403, 0, 264, 0, 404, 265, 266, 267,
+	268, 269, 270, 271, 0, 272, 273, 405, 274, 406,
+	277, 275, 276, 1282, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 407, 287, 288, 289, 290, 0, 291,
+	292, 293, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 0, 304, 305, 306, 408, 307, 308,
+	309, 310, 311, 312, 313, 314, 0, 315, 316, 409,
+	317, 318, 428, 0, 319, 320, 410, 321, 322, 0,
+	323, 324, 411, 325, 0, 326, 327, 328, 329, 330,
+	331, 332, 333, 334, 335, 336, 412, 0, 337, 338,
+	0, 339, 0, 340, 341, 342, 343, 344, 0, 441,
+	413, 0, 0, 429, 345, 414, 346, 415, 0, 347,
+	348, 349, 350, 351, 352, 353, 0, 1280, 354, 355,
+	356, 357, 358, 0, 0, 359, 360, 361, 362, 363,
+	416, 417, 0, 364, 0, 365, 366, 367, 368, 109,
+	0, 369, 0, 0, 370, 371, 372, 373, 374, 375,
+	376, 377, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 0, 0,
+	0, 123, 124, 0, 125, 126, 0, 127, 128, 129,
+	378, 379, 0, 380, 0, 381, 0, 130, 131, 132,
+	133, 134, 135, 0, 0, 425, 136, 382, 383, 137,
+	0, 138, 139, 140, 141, 384, 0, 0, 0, 142,
+	143, 144, 145, 146, 0, 0, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 0, 156, 157,
+	158, 0, 0, 0, 0, 0, 0, 0, 159, 160,
+	161, 162, 163, 385, 164, 165, 386, 387, 166, 0,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 178, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 388, 191, 192, 193, 389, 0, 194, 0, 195,
+	196, 390, 197, 0, 198, 0, 199, 0, 0, 0,
+	200, 201, 202, 0, 203, 204, 391, 0, 392, 205,
+	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 0,
+	393, 222, 223, 224, 225, 394, 395, 0, 396, 0,
+	226, 0, 227, 0, 228, 229, 230, 231, 232, 0,
+	0, 233, 397, 0, 234, 0, 0, 235, 236, 426,
+	0, 0, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 0, 399,
+	251, 252, 400, 0, 253, 254, 255, 0, 401, 256,
+	402, 257, 258, 259, 0, 260, 0, 0, 261, 262,
+	0, 0, 263, 403, 0, 264, 0, 404, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 405, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 407, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 0, 304, 305, 306, 408, 307,
+	308, 309, 310, 311, 312, 313, 314, 0, 315, 316,
+	409, 317, 318, 428, 0, 319, 320, 410, 321, 322,
+	0, 323, 324, 411, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 412, 0, 337,
+	338, 0, 339, 0, 340, 341, 342, 343, 344, 0,
+	441, 413, 0, 0, 429, 345, 414, 346, 415, 0,
+	347, 348, 349, 350, 351, 352, 353, 0, 0, 354,
+	355, 356, 357, 358, 0, 2099, 359, 360, 361, 362,
+	363, 416, 417, 0, 364, 0, 365, 366, 367, 368,
+	109, 0, 369, 0, 0, 370, 371, 372, 373, 374,
+	375, 376, 377, 112, 113, 114, 115, 116, 117, 118,
+	119, 0, 120, 121, 122, 0, 0, 0, 0, 0,
+	1625, 0, 123, 124, 0, 125, 126, 0, 127, 128,
+	129, 378, 379, 0, 380, 0, 381, 0, 130, 131,
+	132, 133, 134, 135, 0, 0, 425, 136, 382, 383,
+	137, 0, 138, 139, 140, 141, 384, 0, 0, 0,
+	142, 143, 144, 145, 146, 0, 0, 147, 148, 149,
+	0, 150, 151, 152, 153, 154, 155, 0, 0, 156,
+	157, 158, 0, 0, 0, 0, 0, 0, 0, 159,
+	160, 161, 162, 163, 385, 164, 165, 386, 387, 166,
+	0, 167, 0, 168, 169, 170, 171, 172, 0, 173,
+	174, 175, 0, 0, 176, 177, 178, 179, 180, 0,
+	181, 182, 183, 0, 184, 185, 186, 0, 187, 188,
+	189, 190, 388, 191, 192, 193, 389, 0, 194, 0,
+	195, 196, 390, 197, 0, 198, 0, 199, 0, 0,
+	0, 200, 201, 202, 0, 203, 204, 391, 0, 392,
+	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 0, 215, 216, 217, 218, 219, 220, 0, 221,
+	0, 393, 222, 223, 224, 225, 394, 395, 0, 396,
+	0, 226, 0, 227, 0, 228, 229, 230, 231, 232,
+	0, 0, 233, 397, 0, 234, 0, 0, 235, 236,
+	426, 0, 0, 237, 238, 239, 240, 241, 242, 243,
+	244, 245, 246, 247, 248, 249, 250, 427, 398, 0,
+	399, 251, 252, 400, 0, 253, 254, 255, 0, 401,
+	256, 402, 257, 258, 259, 0, 260, 0, 0, 261,
+	262, 0, 0, 263, 403, 0, 264, 0, 404, 265,
+	266, 267, 268, 269, 270, 271, 0, 272, 273, 405,
+	274, 406, 277, 275, 276, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 407, 287, 288, 289, 290,
+	0, 291, 292, 293, 294, 295, 296, 297, 298, 299,
+	300, 301, 0, 302, 303, 0, 304, 305, 306, 408,
+	307, 308, 309, 310, 311, 312, 313, 314, 0, 315,
+	316, 409, 317, 318, 428, 0, 319, 320, 410, 321,
+	322, 0, 323, 324, 411, 325, 0, 326, 327, 328,
+	329, 330, 331, 332, 333, 334, 335, 336, 412, 0,
+	337, 338, 0, 339, 0, 340, 341, 342, 343, 344,
+	0, 441, 413, 0, 0, 429, 345, 414, 346, 415,
+	0, 347, 348, 349, 350, 351, 352, 353, 0, 0,
+	354, 355, 356, 357, 358, 0, 0, 359, 360, 361,
+	362, 363, 416, 417, 0, 364, 0, 365, 366, 367,
+	368, 109, 0, 369, 0, 0, 370, 371, 372, 373,
+	374, 375, 376, 377, 112, 113, 114, 115, 116, 117,
+	118, 119, 0, 120, 121, 122, 0, 0, 0, 0,
+	0, 1629, 0, 123, 124, 0, 125, 126, 0, 127,
+	128, 129, 378, 379, 0, 380, 0, 381, 0, 130,
+	131, 132, 133, 134, 135, 0, 0, 425, 136, 382,
+	383, 137, 0, 138, 139, 140, 141, 384, 0, 0,
+	0, 142, 143, 144, 145, 146, 0, 0, 147, 148,
+	149, 0, 150, 151, 152, 153, 154, 155, 0, 0,
+	156, 157, 158, 0, 0, 0, 0, 0, 0, 0,
+	159, 160, 161, 162, 163, 385, 164, 165, 386, 387,
+	166, 0, 167, 0, 168, 169, 170, 171, 172, 0,
+	173, 174, 175, 0, 0, 176, 177, 178, 179, 180,
+	0, 181, 182, 183, 0, 184, 185, 186, 0, 187,
+	188, 189, 190, 388, 191, 192, 193, 389, 0, 194,
+	0, 195, 196, 390, 197, 0, 198, 0, 199, 0,
+	0, 0, 200, 201, 202, 0, 203, 204, 391, 0,
+	392, 205, 0, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 0, 215, 216, 217, 218, 219, 220, 0,
+	221, 0, 393, 222, 223, 224, 225, 394, 395, 0,
+	396, 0, 226, 0, 227, 0, 228, 229, 230, 231,
+	232, 0, 0, 233, 397, 0, 234, 0, 0, 235,
+	236, 426, 0, 0, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 398,
+	0, 399, 251, 252, 400, 0, 253, 254, 255, 0,
+	401, 256, 402, 257, 258, 259, 0, 260, 0, 0,
+	261, 262, 0, 0, 263, 403, 0, 264, 0, 404,
+	265, 266, 267, 268, 269, 270, 271, 0, 272, 273,
+	405, 274, 406, 277, 275, 276, 0, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 407, 287, 288, 289,
+	290, 0, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 0, 304, 305, 306,
+	408, 307, 308, 309, 310, 311, 312, 313, 314, 0,
+	315, 316, 409, 317, 318, 428, 0, 319, 320, 410,
+	321, 322, 0, 323, 324, 411, 325, 0, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 412,
+	0, 337, 338, 0, 339, 0, 340, 341, 342, 343,
+	344, 0, 441, 413, 0, 0, 429, 345, 414, 346,
+	415, 0, 347, 348, 349, 350, 351, 352, 353, 0,
+	0, 354, 355, 356, 357, 358, 0, 0, 359, 360,
+	361, 362, 363, 416, 417, 0, 364, 0, 365, 366,
+	367, 368, 109, 0, 369, 0, 0, 370, 371, 372,
+	373, 374, 375, 376, 377, 112, 113, 114, 115, 116,
+	117, 118, 119, 0, 120, 121, 122, 0, 0, 0,
+	0, 0, 0, 0, 123, 124, 0, 125, 126, 0,
+	127, 128, 129, 378, 379, 0, 380, 0, 381, 0,
+	130, 131, 132, 133, 134, 135, 0, 0, 425, 136,
+	382, 383, 137, 0, 138, 139, 140, 141, 384, 0,
+	0, 0, 142, 143, 144, 145, 146, 0, 0, 147,
+	148, 149, 0, 150, 151, 152, 153, 154, 155, 0,
+	0, 156, 157, 158, 0, 0, 0, 0, 0, 0,
+	0, 159, 160, 161, 162, 163, 385, 164, 165, 386,
+	387, 166, 0, 167, 0, 168, 169, 170, 171, 172,
+	0, 173, 174, 175, 0, 0, 176, 177, 178, 179,
+	180, 0, 181, 182, 183, 0, 184, 185, 186, 0,
+	187, 188, 189, 190, 388, 191, 192, 193, 389, 0,
+	194, 0, 195, 196, 390, 197, 0, 198, 0, 199,
+	0, 0, 0, 200, 201, 202, 0, 203, 204, 391,
+	0, 392, 205, 0, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 0, 215, 216, 217, 218, 219, 220,
+	0, 221, 0, 393, 222, 223, 224, 225, 394, 395,
+	0, 396, 0, 226, 0, 227, 0, 228, 229, 230,
+	231, 232, 0, 0, 233, 397, 0, 234, 0, 0,
+	235, 236, 426, 0, 0, 237, 238, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 427,
+	398, 0, 399, 251, 252, 400, 0, 253, 254, 255,
+	0, 401, 256, 402, 257, 258, 259, 0, 260, 0,
+	481, 261, 262, 0, 0, 263, 403, 0, 264, 0,
+	404, 265, 266, 267, 268, 269, 270, 271, 0, 272,
+	273, 405, 274, 406, 277, 275, 276, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 407, 287, 288,
+	289, 290, 0, 291, 292, 293, 294, 295, 296, 297,
+	298, 299, 300, 301, 0, 302, 303, 0, 304, 305,
+	306, 408, 307, 308, 309, 310, 311, 312, 313, 314,
+	0, 315, 316, 409, 317, 318, 428, 0, 319, 320,
+	410, 321, 322, 0, 323, 324, 411, 325, 0, 326,
+	327, 328, 329, 330, 331, 332, 333, 334, 335, 336,
+	412, 0, 337, 338, 0, 339, 0, 340, 341, 342,
+	343, 344, 0, 441, 413, 0, 0, 429, 345, 414,
+	346, 415, 0, 347, 348, 349, 350, 351, 352, 353,
+	0, 0, 354, 355, 356, 357, 358, 0, 0, 359,
+	360, 361, 362, 363, 416, 417, 0, 364, 0, 365,
+	366, 367, 368, 109, 0, 369, 0, 0, 370, 371,
+	372, 373, 374, 375, 376, 377, 112, 113, 114, 115,
+	116, 117, 118, 119, 0, 120, 121, 122, 0, 0,
+	0, 0, 0, 0, 0, 123, 124, 0, 125, 126,
+	0, 127, 128, 129, 378, 379, 0, 380, 0, 381,
+	0, 130, 131, 132, 133, 134, 135, 0, 0, 425,
+	136, 382, 383, 137, 0, 138, 139, 140, 141, 384,
+	0, 0, 0, 142, 143, 144, 145, 146, 0, 0,
+	147, 148, 149, 0, 150, 151, 152, 153, 154, 155,
+	0, 0, 156, 157, 158, 0, 0, 0, 0, 0,
+	0, 0, 159, 160, 161, 852, 163, 385, 164, 165,
+	386, 387, 166, 0, 167, 0, 168, 169, 170, 171,
+	172, 0, 173, 174, 175, 0, 0, 176, 177, 178,
+	179, 180, 0, 181, 182, 183, 0, 184, 185, 186,
+	0, 187, 188, 189, 190, 388, 191, 192, 193, 389,
+	0, 194, 0, 195, 196, 390, 197, 0, 198, 0,
+	199, 0, 0, 0, 200, 201, 202, 0, 203, 204,
+	391, 0, 392, 205, 0, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 0, 215, 216, 217, 218, 219,
+	220, 0, 221, 0, 393, 222, 223, 224, 225, 394,
+	395, 0, 396, 0, 226, 0, 227, 0, 228, 229,
+	230, 231, 232, 0, 0, 233, 397, 0, 234, 0,
+	0, 235, 236, 426, 0, 0, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	427, 398, 0, 399, 251, 252, 400, 0, 253, 254,
+	255, 0, 401, 256, 402, 257, 258, 259, 0, 260,
+	0, 0, 261, 262, 0, 0, 263, 403, 0, 264,
+	0, 404, 265, 266, 267, 268, 269, 270, 271, 0,
+	272, 273, 405, 274, 406, 277, 275, 276, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 407, 287,
+	288, 289, 290, 0, 291, 292, 293, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 0, 304,
+	305, 306, 408, 307, 308, 309, 310, 311, 312, 313,
+	314, 0, 315, 316, 409, 317, 318, 428, 0, 319,
+	320, 410, 321, 322, 0, 323, 324, 411, 325, 0,
+	326, 327, 328, 329, 330, 331, 332, 333, 334, 335,
+	336, 412, 0, 337, 338, 851, 339, 0, 340, 341,
+	342, 343, 344, 0, 441, 413, 0, 0, 429, 345,
+	414, 346, 415, 0, 347, 348, 349, 350, 351, 352,
+	353, 0, 0, 354, 355, 356, 357, 358, 0, 0,
+	359, 360, 361, 362, 363, 416, 417, 0, 364, 0,
+	365, 366, 367, 368, 109, 0, 369, 0, 0, 370,
+	371, 372, 373, 374, 375, 376, 377, 112, 113, 114,
+	115, 116, 117, 118, 119, 0, 120, 121, 122, 0,
+	0, 0, 0, 0, 0, 0, 123, 124, 0, 125,
+	126, 0, 127, 128, 129, 378, 379, 0, 380, 0,
+	381, 0, 130, 131, 132, 133, 134, 135, 0, 0,
+	425, 136, 382, 383, 137, 0, 138, 139, 140, 141,
+	384, 0, 0, 0, 142, 143, 144, 145, 146, 0,
+	0, 147, 148, 149, 0, 150, 151, 152, 153, 154,
+	155, 0, 0, 156, 157, 158, 0, 0, 0, 0,
+	0, 0, 0, 159, 160, 161, 162, 163, 385, 164,
+	165, 386, 387, 166, 0, 167, 0, 168, 169, 170,
+	171, 172, 0, 173, 174, 175, 0, 0, 176, 177,
+	178, 179, 180, 0, 181, 182, 183, 0, 184, 185,
+	186, 0, 187, 188, 189, 190, 388, 191, 192, 193,
+	389, 0, 194, 0, 195, 196, 390, 197, 0, 198,
+	0, 199, 0, 0, 0, 200, 201, 202, 0, 203,
+	204, 391, 0, 392, 205, 0, 206, 207, 208, 209,
+	632, 211, 212, 213, 214, 0, 215, 216, 217, 218,
+	219, 220, 0, 221, 0, 393, 222, 223, 224, 225,
+	394, 395, 0, 396, 0, 226, 0, 227, 0, 228,
+	229, 230, 231, 232, 0, 0, 233, 397, 0, 234,
+	0, 0, 235, 236, 426, 0, 0, 237, 238, 239,
+	240, 241, 242, 243, 244, 245, 246, 247, 248, 249,
+	250, 427, 398, 0, 399, 251, 252, 400, 0, 253,
+	254, 255, 0, 401, 256, 402, 257, 258, 259, 0,
+	260, 0, 481, 261, 262, 0, 0, 263, 403, 0,
+	264, 0, 404, 265, 266, 267, 268, 269, 270, 271,
+	0, 272, 273, 405, 274, 406, 277, 275, 276, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 407,
+	287, 288, 289, 290, 0, 291, 292, 293, 294, 295,
+	296, 297, 298, 299, 300, 301, 0, 302, 303, 0,
+	304, 305, 306, 408, 307, 308, 309, 310, 311, 312,
+	313, 314, 0, 315, 316, 409, 317, 318, 428, 0,
+	319, 320, 410, 321, 322, 0, 323, 324, 411, 325,
+	0, 326, 327, 328, 329, 330, 331, 332, 333, 334,
+	335, 336, 412, 0, 337, 338, 0, 339, 0, 340,
+	341, 342, 343, 344, 0, 441, 413, 0, 0, 429,
+	345, 414, 346, 415, 0, 347, 348, 349, 350, 351,
+	352, 353, 0, 0, 354, 355, 356, 357, 358, 0,
+	0, 359, 360, 361, 362, 363, 416, 417, 0, 364,
+	0, 365, 366, 367, 368, 109, 0, 369, 0, 0,
+	370, 371, 372, 373, 374, 375, 376, 377, 112, 113,
+	114, 115, 116, 117, 118, 119, 420, 120, 121, 122,
+	0, 0, 0, 0, 0, 0, 0, 123, 124, 0,
+	125, 126, 0, 127, 128, 129, 378, 379, 0, 380,
+	0, 381, 0, 130, 131, 132, 133, 134, 135, 0,
+	0, 425, 136, 382, 383, 137, 0, 138, 139, 140,
+	141, 384, 0, 0, 0, 422, 143, 144, 145, 146,
+	0, 0, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 0, 156, 157, 158, 0, 0, 0,
+	0, 0, 0, 0, 159, 160, 161, 162, 163, 385,
+	164, 165, 386, 387, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 178, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 388, 191, 192,
+	193, 389, 0, 194, 0, 195, 196, 390, 197, 0,
+	198, 0, 199, 0, 0, 0, 200, 201, 202, 0,
+	203, 424, 391, 0, 392, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 423,
+	218, 219, 220, 0, 221, 0, 393, 222, 223, 224,
+	225, 394, 395, 0, 396, 0, 226, 0, 227, 0,
+	228, 229, 230, 231, 232, 0, 0, 233, 397, 0,
+	234, 0, 0, 235, 236, 426, 0, 0, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 0, 399, 251, 252, 400, 0,
+	253, 254, 255, 0, 401, 256, 402, 257, 258, 259,
+	0, 260, 0, 0, 261, 262, 0, 0, 263, 403,
+	0, 264, 0, 404, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 405, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	407, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	0, 304, 305, 306, 408, 307, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 428,
+	0, 319, 320, 410, 321, 322, 0, 323, 324, 411,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 412, 0, 337, 338, 0, 421, 0,
+	340, 341, 342, 343, 344, 0, 419, 413, 0, 0,
+	429, 345, 414, 346, 415, 0, 347, 348, 349, 350,
+	351, 352, 353, 0, 0, 354, 355, 356, 357, 358,
+	0, 0, 359, 360, 361, 362, 363, 416, 417, 0,
+	364, 0, 365, 366, 367, 368, 109, 0, 369, 0,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 112,
+	113, 114, 115, 116, 117, 118, 119, 0, 120, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 123, 124,
+	0, 125, 126, 0, 127, 128, 129, 378, 379, 0,
+	380, 0, 381, 0, 130, 131, 132, 133, 134, 135,
+	0, 0, 425, 136, 382, 383, 137, 0, 138, 139,
+	140, 141, 384, 0, 0, 0, 142, 143, 144, 145,
+	146, 0, 0, 147, 148, 149, 0, 150, 151, 152,
+	153, 154, 155, 0, 0, 156, 157, 158, 0, 0,
+	0, 0, 0, 0, 0, 159, 160, 161, 162, 163,
+	385, 164, 165, 386, 387, 166, 0, 167, 0, 168,
+	169, 170, 171, 172, 0, 173, 174, 175, 0, 0,
+	176, 177, 178, 179, 180, 0, 181, 182, 183, 0,
+	184, 185, 186, 0, 187, 188, 189, 190, 388, 191,
+	192, 193, 389, 0, 194, 0, 195, 196, 390, 197,
+	0, 198, 0, 199, 0, 0, 0, 200, 201, 202,
+	0, 203, 204, 391, 0, 392, 205, 0, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 0, 215, 216,
+	217, 218, 219, 220, 0, 221, 0, 393, 222, 223,
+	224, 225, 394, 395, 0, 396, 0, 226, 0, 227,
+	0, 228, 229, 230, 231, 232, 0, 0, 233, 397,
+	0, 234, 0, 0, 235, 236, 426, 0, 0, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+	248, 249, 250, 427, 398, 0, 399, 251, 252, 400,
+	0, 253, 254, 255, 0, 401, 256, 402, 257, 258,
+	259, 0, 260, 0, 0, 261, 262, 0, 0, 263,
+	403, 0, 264, 0, 404, 265, 266, 267, 268, 269,
+	270, 271, 0, 272, 273, 405, 274, 406, 277, 275,
+	276, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 407, 287, 288, 289, 290, 0, 291, 292, 293,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 0, 304, 305, 306, 408, 307, 308, 309, 310,
+	311, 312, 313, 314, 0, 315, 316, 409, 317, 318,
+	428, 0, 319, 320, 410, 321, 322, 0, 323, 324,
+	411, 325, 0, 326, 327, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 412, 0, 337, 338, 0, 339,
+	0, 340, 341, 342, 343, 344, 0, 441, 413, 0,
+	0, 429, 345, 414, 346, 415, 0, 347, 348, 349,
+	350, 351, 352, 353, 0, 0, 354, 355, 356, 357,
+	358, 0, 0, 359, 360, 361, 362, 363, 416, 417,
+	0, 364, 0, 365, 366, 367, 368, 109, 0, 369,
+	0, 0, 370, 371, 372, 373, 374, 375, 376, 377,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 123,
+	124, 0, 125, 126, 0, 127, 128, 129, 378, 379,
+	0, 380, 0, 381, 0, 130, 131, 132, 133, 134,
+	135, 0, 0, 425, 136, 382, 383, 137, 0, 138,
+	139, 140, 141, 384, 0, 0, 0, 142, 143, 144,
+	145, 146, 0, 0, 147, 148, 149, 0, 150, 151,
+	152, 153, 154, 155, 0, 0, 156, 157, 158, 0,
+	0, 0, 0, 0, 0, 0, 159, 160, 161, 162,
+	163, 385, 164, 165, 386, 387, 166, 0, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 178, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 187, 188, 189, 190, 388,
+	191, 192, 193, 389, 0, 194, 0, 195, 196, 390,
+	197, 0, 198, 0, 199, 0, 0, 0, 200, 201,
+	202, 0, 203, 204, 391, 0, 392, 205, 0, 206,
+	207, 208, 209, 1540, 211, 212, 213, 214, 0, 215,
+	216, 217, 218, 219, 220, 0, 221, 0, 393, 222,
+	223, 224, 225, 394, 395, 0, 396, 0, 226, 0,
+	227, 0, 228, 229, 230, 231, 232, 0, 0, 233,
+	397, 0, 234, 0, 0, 235, 236, 426, 0, 0,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 427, 398, 0, 399, 251, 252,
+	400, 0, 253, 254, 255, 0, 401, 256, 402, 257,
+	258, 259, 0, 260, 0, 0, 261, 262, 0, 0,
+	263, 403, 0, 264, 0, 404, 265, 266, 267, 268,
+	269, 270, 271, 0, 272, 273, 405, 274, 406, 277,
+	275, 276, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 407, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 0, 304, 305, 306, 408, 307, 308, 309,
+	310, 311, 312, 313, 314, 0, 315, 316, 409, 317,
+	318, 428, 0, 319, 320, 410, 321, 322, 0, 323,
+	324, 411, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 412, 0, 337, 338, 0,
+	339, 0, 340, 341, 342, 343, 344, 0, 441, 413,
+	0, 0, 429, 345, 414, 346, 415, 0, 347, 348,
+	349, 350, 351, 352, 353, 0, 0, 354, 355, 356,
+	357, 358, 0, 0, 359, 360, 361, 362, 363, 416,
+	417, 0, 364, 0, 365, 366, 367, 368, 109, 0,
+	369, 0, 0, 370, 371, 372, 373, 374, 375, 376,
+	377, 112, 113, 114, 115, 116, 117, 118, 119, 0,
+	120, 121, 122, 0, 0, 0, 0, 0, 0, 0,
+	123, 124, 0, 125, 126, 0, 127, 128, 129, 378,
+	379, 0, 380, 0, 381, 0, 130, 131, 132, 133,
+	134, 135, 0, 0, 425, 136, 382, 383, 137, 0,
+	138, 139, 140, 141, 384, 0, 0, 0, 142, 143,
+	144, 145, 146, 0, 0, 147, 148, 149, 0, 150,
+	151, 152, 153, 154, 155, 0, 0, 156, 157, 158,
+	0, 0, 0, 0, 0, 0, 0, 159, 160, 161,
+	162, 163, 385, 164, 165, 386, 387, 166, 0, 167,
+	0, 168, 169, 170, 171, 172, 0, 173, 174, 175,
+	0, 0, 176, 177, 178, 179, 180, 0, 181, 182,
+	183, 0, 184, 185, 186, 0, 187, 188, 189, 190,
+	388, 191, 192, 193, 389, 0, 194, 0, 195, 196,
+	390, 197, 0, 198, 0, 199, 0, 0, 0, 200,
+	201, 202, 0, 203, 204, 391, 0, 392, 205, 0,
+	206, 207, 208, 209, 1538, 211, 212, 213, 214, 0,
+	215, 216, 217, 218, 219, 220, 0, 221, 0, 393,
+	222, 223, 224, 225, 394, 395, 0, 396, 0, 226,
+	0, 227, 0, 228, 229, 230, 231, 232, 0, 0,
+	233, 397, 0, 234, 0, 0, 235, 236, 426, 0,
+	0, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	246, 247, 248, 249, 250, 427, 398, 0, 399, 251,
+	252, 400, 0, 253, 254, 255, 0, 401, 256, 402,
+	257, 258, 259, 0, 260, 0, 0, 261, 262, 0,
+	0, 263, 403, 0, 264, 0, 404, 265, 266, 267,
+	268, 269, 270, 271, 0, 272, 273, 405, 274, 406,
+	277, 275, 276, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 407, 287, 288, 289, 290, 0, 291,
+	292, 293, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 0, 304, 305, 306, 408, 307, 308,
+	309, 310, 311, 312, 313, 314, 0, 315, 316, 409,
+	317, 318, 428, 0, 319, 320, 410, 321, 322, 0,
+	323, 324, 411, 325, 0, 326, 327, 328, 329, 330,
+	331, 332, 333, 334, 335, 336, 412, 0, 337, 338,
+	0, 339, 0, 340, 341, 342, 343, 344, 0, 441,
+	413, 0, 0, 429, 345, 414, 346, 415, 0, 347,
+	348, 349, 350, 351, 352, 353, 0, 0, 354, 355,
+	356, 357, 358, 0, 0, 359, 360, 361, 362, 363,
+	416, 417, 0, 364, 0, 365, 366, 367, 368, 109,
+	0, 369, 0, 0, 370, 371, 372, 373, 374, 375,
+	376, 377, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 0, 0,
+	0, 123, 124, 0, 125, 126, 0, 127, 128, 129,
+	378, 379, 0, 380, 0, 381, 0, 130, 131, 132,
+	133, 134, 135, 0, 0, 425, 136, 382, 383, 137,
+	0, 138, 139, 140, 141, 384, 0, 0, 0, 142,
+	143, 144, 145, 146, 0, 0, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 0, 156, 157,
+	158, 0, 0, 0, 0, 0, 0, 0, 159, 160,
+	161, 162, 163, 385, 164, 165, 386, 387, 166, 0,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 178, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 388, 191, 192, 193, 389, 0, 194, 0, 195,
+	196, 390, 197, 0, 198, 0, 199, 0, 0, 0,
+	200, 201, 202, 0, 203, 204, 391, 0, 392, 205,
+	0, 206, 207, 208, 209, 1330, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 0,
+	393, 222, 223, 224, 225, 394, 395, 0, 396, 0,
+	226, 0, 227, 0, 228, 229, 230, 231, 232, 0,
+	0, 233, 397, 0, 234, 0, 0, 235, 236, 426,
+	0, 0, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 0, 399,
+	251, 252, 400, 0, 253, 254, 255, 0, 401, 256,
+	402, 257, 258, 259, 0, 260, 0, 0, 261, 262,
+	0, 0, 263, 403, 0, 264, 0, 404, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 405, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 407, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 0, 304, 305, 306, 408, 307,
+	308, 309, 310, 311, 312, 313, 314, 0, 315, 316,
+	409, 317, 318, 428, 0, 319, 320, 410, 321, 322,
+	0, 323, 324, 411, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 412, 0, 337,
+	338, 0, 339, 0, 340, 341, 342, 343, 344, 0,
+	441, 413, 0, 0, 429, 345, 414, 346, 415, 0,
+	347, 348, 349, 350, 351, 352, 353, 0, 0, 354,
+	355, 356, 357, 358, 0, 0, 359, 360, 361, 362,
+	363, 416, 417, 0, 364, 0, 365, 366, 367, 368,
+	109, 0, 369, 0, 0, 370, 371, 372, 373, 374,
+	375, 376, 377, 112, 113, 114, 115, 116, 117, 118,
+	119, 0, 120, 121, 122, 0, 0, 0, 0, 0,
+	0, 0, 123, 124, 0, 125, 126, 0, 127, 128,
+	129, 378, 379, 0, 380, 0, 381, 0, 130, 131,
+	132, 133, 134, 135, 0, 0, 106, 136, 382, 383,
+	137, 0, 138, 139, 140, 141, 384, 0, 0, 0,
+	142, 143, 144, 145, 146, 0, 0, 147, 148, 149,
+	0, 150, 151, 152, 153, 154, 155, 0, 0, 156,
+	157, 158, 0, 0, 0, 0, 0, 0, 0, 159,
+	160, 161, 162, 163, 385, 164, 165, 386, 387, 166,
+	0, 167, 0, 168, 169, 170, 171, 172, 0, 173,
+	174, 175, 0, 0, 176, 177, 178, 179, 180, 0,
+	181, 182, 183, 0, 184, 185, 186, 0, 187, 188,
+	189, 190, 388, 191, 192, 193, 389, 0, 194, 0,
+	195, 196, 390, 197, 0, 198, 0, 199, 0, 0,
+	0, 200, 201, 202, 0, 203, 204, 391, 0, 392,
+	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 0, 215, 216, 217, 218, 219, 220, 0, 221,
+	0, 393, 222, 223, 224, 225, 394, 395, 0, 396,
+	0, 226, 0, 227, 0, 228, 229, 230, 231, 232,
+	0, 0, 233, 397, 0, 234, 0, 0, 235, 236,
+	426, 0, 0, 237, 238, 239, 240, 241, 242, 243,
+	244, 245, 246, 247, 248, 249, 250, 107, 398, 0,
+	399, 251, 252, 400, 0, 253, 254, 255, 0, 401,
+	256, 402, 257, 258, 259, 0, 260, 0, 0, 261,
+	262, 0, 0, 263, 403, 0, 264, 0, 404, 265,
+	266, 267, 268, 269, 270, 271, 0, 272, 273, 405,
+	274, 406, 277, 275, 276, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 407, 287, 288, 289, 290,
+	0, 291, 292, 293, 294, 295, 296, 297, 298, 299,
+	300, 301, 0, 302, 303, 0, 304, 305, 306, 408,
+	307, 308, 309, 310, 311, 312, 313, 314, 0, 315,
+	316, 409, 317, 318, 635, 0, 319, 320, 410, 321,
+	322, 0, 323, 324, 411, 325, 0, 326, 327, 328,
+	329, 330, 331, 332, 333, 334, 335, 336, 412, 0,
+	337, 338, 0, 339, 0, 340, 341, 342, 343, 344,
+	0, 105, 413, 0, 0, 101, 345, 414, 346, 415,
+	0, 347, 348, 349, 350, 351, 352, 353, 0, 0,
+	354, 355, 356, 357, 358, 0, 0, 359, 360, 361,
+	362, 363, 416, 417, 0, 364, 0, 365, 366, 367,
+	368, 109, 0, 369, 0, 0, 370, 371, 372, 373,
+	374, 375, 376, 377, 112, 113, 114, 115, 116, 117,
+	118, 119, 0, 120, 121, 122, 0, 0, 0, 0,
+	0, 0, 0, 123, 124, 0, 125, 126, 0, 127,
+	128, 129, 378, 379, 0, 380, 0, 381, 0, 130,
+	131, 132, 133, 134, 135, 0, 0, 425, 136, 382,
+	383, 137, 0, 138, 139, 140, 141, 384, 0, 0,
+	0, 142, 143, 144, 145, 146, 0, 0, 147, 148,
+	149, 0, 150, 151, 152, 153, 154, 155, 0, 0,
+	156, 157, 158, 0, 0, 0, 0, 0, 0, 0,
+	159, 160, 161, 162, 163, 385, 164, 165, 386, 387,
+	166, 0, 167, 0, 168, 169, 170, 171, 172, 0,
+	173, 174, 175, 0, 0, 176, 177, 178, 179, 180,
+	0, 181, 182, 183, 0, 184, 185, 186, 0, 187,
+	188, 189, 190, 388, 191, 192, 193, 389, 0, 194,
+	0, 195, 196, 390, 197, 0, 198, 0, 199, 0,
+	0, 0, 200, 201, 202, 0, 203, 204, 391, 0,
+	392, 205, 0, 206, 207, 208, 209, 1257, 211, 212,
+	213, 214, 0, 215, 216, 217, 218, 219, 220, 0,
+	221, 0, 393, 222, 223, 224, 225, 394, 395, 0,
+	396, 0, 226, 0, 227, 0, 228, 229, 230, 231,
+	232, 0, 0, 233, 397, 0, 234, 0, 0, 235,
+	236, 426, 0, 0, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 398,
+	0, 399, 251, 252, 400, 0, 253, 254, 255, 0,
+	401, 256, 402, 257, 258, 259, 0, 260, 0, 0,
+	261, 262, 0, 0, 263, 403, 0, 264, 0, 404,
+	265, 266, 267, 268, 269, 270, 271, 0, 272, 273,
+	405, 274, 406, 277, 275, 276, 0, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 407, 287, 288, 289,
+	290, 0, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 0, 304, 305, 306,
+	408, 307, 308, 309, 310, 311, 312, 313, 314, 0,
+	315, 316, 409, 317, 318, 428, 0, 319, 320, 410,
+	321, 322, 0, 323, 324, 411, 325, 0, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 412,
+	0, 337, 338, 0, 339, 0, 340, 341, 342, 343,
+	344, 0, 441, 413, 0, 0, 429, 345, 414, 346,
+	415, 0, 347, 348, 349, 350, 351, 352, 353, 0,
+	0, 354, 355, 356, 357, 358, 0, 0, 359, 360,
+	361, 362, 363, 416, 417, 0, 364, 0, 365, 366,
+	367, 368, 109, 0, 369, 0, 0, 370, 371, 372,
+	373, 374, 375, 376, 377, 112, 113, 114, 115, 116,
+	117, 118, 119, 0, 120, 121, 122, 0, 0, 0,
+	0, 0, 0, 0, 123, 124, 0, 125, 126, 0,
+	127, 128, 129, 378, 379, 0, 380, 0, 381, 0,
+	130, 131, 132, 133, 134, 135, 0, 0, 425, 136,
+	382, 383, 137, 0, 138, 139, 140, 141, 384, 0,
+	0, 0, 142, 143, 144, 145, 146, 0, 0, 147,
+	148, 149, 0, 150, 151, 152, 153, 154, 155, 0,
+	0, 156, 157, 158, 0, 0, 0, 0, 0, 0,
+	0, 159, 160, 161, 162, 163, 385, 164, 165, 386,
+	387, 166, 0, 167, 0, 168, 169, 170, 171, 172,
+	0, 173, 174, 175, 0, 0, 176, 177, 178, 179,
+	180, 0, 181, 182, 183, 0, 184, 185, 186, 0,
+	187, 188, 189, 190, 388, 191, 192, 193, 389, 0,
+	194, 0, 195, 196, 390, 197, 0, 198, 0, 199,
+	0, 0, 0, 200, 201, 202, 0, 203, 204, 391,
+	0, 392, 205, 0, 206, 207, 208, 209, 929, 211,
+	212, 213, 214, 0, 215, 216, 217, 218, 219, 220,
+	0, 221, 0, 393, 222, 223, 224, 225, 394, 395,
+	0, 396, 0, 226, 0, 227, 0, 228, 229, 230,
+	231, 232, 0, 0, 233, 397, 0, 234, 0, 0,
+	235, 236, 426, 0, 0, 237, 238, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 427,
+	398, 0, 399, 251, 252, 400, 0, 253, 254, 255,
+	0, 401, 256, 402, 257, 258, 259, 0, 260, 0,
+	0, 261, 262, 0, 0, 263, 403, 0, 264, 0,
+	404, 265, 266, 267, 268, 269, 270, 271, 0, 272,
+	273, 405, 274, 406, 277, 275, 276, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 407, 287, 288,
+	289, 290, 0, 291, 292, 293, 294, 295, 296, 297,
+	298, 299, 300, 301, 0, 302, 303, 0, 304, 305,
+	306, 408, 307, 308, 309, 310, 311, 312, 313, 314,
+	0, 315, 316, 409, 317, 318, 428, 0, 319, 320,
+	410, 321, 322, 0, 323, 324, 411, 325, 0, 326,
+	327, 328, 329, 330, 331, 332, 333, 334, 335, 336,
+	412, 0, 337, 338, 0, 339, 0, 340, 341, 342,
+	343, 344, 0, 441, 413, 0, 0, 429, 345, 414,
+	346, 415, 0, 347, 348, 349, 350, 351, 352, 353,
+	0, 0, 354, 355, 356, 357, 358, 0, 0, 359,
+	360, 361, 362, 363, 416, 417, 0, 364, 0, 365,
+	366, 367, 368, 109, 0, 369, 0, 0, 370, 371,
+	372, 373, 374, 375, 376, 377, 112, 113, 114, 115,
+	116, 117, 118, 119, 0, 120, 121, 122, 0, 0,
+	0, 0, 0, 0, 0, 123, 124, 0, 125, 126,
+	0, 127, 128, 129, 378, 379, 0, 380, 0, 381,
+	0, 130, 131, 132, 133, 134, 135, 0, 0, 425,
+	136, 382, 383, 137, 0, 138, 139, 140, 141, 384,
+	0, 0, 0, 142, 143, 144, 145, 146, 0, 0,
+	147, 148, 149, 0, 150, 151, 152, 153, 154, 155,
+	0, 0, 156, 157, 158, 0, 0, 0, 0, 0,
+	0, 0, 159, 160, 161, 162, 163, 385, 164, 165,
+	386, 387, 166, 0, 167, 0, 168, 169, 170, 171,
+	172, 0, 173, 174, 175, 0, 0, 176, 177, 178,
+	179, 180, 0, 181, 182, 183, 0, 184, 185, 186,
+	0, 187, 188, 189, 190, 388, 191, 192, 193, 389,
+	0, 194, 0, 195, 196, 390, 197, 0, 198, 0,
+	199, 0, 0, 0, 200, 201, 202, 0, 203, 204,
+	391, 0, 392, 205, 0, 206, 207, 208, 209, 927,
+	211, 212, 213, 214, 0, 215, 216, 217, 218, 219,
+	220, 0, 221, 0, 393, 222, 223, 224, 225, 394,
+	395, 0, 396, 0, 226, 0, 227, 0, 228, 229,
+	230, 231, 232, 0, 0, 233, 397, 0, 234, 0,
+	0, 235, 236, 426, 0, 0, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	427, 398, 0, 399, 251, 252, 400, 0, 253, 254,
+	255, 0, 401, 256, 402, 257, 258, 259, 0, 260,
+	0, 0, 261, 262, 0, 0, 263, 403, 0, 264,
+	0, 404, 265, 266, 267, 268, 269, 270, 271, 0,
+	272, 273, 405, 274, 406, 277, 275, 276, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 407, 287,
+	288, 289, 290, 0, 291, 292, 293, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 0, 304,
+	305, 306, 408, 307, 308, 309, 310, 311, 312, 313,
+	314, 0, 315, 316, 409, 317, 318, 428, 0, 319,
+	320, 410, 321, 322, 0, 323, 324, 411, 325, 0,
+	326, 327, 328, 329, 330, 331, 332, 333, 334, 335,
+	336, 412, 0, 337, 338, 0, 339, 0, 340, 341,
+	342, 343, 344, 0, 441, 413, 0, 0, 429, 345,
+	414, 346, 415, 0, 347, 348, 349, 350, 351, 352,
+	353, 0, 0, 354, 355, 356, 357, 358, 0, 0,
+	359, 360, 361, 362, 363, 416, 417, 0, 364, 0,
+	365, 366, 367, 368, 109, 0, 369, 0, 0, 370,
+	371, 372, 373, 374, 375, 376, 377, 112, 113, 114,
+	115, 116, 117, 118, 119, 0, 120, 121, 122, 0,
+	0, 0, 0, 0, 0, 0, 123, 124, 0, 125,
+	126, 0, 127, 128, 129, 378, 379, 0, 380, 0,
+	381, 0, 130, 131, 132, 133, 134, 135, 0, 0,
+	425, 136, 382, 383, 137, 0, 138, 139, 140, 141,
+	384, 0, 0, 0, 142, 143, 144, 145, 146, 0,
+	0, 147, 148, 149, 0, 150, 151, 152, 153, 154,
+	155, 0, 0, 156, 157, 158, 0, 0, 0, 0,
+	0, 0, 0, 159, 160, 161, 162, 163, 385, 164,
+	165, 386, 387, 166, 0, 167, 0, 168, 169, 170,
+	171, 172, 0, 173, 174, 175, 0, 0, 176, 177,
+	178, 179, 180, 0, 181, 182, 183, 0, 184, 185,
+	186, 0, 187, 188, 189, 190, 388, 191, 192, 193,
+	389, 0, 194, 0, 195, 196, 390, 197, 0, 198,
+	0, 199, 0, 0, 0, 200, 201, 202, 0, 203,
+	204, 391, 0, 392, 205, 0, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 0, 215, 216, 217, 218,
+	219, 220, 0, 221, 0, 393, 222, 223, 224, 225,
+	394, 395, 0, 396, 0, 226, 0, 227, 0, 228,
+	229, 230, 231, 232, 0, 0, 233, 397, 0, 234,
+	0, 0, 235, 236, 426, 0, 0, 237, 238, 239,
+	240, 241, 242, 243, 244, 245, 246, 247, 248, 249,
+	250, 427, 398, 0, 399, 251, 252, 400, 0, 253,
+	254, 255, 0, 401, 256, 402, 257, 258, 259, 0,
+	260, 0, 0, 261, 262, 0, 0, 263, 403, 0,
+	264, 0, 404, 265, 266, 267, 268, 269, 270, 271,
+	0, 272, 273, 405, 274, 406, 277, 275, 276, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 407,
+	287, 288, 289, 290, 0, 291, 292, 293, 294, 295,
+	296, 297, 298, 299, 300, 301, 0, 302, 303, 0,
+	304, 305, 306, 408, 307, 308, 920, 310, 311, 312,
+	313, 314, 0, 315, 316, 409, 317, 318, 428, 0,
+	319, 320, 410, 321, 322, 0, 323, 324, 411, 325,
+	0, 326, 327, 328, 329, 330, 331, 332, 333, 334,
+	335, 336, 412, 0, 337, 338, 0, 339, 0, 340,
+	341, 342, 343, 344, 0, 441, 413, 0, 0, 429,
+	345, 414, 346, 415, 0, 347, 348, 349, 350, 351,
+	352, 353, 0, 0, 354, 355, 356, 357, 358, 0,
+	0, 359, 360, 361, 362, 363, 416, 417, 0, 364,
+	0, 365, 366, 367, 368, 109, 0, 369, 0, 0,
+	370, 371, 372, 373, 374, 375, 376, 377, 112, 113,
+	114, 115, 116, 117, 118, 119, 0, 120, 121, 122,
+	0, 0, 0, 0, 0, 821, 0, 123, 124, 0,
+	125, 126, 0, 127, 128, 129, 378, 379, 0, 380,
+	0, 381, 0, 130, 131, 132, 133, 134, 135, 0,
+	0, 425, 136, 382, 383, 137, 0, 138, 139, 140,
+	141, 384, 0, 0, 0, 142, 143, 144, 145, 146,
+	0, 0, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 0, 156, 157, 158, 0, 0, 0,
+	0, 0, 0, 0, 159, 160, 161, 162, 163, 385,
+	164, 165, 386, 387, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 178, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 388, 191, 192,
+	193, 389, 0, 194, 0, 195, 196, 390, 197, 0,
+	198, 0, 199, 0, 0, 0, 200, 201, 202, 0,
+	203, 204, 391, 0, 392, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 217,
+	218, 219, 220, 0, 221, 0, 393, 222, 223, 224,
+	225, 394, 395, 0, 396, 0, 226, 0, 227, 0,
+	228, 229, 230, 231, 232, 0, 0, 233, 397, 0,
+	234, 0, 0, 235, 236, 426, 0, 0, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 427, 398, 0, 399, 251, 252, 400, 0,
+	253, 254, 255, 0, 401, 256, 402, 257, 258, 259,
+	0, 260, 0, 0, 261, 262, 0, 0, 263, 403,
+	0, 264, 0, 404, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 405, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	407, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	0, 304, 305, 306, 408, 307, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 428,
+	0, 0, 320, 410, 321, 322, 0, 323, 324, 411,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 412, 0, 337, 338, 0, 339, 0,
+	340, 341, 342, 343, 344, 0, 441, 413, 0, 0,
+	429, 345, 414, 346, 415, 0, 347, 348, 349, 350,
+	351, 352, 353, 0, 0, 354, 355, 356, 357, 358,
+	0, 0, 359, 360, 361, 362, 363, 416, 417, 0,
+	364, 0, 365, 366, 367, 368, 109, 0, 369, 0,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 112,
+	113, 114, 115, 116, 117, 118, 119, 0, 120, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 123, 124,
+	0, 125, 126, 0, 127, 128, 129, 378, 379, 0,
+	380, 0, 381, 0, 130, 131, 132, 133, 134, 135,
+	0, 0, 425, 136, 382, 383, 137, 0, 138, 139,
+	140, 141, 384, 0, 0, 0, 142, 143, 144, 145,
+	146, 0, 0, 147, 148, 149, 0, 150, 151, 152,
+	153, 154, 155, 0, 0, 156, 157, 158, 0, 0,
+	0, 0, 0, 0, 0, 159, 160, 161, 162, 163,
+	385, 164, 165, 386, 387, 166, 0, 167, 0, 168,
+	169, 170, 171, 172, 0, 173, 174, 175, 0, 0,
+	176, 177, 178, 179, 180, 0, 181, 182, 183, 0,
+	184, 185, 186, 0, 187, 188, 189, 190, 388, 191,
+	192, 193, 389, 0, 194, 0, 195, 196, 390, 197,
+	0, 198, 0, 199, 0, 0, 0, 200, 201, 202,
+	0, 203, 204, 391, 0, 392, 205, 0, 206, 207,
+	208, 209, 674, 211, 212, 213, 214, 0, 215, 216,
+	217, 218, 219, 220, 0, 221, 0, 393, 222, 223,
+	224, 225, 394, 395, 0, 396, 0, 226, 0, 227,
+	0, 228, 229, 230, 231, 232, 0, 0, 233, 397,
+	0, 234, 0, 0, 235, 236, 426, 0, 0, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+	248, 249, 250, 427, 398, 0, 399, 251, 252, 400,
+	0, 253, 254, 255, 0, 401, 256, 402, 257, 258,
+	259, 0, 260, 0, 0, 261, 262, 0, 0, 263,
+	403, 0, 264, 0, 404, 265, 266, 267, 268, 269,
+	270, 271, 0, 272, 273, 405, 274, 406, 277, 275,
+	276, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 407, 287, 288, 289, 290, 0, 291, 292, 293,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 0, 304, 305, 306, 408, 307, 308, 309, 310,
+	311, 312, 313, 314, 0, 315, 316, 409, 317, 318,
+	428, 0, 319, 320, 410, 321, 322, 0, 323, 324,
+	411, 325, 0, 326, 327, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 412, 0, 337, 338, 0, 339,
+	0, 340, 341, 342, 343, 344, 0, 441, 413, 0,
+	0, 429, 345, 414, 346, 415, 0, 347, 348, 349,
+	350, 351, 352, 353, 0, 0, 354, 355, 356, 357,
+	358, 0, 0, 359, 360, 361, 362, 363, 416, 417,
+	0, 364, 0, 365, 366, 367, 368, 109, 0, 369,
+	0, 0, 370, 371, 372, 373, 374, 375, 376, 377,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 123,
+	124, 0, 125, 126, 0, 127, 128, 129, 378, 379,
+	0, 380, 0, 381, 0, 130, 131, 132, 133, 134,
+	135, 0, 0, 106, 136, 382, 383, 637, 0, 138,
+	139, 140, 141, 384, 0, 0, 0, 142, 143, 144,
+	145, 146, 0, 0, 147, 148, 149, 0, 150, 151,
+	152, 153, 154, 155, 0, 0, 156, 157, 158, 0,
+	0, 0, 0, 0, 0, 0, 159, 160, 161, 162,
+	163, 385, 164, 165, 386, 387, 166, 0, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 178, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 187, 188, 189, 190, 388,
+	191, 192, 193, 389, 0, 194, 0, 195, 196, 390,
+	197, 0, 198, 0, 199, 0, 0, 0, 200, 201,
+	202, 0, 203, 204, 391, 0, 392, 205, 0, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 0, 215,
+	216, 217, 218, 219, 220, 0, 221, 0, 393, 222,
+	223, 224, 225, 394, 395, 0, 396, 0, 226, 0,
+	227, 0, 228, 229, 230, 231, 232, 0, 0, 233,
+	397, 0, 234, 0, 0, 235, 236, 426, 0, 0,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 107, 398, 0, 399, 251, 252,
+	400, 0, 253, 254, 255, 0, 401, 256, 402, 257,
+	258, 259, 0, 260, 0, 0, 261, 262, 0, 0,
+	263, 403, 0, 264, 0, 404, 265, 266, 267, 268,
+	269, 270, 271, 0, 272, 273, 405, 274, 406, 277,
+	275, 276, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 407, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 0, 304, 305, 306, 408, 307, 308, 309,
+	310, 311, 312, 313, 314, 0, 315, 316, 409, 317,
+	318, 635, 0, 319, 320, 410, 321, 322, 0, 323,
+	324, 411, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 412, 0, 337, 338, 0,
+	339, 0, 340, 341, 342, 343, 344, 0, 105, 413,
+	0, 0, 101, 345, 414, 346, 415, 0, 347, 348,
+	349, 350, 351, 352, 353, 0, 0, 354, 355, 356,
+	357, 358, 0, 0, 359, 360, 361, 362, 363, 416,
+	417, 0, 364, 0, 365, 366, 367, 368, 109, 0,
+	369, 0, 0, 370, 371, 372, 373, 374, 375, 376,
+	377, 112, 113, 114, 115, 116, 117, 118, 119, 0,
+	120, 121, 122, 0, 0, 0, 0, 0, 0, 0,
+	123, 124, 0, 125, 126, 0, 127, 128, 129, 378,
+	379, 0, 380, 0, 381, 0, 130, 131, 132, 133,
+	134, 135, 0, 0, 425, 136, 382, 383, 137, 0,
+	138, 139, 140, 141, 384, 0, 0, 0, 142, 143,
+	144, 145, 146, 0, 0, 147, 148, 149, 0, 150,
+	151, 152, 153, 154, 155, 0, 0, 156, 157, 158,
+	0, 0, 0, 0, 0, 0, 0, 159, 160, 161,
+	162, 163, 385, 164, 165, 386, 387, 166, 0, 167,
+	0, 168, 169, 170, 171, 172, 0, 173, 174, 175,
+	0, 0, 176, 177, 178, 179, 180, 0, 181, 182,
+	183, 0, 184, 185, 186, 0, 187, 188, 189, 190,
+	388, 191, 192, 193, 389, 0, 194, 0, 195, 196,
+	390, 197, 0, 198, 0, 199, 0, 0, 0, 200,
+	201, 202, 0, 203, 204, 391, 0, 392, 205, 0,
+	206, 207, 208, 209, 630, 211, 212, 213, 214, 0,
+	215, 216, 217, 218, 219, 220, 0, 221, 0, 393,
+	222, 223, 224, 225, 394, 395, 0, 396, 0, 226,
+	0, 227, 0, 228, 229, 230, 231, 232, 0, 0,
+	233, 397, 0, 234, 0, 0, 235, 236, 426, 0,
+	0, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	246, 247, 248, 249, 250, 427, 398, 0, 399, 251,
+	252, 400, 0, 253, 254, 255, 0, 401, 256, 402,
+	257, 258, 259, 0, 260, 0, 0, 261, 262, 0,
+	0, 263, 403, 0, 264, 0, 404, 265, 266, 267,
+	268, 269, 270, 271, 0, 272, 273, 405, 274, 406,
+	277, 275, 276, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 407, 287, 288, 289, 290, 0, 291,
+	292, 293, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 0, 304, 305, 306, 408, 307, 308,
+	309, 310, 311, 312, 313, 314, 0, 315, 316, 409,
+	317, 318, 428, 0, 319, 320, 410, 321, 322, 0,
+	323, 324, 411, 325, 0, 326, 327, 328, 329, 330,
+	331, 332, 333, 334, 335, 336, 412, 0, 337, 338,
+	0, 339, 0, 340, 341, 342, 343, 344, 0, 441,
+	413, 0, 0, 429, 345, 414, 346, 415, 0, 347,
+	348, 349, 350, 351, 352, 353, 0, 0, 354, 355,
+	356, 357, 358, 0, 0, 359, 360, 361, 362, 363,
+	416, 417, 0, 364, 0, 365, 366, 367, 368, 109,
+	0, 369, 0, 0, 370, 371, 372, 373, 374, 375,
+	376, 377, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 120, 121, 122, 0, 0, 0, 0, 0, 0,
+	0, 123, 124, 0, 125, 126, 0, 127, 128, 129,
+	378, 379, 0, 380, 0, 381, 0, 130, 131, 132,
+	133, 134, 135, 0, 0, 425, 136, 382, 383, 137,
+	0, 138, 139, 140, 141, 384, 0, 0, 0, 142,
+	143, 144, 145, 146, 0, 0, 147, 148, 149, 0,
+	150, 151, 152, 153, 154, 155, 0, 0, 156, 157,
+	158, 0, 0, 0, 0, 0, 0, 0, 159, 160,
+	161, 162, 163, 385, 164, 165, 386, 387, 166, 0,
+	167, 0, 168, 169, 170, 171, 172, 0, 173, 174,
+	175, 0, 0, 176, 177, 178, 179, 180, 0, 181,
+	182, 183, 0, 184, 185, 186, 0, 187, 188, 189,
+	190, 388, 191, 192, 193, 389, 0, 194, 0, 195,
+	196, 390, 197, 0, 198, 0, 199, 0, 0, 0,
+	200, 201, 202, 0, 203, 204, 391, 0, 392, 205,
+	0, 206, 207, 208, 209, 609, 211, 212, 213, 214,
+	0, 215, 216, 217, 218, 219, 220, 0, 221, 0,
+	393, 222, 223, 224, 225, 394, 395, 0, 396, 0,
+	226, 0, 227, 0, 228, 229, 230, 231, 232, 0,
+	0, 233, 397, 0, 234, 0, 0, 235, 236, 426,
+	0, 0, 237, 238, 239, 240, 241, 242, 243, 244,
+	245, 246, 247, 248, 249, 250, 427, 398, 0, 399,
+	251, 252, 400, 0, 253, 254, 255, 0, 401, 256,
+	402, 257, 258, 259, 0, 260, 0, 0, 261, 262,
+	0, 0, 263, 403, 0, 264, 0, 404, 265, 266,
+	267, 268, 269, 270, 271, 0, 272, 273, 405, 274,
+	406, 277, 275, 276, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 407, 287, 288, 289, 290, 0,
+	291, 292, 293, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 0, 304, 305, 306, 408, 307,
+	308, 309, 310, 311, 312, 313, 314, 0, 315, 316,
+	409, 317, 318, 428, 0, 319, 320, 410, 321, 322,
+	0, 323, 324, 411, 325, 0, 326, 327, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 412, 0, 337,
+	338, 0, 339, 0, 340, 341, 342, 343, 344, 0,
+	441, 413, 0, 0, 429, 345, 414, 346, 415, 0,
+	347, 348, 349, 350, 351, 352, 353, 0, 0, 354,
+	355, 356, 357, 358, 0, 0, 359, 360, 361, 362,
+	363, 416, 417, 0, 364, 0, 365, 366, 367, 368,
+	109, 0, 369, 0, 0, 370, 371, 372, 373, 374,
+	375, 376, 377, 112, 113, 114, 115, 116, 117, 118,
+	119, 0, 120, 121, 122, 0, 0, 0, 0, 0,
+	0, 0, 123, 124, 0, 125, 126, 0, 127, 128,
+	129, 378, 379, 0, 380, 0, 381, 0, 130, 131,
+	132, 133, 134, 135, 0, 0, 425, 136, 382, 383,
+	137, 0, 138, 139, 140, 141, 384, 0, 0, 0,
+	142, 143, 144, 145, 146, 0, 0, 147, 148, 149,
+	0, 150, 151, 152, 153, 154, 155, 0, 0, 156,
+	157, 158, 0, 0, 0, 0, 0, 0, 0, 159,
+	160, 161, 162, 163, 385, 164, 165, 386, 387, 166,
+	0, 167, 0, 168, 169, 170, 171, 172, 0, 173,
+	174, 175, 0, 0, 176, 177, 178, 179, 180, 0,
+	181, 182, 183, 0, 184, 185, 186, 0, 187, 188,
+	189, 190, 388, 191, 192, 193, 389, 0, 194, 0,
+	195, 196, 390, 197, 0, 198, 0, 199, 0, 0,
+	0, 200, 201, 202, 0, 203, 204, 391, 0, 392,
+	205, 0, 206, 207, 208, 209, 607, 211, 212, 213,
+	214, 0, 215, 216, 217, 218, 219, 220, 0, 221,
+	0, 393, 222, 223, 224, 225, 394, 395, 0, 396,
+	0, 226, 0, 227, 0, 228, 229, 230, 231, 232,
+	0, 0, 233, 397, 0, 234, 0, 0, 235, 236,
+	426, 0, 0, 237, 238, 239, 240, 241, 242, 243,
+	244, 245, 246, 247, 248, 249, 250, 427, 398, 0,
+	399, 251, 252, 400, 0, 253, 254, 255, 0, 401,
+	256, 402, 257, 258, 259, 0, 260, 0, 0, 261,
+	262, 0, 0, 263, 403, 0, 264, 0, 404, 265,
+	266, 267, 268, 269, 270, 271, 0, 272, 273, 405,
+	274, 406, 277, 275, 276, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 407, 287, 288, 289, 290,
+	0, 291, 292, 293, 294, 295, 296, 297, 298, 299,
+	300, 301, 0, 302, 303, 0, 304, 305, 306, 408,
+	307, 308, 309, 310, 311, 312, 313, 314, 0, 315,
+	316, 409, 317, 318, 428, 0, 319, 320, 410, 321,
+	322, 0, 323, 324, 411, 325, 0, 326, 327, 328,
+	329, 330, 331, 332, 333, 334, 335, 336, 412, 0,
+	337, 338, 0, 339, 0, 340, 341, 342, 343, 344,
+	0, 441, 413, 0, 0, 429, 345, 414, 346, 415,
+	0, 347, 348, 349, 350, 351, 352, 353, 0, 0,
+	354, 355, 356, 357, 358, 0, 0, 359, 360, 361,
+	362, 363, 416, 417, 0, 364, 0, 365, 366, 367,
+	368, 109, 0, 369, 0, 0, 370, 371, 372, 373,
+	374, 375, 376, 377, 112, 113, 114, 115, 116, 117,
+	118, 119, 0, 120, 121, 122, 0, 0, 0, 0,
+	0, 0, 0, 123, 124, 0, 125, 126, 0, 127,
+	128, 129, 378, 379, 0, 380, 0, 381, 0, 130,
+	131, 132, 133, 134, 135, 0, 0, 425, 136, 382,
+	383, 137, 0, 138, 139, 140, 141, 384, 0, 0,
+	0, 142, 143, 144, 145, 146, 0, 0, 147, 148,
+	149, 0, 150, 151, 152, 153, 154, 155, 0, 0,
+	156, 157, 158, 0, 0, 0, 0, 0, 0, 0,
+	159, 160, 161, 162, 163, 385, 164, 165, 386, 387,
+	166, 0, 167, 0, 168, 169, 170, 171, 172, 0,
+	173, 174, 175, 0, 0, 176, 177, 178, 179, 180,
+	0, 181, 182, 183, 0, 184, 185, 186, 0, 187,
+	188, 189, 190, 388, 191, 192, 193, 389, 0, 194,
+	0, 195, 196, 390, 197, 0, 198, 0, 199, 0,
+	0, 0, 200, 201, 202, 0, 203, 204, 391, 0,
+	392, 205, 0, 206, 207, 208, 209, 605, 211, 212,
+	213, 214, 0, 215, 216, 217, 218, 219, 220, 0,
+	221, 0, 393, 222, 223, 224, 225, 394, 395, 0,
+	396, 0, 226, 0, 227, 0, 228, 229, 230, 231,
+	232, 0, 0, 233, 397, 0, 234, 0, 0, 235,
+	236, 426, 0, 0, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 250, 427, 398,
+	0, 399, 251, 252, 400, 0, 253, 254, 255, 0,
+	401, 256, 402, 257, 258, 259, 0, 260, 0, 0,
+	261, 262, 0, 0, 263, 403, 0, 264, 0, 404,
+	265, 266, 267, 268, 269, 270, 271, 0, 272, 273,
+	405, 274, 406, 277, 275, 276, 0, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 407, 287, 288, 289,
+	290, 0, 291, 292, 293, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 0, 304, 305, 306,
+	408, 307, 308, 309, 310, 311, 312, 313, 314, 0,
+	315, 316, 409, 317, 318, 428, 0, 319, 320, 410,
+	321, 322, 0, 323, 324, 411, 325, 0, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 412,
+	0, 337, 338, 0, 339, 0, 340, 341, 342, 343,
+	344, 0, 441, 413, 0, 0, 429, 345, 414, 346,
+	415, 0, 347, 348, 349, 350, 351, 352, 353, 0,
+	0, 354, 355, 356, 357, 358, 0, 0, 359, 360,
+	361, 362, 363, 416, 417, 0, 364, 0, 365, 366,
+	367, 368, 109, 0, 369, 0, 0, 370, 371, 372,
+	373, 374, 375, 376, 377, 112, 113, 114, 115, 116,
+	117, 118, 119, 0, 120, 121, 122, 0, 0, 0,
+	0, 0, 0, 0, 123, 124, 0, 125, 126, 0,
+	127, 128, 129, 378, 379, 0, 380, 0, 381, 0,
+	130, 131, 132, 133, 134, 135, 0, 0, 425, 136,
+	382, 383, 137, 0, 138, 139, 140, 141, 384, 0,
+	0, 0, 142, 143, 144, 145, 146, 0, 0, 147,
+	148, 149, 0, 150, 151, 152, 153, 154, 155, 0,
+	0, 156, 157, 158, 0, 0, 0, 0, 0, 0,
+	0, 159, 160, 161, 162, 163, 385, 164, 165, 386,
+	387, 166, 0, 167, 0, 168, 169, 170, 171, 172,
+	0, 173, 174, 175, 0, 0, 176, 177, 178, 179,
+	180, 0, 181, 182, 183, 0, 184, 185, 186, 0,
+	187, 188, 189, 190, 388, 191, 192, 193, 389, 0,
+	194, 0, 195, 196, 390, 197, 0, 198, 0, 199,
+	0, 0, 0, 200, 201, 202, 0, 203, 204, 391,
+	0, 392, 205, 0, 206, 207, 208, 209, 600, 211,
+	212, 213, 214, 0, 215, 216, 217, 218, 219, 220,
+	0, 221, 0, 393, 222, 223, 224, 225, 394, 395,
+	0, 396, 0, 226, 0, 227, 0, 228, 229, 230,
+	231, 232, 0, 0, 233, 397, 0, 234, 0, 0,
+	235, 236, 426, 0, 0, 237, 238, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 427,
+	398, 0, 399, 251, 252, 400, 0, 253, 254, 255,
+	0, 401, 256, 402, 257, 258, 259, 0, 260, 0,
+	0, 261, 262, 0, 0, 263, 403, 0, 264, 0,
+	404, 265, 266, 267, 268, 269, 270, 271, 0, 272,
+	273, 405, 274, 406, 277, 275, 276, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 407, 287, 288,
+	289, 290, 0, 291, 292, 293, 294, 295, 296, 297,
+	298, 299, 300, 301, 0, 302, 303, 0, 304, 305,
+	306, 408, 307, 308, 309, 310, 311, 312, 313, 314,
+	0, 315, 316, 409, 317, 318, 428, 0, 319, 320,
+	410, 321, 322, 0, 323, 324, 411, 325, 0, 326,
+	327, 328, 329, 330, 331, 332, 333, 334, 335, 336,
+	412, 0, 337, 338, 0, 339, 0, 340, 341, 342,
+	343, 344, 0, 441, 413, 0, 0, 429, 345, 414,
+	346, 415, 0, 347, 348, 349, 350, 351, 352, 353,
+	0, 0, 354, 355, 356, 357, 358, 0, 0, 359,
+	360, 361, 362, 363, 416, 417, 0, 364, 0, 365,
+	366, 367, 368, 109, 0, 369, 0, 0, 370, 371,
+	372, 373, 374, 375, 376, 377, 112, 113, 114, 115,
+	116, 117, 118, 119, 0, 120, 121, 122, 0, 0,
+	0, 0, 0, 0, 0, 123, 124, 0, 125, 126,
+	0, 127, 128, 129, 378, 379, 0, 380, 0, 381,
+	0, 130, 131, 132, 133, 134, 135, 0, 0, 425,
+	136, 382, 383, 137, 0, 138, 139, 140, 141, 384,
+	0, 0, 0, 142, 143, 144, 145, 146, 0, 0,
+	147, 148, 149, 0, 150, 151, 152, 153, 154, 155,
+	0, 0, 156, 157, 158, 0, 0, 0, 0, 0,
+	0, 0, 159, 160, 161, 162, 163, 385, 164, 165,
+	386, 387, 166, 0, 167, 0, 168, 169, 170, 171,
+	172, 0, 173, 174, 175, 0, 0, 176, 177, 178,
+	179, 180, 0, 181, 182, 183, 0, 184, 185, 186,
+	0, 187, 188, 189, 190, 388, 191, 192, 193, 389,
+	0, 194, 0, 195, 196, 390, 197, 0, 198, 0,
+	199, 0, 0, 0, 200, 201, 202, 0, 203, 204,
+	391, 0, 392, 205, 0, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 0, 215, 216, 217, 218, 219,
+	220, 0, 221, 0, 393, 222, 223, 224, 225, 394,
+	395, 0, 396, 0, 226, 0, 227, 0, 228, 229,
+	230, 231, 232, 0, 0, 233, 397, 0, 234, 0,
+	0, 235, 236, 426, 0, 0, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	427, 398, 0, 399, 251, 252, 400, 0, 253, 254,
+	255, 0, 401, 256, 402, 257, 258, 259, 0, 260,
+	0, 0, 261, 262, 0, 0, 263, 403, 0, 264,
+	0, 404, 265, 266, 267, 268, 269, 270, 271, 0,
+	272, 273, 405, 274, 406, 277, 275, 276, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 407, 287,
+	288, 470, 290, 0, 291, 292, 293, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 0, 304,
+	305, 306, 408, 307, 308, 309, 310, 311, 312, 313,
+	314, 0, 315, 316, 409, 317, 318, 428, 0, 319,
+	320, 410, 321, 322, 0, 323, 324, 411, 325, 0,
+	326, 327, 328, 329, 330, 331, 332, 333, 334, 335,
+	336, 412, 0, 337, 338, 0, 339, 0, 340, 341,
+	342, 343, 344, 0, 441, 413, 0, 0, 429, 345,
+	414, 346, 415, 0, 347, 348, 349, 350, 351, 352,
+	353, 0, 0, 354, 355, 356, 357, 358, 0, 0,
+	359, 360, 361, 362, 363, 416, 417, 0, 364, 0,
+	365, 366, 367, 368, 109, 0, 369, 0, 0, 370,
+	371, 372, 373, 374, 375, 376, 377, 112, 113, 114,
+	115, 116, 117, 118, 119, 0, 120, 121, 122, 0,
+	0, 0, 0, 0, 0, 0, 123, 124, 0, 125,
+	126, 0, 127, 128, 129, 378, 379, 0, 380, 0,
+	381, 0, 130, 131, 132, 133, 134, 135, 0, 0,
+	425, 136, 382, 383, 137, 0, 138, 139, 140, 141,
+	384, 0, 0, 0, 142, 143, 144, 145, 146, 0,
+	0, 147, 148, 149, 0, 150, 151, 152, 153, 154,
+	155, 0, 0, 156, 157, 158, 0, 0, 0, 0,
+	0, 0, 0, 159, 160, 161, 162, 163, 385, 164,
+	165, 386, 387, 166, 0, 167, 0, 168, 169, 170,
+	171, 172, 0, 173, 174, 175, 0, 0, 176, 177,
+	178, 179, 180, 0, 181, 182, 183, 0, 184, 185,
+	186, 0, 187, 188, 189, 190, 388, 191, 192, 193,
+	389, 0, 194, 0, 195, 196, 390, 197, 0, 198,
+	0, 199, 0, 0, 0, 200, 201, 202, 0, 203,
+	204, 391, 0, 392, 205, 0, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 0, 215, 216, 217, 218,
+	219, 220, 0, 221, 0, 393, 222, 223, 224, 225,
+	394, 395, 0, 396, 0, 226, 0, 227, 0, 228,
+	229, 230, 231, 232, 0, 0, 233, 397, 0, 234,
+	0, 0, 235, 236, 426, 0, 0, 237, 238, 239,
+	240, 241, 242, 243, 244, 245, 246, 247, 248, 249,
+	250, 427, 398, 0, 399, 251, 252, 400, 0, 253,
+	254, 255, 0, 401, 256, 402, 257, 258, 259, 0,
+	260, 0, 0, 261, 262, 0, 0, 263, 403, 0,
+	264, 0, 404, 265, 266, 267, 268, 269, 270, 271,
+	0, 272, 273, 405, 274, 406, 277, 275, 276, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 407,
+	287, 288, 289, 290, 0, 291, 292, 293, 294, 295,
+	296, 297, 298, 299, 300, 301, 0, 302, 303, 0,
+	304, 305, 306, 408, 307, 308, 442, 310, 311, 312,
+	313, 314, 0, 315, 316, 409, 317, 318, 428, 0,
+	319, 320, 410, 321, 322, 0, 323, 324, 411, 325,
+	0, 326, 327, 328, 329, 330, 331, 332, 333, 334,
+	335, 336, 412, 0, 337, 338, 0, 339, 0, 340,
+	341, 342, 343, 344, 0, 441, 413, 0, 0, 429,
+	345, 414, 346, 415, 0, 347, 348, 349, 350, 351,
+	352, 353, 0, 0, 354, 355, 356, 357, 358, 0,
+	0, 359, 360, 361, 362, 363, 416, 417, 0, 364,
+	0, 365, 366, 367, 368, 109, 0, 369, 0, 0,
+	370, 371, 372, 373, 374, 375, 376, 377, 112, 113,
+	114, 115, 116, 117, 118, 119, 0, 120, 121, 122,
+	0, 0, 0, 0, 0, 0, 0, 123, 124, 0,
+	125, 126, 0, 127, 128, 129, 378, 379, 0, 380,
+	0, 381, 0, 130, 131, 132, 133, 134, 135, 0,
+	0, 106, 136, 382, 383, 137, 0, 138, 139, 140,
+	141, 384, 0, 0, 0, 142, 143, 144, 145, 146,
+	0, 0, 147, 148, 149, 0, 150, 151, 152, 153,
+	154, 155, 0, 0, 156, 157, 158, 0, 0, 0,
+	0, 0, 0, 0, 159, 160, 161, 162, 163, 385,
+	164, 165, 386, 387, 166, 0, 167, 0, 168, 169,
+	170, 171, 172, 0, 173, 174, 175, 0, 0, 176,
+	177, 178, 179, 180, 0, 181, 182, 183, 0, 184,
+	185, 186, 0, 187, 188, 189, 190, 388, 191, 192,
+	193, 389, 0, 194, 0, 195, 196, 390, 197, 0,
+	198, 0, 199, 0, 0, 0, 200, 201, 202, 0,
+	203, 204, 391, 0, 392, 205, 0, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 0, 215, 216, 217,
+	218, 219, 220, 0, 221, 0, 393, 222, 223, 224,
+	225, 394, 395, 0, 396, 0, 226, 0, 227, 0,
+	228, 229, 230, 231, 232, 0, 0, 233, 397, 0,
+	234, 0, 0, 235, 236, 99, 0, 0, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 107, 398, 0, 399, 251, 252, 400, 0,
+	253, 254, 255, 0, 401, 256, 402, 257, 258, 259,
+	0, 260, 0, 0, 261, 262, 0, 0, 263, 403,
+	0, 264, 0, 404, 265, 266, 267, 268, 269, 270,
+	271, 0, 272, 273, 405, 274, 406, 277, 275, 276,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	407, 287, 288, 289, 290, 0, 291, 292, 293, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	0, 304, 305, 306, 408, 307, 308, 309, 310, 311,
+	312, 313, 314, 0, 315, 316, 409, 317, 318, 100,
+	0, 319, 320, 410, 321, 322, 0, 323, 324, 411,
+	325, 0, 326, 327, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 412, 0, 337, 338, 0, 339, 0,
+	340, 341, 342, 343, 344, 0, 105, 413, 0, 0,
+	101, 345, 414, 346, 415, 0, 347, 348, 349, 350,
+	351, 352, 353, 0, 0, 354, 355, 356, 357, 358,
+	0, 0, 359, 360, 361, 362, 363, 416, 417, 0,
+	364, 0, 365, 366, 367, 368, 1925, 0, 369, 0,
+	0, 370, 371, 372, 373, 374, 375, 376, 377, 112,
+	113, 114, 115, 116, 117, 118, 119, 0, 120, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 123, 124,
+	0, 125, 126, 577, 127, 128, 129, 0, 1083, 578,
+	1098, 1078, 1090, 0, 130, 131, 132, 133, 134, 135,
+	0, 0, 425, 136, 1100, 1099, 137, 0, 138, 139,
+	140, 141, 0, 0, 620, 0, 142, 143, 144, 145,
+	146, 0, 579, 147, 148, 149, 0, 150, 151, 152,
+	153, 154, 155, 0, 580, 156, 157, 158, 0, 0,
+	0, 621, 0, 0, 0, 159, 160, 161, 162, 163,
+	1095, 164, 165, 1088, 1087, 166, 0, 167, 0, 168,
+	169, 170, 171, 172, 0, 173, 174, 175, 0, 0,
+	176, 177, 554, 179, 180, 0, 181, 182, 183, 0,
+	184, 185, 186, 0, 187, 188, 189, 190, 0, 191,
+	192, 193, 0, 0, 194, 0, 195, 196, 1085, 197,
+	0, 198, 0, 199, 581, 0, 582, 200, 201, 202,
+	0, 203, 204, 0, 0, 0, 205, 0, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 0, 215, 216,
+	217, 218, 219, 220, 0, 221, 583, 0, 222, 223,
+	224, 225, 1080, 1081, 0, 912, 0, 226, 584, 227,
+	585, 228, 229, 230, 231, 232, 0, 0, 233, 0,
+	586, 234, 587, 0, 235, 236, 426, 0, 0, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+	248, 249, 250, 427, 0, 588, 0, 251, 252, 0,
+	0, 253, 254, 255, 0, 0, 256, 1089, 257, 258,
+	259, 0, 260, 0, 0, 261, 262, 0, 0, 263,
+	0, 589, 264, 590, 0, 265, 266, 267, 268, 269,
+	270, 271, 0, 272, 273, 0, 274, 0, 277, 275,
+	276, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 1084, 287, 288, 289, 290, 0, 291, 292, 293,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 591, 304, 305, 306, 0, 307, 308, 309, 310,
+	311, 312, 313, 314, 0, 315, 316, 1073, 317, 318,
+	428, 0, 319, 320, 0, 321, 322, 592, 323, 324,
+	1082, 325, 0, 326, 327, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 0, 0, 337, 338, 0, 339,
+	593, 340, 341, 342, 343, 1927, 0, 1097, 1096, 0,
+	0, 429, 345, 0, 346, 0, 0, 347, 348, 349,
+	350, 351, 352, 353, 0, 0, 354, 355, 356, 357,
+	358, 0, 0, 359, 360, 361, 362, 363, 0, 1101,
+	0, 364, 594, 365, 366, 367, 368, 109, 0, 369,
+	0, 0, 370, 371, 372, 373, 374, 375, 376, 377,
+	112, 113, 114, 115, 116, 117, 118, 119, 0, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 123,
+	124, 0, 125, 126, 0, 127, 128, 129, 378, 379,
+	0, 380, 0, 381, 0, 130, 131, 132, 133, 134,
+	135, 0, 0, 425, 136, 382, 383, 137, 0, 138,
+	139, 140, 141, 384, 0, 0, 0, 142, 143, 144,
+	145, 146, 0, 0, 147, 148, 149, 0, 150, 151,
+	152, 153, 154, 155, 0, 0, 156, 157, 158, 0,
+	0, 0, 0, 0, 0, 0, 159, 160, 161, 162,
+	163, 385, 164, 165, 386, 387, 166, 0, 167, 0,
+	168, 169, 170, 171, 172, 0, 173, 174, 175, 0,
+	0, 176, 177, 178, 179, 180, 0, 181, 182, 183,
+	0, 184, 185, 186, 0, 187, 188, 189, 190, 388,
+	191, 192, 193, 389, 0, 194, 0, 195, 196, 390,
+	197, 0, 198, 0, 199, 0, 0, 0, 200, 201,
+	202, 0, 203, 204, 391, 0, 392, 205, 0, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 0, 215,
+	216, 217, 218, 219, 220, 0, 221, 0, 393, 222,
+	223, 224, 225, 394, 395, 0, 396, 0, 226, 0,
+	227, 0, 228, 229, 230, 231, 232, 0, 0, 233,
+	397, 0, 234, 0, 0, 235, 236, 426, 0, 0,
+	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 427, 398, 0, 399, 251, 252,
+	400, 0, 253, 254, 255, 0, 401, 256, 402, 257,
+	258, 259, 0, 260, 0, 0, 261, 262, 0, 0,
+	263, 403, 0, 264, 0, 404, 265, 266, 267, 268,
+	0, 270, 271, 0, 272, 273, 405, 274, 406, 277,
+	275, 276, 0, 278, 279, 280, 281, 282, 283, 284,
+	0, 286, 407, 287, 288, 289, 290, 0, 291, 292,
+	293, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 0, 304, 305, 306, 408, 0, 308, 309,
+	310, 311, 312, 313, 314, 0, 315, 316, 409, 317,
+	318, 428, 0, 319, 320, 410, 321, 322, 0, 323,
+	324, 411, 325, 0, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 412, 0, 337, 338, 0,
+	339, 0, 340, 341, 342, 343, 344, 0, 441, 413,
+	0, 0, 429, 345, 414, 346, 415, 0, 347, 348,
+	349, 350, 351, 352, 353, 0, 0, 354, 355, 356,
+	357, 358, 0, 0, 359, 360, 361, 362, 363, 416,
+	417, 0, 364, 0, 365, 366, 367, 368, 0, 0,
+	369, 0, 0, 370, 371, 372, 373, 374, 375, 376,
+	377, 723, 0, 739, 740, 741, 0, 0, 0, 0,
+	1083, 0, 1098, 1078, 1090, 0, 0, 0, 0, 0,
+	723, 742, 739, 740, 741, 0, 1100, 1099, 0, 725,
+	0, 0, 0, 0, 0, 748, 0, 0, 0, 723,
+	742, 739, 740, 741, 0, 0, 0, 0, 725, 0,
+	0, 0, 0, 0, 748, 0, 0, 0, 0, 742,
+	0, 724, 723, 0, 739, 740, 741, 725, 0, 738,
+	0, 0, 1095, 748, 0, 1088, 1087, 0, 0, 0,
+	724, 0, 742, 0, 0, 0, 0, 0, 738, 0,
+	725, 0, 0, 0, 1086, 0, 748, 0, 0, 724,
+	0, 723, 0, 739, 740, 741, 0, 738, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1085, 742, 724, 723, 0, 739, 740, 741, 0, 725,
+	738, 0, 0, 0, 0, 748, 0, 0, 1451, 0,
+	1465, 1466, 1467, 742, 0, 0, 0, 0, 0, 0,
+	0, 725, 0, 0, 0, 0, 0, 748, 0, 0,
+	0, 724, 0, 0, 1080, 1081, 0, 912, 0, 738,
+	723, 0, 739, 740, 741, 749, 0, 0, 0, 0,
+	0, 0, 0, 724, 0, 0, 0, 0, 0, 0,
+	742, 738, 0, 0, 749, 0, 747, 0, 725, 0,
+	0, 0, 0, 0, 748, 0, 1464, 0, 0, 0,
+	744, 0, 0, 749, 0, 747, 0, 0, 0, 1089,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 744,
+	724, 0, 0, 0, 747, 0, 749, 0, 738, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 744, 0,
+	0, 0, 0, 0, 0, 743, 0, 747, 0, 0,
+	0, 0, 0, 1084, 0, 0, 0, 0, 0, 0,
+	0, 744, 0, 0, 743, 749, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 743, 0, 0, 747, 749, 0, 1073,
+	0, 0, 723, 0, 739, 740, 741, 0, 0, 0,
+	744, 0, 1082, 0, 0, 0, 743, 0, 747, 0,
+	0, 0, 742, 0, 0, 723, 0, 739, 740, 741,
+	725, 0, 744, 1468, 0, 746, 748, 1079, 0, 1097,
+	1096, 0, 0, 0, 749, 742, 0, 0, 0, 1355,
+	0, 0, 0, 725, 746, 743, 0, 0, 0, 748,
+	0, 0, 724, 0, 0, 747, 0, 0, 0, 0,
+	738, 1101, 0, 746, 0, 0, 0, 743, 0, 744,
+	0, 0, 0, 0, 0, 724, 0, 0, 0, 0,
+	0, 0, 0, 738, 0, 0, 746, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 745, 0,
+	0, 735, 736, 737, 743, 726, 727, 728, 729, 730,
+	732, 733, 731, 734, 0, 746, 1356, 745, 0, 1889,
+	735, 736, 737, 0, 726, 727, 728, 729, 730, 732,
+	733, 731, 734, 0, 0, 0, 745, 746, 1870, 735,
+	736, 737, 0, 726, 727, 728, 729, 730, 732, 733,
+	731, 734, 0, 0, 0, 0, 749, 1846, 0, 745,
+	0, 0, 735, 736, 737, 0, 726, 727, 728, 729,
+	730, 732, 733, 731, 734, 0, 0, 747, 0, 749,
+	1798, 0, 0, 0, 746, 0, 0, 0, 0, 0,
+	0, 744, 0, 0, 0, 0, 0, 0, 745, 0,
+	747, 735, 736, 737, 0, 726, 727, 728, 729, 730,
+	732, 733, 731, 734, 744, 0, 0, 0, 0, 1694,
+	745, 0, 0, 735, 736, 737, 0, 726, 727, 728,
+	729, 730, 732, 733, 731, 734, 743, 0, 1461, 1462,
+	1463, 1669, 1452, 1453, 1454, 1455, 1456, 1458, 1459, 1457,
+	1460, 0, 0, 0, 0, 0, 0, 0, 0, 743,
+	0, 0, 0, 0, 0, 0, 0, 745, 0, 0,
+	735, 736, 737, 0, 726, 727, 728, 729, 730, 732,
+	733, 731, 734, 0, 0, 0, 0, 0, 1150, 0,
+	0, 0, 723, 0, 739, 740, 741, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 742, 0, 0, 723, 746, 739, 740, 741,
+	725, 0, 0, 0, 0, 0, 748, 0, 0, 0,
+	0, 0, 0, 0, 0, 742, 0, 0, 0, 746,
+	0, 0, 0, 725, 0, 0, 0, 0, 0, 748,
+	0, 0, 724, 0, 0, 0, 0, 0, 0, 0,
+	738, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 724, 0, 0, 0, 0,
+	0, 0, 0, 738, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1353, 0, 0, 0, 0, 745,
+	0, 0, 735, 736, 737, 0, 726, 727, 728, 729,
+	730, 732, 733, 731, 734, 0, 0, 0, 1829, 0,
+	0, 0, 745, 0, 0, 735, 736, 737, 0, 726,
+	727, 728, 729, 730, 732, 733, 731, 734, 2211, 0,
+	0, 977, 0, 0, 0, 0, 0, 0, 723, 0,
+	739, 740, 741, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1479, 0, 0, 0, 749, 1478, 742, 0,
+	0, 976, 0, 0, 0, 0, 725, 0, 0, 0,
+	0, 0, 748, 0, 0, 0, 0, 747, 0, 749,
+	0, 0, 0, 0, 723, 0, 739, 740, 741, 0,
+	0, 744, 0, 0, 0, 0, 0, 0, 724, 0,
+	747, 0, 0, 0, 742, 0, 738, 0, 0, 0,
+	0, 0, 725, 0, 744, 0, 0, 0, 748, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 823, 842, 839, 840, 841, 0, 0, 0,
-	0, 825, 0, 0, 0, 0, 0, 848, 0, 0,
-	0, 823, 842, 839, 840, 841, 0, 0, 0, 0,
-	825, 0, 0, 0, 0, 0, 848, 0, 0, 0,
-	0, 842, 824, 823, 0, 839, 840, 841, 0, 825,
-	838, 0, 0, 0, 0, 848, 0, 0, 0, 0,
-	0, 824, 823, 842, 839, 840, 841, 0, 0, 838,
-	0, 825, 0, 0, 0, 0, 0, 848, 0, 0,
-	824, 0, 842, 0, 0, 0, 0, 0, 838, 0,
-	825, 823, 0, 839, 840, 841, 848, 0, 0, 0,
-	0, 0, 824, 0, 0, 0, 0, 0, 0, 0,
-	838, 842, 0, 0, 0, 0, 0, 0, 0, 825,
-	0, 824, 0, 0, 0, 848, 0, 0, 0, 838,
-	0, 0, 823, 0, 839, 840, 841, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 743, 0, 0, 0,
+	0, 0, 0, 0, 724, 0, 0, 0, 0, 0,
+	0, 0, 738, 0, 723, 2210, 739, 740, 741, 743,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	824, 0, 842, 0, 0, 849, 0, 0, 838, 0,
-	825, 0, 0, 0, 0, 0, 848, 0, 0, 0,
-	0, 0, 0, 0, 849, 0, 847, 0, 0, 0,
+	0, 0, 0, 0, 742, 0, 0, 0, 0, 0,
+	0, 0, 725, 0, 0, 0, 0, 0, 748, 0,
+	0, 723, 0, 739, 740, 741, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	844, 824, 0, 849, 0, 847, 0, 0, 0, 838,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 844,
-	0, 0, 0, 0, 847, 849, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 844, 0,
-	0, 0, 0, 0, 849, 843, 847, 0, 0, 0,
+	0, 742, 749, 0, 724, 0, 746, 0, 0, 725,
+	0, 0, 738, 0, 0, 748, 0, 0, 0, 0,
+	0, 0, 0, 747, 0, 0, 0, 0, 0, 746,
+	0, 0, 0, 0, 0, 0, 0, 744, 0, 0,
+	0, 724, 0, 0, 0, 0, 0, 0, 749, 738,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	844, 0, 0, 0, 843, 847, 0, 0, 0, 0,
-	0, 0, 0, 849, 0, 0, 0, 0, 0, 844,
-	0, 0, 0, 843, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 847, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 843, 0, 0, 844, 0,
-	0, 0, 0, 0, 849, 0, 0, 0, 0, 0,
-	0, 0, 0, 846, 843, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 847, 0, 823, 0, 839,
-	840, 841, 846, 0, 0, 0, 0, 0, 0, 844,
-	0, 0, 0, 843, 0, 0, 0, 842, 0, 0,
-	0, 846, 0, 0, 0, 825, 0, 0, 0, 0,
-	0, 848, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 846, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 843, 0, 824, 0, 0, 0,
-	0, 0, 846, 0, 838, 0, 845, 0, 0, 835,
-	836, 837, 0, 826, 827, 828, 829, 830, 832, 833,
-	831, 834, 0, 0, 0, 845, 0, 1906, 835, 836,
-	837, 846, 826, 827, 828, 829, 830, 832, 833, 831,
-	834, 0, 0, 0, 845, 0, 1882, 835, 836, 837,
-	0, 826, 827, 828, 829, 830, 832, 833, 831, 834,
-	0, 0, 0, 0, 0, 1772, 845, 0, 0, 835,
-	836, 837, 846, 826, 827, 828, 829, 830, 832, 833,
-	831, 834, 0, 0, 0, 845, 0, 1708, 835, 836,
-	837, 0, 826, 827, 828, 829, 830, 832, 833, 831,
-	834, 0, 0, 0, 0, 0, 1683, 0, 0, 849,
-	0, 0, 0, 0, 845, 0, 0, 835, 836, 837,
-	0, 826, 827, 828, 829, 830, 832, 833, 831, 834,
-	847, 0, 0, 0, 0, 1628, 0, 0, 0, 0,
-	0, 0, 0, 0, 844, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 845, 0, 0, 835, 836,
-	837, 0, 826, 827, 828, 829, 830, 832, 833, 831,
-	834, 0, 0, 0, 0, 823, 1222, 839, 840, 841,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 843,
-	0, 0, 0, 0, 823, 842, 839, 840, 841, 1121,
-	0, 0, 0, 825, 0, 0, 0, 0, 0, 848,
-	0, 0, 0, 0, 842, 0, 0, 0, 0, 0,
-	0, 823, 825, 839, 840, 841, 0, 0, 848, 0,
-	0, 0, 0, 0, 824, 0, 852, 0, 0, 0,
-	0, 842, 838, 823, 0, 839, 840, 841, 0, 825,
-	0, 0, 0, 824, 0, 848, 0, 0, 0, 0,
-	0, 838, 0, 842, 0, 0, 851, 846, 0, 0,
-	0, 825, 0, 0, 0, 0, 0, 848, 0, 823,
-	824, 839, 840, 841, 0, 1122, 0, 0, 838, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 842,
-	0, 0, 824, 0, 0, 0, 0, 825, 0, 0,
-	838, 0, 0, 848, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 747,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 824, 2151,
-	0, 0, 0, 0, 0, 0, 838, 849, 0, 0,
-	845, 0, 0, 835, 836, 837, 0, 826, 827, 828,
-	829, 830, 832, 833, 831, 834, 849, 1544, 847, 1754,
-	0, 0, 1543, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 844, 0, 0, 0, 0, 847, 0, 0,
-	0, 0, 0, 849, 0, 0, 0, 0, 0, 0,
-	0, 844, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 847, 849, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 843, 844, 0,
-	0, 0, 0, 0, 0, 0, 847, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 843, 0, 0, 0,
-	844, 849, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2150, 0, 0, 0, 0,
-	0, 0, 847, 843, 0, 0, 0, 0, 0, 823,
-	0, 839, 840, 841, 0, 0, 844, 0, 0, 0,
-	0, 0, 0, 0, 0, 843, 0, 0, 0, 842,
-	0, 0, 0, 0, 0, 846, 0, 825, 0, 0,
-	0, 0, 0, 848, 0, 0, 823, 0, 839, 840,
-	841, 0, 0, 0, 846, 0, 0, 0, 0, 0,
-	0, 843, 434, 0, 0, 0, 842, 0, 824, 1545,
-	0, 0, 0, 0, 825, 0, 838, 0, 0, 0,
-	848, 846, 0, 0, 0, 0, 0, 0, 0, 0,
-	1119, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 846, 0, 824, 0, 0, 0, 0,
-	0, 0, 0, 838, 0, 0, 0, 0, 845, 0,
-	0, 835, 836, 837, 0, 826, 827, 828, 829, 830,
-	832, 833, 831, 834, 0, 0, 0, 845, 0, 846,
-	835, 836, 837, 0, 826, 827, 828, 829, 830, 832,
-	833, 831, 834, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 845, 0, 0, 835, 836, 837,
-	0, 826, 827, 828, 829, 830, 832, 833, 831, 834,
-	0, 849, 0, 0, 0, 0, 845, 0, 0, 835,
-	836, 837, 0, 826, 827, 828, 829, 830, 832, 833,
-	831, 834, 847, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 844, 0, 849, 0,
-	0, 0, 845, 0, 0, 835, 836, 837, 0, 826,
-	827, 828, 829, 830, 832, 833, 831, 834, 0, 847,
-	0, 0, 0, 0, 0, 823, 0, 839, 840, 841,
-	0, 0, 0, 844, 0, 0, 0, 0, 0, 0,
-	0, 843, 0, 0, 0, 842, 0, 0, 823, 0,
-	839, 840, 841, 825, 0, 0, 0, 0, 0, 848,
-	0, 0, 0, 0, 0, 0, 0, 0, 842, 0,
-	0, 0, 0, 0, 0, 0, 825, 0, 843, 0,
-	0, 0, 848, 0, 824, 0, 823, 0, 839, 840,
-	841, 0, 838, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 842, 824, 0, 1509,
-	0, 0, 0, 0, 825, 838, 0, 0, 0, 846,
-	848, 0, 0, 0, 823, 0, 839, 840, 841, 0,
+	0, 0, 743, 744, 0, 0, 0, 0, 0, 745,
+	0, 0, 735, 736, 737, 0, 726, 727, 728, 729,
+	730, 732, 733, 731, 734, 0, 0, 0, 0, 0,
+	0, 0, 745, 0, 0, 735, 736, 737, 749, 726,
+	727, 728, 729, 730, 732, 733, 731, 734, 743, 465,
+	0, 0, 0, 1485, 0, 0, 0, 0, 0, 747,
+	0, 0, 0, 723, 0, 739, 740, 741, 0, 0,
+	0, 0, 0, 744, 0, 749, 0, 0, 0, 0,
+	0, 0, 746, 742, 0, 0, 1480, 0, 0, 0,
+	0, 725, 0, 0, 0, 0, 747, 748, 0, 723,
+	0, 739, 740, 741, 0, 0, 0, 0, 0, 0,
+	744, 0, 0, 0, 0, 0, 0, 0, 743, 0,
+	0, 0, 0, 724, 0, 0, 0, 725, 746, 0,
+	0, 738, 0, 748, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 842, 824, 0, 0, 1702, 0,
-	0, 0, 825, 838, 0, 0, 846, 0, 848, 0,
+	0, 0, 0, 0, 0, 743, 0, 0, 0, 724,
+	0, 0, 0, 0, 0, 745, 0, 738, 735, 736,
+	737, 0, 726, 727, 728, 729, 730, 732, 733, 731,
+	734, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 746, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1550, 0, 0, 0,
-	0, 0, 0, 824, 0, 0, 0, 0, 0, 0,
-	0, 838, 0, 0, 0, 0, 0, 849, 0, 0,
-	0, 0, 845, 0, 0, 835, 836, 837, 0, 826,
-	827, 828, 829, 830, 832, 833, 831, 834, 847, 0,
-	849, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 844, 0, 0, 0, 0, 0, 0, 845,
-	0, 847, 835, 836, 837, 0, 826, 827, 828, 829,
-	830, 832, 833, 831, 834, 844, 0, 0, 849, 0,
+	0, 745, 0, 0, 735, 736, 737, 0, 726, 727,
+	728, 729, 730, 732, 733, 731, 734, 1688, 0, 0,
+	0, 0, 0, 0, 0, 746, 0, 749, 0, 0,
+	0, 0, 723, 0, 739, 740, 741, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 747, 0,
+	0, 0, 742, 0, 0, 0, 0, 0, 0, 0,
+	725, 0, 744, 749, 0, 0, 748, 0, 0, 0,
+	0, 745, 0, 0, 735, 736, 737, 0, 726, 727,
+	728, 729, 730, 732, 733, 731, 734, 0, 0, 0,
+	0, 0, 724, 0, 0, 0, 0, 0, 744, 0,
+	738, 0, 0, 0, 0, 0, 0, 743, 745, 0,
+	0, 735, 736, 737, 0, 726, 727, 728, 729, 730,
+	732, 733, 731, 734, 0, 0, 0, 0, 0, 0,
+	0, 723, 0, 739, 740, 741, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1154, 0, 1169, 1149, 1161, 0, 843, 0, 847,
-	0, 0, 0, 0, 0, 0, 1171, 1170, 0, 0,
-	0, 0, 0, 844, 0, 0, 849, 0, 0, 0,
-	843, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1514, 0, 847, 0, 0,
+	0, 742, 0, 0, 1444, 0, 0, 0, 723, 725,
+	739, 740, 741, 0, 0, 748, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 723, 742, 739,
+	740, 741, 0, 0, 0, 0, 725, 746, 0, 0,
+	0, 724, 748, 0, 0, 0, 0, 742, 0, 738,
+	0, 0, 0, 0, 0, 725, 749, 0, 0, 0,
+	0, 748, 0, 0, 0, 0, 0, 0, 724, 0,
+	0, 0, 0, 746, 0, 0, 738, 747, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 724, 0, 0,
+	0, 744, 0, 0, 0, 738, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 844, 1166, 0, 0, 1159, 1158, 0, 843, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1157, 846, 0, 0, 0, 0,
+	745, 0, 0, 735, 736, 737, 0, 726, 727, 728,
+	729, 730, 732, 733, 731, 734, 743, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 843, 0, 846, 0,
-	1156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1449, 0, 0, 0, 749, 745, 0, 0, 735,
+	736, 737, 0, 726, 727, 728, 729, 730, 732, 733,
+	731, 734, 0, 0, 0, 0, 747, 0, 0, 0,
+	0, 0, 749, 0, 0, 0, 0, 0, 0, 0,
+	744, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 749, 0, 747, 0, 0, 0, 0, 1083, 0,
+	1098, 1078, 1090, 0, 0, 0, 746, 744, 0, 0,
+	0, 0, 747, 0, 1100, 1099, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 743, 744, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 846, 0, 0, 0,
-	0, 0, 0, 1151, 1152, 0, 766, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 845, 0,
-	0, 835, 836, 837, 0, 826, 827, 828, 829, 830,
-	832, 833, 831, 834, 846, 0, 0, 0, 0, 0,
-	0, 845, 0, 0, 835, 836, 837, 0, 826, 827,
-	828, 829, 830, 832, 833, 831, 834, 0, 1160, 0,
+	0, 0, 743, 0, 0, 0, 0, 0, 0, 0,
+	1095, 0, 0, 1088, 1087, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 845,
-	0, 0, 835, 836, 837, 0, 826, 827, 828, 829,
-	830, 832, 833, 831, 834, 0, 0, 0, 0, 0,
-	0, 1155, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 845, 0, 0,
-	835, 836, 837, 0, 826, 827, 828, 829, 830, 832,
-	833, 831, 834, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1153,
+	0, 0, 1086, 0, 0, 0, 0, 0, 0, 745,
+	0, 0, 735, 736, 737, 0, 726, 727, 728, 729,
+	730, 732, 733, 731, 734, 746, 0, 0, 1085, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1150, 0, 1168, 1167, 0, 0,
+	0, 0, 746, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 746, 1080, 1081, 0, 912, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 745, 0,
+	0, 735, 736, 737, 0, 726, 727, 728, 729, 730,
+	732, 733, 731, 734, 0, 0, 0, 1089, 0, 0,
+	0, 0, 0, 0, 0, 745, 0, 0, 735, 736,
+	737, 0, 726, 727, 728, 729, 730, 732, 733, 731,
+	734, 0, 0, 0, 745, 0, 0, 735, 736, 737,
+	0, 726, 727, 728, 729, 730, 732, 733, 731, 734,
+	0, 1084, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1073, 0, 0,
+	0, 0, 0, 0, 1071, 0, 0, 0, 0, 0,
+	1082, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1079, 0, 1097, 1096, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1101,
 }
 var sqlPact = [...]int{
 
-	222, -1000, -111, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	158, -1000, -145, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 634, -1000, -1000, -1000, -1000, -1000,
+	-1000, 677, 557, 97, 1225, 1009, 780, 1225, -1000, -1000,
+	33891, 25671, 240, 240, 334, 379, 240, 330, 26082, 33480,
+	333, 447, 730, 43, -1000, 862, -146, 33069, 26082, 1343,
+	-101, 24438, 102, 158, 12272, 1137, 1008, 24438, 32658, 32247,
+	31836, 31425, -1000, -1000, 98, 16093, -1000, -1000, -1000, -1000,
+	-1000, 702, 817, 639, -102, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 26082, 26082, 31014, 25260, 816, -1000, 27726,
+	30603, 903, -1000, -1000, 82, 203, 1558, 1372, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 555, 791, 104, 644, -1000, -1000,
-	32205, 24879, 247, 247, 324, 452, 247, 451, 25286, 31798,
-	322, 397, 941, 19, -1000, 666, -126, 31391, 25286, 1307,
-	-115, 24065, 133, 222, 1106, 921, 24065, 30984, 30577, 30170,
-	-1000, -1000, 141, 15800, -1000, -1000, -1000, -1000, -1000, 772,
-	25286, 29763, 24472, -1000, 26914, 29356, 863, -1000, -1000, 54,
-	212, 1543, 1332, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4857,447 +5076,460 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -78, 200,
+	-1000, 1133, 1121, 1118, 905, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 903, 903, -1000, 1554, -1000, 416, 1553,
+	-1000, -1000, 26082, -1000, 1550, 24438, -1000, 469, 1039, 26082,
+	30192, -1000, -1000, 471, 454, -1000, -1000, 862, -1000, -1000,
+	-1000, 1005, 1332, 1332, 1332, 1363, 16, 13, 43, -103,
+	26082, -1000, 137, -103, 10106, 10106, -1000, 806, 102, -1000,
+	133, 21147, -85, -1000, 9673, -1000, 36517, -1000, 12272, 12272,
+	12272, 12272, 12272, 703, 136, -1000, -1000, -1000, 7075, -1000,
+	-1000, -85, 136, 7, -1000, -1000, 128, 124, -85, -1000,
+	-1000, -1000, -1000, -1000, 448, 1548, 446, -1000, -1000, -1000,
+	12272, 213, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1164, -1000, 123, 121, 120, 119, -1000, -1000, -1000,
+	-1000, -1000, -1000, 117, 115, 113, 112, 111, 110, 108,
+	107, 106, 105, 104, 667, -1000, 251, -1000, 251, 251,
+	-1000, 89, 89, 90, -1000, -1000, 89, -1000, -1000, -53,
+	-47, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -102,
-	211, -1000, 1099, 1094, 1090, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 863, 863, -1000, 1540, -1000, 367, 1539,
-	-1000, -1000, 25286, -1000, 1537, 24065, -1000, 391, 959, 28949,
-	-1000, -1000, 386, 379, -1000, -1000, 666, -1000, -1000, -1000,
-	956, 1306, 1306, 1306, 1402, -16, -19, 19, -117, 25286,
-	-1000, 134, -117, 10298, 10298, -1000, 734, 133, -1000, 61,
-	20806, -108, -1000, 9869, -1000, 24065, 25286, 433, 28542, 1178,
-	116, -1000, -105, -1000, 1177, -124, 1169, -1000, -128, -1000,
-	17068, -1000, 133, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 385, 24438, 26082, 548, 29781,
+	1206, 122, -1000, -86, -1000, 1205, -117, 1199, -117, 1197,
+	-1000, -104, -1000, 17373, -1000, 102, -1000, -1000, -1000, -1000,
+	-1000, -1000, 432, 24849, -1000, -1000, 787, 1280, 1218, 2318,
+	1196, 2264, 1194, 24849, -1000, 1340, -1000, 1410, 56, -1000,
+	969, 350, -1000, 1245, 16949, 16525, 1277, 26082, 1053, -1000,
+	-1000, -1000, -1000, -1000, 26082, 26082, 26082, 24849, 26082, -1000,
+	56, -1000, -1000, 29370, -1000, -1000, -1000, 92, -1000, 28959,
+	28548, 1316, 20, 191, 912, -1000, -1000, -1000, -1000, -1000,
+	1005, 938, 1149, 1152, 569, 792, 1055, -1000, 11406, 1050,
+	12272, 852, 43, -1000, -1000, 43, 43, 12272, -1000, -1000,
+	26082, -103, 1414, 26082, 1117, -195, -1000, 35897, -1000, 58,
+	101, -1000, -1000, -1000, 26082, -85, -1000, 4927, 12272, -108,
+	-1000, 36517, -1000, 36682, 26082, 422, 12272, 12272, 12272, 12272,
+	12272, 12272, 12272, 12272, 12272, 12272, 12272, 12272, 12272, 12272,
+	12272, 12272, 12272, 12272, 12272, 883, 415, 2115, 839, 88,
+	860, -1000, 1455, 1455, 1455, 4005, 4005, 135, -1000, -85,
+	-93, 35299, -105, -85, -1000, -1000, -1000, 9240, 12272, 8807,
+	-85, 6208, -1000, -1000, 508, 1540, 238, 36517, 1154, 1062,
+	100, 1539, 1538, 1537, 1536, 12272, 1150, 12272, 13571, 12272,
+	12272, 7508, 12272, 12272, 12272, 12272, -1000, 99, -1000, -1000,
+	-1000, -1000, 1528, -1000, -1000, 1527, -1000, 1521, -1000, 420,
+	381, 1517, -1000, 1514, -1000, 543, 233, 66, 1413, 19905,
+	-1000, 26082, 26082, -1000, 26082, -1000, -1000, -70, 5351, 26082,
+	26082, 26082, 98, 21561, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1501, -1000, -1000, -1000, -1000, 1458, 28137, 403,
+	-129, 26082, 26082, -1000, -1000, 12272, 1072, -1000, -1000, 399,
+	1072, 27726, -1000, 176, -1000, 949, -1000, -1000, -1000, -1000,
+	-159, 396, -1000, 22383, 1308, 1306, 1293, 146, 26082, 1027,
+	26082, 828, 8, 26082, -159, 809, 24438, 1111, 1410, 332,
+	903, -1000, 686, -1000, -1000, -1000, -106, -1000, -1000, -1000,
+	-1000, -106, -1000, -1000, -1000, -1000, -1000, -1000, 373, -1000,
+	-1000, -1000, -1000, -78, -78, -78, 1151, -1000, -127, -1000,
+	26082, -1000, 869, 24438, 1051, 1044, -1000, 904, 145, 901,
+	1402, 27315, -1000, 1400, 26082, 1072, -1000, -1000, 1192, 938,
+	1149, -1000, -1000, -1000, -1000, -1000, 533, 737, -1000, 994,
+	-1000, 486, -1000, -1000, -1000, 36517, -1000, 364, -1000, -1000,
+	36517, 581, 563, 26082, 1012, -1000, 1012, -113, -1000, 35454,
+	-1000, 91, -109, 233, 14851, 10106, 5351, -1000, 233, 12272,
+	-2, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 382, 2160, 2882,
-	1161, 2739, 1160, -1000, 1335, -1000, 1426, 50, -1000, 877,
-	400, -1000, 1219, 16648, 16228, 1246, 25286, 1311, -1000, -1000,
-	-1000, -1000, -1000, 25286, 25286, 25286, -1000, 50, -1000, -1000,
-	28135, -1000, -1000, -1000, 86, -1000, 27728, 1279, 199, 803,
-	-1000, -1000, -1000, -1000, -1000, 956, 938, 1118, 1120, 672,
-	740, 936, -1000, 11157, 942, 12444, 743, 19, -1000, -1000,
-	19, 19, 12444, -1000, -1000, 25286, -117, 1433, 25286, 1089,
-	-161, -1000, 34082, -1000, -1000, 12444, 12444, 12444, 12444, 12444,
-	637, 132, -1000, -1000, -1000, 6866, -1000, -1000, -108, 132,
-	-30, -1000, -1000, 131, 129, -108, -1000, -1000, -1000, -1000,
-	-1000, 431, 1533, 430, -1000, -1000, -1000, 12444, 220, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1119, -1000,
-	127, 124, 117, 111, -1000, -1000, -1000, -1000, -1000, -1000,
-	106, 105, 103, 102, 98, 89, 88, 85, 79, 78,
-	76, 590, -1000, 259, -1000, 259, 259, -1000, 51, 51,
-	52, -1000, -1000, 51, -1000, -1000, -75, -70, -1000, -1000,
-	-1000, -1000, -1000, -1000, 356, 53, 70, -1000, -1000, -1000,
-	25286, -108, -1000, 4738, 12444, -129, -1000, 34653, -1000, 244,
-	60, 1431, 19576, -1000, 25286, 25286, -1000, 25286, -1000, -1000,
-	-97, 5158, 25286, 25286, 141, 21216, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1481, -1000, -1000, -1000, -1000, 1479,
-	27321, 1275, -1000, -1000, 360, 1275, 26914, -1000, 170, -1000,
-	872, -1000, -1000, -1000, -1000, -133, 359, -1000, 22030, 1317,
-	1303, 1261, 126, 25286, 948, 25286, 731, -71, 25286, -133,
-	626, 24065, 1426, 320, 863, -1000, 634, -1000, -1000, -1000,
-	-120, -1000, -1000, -1000, -1000, -120, -1000, -1000, -1000, -1000,
-	-1000, -1000, 411, -1000, -1000, -1000, -1000, -102, -102, -102,
-	25286, -1000, 802, 24065, 977, 970, 156, 798, 1425, 26507,
-	-1000, 1275, -1000, -1000, 1159, 938, 1118, -1000, -1000, -1000,
-	-1000, -1000, 598, 698, -1000, 910, -1000, 409, -1000, -1000,
-	-1000, 34653, -1000, 331, -1000, -1000, 34653, 541, 549, 25286,
-	924, -1000, 924, -121, -1000, 34014, -1000, 68, -134, -1000,
-	244, 14570, 10298, 3878, 25286, 372, 12444, 12444, 12444, 12444,
-	12444, 12444, 12444, 12444, 12444, 12444, 12444, 12444, 12444, 12444,
-	12444, 12444, 12444, 12444, 12444, 869, 355, 1162, 730, 48,
-	883, 5158, -1000, -1000, 1473, 1473, 1473, 2254, 2254, 95,
-	-1000, -108, -116, 33581, -123, -108, -1000, -1000, -1000, 9011,
-	12444, 8582, -108, 6007, -1000, -1000, 700, 1526, 252, 34653,
-	1125, 1016, 65, 1522, 1514, 1511, 1510, 12444, 627, 12444,
-	13302, 12444, 12444, 7295, 12444, 12444, 12444, 12444, -1000, 64,
-	-1000, -1000, -1000, -1000, 1507, -1000, -1000, 1502, -1000, 1500,
-	-1000, 370, 333, 1496, -1000, 1494, -1000, 425, 244, 12444,
-	-36, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3082, 9673, -1000, -1000,
+	1418, 35104, -1000, -1000, -1000, -1000, -1000, 74, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 90, 667, 89, 89, 89,
+	-1000, -1000, -1000, -1000, -1000, -1000, -47, -53, 251, 251,
+	251, -1000, -1000, 199, 575, 575, 518, 518, 518, 1492,
+	1492, 1492, 1492, 237, 237, 237, 590, 237, 237, 237,
+	4005, 36536, 348, 12272, 389, 790, 88, 12272, -1000, 611,
+	-1000, -1000, -1000, 1108, 86, -1000, 13571, 13571, -1000, -1000,
+	-1000, 7075, 85, -1000, -1000, -1000, -1000, 83, 12272, -1000,
+	-1000, 12272, -114, -247, -1000, 36517, -1000, -115, -120, -1000,
+	-1000, -82, 12272, 12272, 12272, -5, -1000, 388, -1000, 387,
+	386, 383, -1000, 77, -7, 519, -1000, 12272, 710, 73,
+	72, 12272, -8, -9, -10, -11, 36490, -13, 1107, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -14, 36391, -15,
+	3024, -1000, 13571, 13571, 13571, 7075, 71, -16, 35764, -237,
+	36212, 10973, 10973, 10973, -21, 36050, 12272, -237, 3570, -122,
+	-128, -130, 1513, -134, -23, -24, 198, 196, -25, -26,
+	26082, 598, 12272, 789, -1000, 19062, 260, 26082, 36, -1000,
+	-1000, -1000, 174, 26082, -1000, 122, -1000, 5351, -1000, -1000,
+	-117, -117, -1000, -1000, -1000, -1000, -1000, 899, 20, 26082,
+	26082, -129, -127, 36517, -1000, 26082, -1000, -1000, 17797, -1000,
+	-1000, 1991, 26082, -1000, 26082, -1000, 36682, 26082, -1000, 70,
+	21, 45, 987, 268, 981, 26082, 26082, -1000, 26904, 26493,
+	26082, -1000, -1000, -1000, 5351, -1000, 26082, -1000, -1000, -1000,
+	1093, 26082, 26082, 26082, 1067, 26082, 903, -1000, -1000, 610,
+	617, -1000, 17373, 508, 1511, -1000, 26082, 26082, -1000, -1000,
+	-1000, -1000, -1000, 1191, 21972, 26082, 219, 1189, -146, 763,
+	892, -1000, -146, -138, -1000, 26082, -1000, -1000, -1000, 285,
+	-1000, 581, -1000, 12272, 1510, -1000, -1000, -1000, -1000, -1000,
+	940, -129, 12272, 2038, 182, -1000, -1000, -146, -1000, 1061,
+	-135, 1587, 23616, 24027, 15679, 23616, -1000, 14437, 161, 1105,
+	-1000, -1000, 63, -1000, -1000, 1061, -142, -1000, -1000, 12272,
+	-1000, 149, 153, 1393, -1000, 1508, 12272, 12272, 292, 12272,
+	13571, 13571, -1000, 281, -1000, -1000, -1000, -1000, 1089, 61,
+	-1000, 12272, 36682, 3973, 3920, -151, -1000, 7075, 8374, -139,
+	-85, 35252, 12272, -1000, -1000, 150, -1000, -1000, -31, 10540,
+	-1000, 35943, -71, -71, -1000, 927, 771, 663, 570, 1505,
+	1547, 1221, -1000, 12272, 36013, -1000, 20733, 235, 720, 35230,
+	-1000, -1000, -1000, -1000, 36682, -1000, 12272, -1000, 1085, 12272,
+	-1000, 36682, 13571, 13571, 13571, 13571, 13571, 13571, 13571, 13571,
+	13571, 13571, 13571, 13571, 13571, 13571, 13571, 13571, 1015, 13571,
+	1444, 1444, 1444, -153, 7941, -1000, 1140, 1085, 12272, 12272,
+	36682, -32, -33, -35, -1000, 12272, -237, 12272, -1000, -1000,
+	-1000, -36, -1000, 1503, -1000, 192, -1000, -1000, 192, 1346,
+	-1000, -1000, 10106, 36517, 598, 1304, -152, -1000, -1000, 233,
+	19905, 9673, -158, -1000, -1000, 1185, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1032, 12272, 26082, -1000,
+	1033, 26082, 12, 57, 26082, 53, 805, -1000, 1183, 869,
+	1181, 869, -1000, -162, -1000, -66, -1000, -159, 380, -1000,
+	372, 370, -159, 688, -1000, 56, -1000, -1000, -1000, -1000,
+	-1000, -38, -1000, -1000, 26082, -39, -173, 36682, -1000, -1000,
+	-1000, -1000, 26082, 27, 746, 8, 26082, 223, 26082, 1177,
+	-1000, -1000, 191, -1000, 736, 35191, -1000, -1000, 182, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2966, 9869, -1000, 489,
-	12444, 729, -1000, 18741, 266, 25286, 12, -1000, -1000, -1000,
-	167, 25286, -1000, 116, -1000, 5158, -1000, -1000, -124, -1000,
-	-1000, -1000, -1000, -1000, 795, -10, -1000, 25286, -1000, -1000,
-	17488, -1000, -1000, 1891, 25286, -1000, 25286, -1000, 3878, 25286,
-	-1000, 58, 28, 11, 905, 273, 893, 25286, 25286, -1000,
-	26100, 25693, 25286, -1000, -1000, -1000, 5158, -1000, 25286, -1000,
-	-1000, -1000, 2535, 25286, 25286, 25286, 1141, 863, -1000, -1000,
-	603, 576, -1000, 17068, 700, 1490, -1000, -1000, -1000, -1000,
-	-1000, 21623, 25286, 235, 1157, -126, 721, 769, -1000, -1000,
-	25286, -1000, -1000, -1000, 284, -1000, 541, -1000, 12444, 1488,
-	-1000, -1000, -1000, -1000, -1000, 858, -125, -1000, 12444, 2510,
-	191, -1000, -1000, -126, 25286, -1000, 1001, -127, 1984, 23251,
-	23658, 15390, 23251, -1000, 14160, 189, 1069, -1000, -1000, 55,
-	-1000, -1000, 1439, 34765, -1000, -1000, -1000, -1000, 69, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 52, 590, 51, 51,
-	51, -1000, -1000, -1000, -1000, -1000, -1000, -70, -75, 259,
-	259, 259, -1000, -1000, 204, 399, 399, 482, 482, 482,
-	1396, 1396, 1396, 1396, 380, 380, 380, 207, 380, 380,
-	380, 2254, 466, 2263, 12444, 351, 727, 48, 12444, -1000,
-	1321, -1000, -1000, -1000, 1053, 45, -1000, 13302, 13302, -1000,
-	-1000, -1000, 6866, 44, -1000, -1000, -1000, -1000, -1000, 43,
-	12444, -1000, -1000, 12444, -135, -220, -1000, 34653, -1000, -142,
-	-144, -1000, -1000, -73, 12444, 12444, 12444, -37, -1000, 350,
-	-1000, 347, 345, 342, -1000, 41, -38, 535, -1000, 12444,
-	641, 40, 38, 12444, -39, -41, -42, -43, 34615, -44,
-	1052, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -45,
-	34577, -46, 3636, -1000, 13302, 13302, 13302, 6866, 34, -47,
-	34060, -208, 34355, 10728, 10728, 10728, -50, 34554, 12444, -208,
-	3084, -151, -153, -157, 1486, -164, -51, -52, 200, 198,
-	-55, -57, 25286, 1001, -165, -1000, -1000, 12444, -1000, -1000,
-	10298, 34653, 489, 1264, -166, -1000, -1000, 244, 19576, 9869,
-	-170, -1000, -1000, 1156, 25286, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 987, 12444, 25286, -1000, 957, 25286, -9,
-	32, 25286, 30, 643, -1000, 1140, 802, 1138, 802, -1000,
-	-174, -1000, -107, -1000, -133, 340, -1000, 339, 334, -133,
-	617, 50, -1000, -1000, -1000, -1000, -1000, -59, -60, -175,
-	-138, 3878, -1000, -1000, -1000, -1000, 25286, -1, 704, -71,
-	25286, 236, 25286, 1135, 199, -1000, 687, 33540, -1000, 25286,
-	-1000, 191, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 997,
-	-61, -1000, -1000, -1000, -1000, -1000, 992, 1400, 14570, 916,
-	914, 14570, 943, 657, 657, 657, -1000, -1000, -1000, 25286,
-	27, -1000, -1000, 20396, 24, 23658, 23251, -108, -1000, 19169,
-	-62, 1984, -1000, 646, 23, 6007, 144, 146, 1435, -1000,
-	1485, 12444, 12444, 932, 12444, 13302, 13302, -1000, 917, -1000,
-	-1000, -1000, -1000, 1049, 20, -1000, 12444, 3878, 3419, 2820,
-	-176, -1000, 6866, 8153, -139, -108, 33511, 12444, -1000, -1000,
-	145, -1000, -1000, -63, 9440, -1000, 34118, -72, -72, -1000,
-	835, 737, 649, 443, 1484, 1532, 1188, -1000, 12444, 34318,
-	-1000, 19986, 249, 662, 33492, -1000, -1000, -1000, -1000, 3878,
-	-1000, 12444, -1000, 1047, 12444, -1000, 3878, 13302, 13302, 13302,
-	13302, 13302, 13302, 13302, 13302, 13302, 13302, 13302, 13302, 13302,
-	13302, 13302, 13302, 1133, 13302, 1471, 1471, 1471, -155, 7724,
-	-1000, 1107, 1047, 12444, 12444, 3878, -65, -66, -67, -1000,
-	12444, -208, 12444, -1000, -1000, -1000, -68, -1000, 1482, -1000,
-	187, -1000, -1000, 187, 1341, -1000, 992, -1000, 33776, -161,
-	-1000, 113, -126, 489, -1000, -1000, 164, 25286, 2027, -1000,
-	11, 273, 33470, -180, 25286, -181, 25286, -1000, 14980, -1000,
-	25286, -1000, 954, 186, -71, 304, 25286, -1000, 25286, -1000,
-	-1000, 5158, 4312, 5158, 25286, 25286, 25286, 25286, 25286, 25286,
-	1531, 937, -1000, 21623, -1000, 235, 22437, -1000, 1284, -1000,
-	115, 1421, -1000, 952, 273, 25286, 1275, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 241, 12444, 12873, 1984, 14570, 14570,
-	1102, 913, 14570, -1000, -1000, -1000, -1000, 10, 25286, 9,
-	25286, 25286, -1000, -1000, -108, 23251, -1000, 18334, -73, 464,
-	1480, 144, 118, -74, 1363, 2115, 12444, 353, 1391, 597,
-	12444, 12444, 3878, 2304, -185, -1000, 12444, 12444, -1000, 33451,
-	-186, -1000, 12444, -1000, 34653, -1000, 1530, 12444, -78, -79,
-	-80, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -82, -1000,
-	-1000, 34653, 12444, -1000, -1000, 33019, 12444, -83, -1000, -84,
-	34653, 1107, 34653, -1000, 450, 450, 581, 581, 581, 1471,
-	1471, 1471, 1471, 745, 745, 745, 785, 745, 745, 745,
-	1030, 882, 8, -1000, 3678, 12444, -187, -1000, -1000, -1000,
-	34653, 34653, -85, -1000, -1000, -1000, -208, 33432, -1000, -86,
-	-1000, -1000, -1000, 241, -1000, 1200, 14980, 1258, -1000, -1000,
-	5, -1000, 25286, -1000, 25286, 762, -1000, -1000, 890, 4,
-	13302, 25286, -1000, -1000, -1000, -1000, -191, -192, -1000, 238,
-	216, 1310, 12444, -197, 758, -1000, 753, 611, -1000, 25286,
-	12444, -1000, -1000, 3878, 802, 802, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -105, 32612, 1479, 1481, -1000, -1000,
-	-1000, 162, -1000, -1000, -1000, -1000, 329, 328, -1000, 235,
-	3, -1000, 1014, 704, -198, -1000, -1000, -1000, 237, 827,
-	21623, 25286, -126, -1000, 1233, 2, 716, -1000, -1000, 25286,
-	34653, -196, -1000, 34653, -1000, 5578, -1000, 1102, -1000, 1,
-	12444, 14570, -1000, 25286, -204, 25286, -210, -1000, 3878, -211,
-	3878, -1000, -215, -1000, 1381, -88, -1000, 138, 137, 1478,
-	-1000, 2115, 12444, 12444, 12444, 2115, 2304, -216, -1000, 3878,
-	2115, 2115, -1000, -1000, 3830, -1000, 34118, -1000, -1000, -1000,
-	-1000, 34653, 635, -1000, 3715, -1000, -1000, -1000, 13302, 1028,
-	0, -1000, 3878, 3529, -1000, -1000, -1000, -1000, -1000, 449,
-	-221, -1000, 25286, -1000, -1000, 6437, 1454, -1000, -1000, -1000,
-	12444, 3678, -10, -1000, 245, 14980, 17908, 22844, 25286, 22844,
-	3457, 564, -1000, -1000, -1000, -1000, 34653, 1312, -1000, -1000,
-	-100, -105, 4312, 25286, 25286, 704, 25286, 1366, 1366, -1000,
-	-1000, 22437, 665, -1000, 460, 457, -90, -1, 236, -1000,
-	14980, 25286, -206, -1000, 1339, 12873, -1000, -1000, 25286, 34653,
-	-1000, -228, -1000, -236, -1000, 25286, 1312, -1000, 189, 18334,
-	-1000, -2, -1000, -1000, -1000, 136, 2115, 2115, 2115, -1000,
-	-1000, -1000, -92, 662, 1397, -1000, 1801, 13302, 3878, -246,
-	-1000, 424, -1000, 14980, 244, -1000, -1000, 3397, 842, -1000,
-	-3, -1000, -1000, -1000, -109, 1337, -1000, 25286, -1000, 1337,
-	1310, 25286, 276, 25286, 299, -103, -1000, -1000, -1000, -1000,
-	-259, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 937, 235, -1000, -260, 273, 25286, -5,
-	-1000, -264, -1000, -1000, -1000, -1000, -1000, -1000, 25286, -1000,
-	-1000, 631, 12444, 1801, -265, -1000, 19576, -1000, -1000, 816,
-	703, 695, 12444, 17908, 191, -1000, 191, 22844, -10, -1000,
-	12444, -1000, -1000, 298, -1000, 2027, 235, 704, 232, -14,
-	-1000, -1000, -1000, -266, -95, 12015, 12015, -208, -1000, -244,
-	-1000, 946, -1000, 715, 713, 556, -1000, -1000, -1000, 3343,
-	-1000, -1000, -1000, 1337, 842, 34653, -1000, 704, -1000, 244,
-	-71, 14980, -1000, -1000, -1000, -1000, 13731, 775, 519, 34033,
-	-1000, -1000, -1000, -1000, 1210, -1000, 282, 950, 950, -1000,
-	191, 703, -1000, -1000, -1000, -270, 1448, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1461, -1000, -1000, 891, -1000, -1000, -1000,
-	232, 11586, -1000, -1000, -1000, 244, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1038, -41, -1000, -1000,
+	-1000, -1000, 1057, 1361, 14851, 1003, 1001, 14851, 1035, 704,
+	704, 704, -1000, -1000, -1000, 26082, 52, -1000, -1000, 20319,
+	49, 24027, 23616, -85, -1000, 19494, -42, 1587, -1000, 716,
+	47, 6208, 1057, -1000, 35431, 452, 1499, 149, 144, -44,
+	1392, 502, 12272, 257, 2504, 740, 12272, 12272, 36682, 36248,
+	-164, -1000, 12272, 12272, -1000, 35168, -166, -1000, 12272, -1000,
+	36517, -1000, 1544, 12272, -46, -49, -50, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -52, -1000, -1000, 36517, 12272, -1000,
+	-1000, 34713, 12272, -55, -1000, -56, 36517, 1140, 36517, -1000,
+	367, 367, 594, 594, 594, 1444, 1444, 1444, 1444, 696,
+	696, 696, 605, 696, 696, 696, 1082, 1022, 46, -1000,
+	35267, 12272, -172, -1000, -1000, -1000, 36517, 36517, -58, -1000,
+	-1000, -1000, -237, 35149, -1000, -59, -1000, -1000, -1000, -195,
+	-1000, 131, -146, 598, -1000, -1000, 171, 26082, 1135, -1000,
+	45, 268, 35130, -174, 26082, -178, 26082, -1000, 15265, -1000,
+	26082, -1000, 1007, 167, 8, 321, 26082, -1000, 26082, -1000,
+	-1000, 5351, 4497, 5351, 26082, 26082, 26082, 26082, 26082, 26082,
+	1481, -1000, 1024, 21972, -1000, 219, 22794, -1000, 1321, -1000,
+	132, 1385, -1000, 1059, 268, 26082, 1072, -1000, -1000, -1000,
+	-1000, -1000, -1000, 229, 12272, 13138, 1587, 14851, 14851, 1478,
+	996, 14851, -1000, -1000, -1000, -1000, 44, 26082, 41, 26082,
+	26082, -1000, -1000, -85, 23616, -1000, 18651, -82, 229, -1000,
+	-1000, 140, 93, 1498, -1000, 502, 12272, 12272, 12272, 502,
+	36248, -182, -1000, 36682, 502, 502, -1000, -1000, 4044, -1000,
+	35943, -1000, -1000, -1000, -1000, 36517, 676, -1000, 3887, -1000,
+	-1000, -1000, 13571, 1079, 38, -1000, 36682, 3840, -1000, -1000,
+	-1000, -1000, 1228, 15265, 1290, -1000, -1000, 35, -1000, 26082,
+	-1000, 26082, 870, -1000, -1000, 980, 33, 13571, 26082, -1000,
+	-1000, -1000, -1000, -183, -187, -1000, 225, 185, 1329, 12272,
+	-188, 864, -1000, 863, 619, -1000, 26082, 12272, -1000, -1000,
+	36682, 869, 869, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -86, 34302, 1458, 1501, -1000, -1000, -1000, 169, -1000,
+	-1000, -1000, -1000, 363, 361, -1000, 219, 32, -1000, 1126,
+	746, -194, -1000, -1000, -1000, 224, 844, 21972, 26082, -146,
+	-1000, 1264, 30, 762, -1000, -1000, 26082, 36517, -220, -1000,
+	36517, -1000, 5775, -1000, 1478, -1000, 29, 12272, 14851, -1000,
+	26082, -198, 26082, -199, -1000, 36682, -205, 36682, -1000, -211,
+	-1000, 1384, -61, -1000, -1000, -1000, 59, 502, 502, 502,
+	-1000, -1000, -1000, -63, 720, 1360, -1000, 3295, 13571, 36682,
+	-212, -1000, 436, -213, -1000, 26082, -1000, -1000, 6642, 1220,
+	-1000, -1000, -1000, 12272, 35267, 20, -1000, 234, 15265, 18221,
+	23205, 26082, 23205, 3735, 615, -1000, -1000, -1000, -1000, 36517,
+	1330, -1000, -1000, -81, -86, 4497, 26082, 26082, 746, 26082,
+	1440, 1440, -1000, -1000, 22794, 726, -1000, 589, 577, -64,
+	27, 223, -1000, 15265, 26082, -227, -1000, 1382, 13138, -1000,
+	-1000, 26082, 36517, -1000, -223, -1000, -233, -1000, 26082, 1330,
+	-1000, 161, 18651, -1000, 26, -1000, -1000, -1000, 623, 12272,
+	3295, -238, -1000, 540, -1000, 15265, 233, -1000, -1000, 3708,
+	936, -1000, 25, -1000, -1000, -1000, -88, 1324, -1000, 26082,
+	-1000, 1324, 1329, 26082, 282, 26082, 316, -84, -1000, -1000,
+	-1000, -1000, -242, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1024, 219, -1000, -243, 268,
+	26082, 22, -1000, -248, -1000, -1000, -1000, -1000, -1000, -1000,
+	26082, -67, 12705, 12705, -237, -1000, 19905, -1000, -1000, 915,
+	741, 744, 12272, 18221, 182, -1000, 182, 23205, 20, -1000,
+	12272, -1000, -1000, 304, -1000, 1135, 219, 746, 217, 19,
+	-1000, -1000, -1000, -256, -1000, -1000, -1000, 14004, 800, 584,
+	35741, -1000, -234, -1000, 1026, -1000, 748, 747, 583, -1000,
+	-1000, -1000, 3599, -1000, -1000, -1000, 1324, 936, 36517, -1000,
+	746, -1000, 233, 8, 15265, -1000, 1421, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1241, -1000, 283, 847, 847,
+	-1000, 182, 741, -1000, -1000, -1000, -258, 11839, -1000, 1433,
+	-1000, -1000, 1010, -1000, -1000, -1000, 217, -1000, -1000, -1000,
+	-1000, 233, -1000,
 }
 var sqlPgo = [...]int{
 
-	0, 1836, 1835, 1438, 1834, 1821, 1817, 1805, 1804, 1803,
-	1802, 1801, 1410, 146, 1800, 1798, 158, 143, 1796, 1788,
-	65, 1785, 1782, 1780, 1776, 139, 68, 2441, 169, 164,
-	1773, 1771, 1770, 11, 9, 130, 42, 124, 74, 82,
-	1769, 128, 152, 101, 41, 1768, 23, 0, 1761, 1760,
-	56, 21, 27, 138, 1759, 30, 1756, 1754, 70, 1753,
-	26, 43, 1752, 17, 4, 18, 1751, 1750, 16, 89,
-	38, 1749, 10, 137, 1748, 95, 131, 1746, 51, 285,
-	154, 20, 207, 50, 108, 1745, 22, 106, 1744, 63,
-	112, 1739, 80, 1738, 110, 170, 148, 679, 28, 86,
-	1736, 1732, 37, 1729, 93, 1727, 1726, 1392, 13, 1723,
-	161, 159, 145, 1720, 1719, 606, 104, 54, 99, 1718,
-	1717, 1715, 1712, 109, 117, 127, 1710, 88, 85, 1703,
-	1702, 100, 1688, 1687, 151, 157, 1684, 1682, 123, 1679,
-	1676, 1662, 1661, 1644, 150, 1643, 1641, 84, 125, 105,
-	45, 44, 1637, 83, 62, 3, 78, 405, 1102, 1083,
-	1636, 113, 87, 122, 94, 1635, 33, 1632, 1630, 36,
-	1628, 46, 35, 1625, 141, 1624, 1622, 1616, 1615, 1613,
-	111, 1603, 1601, 107, 64, 81, 97, 96, 136, 29,
-	91, 118, 149, 163, 142, 1598, 1592, 1590, 156, 1588,
-	176, 55, 1586, 1384, 129, 1584, 1583, 1582, 1347, 1296,
-	1204, 844, 1581, 1580, 1053, 845, 1579, 1577, 92, 120,
-	114, 1574, 77, 126, 162, 166, 90, 67, 155, 1573,
-	1572, 121, 1570, 1735, 243, 103, 69, 47, 1569, 1568,
-	53, 25, 1566, 61, 2, 12, 15, 8, 6, 102,
-	34, 7, 1565, 5, 153, 133, 1564, 583, 1563, 1562,
-	79, 1561, 1559, 24, 1558, 19, 1556, 14, 1, 1555,
-	1551, 1470, 98, 1550, 116,
+	0, 1806, 1804, 1440, 1803, 1802, 1800, 1793, 1792, 1791,
+	1790, 1783, 1774, 1773, 1410, 1772, 133, 1766, 1763, 159,
+	1760, 1758, 126, 1754, 1753, 1752, 144, 1750, 1749, 1746,
+	1745, 123, 52, 2504, 168, 163, 1743, 1742, 1741, 10,
+	4, 125, 137, 31, 74, 65, 1740, 121, 149, 87,
+	36, 1738, 22, 0, 1737, 1735, 150, 18, 23, 1974,
+	1734, 28, 1733, 1732, 45, 1731, 24, 34, 1730, 6,
+	1, 26, 1728, 1727, 14, 104, 77, 1725, 9, 37,
+	1724, 115, 1723, 1495, 1722, 167, 169, 106, 132, 1720,
+	75, 246, 156, 16, 76, 48, 102, 1719, 15, 92,
+	1713, 83, 143, 1710, 51, 1708, 107, 177, 152, 603,
+	25, 80, 1707, 1705, 32, 1702, 81, 1697, 1695, 1389,
+	11, 1694, 166, 158, 148, 1693, 1691, 176, 79, 61,
+	85, 1690, 1689, 1688, 1687, 135, 113, 112, 1685, 103,
+	84, 1684, 1683, 90, 1680, 1678, 164, 171, 1677, 1676,
+	108, 1674, 1673, 1665, 1664, 1663, 142, 1662, 1661, 64,
+	120, 93, 47, 38, 1660, 67, 60, 3, 131, 1699,
+	2109, 2229, 1655, 140, 73, 114, 105, 1654, 29, 1653,
+	1652, 33, 1651, 55, 44, 1650, 153, 1649, 1648, 1647,
+	1646, 1641, 138, 1640, 1639, 89, 40, 66, 78, 82,
+	111, 27, 170, 110, 162, 173, 141, 1638, 1634, 1631,
+	157, 1628, 53, 57, 1627, 1476, 147, 1624, 1622, 1618,
+	1422, 1394, 1292, 684, 1617, 1615, 1144, 690, 1612, 1610,
+	99, 146, 98, 1607, 63, 130, 165, 161, 119, 43,
+	155, 1606, 1604, 116, 1602, 1011, 136, 122, 46, 35,
+	1600, 1598, 39, 21, 1596, 56, 13, 5, 12, 8,
+	7, 86, 129, 91, 1595, 30, 154, 117, 1587, 174,
+	1586, 1584, 54, 1583, 1580, 20, 1578, 17, 1576, 19,
+	2, 1574, 1571, 1568, 1544, 88, 1567, 139,
 }
 var sqlR1 = [...]int{
 
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 4, 4,
-	4, 5, 5, 5, 6, 6, 7, 7, 37, 37,
-	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
-	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
-	35, 35, 35, 35, 35, 31, 31, 38, 38, 38,
-	36, 36, 32, 32, 63, 64, 64, 93, 93, 153,
-	153, 153, 153, 8, 8, 8, 8, 13, 14, 14,
-	14, 14, 14, 14, 133, 80, 80, 79, 79, 83,
-	83, 15, 15, 15, 16, 16, 16, 16, 16, 199,
-	199, 198, 196, 197, 197, 197, 21, 21, 21, 144,
-	144, 144, 143, 143, 143, 143, 145, 145, 145, 145,
-	145, 225, 225, 224, 224, 231, 231, 56, 56, 56,
-	56, 223, 223, 223, 223, 232, 232, 232, 232, 232,
-	232, 232, 57, 57, 57, 229, 229, 146, 22, 22,
-	22, 22, 22, 22, 11, 11, 11, 11, 107, 107,
-	107, 107, 107, 107, 107, 107, 58, 58, 61, 61,
-	59, 59, 62, 62, 147, 147, 147, 150, 150, 148,
-	152, 241, 241, 242, 242, 242, 243, 243, 243, 243,
-	243, 243, 243, 238, 239, 239, 239, 240, 240, 240,
-	240, 240, 240, 237, 237, 149, 149, 149, 149, 149,
-	149, 149, 149, 221, 221, 68, 68, 246, 246, 246,
-	246, 170, 170, 171, 165, 165, 244, 244, 244, 244,
-	244, 247, 245, 248, 248, 248, 248, 248, 60, 60,
-	65, 65, 108, 108, 108, 108, 249, 12, 12, 104,
-	44, 44, 44, 184, 184, 184, 154, 154, 154, 154,
-	154, 24, 106, 106, 106, 10, 10, 129, 129, 130,
-	130, 46, 46, 72, 72, 189, 189, 189, 52, 52,
-	51, 51, 51, 33, 33, 33, 34, 34, 34, 105,
-	105, 105, 81, 81, 19, 19, 19, 19, 19, 19,
-	19, 19, 19, 131, 131, 132, 132, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 271, 271, 271, 43, 43, 43, 43, 43, 41,
-	41, 41, 97, 97, 9, 9, 272, 272, 272, 39,
-	39, 40, 40, 42, 42, 42, 230, 230, 230, 230,
-	230, 230, 273, 273, 17, 54, 54, 140, 140, 140,
-	142, 142, 142, 141, 141, 141, 99, 99, 25, 86,
-	86, 87, 87, 195, 88, 88, 20, 20, 27, 27,
-	26, 26, 26, 26, 26, 26, 26, 26, 28, 28,
-	29, 29, 29, 29, 29, 29, 29, 255, 255, 255,
-	257, 257, 254, 18, 18, 18, 18, 256, 256, 270,
-	270, 115, 115, 115, 66, 66, 67, 67, 70, 70,
-	69, 71, 71, 188, 188, 95, 95, 95, 95, 96,
-	96, 134, 134, 135, 135, 136, 136, 137, 137, 137,
-	138, 138, 139, 139, 94, 94, 101, 101, 102, 102,
-	103, 162, 162, 111, 111, 112, 112, 113, 113, 110,
-	45, 45, 45, 109, 109, 109, 109, 114, 114, 30,
-	30, 76, 76, 77, 77, 190, 190, 190, 190, 190,
-	190, 190, 191, 191, 191, 191, 191, 191, 185, 185,
-	185, 185, 186, 186, 187, 187, 187, 187, 187, 118,
-	118, 118, 118, 116, 116, 117, 117, 192, 192, 192,
-	192, 100, 100, 193, 193, 193, 163, 163, 166, 167,
-	167, 168, 168, 169, 169, 155, 155, 98, 98, 151,
-	200, 200, 200, 200, 200, 200, 75, 75, 75, 201,
-	201, 201, 201, 201, 201, 201, 201, 202, 202, 202,
-	202, 204, 204, 204, 203, 203, 203, 203, 203, 203,
-	203, 203, 203, 203, 203, 205, 205, 212, 212, 213,
-	213, 214, 215, 206, 206, 207, 207, 208, 209, 216,
-	216, 216, 219, 219, 218, 218, 210, 210, 210, 210,
-	210, 211, 220, 220, 220, 124, 124, 124, 124, 124,
-	124, 124, 124, 124, 124, 124, 124, 124, 124, 125,
-	125, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
-	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
-	158, 158, 158, 158, 158, 158, 158, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 250, 250, 250, 250, 250, 250, 250, 252, 252,
-	253, 253, 251, 251, 251, 251, 251, 251, 251, 251,
-	251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
-	251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
-	251, 251, 258, 258, 259, 259, 260, 260, 261, 261,
-	263, 264, 264, 264, 265, 269, 269, 262, 262, 266,
-	266, 266, 267, 267, 268, 268, 268, 268, 268, 174,
-	174, 174, 175, 175, 176, 182, 182, 182, 55, 55,
-	55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
-	55, 55, 55, 55, 49, 49, 49, 82, 82, 172,
-	172, 164, 164, 164, 173, 173, 119, 119, 217, 217,
-	217, 217, 217, 217, 217, 217, 120, 120, 126, 121,
-	121, 122, 122, 122, 122, 122, 122, 127, 128, 123,
-	123, 123, 161, 161, 177, 181, 181, 180, 179, 179,
-	178, 178, 156, 156, 156, 156, 89, 89, 90, 90,
-	274, 274, 183, 183, 91, 91, 92, 85, 85, 84,
-	84, 194, 194, 194, 194, 78, 78, 53, 53, 73,
-	73, 74, 74, 50, 50, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 222, 222,
-	222, 47, 47, 47, 48, 48, 227, 227, 227, 228,
-	228, 228, 228, 226, 226, 226, 226, 226, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 8, 21, 4, 4, 4, 5, 5, 5,
+	6, 6, 7, 7, 42, 42, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 37, 37, 44, 44, 44, 43, 43, 38, 38,
+	69, 70, 70, 105, 105, 165, 165, 165, 165, 9,
+	9, 9, 9, 9, 9, 16, 17, 17, 17, 17,
+	17, 17, 17, 17, 145, 92, 92, 91, 91, 95,
+	95, 18, 18, 18, 19, 19, 19, 19, 19, 211,
+	211, 210, 208, 209, 209, 209, 20, 24, 86, 86,
+	86, 83, 83, 282, 282, 84, 84, 85, 85, 85,
+	85, 85, 85, 85, 81, 27, 27, 27, 156, 156,
+	156, 155, 155, 155, 155, 157, 157, 157, 157, 157,
+	237, 237, 236, 236, 243, 243, 62, 62, 62, 62,
+	235, 235, 235, 235, 244, 244, 244, 244, 244, 244,
+	244, 63, 63, 63, 241, 241, 158, 28, 28, 28,
+	28, 28, 28, 28, 28, 82, 82, 12, 12, 13,
+	13, 13, 13, 119, 119, 119, 119, 119, 119, 119,
+	119, 64, 64, 67, 67, 65, 65, 68, 68, 159,
+	159, 159, 162, 162, 160, 164, 253, 253, 254, 254,
+	254, 255, 255, 255, 255, 255, 255, 255, 250, 251,
+	251, 251, 252, 252, 252, 252, 252, 252, 249, 249,
+	161, 161, 161, 161, 161, 161, 161, 161, 233, 233,
+	74, 74, 256, 256, 256, 256, 182, 182, 183, 177,
+	177, 257, 257, 257, 257, 257, 259, 258, 260, 260,
+	260, 260, 260, 66, 66, 71, 71, 120, 120, 120,
+	120, 261, 14, 14, 116, 50, 50, 50, 196, 196,
+	196, 166, 166, 166, 166, 166, 30, 118, 118, 118,
+	11, 11, 141, 141, 142, 142, 52, 52, 78, 78,
+	201, 201, 201, 58, 58, 57, 57, 57, 39, 39,
+	39, 40, 40, 40, 117, 117, 117, 93, 93, 25,
+	25, 25, 25, 25, 25, 25, 25, 25, 143, 143,
+	144, 144, 29, 29, 29, 29, 29, 29, 29, 29,
+	29, 29, 29, 29, 29, 29, 284, 284, 284, 49,
+	49, 49, 49, 49, 47, 47, 47, 109, 109, 15,
+	10, 10, 285, 285, 285, 45, 45, 46, 46, 48,
+	48, 48, 242, 242, 242, 242, 242, 242, 286, 286,
+	22, 60, 60, 152, 152, 152, 154, 154, 154, 153,
+	153, 153, 111, 111, 31, 98, 98, 99, 99, 207,
+	100, 100, 26, 26, 33, 33, 32, 32, 32, 32,
+	32, 32, 32, 32, 34, 34, 35, 35, 35, 35,
+	35, 35, 35, 267, 267, 267, 269, 269, 266, 23,
+	23, 23, 23, 268, 268, 283, 283, 127, 127, 127,
+	72, 72, 73, 73, 76, 76, 75, 77, 77, 200,
+	200, 107, 107, 107, 107, 108, 108, 146, 146, 147,
+	147, 148, 148, 149, 149, 149, 150, 150, 151, 151,
+	106, 106, 113, 113, 114, 114, 115, 174, 174, 123,
+	123, 124, 124, 125, 125, 122, 51, 51, 51, 121,
+	121, 121, 121, 126, 126, 36, 36, 88, 88, 89,
+	89, 202, 202, 202, 202, 202, 202, 202, 203, 203,
+	203, 203, 203, 203, 197, 197, 197, 197, 198, 198,
+	199, 199, 199, 199, 199, 130, 130, 130, 130, 128,
+	128, 129, 129, 204, 204, 204, 204, 112, 112, 205,
+	205, 205, 175, 175, 178, 179, 179, 180, 180, 181,
+	181, 167, 167, 110, 110, 163, 212, 212, 212, 212,
+	212, 212, 87, 87, 87, 213, 213, 213, 213, 213,
+	213, 213, 213, 213, 214, 214, 214, 214, 216, 216,
+	216, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 217, 217, 224, 224, 225, 225, 226, 227,
+	218, 218, 219, 219, 220, 221, 228, 228, 228, 231,
+	231, 230, 230, 222, 222, 222, 222, 222, 223, 232,
+	232, 232, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 137, 137, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 171, 171, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 262, 262,
+	262, 262, 262, 262, 262, 264, 264, 265, 265, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 270,
+	270, 271, 271, 272, 272, 273, 273, 275, 276, 276,
+	276, 277, 281, 281, 274, 274, 278, 278, 278, 279,
+	279, 280, 280, 280, 280, 280, 186, 186, 186, 187,
+	187, 188, 194, 194, 194, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 55, 55, 55, 94, 94, 184, 184, 176, 176,
+	176, 185, 185, 131, 131, 229, 229, 229, 229, 229,
+	229, 229, 229, 132, 132, 138, 133, 133, 134, 134,
+	134, 134, 134, 134, 139, 140, 135, 135, 135, 173,
+	173, 189, 193, 193, 192, 191, 191, 190, 190, 168,
+	168, 168, 168, 101, 101, 102, 102, 287, 287, 195,
+	195, 103, 103, 104, 97, 97, 96, 96, 206, 206,
+	206, 206, 90, 90, 59, 59, 79, 79, 80, 80,
+	56, 56, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 234, 234, 234, 53, 53,
+	53, 54, 54, 239, 239, 239, 240, 240, 240, 240,
+	238, 238, 238, 238, 238, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248,
 }
 var sqlR2 = [...]int{
 
 	0, 1, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 5, 4, 4, 4, 6, 4, 6, 1, 3,
-	2, 3, 4, 6, 6, 6, 5, 5, 6, 6,
-	4, 8, 2, 3, 3, 6, 4, 2, 2, 2,
-	3, 2, 2, 2, 2, 3, 2, 1, 1, 0,
-	2, 0, 2, 0, 3, 2, 0, 1, 3, 3,
-	1, 5, 3, 1, 1, 1, 1, 6, 6, 4,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 3, 5, 1, 1, 1, 5, 4, 4,
+	4, 6, 4, 6, 1, 3, 2, 3, 4, 6,
+	6, 6, 5, 5, 6, 6, 4, 8, 2, 3,
+	3, 6, 4, 2, 2, 2, 3, 2, 2, 2,
+	2, 3, 2, 1, 1, 0, 2, 0, 2, 0,
+	3, 2, 0, 1, 3, 3, 1, 5, 3, 1,
+	1, 1, 1, 1, 1, 6, 6, 4, 3, 5,
 	3, 5, 3, 5, 1, 1, 3, 1, 2, 2,
 	3, 2, 3, 5, 1, 1, 1, 1, 1, 1,
-	3, 2, 1, 1, 1, 0, 2, 3, 3, 2,
-	5, 1, 3, 3, 3, 3, 1, 3, 3, 2,
-	2, 1, 3, 1, 3, 1, 1, 2, 2, 2,
-	1, 1, 1, 1, 1, 1, 1, 3, 5, 1,
-	1, 1, 1, 1, 0, 1, 1, 2, 2, 3,
-	2, 4, 4, 4, 10, 13, 9, 12, 1, 1,
-	2, 2, 2, 2, 1, 0, 1, 0, 3, 0,
-	1, 3, 1, 3, 1, 1, 1, 1, 1, 3,
-	4, 2, 0, 3, 1, 2, 2, 1, 1, 2,
-	5, 2, 5, 3, 3, 3, 0, 1, 1, 1,
-	1, 1, 1, 3, 1, 4, 4, 2, 4, 5,
-	3, 6, 10, 2, 0, 3, 0, 2, 2, 2,
-	0, 1, 3, 3, 4, 0, 1, 1, 2, 2,
-	0, 3, 3, 2, 1, 1, 2, 2, 4, 0,
-	2, 0, 3, 4, 4, 0, 3, 7, 10, 4,
-	2, 3, 0, 1, 2, 1, 1, 1, 1, 1,
-	1, 5, 2, 2, 0, 13, 16, 1, 0, 1,
-	0, 2, 0, 1, 3, 5, 5, 7, 2, 0,
-	1, 2, 0, 1, 1, 0, 2, 2, 0, 1,
-	4, 5, 1, 3, 6, 6, 8, 6, 8, 8,
-	10, 8, 10, 1, 0, 2, 0, 2, 3, 3,
-	2, 2, 2, 2, 3, 2, 5, 4, 3, 3,
-	3, 1, 1, 0, 3, 2, 2, 1, 2, 1,
-	3, 2, 1, 0, 5, 8, 1, 1, 0, 1,
-	0, 1, 2, 3, 3, 3, 1, 2, 1, 1,
-	1, 1, 1, 0, 7, 1, 3, 1, 4, 2,
-	8, 5, 0, 4, 3, 0, 2, 0, 8, 1,
-	3, 1, 1, 3, 5, 5, 1, 1, 3, 3,
-	1, 2, 4, 4, 2, 3, 5, 5, 1, 1,
-	8, 8, 1, 2, 4, 4, 4, 2, 2, 3,
-	1, 3, 6, 1, 1, 1, 1, 1, 0, 1,
-	0, 1, 1, 0, 1, 5, 1, 0, 1, 0,
-	3, 1, 3, 4, 3, 2, 2, 1, 1, 1,
-	0, 2, 5, 2, 3, 1, 1, 1, 3, 0,
-	1, 1, 1, 1, 3, 0, 1, 3, 1, 1,
-	2, 2, 0, 1, 3, 1, 0, 1, 2, 3,
-	1, 2, 0, 2, 4, 2, 3, 2, 0, 2,
-	3, 2, 0, 1, 3, 2, 2, 3, 2, 3,
-	1, 4, 3, 4, 5, 4, 5, 4, 5, 2,
-	4, 1, 1, 0, 1, 4, 5, 4, 0, 2,
-	2, 2, 1, 1, 0, 4, 2, 1, 2, 2,
-	4, 1, 3, 1, 2, 3, 2, 6, 2, 1,
-	3, 4, 0, 2, 0, 2, 0, 1, 3, 3,
-	2, 3, 5, 6, 2, 3, 3, 4, 0, 1,
-	1, 1, 1, 2, 4, 1, 1, 1, 1, 1,
-	1, 3, 5, 0, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 3, 0, 1, 1, 1,
-	1, 5, 2, 1, 1, 1, 1, 5, 2, 2,
-	2, 1, 1, 0, 3, 0, 1, 5, 2, 5,
-	2, 1, 3, 3, 0, 1, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 0, 1,
-	4, 1, 3, 3, 5, 2, 2, 2, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 3, 5,
-	4, 6, 4, 6, 5, 7, 3, 4, 3, 3,
-	4, 3, 4, 3, 4, 5, 6, 6, 7, 6,
-	7, 6, 7, 3, 4, 4, 6, 2, 3, 4,
-	1, 3, 2, 2, 2, 3, 3, 3, 3, 3,
+	3, 2, 1, 1, 1, 0, 6, 6, 1, 2,
+	2, 2, 1, 1, 0, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 3, 3, 2, 5,
+	1, 3, 3, 3, 3, 1, 3, 3, 2, 2,
+	1, 3, 1, 3, 1, 1, 2, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 3, 5, 1, 1,
+	1, 1, 1, 0, 1, 1, 2, 2, 3, 2,
+	4, 4, 4, 5, 4, 2, 0, 4, 7, 10,
+	13, 9, 12, 1, 1, 2, 2, 2, 2, 1,
+	0, 1, 0, 3, 0, 1, 3, 1, 3, 1,
+	1, 1, 1, 1, 3, 4, 2, 0, 3, 1,
+	2, 2, 1, 1, 2, 5, 2, 5, 3, 3,
+	3, 0, 1, 1, 1, 1, 1, 1, 3, 1,
+	4, 4, 2, 4, 5, 3, 6, 10, 2, 0,
+	3, 0, 2, 2, 2, 0, 1, 3, 3, 4,
+	0, 1, 1, 2, 2, 0, 3, 3, 2, 1,
+	1, 2, 2, 4, 0, 2, 0, 3, 4, 4,
+	0, 3, 7, 10, 4, 2, 3, 0, 1, 2,
+	1, 1, 1, 1, 1, 1, 5, 2, 2, 0,
+	13, 16, 1, 0, 1, 0, 2, 0, 1, 3,
+	5, 5, 7, 2, 0, 1, 2, 0, 1, 1,
+	0, 2, 2, 0, 1, 4, 5, 1, 3, 6,
+	6, 8, 6, 8, 8, 10, 8, 10, 1, 0,
+	2, 0, 2, 3, 3, 2, 2, 2, 2, 3,
+	2, 5, 4, 3, 3, 3, 1, 1, 0, 3,
+	2, 2, 1, 2, 1, 3, 2, 1, 0, 6,
+	5, 8, 1, 1, 0, 1, 0, 1, 2, 3,
+	3, 3, 1, 2, 1, 1, 1, 1, 1, 0,
+	7, 1, 3, 1, 4, 2, 8, 5, 0, 4,
+	3, 0, 2, 0, 8, 1, 3, 1, 1, 3,
+	5, 5, 1, 1, 3, 3, 1, 2, 4, 4,
+	2, 3, 5, 5, 1, 1, 8, 8, 1, 2,
+	4, 4, 4, 2, 2, 3, 1, 3, 6, 1,
+	1, 1, 1, 1, 0, 1, 0, 1, 1, 0,
+	1, 5, 1, 0, 1, 0, 3, 1, 3, 4,
+	3, 2, 2, 1, 1, 1, 0, 2, 5, 2,
+	3, 1, 1, 1, 3, 0, 1, 1, 1, 1,
+	3, 0, 1, 3, 1, 1, 2, 2, 0, 1,
+	3, 1, 0, 1, 2, 3, 1, 2, 0, 2,
+	4, 2, 3, 2, 0, 2, 3, 2, 0, 1,
+	3, 2, 2, 3, 2, 3, 1, 4, 3, 4,
+	5, 4, 5, 4, 5, 2, 4, 1, 1, 0,
+	1, 4, 5, 4, 0, 2, 2, 2, 1, 1,
+	0, 4, 2, 1, 2, 2, 4, 1, 3, 1,
+	2, 3, 2, 6, 2, 1, 3, 4, 0, 2,
+	0, 2, 0, 1, 3, 3, 2, 3, 5, 6,
+	2, 3, 3, 4, 0, 1, 1, 1, 1, 1,
+	2, 4, 1, 1, 1, 1, 1, 1, 3, 5,
+	0, 1, 1, 1, 1, 1, 2, 2, 2, 2,
+	2, 1, 3, 0, 1, 1, 1, 1, 5, 2,
+	1, 1, 1, 1, 5, 2, 2, 2, 1, 1,
+	0, 3, 0, 1, 5, 2, 5, 2, 1, 3,
+	3, 0, 1, 1, 1, 1, 1, 1, 3, 3,
+	3, 3, 3, 3, 3, 0, 1, 4, 1, 3,
+	3, 5, 2, 2, 2, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 5, 6, 6, 7, 3, 4, 1, 1, 2,
-	4, 1, 1, 1, 2, 2, 2, 2, 1, 1,
-	4, 3, 5, 6, 8, 6, 6, 4, 4, 1,
-	1, 1, 5, 1, 1, 4, 1, 4, 1, 4,
-	1, 4, 1, 1, 1, 1, 1, 1, 6, 4,
-	4, 4, 4, 6, 5, 5, 5, 4, 6, 4,
-	4, 4, 5, 0, 5, 0, 2, 0, 1, 3,
-	3, 2, 2, 0, 6, 1, 0, 3, 0, 2,
-	2, 0, 1, 4, 2, 2, 2, 2, 2, 4,
-	3, 5, 4, 3, 5, 1, 1, 1, 1, 1,
+	3, 3, 3, 2, 2, 3, 5, 4, 6, 4,
+	6, 5, 7, 3, 4, 3, 3, 4, 3, 4,
+	3, 4, 5, 6, 6, 7, 6, 7, 6, 7,
+	3, 4, 4, 6, 2, 3, 4, 1, 3, 2,
+	2, 2, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 5, 6,
+	6, 7, 3, 4, 1, 1, 2, 4, 1, 1,
+	1, 2, 2, 2, 2, 1, 1, 4, 3, 5,
+	6, 8, 6, 6, 4, 4, 1, 1, 1, 5,
+	1, 1, 4, 1, 4, 1, 4, 1, 4, 1,
+	1, 1, 1, 1, 1, 6, 4, 4, 4, 4,
+	6, 5, 5, 5, 4, 6, 4, 4, 4, 5,
+	0, 5, 0, 2, 0, 1, 3, 3, 2, 2,
+	0, 6, 1, 0, 3, 0, 2, 2, 0, 1,
+	4, 2, 2, 2, 2, 2, 4, 3, 5, 4,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 1, 3, 1,
-	3, 3, 3, 2, 1, 3, 3, 0, 1, 1,
-	1, 1, 1, 1, 1, 1, 4, 3, 2, 3,
-	0, 3, 3, 2, 2, 1, 0, 2, 2, 3,
-	2, 1, 1, 3, 5, 1, 2, 4, 2, 0,
-	1, 0, 2, 2, 3, 5, 1, 2, 0, 2,
-	1, 0, 1, 1, 1, 3, 3, 1, 0, 1,
-	3, 3, 2, 1, 1, 1, 3, 1, 2, 1,
-	3, 3, 0, 1, 2, 1, 1, 1, 1, 1,
-	2, 6, 2, 3, 5, 1, 1, 1, 1, 2,
-	2, 1, 1, 1, 1, 0, 1, 1, 1, 1,
+	1, 1, 1, 2, 1, 3, 1, 3, 3, 3,
+	2, 1, 3, 3, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 4, 3, 2, 3, 0, 3, 3,
+	2, 2, 1, 0, 2, 2, 3, 2, 1, 1,
+	3, 5, 1, 2, 4, 2, 0, 1, 0, 2,
+	2, 3, 5, 1, 2, 0, 2, 1, 0, 1,
+	1, 1, 3, 3, 1, 0, 1, 3, 3, 2,
+	1, 1, 1, 3, 1, 2, 1, 3, 3, 0,
+	1, 2, 1, 1, 1, 1, 1, 2, 6, 2,
+	3, 5, 1, 1, 1, 1, 2, 2, 1, 1,
+	1, 1, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5339,463 +5571,479 @@ var sqlR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1,
+	1, 1, 1, 1,
 }
 var sqlChk = [...]int{
 
-	-1000, -1, -2, -3, -4, -8, -13, -14, -15, -17,
-	-19, -20, -21, -22, -23, -24, -25, -5, -6, -7,
-	-9, -10, -11, -12, -256, 130, 146, 27, -26, -27,
-	336, 340, 17, 44, 350, 76, 136, 318, 323, 305,
-	285, 381, 90, -255, -29, -28, 444, 415, 426, 329,
-	-30, 362, 402, 447, 118, 196, 394, -133, 105, 362,
-	186, -16, 406, 444, -20, -17, -25, -13, -12, 90,
-	105, 186, 362, -144, 222, 334, 375, -145, -143, -225,
-	371, 59, 239, -47, 4, -233, -235, 17, 18, 19,
-	20, 21, 22, 23, 24, 26, 27, 28, 36, 37,
-	39, 40, 42, 43, 44, 52, 53, 54, 55, 56,
-	60, 63, 65, 66, 67, 68, 73, 74, 75, 76,
-	77, 80, 81, 82, 84, 85, 86, 87, 88, 89,
-	92, 93, 94, 102, 103, 104, 105, 106, 108, 109,
-	112, 114, 116, 117, 118, 119, 120, 122, 123, 124,
-	127, 128, 129, 130, 131, 133, 134, 135, 137, 138,
-	139, 141, 142, 143, 144, 146, 147, 148, 151, 153,
-	154, 156, 158, 160, 164, 165, 166, 168, 172, 174,
-	175, 176, 177, 178, 179, 180, 181, 182, 184, 185,
-	186, 187, 188, 189, 191, 194, 195, 196, 197, 203,
-	205, 207, 208, 209, 210, 211, 214, 217, 220, 221,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	235, 236, 237, 238, 243, 244, 247, 248, 249, 252,
-	254, 255, 256, 258, 261, 262, 265, 268, 271, 272,
-	273, 274, 275, 276, 277, 279, 280, 282, 285, 286,
-	284, 288, 289, 290, 291, 292, 293, 294, 295, 297,
-	298, 299, 300, 302, 303, 304, 305, 306, 307, 308,
-	309, 310, 311, 312, 314, 315, 317, 318, 319, 321,
-	322, 323, 324, 325, 326, 327, 328, 330, 331, 332,
-	333, 336, 337, 339, 340, 342, 343, 345, 347, 348,
-	349, 350, 351, 352, 353, 354, 355, 356, 357, 360,
-	361, 363, 365, 366, 367, 368, 369, 376, 378, 381,
-	382, 383, 384, 385, 386, 387, 390, 391, 392, 393,
-	394, 397, 398, 399, 400, 401, 405, 407, 408, 409,
-	410, 413, 416, 417, 418, 419, 420, 421, 422, 423,
-	45, 46, 48, 50, 61, 62, 69, 107, 110, 111,
-	145, 149, 155, 169, 171, 193, 198, 199, 201, 215,
-	240, 242, 245, 251, 253, 266, 270, 281, 283, 296,
-	320, 338, 344, 358, 372, 377, 379, 402, 403, -225,
-	371, 25, 363, 73, 186, 59, 222, 239, 334, 375,
-	-271, 418, 375, -271, 375, -271, 286, -271, -271, 286,
-	-47, 371, 323, -47, 375, -270, 362, -107, -129, 105,
-	368, 366, 222, 166, 392, 389, -28, -29, -27, -69,
-	-70, 388, 200, 140, 264, -26, -27, -255, -257, 299,
-	-254, -47, -257, -67, -66, 25, 125, 448, -192, -53,
-	260, -47, -92, 444, -3, 162, 202, -193, -192, 178,
-	-80, -79, -47, -47, 178, -80, 178, -16, -199, -198,
-	-196, -228, 402, 4, -233, -235, -234, 41, 47, 71,
-	79, 91, 98, 161, 163, 192, 204, 206, 216, 218,
-	241, 267, 269, 316, 341, 364, 406, -107, -47, -53,
-	178, -192, 178, -144, 334, -144, 63, -41, -43, 205,
-	295, 115, 246, 373, 429, 162, 446, 423, 6, -57,
-	6, 113, 423, 162, 162, 162, -97, -41, -97, 6,
-	373, 6, -47, 6, -100, -192, 362, 186, -47, 178,
-	368, 366, 368, 366, -69, -70, -111, -95, -113, 157,
-	-134, -135, -110, 219, 152, 257, -109, -115, 25, 125,
-	-115, -115, 52, 445, 445, 448, -257, -74, 444, -85,
-	-84, -194, -157, 433, -159, 431, 432, 441, 246, 424,
-	-174, 389, -53, -160, 10, 444, -177, -252, -27, 145,
-	33, -175, -176, 171, 320, -47, 9, 5, 6, 7,
-	8, -50, -202, -211, 380, 150, 250, 57, -250, -251,
-	4, -233, -227, -203, -213, -207, -210, 201, 71, 96,
-	99, 100, 223, 224, 97, 101, 335, 395, 95, 98,
-	58, 149, 270, 281, 358, 377, 379, 251, 69, 169,
-	215, 129, 46, 48, 50, 61, 62, 107, 110, 111,
-	155, 198, 199, 253, 296, 344, 371, 372, 403, -234,
-	-214, -215, -208, -209, -216, -84, 259, -92, 433, -53,
-	444, -89, -156, 446, 442, -91, -183, -157, 113, -193,
-	-54, -53, 336, -47, 34, 145, -38, 448, 55, 312,
-	-83, 446, 145, 145, 445, 448, -197, -223, -184, 380,
-	150, 259, -229, 5, 432, -222, -228, 6, 9, 431,
-	362, 415, -39, -146, 306, -40, 336, -42, -230, 4,
-	82, 134, 225, 272, 367, -37, 306, -35, 21, 27,
-	130, 399, 336, 188, 244, 255, 246, 310, 145, -37,
-	306, 145, 63, 34, 448, -43, 217, 260, 420, 115,
-	-224, 113, -231, -223, -184, -224, 113, 94, -47, -232,
-	6, 4, -211, -184, 113, 222, 201, -225, -225, -225,
-	323, -47, -106, 448, 86, 311, -79, 178, -104, -130,
-	79, -272, 415, 426, 246, -111, -95, -96, -95, -112,
-	-111, -110, 157, 295, 394, 244, 339, 207, -135, -134,
-	-136, -157, 25, -139, 154, 243, -157, -159, -114, 255,
-	-28, -28, -28, -71, -188, -157, -254, 34, -73, -47,
-	-76, 162, 448, 11, 70, 39, 431, 432, 433, 434,
-	435, 438, 436, 437, 439, 427, 428, 429, 78, 13,
-	14, 15, 31, 263, 218, 424, 341, 204, 45, 183,
-	-49, 34, 4, -55, -157, -157, -157, -157, -157, 269,
-	-27, -90, -27, -157, -82, -89, -27, -27, -164, 442,
-	444, 444, -89, 444, 6, 6, 6, 444, -178, -157,
-	-258, 416, 157, 444, 444, 444, 444, 444, 444, 444,
-	444, 444, 444, 444, 444, 444, 444, 444, 283, -219,
-	405, -219, -219, -204, 444, -204, -205, 444, -204, 444,
-	-220, 426, 417, 444, -220, 444, -218, 62, -76, 444,
-	-53, -156, -226, 433, 4, -233, -235, -234, -236, 25,
-	29, 30, 31, 32, 33, 34, 35, 38, 51, 57,
-	58, 64, 70, 72, 83, 90, 95, 96, 97, 99,
-	100, 101, 113, 115, 121, 125, 126, 132, 136, 140,
-	150, 152, 157, 159, 162, 167, 170, 173, 183, 190,
-	200, 202, 212, 213, 219, 223, 224, 246, 250, 257,
-	259, 260, 263, 264, 278, 287, 301, 313, 329, 335,
-	346, 359, 362, 370, 373, 374, 380, 388, 389, 395,
-	396, 404, 411, 412, 414, 415, -157, 448, 445, -155,
-	412, -140, -20, 444, 113, 34, -86, -87, -195, -88,
-	-53, 444, -47, -80, -79, 446, -226, -47, -80, -16,
-	-198, 5, 9, 9, 178, -79, -39, 373, -42, -144,
-	-273, 429, 219, 448, 373, -148, 72, -237, -47, 83,
-	-149, 64, 389, 186, 287, 141, 159, -131, 83, 72,
-	-131, 83, 83, 228, 392, -63, 444, -53, 188, -79,
-	255, -63, -53, 373, -131, 83, -192, 375, -43, -56,
-	295, 307, 332, 448, 6, 444, -47, -38, -192, 177,
-	177, 444, 255, -68, 246, 34, -48, 178, -47, -39,
-	145, -96, -112, 260, 207, 339, -137, -222, 444, 432,
-	-138, 320, 321, -45, 249, 343, -78, -53, 448, 396,
-	-33, 35, 121, 444, 448, 445, -155, -77, -190, -192,
-	-163, 212, -27, -191, 444, -253, 321, -250, -251, -50,
-	-194, -200, -201, 338, -203, -212, -206, -210, -211, 49,
-	369, 198, 199, 344, 46, 296, 155, 129, 111, 110,
-	253, 50, -214, -215, -208, -209, 107, 372, 371, 48,
-	62, 61, 403, -79, 371, -157, -157, -157, -157, -157,
-	-157, -157, -157, -157, -157, -157, -157, -157, -157, -157,
-	-157, -157, -157, -157, 218, 341, 45, 183, 373, 250,
-	246, 380, 150, 390, 125, 255, 127, -274, 359, 38,
-	-161, -27, 444, -182, 32, 346, 25, -226, -174, 320,
-	444, -156, 445, 448, -82, -173, 443, -157, -164, -82,
-	-82, 445, 445, -82, 404, 25, 125, 433, -124, 421,
-	236, 108, 176, 233, -125, 327, 9, -181, -180, 411,
-	-259, 153, 170, 444, 9, 9, 9, 9, -157, -119,
-	-217, 4, 421, 236, 108, 176, 233, 327, 6, -120,
-	-157, -121, -158, -159, 431, 432, 441, 444, 320, -122,
-	-157, -82, -157, 51, 213, 374, -123, -157, 162, -82,
-	-157, -82, -82, -82, 444, 9, 9, 9, 371, 371,
-	9, 9, 336, -155, -82, 445, 443, 449, -183, -99,
-	313, -157, -142, 259, -78, 402, -47, -76, 448, 429,
-	-78, -38, -226, 246, 444, -47, -222, -223, 113, -35,
-	-47, -148, -200, -47, 444, 444, -249, 396, 444, 207,
-	-46, 396, 207, -47, -47, 178, -47, 178, -47, -47,
-	-93, -153, -226, -53, -37, 306, -47, -47, -47, -37,
-	306, -41, 386, 77, 295, -231, -124, 9, -58, -73,
-	-59, -47, -147, -148, -238, -237, 218, -79, -65, 415,
-	145, -20, 259, 246, -47, 394, -138, -157, 227, 448,
-	-188, -55, 431, 432, 433, 434, 435, 436, 437, 438,
-	439, 427, 428, 429, 78, 13, 14, 15, -34, 425,
-	-18, -20, -17, -25, -13, -47, -94, 170, 448, 91,
-	-118, 206, 241, 163, 216, 316, 192, -186, -185, 34,
-	-47, -187, -185, 34, -47, -163, -27, -47, -186, -27,
-	-191, -190, -169, 426, 162, 444, -75, 33, -201, -124,
-	444, 423, 138, -157, 373, -274, 359, -161, -157, 250,
-	380, 150, 390, 125, 255, 127, 162, 444, -158, -158,
-	-82, -27, 444, 444, -82, -90, -157, 448, 443, 443,
-	448, 445, 445, -70, 448, -69, -157, -82, -82, 445,
-	373, 373, 373, 373, 444, 445, -179, -180, 132, -157,
-	-264, 268, 444, 444, -157, 445, 445, 445, 445, 34,
-	445, 162, 445, -126, 278, 445, 11, 431, 432, 433,
-	434, 435, 438, 436, 437, 439, 427, 428, 429, 78,
-	13, 14, 15, 204, 183, -158, -158, -158, -82, 444,
-	445, -127, -128, 162, 157, 34, -123, -123, -123, 445,
-	162, -82, 448, 445, 445, 445, 9, 445, 448, 445,
-	445, 423, 423, 445, 445, -47, -94, 445, -157, -84,
-	-99, 81, 445, -155, -87, -183, 445, 145, -241, -149,
-	186, 141, -157, -73, 186, -73, 444, -249, 444, -47,
-	444, -31, 130, 336, 310, -132, 145, -38, 145, -38,
-	445, 448, 429, 446, 373, 373, 373, 373, -131, 83,
-	445, 445, 445, 448, -53, -61, 444, -108, 259, -63,
-	-79, -104, -44, 415, -53, 145, -272, 260, 445, -53,
-	-34, 154, 211, 445, -162, 173, 52, -190, 206, 206,
-	-190, -118, 206, -116, 267, -116, -116, -47, 444, -47,
-	444, 444, -187, -186, -89, 445, 265, 444, -82, 442,
-	442, -75, 33, 9, -157, -157, 138, -157, -158, -158,
-	138, 162, 444, -157, -172, -200, 31, 31, 445, -157,
-	-82, 445, 448, 445, -157, -164, 445, 404, -70, -70,
-	-70, 236, 176, 233, -125, 233, -125, -125, 9, 6,
-	136, -157, 370, -265, -47, 444, 412, -69, 445, -200,
-	-157, -127, -157, -200, -158, -158, -158, -158, -158, -158,
-	-158, -158, -158, -158, -158, -158, -158, -158, -158, -158,
-	125, 246, 255, 127, -158, 448, -82, 445, -128, -127,
-	-157, -157, -200, 445, 445, 445, -82, -157, 445, 9,
-	-220, -220, -218, -162, 443, -141, 444, 259, -20, -99,
-	429, -242, 83, -243, 70, 246, 250, 389, 287, 64,
-	113, 301, 445, 445, -47, 445, -73, -170, -171, -189,
-	-47, -253, 444, -73, 246, 113, 246, 352, -63, 355,
-	113, 104, -63, 383, -47, -47, -153, -154, -105, -236,
-	-55, -184, 6, -200, -227, 338, 431, 432, 4, -233,
-	369, -226, -47, -47, -47, -47, -47, -47, 6, -60,
-	189, -147, -239, -65, -62, -150, -152, -237, -47, 76,
-	444, 255, 34, 104, 244, -46, -47, -39, -260, 414,
-	-157, -101, -102, -157, -103, 444, -190, -190, -117, 396,
-	259, 206, -190, 444, -73, 444, -98, -151, -47, -98,
-	-47, -185, -167, -166, -253, -70, 443, 9, 9, 442,
-	445, -157, 138, 31, 31, -157, -157, -172, 445, 448,
-	-157, -157, 445, 445, -157, 6, -157, 445, 445, 445,
-	445, -157, -269, -47, -157, 445, 445, -128, 162, 125,
-	255, 127, 444, -157, 445, 445, 445, 445, -260, 126,
-	-72, -189, 83, -92, -27, 444, -47, -79, 250, 207,
-	444, -158, -53, 445, 445, 448, 415, -52, 70, -52,
-	-157, 445, 250, 250, -222, -47, -157, -200, -38, -38,
-	-83, -227, 429, 373, 373, -65, 444, 184, 142, -108,
-	445, 448, 415, 130, 118, 284, -58, -79, -20, 104,
-	444, 259, -261, -263, -47, 448, 445, -117, 444, -157,
-	-190, -73, 445, -98, 445, 448, -200, 445, 445, 448,
-	-168, 34, 445, 443, 443, 9, -157, -157, -157, 445,
-	-200, 445, -70, -262, 275, 445, -158, 162, 444, -172,
-	445, 394, 247, 448, 445, -47, -243, -157, -68, -165,
-	412, -171, -81, -55, -47, -51, -79, 396, -79, -51,
-	445, 301, -36, 70, 435, -83, -154, -47, -47, -108,
-	-78, -240, 114, 84, 187, 355, 75, 25, -240, -150,
-	262, 321, 321, 445, -61, -44, -72, -53, 448, 34,
-	-102, -73, 445, 445, -151, -36, -169, -166, 444, 443,
-	445, -70, 52, -158, -172, 445, 336, -189, -155, 445,
-	-246, 230, 444, 446, -33, -79, -33, -52, -53, -32,
-	396, -79, 383, 435, 445, -241, -60, -65, 445, -46,
-	-263, -265, 445, -98, -266, 294, 321, -82, 445, -86,
-	-221, 244, -244, -247, -245, 259, 163, 274, 342, -157,
-	-81, -34, -34, -51, -68, -157, 383, -65, -108, -64,
-	415, 444, 445, 445, -267, -268, 45, 385, 94, -157,
-	-267, -155, 188, -245, 259, -247, 259, 394, 118, 445,
-	-33, -246, -108, -155, -63, -72, -268, 282, 156, 320,
-	282, 156, -248, 244, 312, 55, 336, -248, -34, -244,
-	445, 31, 20, 250, 113, -64, -268, -155,
+	-1000, -1, -2, -3, -4, -8, -9, -16, -17, -18,
+	-20, -21, -22, -25, -24, -26, -27, -28, -29, -30,
+	-31, -5, -6, -7, 55, -10, -11, -12, -13, -14,
+	-15, -268, 131, 147, 168, 184, 27, 318, -32, -33,
+	340, 344, 17, 44, 354, 77, 137, 321, 326, 308,
+	287, 385, 91, -267, -35, -34, 448, 419, 430, 332,
+	-36, 366, 406, 451, 295, 119, 198, 398, -145, 106,
+	366, 412, 188, -19, 410, 448, -26, -22, -31, -16,
+	-14, 91, -83, 25, -84, -85, 91, 131, 168, 332,
+	198, 119, 398, 204, 106, 188, 366, -83, -156, 224,
+	338, 379, -157, -155, -237, 375, 60, 241, -53, 4,
+	-245, -247, 17, 18, 19, 20, 21, 22, 23, 24,
+	26, 27, 28, 36, 37, 39, 40, 42, 43, 44,
+	52, 53, 54, 55, 56, 57, 61, 64, 66, 67,
+	68, 69, 74, 75, 76, 77, 78, 81, 82, 83,
+	85, 86, 87, 88, 89, 90, 93, 94, 95, 103,
+	104, 105, 106, 107, 109, 110, 113, 115, 117, 118,
+	119, 120, 121, 123, 124, 125, 128, 129, 130, 131,
+	132, 134, 135, 136, 138, 139, 140, 142, 143, 144,
+	145, 147, 148, 149, 152, 154, 155, 157, 159, 161,
+	165, 166, 167, 169, 170, 174, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 186, 187, 188, 189, 190,
+	191, 193, 196, 197, 198, 199, 205, 207, 209, 210,
+	211, 212, 213, 216, 219, 222, 223, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+	240, 245, 246, 249, 250, 251, 254, 256, 257, 258,
+	260, 263, 264, 267, 270, 273, 274, 275, 276, 277,
+	278, 279, 281, 282, 284, 287, 288, 286, 290, 291,
+	292, 293, 294, 295, 296, 297, 298, 300, 301, 302,
+	303, 305, 306, 307, 308, 309, 310, 311, 312, 313,
+	314, 315, 317, 318, 320, 321, 322, 324, 325, 326,
+	327, 328, 329, 330, 331, 333, 334, 336, 337, 340,
+	341, 343, 344, 346, 347, 349, 351, 352, 353, 354,
+	355, 356, 357, 358, 359, 360, 361, 364, 365, 367,
+	369, 370, 371, 372, 373, 380, 382, 385, 386, 387,
+	388, 389, 390, 391, 394, 395, 396, 397, 398, 401,
+	402, 403, 404, 405, 409, 411, 412, 413, 414, 417,
+	420, 421, 422, 423, 424, 425, 426, 427, 45, 46,
+	48, 50, 62, 63, 70, 108, 111, 112, 146, 150,
+	156, 171, 173, 195, 200, 201, 203, 217, 242, 244,
+	247, 253, 255, 268, 272, 283, 285, 299, 323, 335,
+	342, 348, 362, 376, 381, 383, 406, 407, -237, 375,
+	25, 367, 74, 188, 170, 60, 224, 241, 338, 379,
+	-284, 422, 379, -284, 379, -284, 288, -284, -284, 288,
+	-53, 375, 326, -53, 379, -283, 366, -119, -141, 412,
+	106, 372, 370, 224, 167, 396, 393, -34, -35, -33,
+	-75, -76, 392, 202, 141, 266, -32, -33, -267, -269,
+	302, -266, -53, -269, -73, -72, 25, 126, 452, -204,
+	-59, 262, -53, -104, 448, -3, -169, -171, 435, 436,
+	445, 248, 428, -186, 393, -59, -172, 10, 448, -189,
+	-264, -33, 146, 33, -187, -188, 173, 323, -53, 9,
+	5, 6, 7, 8, -56, -214, -223, 384, 151, 252,
+	58, -262, -263, 4, -245, -239, -215, -225, -219, -222,
+	203, 72, 97, 100, 101, 225, 226, 98, 102, 339,
+	399, 96, 99, 59, 150, 272, 283, 362, 381, 383,
+	253, 70, 171, 217, 130, 46, 48, 50, 62, 63,
+	108, 111, 112, 156, 200, 201, 255, 299, 348, 375,
+	376, 407, -246, -226, -227, -220, -221, 41, 47, 80,
+	92, 162, 164, 194, 206, 208, 218, 220, 243, 269,
+	271, 319, 345, 368, 410, -228, 163, 204, -205, -204,
+	180, -92, -91, -53, -53, 180, -92, 180, -92, 180,
+	-19, -211, -210, -208, -240, 406, 4, -245, -247, -246,
+	72, 99, -119, 261, -282, 291, 452, -59, -53, -59,
+	180, -204, 180, 261, -156, 338, -156, 64, -47, -49,
+	207, 298, 116, 248, 377, 433, 163, 450, 427, 6,
+	-63, 6, 114, 427, 163, 163, 163, 261, 158, -109,
+	-47, -109, 6, 377, 6, -53, 6, -112, -204, 333,
+	366, 188, -59, -53, 180, 372, 370, 372, 370, -75,
+	-76, -123, -107, -125, 158, -146, -147, -122, 221, 153,
+	259, -121, -127, 25, 126, -127, -127, 52, 449, 449,
+	452, -269, -80, 448, -97, -96, -206, -169, 437, -96,
+	261, -104, 437, -59, 448, -101, -168, 450, 446, -103,
+	-195, -169, 114, 11, 71, 39, 435, 436, 437, 438,
+	439, 442, 440, 441, 443, 431, 432, 433, 79, 13,
+	14, 15, 31, 265, 220, 428, 345, 206, 45, 185,
+	-55, -61, -169, -169, -169, -169, -169, 271, -33, -102,
+	-33, -169, -94, -101, -33, -33, -176, 446, 448, 448,
+	-101, 448, 6, 6, 6, 448, -190, -169, -270, 420,
+	158, 448, 448, 448, 448, 448, 448, 448, 448, 448,
+	448, 448, 448, 448, 448, 448, 285, -231, 409, -231,
+	-231, -216, 448, -216, -217, 448, -216, 448, -232, 430,
+	421, 448, -232, 448, -230, 63, -205, -60, -59, 340,
+	-53, 34, 146, -44, 452, 56, 315, -95, 450, 146,
+	146, 146, 449, 452, -209, -235, -196, 384, 151, 261,
+	-241, 5, 436, -234, -240, 6, 9, 435, 366, -86,
+	-90, 366, 106, -59, -85, 93, 419, -45, -158, 309,
+	-46, 340, -48, -242, 4, 83, 135, 227, 274, 371,
+	-42, 309, -41, 21, 27, 131, 403, 340, 190, 246,
+	257, 248, 313, 146, -42, 309, 146, -86, 64, 34,
+	452, -49, 219, 262, 424, 116, -236, 114, -243, -235,
+	-196, -236, 114, 95, -53, -244, 6, 4, -223, -196,
+	114, 224, 203, -237, -237, -237, -86, -81, -79, -53,
+	326, -53, -118, 452, 87, 314, -91, 180, -91, 180,
+	-116, -142, 80, -74, 448, -285, 419, 430, 248, -123,
+	-107, -108, -107, -124, -123, -122, 158, 298, 398, 246,
+	343, 209, -147, -146, -148, -169, 25, -151, 155, 245,
+	-169, -171, -126, 257, -34, -34, -34, -77, -200, -169,
+	-266, 34, -79, -88, 163, 452, 34, 4, -88, 448,
+	-59, -168, -238, 437, 4, -245, -247, -246, -248, 25,
+	29, 30, 31, 32, 33, 34, 35, 38, 51, 58,
+	59, 65, 71, 73, 84, 91, 96, 97, 98, 100,
+	101, 102, 114, 116, 122, 126, 127, 133, 137, 141,
+	151, 153, 158, 160, 163, 168, 172, 175, 185, 192,
+	202, 204, 214, 215, 221, 225, 226, 248, 252, 259,
+	261, 262, 265, 266, 280, 289, 304, 316, 332, 339,
+	350, 363, 366, 374, 377, 378, 384, 392, 393, 399,
+	400, 408, 415, 416, 418, 419, -169, 452, 449, -212,
+	-213, 342, -215, 335, -224, -218, -222, -223, 49, 373,
+	200, 201, 348, 46, 299, 156, 130, 112, 111, 255,
+	50, -226, -227, -220, -221, 108, 376, 375, 48, 63,
+	62, 407, -91, 375, -169, -169, -169, -169, -169, -169,
+	-169, -169, -169, -169, -169, -169, -169, -169, -169, -169,
+	-169, -169, -169, 220, 345, 45, 185, 377, 252, 248,
+	384, 151, 394, 126, 257, 128, -287, 363, 38, -173,
+	-33, 448, -194, 32, 350, 25, -186, 323, 448, -168,
+	449, 452, -94, -185, 447, -169, -176, -94, -94, 449,
+	449, -94, 408, 25, 126, 437, -136, 425, 238, 109,
+	178, 235, -137, 330, 9, -193, -192, 415, -271, 154,
+	172, 448, 9, 9, 9, 9, -169, -131, -229, 4,
+	425, 238, 109, 178, 235, 330, 6, -132, -169, -133,
+	-170, -171, 435, 436, 445, 448, 323, -134, -169, -94,
+	-169, 51, 215, 378, -135, -169, 163, -94, -169, -94,
+	-94, -94, 448, 9, 9, 9, 375, 375, 9, 9,
+	340, -167, 416, -152, -26, 448, 114, 34, -98, -99,
+	-207, -100, -59, 448, -53, -92, -91, 450, -238, -53,
+	-92, -92, -19, -210, 5, 9, 9, 180, -91, 377,
+	452, -90, -79, -169, -45, 377, -48, -156, -286, 433,
+	221, 452, 377, -160, 73, -249, -53, 84, -161, 65,
+	393, 188, 289, 142, 160, -143, 84, 73, -143, 84,
+	84, 230, 396, -69, 448, -59, 190, -91, 257, -69,
+	-59, 377, -143, 84, -204, 163, 379, -49, -62, 298,
+	310, 336, 452, 6, 448, -82, 158, 452, -53, -44,
+	-204, 179, 179, 248, 448, 257, -74, 248, 34, -54,
+	180, -53, 34, -79, -45, 146, -108, -124, 262, 209,
+	343, -149, -234, 448, 436, -150, 323, 324, -51, 251,
+	347, -90, 452, 400, -39, 35, 122, 448, 449, -167,
+	-89, -202, -204, -175, 214, -33, -203, 448, -265, 324,
+	-262, -263, -56, -206, -238, -167, -94, 449, 447, 453,
+	-195, -87, 33, -213, -136, 448, 427, 139, -169, 377,
+	-287, 363, -173, -169, 252, 384, 151, 394, 126, 257,
+	128, 163, 448, -170, -170, -94, -33, 448, 448, -94,
+	-102, -169, 452, 447, 447, 452, 449, 449, -76, 452,
+	-75, -169, -94, -94, 449, 377, 377, 377, 377, 448,
+	449, -191, -192, 133, -169, -276, 270, 448, 448, -169,
+	449, 449, 449, 449, 34, 449, 163, 449, -138, 280,
+	449, 11, 435, 436, 437, 438, 439, 442, 440, 441,
+	443, 431, 432, 433, 79, 13, 14, 15, 206, 185,
+	-170, -170, -170, -94, 448, 449, -139, -140, 163, 158,
+	34, -135, -135, -135, 449, 163, -94, 452, 449, 449,
+	449, 9, 449, 452, 449, 449, 427, 427, 449, 449,
+	-53, -111, 316, -169, -154, 261, -90, 406, -53, -88,
+	452, 433, -90, -44, -238, 248, -81, -59, -53, -234,
+	-235, 114, -41, -53, -160, -212, -53, 448, 448, -261,
+	400, 448, 209, -52, 400, 209, -53, -53, 180, -53,
+	180, -53, -53, -105, -165, -238, -59, -42, 309, -53,
+	-53, -53, -42, 309, -81, -47, 390, 78, 298, -243,
+	-136, 9, -81, -53, 146, -64, -65, -53, -159, -160,
+	-250, -249, 220, -91, -71, 419, 146, -26, 261, 248,
+	-26, 449, -53, 398, -150, -169, 229, -200, -61, 435,
+	436, 437, 438, 439, 440, 441, 442, 443, 431, 432,
+	433, 79, 13, 14, 15, -40, 429, -23, -26, -22,
+	-31, -16, -106, 172, 452, 92, -130, 208, 243, 164,
+	218, 319, 194, -198, -197, 34, -53, -199, -197, 34,
+	-53, -175, -33, -53, -198, -33, -203, -202, -181, 430,
+	163, 448, -106, 449, -169, 446, 446, -87, 33, 9,
+	-169, -169, 139, -169, -170, -170, 139, 163, 448, -169,
+	-184, -212, 31, 31, 449, -169, -94, 449, 452, 449,
+	-169, -176, 449, 408, -76, -76, -76, 238, 178, 235,
+	-137, 235, -137, -137, 9, 6, 137, -169, 374, -277,
+	-53, 448, 416, -75, 449, -212, -169, -139, -169, -212,
+	-170, -170, -170, -170, -170, -170, -170, -170, -170, -170,
+	-170, -170, -170, -170, -170, -170, 126, 248, 257, 128,
+	-170, 452, -94, 449, -140, -139, -169, -169, -212, 449,
+	449, 449, -94, -169, 449, 9, -232, -232, -230, -96,
+	-111, 82, 449, -167, -99, -195, 449, 146, -253, -161,
+	188, 142, -169, -79, 188, -79, 448, -261, 448, -53,
+	448, -37, 131, 340, 313, -144, 146, -44, 146, -44,
+	449, 452, 433, 450, 377, 377, 377, 377, -143, 84,
+	449, -91, 449, 452, -59, -67, 448, -120, 261, -69,
+	-91, -116, -50, 419, -59, 146, -285, 262, 449, -40,
+	155, 213, 449, -174, 175, 52, -202, 208, 208, -202,
+	-130, 208, -128, 269, -128, -128, -53, 448, -53, 448,
+	448, -199, -198, -101, 449, 267, 448, -94, -174, 447,
+	447, 9, 9, 446, 449, -169, 139, 31, 31, -169,
+	-169, -184, 449, 452, -169, -169, 449, 449, -169, 6,
+	-169, 449, 449, 449, 449, -169, -281, -53, -169, 449,
+	449, -140, 163, 126, 257, 128, 448, -169, 449, 449,
+	449, 449, -153, 448, 261, -26, -111, 433, -254, 84,
+	-255, 71, 248, 252, 393, 289, 65, 114, 304, 449,
+	449, -53, 449, -79, -182, -183, -201, -53, -265, 448,
+	-79, 248, 114, 248, 356, -69, 359, 114, 105, -69,
+	387, -53, -53, -165, -166, -117, -248, -61, -196, 6,
+	-212, -239, 342, 435, 436, 4, -245, 373, -238, -53,
+	-53, -53, -53, -53, -53, 6, -66, 191, -159, -251,
+	-71, -68, -162, -164, -249, -53, 77, 448, 257, 34,
+	105, 246, -52, -53, -45, -272, 418, -169, -113, -114,
+	-169, -115, 448, -202, -202, -129, 400, 261, 208, -202,
+	448, -79, 448, -110, -163, -53, -110, -53, -197, -179,
+	-178, -265, -76, -272, 447, 447, 9, -169, -169, -169,
+	449, -212, 449, -76, -274, 277, 449, -170, 163, 448,
+	-184, 449, 127, -78, -201, 84, -104, -33, 448, -53,
+	-91, 252, 209, 448, -170, -59, 449, 449, 452, 419,
+	-58, 71, -58, -169, 449, 252, 252, -234, -53, -169,
+	-212, -44, -44, -95, -239, 433, 377, 377, -71, 448,
+	186, 143, -120, 449, 452, 419, 131, 119, 286, -64,
+	-91, -26, 105, 448, 261, -273, -275, -53, 452, 449,
+	-129, 448, -169, -202, -79, 449, -110, 449, 452, -212,
+	449, 449, 452, -180, 34, 449, 447, 449, -76, 52,
+	-170, -184, 449, 398, 249, 452, 449, -53, -255, -169,
+	-74, -177, 416, -183, -93, -61, -53, -57, -91, 400,
+	-91, -57, 449, 304, -43, 71, 439, -95, -166, -53,
+	-53, -120, -90, -252, 115, 85, 189, 359, 76, 25,
+	-252, -162, 264, 324, 324, 449, -67, -50, -78, -59,
+	452, 34, -114, -79, 449, 449, -163, -43, -181, -178,
+	448, -278, 297, 324, -94, 449, 340, -201, -167, 449,
+	-256, 232, 448, 450, -39, -91, -39, -58, -59, -38,
+	400, -91, 387, 439, 449, -253, -66, -71, 449, -52,
+	-275, -277, 449, -110, 449, -279, -280, 45, 389, 95,
+	-169, -279, -98, -233, 246, -257, -259, -258, 261, 164,
+	276, 346, -169, -93, -40, -40, -57, -74, -169, 387,
+	-71, -120, -70, 419, 448, 449, -280, 284, 157, 323,
+	284, 157, -167, 190, -258, 261, -259, 261, 398, 119,
+	449, -39, -256, -120, -167, -69, -78, 31, -260, 246,
+	315, 56, 340, -260, -40, -257, 449, -280, 20, 252,
+	114, -70, -167,
 }
 var sqlDef = [...]int{
 
 	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
-	10, 11, 12, 13, 14, 15, 16, 18, 19, 20,
-	73, 74, 75, 76, 0, 0, 408, 0, 376, -2,
-	0, 0, 323, 323, 0, 323, 323, 323, 0, 0,
-	0, 410, -2, 407, -2, 419, 0, 0, 0, 417,
-	392, 0, 0, -2, 0, 0, 0, 0, 0, 0,
-	84, 91, 408, 0, 94, 95, 96, 97, 98, 165,
-	0, 0, 0, 106, 1042, 1125, 1152, 111, 116, 0,
-	1220, 941, -2, 121, 901, 902, 903, 918, 919, 920,
-	921, 922, 923, 924, 925, 926, 927, 928, 929, 930,
-	931, 932, 933, 934, 935, 936, 937, 938, 939, 940,
-	942, 943, 944, 945, 946, 947, 948, 949, 950, 951,
-	952, 953, 954, 955, 956, 957, 958, 959, 960, 961,
-	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
-	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
-	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
-	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
-	1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011,
-	1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021,
-	1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031,
-	1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041,
-	1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052,
-	1053, 1054, 1055, 1056, 1058, 1059, 1060, 1061, 1062, 1063,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 24, 25, 26, 0, 79, 80, 81, 82, 83,
+	84, 0, 0, 444, 0, 0, 0, 0, 412, -2,
+	0, 0, 358, 358, 0, 358, 358, 358, 0, 0,
+	0, 446, -2, 443, -2, 455, 0, 0, 0, 453,
+	428, 0, 0, -2, 0, 0, 0, 0, 0, 0,
+	0, 0, 94, 101, 444, 0, 104, 105, 106, 107,
+	108, 200, 0, 124, 122, 125, 127, 128, 129, 130,
+	131, 132, 133, 0, 0, 0, 0, 0, 135, 1081,
+	1165, 1192, 140, 145, 0, 1261, 979, -2, 150, 938,
+	939, 940, 955, 956, 957, 958, 959, 960, 961, 962,
+	963, 964, 965, 966, 967, 968, 969, 970, 971, 972,
+	973, 974, 975, 976, 977, 978, 980, 981, 982, 983,
+	984, 985, 986, 987, 988, 989, 990, 991, 992, 993,
+	994, 995, 996, 997, 998, 999, 1000, 1001, 1002, 1003,
+	1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013,
+	1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023,
+	1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033,
+	1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043,
+	1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053,
+	1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063,
 	1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073,
-	1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083,
-	1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
-	1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103,
-	1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113,
-	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
-	1124, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134,
-	1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144,
-	1145, 1146, 1147, 1148, 1149, 1150, 1151, 1153, 1154, 1155,
-	1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165,
-	1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175,
-	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
-	1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195,
-	1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205,
-	1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215,
-	1216, 1217, 1218, 1219, 1221, 1222, 1223, 1224, 1225, 148,
-	1220, 150, 1146, 948, 1022, 941, 1042, 1057, 1125, 1152,
-	307, 321, 322, 333, 333, 310, 0, 311, 312, 0,
-	313, 1220, 1115, 315, 0, 0, 409, 0, 0, 0,
-	158, 159, 0, 0, 164, 267, -2, 388, 389, -2,
-	0, 413, 413, 413, 0, 0, 389, 0, 397, 1095,
-	400, 882, 398, 868, 0, 416, 414, 0, 393, 507,
-	0, 877, 469, 0, 2, 0, 0, 0, 513, 1015,
-	59, 85, 87, 80, 1015, 82, 1015, 92, 0, 99,
-	105, 102, 1224, 909, 910, 911, 912, 1226, 1227, 1228,
-	1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238,
-	1239, 1240, 1241, 1242, 1243, 1244, 1245, 0, 340, 0,
-	1015, 0, 1015, 107, 1125, 108, 943, 109, 329, 0,
-	0, 327, 0, 0, 0, 0, 0, 0, 119, 120,
-	142, 143, 149, 0, 0, 0, 308, 332, 309, 319,
-	0, 320, 314, 318, 264, 511, 0, 270, 338, 1015,
-	160, 161, 162, 163, -2, 0, 430, 456, 453, 0,
-	427, 428, 457, 0, 0, 0, 468, 0, 411, 412,
-	0, 0, 0, 378, 379, 0, 399, 0, 0, 472,
-	867, 869, 873, 874, 611, 0, 0, 0, 0, 0,
-	0, 0, 697, 698, 858, 0, 701, 702, 703, 1196,
-	0, 708, 709, 1200, 1216, 877, 885, 886, 887, 888,
-	889, 0, 0, 0, 895, 896, 897, 851, 753, 719,
-	-2, -2, 883, 547, 548, 549, 550, -2, 1228, 723,
-	724, 726, 728, 730, 732, 733, 734, 735, 736, -2,
-	0, 1197, 1212, 1213, 1219, 1222, 1223, 1209, 1192, 1199,
-	1205, 984, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, -2, 908,
-	569, 570, 575, 576, 585, 472, 0, 470, 508, 509,
-	0, 878, 856, 0, 0, 0, 864, 862, 863, 526,
-	0, 355, 0, 514, 0, 0, 79, 0, 57, 58,
-	88, 0, 0, 0, 408, 0, 101, 103, 104, 131,
-	132, 133, 134, 253, 0, 255, 145, 146, 898, 0,
-	0, 340, 22, 23, 0, 339, 0, 341, 353, 346,
-	0, 348, 349, 350, 351, 24, 0, 28, 0, 304,
-	304, 0, 0, 0, 0, 0, 0, 0, 0, 26,
-	304, 0, 0, 0, 0, 331, 0, 325, 326, 328,
-	112, 114, 123, 125, 126, 113, 115, 117, 122, 118,
-	135, 136, 0, 139, 140, 141, 591, 151, 152, 153,
-	1115, 317, 59, 0, 0, 0, 216, 1015, 0, 905,
-	269, 340, 336, 337, 0, 430, 456, 382, 429, 383,
-	455, 458, 0, 0, 463, 0, 465, 0, 425, 426,
-	431, 435, 436, 439, 442, 443, 433, 611, 462, 0,
-	394, 395, 396, 420, 421, 285, 401, 0, 0, 879,
-	526, 0, 0, 0, 0, 0, 788, 789, 790, 791,
-	792, 795, 793, 794, 796, 797, 798, 799, 800, 801,
-	802, 803, 0, 0, 805, 0, 0, 0, 861, 0,
-	0, 0, 872, 804, 615, 616, 617, 636, 637, 0,
-	667, 699, -2, 807, 0, 704, 705, 706, 707, 0,
-	0, 0, -2, 0, 890, 892, 608, 0, 0, 850,
-	755, 0, 0, 0, 0, 0, 0, 0, 817, 0,
-	830, 836, 0, 0, 0, 0, 0, 0, 560, 572,
-	582, 580, 579, 562, 0, 561, 559, 0, 563, 0,
-	590, 0, 0, 0, 588, 0, 578, 0, 526, 0,
-	0, 857, 852, 853, 913, 914, 915, 916, 917, 1246,
-	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
-	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
-	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
-	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
-	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
-	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
-	1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316,
-	1317, 1318, 1319, 1320, 1321, 1322, 0, 0, 866, 367,
-	0, 362, 357, 0, 0, 0, 472, 369, 371, 372,
-	0, 0, 515, 59, 86, 0, 89, 81, 83, 93,
-	100, 254, 900, 899, 1015, 216, 21, 0, 342, 147,
-	0, 352, 347, 0, 0, 30, 0, 42, 0, 0,
-	204, 0, 0, 1022, 0, -2, 0, 0, 0, 303,
-	0, 0, 0, 47, 48, 53, 0, 49, 0, 51,
-	52, 54, 0, 0, 0, 0, 0, 0, 330, 324,
-	0, 0, 130, 0, 608, 0, 316, 261, 512, 262,
-	263, 167, 0, 241, 0, 0, 0, 1015, 904, 334,
-	0, 386, 387, 454, 0, 466, 0, 437, 0, 0,
-	434, 440, 441, 459, 460, 0, 467, 875, 0, 0,
-	288, 283, 284, 408, 0, 881, 445, 471, 473, 493,
-	498, 0, 493, 480, 0, 524, 1113, 720, 721, 0,
-	870, 612, 538, 0, 539, 540, 541, 542, 608, 545,
-	546, 554, 555, 556, 557, 558, 566, 0, 553, 553,
-	553, 564, 567, 568, 573, 574, 586, 594, 594, 583,
-	583, 583, 581, 613, 0, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, -2, -2, -2, 630, -2, -2,
-	-2, 634, 635, -2, 806, 0, 861, 0, 0, 646,
-	0, 649, 651, 653, 0, 0, 668, 0, 0, 860,
-	663, 842, 0, 0, 785, 786, 787, 871, 648, 0,
-	0, 859, 858, 0, 0, 0, 813, 807, 814, 0,
-	0, -2, 711, 419, 0, 0, 0, 0, 893, 595,
-	596, 597, 598, 599, 600, 609, 0, 849, 845, 0,
-	763, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 818, 819, 820, 821, 822, 823, 824, 825, 0,
-	0, 0, 0, 670, 0, 0, 0, 0, 1216, 0,
-	807, 835, 0, 0, 0, 0, 0, 807, 0, 841,
+	1074, 1075, 1076, 1077, 1078, 1079, 1080, 1082, 1083, 1084,
+	1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094,
+	1095, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105,
+	1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115,
+	1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125,
+	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135,
+	1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145,
+	1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155,
+	1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1166,
+	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
+	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
+	1187, 1188, 1189, 1190, 1191, 1193, 1194, 1195, 1196, 1197,
+	1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
+	1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217,
+	1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227,
+	1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237,
+	1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247,
+	1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257,
+	1258, 1259, 1260, 1262, 1263, 1264, 1265, 1266, 177, 1261,
+	179, 1186, 986, 1061, 1048, 979, 1081, 1096, 1165, 1192,
+	342, 356, 357, 368, 368, 345, 0, 346, 347, 0,
+	348, 1261, 1155, 350, 0, 0, 445, 0, 0, 0,
+	0, 193, 194, 0, 0, 199, 302, -2, 424, 425,
+	-2, 0, 449, 449, 449, 0, 0, 425, 0, 433,
+	1135, 436, 919, 434, 905, 0, 452, 450, 0, 429,
+	543, 0, 914, 505, 0, 2, 22, 648, 0, 0,
+	0, 0, 0, 0, 0, 734, 735, 895, 0, 738,
+	739, 740, 1236, 0, 745, 746, 1240, 1256, 914, 922,
+	923, 924, 925, 926, 0, 0, 0, 932, 933, 934,
+	888, 790, 756, -2, -2, 920, 584, 585, 586, 587,
+	-2, 1269, 760, 761, 763, 765, 767, 769, 770, 771,
+	772, 773, -2, 0, 1237, 1252, 1253, 1260, 1263, 1264,
+	1249, 1232, 1239, 1245, 1022, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, 945, 606, 607, 612, 613, 1267, 1268, 1270,
+	1271, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
+	1282, 1283, 1284, 1285, 1286, 622, 0, 0, 0, 549,
+	1054, 65, 95, 97, 88, 1054, 90, 1054, 92, 1054,
+	102, 0, 109, 115, 112, 1265, 946, 947, 948, 949,
+	1269, 1272, 0, 0, 121, 123, 0, 0, 376, 0,
+	1054, 0, 1054, 0, 136, 1165, 137, 981, 138, 364,
+	0, 0, 362, 0, 0, 0, 0, 0, 0, 148,
+	149, 171, 172, 178, 0, 0, 0, 0, 0, 343,
+	367, 344, 354, 0, 355, 349, 353, 299, 547, 0,
+	0, 305, 251, 374, 1054, 195, 196, 197, 198, -2,
+	0, 466, 492, 489, 0, 463, 464, 493, 0, 0,
+	0, 504, 0, 447, 448, 0, 0, 0, 414, 415,
+	0, 435, 0, 0, 508, 904, 906, 910, 911, 508,
+	0, 506, 544, 545, 0, 915, 893, 0, 0, 0,
+	901, 899, 900, 0, 0, 0, 825, 826, 827, 828,
+	829, 832, 830, 831, 833, 834, 835, 836, 837, 838,
+	839, 840, 0, 0, 842, 0, 0, 0, 898, 0,
+	0, 841, 652, 653, 654, 673, 674, 0, 704, 736,
+	-2, 844, 0, 741, 742, 743, 744, 0, 0, 0,
+	-2, 0, 927, 929, 645, 0, 0, 887, 792, 0,
+	0, 0, 0, 0, 0, 0, 854, 0, 867, 873,
+	0, 0, 0, 0, 0, 0, 597, 609, 619, 617,
+	616, 599, 0, 598, 596, 0, 600, 0, 627, 0,
+	0, 0, 625, 0, 615, 0, 562, 0, 391, 0,
+	550, 0, 0, 87, 0, 63, 64, 98, 0, 0,
+	0, 0, 444, 0, 111, 113, 114, 160, 161, 162,
+	163, 288, 0, 290, 174, 175, 935, 0, 0, 0,
+	118, 0, 1006, 912, 126, 0, 376, 28, 29, 0,
+	375, 0, 377, 389, 382, 0, 384, 385, 386, 387,
+	30, 0, 34, 0, 339, 339, 0, 0, 0, 0,
+	0, 0, 0, 0, 32, 339, 0, 0, 0, 0,
+	0, 366, 0, 360, 361, 363, 141, 143, 152, 154,
+	155, 142, 144, 146, 151, 147, 164, 165, 0, 168,
+	169, 170, 628, 180, 181, 182, 186, 184, 134, 916,
+	1155, 352, 65, 0, 0, 0, 187, 1054, 251, 1054,
+	0, 942, 304, 0, 0, 376, 372, 373, 0, 466,
+	492, 418, 465, 419, 491, 494, 0, 0, 499, 0,
+	501, 0, 461, 462, 467, 471, 472, 475, 478, 479,
+	469, 648, 498, 0, 430, 431, 432, 456, 457, 320,
+	437, 0, 0, 562, 0, 0, 0, 909, 562, 0,
+	0, 894, 889, 890, 950, 951, 952, 953, 954, 1287,
+	1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297,
+	1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307,
+	1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317,
+	1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327,
+	1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337,
+	1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347,
+	1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357,
+	1358, 1359, 1360, 1361, 1362, 1363, 0, 0, 903, 649,
+	574, 0, 575, 576, 577, 578, 579, 645, 582, 583,
+	591, 592, 593, 594, 595, 603, 0, 590, 590, 590,
+	601, 604, 605, 610, 611, 623, 631, 631, 620, 620,
+	620, 618, 650, 0, 655, 656, 657, 658, 659, 660,
+	661, 662, 663, -2, -2, -2, 667, -2, -2, -2,
+	671, 672, -2, 843, 0, 898, 0, 0, 683, 0,
+	686, 688, 690, 0, 0, 705, 0, 0, 897, 700,
+	879, 0, 0, 822, 823, 824, 685, 0, 0, 896,
+	895, 0, 0, 0, 850, 844, 851, 0, 0, -2,
+	748, 455, 0, 0, 0, 0, 930, 632, 633, 634,
+	635, 636, 637, 646, 0, 886, 882, 0, 800, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 855,
+	856, 857, 858, 859, 860, 861, 862, 0, 0, 0,
+	0, 707, 0, 0, 0, 0, 1256, 0, 844, 872,
+	0, 0, 0, 0, 0, 844, 0, 878, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 445, 0, 510, 854, 0, 865, 77,
-	0, 525, 367, 0, 0, 359, 356, 526, 0, 0,
-	0, 78, 90, 0, 0, 294, 343, 344, 345, 29,
-	297, 31, 182, 0, 0, 0, 207, 0, 0, 0,
-	0, 0, 0, 306, 43, 1015, 59, 1015, 59, 44,
-	0, 67, 70, 50, 25, 0, 295, 0, 0, 27,
-	304, 110, 127, 128, 129, 124, 137, 0, 0, 0,
-	166, 879, 170, 174, 175, 176, 0, 169, 245, 0,
-	0, 252, 0, 0, 338, 464, 0, 0, 461, 0,
-	422, 288, 788, 789, 790, 791, 792, 793, 794, 795,
-	796, 797, 798, 799, 800, 801, 802, 803, 424, 0,
-	0, 403, 404, 405, 406, 880, 452, 0, 0, 0,
-	0, 0, 0, 504, 504, 504, 502, 475, 492, 0,
-	491, 476, 494, 0, 491, 498, 493, 0, 478, -2,
-	480, 0, 516, 0, 0, 0, 530, 534, 538, 543,
-	0, 0, 0, -2, 0, 0, 0, 664, -2, 647,
-	650, 652, 654, 0, 0, 669, 0, 0, 0, 0,
-	0, 665, 0, 0, 0, 700, 808, 0, 811, 812,
-	0, 710, -2, 0, 0, 418, 419, 419, 419, 717,
-	0, 0, 0, 0, 0, 0, 0, 846, 0, 0,
-	718, 0, 0, 0, 0, 725, 727, 729, 731, 0,
-	739, 0, 740, 0, 0, 741, 0, 0, 0, 0,
+	0, 403, 0, 398, 393, 0, 0, 0, 508, 405,
+	407, 408, 0, 0, 551, 65, 96, 0, 99, 89,
+	91, 93, 103, 110, 289, 937, 936, 1054, 251, 0,
+	0, 119, 120, 23, 27, 0, 378, 176, 0, 388,
+	383, 0, 0, 36, 0, 48, 0, 0, 239, 0,
+	0, 1061, 0, -2, 0, 0, 0, 338, 0, 0,
+	0, 53, 54, 59, 0, 55, 0, 57, 58, 60,
+	0, 0, 0, 0, 0, 0, 0, 365, 359, 0,
+	0, 159, 0, 645, 0, 183, 0, 0, 351, 296,
+	548, 297, 298, 0, 202, 0, 276, 0, 0, 0,
+	1054, 941, 0, 0, 370, 0, 422, 423, 490, 0,
+	502, 0, 473, 0, 0, 470, 476, 477, 495, 496,
+	0, 503, 0, 0, 323, 318, 319, 444, 918, 481,
+	507, 509, 529, 534, 0, 529, 516, 0, 560, 1153,
+	757, 758, 0, 907, 908, 481, 0, 546, 891, 0,
+	902, 566, 570, 574, 580, 0, 0, 0, -2, 0,
+	0, 0, 701, -2, 684, 687, 689, 691, 0, 0,
+	706, 0, 0, 0, 0, 0, 702, 0, 0, 0,
+	737, 845, 0, 848, 849, 0, 747, -2, 0, 0,
+	454, 455, 455, 455, 754, 0, 0, 0, 0, 0,
+	0, 0, 883, 0, 0, 755, 0, 0, 0, 0,
+	762, 764, 766, 768, 0, 776, 0, 777, 0, 0,
+	778, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 672, 673, 674, 0, 0,
-	742, 833, 834, 0, 0, 0, 0, 0, 0, 747,
-	0, 840, 0, 749, 750, 751, 0, 551, 0, 565,
-	594, 592, 593, 594, 585, 584, 452, 415, 0, 366,
-	354, 365, 0, 367, 370, 373, 0, 0, 179, 203,
-	0, 272, 0, 0, 0, 0, 0, 210, 0, 271,
-	0, 32, 0, 0, 0, 0, 0, 40, 0, 46,
-	64, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 239, 215, 0, 196, 241, 0, 249, 0, 240,
-	216, 0, 247, 0, 272, 0, 340, 432, 438, 876,
-	423, 286, 287, 402, 757, 0, 0, 474, 0, 0,
-	0, 0, 0, 499, 503, 500, 501, 489, 0, 489,
-	0, 0, 477, 479, 884, 482, 523, 0, 419, 0,
-	0, 531, 535, 0, 614, -2, 0, -2, 0, 0,
-	0, 0, 0, -2, 0, 809, 0, 0, 843, 0,
-	0, 780, 0, -2, 808, 815, 712, 0, 0, 0,
-	0, 601, 602, 603, 604, 605, 606, 607, 0, 894,
-	844, 848, 0, 761, 762, 766, 0, 0, 722, 0,
-	816, 827, 828, 671, 675, 676, 677, 678, 679, 680,
-	681, 682, 683, -2, -2, -2, 687, -2, -2, -2,
-	0, 0, 0, 695, 829, 0, 0, 783, 831, 832,
-	837, 838, 0, 744, 745, 746, 839, 0, 571, 0,
-	589, 587, 577, 757, 855, 0, 0, 0, 358, 368,
-	0, 181, 0, 184, 0, 0, 187, 188, 0, 0,
-	0, 0, 205, 206, 246, 208, 0, 0, 221, 0,
-	279, 279, 0, 0, 0, 56, 0, 0, 36, 0,
-	0, 305, 37, 0, 59, 59, 68, 69, 256, 257,
-	258, 259, 260, 289, 0, 0, 788, 789, 906, 907,
-	-2, 72, 298, 299, 301, 296, 0, 0, 138, 241,
-	0, 171, 193, 245, 0, 172, 177, 178, 0, 0,
-	167, 0, 0, 250, 0, 0, 0, 335, 390, 0,
-	451, 444, 446, 448, 449, 0, 483, 0, 485, 0,
-	0, 0, 487, 0, 0, 0, 0, 527, 0, 0,
-	879, 481, 0, 519, 522, 0, 536, 0, 0, 0,
-	544, -2, 0, 0, 0, -2, -2, 0, 657, 0,
-	-2, -2, 666, 779, 808, 891, 419, 713, 715, 716,
-	610, 847, 768, 765, 0, 752, 738, 826, 0, 0,
-	0, 696, 0, 808, 782, 743, 748, 552, 391, 0,
-	0, 273, 0, 374, 375, 0, 0, 185, 186, 189,
-	0, 191, 216, 209, 225, 0, 0, 282, 0, 282,
-	0, 0, 33, 34, 35, 38, 55, 61, 39, 45,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 156,
-	168, 0, 0, 242, 0, 0, 0, 169, 252, 251,
-	0, 0, 756, 758, 0, 0, 450, 484, 0, 506,
-	486, 0, 490, 0, 495, 0, 61, 497, 524, 0,
-	518, 0, 712, 537, 532, 0, -2, -2, -2, 658,
-	810, 781, 0, 419, 0, 754, -2, 0, 0, 0,
-	784, 0, 361, 0, 526, 364, 183, 0, 220, 211,
-	0, 222, 223, 292, 0, 285, 280, 0, 278, 285,
-	279, 0, 63, 0, 0, 0, 71, 300, 302, 154,
-	0, 194, 197, 198, 199, 200, 201, 202, 195, 173,
-	182, 243, 244, 239, 241, 248, 0, 272, 0, 0,
-	447, 0, 488, 496, 528, 529, 517, 520, 0, 533,
-	714, 771, 0, -2, 0, 693, 0, 274, 363, 214,
-	230, 0, 0, 0, 288, 281, 288, 282, 216, 41,
-	0, 60, 290, 0, 238, 180, 241, 245, 66, 0,
-	759, 760, 505, 0, 0, 0, 0, 767, 694, 526,
-	190, 0, 192, 226, 227, 0, 217, 218, 219, 0,
-	293, 275, 276, 285, 220, 62, 291, 245, 157, 526,
-	0, 0, 521, 764, 769, 772, -2, 1159, 964, 0,
-	770, 360, 213, 228, 0, 229, 0, 0, 0, 224,
-	288, 230, 155, 265, 65, 0, 0, 774, 775, 776,
-	777, 778, 231, 0, 234, 235, 0, 232, 277, 212,
-	66, 0, 233, 236, 237, 526, 773, 266,
+	709, 710, 711, 0, 0, 779, 870, 871, 0, 0,
+	0, 0, 0, 0, 784, 0, 877, 0, 786, 787,
+	788, 0, 588, 0, 602, 631, 629, 630, 631, 622,
+	621, 85, 0, 561, 403, 0, 0, 395, 392, 562,
+	0, 0, 0, 86, 100, 0, 116, 913, 329, 379,
+	380, 381, 35, 332, 37, 217, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 341, 49, 1054, 65,
+	1054, 65, 50, 0, 73, 76, 56, 31, 0, 330,
+	0, 0, 33, 339, 117, 139, 156, 157, 158, 153,
+	166, 0, 185, 917, 0, 0, 201, 916, 205, 209,
+	210, 211, 0, 204, 280, 0, 0, 287, 0, 0,
+	369, 250, 374, 500, 0, 0, 497, 458, 323, 825,
+	826, 827, 828, 829, 830, 831, 832, 833, 834, 835,
+	836, 837, 838, 839, 840, 460, 0, 0, 439, 440,
+	441, 442, 488, 0, 0, 0, 0, 0, 0, 540,
+	540, 540, 538, 511, 528, 0, 527, 512, 530, 0,
+	527, 534, 529, 0, 514, -2, 516, 0, 552, 0,
+	0, 0, 488, 451, 0, 0, 0, 567, 571, 0,
+	651, -2, 0, -2, 0, 0, 0, 0, 0, -2,
+	0, 846, 0, 0, 880, 0, 0, 817, 0, -2,
+	845, 852, 749, 0, 0, 0, 0, 638, 639, 640,
+	641, 642, 643, 644, 0, 931, 881, 885, 0, 798,
+	799, 803, 0, 0, 759, 0, 853, 864, 865, 708,
+	712, 713, 714, 715, 716, 717, 718, 719, 720, -2,
+	-2, -2, 724, -2, -2, -2, 0, 0, 0, 732,
+	866, 0, 0, 820, 868, 869, 874, 875, 0, 781,
+	782, 783, 876, 0, 608, 0, 626, 624, 614, 402,
+	390, 401, 0, 403, 406, 409, 0, 0, 214, 238,
+	0, 307, 0, 0, 0, 0, 0, 245, 0, 306,
+	0, 38, 0, 0, 0, 0, 0, 46, 0, 52,
+	70, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 188, 274, 0, 231, 276, 0, 284, 0, 275,
+	251, 0, 282, 0, 307, 0, 376, 468, 474, 459,
+	321, 322, 438, 794, 0, 0, 510, 0, 0, 0,
+	0, 0, 535, 539, 536, 537, 525, 0, 525, 0,
+	0, 513, 515, 921, 518, 559, 0, 455, 794, 892,
+	572, 0, 0, 0, 581, -2, 0, 0, 0, -2,
+	-2, 0, 694, 0, -2, -2, 703, 816, 845, 928,
+	455, 750, 752, 753, 647, 884, 805, 802, 0, 789,
+	775, 863, 0, 0, 0, 733, 0, 845, 819, 780,
+	785, 589, 0, 0, 0, 394, 404, 0, 216, 0,
+	219, 0, 0, 222, 223, 0, 0, 0, 0, 240,
+	241, 281, 243, 0, 0, 256, 0, 314, 314, 0,
+	0, 0, 62, 0, 0, 42, 0, 0, 340, 43,
+	0, 65, 65, 74, 75, 291, 292, 293, 294, 295,
+	324, 0, 0, 825, 826, 943, 944, -2, 78, 333,
+	334, 336, 331, 0, 0, 167, 276, 0, 206, 228,
+	280, 0, 207, 212, 213, 0, 0, 202, 0, 0,
+	285, 0, 0, 0, 371, 426, 0, 487, 480, 482,
+	484, 485, 0, 519, 0, 521, 0, 0, 0, 523,
+	0, 0, 0, 0, 563, 0, 0, 916, 517, 0,
+	555, 558, 0, 427, 573, 568, 0, -2, -2, -2,
+	695, 847, 818, 0, 455, 0, 791, -2, 0, 0,
+	0, 821, 0, 0, 308, 0, 410, 411, 0, 0,
+	220, 221, 224, 0, 226, 251, 244, 260, 0, 0,
+	317, 0, 317, 0, 0, 39, 40, 41, 44, 61,
+	67, 45, 51, 0, 0, 0, 0, 0, 280, 0,
+	0, 0, 191, 203, 0, 0, 277, 0, 0, 0,
+	204, 287, 286, 0, 0, 793, 795, 0, 0, 486,
+	520, 0, 542, 522, 0, 526, 0, 531, 0, 67,
+	533, 560, 0, 554, 0, 749, 569, 751, 808, 0,
+	-2, 0, 730, 0, 397, 0, 562, 400, 218, 0,
+	255, 246, 0, 257, 258, 327, 0, 320, 315, 0,
+	313, 320, 314, 0, 69, 0, 0, 0, 77, 335,
+	337, 189, 0, 229, 232, 233, 234, 235, 236, 237,
+	230, 208, 217, 278, 279, 274, 276, 283, 0, 307,
+	0, 0, 483, 0, 524, 532, 564, 565, 553, 556,
+	0, 0, 0, 0, 804, 731, 0, 309, 399, 249,
+	265, 0, 0, 0, 323, 316, 323, 317, 251, 47,
+	0, 66, 325, 0, 273, 215, 276, 280, 72, 0,
+	796, 797, 541, 0, 801, 806, 809, -2, 1199, 1002,
+	0, 807, 562, 225, 0, 227, 261, 262, 0, 252,
+	253, 254, 0, 328, 310, 311, 320, 255, 68, 326,
+	280, 192, 562, 0, 0, 557, 0, 811, 812, 813,
+	814, 815, 396, 248, 263, 0, 264, 0, 0, 0,
+	259, 323, 265, 190, 300, 71, 0, 0, 266, 0,
+	269, 270, 0, 267, 312, 247, 72, 810, 268, 271,
+	272, 562, 301,
 }
 var sqlTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 439, 3, 435, 436, 3,
-	444, 445, 433, 431, 448, 432, 446, 434, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 449, 447,
-	427, 429, 428, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 443, 3, 439, 440, 3,
+	448, 449, 437, 435, 452, 436, 450, 438, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 453, 451,
+	431, 433, 432, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 442, 3, 443, 438, 3, 3, 3, 3, 3,
+	3, 446, 3, 447, 442, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 437, 3, 441,
+	3, 3, 3, 3, 441, 3, 445,
 }
 var sqlTok2 = [...]int{
 
@@ -5860,8 +6108,9 @@ var sqlTok3 = [...]int{
 	57750, 408, 57751, 409, 57752, 410, 57753, 411, 57754, 412,
 	57755, 413, 57756, 414, 57757, 415, 57758, 416, 57759, 417,
 	57760, 418, 57761, 419, 57762, 420, 57763, 421, 57764, 422,
-	57765, 423, 57766, 424, 57767, 425, 57768, 426, 57769, 430,
-	57770, 440, 0,
+	57765, 423, 57766, 424, 57767, 425, 57768, 426, 57769, 427,
+	57770, 428, 57771, 429, 57772, 430, 57773, 434, 57774, 444,
+	0,
 }
 
 var sqlErrorMessages = [...]struct {
@@ -6205,13 +6454,13 @@ sqldefault:
 
 	case 1:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:458
+		//line sql.y:486
 		{
 			sqllex.(*scanner).stmts = sqlDollar[1].stmts
 		}
 	case 2:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:464
+		//line sql.y:492
 		{
 			if sqlDollar[3].stmt != nil {
 				sqlVAL.stmts = append(sqlDollar[1].stmts, sqlDollar[3].stmt)
@@ -6219,7 +6468,7 @@ sqldefault:
 		}
 	case 3:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:470
+		//line sql.y:498
 		{
 			if sqlDollar[1].stmt != nil {
 				sqlVAL.stmts = []Statement{sqlDollar[1].stmt}
@@ -6227,1858 +6476,2103 @@ sqldefault:
 				sqlVAL.stmts = nil
 			}
 		}
-	case 17:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:493
-		{
-			sqlVAL.stmt = nil
-		}
 	case 21:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:506
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:525
 		{
 			sqlVAL.stmt = nil
 		}
 	case 22:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:510
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:532
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &CancelQuery{ID: sqlDollar[3].expr}
 		}
 	case 23:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:514
-		{
-			sqlVAL.stmt = nil
-		}
-	case 24:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:520
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:539
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &Import{Table: sqlDollar[3].qname, Format: "CSV", File: sqlDollar[5].expr}
 		}
-	case 25:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:524
+	case 27:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:552
 		{
 			sqlVAL.stmt = nil
 		}
-	case 26:
+	case 28:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:530
+		//line sql.y:556
 		{
 			sqlVAL.stmt = nil
 		}
-	case 27:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:534
+	case 29:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:560
 		{
 			sqlVAL.stmt = nil
 		}
 	case 30:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:570
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:566
 		{
+			sqlVAL.stmt = nil
 		}
 	case 31:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:572
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:570
 		{
+			sqlVAL.stmt = nil
 		}
 	case 32:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:574
+		//line sql.y:576
 		{
+			sqlVAL.stmt = newAlterTable(sqlDollar[3].qname, sqlDollar[4].alterCmds, false)
 		}
 	case 33:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:576
+		//line sql.y:580
 		{
+			sqlVAL.stmt = newAlterTable(sqlDollar[5].qname, sqlDollar[6].alterCmds, true)
 		}
 	case 34:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:578
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:612
 		{
+			sqlVAL.alterCmds = AlterTableCmds{sqlDollar[1].alterCmd}
 		}
 	case 35:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:580
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:616
 		{
+			sqlVAL.alterCmds = append(sqlDollar[1].alterCmds, sqlDollar[3].alterCmd)
 		}
 	case 36:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:582
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:622
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 37:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:584
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:624
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 38:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:586
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:626
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 39:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:588
+		//line sql.y:628
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 40:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:590
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:630
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 41:
-		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:593
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:632
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 42:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:595
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:634
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 43:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:597
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:636
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 44:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:599
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:638
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 45:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:601
+		//line sql.y:640
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 46:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:603
+		//line sql.y:642
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 47:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:605
+		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
+		//line sql.y:645
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 48:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:607
+		//line sql.y:648
 		{
+			sqlVAL.alterCmd = &AlterTableAddConstraint{ConstraintDef: sqlDollar[2].tblDef}
 		}
 	case 49:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:609
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:652
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 50:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:611
+		//line sql.y:654
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 51:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:613
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:656
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 52:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:615
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:658
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 53:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:617
+		//line sql.y:660
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 54:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:619
+		//line sql.y:662
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 55:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:622
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:664
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 56:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:623
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:666
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 57:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:626
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:668
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 58:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:627
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:670
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 59:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:628
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:672
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 60:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:631
+		//line sql.y:674
 		{
+			sqlVAL.alterCmd = nil
 		}
 	case 61:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:632
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:677
 		{
 		}
 	case 62:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:635
+		//line sql.y:678
 		{
 		}
 	case 63:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:636
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:681
 		{
 		}
 	case 64:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:640
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:682
 		{
-			sqlVAL.empty = sqlDollar[2].empty
 		}
 	case 65:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:645
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:683
 		{
 		}
 	case 66:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:646
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:686
 		{
 		}
 	case 67:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:649
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:687
 		{
 		}
 	case 68:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:650
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:690
 		{
 		}
 	case 69:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:654
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:691
 		{
 		}
 	case 70:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:655
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:695
 		{
+			sqlVAL.empty = sqlDollar[2].empty
 		}
 	case 71:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:656
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:700
 		{
 		}
 	case 72:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:701
+		{
+		}
+	case 73:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:704
+		{
+		}
+	case 74:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:705
+		{
+		}
+	case 75:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:657
+		//line sql.y:709
+		{
+		}
+	case 76:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:710
 		{
 		}
 	case 77:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:711
+		{
+		}
+	case 78:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:712
+		{
+		}
+	case 85:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:672
+		//line sql.y:728
 		{
 			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr)}
 		}
-	case 78:
+	case 86:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:679
+		//line sql.y:735
 		{
 			sqlVAL.stmt = nil
 		}
-	case 79:
+	case 87:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:683
+		//line sql.y:739
 		{
 			sqlVAL.stmt = nil
 		}
-	case 80:
+	case 88:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:687
+		//line sql.y:743
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[3].str), IfExists: false}
 		}
-	case 81:
+	case 89:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:691
+		//line sql.y:747
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[5].str), IfExists: true}
 		}
-	case 82:
+	case 90:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:695
+		//line sql.y:751
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 83:
+	case 91:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:699
+		//line sql.y:755
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 84:
+	case 92:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:759
+		{
+			sqlVAL.stmt = &DropView{Names: sqlDollar[3].qnames, IfExists: false}
+		}
+	case 93:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:763
+		{
+			sqlVAL.stmt = &DropView{Names: sqlDollar[5].qnames, IfExists: true}
+		}
+	case 94:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:704
+		//line sql.y:768
 		{
 		}
-	case 85:
+	case 95:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:710
+		//line sql.y:774
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 86:
+	case 96:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:714
+		//line sql.y:778
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 87:
+	case 97:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:720
+		//line sql.y:784
 		{
 			sqlVAL.qname = QualifiedName{sqlDollar[1].str}
 		}
-	case 88:
+	case 98:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:724
+		//line sql.y:788
 		{
 			sqlVAL.qname = append(QualifiedName{sqlDollar[1].str}, sqlDollar[2].qname...)
 		}
-	case 89:
+	case 99:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:730
+		//line sql.y:794
 		{
 			sqlVAL.qname = QualifiedName{sqlDollar[2].str}
 		}
-	case 90:
+	case 100:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:734
+		//line sql.y:798
 		{
 			sqlVAL.qname = append(sqlDollar[1].qname, sqlDollar[3].str)
 		}
-	case 91:
+	case 101:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:742
+		//line sql.y:806
 		{
 			sqlVAL.stmt = nil
 		}
-	case 92:
+	case 102:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:746
+		//line sql.y:810
 		{
 			sqlVAL.stmt = nil
 		}
-	case 93:
+	case 103:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:750
+		//line sql.y:814
 		{
 			sqlVAL.stmt = nil
 		}
-	case 102:
+	case 112:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:770
+		//line sql.y:834
 		{
 		}
-	case 103:
+	case 113:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:773
+		//line sql.y:837
 		{
 		}
-	case 104:
+	case 114:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:774
+		//line sql.y:838
 		{
 		}
-	case 105:
+	case 115:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:775
+		//line sql.y:839
 		{
 		}
-	case 106:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:814
+	case 116:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:877
 		{
-			sqlVAL.stmt = sqlDollar[2].stmt
+			sqlVAL.stmt = &Grant{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 107:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:818
+	case 117:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:884
 		{
-			sqlVAL.stmt = sqlDollar[3].stmt
+			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 108:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:822
+	case 118:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:890
 		{
-			sqlVAL.stmt = sqlDollar[3].stmt
+			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
 		}
-	case 109:
+	case 119:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:894
+		{
+			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
+		}
+	case 120:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:898
+		{
+			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
+		}
+	case 121:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:905
+		{
+			sqlVAL.privilegeList = privilege.List{privilege.ALL}
+		}
+	case 122:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:909
+		{
+			sqlVAL.privilegeList = sqlDollar[1].privilegeList
+		}
+	case 123:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:914
+		{
+		}
+	case 124:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:915
+		{
+		}
+	case 125:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:919
+		{
+			sqlVAL.privilegeList = privilege.List{sqlDollar[1].privilegeType}
+		}
+	case 126:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:923
+		{
+			sqlVAL.privilegeList = append(sqlDollar[1].privilegeList, sqlDollar[3].privilegeType)
+		}
+	case 127:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:930
+		{
+			sqlVAL.privilegeType = privilege.CREATE
+		}
+	case 128:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:934
+		{
+			sqlVAL.privilegeType = privilege.DROP
+		}
+	case 129:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:938
+		{
+			sqlVAL.privilegeType = privilege.GRANT
+		}
+	case 130:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:942
+		{
+			sqlVAL.privilegeType = privilege.SELECT
+		}
+	case 131:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:946
+		{
+			sqlVAL.privilegeType = privilege.INSERT
+		}
+	case 132:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:950
+		{
+			sqlVAL.privilegeType = privilege.DELETE
+		}
+	case 133:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:954
+		{
+			sqlVAL.privilegeType = privilege.UPDATE
+		}
+	case 134:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:961
+		{
+			sqlVAL.strs = sqlDollar[1].strs
+		}
+	case 135:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:969
+		{
+			sqlVAL.stmt = sqlDollar[2].stmt
+		}
+	case 136:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:973
+		{
+			sqlVAL.stmt = sqlDollar[3].stmt
+		}
+	case 137:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:977
+		{
+			sqlVAL.stmt = sqlDollar[3].stmt
+		}
+	case 138:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:827
+		//line sql.y:982
 		{
 		}
-	case 110:
+	case 139:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:828
+		//line sql.y:983
 		{
 		}
-	case 111:
+	case 140:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:829
+		//line sql.y:984
 		{
 		}
-	case 112:
+	case 141:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:833
+		//line sql.y:988
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].strs, Values: sqlDollar[3].exprs}
 		}
-	case 113:
+	case 142:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:837
+		//line sql.y:992
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].strs, Values: sqlDollar[3].exprs}
 		}
-	case 114:
+	case 143:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:841
+		//line sql.y:996
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].strs}
 		}
-	case 115:
+	case 144:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:845
+		//line sql.y:1000
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].strs}
 		}
-	case 117:
+	case 146:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:852
+		//line sql.y:1007
 		{
 		}
-	case 118:
+	case 147:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:854
+		//line sql.y:1010
 		{
+			if sqlDollar[3].expr == nil {
+				sqlVAL.stmt = &Set{Name: QualifiedName{"time_zone"}}
+			} else {
+				sqlVAL.stmt = &Set{Name: QualifiedName{"time_zone"}, Values: Exprs{sqlDollar[3].expr}}
+			}
 		}
-	case 119:
+	case 148:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:855
+		//line sql.y:1017
 		{
 		}
-	case 120:
+	case 149:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:857
+		//line sql.y:1019
 		{
 		}
-	case 121:
+	case 150:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:861
+		//line sql.y:1023
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 122:
+	case 151:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:865
+		//line sql.y:1027
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 123:
+	case 152:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:871
+		//line sql.y:1033
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 124:
+	case 153:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:875
+		//line sql.y:1037
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 127:
+	case 156:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:884
+		//line sql.y:1046
 		{
 		}
-	case 128:
+	case 157:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:885
+		//line sql.y:1047
 		{
 		}
-	case 129:
+	case 158:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:886
+		//line sql.y:1048
 		{
 		}
-	case 130:
+	case 159:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:887
+		//line sql.y:1049
 		{
 		}
-	case 131:
+	case 160:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:891
+		//line sql.y:1053
 		{
 			sqlVAL.expr = BoolVal(true)
 		}
-	case 132:
+	case 161:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:895
+		//line sql.y:1057
 		{
 			sqlVAL.expr = BoolVal(false)
 		}
-	case 133:
+	case 162:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:899
+		//line sql.y:1061
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 135:
+	case 164:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:915
+		//line sql.y:1078
 		{
+			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 136:
+	case 165:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:916
+		//line sql.y:1082
 		{
+			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 137:
+	case 166:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:917
+		//line sql.y:1086
 		{
+			sqlVAL.expr = StrVal(sqlDollar[2].str)
 		}
-	case 138:
+	case 167:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:918
+		//line sql.y:1090
 		{
+			sqlVAL.expr = StrVal(sqlDollar[5].str)
 		}
-	case 139:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:919
-		{
-		}
-	case 140:
+	case 169:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:920
+		//line sql.y:1095
 		{
+			sqlVAL.expr = nil
 		}
-	case 141:
+	case 170:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:921
+		//line sql.y:1099
 		{
+			sqlVAL.expr = nil
 		}
-	case 142:
+	case 171:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:924
+		//line sql.y:1104
 		{
 		}
-	case 143:
+	case 172:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:925
+		//line sql.y:1105
 		{
 		}
-	case 144:
+	case 173:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:926
+		//line sql.y:1106
 		{
 		}
-	case 145:
+	case 174:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:930
+		//line sql.y:1110
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 146:
+	case 175:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:934
+		//line sql.y:1114
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 147:
+	case 176:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:939
+		//line sql.y:1119
 		{
 		}
-	case 148:
+	case 177:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:943
+		//line sql.y:1123
 		{
-			// It would be cleaner if we could have "SHOW DATABASES" and "SHOW
-			// TABLES" rules, but unfortunately DATABASES and TABLES are
-			// unreserved keywords and thus valid variable names and such
-			// rules would cause reduce/reduce conflicts.
+			// It would be cleaner if we could have "SHOW DATABASES", "SHOW
+			// TABLES" and "SHOW GRANTS" rules, but unfortunately DATABASES,
+			// TABLES and GRANTS are unreserved keywords and thus valid variable
+			// names and such rules would cause reduce/reduce conflicts.
 			if len(sqlDollar[2].strs) == 1 && strings.EqualFold(sqlDollar[2].strs[0], "DATABASES") {
 				sqlVAL.stmt = &ShowDatabases{}
 			} else if len(sqlDollar[2].strs) == 1 && strings.EqualFold(sqlDollar[2].strs[0], "TABLES") {
 				sqlVAL.stmt = &ShowTables{}
+			} else if len(sqlDollar[2].strs) == 1 && strings.EqualFold(sqlDollar[2].strs[0], "GRANTS") {
+				sqlVAL.stmt = &ShowGrants{}
 			} else {
-				sqlVAL.stmt = nil
+				sqlVAL.stmt = &Show{Name: strings.Join(sqlDollar[2].strs, ".")}
 			}
 		}
-	case 149:
+	case 178:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:957
+		//line sql.y:1139
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &Show{Name: "time_zone"}
 		}
-	case 150:
+	case 179:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:961
+		//line sql.y:1143
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &Show{Name: "ALL"}
 		}
-	case 151:
+	case 180:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:965
+		//line sql.y:1147
 		{
 			sqlVAL.stmt = &ShowTables{Name: sqlDollar[4].strs}
 		}
-	case 152:
+	case 181:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:969
+		//line sql.y:1151
 		{
 			sqlVAL.stmt = &ShowColumns{Table: sqlDollar[4].strs}
 		}
-	case 153:
+	case 182:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:973
+		//line sql.y:1155
 		{
 			sqlVAL.stmt = &ShowIndex{Table: sqlDollar[4].strs}
 		}
-	case 154:
+	case 183:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1159
+		{
+			targets := sqlDollar[4].targetList
+			sqlVAL.stmt = &ShowGrants{Targets: &targets, Grantees: NameList(sqlDollar[5].strs)}
+		}
+	case 184:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1164
+		{
+			sqlVAL.stmt = &ShowGrants{Grantees: NameList(sqlDollar[4].strs)}
+		}
+	case 185:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1170
+		{
+			sqlVAL.strs = sqlDollar[2].strs
+		}
+	case 186:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1174
+		{
+			sqlVAL.strs = nil
+		}
+	case 187:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1181
+		{
+			sqlVAL.stmt = &CreateSequence{Name: sqlDollar[4].qname, IfNotExists: false}
+		}
+	case 188:
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+		//line sql.y:1185
+		{
+			sqlVAL.stmt = &CreateSequence{Name: sqlDollar[7].qname, IfNotExists: true}
+		}
+	case 189:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:981
+		//line sql.y:1193
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[4].qname, IfNotExists: false, Defs: sqlDollar[6].tblDefs}
 		}
-	case 155:
+	case 190:
 		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:986
+		//line sql.y:1198
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[7].qname, IfNotExists: true, Defs: sqlDollar[9].tblDefs}
 		}
-	case 156:
+	case 191:
 		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:991
+		//line sql.y:1203
 		{
 			sqlVAL.stmt = nil
 		}
-	case 157:
+	case 192:
 		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
-		//line sql.y:996
+		//line sql.y:1208
 		{
 			sqlVAL.stmt = nil
 		}
-	case 158:
+	case 193:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1010
+		//line sql.y:1222
 		{
 		}
-	case 159:
+	case 194:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1011
+		//line sql.y:1223
 		{
 		}
-	case 160:
+	case 195:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1012
+		//line sql.y:1224
 		{
 		}
-	case 161:
+	case 196:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1013
+		//line sql.y:1225
 		{
 		}
-	case 162:
+	case 197:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1014
+		//line sql.y:1226
 		{
 		}
-	case 163:
+	case 198:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1015
+		//line sql.y:1227
 		{
 		}
-	case 164:
+	case 199:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1016
+		//line sql.y:1228
 		{
 		}
-	case 165:
+	case 200:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1017
+		//line sql.y:1229
 		{
 		}
-	case 167:
+	case 202:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1022
+		//line sql.y:1234
 		{
 			sqlVAL.tblDefs = nil
 		}
-	case 168:
+	case 203:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1028
+		//line sql.y:1240
 		{
 			sqlVAL.empty = sqlDollar[2].empty
 		}
-	case 169:
+	case 204:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1031
+		//line sql.y:1243
 		{
 		}
-	case 170:
+	case 205:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1035
+		//line sql.y:1247
 		{
 			sqlVAL.tblDefs = TableDefs{sqlDollar[1].tblDef}
 		}
-	case 171:
+	case 206:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1039
+		//line sql.y:1251
 		{
 			sqlVAL.tblDefs = append(sqlDollar[1].tblDefs, sqlDollar[3].tblDef)
 		}
-	case 172:
+	case 207:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1044
+		//line sql.y:1256
 		{
 		}
-	case 173:
+	case 208:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1045
+		//line sql.y:1257
 		{
 		}
-	case 175:
+	case 210:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1049
+		//line sql.y:1261
 		{
 		}
-	case 177:
+	case 212:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1053
+		//line sql.y:1265
 		{
 		}
-	case 178:
+	case 213:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1054
+		//line sql.y:1266
 		{
 		}
-	case 179:
+	case 214:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1058
+		//line sql.y:1270
 		{
 			sqlVAL.tblDef = newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].colType, sqlDollar[3].colConstraints)
 		}
-	case 180:
+	case 215:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1063
+		//line sql.y:1275
 		{
 		}
-	case 181:
+	case 216:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1067
+		//line sql.y:1279
 		{
 			sqlVAL.colConstraints = append(sqlDollar[1].colConstraints, sqlDollar[2].colConstraint)
 		}
-	case 182:
+	case 217:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1071
+		//line sql.y:1283
 		{
 			sqlVAL.colConstraints = nil
 		}
-	case 183:
+	case 218:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1077
+		//line sql.y:1289
 		{
 			// TODO(pmattis): Handle constraint name.
 			sqlVAL.colConstraint = sqlDollar[3].colConstraint
+			if c, ok := sqlVAL.colConstraint.(*CheckConstraint); ok {
+				c.Name = Name(sqlDollar[2].str)
+			}
 		}
-	case 185:
+	case 220:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1082
+		//line sql.y:1297
 		{
 		}
-	case 186:
+	case 221:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1098
+		//line sql.y:1313
 		{
 			sqlVAL.colConstraint = NotNullConstraint{}
 		}
-	case 187:
+	case 222:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1102
+		//line sql.y:1317
 		{
 			sqlVAL.colConstraint = NullConstraint{}
 		}
-	case 188:
+	case 223:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1106
+		//line sql.y:1321
 		{
 			sqlVAL.colConstraint = UniqueConstraint{}
 		}
-	case 189:
+	case 224:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1110
+		//line sql.y:1325
 		{
 			sqlVAL.colConstraint = PrimaryKeyConstraint{}
 		}
-	case 190:
+	case 225:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1113
+		//line sql.y:1329
 		{
+			sqlVAL.colConstraint = &CheckConstraint{Expr: sqlDollar[3].expr}
 		}
-	case 191:
+	case 226:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1114
+		//line sql.y:1333
 		{
+			sqlVAL.colConstraint = &DefaultConstraint{Expr: sqlDollar[2].expr}
 		}
-	case 192:
+	case 227:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1115
+		//line sql.y:1337
 		{
+			sqlVAL.colConstraint = &ReferencesConstraint{Table: sqlDollar[2].qname, Columns: NameList(sqlDollar[3].strs), Actions: sqlDollar[5].refActions}
 		}
-	case 193:
+	case 228:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1118
+		//line sql.y:1342
 		{
 		}
-	case 194:
+	case 229:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1121
+		//line sql.y:1345
 		{
 		}
-	case 195:
+	case 230:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1122
+		//line sql.y:1346
 		{
 		}
-	case 196:
+	case 231:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1123
+		//line sql.y:1347
 		{
 		}
-	case 197:
+	case 232:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1126
+		//line sql.y:1350
 		{
 		}
-	case 198:
+	case 233:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1127
+		//line sql.y:1351
 		{
 		}
-	case 199:
+	case 234:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1128
+		//line sql.y:1352
 		{
 		}
-	case 200:
+	case 235:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1129
+		//line sql.y:1353
 		{
 		}
-	case 201:
+	case 236:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1130
+		//line sql.y:1354
 		{
 		}
-	case 202:
+	case 237:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1131
+		//line sql.y:1355
 		{
 		}
-	case 203:
+	case 238:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1138
+		//line sql.y:1362
 		{
 			sqlVAL.tblDef = sqlDollar[3].tblDef
-			if i, ok := sqlVAL.tblDef.(*IndexTableDef); ok {
-				i.Name = Name(sqlDollar[2].str)
+			switch t := sqlVAL.tblDef.(type) {
+			case *IndexTableDef:
+				t.Name = Name(sqlDollar[2].str)
+			case *ForeignKeyTableDef:
+				t.Name = Name(sqlDollar[2].str)
+			case *CheckConstraintTableDef:
+				t.Name = Name(sqlDollar[2].str)
 			}
 		}
-	case 204:
+	case 239:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1145
+		//line sql.y:1374
 		{
 			sqlVAL.tblDef = sqlDollar[1].tblDef
 		}
-	case 205:
+	case 240:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1150
+		//line sql.y:1380
 		{
+			sqlVAL.tblDef = &CheckConstraintTableDef{Expr: sqlDollar[3].expr}
 		}
-	case 206:
+	case 241:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1152
+		//line sql.y:1384
 		{
 			sqlVAL.tblDef = &IndexTableDef{Unique: true, Columns: NameList(sqlDollar[3].strs)}
 		}
-	case 207:
+	case 242:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1155
+		//line sql.y:1387
 		{
 		}
-	case 208:
+	case 243:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1157
+		//line sql.y:1389
 		{
 			sqlVAL.tblDef = &IndexTableDef{Columns: NameList(sqlDollar[3].strs)}
 		}
-	case 209:
+	case 244:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1161
+		//line sql.y:1393
 		{
 			sqlVAL.tblDef = &IndexTableDef{PrimaryKey: true, Unique: true, Columns: NameList(sqlDollar[4].strs)}
 		}
-	case 210:
+	case 245:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1164
+		//line sql.y:1396
 		{
 		}
-	case 211:
+	case 246:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1166
+		//line sql.y:1398
 		{
 		}
-	case 212:
+	case 247:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1168
+		//line sql.y:1401
 		{
+			sqlVAL.tblDef = &ForeignKeyTableDef{
+				Columns:    NameList(sqlDollar[4].strs),
+				Table:      sqlDollar[7].qname,
+				RefColumns: NameList(sqlDollar[8].strs),
+				Actions:    sqlDollar[10].refActions,
+			}
 		}
-	case 213:
+	case 248:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1171
+		//line sql.y:1411
 		{
 		}
-	case 214:
+	case 249:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1172
+		//line sql.y:1412
 		{
 		}
-	case 215:
+	case 250:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1176
+		//line sql.y:1416
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 216:
+	case 251:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1180
+		//line sql.y:1420
 		{
 			sqlVAL.strs = nil
 		}
-	case 217:
+	case 252:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1185
+		//line sql.y:1425
 		{
 		}
-	case 218:
+	case 253:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1186
+		//line sql.y:1426
 		{
 		}
-	case 219:
+	case 254:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1187
+		//line sql.y:1427
 		{
 		}
-	case 220:
+	case 255:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1188
+		//line sql.y:1428
 		{
 		}
-	case 221:
+	case 256:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1191
+		//line sql.y:1431
 		{
 		}
-	case 222:
+	case 257:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1192
+		//line sql.y:1432
 		{
 		}
-	case 223:
+	case 258:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1195
+		//line sql.y:1435
 		{
 		}
-	case 224:
+	case 259:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1198
+		//line sql.y:1438
 		{
 		}
-	case 225:
+	case 260:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1199
+		//line sql.y:1439
 		{
 		}
-	case 226:
+	case 261:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1206
+		//line sql.y:1445
 		{
+			sqlVAL.refActions = ReferenceActions{Update: sqlDollar[1].refAction}
 		}
-	case 227:
+	case 262:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1207
+		//line sql.y:1449
 		{
+			sqlVAL.refActions = ReferenceActions{Delete: sqlDollar[1].refAction}
 		}
-	case 228:
+	case 263:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1208
+		//line sql.y:1453
 		{
+			sqlVAL.refActions = ReferenceActions{Delete: sqlDollar[2].refAction, Update: sqlDollar[1].refAction}
 		}
-	case 229:
+	case 264:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1209
+		//line sql.y:1457
 		{
+			sqlVAL.refActions = ReferenceActions{Delete: sqlDollar[1].refAction, Update: sqlDollar[2].refAction}
 		}
-	case 230:
+	case 265:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1210
+		//line sql.y:1461
 		{
+			sqlVAL.refActions = ReferenceActions{}
 		}
-	case 231:
+	case 266:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1213
+		//line sql.y:1467
 		{
+			sqlVAL.refAction = sqlDollar[3].refAction
 		}
-	case 232:
+	case 267:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1216
+		//line sql.y:1473
 		{
+			sqlVAL.refAction = sqlDollar[3].refAction
 		}
-	case 233:
+	case 268:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1219
+		//line sql.y:1479
 		{
+			sqlVAL.refAction = NoAction
 		}
-	case 234:
+	case 269:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1220
+		//line sql.y:1483
 		{
+			sqlVAL.refAction = Restrict
 		}
-	case 235:
+	case 270:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1221
+		//line sql.y:1487
 		{
+			sqlVAL.refAction = Cascade
 		}
-	case 236:
+	case 271:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1222
+		//line sql.y:1491
 		{
+			sqlVAL.refAction = SetNull
 		}
-	case 237:
+	case 272:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1223
+		//line sql.y:1495
 		{
+			sqlVAL.refAction = SetDefault
 		}
-	case 238:
+	case 273:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1226
+		//line sql.y:1500
 		{
 		}
-	case 239:
+	case 274:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1227
+		//line sql.y:1501
 		{
 		}
-	case 240:
+	case 275:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1230
+		//line sql.y:1504
 		{
 		}
-	case 241:
+	case 276:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1231
+		//line sql.y:1505
 		{
 		}
-	case 242:
+	case 277:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1234
+		//line sql.y:1508
 		{
 		}
-	case 243:
+	case 278:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1235
+		//line sql.y:1509
 		{
 		}
-	case 244:
+	case 279:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1236
+		//line sql.y:1510
 		{
 		}
-	case 245:
+	case 280:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1237
+		//line sql.y:1511
 		{
 		}
-	case 246:
+	case 281:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1240
+		//line sql.y:1514
 		{
 		}
-	case 247:
+	case 282:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1245
+		//line sql.y:1519
 		{
 			sqlVAL.stmt = nil
 		}
-	case 248:
+	case 283:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1249
+		//line sql.y:1523
 		{
 			sqlVAL.stmt = nil
 		}
-	case 249:
+	case 284:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1254
+		//line sql.y:1528
 		{
 		}
-	case 250:
+	case 285:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1257
+		//line sql.y:1531
 		{
 		}
-	case 251:
+	case 286:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1258
+		//line sql.y:1532
 		{
 		}
-	case 252:
+	case 287:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1259
+		//line sql.y:1533
 		{
 		}
-	case 253:
+	case 288:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1263
+		//line sql.y:1537
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 254:
+	case 289:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1267
+		//line sql.y:1541
 		{
 			sqlVAL.expr = NumVal("-" + sqlDollar[2].str)
 		}
-	case 255:
+	case 290:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1271
+		//line sql.y:1545
 		{
 			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
-	case 256:
+	case 291:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1277
+		//line sql.y:1551
 		{
 		}
-	case 257:
+	case 292:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1278
+		//line sql.y:1552
 		{
 		}
-	case 258:
+	case 293:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1279
+		//line sql.y:1553
 		{
 		}
-	case 259:
+	case 294:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1280
+		//line sql.y:1554
 		{
 		}
-	case 260:
+	case 295:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1281
+		//line sql.y:1555
 		{
 		}
-	case 261:
+	case 296:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1286
+		//line sql.y:1560
 		{
 			sqlVAL.stmt = &Truncate{Tables: sqlDollar[3].qnames}
 		}
-	case 262:
+	case 297:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1291
+		//line sql.y:1565
 		{
 		}
-	case 263:
+	case 298:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1292
+		//line sql.y:1566
 		{
 		}
-	case 264:
+	case 299:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1293
+		//line sql.y:1567
 		{
 		}
-	case 265:
+	case 300:
 		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:1300
+		//line sql.y:1574
 		{
 			sqlVAL.stmt = nil
 		}
-	case 266:
+	case 301:
 		sqlDollar = sqlS[sqlpt-16 : sqlpt+1]
-		//line sql.y:1306
+		//line sql.y:1580
 		{
 			sqlVAL.stmt = nil
 		}
-	case 267:
+	case 302:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1311
+		//line sql.y:1585
 		{
 		}
-	case 268:
+	case 303:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1312
+		//line sql.y:1586
 		{
 		}
-	case 269:
+	case 304:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1315
+		//line sql.y:1589
 		{
 		}
-	case 270:
+	case 305:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1316
+		//line sql.y:1590
 		{
 		}
-	case 271:
+	case 306:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1319
+		//line sql.y:1593
 		{
 		}
-	case 272:
+	case 307:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1320
+		//line sql.y:1594
 		{
 		}
-	case 273:
+	case 308:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1323
+		//line sql.y:1597
 		{
 		}
-	case 274:
+	case 309:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1324
+		//line sql.y:1598
 		{
 		}
-	case 275:
+	case 310:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1331
+		//line sql.y:1605
 		{
 		}
-	case 276:
+	case 311:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1333
+		//line sql.y:1607
 		{
 		}
-	case 277:
+	case 312:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1335
+		//line sql.y:1609
 		{
 		}
-	case 278:
+	case 313:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1338
+		//line sql.y:1612
 		{
 		}
-	case 279:
+	case 314:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1339
+		//line sql.y:1613
 		{
 		}
-	case 280:
+	case 315:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1342
+		//line sql.y:1616
 		{
 		}
-	case 281:
+	case 316:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1343
+		//line sql.y:1617
 		{
 		}
-	case 282:
+	case 317:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1344
+		//line sql.y:1618
 		{
 		}
-	case 283:
+	case 318:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1347
+		//line sql.y:1622
 		{
+			sqlVAL.str = astAsc
 		}
-	case 284:
+	case 319:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1348
+		//line sql.y:1626
 		{
+			sqlVAL.str = astDesc
 		}
-	case 285:
+	case 320:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1349
+		//line sql.y:1630
 		{
+			sqlVAL.str = ""
 		}
-	case 286:
+	case 321:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1352
+		//line sql.y:1635
 		{
 		}
-	case 287:
+	case 322:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1353
+		//line sql.y:1636
 		{
 		}
-	case 288:
+	case 323:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1354
+		//line sql.y:1637
 		{
 		}
-	case 289:
+	case 324:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1360
+		//line sql.y:1643
 		{
 		}
-	case 290:
+	case 325:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1361
+		//line sql.y:1644
 		{
 		}
-	case 291:
+	case 326:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1362
+		//line sql.y:1645
 		{
 		}
-	case 292:
+	case 327:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1365
+		//line sql.y:1648
 		{
 		}
-	case 293:
+	case 328:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1366
+		//line sql.y:1649
 		{
 		}
-	case 294:
+	case 329:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1371
+		//line sql.y:1654
 		{
 			sqlVAL.stmt = nil
 		}
-	case 295:
+	case 330:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1379
+		//line sql.y:1662
 		{
 			sqlVAL.stmt = nil
 		}
-	case 296:
+	case 331:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1383
+		//line sql.y:1666
 		{
 			sqlVAL.stmt = nil
 		}
-	case 297:
+	case 332:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1395
+		//line sql.y:1678
 		{
 			sqlVAL.stmt = nil
 		}
-	case 298:
+	case 333:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1399
+		//line sql.y:1682
 		{
 			sqlVAL.stmt = nil
 		}
-	case 299:
+	case 334:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1403
+		//line sql.y:1686
 		{
 			sqlVAL.stmt = nil
 		}
-	case 300:
+	case 335:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1407
+		//line sql.y:1690
 		{
 			sqlVAL.stmt = nil
 		}
-	case 301:
+	case 336:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1411
+		//line sql.y:1694
 		{
 			sqlVAL.stmt = nil
 		}
-	case 302:
+	case 337:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1415
+		//line sql.y:1698
 		{
 			sqlVAL.stmt = nil
 		}
-	case 303:
+	case 338:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1420
+		//line sql.y:1703
 		{
 		}
-	case 304:
+	case 339:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1421
+		//line sql.y:1704
 		{
 		}
-	case 305:
+	case 340:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1424
+		//line sql.y:1707
 		{
 		}
-	case 306:
+	case 341:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1425
+		//line sql.y:1708
 		{
 		}
-	case 307:
+	case 342:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1430
+		//line sql.y:1713
 		{
 			sqlVAL.stmt = nil
 		}
-	case 308:
+	case 343:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1434
+		//line sql.y:1717
 		{
 			sqlVAL.stmt = nil
 		}
-	case 309:
+	case 344:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1438
+		//line sql.y:1721
 		{
 			sqlVAL.stmt = nil
 		}
-	case 310:
+	case 345:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1442
+		//line sql.y:1725
 		{
 			sqlVAL.stmt = nil
 		}
-	case 311:
+	case 346:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1446
+		//line sql.y:1729
 		{
 			sqlVAL.stmt = nil
 		}
-	case 312:
+	case 347:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1450
+		//line sql.y:1733
 		{
 			sqlVAL.stmt = nil
 		}
-	case 313:
+	case 348:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1454
+		//line sql.y:1737
 		{
 			sqlVAL.stmt = nil
 		}
-	case 314:
+	case 349:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1458
+		//line sql.y:1741
 		{
 			sqlVAL.stmt = nil
 		}
-	case 315:
+	case 350:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1462
+		//line sql.y:1745
 		{
 			sqlVAL.stmt = nil
 		}
-	case 316:
+	case 351:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1466
+		//line sql.y:1749
 		{
 			sqlVAL.stmt = nil
 		}
-	case 317:
+	case 352:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1470
+		//line sql.y:1753
 		{
 			sqlVAL.stmt = nil
 		}
-	case 318:
+	case 353:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1474
+		//line sql.y:1757
 		{
 			sqlVAL.stmt = nil
 		}
-	case 319:
+	case 354:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1478
+		//line sql.y:1761
 		{
 			sqlVAL.stmt = nil
 		}
-	case 320:
+	case 355:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1482
+		//line sql.y:1765
 		{
 			sqlVAL.stmt = nil
 		}
-	case 321:
+	case 356:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1487
+		//line sql.y:1770
 		{
 		}
-	case 322:
+	case 357:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1488
+		//line sql.y:1771
 		{
 		}
-	case 323:
+	case 358:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1489
+		//line sql.y:1772
 		{
 		}
-	case 324:
+	case 359:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1492
+		//line sql.y:1775
 		{
 		}
-	case 325:
+	case 360:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1493
+		//line sql.y:1776
 		{
 		}
-	case 326:
+	case 361:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1494
+		//line sql.y:1777
 		{
 		}
-	case 327:
+	case 362:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1495
+		//line sql.y:1778
 		{
 		}
-	case 328:
+	case 363:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1496
+		//line sql.y:1779
 		{
 		}
-	case 329:
+	case 364:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1500
+		//line sql.y:1783
 		{
 		}
-	case 330:
+	case 365:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1501
+		//line sql.y:1784
 		{
 		}
-	case 331:
+	case 366:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1502
+		//line sql.y:1785
 		{
 		}
-	case 332:
+	case 367:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1505
+		//line sql.y:1788
 		{
 		}
-	case 333:
+	case 368:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1506
+		//line sql.y:1789
 		{
 		}
-	case 334:
+	case 369:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1795
+		{
+			sqlVAL.stmt = &CreateView{Name: sqlDollar[3].qname, ColumnNames: NameList(sqlDollar[4].strs), AsSource: sqlDollar[6].stmt.(SelectStatement)}
+		}
+	case 370:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1540
+		//line sql.y:1827
 		{
 			// TODO(pmattis): Handle options.
 			sqlVAL.stmt = &CreateDatabase{Name: Name(sqlDollar[3].str)}
 		}
-	case 335:
+	case 371:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1545
+		//line sql.y:1832
 		{
 			// TODO(pmattis): Handle options.
 			sqlVAL.stmt = &CreateDatabase{IfNotExists: true, Name: Name(sqlDollar[6].str)}
 		}
-	case 336:
+	case 372:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1551
+		//line sql.y:1838
 		{
 		}
-	case 337:
+	case 373:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1552
+		//line sql.y:1839
 		{
 		}
-	case 338:
+	case 374:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1553
+		//line sql.y:1840
 		{
 		}
-	case 339:
+	case 375:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1556
+		//line sql.y:1843
 		{
 		}
-	case 340:
+	case 376:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1557
+		//line sql.y:1844
 		{
 		}
-	case 341:
+	case 377:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1560
+		//line sql.y:1847
 		{
 		}
-	case 342:
+	case 378:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1561
+		//line sql.y:1848
 		{
 		}
-	case 343:
+	case 379:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1564
+		//line sql.y:1851
 		{
 		}
-	case 344:
+	case 380:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1565
+		//line sql.y:1852
 		{
 		}
-	case 345:
+	case 381:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1566
+		//line sql.y:1853
 		{
 		}
-	case 346:
+	case 382:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1578
+		//line sql.y:1865
 		{
 		}
-	case 347:
+	case 383:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1579
+		//line sql.y:1866
 		{
 		}
-	case 348:
+	case 384:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1580
+		//line sql.y:1867
 		{
 		}
-	case 349:
+	case 385:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1581
+		//line sql.y:1868
 		{
 		}
-	case 350:
+	case 386:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1582
+		//line sql.y:1869
 		{
 		}
-	case 351:
+	case 387:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1583
+		//line sql.y:1870
 		{
 		}
-	case 352:
+	case 388:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1588
+		//line sql.y:1875
 		{
 		}
-	case 353:
+	case 389:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1589
+		//line sql.y:1876
 		{
 		}
-	case 354:
+	case 390:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1594
+		//line sql.y:1881
 		{
 			sqlVAL.stmt = sqlDollar[5].stmt
 			sqlVAL.stmt.(*Insert).Table = sqlDollar[4].qname
 		}
-	case 357:
+	case 393:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1610
+		//line sql.y:1897
 		{
 			sqlVAL.stmt = &Insert{Rows: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 358:
+	case 394:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1614
+		//line sql.y:1901
 		{
 			sqlVAL.stmt = &Insert{Columns: sqlDollar[2].qnames, Rows: sqlDollar[4].stmt.(SelectStatement)}
 		}
-	case 359:
+	case 395:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1618
+		//line sql.y:1905
 		{
 			sqlVAL.stmt = &Insert{}
 		}
-	case 360:
+	case 396:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1623
+		//line sql.y:1910
 		{
 		}
-	case 361:
+	case 397:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1624
+		//line sql.y:1911
 		{
 		}
-	case 362:
+	case 398:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1625
+		//line sql.y:1912
 		{
 		}
-	case 363:
+	case 399:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1628
+		//line sql.y:1915
 		{
 		}
-	case 364:
+	case 400:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1629
+		//line sql.y:1916
 		{
 		}
-	case 365:
+	case 401:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1630
+		//line sql.y:1917
 		{
 		}
-	case 366:
+	case 402:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1633
+		//line sql.y:1920
 		{
 		}
-	case 367:
+	case 403:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1634
+		//line sql.y:1921
 		{
 		}
-	case 368:
+	case 404:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1642
+		//line sql.y:1929
 		{
 			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr)}
 		}
-	case 369:
+	case 405:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1648
+		//line sql.y:1935
 		{
 			sqlVAL.updateExprs = UpdateExprs{sqlDollar[1].updateExpr}
 		}
-	case 370:
+	case 406:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1652
+		//line sql.y:1939
 		{
 			sqlVAL.updateExprs = append(sqlDollar[1].updateExprs, sqlDollar[3].updateExpr)
 		}
-	case 372:
+	case 408:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1658
+		//line sql.y:1945
 		{
 		}
-	case 373:
+	case 409:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1662
+		//line sql.y:1949
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Name: sqlDollar[1].qname, Expr: sqlDollar[3].expr}
 		}
-	case 374:
+	case 410:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1673
+		//line sql.y:1960
 		{
 		}
-	case 375:
+	case 411:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1674
+		//line sql.y:1961
 		{
 		}
-	case 378:
+	case 414:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1719
+		//line sql.y:2006
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 379:
+	case 415:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1723
+		//line sql.y:2010
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 381:
+	case 417:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1739
+		//line sql.y:2026
 		{
-			sqlVAL.stmt = sqlDollar[1].stmt
+			sqlVAL.stmt = withOrderByLimit(sqlDollar[1].stmt, sqlDollar[2].orderBy, nil)
 		}
-	case 382:
+	case 418:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1743
+		//line sql.y:2030
 		{
-			sqlVAL.stmt = sqlDollar[1].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
-				s.Limit = sqlDollar[4].limit
-			}
+			sqlVAL.stmt = withOrderByLimit(sqlDollar[1].stmt, sqlDollar[2].orderBy, sqlDollar[4].limit)
 		}
-	case 383:
+	case 419:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1750
+		//line sql.y:2034
 		{
-			sqlVAL.stmt = sqlDollar[1].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
-				s.Limit = sqlDollar[3].limit
-			}
+			sqlVAL.stmt = withOrderByLimit(sqlDollar[1].stmt, sqlDollar[2].orderBy, sqlDollar[3].limit)
 		}
-	case 384:
+	case 420:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1757
+		//line sql.y:2038
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 385:
+	case 421:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1761
+		//line sql.y:2042
 		{
-			sqlVAL.stmt = sqlDollar[2].stmt
+			sqlVAL.stmt = withOrderByLimit(sqlDollar[2].stmt, sqlDollar[3].orderBy, nil)
 		}
-	case 386:
+	case 422:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1765
+		//line sql.y:2046
 		{
-			sqlVAL.stmt = sqlDollar[2].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
-				s.Limit = sqlDollar[5].limit
-			}
+			sqlVAL.stmt = withOrderByLimit(sqlDollar[2].stmt, sqlDollar[3].orderBy, sqlDollar[5].limit)
 		}
-	case 387:
+	case 423:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1772
+		//line sql.y:2050
 		{
-			sqlVAL.stmt = sqlDollar[2].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
-				s.Limit = sqlDollar[4].limit
-			}
+			sqlVAL.stmt = withOrderByLimit(sqlDollar[2].stmt, sqlDollar[3].orderBy, sqlDollar[4].limit)
 		}
-	case 390:
+	case 426:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1809
+		//line sql.y:2084
 		{
 			sqlVAL.stmt = &Select{
 				Exprs:  sqlDollar[3].selExprs,
@@ -8087,9 +8581,9 @@ sqldefault:
 				Having: newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 391:
+	case 427:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1820
+		//line sql.y:2095
 		{
 			// TODO(pmattis): Support DISTINCT ON?
 			sqlVAL.stmt = &Select{
@@ -8100,167 +8594,172 @@ sqldefault:
 				Having:   newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 393:
+	case 429:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1831
+		//line sql.y:2106
 		{
 		}
-	case 394:
+	case 430:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1833
+		//line sql.y:2108
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
 				Type:  astUnion,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 395:
+	case 431:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1842
+		//line sql.y:2117
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
 				Type:  astIntersect,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 396:
+	case 432:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1851
+		//line sql.y:2126
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
 				Type:  astExcept,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 397:
+	case 433:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1869
+		//line sql.y:2144
 		{
 		}
-	case 398:
+	case 434:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1870
+		//line sql.y:2145
 		{
 		}
-	case 399:
+	case 435:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1871
+		//line sql.y:2146
 		{
 		}
-	case 400:
+	case 436:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1874
+		//line sql.y:2149
 		{
 		}
-	case 401:
+	case 437:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1875
+		//line sql.y:2150
 		{
 		}
-	case 402:
+	case 438:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1878
+		//line sql.y:2153
 		{
 		}
-	case 407:
+	case 443:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1887
+		//line sql.y:2162
 		{
 		}
-	case 408:
+	case 444:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1888
+		//line sql.y:2163
 		{
 		}
-	case 409:
+	case 445:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1891
+		//line sql.y:2166
 		{
 		}
-	case 410:
+	case 446:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1892
+		//line sql.y:2167
 		{
 		}
-	case 411:
+	case 447:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1895
+		//line sql.y:2171
 		{
+			sqlVAL.boolVal = true
 		}
-	case 412:
+	case 448:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1896
+		//line sql.y:2175
 		{
+			sqlVAL.boolVal = false
 		}
-	case 413:
+	case 449:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1897
+		//line sql.y:2179
 		{
+			sqlVAL.boolVal = false
 		}
-	case 414:
+	case 450:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1902
+		//line sql.y:2186
 		{
 		}
-	case 415:
+	case 451:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1903
+		//line sql.y:2187
 		{
 		}
-	case 416:
+	case 452:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1906
+		//line sql.y:2190
 		{
 		}
-	case 417:
+	case 453:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1907
+		//line sql.y:2191
 		{
 		}
-	case 418:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1910
-		{
-		}
-	case 419:
+	case 455:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1911
+		//line sql.y:2196
 		{
+			sqlVAL.orderBy = nil
 		}
-	case 420:
+	case 456:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1914
+		//line sql.y:2202
 		{
+			sqlVAL.orderBy = sqlDollar[3].orderBy
 		}
-	case 421:
+	case 457:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1917
+		//line sql.y:2208
 		{
+			sqlVAL.orderBy = OrderBy{sqlDollar[1].order}
 		}
-	case 422:
+	case 458:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1918
+		//line sql.y:2212
 		{
+			sqlVAL.orderBy = append(sqlDollar[1].orderBy, sqlDollar[3].order)
 		}
-	case 423:
+	case 459:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1921
+		//line sql.y:2218
 		{
+			// TODO(pmattis): Support USING and NULLS FIRST/LAST.
+			sqlVAL.order = &Order{Expr: sqlDollar[1].expr}
 		}
-	case 424:
+	case 460:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1922
+		//line sql.y:2223
 		{
+			sqlVAL.order = &Order{Expr: sqlDollar[1].expr, Direction: sqlDollar[2].str}
 		}
-	case 425:
+	case 461:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1926
+		//line sql.y:2229
 		{
 			if sqlDollar[1].limit == nil {
 				sqlVAL.limit = sqlDollar[2].limit
@@ -8269,24 +8768,24 @@ sqldefault:
 				sqlVAL.limit.Offset = sqlDollar[2].limit.Offset
 			}
 		}
-	case 426:
+	case 462:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1935
+		//line sql.y:2238
 		{
 			sqlVAL.limit = sqlDollar[1].limit
 			if sqlDollar[2].limit != nil {
 				sqlVAL.limit.Count = sqlDollar[2].limit.Count
 			}
 		}
-	case 430:
+	case 466:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1947
+		//line sql.y:2250
 		{
 			sqlVAL.limit = nil
 		}
-	case 431:
+	case 467:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1953
+		//line sql.y:2256
 		{
 			if sqlDollar[2].expr == nil {
 				sqlVAL.limit = nil
@@ -8294,2426 +8793,2432 @@ sqldefault:
 				sqlVAL.limit = &Limit{Count: sqlDollar[2].expr}
 			}
 		}
-	case 432:
+	case 468:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1961
+		//line sql.y:2264
 		{
 		}
-	case 433:
+	case 469:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1965
+		//line sql.y:2268
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 434:
+	case 470:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1972
+		//line sql.y:2275
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 436:
+	case 472:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1979
+		//line sql.y:2282
 		{
 			sqlVAL.expr = nil
 		}
-	case 437:
+	case 473:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1987
+		//line sql.y:2290
 		{
 		}
-	case 438:
+	case 474:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1988
+		//line sql.y:2291
 		{
 		}
-	case 439:
+	case 475:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1989
+		//line sql.y:2292
 		{
 		}
-	case 440:
+	case 476:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1993
+		//line sql.y:2296
 		{
 		}
-	case 441:
+	case 477:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1994
+		//line sql.y:2297
 		{
 		}
-	case 442:
+	case 478:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1997
+		//line sql.y:2300
 		{
 		}
-	case 443:
+	case 479:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1998
+		//line sql.y:2301
 		{
 		}
-	case 444:
+	case 480:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2019
+		//line sql.y:2322
 		{
 		}
-	case 445:
+	case 481:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2020
+		//line sql.y:2323
 		{
 		}
-	case 446:
+	case 482:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2023
+		//line sql.y:2326
 		{
 		}
-	case 447:
+	case 483:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2024
+		//line sql.y:2327
 		{
 		}
-	case 448:
+	case 484:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2027
+		//line sql.y:2330
 		{
 		}
-	case 449:
+	case 485:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2028
+		//line sql.y:2331
 		{
 		}
-	case 450:
+	case 486:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2031
+		//line sql.y:2334
 		{
 		}
-	case 451:
+	case 487:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2035
+		//line sql.y:2338
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 452:
+	case 488:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2039
+		//line sql.y:2342
 		{
 			sqlVAL.expr = nil
 		}
-	case 453:
+	case 489:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2044
+		//line sql.y:2347
 		{
 		}
-	case 454:
+	case 490:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2045
+		//line sql.y:2348
 		{
 		}
-	case 455:
+	case 491:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2048
+		//line sql.y:2351
 		{
 		}
-	case 456:
+	case 492:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2049
+		//line sql.y:2352
 		{
 		}
-	case 457:
+	case 493:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2052
+		//line sql.y:2355
 		{
 		}
-	case 458:
+	case 494:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2053
+		//line sql.y:2356
 		{
 		}
-	case 459:
+	case 495:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2056
+		//line sql.y:2359
 		{
 		}
-	case 460:
+	case 496:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2059
+		//line sql.y:2362
 		{
 		}
-	case 461:
+	case 497:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2060
+		//line sql.y:2363
 		{
 		}
-	case 462:
+	case 498:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2061
+		//line sql.y:2364
 		{
 		}
-	case 463:
+	case 499:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2064
+		//line sql.y:2367
 		{
 		}
-	case 464:
+	case 500:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2065
+		//line sql.y:2368
 		{
 		}
-	case 465:
+	case 501:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2066
+		//line sql.y:2369
 		{
 		}
-	case 466:
+	case 502:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2067
+		//line sql.y:2370
 		{
 		}
-	case 467:
+	case 503:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2070
+		//line sql.y:2373
 		{
 		}
-	case 468:
+	case 504:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2071
+		//line sql.y:2374
 		{
 		}
-	case 469:
+	case 505:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2075
+		//line sql.y:2378
 		{
 			sqlVAL.stmt = Values{Tuple(sqlDollar[2].exprs)}
 		}
-	case 470:
+	case 506:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2079
+		//line sql.y:2382
 		{
 			sqlVAL.stmt = append(sqlDollar[1].stmt.(Values), Tuple(sqlDollar[3].exprs))
 		}
-	case 471:
+	case 507:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2089
+		//line sql.y:2392
 		{
 			sqlVAL.tblExprs = sqlDollar[2].tblExprs
 		}
-	case 472:
+	case 508:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2093
+		//line sql.y:2396
 		{
 			sqlVAL.tblExprs = nil
 		}
-	case 473:
+	case 509:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2099
+		//line sql.y:2402
 		{
 			sqlVAL.tblExprs = TableExprs{sqlDollar[1].tblExpr}
 		}
-	case 474:
+	case 510:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2103
+		//line sql.y:2406
 		{
 			sqlVAL.tblExprs = append(sqlDollar[1].tblExprs, sqlDollar[3].tblExpr)
 		}
-	case 475:
+	case 511:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2110
+		//line sql.y:2413
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 476:
+	case 512:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2113
+		//line sql.y:2416
 		{
 		}
-	case 477:
+	case 513:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2114
+		//line sql.y:2417
 		{
 		}
-	case 478:
+	case 514:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2116
+		//line sql.y:2419
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}, As: Name(sqlDollar[2].str)}
 		}
-	case 479:
+	case 515:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2119
+		//line sql.y:2422
 		{
 		}
-	case 481:
+	case 517:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2121
+		//line sql.y:2424
 		{
 		}
-	case 482:
+	case 518:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2139
+		//line sql.y:2442
 		{
 			sqlVAL.tblExpr = &ParenTableExpr{Expr: sqlDollar[2].tblExpr}
 		}
-	case 483:
+	case 519:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2143
+		//line sql.y:2446
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astCrossJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 484:
+	case 520:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2147
+		//line sql.y:2450
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: sqlDollar[2].str, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr, Cond: sqlDollar[5].joinCond}
 		}
-	case 485:
+	case 521:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2151
+		//line sql.y:2454
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[3].tblExpr, Cond: sqlDollar[4].joinCond}
 		}
-	case 486:
+	case 522:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2155
+		//line sql.y:2458
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[5].tblExpr}
 		}
-	case 487:
+	case 523:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2159
+		//line sql.y:2462
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 488:
+	case 524:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2164
+		//line sql.y:2467
 		{
 		}
-	case 489:
+	case 525:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2166
+		//line sql.y:2469
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 490:
+	case 526:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2169
+		//line sql.y:2472
 		{
 		}
-	case 491:
+	case 527:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2171
+		//line sql.y:2474
 		{
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 493:
+	case 529:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2178
+		//line sql.y:2481
 		{
 			sqlVAL.str = ""
 		}
-	case 494:
+	case 530:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2185
+		//line sql.y:2488
 		{
 		}
-	case 495:
+	case 531:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2186
+		//line sql.y:2489
 		{
 		}
-	case 496:
+	case 532:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2187
+		//line sql.y:2490
 		{
 		}
-	case 497:
+	case 533:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2188
+		//line sql.y:2491
 		{
 		}
-	case 498:
+	case 534:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2189
+		//line sql.y:2492
 		{
 		}
-	case 499:
+	case 535:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2193
+		//line sql.y:2496
 		{
 			sqlVAL.str = astFullJoin
 		}
-	case 500:
+	case 536:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2197
+		//line sql.y:2500
 		{
 			sqlVAL.str = astLeftJoin
 		}
-	case 501:
+	case 537:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2201
+		//line sql.y:2504
 		{
 			sqlVAL.str = astRightJoin
 		}
-	case 502:
+	case 538:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2205
+		//line sql.y:2508
 		{
 			sqlVAL.str = astInnerJoin
 		}
-	case 503:
+	case 539:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2211
+		//line sql.y:2514
 		{
 		}
-	case 504:
+	case 540:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2212
+		//line sql.y:2515
 		{
 		}
-	case 505:
+	case 541:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2223
+		//line sql.y:2526
 		{
 			sqlVAL.joinCond = &UsingJoinCond{Cols: NameList(sqlDollar[3].strs)}
 		}
-	case 506:
+	case 542:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2227
+		//line sql.y:2530
 		{
 			sqlVAL.joinCond = &OnJoinCond{Expr: sqlDollar[2].expr}
 		}
-	case 507:
+	case 543:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2233
+		//line sql.y:2536
 		{
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 508:
+	case 544:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2237
+		//line sql.y:2540
 		{
 			sqlVAL.qname = append(QualifiedName(sqlDollar[1].qname), "*")
 		}
-	case 509:
+	case 545:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2241
+		//line sql.y:2544
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 510:
+	case 546:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2246
+		//line sql.y:2549
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[3].qname
 		}
-	case 511:
+	case 547:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2253
+		//line sql.y:2556
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 512:
+	case 548:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2257
+		//line sql.y:2560
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 513:
+	case 549:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2270
+		//line sql.y:2573
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname}
 		}
-	case 514:
+	case 550:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2274
+		//line sql.y:2577
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 515:
+	case 551:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2278
+		//line sql.y:2581
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[3].str)}
 		}
-	case 516:
+	case 552:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2292
+		//line sql.y:2595
 		{
 		}
-	case 517:
+	case 553:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2293
+		//line sql.y:2596
 		{
 		}
-	case 518:
+	case 554:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2296
+		//line sql.y:2599
 		{
 		}
-	case 519:
+	case 555:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2299
+		//line sql.y:2602
 		{
 		}
-	case 520:
+	case 556:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2300
+		//line sql.y:2603
 		{
 		}
-	case 521:
+	case 557:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2303
+		//line sql.y:2606
 		{
 		}
-	case 522:
+	case 558:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2304
+		//line sql.y:2607
 		{
 		}
-	case 523:
+	case 559:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2307
+		//line sql.y:2610
 		{
 		}
-	case 524:
+	case 560:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2308
+		//line sql.y:2611
 		{
 		}
-	case 525:
+	case 561:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2312
+		//line sql.y:2615
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 526:
+	case 562:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2316
+		//line sql.y:2619
 		{
 			sqlVAL.expr = nil
 		}
-	case 527:
+	case 563:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2321
+		//line sql.y:2624
 		{
 		}
-	case 528:
+	case 564:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2322
+		//line sql.y:2625
 		{
 		}
-	case 529:
+	case 565:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2325
+		//line sql.y:2628
 		{
 		}
-	case 530:
+	case 566:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2335
+		//line sql.y:2638
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 531:
+	case 567:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2338
+		//line sql.y:2641
 		{
 		}
-	case 532:
+	case 568:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2340
+		//line sql.y:2643
 		{
 		}
-	case 533:
+	case 569:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2341
+		//line sql.y:2644
 		{
 		}
-	case 534:
+	case 570:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2342
+		//line sql.y:2645
 		{
 		}
-	case 535:
+	case 571:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2343
+		//line sql.y:2646
 		{
 		}
-	case 536:
+	case 572:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2346
+		//line sql.y:2649
 		{
 		}
-	case 537:
+	case 573:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2347
+		//line sql.y:2650
 		{
 		}
-	case 538:
+	case 574:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2348
+		//line sql.y:2651
 		{
 		}
-	case 543:
+	case 576:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2656
+		{
+			sqlVAL.colType = &IntType{Name: astSerial}
+		}
+	case 580:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2355
+		//line sql.y:2662
 		{
 		}
-	case 544:
+	case 581:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2356
+		//line sql.y:2663
 		{
 		}
-	case 545:
+	case 582:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2358
+		//line sql.y:2665
 		{
 			sqlVAL.colType = &BlobType{}
 		}
-	case 546:
+	case 583:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2362
+		//line sql.y:2669
 		{
 			sqlVAL.colType = &TextType{}
 		}
-	case 551:
+	case 588:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2383
+		//line sql.y:2690
 		{
 			sqlVAL.colType = &DecimalType{Prec: sqlDollar[2].ival}
 		}
-	case 552:
+	case 589:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2387
+		//line sql.y:2694
 		{
 			sqlVAL.colType = &DecimalType{Prec: sqlDollar[2].ival, Scale: sqlDollar[4].ival}
 		}
-	case 553:
+	case 590:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2391
+		//line sql.y:2698
 		{
 			sqlVAL.colType = &DecimalType{}
 		}
-	case 554:
+	case 591:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2398
+		//line sql.y:2705
 		{
 			sqlVAL.colType = &IntType{Name: astInt}
 		}
-	case 555:
+	case 592:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2402
+		//line sql.y:2709
 		{
 			sqlVAL.colType = &IntType{Name: astInteger}
 		}
-	case 556:
+	case 593:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2406
+		//line sql.y:2713
 		{
 			sqlVAL.colType = &IntType{Name: astSmallInt}
 		}
-	case 557:
+	case 594:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2410
+		//line sql.y:2717
 		{
 			sqlVAL.colType = &IntType{Name: astBigInt}
 		}
-	case 558:
+	case 595:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2414
+		//line sql.y:2721
 		{
 			sqlVAL.colType = &FloatType{Name: astReal}
 		}
-	case 559:
+	case 596:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2418
+		//line sql.y:2725
 		{
 			sqlVAL.colType = &FloatType{Name: astFloat, Prec: sqlDollar[2].ival}
 		}
-	case 560:
+	case 597:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2422
+		//line sql.y:2729
 		{
 			sqlVAL.colType = &FloatType{Name: astDouble}
 		}
-	case 561:
+	case 598:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2426
+		//line sql.y:2733
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astDecimal
 		}
-	case 562:
+	case 599:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2431
+		//line sql.y:2738
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astDecimal
 		}
-	case 563:
+	case 600:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2436
+		//line sql.y:2743
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astNumeric
 		}
-	case 564:
+	case 601:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2441
+		//line sql.y:2748
 		{
 			sqlVAL.colType = &BoolType{}
 		}
-	case 565:
+	case 602:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2447
+		//line sql.y:2754
 		{
 			sqlVAL.ival = sqlDollar[2].ival
 		}
-	case 566:
+	case 603:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2451
+		//line sql.y:2758
 		{
 			sqlVAL.ival = 0
 		}
-	case 571:
+	case 608:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2469
+		//line sql.y:2776
 		{
 			sqlVAL.colType = &BitType{N: sqlDollar[4].ival}
 		}
-	case 572:
+	case 609:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2475
+		//line sql.y:2782
 		{
 			sqlVAL.colType = &BitType{}
 		}
-	case 577:
+	case 614:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2491
+		//line sql.y:2798
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 			sqlVAL.colType.(*CharType).N = sqlDollar[3].ival
 		}
-	case 578:
+	case 615:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2498
+		//line sql.y:2805
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 579:
+	case 616:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2504
+		//line sql.y:2811
 		{
 			sqlVAL.colType = &CharType{Name: astChar}
 		}
-	case 580:
+	case 617:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2508
+		//line sql.y:2815
 		{
 			sqlVAL.colType = &CharType{Name: astChar}
 		}
-	case 581:
+	case 618:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2512
+		//line sql.y:2819
 		{
 			sqlVAL.colType = &CharType{Name: astVarChar}
 		}
-	case 582:
+	case 619:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2517
+		//line sql.y:2824
 		{
 		}
-	case 583:
+	case 620:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2518
+		//line sql.y:2825
 		{
 		}
-	case 584:
+	case 621:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2521
+		//line sql.y:2828
 		{
 		}
-	case 585:
+	case 622:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2522
+		//line sql.y:2829
 		{
 		}
-	case 586:
+	case 623:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2527
+		//line sql.y:2834
 		{
 			sqlVAL.colType = &DateType{}
 		}
-	case 587:
+	case 624:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2531
+		//line sql.y:2838
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 588:
+	case 625:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2535
+		//line sql.y:2842
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 589:
+	case 626:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2539
+		//line sql.y:2846
 		{
 			sqlVAL.colType = &TimeType{}
 		}
-	case 590:
+	case 627:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2543
+		//line sql.y:2850
 		{
 			sqlVAL.colType = &TimeType{}
 		}
-	case 591:
+	case 628:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2548
+		//line sql.y:2855
 		{
 		}
-	case 592:
+	case 629:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2551
+		//line sql.y:2858
 		{
 		}
-	case 593:
+	case 630:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2552
+		//line sql.y:2859
 		{
 		}
-	case 594:
+	case 631:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2553
+		//line sql.y:2860
 		{
 		}
-	case 595:
+	case 632:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2556
+		//line sql.y:2863
 		{
 		}
-	case 596:
+	case 633:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2557
+		//line sql.y:2864
 		{
 		}
-	case 597:
+	case 634:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2558
+		//line sql.y:2865
 		{
 		}
-	case 598:
+	case 635:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2559
+		//line sql.y:2866
 		{
 		}
-	case 599:
+	case 636:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2560
+		//line sql.y:2867
 		{
 		}
-	case 600:
+	case 637:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2561
+		//line sql.y:2868
 		{
 		}
-	case 601:
+	case 638:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2562
+		//line sql.y:2869
 		{
 		}
-	case 602:
+	case 639:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2563
+		//line sql.y:2870
 		{
 		}
-	case 603:
+	case 640:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2564
+		//line sql.y:2871
 		{
 		}
-	case 604:
+	case 641:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2565
+		//line sql.y:2872
 		{
 		}
-	case 605:
+	case 642:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2566
+		//line sql.y:2873
 		{
 		}
-	case 606:
+	case 643:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2567
+		//line sql.y:2874
 		{
 		}
-	case 607:
+	case 644:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2568
+		//line sql.y:2875
 		{
 		}
-	case 608:
+	case 645:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2569
+		//line sql.y:2876
 		{
 		}
-	case 609:
+	case 646:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2572
+		//line sql.y:2879
 		{
 		}
-	case 610:
+	case 647:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2573
+		//line sql.y:2880
 		{
 		}
-	case 612:
+	case 649:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2597
+		//line sql.y:2904
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 613:
+	case 650:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2600
+		//line sql.y:2907
 		{
 		}
-	case 614:
+	case 651:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2601
+		//line sql.y:2908
 		{
 		}
-	case 615:
+	case 652:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2610
+		//line sql.y:2917
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 616:
+	case 653:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2614
+		//line sql.y:2921
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 617:
+	case 654:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2618
+		//line sql.y:2925
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 618:
+	case 655:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2622
+		//line sql.y:2929
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 619:
+	case 656:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2626
+		//line sql.y:2933
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 620:
+	case 657:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2630
+		//line sql.y:2937
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 621:
+	case 658:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2634
+		//line sql.y:2941
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 622:
+	case 659:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2638
+		//line sql.y:2945
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 623:
+	case 660:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2642
+		//line sql.y:2949
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Exp, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 624:
+	case 661:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2646
+		//line sql.y:2953
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 625:
+	case 662:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2650
+		//line sql.y:2957
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 626:
+	case 663:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2654
+		//line sql.y:2961
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 627:
+	case 664:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2658
+		//line sql.y:2965
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 628:
+	case 665:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2662
+		//line sql.y:2969
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 629:
+	case 666:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2666
+		//line sql.y:2973
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 630:
+	case 667:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2670
+		//line sql.y:2977
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 631:
+	case 668:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2674
+		//line sql.y:2981
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 632:
+	case 669:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2678
+		//line sql.y:2985
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 633:
+	case 670:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2682
+		//line sql.y:2989
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 634:
+	case 671:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2686
+		//line sql.y:2993
 		{
 			sqlVAL.expr = &AndExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 635:
+	case 672:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2690
+		//line sql.y:2997
 		{
 			sqlVAL.expr = &OrExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 636:
+	case 673:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2694
+		//line sql.y:3001
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 637:
+	case 674:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2698
+		//line sql.y:3005
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 638:
+	case 675:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2702
+		//line sql.y:3009
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 639:
+	case 676:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2706
+		//line sql.y:3013
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 640:
+	case 677:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2710
+		//line sql.y:3017
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 641:
+	case 678:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2714
+		//line sql.y:3021
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 642:
+	case 679:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2717
+		//line sql.y:3024
 		{
 		}
-	case 643:
+	case 680:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2718
+		//line sql.y:3025
 		{
 		}
-	case 644:
+	case 681:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2719
+		//line sql.y:3026
 		{
 		}
-	case 645:
+	case 682:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2720
+		//line sql.y:3027
 		{
 		}
-	case 646:
+	case 683:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2722
+		//line sql.y:3029
 		{
 			sqlVAL.expr = &NullCheck{Expr: sqlDollar[1].expr}
 		}
-	case 647:
+	case 684:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2726
+		//line sql.y:3033
 		{
 			sqlVAL.expr = &NullCheck{Not: true, Expr: sqlDollar[1].expr}
 		}
-	case 648:
+	case 685:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2729
+		//line sql.y:3036
 		{
 		}
-	case 649:
+	case 686:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2730
+		//line sql.y:3037
 		{
 		}
-	case 650:
+	case 687:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2731
+		//line sql.y:3038
 		{
 		}
-	case 651:
+	case 688:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2732
+		//line sql.y:3039
 		{
 		}
-	case 652:
+	case 689:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2733
+		//line sql.y:3040
 		{
 		}
-	case 653:
+	case 690:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2734
+		//line sql.y:3041
 		{
 		}
-	case 654:
+	case 691:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2735
+		//line sql.y:3042
 		{
 		}
-	case 655:
+	case 692:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2736
+		//line sql.y:3043
 		{
 		}
-	case 656:
+	case 693:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2737
+		//line sql.y:3044
 		{
 		}
-	case 657:
+	case 694:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2738
+		//line sql.y:3045
 		{
 		}
-	case 658:
+	case 695:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2739
+		//line sql.y:3046
 		{
 		}
-	case 659:
+	case 696:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2741
+		//line sql.y:3048
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 660:
+	case 697:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2745
+		//line sql.y:3052
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 661:
+	case 698:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2749
+		//line sql.y:3056
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 662:
+	case 699:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2753
+		//line sql.y:3060
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 663:
+	case 700:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2757
+		//line sql.y:3064
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: In, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 664:
+	case 701:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2761
+		//line sql.y:3068
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotIn, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 665:
+	case 702:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2764
+		//line sql.y:3071
 		{
 		}
-	case 666:
+	case 703:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2765
+		//line sql.y:3072
 		{
 		}
-	case 667:
+	case 704:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2766
+		//line sql.y:3073
 		{
 		}
-	case 668:
+	case 705:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2767
+		//line sql.y:3074
 		{
 		}
-	case 669:
+	case 706:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2768
+		//line sql.y:3075
 		{
 		}
-	case 671:
+	case 708:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2780
+		//line sql.y:3087
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 672:
+	case 709:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2784
+		//line sql.y:3091
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 673:
+	case 710:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2788
+		//line sql.y:3095
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 674:
+	case 711:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2792
+		//line sql.y:3099
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 675:
+	case 712:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2796
+		//line sql.y:3103
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 676:
+	case 713:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2800
+		//line sql.y:3107
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 677:
+	case 714:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2804
+		//line sql.y:3111
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 678:
+	case 715:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2808
+		//line sql.y:3115
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 679:
+	case 716:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2812
+		//line sql.y:3119
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 680:
+	case 717:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2816
+		//line sql.y:3123
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Exp, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 681:
+	case 718:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2820
+		//line sql.y:3127
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 682:
+	case 719:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2824
+		//line sql.y:3131
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 683:
+	case 720:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2828
+		//line sql.y:3135
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 684:
+	case 721:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2832
+		//line sql.y:3139
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 685:
+	case 722:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2836
+		//line sql.y:3143
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 686:
+	case 723:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2840
+		//line sql.y:3147
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 687:
+	case 724:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2844
+		//line sql.y:3151
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 688:
+	case 725:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2848
+		//line sql.y:3155
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 689:
+	case 726:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2852
+		//line sql.y:3159
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 690:
+	case 727:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2856
+		//line sql.y:3163
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 691:
+	case 728:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2859
+		//line sql.y:3166
 		{
 		}
-	case 692:
+	case 729:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2860
+		//line sql.y:3167
 		{
 		}
-	case 693:
+	case 730:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2861
+		//line sql.y:3168
 		{
 		}
-	case 694:
+	case 731:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2862
+		//line sql.y:3169
 		{
 		}
-	case 695:
+	case 732:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2863
+		//line sql.y:3170
 		{
 		}
-	case 696:
+	case 733:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2864
+		//line sql.y:3171
 		{
 		}
-	case 697:
+	case 734:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2874
+		//line sql.y:3181
 		{
 			sqlVAL.expr = sqlDollar[1].qname
 		}
-	case 699:
+	case 736:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2879
+		//line sql.y:3186
 		{
 			sqlVAL.expr = ValArg(sqlDollar[1].ival)
 		}
-	case 700:
+	case 737:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2883
+		//line sql.y:3190
 		{
 			sqlVAL.expr = &ParenExpr{Expr: sqlDollar[2].expr}
 		}
-	case 703:
+	case 740:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2889
+		//line sql.y:3196
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 704:
+	case 741:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2893
+		//line sql.y:3200
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 705:
+	case 742:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2897
+		//line sql.y:3204
 		{
 			sqlVAL.expr = &ExistsExpr{Subquery: &Subquery{Select: sqlDollar[2].stmt.(SelectStatement)}}
 		}
-	case 706:
+	case 743:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2900
+		//line sql.y:3207
 		{
 		}
-	case 707:
+	case 744:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2901
+		//line sql.y:3208
 		{
 		}
-	case 708:
+	case 745:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2903
+		//line sql.y:3210
 		{
 			sqlVAL.expr = Tuple(sqlDollar[1].exprs)
 		}
-	case 709:
+	case 746:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2907
+		//line sql.y:3214
 		{
 			sqlVAL.expr = Tuple(sqlDollar[1].exprs)
 		}
-	case 710:
+	case 747:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2910
+		//line sql.y:3217
 		{
 		}
-	case 711:
+	case 748:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2914
+		//line sql.y:3221
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname}
 		}
-	case 712:
+	case 749:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2918
+		//line sql.y:3225
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: sqlDollar[3].exprs}
 		}
-	case 713:
+	case 750:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2923
+		//line sql.y:3230
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 714:
+	case 751:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2927
+		//line sql.y:3234
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 715:
+	case 752:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2931
+		//line sql.y:3238
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 716:
+	case 753:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2935
+		//line sql.y:3242
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Distinct: true, Exprs: sqlDollar[4].exprs}
 		}
-	case 717:
+	case 754:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2940
+		//line sql.y:3247
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: Exprs{QualifiedName{"*"}}}
 		}
-	case 718:
+	case 755:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2953
+		//line sql.y:3260
 		{
 			// TODO(pmattis): Support within_group_clause, filter_clause and
 			// over_clause?
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 719:
+	case 756:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2959
+		//line sql.y:3266
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 720:
+	case 757:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2968
+		//line sql.y:3275
 		{
 		}
-	case 721:
+	case 758:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2969
+		//line sql.y:3276
 		{
 		}
-	case 722:
+	case 759:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2973
+		//line sql.y:3280
 		{
 		}
-	case 723:
+	case 760:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2974
+		//line sql.y:3281
 		{
 		}
-	case 724:
+	case 761:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2975
+		//line sql.y:3282
 		{
 		}
-	case 725:
+	case 762:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2976
+		//line sql.y:3283
 		{
 		}
-	case 726:
+	case 763:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2977
+		//line sql.y:3284
 		{
 		}
-	case 727:
+	case 764:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2978
+		//line sql.y:3285
 		{
 		}
-	case 728:
+	case 765:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2979
+		//line sql.y:3286
 		{
 		}
-	case 729:
+	case 766:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2980
+		//line sql.y:3287
 		{
 		}
-	case 730:
+	case 767:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2981
+		//line sql.y:3288
 		{
 		}
-	case 731:
+	case 768:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2982
+		//line sql.y:3289
 		{
 		}
-	case 732:
+	case 769:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2983
+		//line sql.y:3290
 		{
 		}
-	case 733:
+	case 770:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2984
+		//line sql.y:3291
 		{
 		}
-	case 734:
+	case 771:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2985
+		//line sql.y:3292
 		{
 		}
-	case 735:
+	case 772:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2986
+		//line sql.y:3293
 		{
 		}
-	case 736:
+	case 773:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2987
+		//line sql.y:3294
 		{
 		}
-	case 737:
+	case 774:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2988
+		//line sql.y:3295
 		{
 		}
-	case 738:
+	case 775:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2990
+		//line sql.y:3297
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[3].expr, Type: sqlDollar[5].colType}
 		}
-	case 739:
+	case 776:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2993
+		//line sql.y:3300
 		{
 		}
-	case 740:
+	case 777:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2994
+		//line sql.y:3301
 		{
 		}
-	case 741:
+	case 778:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2995
+		//line sql.y:3302
 		{
 		}
-	case 742:
+	case 779:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2996
+		//line sql.y:3303
 		{
 		}
-	case 743:
+	case 780:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2997
+		//line sql.y:3304
 		{
 		}
-	case 744:
+	case 781:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2998
+		//line sql.y:3305
 		{
 		}
-	case 745:
+	case 782:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2999
+		//line sql.y:3306
 		{
 		}
-	case 746:
+	case 783:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3000
+		//line sql.y:3307
 		{
 		}
-	case 747:
+	case 784:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3001
+		//line sql.y:3308
 		{
 		}
-	case 748:
+	case 785:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3002
+		//line sql.y:3309
 		{
 		}
-	case 749:
+	case 786:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3003
+		//line sql.y:3310
 		{
 		}
-	case 750:
+	case 787:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3004
+		//line sql.y:3311
 		{
 		}
-	case 751:
+	case 788:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3005
+		//line sql.y:3312
 		{
 		}
-	case 752:
+	case 789:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3009
+		//line sql.y:3316
 		{
 		}
-	case 753:
+	case 790:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3010
+		//line sql.y:3317
 		{
 		}
-	case 754:
+	case 791:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3013
+		//line sql.y:3320
 		{
 		}
-	case 755:
+	case 792:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3014
+		//line sql.y:3321
 		{
 		}
-	case 756:
+	case 793:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3018
+		//line sql.y:3325
 		{
 		}
-	case 757:
+	case 794:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3019
+		//line sql.y:3326
 		{
 		}
-	case 758:
+	case 795:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3022
+		//line sql.y:3329
 		{
 		}
-	case 759:
+	case 796:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3023
+		//line sql.y:3330
 		{
 		}
-	case 760:
+	case 797:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3026
+		//line sql.y:3333
 		{
 		}
-	case 761:
+	case 798:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3029
+		//line sql.y:3336
 		{
 		}
-	case 762:
+	case 799:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3030
+		//line sql.y:3337
 		{
 		}
-	case 763:
+	case 800:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3031
+		//line sql.y:3338
 		{
 		}
-	case 764:
+	case 801:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3035
+		//line sql.y:3342
 		{
 		}
-	case 765:
+	case 802:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3046
+		//line sql.y:3353
 		{
 		}
-	case 766:
+	case 803:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3047
+		//line sql.y:3354
 		{
 		}
-	case 767:
+	case 804:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3050
+		//line sql.y:3357
 		{
 		}
-	case 768:
+	case 805:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3051
+		//line sql.y:3358
 		{
 		}
-	case 769:
+	case 806:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3059
+		//line sql.y:3366
 		{
 		}
-	case 770:
+	case 807:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3060
+		//line sql.y:3367
 		{
 		}
-	case 771:
+	case 808:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3061
+		//line sql.y:3368
 		{
 		}
-	case 772:
+	case 809:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3064
+		//line sql.y:3371
 		{
 		}
-	case 773:
+	case 810:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3065
+		//line sql.y:3372
 		{
 		}
-	case 774:
+	case 811:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3071
+		//line sql.y:3378
 		{
 		}
-	case 775:
+	case 812:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3072
+		//line sql.y:3379
 		{
 		}
-	case 776:
+	case 813:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3073
+		//line sql.y:3380
 		{
 		}
-	case 777:
+	case 814:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3074
+		//line sql.y:3381
 		{
 		}
-	case 778:
+	case 815:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3075
+		//line sql.y:3382
 		{
 		}
-	case 779:
+	case 816:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3086
+		//line sql.y:3393
 		{
 			sqlVAL.exprs = sqlDollar[3].exprs
 		}
-	case 780:
+	case 817:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3090
+		//line sql.y:3397
 		{
 			sqlVAL.exprs = nil
 		}
-	case 781:
+	case 818:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3094
+		//line sql.y:3401
 		{
 			sqlVAL.exprs = append(sqlDollar[2].exprs, sqlDollar[4].expr)
 		}
-	case 782:
+	case 819:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3100
+		//line sql.y:3407
 		{
 			sqlVAL.exprs = sqlDollar[3].exprs
 		}
-	case 783:
+	case 820:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3104
+		//line sql.y:3411
 		{
 			sqlVAL.exprs = nil
 		}
-	case 784:
+	case 821:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3110
+		//line sql.y:3417
 		{
 			sqlVAL.exprs = append(sqlDollar[2].exprs, sqlDollar[4].expr)
 		}
-	case 785:
+	case 822:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3115
+		//line sql.y:3422
 		{
 		}
-	case 786:
+	case 823:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3116
+		//line sql.y:3423
 		{
 		}
-	case 787:
+	case 824:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3117
+		//line sql.y:3424
 		{
 		}
-	case 788:
+	case 825:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3120
+		//line sql.y:3427
 		{
 		}
-	case 789:
+	case 826:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3121
+		//line sql.y:3428
 		{
 		}
-	case 790:
+	case 827:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3122
+		//line sql.y:3429
 		{
 		}
-	case 791:
+	case 828:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3123
+		//line sql.y:3430
 		{
 		}
-	case 792:
+	case 829:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3124
+		//line sql.y:3431
 		{
 		}
-	case 793:
+	case 830:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3125
+		//line sql.y:3432
 		{
 		}
-	case 794:
+	case 831:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3126
+		//line sql.y:3433
 		{
 		}
-	case 795:
+	case 832:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3127
+		//line sql.y:3434
 		{
 		}
-	case 796:
+	case 833:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3128
+		//line sql.y:3435
 		{
 		}
-	case 797:
+	case 834:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3129
+		//line sql.y:3436
 		{
 		}
-	case 798:
+	case 835:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3130
+		//line sql.y:3437
 		{
 		}
-	case 799:
+	case 836:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3131
+		//line sql.y:3438
 		{
 		}
-	case 800:
+	case 837:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3132
+		//line sql.y:3439
 		{
 		}
-	case 801:
+	case 838:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3133
+		//line sql.y:3440
 		{
 		}
-	case 802:
+	case 839:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3134
+		//line sql.y:3441
 		{
 		}
-	case 803:
+	case 840:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3135
+		//line sql.y:3442
 		{
 		}
-	case 804:
+	case 841:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3138
+		//line sql.y:3445
 		{
 		}
-	case 805:
+	case 842:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3139
+		//line sql.y:3446
 		{
 		}
-	case 806:
+	case 843:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3140
+		//line sql.y:3447
 		{
 		}
-	case 807:
+	case 844:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3151
+		//line sql.y:3458
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 808:
+	case 845:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3155
+		//line sql.y:3462
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 809:
+	case 846:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3160
+		//line sql.y:3467
 		{
 		}
-	case 810:
+	case 847:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3161
+		//line sql.y:3468
 		{
 		}
-	case 811:
+	case 848:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3164
+		//line sql.y:3471
 		{
 		}
-	case 812:
+	case 849:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3165
+		//line sql.y:3472
 		{
 		}
-	case 813:
+	case 850:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3166
+		//line sql.y:3473
 		{
 		}
-	case 814:
+	case 851:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3169
+		//line sql.y:3476
 		{
 		}
-	case 815:
+	case 852:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3170
+		//line sql.y:3477
 		{
 		}
-	case 816:
+	case 853:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3173
+		//line sql.y:3480
 		{
 		}
-	case 817:
+	case 854:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3174
+		//line sql.y:3481
 		{
 		}
-	case 818:
+	case 855:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3179
+		//line sql.y:3486
 		{
 		}
-	case 819:
+	case 856:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3180
+		//line sql.y:3487
 		{
 		}
-	case 820:
+	case 857:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3181
+		//line sql.y:3488
 		{
 		}
-	case 821:
+	case 858:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3182
+		//line sql.y:3489
 		{
 		}
-	case 822:
+	case 859:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3183
+		//line sql.y:3490
 		{
 		}
-	case 823:
+	case 860:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3184
+		//line sql.y:3491
 		{
 		}
-	case 824:
+	case 861:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3185
+		//line sql.y:3492
 		{
 		}
-	case 825:
+	case 862:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3186
+		//line sql.y:3493
 		{
 		}
-	case 826:
+	case 863:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3194
+		//line sql.y:3501
 		{
 		}
-	case 827:
+	case 864:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3195
+		//line sql.y:3502
 		{
 		}
-	case 828:
+	case 865:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3198
+		//line sql.y:3505
 		{
 		}
-	case 829:
+	case 866:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3202
+		//line sql.y:3509
 		{
 		}
-	case 830:
+	case 867:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3203
+		//line sql.y:3510
 		{
 		}
-	case 831:
+	case 868:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3217
+		//line sql.y:3524
 		{
 		}
-	case 832:
+	case 869:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3218
+		//line sql.y:3525
 		{
 		}
-	case 833:
+	case 870:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3219
+		//line sql.y:3526
 		{
 		}
-	case 834:
+	case 871:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3220
+		//line sql.y:3527
 		{
 		}
-	case 835:
+	case 872:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3221
+		//line sql.y:3528
 		{
 		}
-	case 836:
+	case 873:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3222
+		//line sql.y:3529
 		{
 		}
-	case 837:
+	case 874:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3225
+		//line sql.y:3532
 		{
 		}
-	case 838:
+	case 875:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3228
+		//line sql.y:3535
 		{
 		}
-	case 839:
+	case 876:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3231
+		//line sql.y:3538
 		{
 		}
-	case 840:
+	case 877:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3232
+		//line sql.y:3539
 		{
 		}
-	case 841:
+	case 878:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3233
+		//line sql.y:3540
 		{
 		}
-	case 842:
+	case 879:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3237
+		//line sql.y:3544
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 843:
+	case 880:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3241
+		//line sql.y:3548
 		{
 			sqlVAL.expr = Tuple(sqlDollar[2].exprs)
 		}
-	case 844:
+	case 881:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3252
+		//line sql.y:3559
 		{
 			sqlVAL.expr = &CaseExpr{Expr: sqlDollar[2].expr, Whens: sqlDollar[3].whens, Else: sqlDollar[4].expr}
 		}
-	case 845:
+	case 882:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3259
+		//line sql.y:3566
 		{
 			sqlVAL.whens = []*When{sqlDollar[1].when}
 		}
-	case 846:
+	case 883:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3263
+		//line sql.y:3570
 		{
 			sqlVAL.whens = append(sqlDollar[1].whens, sqlDollar[2].when)
 		}
-	case 847:
+	case 884:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3269
+		//line sql.y:3576
 		{
 			sqlVAL.when = &When{Cond: sqlDollar[2].expr, Val: sqlDollar[4].expr}
 		}
-	case 848:
+	case 885:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3275
+		//line sql.y:3582
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 849:
+	case 886:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3279
+		//line sql.y:3586
 		{
 			sqlVAL.expr = nil
 		}
-	case 851:
+	case 888:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3286
+		//line sql.y:3593
 		{
 			sqlVAL.expr = nil
 		}
-	case 852:
+	case 889:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3292
+		//line sql.y:3599
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 853:
+	case 890:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3296
+		//line sql.y:3603
 		{
 			sqlVAL.str = "*"
 		}
-	case 854:
+	case 891:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3300
+		//line sql.y:3607
 		{
 			sqlVAL.str = ""
 		}
-	case 855:
+	case 892:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3304
+		//line sql.y:3611
 		{
 			sqlVAL.str = ""
 		}
-	case 856:
+	case 893:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3310
+		//line sql.y:3617
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 857:
+	case 894:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3314
+		//line sql.y:3621
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[2].str)
 		}
-	case 858:
+	case 895:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3320
+		//line sql.y:3627
 		{
 			sqlVAL.strs = nil
 		}
-	case 859:
+	case 896:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3324
+		//line sql.y:3631
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[2].str)
 		}
-	case 860:
+	case 897:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3329
+		//line sql.y:3636
 		{
 		}
-	case 861:
+	case 898:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3330
+		//line sql.y:3637
 		{
 		}
-	case 863:
+	case 900:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3339
+		//line sql.y:3646
 		{
 			sqlVAL.expr = nil
 		}
-	case 864:
+	case 901:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3345
+		//line sql.y:3652
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 865:
+	case 902:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3349
+		//line sql.y:3656
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 866:
+	case 903:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3358
+		//line sql.y:3665
 		{
 			sqlVAL.exprs = sqlDollar[2].exprs
 		}
-	case 868:
+	case 905:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3366
+		//line sql.y:3673
 		{
 			sqlVAL.selExprs = nil
 		}
-	case 869:
+	case 906:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3372
+		//line sql.y:3679
 		{
 			sqlVAL.selExprs = SelectExprs{sqlDollar[1].selExpr}
 		}
-	case 870:
+	case 907:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3376
+		//line sql.y:3683
 		{
 			sqlVAL.selExprs = append(sqlDollar[1].selExprs, sqlDollar[3].selExpr)
 		}
-	case 871:
+	case 908:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3382
+		//line sql.y:3689
 		{
 			sqlVAL.selExpr = &NonStarExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[3].str)}
 		}
-	case 872:
+	case 909:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3391
+		//line sql.y:3698
 		{
 			sqlVAL.selExpr = &NonStarExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[2].str)}
 		}
-	case 873:
+	case 910:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3395
+		//line sql.y:3702
 		{
 			sqlVAL.selExpr = &NonStarExpr{Expr: sqlDollar[1].expr}
 		}
-	case 874:
+	case 911:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3399
+		//line sql.y:3706
 		{
 			sqlVAL.selExpr = &StarExpr{}
 		}
-	case 875:
+	case 912:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3407
+		//line sql.y:3714
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 876:
+	case 913:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3411
+		//line sql.y:3718
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 877:
+	case 914:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3422
+		//line sql.y:3729
 		{
 			sqlVAL.qname = []string{sqlDollar[1].str}
 		}
-	case 878:
+	case 915:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3426
+		//line sql.y:3733
 		{
 			sqlVAL.qname = append([]string{sqlDollar[1].str}, sqlDollar[2].strs...)
 		}
-	case 879:
+	case 916:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3432
+		//line sql.y:3739
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 880:
+	case 917:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3436
+		//line sql.y:3743
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 881:
+	case 918:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3442
+		//line sql.y:3749
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 882:
+	case 919:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3445
+		//line sql.y:3752
 		{
 		}
-	case 883:
+	case 920:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3455
+		//line sql.y:3762
 		{
 			sqlVAL.qname = QualifiedName{sqlDollar[1].str}
 		}
-	case 884:
+	case 921:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3459
+		//line sql.y:3766
 		{
 			sqlVAL.qname = QualifiedName(append([]string{sqlDollar[1].str}, sqlDollar[2].strs...))
 		}
-	case 885:
+	case 922:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3466
+		//line sql.y:3773
 		{
 			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
-	case 886:
+	case 923:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3470
+		//line sql.y:3777
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 887:
+	case 924:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3474
+		//line sql.y:3781
 		{
 			// TODO(pmattis): string literal
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 888:
+	case 925:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3479
+		//line sql.y:3786
 		{
 			// TODO(pmattis): bit literal.
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 889:
+	case 926:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3484
+		//line sql.y:3791
 		{
 			// TODO(pmattis): hex literal.
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 890:
+	case 927:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3488
+		//line sql.y:3795
 		{
 		}
-	case 891:
+	case 928:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3489
+		//line sql.y:3796
 		{
 		}
-	case 892:
+	case 929:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3490
+		//line sql.y:3797
 		{
 		}
-	case 893:
+	case 930:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3491
+		//line sql.y:3798
 		{
 		}
-	case 894:
+	case 931:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3492
+		//line sql.y:3799
 		{
 		}
-	case 895:
+	case 932:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3494
+		//line sql.y:3801
 		{
 			sqlVAL.expr = BoolVal(true)
 		}
-	case 896:
+	case 933:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3498
+		//line sql.y:3805
 		{
 			sqlVAL.expr = BoolVal(false)
 		}
-	case 897:
+	case 934:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3502
+		//line sql.y:3809
 		{
 			sqlVAL.expr = NullVal{}
 		}
-	case 899:
+	case 936:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3509
+		//line sql.y:3816
 		{
 			sqlVAL.ival = +sqlDollar[2].ival
 		}
-	case 900:
+	case 937:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3513
+		//line sql.y:3820
 		{
 			sqlVAL.ival = -sqlDollar[2].ival
 		}
-	case 905:
+	case 942:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3540
+		//line sql.y:3847
 		{
 			sqlVAL.str = ""
 		}
@@ -21,7 +21,11 @@
 %{
 package parser
 
-import "strings"
+import (
+  "strings"
+
+  "github.com/cockroachdb/cockroach/sql/privilege"
+)
 %}
 
 %union {
@@ -52,6 +56,16 @@ import "strings"
   updateExpr     *UpdateExpr
   updateExprs    []*UpdateExpr
   limit          *Limit
+  targetList     TargetList
+  privilegeType  privilege.Kind
+  privilegeList  privilege.List
+  refAction      ReferenceAction
+  refActions     ReferenceActions
+  alterCmd       AlterTableCmd
+  alterCmds      AlterTableCmds
+  boolVal        bool
+  orderBy        OrderBy
+  order          *Order
 }
 
 %type <stmts> stmt_block
@@ -63,19 +77,24 @@ import "strings"
 %type <stmt> alter_index_stmt
 %type <stmt> alter_table_stmt
 // %type <stmt> alter_view_stmt
+%type <stmt> cancel_stmt
 %type <stmt> create_stmt
 %type <stmt> create_database_stmt
 %type <stmt> create_index_stmt
 // %type <stmt> create_schema_stmt
+%type <stmt> create_sequence_stmt
 %type <stmt> create_table_stmt
 %type <stmt> create_table_as_stmt
-// %type <stmt> create_view_stmt
+%type <stmt> create_view_stmt
 %type <stmt> delete_stmt
 %type <stmt> drop_stmt
 %type <stmt> explain_stmt
 %type <stmt> explainable_stmt
+%type <stmt> grant_stmt
+%type <stmt> import_stmt
 %type <stmt> insert_stmt
 %type <stmt> preparable_stmt
+%type <stmt> revoke_stmt
 %type <stmt> rename_stmt
 %type <stmt> select_stmt
 %type <stmt> set_stmt
@@ -88,10 +107,12 @@ import "strings"
 %type <stmt> simple_select values_clause
 
 %type <empty> alter_column_default alter_using
-%type <empty> opt_asc_desc opt_nulls_order
+%type <str> opt_asc_desc
+%type <empty> opt_nulls_order
 
-%type <empty> alter_table_cmd opt_collate_clause
-%type <empty> alter_table_cmds
+%type <alterCmd> alter_table_cmd
+%type <alterCmds> alter_table_cmds
+%type <empty> opt_collate_clause
 
 %type <empty> opt_drop_behavior
 
@@ -126,8 +147,13 @@ import "strings"
 %type <empty> reloptions opt_reloptions
 %type <empty> opt_with distinct_clause opt_all_clause
 %type <strs> opt_column_list
-%type <empty> sort_clause opt_sort_clause sortby_list index_params
+%type <orderBy> sort_clause opt_sort_clause sortby_list
+%type <empty> index_params
 %type <strs> name_list opt_name_list
+%type <strs> grantee_list for_grantee_clause
+%type <privilegeList> privileges privilege_list
+%type <privilegeType> privilege
+%type <targetList> privilege_target
 %type <empty> opt_array_bounds
 %type <tblExprs> from_clause from_list
 %type <qnames> qualified_name_list
@@ -163,7 +189,7 @@ import "strings"
 %type <empty> for_locking_item
 %type <empty> for_locking_clause opt_for_locking_clause for_locking_items
 %type <empty> locked_rels_list
-%type <empty> all_or_distinct
+%type <boolVal> all_or_distinct
 
 %type <empty> join_outer
 %type <joinCond> join_qual
@@ -216,7 +242,7 @@ import "strings"
 %type <expr> numeric_only
 %type <str> alias_clause opt_alias_clause
 %type <empty> func_alias_clause
-%type <empty> sortby
+%type <order> sortby
 %type <empty> index_elem
 %type <tblExpr> table_ref
 %type <tblExpr> joined_table
@@ -251,7 +277,7 @@ import "strings"
 %type <expr>  non_reserved_word_or_sconst
 %type <empty> createdb_opt_name
 %type <expr>  var_value
-%type <empty> zone_value
+%type <expr>  zone_value
 // %type <empty> role_spec
 
 %type <str>   unreserved_keyword type_func_name_keyword
@@ -261,7 +287,9 @@ import "strings"
 %type <empty> table_like_option_list table_like_option
 %type <colConstraints> col_qual_list
 %type <colConstraint> col_constraint col_constraint_elem
-%type <empty> key_actions key_delete key_match key_update key_action
+%type <empty> key_match
+%type <refActions> key_actions
+%type <refAction> key_delete key_update key_action
 %type <empty> existing_index
 
 // %type <empty> opt_check_option
@@ -305,7 +333,7 @@ import "strings"
 %token <str>   BACKWARD BEFORE BEGIN BETWEEN BIGINT BINARY BIT
 %token <str>   BLOB BOOLEAN BOTH BY
 
-%token <str>   CACHE CALLED CASCADE CASCADED CASE CAST CATALOG CHAIN CHAR
+%token <str>   CACHE CALLED CANCEL CASCADE CASCADED CASE CAST CATALOG CHAIN CHAR
 %token <str>   CHARACTER CHARACTERISTICS CHECK CHECKPOINT CLASS CLOSE
 %token <str>   CLUSTER COALESCE COLLATE COLLATION COLUMN COLUMNS COMMENT COMMENTS COMMIT
 %token <str>   COMMITTED CONCAT CONCURRENTLY CONFIGURATION CONFLICT CONNECTION CONSTRAINT
@@ -324,7 +352,7 @@ import "strings"
 %token <str>   FALSE FAMILY FETCH FILTER FIRST FLOAT FOLLOWING FOR
 %token <str>   FORCE FOREIGN FORWARD FREEZE FROM FULL FUNCTION FUNCTIONS
 
-%token <str>   GLOBAL GRANT GRANTED GREATEST GROUP GROUPING
+%token <str>   GLOBAL GRANT GRANTED GRANTS GREATEST GROUP GROUPING
 
 %token <str>   HANDLER HAVING HEADER HOLD HOUR
 
@@ -354,7 +382,7 @@ import "strings"
 %token <str>   PRECEDING PRECISION PRESERVE PREPARE PREPARED PRIMARY
 %token <str>   PRIOR PRIVILEGES PROCEDURAL PROCEDURE PROGRAM
 
-%token <str>   QUOTE
+%token <str>   QUERY QUOTE
 
 %token <str>   RANGE READ REAL REASSIGN RECHECK RECURSIVE REF REFERENCES REFRESH REINDEX
 %token <str>   RELATIVE RELEASE RENAME REPEATABLE REPLACE REPLICA RESET RESTART
@@ -362,7 +390,7 @@ import "strings"
 %token <str>   ROW ROWS RULE
 
 %token <str>   SAVEPOINT SCHEMA SCROLL SEARCH SECOND SECURITY SELECT SEQUENCE SEQUENCES
-%token <str>   SERIALIZABLE SERVER SESSION SESSION_USER SET SETS SETOF SHARE SHOW
+%token <str>   SERIAL SERIALIZABLE SERVER SESSION SESSION_USER SET SETS SETOF SHARE SHOW
 %token <str>   SIMILAR SIMPLE SKIP SMALLINT SNAPSHOT SOME SQL STABLE STANDALONE START
 %token <str>   STATEMENT STATISTICS STDIN STDOUT STORAGE STRICT STRIP SUBSTRING
 %token <str>   SYMMETRIC SYSID SYSTEM
@@ -477,12 +505,16 @@ stmt_list:
 
 stmt:
   alter_stmt
+| cancel_stmt
 | create_stmt
 | delete_stmt
 | drop_stmt
 | explain_stmt
+| grant_stmt
+| import_stmt
 | insert_stmt
 | rename_stmt
+| revoke_stmt
 | select_stmt
 | set_stmt
 | show_stmt
@@ -494,6 +526,20 @@ stmt:
     $$ = nil
   }
 
+// CANCEL QUERY query_id
+cancel_stmt:
+  CANCEL QUERY a_expr
+  {
+    $$ = &CancelQuery{ID: $3}
+  }
+
+// IMPORT INTO table CSV file
+import_stmt:
+  IMPORT INTO qualified_name CSV a_expr
+  {
+    $$ = &Import{Table: $3, Format: "CSV", File: $5}
+  }
+
 // ALTER [DATABASE|INDEX|TABLE|VIEW]
 alter_stmt:
   alter_database_stmt
@@ -528,11 +574,11 @@ alter_index_stmt:
 alter_table_stmt:
   ALTER TABLE relation_expr alter_table_cmds
   {
-    $$ = nil
+    $$ = newAlterTable($3, $4, false)
   }
 | ALTER TABLE IF EXISTS relation_expr alter_table_cmds
   {
-    $$ = nil
+    $$ = newAlterTable($5, $6, true)
   }
 // | ALTER TABLE relation_expr SET SCHEMA name
 //   {
@@ -563,60 +609,69 @@ alter_table_stmt:
 
 alter_table_cmds:
   alter_table_cmd
+  {
+    $$ = AlterTableCmds{$1}
+  }
 | alter_table_cmds ',' alter_table_cmd
+  {
+    $$ = append($1, $3)
+  }
 
 alter_table_cmd:
   // ALTER TABLE <name> ADD <coldef>
-  ADD column_def {}
+  ADD column_def { $$ = nil }
   // ALTER TABLE <name> ADD COLUMN <coldef>
-| ADD COLUMN column_def {}
+| ADD COLUMN column_def { $$ = nil }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> {SET DEFAULT <expr>|DROP DEFAULT}
-| ALTER opt_column name alter_column_default {}
+| ALTER opt_column name alter_column_default { $$ = nil }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> DROP NOT NULL
-| ALTER opt_column name DROP NOT NULL {}
+| ALTER opt_column name DROP NOT NULL { $$ = nil }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET NOT NULL
-| ALTER opt_column name SET NOT NULL {}
+| ALTER opt_column name SET NOT NULL { $$ = nil }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET STATISTICS <signed_iconst>
-| ALTER opt_column name SET STATISTICS signed_iconst {}
+| ALTER opt_column name SET STATISTICS signed_iconst { $$ = nil }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET ( column_parameter = value [, ... ] )
-| ALTER opt_column name SET reloptions {}
+| ALTER opt_column name SET reloptions { $$ = nil }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET ( column_parameter = value [, ... ] )
-| ALTER opt_column name RESET reloptions {}
+| ALTER opt_column name RESET reloptions { $$ = nil }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET STORAGE <storagemode>
-| ALTER opt_column name SET STORAGE name {}
+| ALTER opt_column name SET STORAGE name { $$ = nil }
   // ALTER TABLE <name> DROP [COLUMN] IF EXISTS <colname> [RESTRICT|CASCADE]
-| DROP opt_column IF EXISTS name opt_drop_behavior {}
+| DROP opt_column IF EXISTS name opt_drop_behavior { $$ = nil }
   // ALTER TABLE <name> DROP [COLUMN] <colname> [RESTRICT|CASCADE]
-| DROP opt_column name opt_drop_behavior {}
+| DROP opt_column name opt_drop_behavior { $$ = nil }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> [SET DATA] TYPE <typename>
   //     [ USING <expression> ]
-| ALTER opt_column name opt_set_data TYPE typename opt_collate_clause alter_using {}
+| ALTER opt_column name opt_set_data TYPE typename opt_collate_clause alter_using { $$ = nil }
   // ALTER TABLE <name> ADD CONSTRAINT ...
-| ADD table_constraint {}
+| ADD table_constraint
+  {
+    $$ = &AlterTableAddConstraint{ConstraintDef: $2}
+  }
   // ALTER TABLE <name> ALTER CONSTRAINT ...
-| ALTER CONSTRAINT name {}
+| ALTER CONSTRAINT name { $$ = nil }
   // ALTER TABLE <name> VALIDATE CONSTRAINT ...
-| VALIDATE CONSTRAINT name {}
+| VALIDATE CONSTRAINT name { $$ = nil }
   // ALTER TABLE <name> DROP CONSTRAINT IF EXISTS <name> [RESTRICT|CASCADE]
-| DROP CONSTRAINT IF EXISTS name opt_drop_behavior {}
+| DROP CONSTRAINT IF EXISTS name opt_drop_behavior { $$ = nil }
   // ALTER TABLE <name> DROP CONSTRAINT <name> [RESTRICT|CASCADE]
-| DROP CONSTRAINT name opt_drop_behavior {}
+| DROP CONSTRAINT name opt_drop_behavior { $$ = nil }
   // ALTER TABLE <name> SET LOGGED 
-| SET LOGGED {}
+| SET LOGGED { $$ = nil }
   // ALTER TABLE <name> SET UNLOGGED 
-| SET UNLOGGED {}
+| SET UNLOGGED { $$ = nil }
   // ALTER TABLE <name> INHERIT <parent>
-| INHERIT qualified_name {}
+| INHERIT qualified_name { $$ = nil }
   // ALTER TABLE <name> NO INHERIT <parent>
-| NO INHERIT qualified_name {}
+| NO INHERIT qualified_name { $$ = nil }
   // ALTER TABLE <name> OF <type_name>
-| OF any_name {}
+| OF any_name { $$ = nil }
   // ALTER TABLE <name> NOT OF
-| NOT OF {}
+| NOT OF { $$ = nil }
   // ALTER TABLE <name> SET (...)
-| SET reloptions {}
+| SET reloptions { $$ = nil }
   // ALTER TABLE <name> RESET (...)
-| RESET reloptions {}
+| RESET reloptions { $$ = nil }
 
 alter_column_default:
   SET DEFAULT a_expr {}
@@ -656,14 +711,15 @@ reloption_elem:
 | col_label '.' col_label '=' def_arg {}
 | col_label '.' col_label {}
 
-// CREATE [DATABASE|INDEX|SCHEMA|TABLE|TABLE AS|VIEW]
+// CREATE [DATABASE|INDEX|SCHEMA|SEQUENCE|TABLE|TABLE AS|VIEW]
 create_stmt:
   create_database_stmt
 | create_index_stmt
 // | create_schema_stmt
+| create_sequence_stmt
 | create_table_stmt
 | create_table_as_stmt
-// | create_view_stmt
+| create_view_stmt
 
 // DELETE FROM query
 delete_stmt:
@@ -699,6 +755,14 @@ drop_stmt:
   {
     $$ = &DropTable{Names: $5, IfExists: true}
   }
+| DROP VIEW any_name_list
+  {
+    $$ = &DropView{Names: $3, IfExists: false}
+  }
+| DROP VIEW IF EXISTS any_name_list
+  {
+    $$ = &DropView{Names: $5, IfExists: true}
+  }
 
 drop_type:
   INDEX {}
@@ -807,6 +871,97 @@ explain_option_arg:
 // | create_index_stmt {}
 // | create_view_stmt {}
 
+// GRANT privileges ON privilege_target TO grantee_list
+grant_stmt:
+  GRANT privileges ON privilege_target TO grantee_list
+  {
+    $$ = &Grant{Privileges: $2, Grantees: NameList($6), Targets: $4}
+  }
+
+// REVOKE privileges ON privilege_target FROM grantee_list
+revoke_stmt:
+  REVOKE privileges ON privilege_target FROM grantee_list
+  {
+    $$ = &Revoke{Privileges: $2, Grantees: NameList($6), Targets: $4}
+  }
+
+privilege_target:
+  qualified_name_list
+  {
+    $$ = TargetList{Tables: QualifiedNames($1)}
+  }
+| TABLE qualified_name_list
+  {
+    $$ = TargetList{Tables: QualifiedNames($2)}
+  }
+| DATABASE name_list
+  {
+    $$ = TargetList{Databases: NameList($2)}
+  }
+
+// ALL is always by itself.
+privileges:
+  ALL opt_privileges
+  {
+    $$ = privilege.List{privilege.ALL}
+  }
+| privilege_list
+  {
+    $$ = $1
+  }
+
+opt_privileges:
+  PRIVILEGES {}
+| /* EMPTY */ {}
+
+privilege_list:
+  privilege
+  {
+    $$ = privilege.List{$1}
+  }
+| privilege_list ',' privilege
+  {
+    $$ = append($1, $3)
+  }
+
+// This list must match the list of privileges in sql/privilege/privilege.go.
+privilege:
+  CREATE
+  {
+    $$ = privilege.CREATE
+  }
+| DROP
+  {
+    $$ = privilege.DROP
+  }
+| GRANT
+  {
+    $$ = privilege.GRANT
+  }
+| SELECT
+  {
+    $$ = privilege.SELECT
+  }
+| INSERT
+  {
+    $$ = privilege.INSERT
+  }
+| DELETE
+  {
+    $$ = privilege.DELETE
+  }
+| UPDATE
+  {
+    $$ = privilege.UPDATE
+  }
+
+// Grantees are plain user names as there are no roles.
+grantee_list:
+  name_list
+  {
+    $$ = $1
+  }
+
 // SET name TO 'var_value'
 // SET TIME ZONE 'var_value'
 set_stmt:
@@ -851,7 +1006,14 @@ set_rest_more:
   generic_set
 | var_name FROM CURRENT {}
   // Special syntaxes mandated by SQL standard:
-| TIME ZONE zone_value {}
+| TIME ZONE zone_value
+  {
+    if $3 == nil {
+      $$ = &Set{Name: QualifiedName{"time_zone"}}
+    } else {
+      $$ = &Set{Name: QualifiedName{"time_zone"}, Values: Exprs{$3}}
+    }
+  }
 | CATALOG SCONST {}
 // | SCHEMA SCONST {}
 | NAMES opt_encoding {}
@@ -912,13 +1074,31 @@ opt_boolean_or_string:
 // name gives reduce/reduce errors against const_interval and LOCAL, so use
 // IDENT (meaning we reject anything that is a key word).
 zone_value:
-  SCONST {}
-| IDENT {}
-| const_interval SCONST opt_interval {}
-| const_interval '(' ICONST ')' SCONST {}
-| numeric_only {}
-| DEFAULT {}
-| LOCAL {}
+  SCONST
+  {
+    $$ = StrVal($1)
+  }
+| IDENT
+  {
+    $$ = StrVal($1)
+  }
+| const_interval SCONST opt_interval
+  {
+    $$ = StrVal($2)
+  }
+| const_interval '(' ICONST ')' SCONST
+  {
+    $$ = StrVal($5)
+  }
+| numeric_only
+| DEFAULT
+  {
+    $$ = nil
+  }
+| LOCAL
+  {
+    $$ = nil
+  }
 
 opt_encoding:
   SCONST {}
@@ -941,25 +1121,27 @@ set_reset_clause:
 show_stmt:
   SHOW var_name
   {
-    // It would be cleaner if we could have "SHOW DATABASES" and "SHOW
-    // TABLES" rules, but unfortunately DATABASES and TABLES are
-    // unreserved keywords and thus valid variable names and such
-    // rules would cause reduce/reduce conflicts.
+    // It would be cleaner if we could have "SHOW DATABASES", "SHOW
+    // TABLES" and "SHOW GRANTS" rules, but unfortunately DATABASES,
+    // TABLES and GRANTS are unreserved keywords and thus valid variable
+    // names and such rules would cause reduce/reduce conflicts.
     if len($2) == 1 && strings.EqualFold($2[0], "DATABASES") {
       $$ = &ShowDatabases{}
     } else if len($2) == 1 && strings.EqualFold($2[0], "TABLES") {
       $$ = &ShowTables{}
+    } else if len($2) == 1 && strings.EqualFold($2[0], "GRANTS") {
+      $$ = &ShowGrants{}
     } else {
-      $$ = nil
+      $$ = &Show{Name: strings.Join($2, ".")}
     }
   }
 | SHOW TIME ZONE
   {
-    $$ = nil
+    $$ = &Show{Name: "time_zone"}
   }
 | SHOW ALL
   {
-    $$ = nil
+    $$ = &Show{Name: "ALL"}
   }
 | SHOW TABLES FROM var_name
   {
@@ -973,6 +1155,36 @@ show_stmt:
   {
     $$ = &ShowIndex{Table: $4}
   }
+| SHOW GRANTS ON privilege_target for_grantee_clause
+  {
+    targets := $4
+    $$ = &ShowGrants{Targets: &targets, Grantees: NameList($5)}
+  }
+| SHOW GRANTS FOR grantee_list
+  {
+    $$ = &ShowGrants{Grantees: NameList($4)}
+  }
+
+for_grantee_clause:
+  FOR grantee_list
+  {
+    $$ = $2
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
+// CREATE SEQUENCE seqname
+create_sequence_stmt:
+  CREATE opt_temp SEQUENCE any_name
+  {
+    $$ = &CreateSequence{Name: $4, IfNotExists: false}
+  }
+| CREATE opt_temp SEQUENCE IF NOT EXISTS any_name
+  {
+    $$ = &CreateSequence{Name: $7, IfNotExists: true}
+  }
 
 // CREATE TABLE relname
 create_table_stmt:
@@ -1077,6 +1289,9 @@ col_constraint:
   {
     // TODO(pmattis): Handle constraint name.
     $$ = $3
+    if c, ok := $$.(*CheckConstraint); ok {
+      c.Name = Name($2)
+    }
   }
 | col_constraint_elem
 | COLLATE any_name {}
@@ -1110,9 +1325,18 @@ col_constraint_elem:
   {
     $$ = PrimaryKeyConstraint{}
   }
-| CHECK '(' a_expr ')' opt_no_inherit {}
-| DEFAULT b_expr {}
-| REFERENCES qualified_name opt_column_list key_match key_actions {}
+| CHECK '(' a_expr ')' opt_no_inherit
+  {
+    $$ = &CheckConstraint{Expr: $3}
+  }
+| DEFAULT b_expr
+  {
+    $$ = &DefaultConstraint{Expr: $2}
+  }
+| REFERENCES qualified_name opt_column_list key_match key_actions
+  {
+    $$ = &ReferencesConstraint{Table: $2, Columns: NameList($3), Actions: $5}
+  }
 
 table_like_clause:
   LIKE qualified_name table_like_option_list {}
@@ -1137,8 +1361,13 @@ table_constraint:
   CONSTRAINT name constraint_elem
   {
     $$ = $3
-    if i, ok := $$.(*IndexTableDef); ok {
-      i.Name = Name($2)
+    switch t := $$.(type) {
+    case *IndexTableDef:
+      t.Name = Name($2)
+    case *ForeignKeyTableDef:
+      t.Name = Name($2)
+    case *CheckConstraintTableDef:
+      t.Name = Name($2)
     }
   }
 | constraint_elem
@@ -1147,7 +1376,10 @@ table_constraint:
   }
 
 constraint_elem:
-  CHECK '(' a_expr ')' {}
+  CHECK '(' a_expr ')'
+  {
+    $$ = &CheckConstraintTableDef{Expr: $3}
+  }
 | UNIQUE '(' name_list ')'
   {
     $$ = &IndexTableDef{Unique: true, Columns: NameList($3)}
@@ -1165,7 +1397,15 @@ constraint_elem:
 | EXCLUDE access_method_clause '(' exclusion_constraint_list ')'
     exclusion_where_clause {}
 | FOREIGN KEY '(' name_list ')' REFERENCES qualified_name
-    opt_column_list key_match key_actions {}
+    opt_column_list key_match key_actions
+  {
+    $$ = &ForeignKeyTableDef{
+      Columns: NameList($4),
+      Table: $7,
+      RefColumns: NameList($8),
+      Actions: $10,
+    }
+  }
 
 opt_no_inherit:
   NO INHERIT {}
@@ -1198,29 +1438,63 @@ exclusion_where_clause:
   WHERE '(' a_expr ')' {}
 | /* EMPTY */ {}
 
-// We combine the update and delete actions into one value temporarily for
-// simplicity of parsing, and then break them down again in the calling
-// production. update is in the left 8 bits, delete in the right. Note that
-// NOACTION is the default.
+// The update and delete actions are combined into a ReferenceActions value.
+// Note that NO ACTION is the default.
 key_actions:
-  key_update {}
-| key_delete {}
-| key_update key_delete {}
-| key_delete key_update {}
-| /* EMPTY */ {}
+  key_update
+  {
+    $$ = ReferenceActions{Update: $1}
+  }
+| key_delete
+  {
+    $$ = ReferenceActions{Delete: $1}
+  }
+| key_update key_delete
+  {
+    $$ = ReferenceActions{Delete: $2, Update: $1}
+  }
+| key_delete key_update
+  {
+    $$ = ReferenceActions{Delete: $1, Update: $2}
+  }
+| /* EMPTY */
+  {
+    $$ = ReferenceActions{}
+  }
 
 key_update:
-  ON UPDATE key_action {}
+  ON UPDATE key_action
+  {
+    $$ = $3
+  }
 
 key_delete:
-  ON DELETE key_action {}
+  ON DELETE key_action
+  {
+    $$ = $3
+  }
 
 key_action:
-  NO ACTION {}
-| RESTRICT {}
-| CASCADE {}
-| SET NULL {}
-| SET DEFAULT {}
+  NO ACTION
+  {
+    $$ = NoAction
+  }
+| RESTRICT
+  {
+    $$ = Restrict
+  }
+| CASCADE
+  {
+    $$ = Cascade
+  }
+| SET NULL
+  {
+    $$ = SetNull
+  }
+| SET DEFAULT
+  {
+    $$ = SetDefault
+  }
 
 opt_inherit:
   INHERITS '(' qualified_name_list ')' {}
@@ -1344,9 +1618,18 @@ opt_class:
 | /* EMPTY */ {}
 
 opt_asc_desc:
-  ASC {}
-| DESC {}
-| /* EMPTY */ {}
+  ASC
+  {
+    $$ = astAsc
+  }
+| DESC
+  {
+    $$ = astDesc
+  }
+| /* EMPTY */
+  {
+    $$ = ""
+  }
 
 opt_nulls_order:
   NULLS_LA FIRST {}
@@ -1507,8 +1790,12 @@ transaction_mode_list_or_empty:
 
 // CREATE [ OR REPLACE ] [ TEMP ] VIEW <viewname> '('target-list ')'
 //     AS <query> [ WITH [ CASCADED | LOCAL ] CHECK OPTION ]
-// create_view_stmt:
-//   CREATE opt_temp VIEW qualified_name opt_column_list opt_reloptions
+create_view_stmt:
+  CREATE VIEW qualified_name opt_column_list AS select_stmt
+  {
+    $$ = &CreateView{Name: $3, ColumnNames: NameList($4), AsSource: $6.(SelectStatement)}
+  }
+// | CREATE opt_temp VIEW qualified_name opt_column_list opt_reloptions
 //     AS select_stmt opt_check_option
 //   {
 //     $$ = nil
@@ -1737,21 +2024,15 @@ select_no_parens:
   simple_select
 | select_clause sort_clause
   {
-    $$ = $1
+    $$ = withOrderByLimit($1, $2, nil)
   }
 | select_clause opt_sort_clause for_locking_clause opt_select_limit
   {
-    $$ = $1
-    if s, ok := $$.(*Select); ok {
-      s.Limit = $4
-    }
+    $$ = withOrderByLimit($1, $2, $4)
   }
 | select_clause opt_sort_clause select_limit opt_for_locking_clause
   {
-    $$ = $1
-    if s, ok := $$.(*Select); ok {
-      s.Limit = $3
-    }
+    $$ = withOrderByLimit($1, $2, $3)
   }
 | with_clause select_clause
   {
@@ -1759,21 +2040,15 @@ select_no_parens:
   }
 | with_clause select_clause sort_clause
   {
-    $$ = $2
+    $$ = withOrderByLimit($2, $3, nil)
   }
 | with_clause select_clause opt_sort_clause for_locking_clause opt_select_limit
   {
-    $$ = $2
-    if s, ok := $$.(*Select); ok {
-      s.Limit = $5
-    }
+    $$ = withOrderByLimit($2, $3, $5)
   }
 | with_clause select_clause opt_sort_clause select_limit opt_for_locking_clause
   {
-    $$ = $2
-    if s, ok := $$.(*Select); ok {
-      s.Limit = $4
-    }
+    $$ = withOrderByLimit($2, $3, $4)
   }
 
 select_clause:
@@ -1831,29 +2106,29 @@ simple_select:
 | TABLE relation_expr {}
 | select_clause UNION all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
       Type:  astUnion,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 | select_clause INTERSECT all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
       Type:  astIntersect,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 | select_clause EXCEPT all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
       Type:  astExcept,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 
@@ -1892,9 +2167,18 @@ opt_table:
 | /* EMPTY */ {}
 
 all_or_distinct:
-  ALL {}
-| DISTINCT {}
-| /* EMPTY */ {}
+  ALL
+  {
+    $$ = true
+  }
+| DISTINCT
+  {
+    $$ = false
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 // We use (NIL) as a placeholder to indicate that all target expressions should
 // be placed in the DISTINCT list during parsetree analysis.
@@ -1907,19 +2191,38 @@ opt_all_clause:
 | /* EMPTY */ {}
 
 opt_sort_clause:
-  sort_clause {}
-| /* EMPTY */ {}
+  sort_clause
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 sort_clause:
-  ORDER BY sortby_list {}
+  ORDER BY sortby_list
+  {
+    $$ = $3
+  }
 
 sortby_list:
-  sortby {}
-| sortby_list ',' sortby {}
+  sortby
+  {
+    $$ = OrderBy{$1}
+  }
+| sortby_list ',' sortby
+  {
+    $$ = append($1, $3)
+  }
 
 sortby:
-  a_expr USING math_op opt_nulls_order {}
-| a_expr opt_asc_desc opt_nulls_order {}
+  a_expr USING math_op opt_nulls_order
+  {
+    // TODO(pmattis): Support USING and NULLS FIRST/LAST.
+    $$ = &Order{Expr: $1}
+  }
+| a_expr opt_asc_desc opt_nulls_order
+  {
+    $$ = &Order{Expr: $1, Direction: $2}
+  }
 
 select_limit:
   limit_clause offset_clause
@@ -2349,6 +2652,10 @@ opt_array_bounds:
 
 simple_typename:
   numeric
+| SERIAL
+  {
+    $$ = &IntType{Name: astSerial}
+  }
 | bit
 | character
 | const_datetime
@@ -3597,6 +3904,7 @@ unreserved_keyword:
 | BY
 | CACHE
 | CALLED
+| CANCEL
 | CASCADE
 | CASCADED
 | CATALOG
@@ -3668,6 +3976,7 @@ unreserved_keyword:
 | FUNCTIONS
 | GLOBAL
 | GRANTED
+| GRANTS
 | HANDLER
 | HEADER
 | HOLD
@@ -3748,6 +4057,7 @@ unreserved_keyword:
 | PROCEDURAL
 | PROCEDURE
 | PROGRAM
+| QUERY
 | QUOTE
 | RANGE
 | READ
@@ -3886,6 +4196,7 @@ col_name_keyword:
 | PRECISION
 | REAL
 | ROW
+| SERIAL
 | SETOF
 | SMALLINT
 | SUBSTRING
@@ -40,16 +40,26 @@ type Statement interface {
 	statement()
 }
 
+func (*AlterTable) statement()     {}
+func (*CancelQuery) statement()    {}
 func (*CreateDatabase) statement() {}
+func (*CreateSequence) statement() {}
 func (*CreateTable) statement()    {}
+func (*CreateView) statement()     {}
 func (*Delete) statement()         {}
 func (*DropDatabase) statement()   {}
 func (*DropTable) statement()      {}
+func (*DropView) statement()       {}
+func (*Grant) statement()          {}
+func (*Import) statement()         {}
 func (*Insert) statement()         {}
+func (*Revoke) statement()         {}
 func (*Select) statement()         {}
 func (*Set) statement()            {}
+func (*Show) statement()           {}
 func (*ShowColumns) statement()    {}
 func (*ShowDatabases) statement()  {}
+func (*ShowGrants) statement()     {}
 func (*ShowIndex) statement()      {}
 func (*ShowTables) statement()     {}
 func (*Truncate) statement()       {}
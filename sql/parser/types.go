@@ -27,6 +27,7 @@ const (
 	astInteger  = "INTEGER"
 	astSmallInt = "SMALLINT"
 	astBigInt   = "BIGINT"
+	astSerial   = "SERIAL"
 	astReal     = "REAL"
 	astDouble   = "DOUBLE PRECISION"
 	astFloat    = "FLOAT"
@@ -75,12 +76,18 @@ func (node *BoolType) String() string {
 	return "BOOLEAN"
 }
 
-// IntType represents an INT, INTEGER, SMALLINT or BIGINT type.
+// IntType represents an INT, INTEGER, SMALLINT, BIGINT or SERIAL type.
 type IntType struct {
 	Name string
 	N    int
 }
 
+// IsSerial returns true if the type is SERIAL: an INT column whose values
+// default to unique_rowid().
+func (node *IntType) IsSerial() bool {
+	return node.Name == astSerial
+}
+
 func (node *IntType) String() string {
 	var buf bytes.Buffer
 	_, _ = buf.WriteString(node.Name)
@@ -34,6 +34,7 @@ func TestParseColumnType(t *testing.T) {
 		{"SMALLINT", &IntType{Name: astSmallInt}},
 		{"BIGINT", &IntType{Name: astBigInt}},
 		{"INTEGER", &IntType{Name: astInteger}},
+		{"SERIAL", &IntType{Name: astSerial}},
 		{"REAL", &FloatType{Name: astReal}},
 		{"DOUBLE PRECISION", &FloatType{Name: astDouble}},
 		{"FLOAT", &FloatType{Name: astFloat}},
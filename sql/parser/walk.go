@@ -167,3 +167,110 @@ func FillArgs(expr Expr, args Args) (Expr, error) {
 	expr = WalkExpr(&v, expr)
 	return expr, v.err
 }
+
+// WalkStmt walks the expressions contained in a statement, replacing each
+// of them with the result of WalkExpr. Statements which do not contain
+// expressions are left untouched.
+func WalkStmt(v Visitor, stmt Statement) {
+	switch t := stmt.(type) {
+	case *CancelQuery:
+		t.ID = WalkExpr(v, t.ID)
+
+	case *Delete:
+		walkTableExpr(v, t.Table)
+		walkWhere(v, t.Where)
+
+	case *Import:
+		t.File = WalkExpr(v, t.File)
+
+	case *Insert:
+		walkSelectStmt(v, t.Rows)
+
+	case *Select, *Union, Values:
+		walkSelectStmt(v, t.(SelectStatement))
+
+	case *Set:
+		for i := range t.Values {
+			t.Values[i] = WalkExpr(v, t.Values[i])
+		}
+
+	case *Update:
+		walkTableExpr(v, t.Table)
+		for _, e := range t.Exprs {
+			e.Expr = WalkExpr(v, e.Expr)
+		}
+		walkWhere(v, t.Where)
+	}
+}
+
+func walkSelectStmt(v Visitor, stmt SelectStatement) {
+	switch t := stmt.(type) {
+	case *Select:
+		for _, e := range t.Exprs {
+			if n, ok := e.(*NonStarExpr); ok {
+				n.Expr = WalkExpr(v, n.Expr)
+			}
+		}
+		for _, e := range t.From {
+			walkTableExpr(v, e)
+		}
+		walkWhere(v, t.Where)
+		for i := range t.GroupBy {
+			t.GroupBy[i] = WalkExpr(v, t.GroupBy[i])
+		}
+		walkWhere(v, t.Having)
+		for _, o := range t.OrderBy {
+			o.Expr = WalkExpr(v, o.Expr)
+		}
+		if t.Limit != nil {
+			if t.Limit.Offset != nil {
+				t.Limit.Offset = WalkExpr(v, t.Limit.Offset)
+			}
+			if t.Limit.Count != nil {
+				t.Limit.Count = WalkExpr(v, t.Limit.Count)
+			}
+		}
+
+	case *Union:
+		walkSelectStmt(v, t.Left)
+		walkSelectStmt(v, t.Right)
+
+	case Values:
+		for _, tuple := range t {
+			WalkExpr(v, tuple)
+		}
+	}
+}
+
+func walkTableExpr(v Visitor, expr TableExpr) {
+	switch t := expr.(type) {
+	case *AliasedTableExpr:
+		if s, ok := t.Expr.(*Subquery); ok {
+			walkSelectStmt(v, s.Select)
+		}
+
+	case *ParenTableExpr:
+		walkTableExpr(v, t.Expr)
+
+	case *JoinTableExpr:
+		walkTableExpr(v, t.Left)
+		walkTableExpr(v, t.Right)
+		if on, ok := t.Cond.(*OnJoinCond); ok {
+			on.Expr = WalkExpr(v, on.Expr)
+		}
+	}
+}
+
+func walkWhere(v Visitor, where *Where) {
+	if where != nil {
+		where.Expr = WalkExpr(v, where.Expr)
+	}
+}
+
+// FillStmtArgs replaces any placeholder nodes in the statement with
+// arguments supplied with the query.
+func FillStmtArgs(stmt Statement, args Args) error {
+	v := argVisitor{args: args}
+	WalkStmt(&v, stmt)
+	return v.err
+}
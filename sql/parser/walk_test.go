@@ -115,3 +115,42 @@ func TestFillArgsError(t *testing.T) {
 		}
 	}
 }
+
+// TestFillStmtArgs tests both FillStmtArgs and WalkStmt.
+func TestFillStmtArgs(t *testing.T) {
+	args := mapArgs{1: DInt(1), 2: DString("a"), 3: DBool(true)}
+	testData := []struct {
+		sql      string
+		expected string
+	}{
+		{`SELECT $1, b FROM t WHERE a = $2 LIMIT $1`,
+			`SELECT 1, b FROM t WHERE a = 'a' LIMIT 1`},
+		{`SELECT a FROM t HAVING b = $3`, `SELECT a FROM t HAVING b = true`},
+		{`SELECT a FROM t JOIN u ON t.a = $1`, `SELECT a FROM t JOIN u ON t.a = 1`},
+		{`SELECT a FROM (SELECT $2 FROM t)`, `SELECT a FROM (SELECT 'a' FROM t)`},
+		{`INSERT INTO t VALUES ($1, $2), ($3, $1)`, `INSERT INTO t VALUES (1, 'a'), (true, 1)`},
+		{`INSERT INTO t SELECT $1 FROM u`, `INSERT INTO t SELECT 1 FROM u`},
+		{`UPDATE t SET a = $1 WHERE b = $2`, `UPDATE t SET a = 1 WHERE b = 'a'`},
+		{`DELETE FROM t WHERE a = $1`, `DELETE FROM t WHERE a = 1`},
+	}
+	for _, d := range testData {
+		stmts, err := Parse(d.sql)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		if err := FillStmtArgs(stmts[0], args); err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		if s := stmts[0].String(); d.expected != s {
+			t.Errorf("%s: expected %s, but found %s", d.sql, d.expected, s)
+		}
+	}
+
+	stmts, err := Parse(`SELECT a FROM t WHERE a = $4`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := FillStmtArgs(stmts[0], args); err == nil || err.Error() != `arg $4 not found` {
+		t.Fatalf("expected error, but found %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// maxMessageSize is the maximum size of a message accepted from a client.
+const maxMessageSize = 1 << 24
+
+type readBuffer struct {
+	msg []byte
+	tmp [4]byte
+}
+
+// readUntypedMsg reads a length-prefixed message. It is only used directly
+// during the authentication phase of the protocol; readTypedMsg is
+// used at all other times.
+func (b *readBuffer) readUntypedMsg(rd io.Reader) error {
+	if _, err := io.ReadFull(rd, b.tmp[:]); err != nil {
+		return err
+	}
+	// The size includes itself.
+	size := int(binary.BigEndian.Uint32(b.tmp[:])) - 4
+	if size < 0 || size > maxMessageSize {
+		return util.Errorf("message size %d out of bounds (0..%d)", size, maxMessageSize)
+	}
+	if cap(b.msg) < size {
+		b.msg = make([]byte, size)
+	}
+	b.msg = b.msg[:size]
+	_, err := io.ReadFull(rd, b.msg)
+	return err
+}
+
+// readTypedMsg reads a message, returning its type code.
+func (b *readBuffer) readTypedMsg(rd io.Reader) (clientMessageType, error) {
+	if _, err := io.ReadFull(rd, b.tmp[:1]); err != nil {
+		return 0, err
+	}
+	typ := clientMessageType(b.tmp[0])
+	return typ, b.readUntypedMsg(rd)
+}
+
+// getString reads a NUL-terminated string.
+func (b *readBuffer) getString() (string, error) {
+	pos := bytes.IndexByte(b.msg, 0)
+	if pos == -1 {
+		return "", util.Errorf("NUL terminator not found")
+	}
+	s := string(b.msg[:pos])
+	b.msg = b.msg[pos+1:]
+	return s, nil
+}
+
+func (b *readBuffer) getBytes(n int) ([]byte, error) {
+	if n < 0 || len(b.msg) < n {
+		return nil, util.Errorf("insufficient data: %d", len(b.msg))
+	}
+	v := b.msg[:n]
+	b.msg = b.msg[n:]
+	return v, nil
+}
+
+func (b *readBuffer) getByte() (byte, error) {
+	v, err := b.getBytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return v[0], nil
+}
+
+func (b *readBuffer) getInt16() (int16, error) {
+	v, err := b.getBytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return int16(binary.BigEndian.Uint16(v)), nil
+}
+
+func (b *readBuffer) getInt32() (int32, error) {
+	v, err := b.getBytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.BigEndian.Uint32(v)), nil
+}
+
+// writeBuffer accumulates a single message. The message is sent by
+// finishMsg, which fills in the length of the message.
+type writeBuffer struct {
+	bytes.Buffer
+	putbuf [8]byte
+}
+
+func (b *writeBuffer) initMsg(typ serverMessageType) {
+	b.Reset()
+	b.putbuf[0] = byte(typ)
+	// Reserve space for the length, which is filled in by finishMsg.
+	_, _ = b.Write(b.putbuf[:5])
+}
+
+func (b *writeBuffer) finishMsg(w io.Writer) error {
+	msg := b.Bytes()
+	binary.BigEndian.PutUint32(msg[1:5], uint32(len(msg)-1))
+	_, err := w.Write(msg)
+	b.Reset()
+	return err
+}
+
+func (b *writeBuffer) writeString(s string) {
+	_, _ = b.WriteString(s)
+}
+
+func (b *writeBuffer) writeCString(s string) {
+	_, _ = b.WriteString(s)
+	_ = b.WriteByte(0)
+}
+
+func (b *writeBuffer) putInt16(v int16) {
+	binary.BigEndian.PutUint16(b.putbuf[:], uint16(v))
+	_, _ = b.Write(b.putbuf[:2])
+}
+
+func (b *writeBuffer) putInt32(v int32) {
+	binary.BigEndian.PutUint32(b.putbuf[:], uint32(v))
+	_, _ = b.Write(b.putbuf[:4])
+}
+
+func (b *writeBuffer) putInt64(v int64) {
+	binary.BigEndian.PutUint64(b.putbuf[:], uint64(v))
+	_, _ = b.Write(b.putbuf[:8])
+}
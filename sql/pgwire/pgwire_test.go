@@ -0,0 +1,343 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire_test
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/security/securitytest"
+	"github.com/cockroachdb/cockroach/server"
+	csql "github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	_ "github.com/lib/pq"
+)
+
+// writeTestCerts writes the embedded test certificates to a temporary
+// directory so that they can be used by the PostgreSQL client.
+func writeTestCerts(t *testing.T) string {
+	dir := util.CreateTempDir(t, "pgwire_test")
+	for _, name := range []string{"ca.crt", "root.client.crt", "root.client.key",
+		"test-user.client.crt", "test-user.client.key"} {
+		data, err := securitytest.Asset(filepath.Join(security.EmbeddedCertsDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The client refuses to use keys which are readable by others.
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func pgURL(s *server.TestServer, user, certUser, certsDir, sslMode string) string {
+	options := url.Values{}
+	options.Add("sslmode", sslMode)
+	options.Add("sslrootcert", filepath.Join(certsDir, "ca.crt"))
+	options.Add("sslcert", filepath.Join(certsDir, certUser+".client.crt"))
+	options.Add("sslkey", filepath.Join(certsDir, certUser+".client.key"))
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.User(user),
+		Host:     s.PGAddr(),
+		RawQuery: options.Encode(),
+	}
+	return u.String()
+}
+
+func TestPGWire(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	certsDir := writeTestCerts(t)
+	defer util.CleanupDir(certsDir)
+
+	db, err := sql.Open("postgres", pgURL(s, security.RootUser, security.RootUser, certsDir, "require"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// Statements without arguments use the simple query protocol.
+	for _, stmt := range []string{
+		`CREATE DATABASE t`,
+		`CREATE TABLE t.kv (k CHAR PRIMARY KEY, v INT, b BOOLEAN)`,
+		`INSERT INTO t.kv VALUES ('a', 1, true)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("%s: %s", stmt, err)
+		}
+	}
+	// Statements with arguments use the extended query protocol.
+	res, err := db.Exec(`INSERT INTO t.kv VALUES ($1, $2, $3)`, "b", 2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		t.Fatal(err)
+	} else if n != 1 {
+		t.Fatalf("expected 1 row affected, but found %d", n)
+	}
+
+	type kv struct {
+		k string
+		v int64
+		b bool
+	}
+	readKVs := func(rows *sql.Rows, err error) []kv {
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var kvs []kv
+		for rows.Next() {
+			var r kv
+			if err := rows.Scan(&r.k, &r.v, &r.b); err != nil {
+				t.Fatal(err)
+			}
+			kvs = append(kvs, r)
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return kvs
+	}
+
+	expected := []kv{{"a", 1, true}, {"b", 2, false}}
+	if kvs := readKVs(db.Query(`SELECT k, v, b FROM t.kv`)); !reflect.DeepEqual(expected, kvs) {
+		t.Fatalf("expected %v, but found %v", expected, kvs)
+	}
+	if kvs := readKVs(db.Query(`SELECT k, v, b FROM t.kv WHERE v = $1`, 2)); !reflect.DeepEqual(expected[1:], kvs) {
+		t.Fatalf("expected %v, but found %v", expected[1:], kvs)
+	}
+
+	// Errors are reported and the connection remains usable.
+	if _, err := db.Exec(`SELECT * FROM t.missing`); !testutils.IsError(err, "table .* does not exist") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+	if _, err := db.Exec(`SELECT * FROM t.kv WHERE k = $1`, "a", "b"); err == nil {
+		t.Fatal("expected error, but found success")
+	}
+	if _, err := db.Exec(`SELEC 1`); !testutils.IsError(err, "syntax error") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+	var n int64
+	if err := db.QueryRow(`SELECT 1 + $1`, 2).Scan(&n); err != nil {
+		t.Fatal(err)
+	} else if n != 3 {
+		t.Fatalf("expected 3, but found %d", n)
+	}
+
+	// The number of rows written is reported in the command tag.
+	res, err = db.Exec(`DELETE FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		t.Fatal(err)
+	} else if n != 2 {
+		t.Fatalf("expected 2 rows affected, but found %d", n)
+	}
+}
+
+func TestPGWireDatabase(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	certsDir := writeTestCerts(t)
+	defer util.CleanupDir(certsDir)
+
+	db, err := sql.Open("postgres", pgURL(s, security.RootUser, security.RootUser, certsDir, "require"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE DATABASE foo`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE foo.bar (a INT PRIMARY KEY)`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The database in the connection URL is used as the session database.
+	u := pgURL(s, security.RootUser, security.RootUser, certsDir, "require")
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed.Path = "foo"
+	fooDB, err := sql.Open("postgres", parsed.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fooDB.Close()
+	var table string
+	if err := fooDB.QueryRow(`SHOW TABLES`).Scan(&table); err != nil {
+		t.Fatal(err)
+	} else if table != "bar" {
+		t.Fatalf("expected bar, but found %s", table)
+	}
+}
+
+func TestPGWireTimeZone(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	certsDir := writeTestCerts(t)
+	defer util.CleanupDir(certsDir)
+
+	// The TimeZone parameter of the connection sets the session time zone.
+	u := pgURL(s, security.RootUser, security.RootUser, certsDir, "require")
+	for _, c := range []struct {
+		timeZone string
+		expected string
+	}{
+		{"", "UTC"},
+		{"America/New_York", "America/New_York"},
+	} {
+		tzURL := u
+		if c.timeZone != "" {
+			tzURL += "&" + url.Values{"timezone": {c.timeZone}}.Encode()
+		}
+		db, err := sql.Open("postgres", tzURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var timeZone string
+		err = db.QueryRow(`SHOW TIME ZONE`).Scan(&timeZone)
+		db.Close()
+		if err != nil {
+			t.Fatal(err)
+		} else if timeZone != c.expected {
+			t.Fatalf("expected %s, but found %s", c.expected, timeZone)
+		}
+	}
+}
+
+func TestPGWireAuth(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	certsDir := writeTestCerts(t)
+	defer util.CleanupDir(certsDir)
+
+	testCases := []struct {
+		user, certUser, sslMode string
+		expectedErr             string
+	}{
+		{security.RootUser, security.RootUser, "require", ""},
+		{server.TestUser, server.TestUser, "require", ""},
+		{server.TestUser, security.RootUser, "require", "requested user is test-user, but certificate is for root"},
+		{security.RootUser, security.RootUser, "disable", "request is not using TLS"},
+	}
+	for i, tc := range testCases {
+		db, err := sql.Open("postgres", pgURL(s, tc.user, tc.certUser, certsDir, tc.sslMode))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = db.Exec(`SELECT 1`)
+		if tc.expectedErr == "" {
+			if err != nil {
+				t.Errorf("%d: %s", i, err)
+			}
+		} else if !testutils.IsError(err, tc.expectedErr) {
+			t.Errorf("%d: expected %s, but found %v", i, tc.expectedErr, err)
+		}
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestPGWireInsecure(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := server.NewTestContext()
+	ctx.Insecure = true
+	s := &server.TestServer{Ctx: ctx}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	u := fmt.Sprintf("postgres://%s@%s/?sslmode=disable", security.RootUser, s.PGAddr())
+	db, err := sql.Open("postgres", u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`SELECT 1`); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPGWireResultChunks(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(n int) { csql.ResultChunkSize = n }(csql.ResultChunkSize)
+	csql.ResultChunkSize = 2
+
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	certsDir := writeTestCerts(t)
+	defer util.CleanupDir(certsDir)
+
+	db, err := sql.Open("postgres", pgURL(s, security.RootUser, security.RootUser, certsDir, "require"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE DATABASE t; CREATE TABLE t.kv (k INT PRIMARY KEY, v INT)`); err != nil {
+		t.Fatal(err)
+	}
+	const numRows = 5
+	for i := 0; i < numRows; i++ {
+		if _, err := db.Exec(`INSERT INTO t.kv VALUES ($1, $2)`, i, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count := func(rows *sql.Rows, err error) int {
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		n := 0
+		for rows.Next() {
+			n++
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	// Both the simple and the extended query protocols return all of the
+	// chunks of a result.
+	if n := count(db.Query(`SELECT k, v FROM t.kv`)); n != numRows {
+		t.Fatalf("expected %d rows, but found %d", numRows, n)
+	}
+	if n := count(db.Query(`SELECT k, v FROM t.kv WHERE k < $1`, 4)); n != 4 {
+		t.Fatalf("expected 4 rows, but found %d", n)
+	}
+}
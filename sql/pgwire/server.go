@@ -0,0 +1,198 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire
+
+import (
+	"crypto/tls"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+const (
+	version30  = 196608
+	versionSSL = 80877103
+)
+
+var (
+	sslSupported   = []byte{'S'}
+	sslUnsupported = []byte{'N'}
+)
+
+// Server implements the server side of the PostgreSQL wire protocol.
+type Server struct {
+	context  *base.Context
+	executor *sql.Server
+
+	mu       sync.Mutex // Protects the fields below
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	closed   bool
+}
+
+// NewServer creates a Server which executes statements using the supplied
+// SQL server.
+func NewServer(context *base.Context, executor *sql.Server) *Server {
+	return &Server{
+		context:  context,
+		executor: executor,
+		conns:    make(map[net.Conn]struct{}),
+	}
+}
+
+// Start binds the server to the given address and begins accepting
+// connections. After this method returns, the socket will have been
+// bound. Use Server.Addr() to ascertain the server address.
+func (s *Server) Start(addr string, stopper *stop.Stopper) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.listener = ln
+	s.mu.Unlock()
+
+	stopper.RunWorker(func() {
+		if err := s.serve(ln); err != nil {
+			if !strings.HasSuffix(err.Error(), "use of closed network connection") {
+				log.Error(err)
+			}
+		}
+	})
+
+	stopper.RunWorker(func() {
+		<-stopper.ShouldStop()
+		s.Close()
+	})
+	return nil
+}
+
+// Addr returns the server's network address.
+func (s *Server) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Close closes the listener and all active connections.
+func (s *Server) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// If the server didn't start properly, it might not have a listener.
+	if s.listener != nil {
+		s.listener.Close()
+	}
+	s.closed = true
+	for conn := range s.conns {
+		conn.Close()
+	}
+}
+
+// serve accepts connections on the listener, serving each of them in a
+// new goroutine.
+func (s *Server) serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			continue
+		}
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		go func() {
+			defer func() {
+				s.mu.Lock()
+				delete(s.conns, conn)
+				s.mu.Unlock()
+				conn.Close()
+			}()
+			if err := s.serveConn(conn); err != nil {
+				if log.V(1) {
+					log.Infof("pgwire connection from %s: %s", conn.RemoteAddr(), err)
+				}
+			}
+		}()
+	}
+}
+
+// serveConn negotiates the protocol version and SSL for a new connection
+// and then serves it until the client terminates the session.
+func (s *Server) serveConn(conn net.Conn) error {
+	var buf readBuffer
+	if err := buf.readUntypedMsg(conn); err != nil {
+		return err
+	}
+	version, err := buf.getInt32()
+	if err != nil {
+		return err
+	}
+
+	var tlsState *tls.ConnectionState
+	if version == versionSSL {
+		tlsConfig, err := s.context.GetServerTLSConfig()
+		if err != nil {
+			return err
+		}
+		if tlsConfig == nil {
+			// Running in insecure mode.
+			if _, err := conn.Write(sslUnsupported); err != nil {
+				return err
+			}
+		} else {
+			if _, err := conn.Write(sslSupported); err != nil {
+				return err
+			}
+			tlsConn := tls.Server(conn, tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return err
+			}
+			state := tlsConn.ConnectionState()
+			tlsState = &state
+			conn = tlsConn
+		}
+
+		if err := buf.readUntypedMsg(conn); err != nil {
+			return err
+		}
+		if version, err = buf.getInt32(); err != nil {
+			return err
+		}
+	}
+
+	if version != version30 {
+		return util.Errorf("unknown protocol version %d", version)
+	}
+	c, err := newV3Conn(conn, &buf, s.executor)
+	if err != nil {
+		return err
+	}
+	return c.serve(s.context.Insecure, tlsState)
+}
@@ -0,0 +1,218 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/lib/pq/oid"
+)
+
+type formatCode int16
+
+const (
+	formatText   formatCode = 0
+	formatBinary formatCode = 1
+)
+
+// pgType describes a PostgreSQL type.
+type pgType struct {
+	oid oid.Oid
+	// size is the size of the type in bytes, or -1 for variable length
+	// types.
+	size int16
+}
+
+var (
+	boolType   = pgType{oid.T_bool, 1}
+	int8Type   = pgType{oid.T_int8, 8}
+	float8Type = pgType{oid.T_float8, 8}
+	textType   = pgType{oid.T_text, -1}
+	byteaType  = pgType{oid.T_bytea, -1}
+)
+
+// typeForDatum returns the PostgreSQL type of a datum. NULL datums are
+// reported as text.
+func typeForDatum(d driver.Datum) pgType {
+	switch {
+	case d.BoolVal != nil:
+		return boolType
+	case d.IntVal != nil:
+		return int8Type
+	case d.FloatVal != nil:
+		return float8Type
+	case d.BytesVal != nil:
+		return byteaType
+	}
+	return textType
+}
+
+func isNull(d driver.Datum) bool {
+	return d.BoolVal == nil && d.IntVal == nil && d.FloatVal == nil &&
+		d.BytesVal == nil && d.StringVal == nil
+}
+
+// writeDatum appends the value of a datum to a DataRow message, encoded
+// according to the type the column was described with and the requested
+// format.
+func (b *writeBuffer) writeDatum(d driver.Datum, typ pgType, format formatCode) {
+	if isNull(d) {
+		b.putInt32(-1)
+		return
+	}
+	if format == formatBinary && typ.oid == typeForDatum(d).oid {
+		switch {
+		case d.BoolVal != nil:
+			b.putInt32(1)
+			if *d.BoolVal {
+				_ = b.WriteByte(1)
+			} else {
+				_ = b.WriteByte(0)
+			}
+			return
+		case d.IntVal != nil:
+			b.putInt32(8)
+			b.putInt64(*d.IntVal)
+			return
+		case d.FloatVal != nil:
+			b.putInt32(8)
+			b.putInt64(int64(math.Float64bits(*d.FloatVal)))
+			return
+		case d.BytesVal != nil:
+			b.putInt32(int32(len(d.BytesVal)))
+			_, _ = b.Write(d.BytesVal)
+			return
+		}
+	}
+
+	var s string
+	switch {
+	case d.BoolVal != nil:
+		// PostgreSQL uses 't' and 'f' for the text representation of booleans.
+		if *d.BoolVal {
+			s = "t"
+		} else {
+			s = "f"
+		}
+	case d.BytesVal != nil && typ.oid == oid.T_bytea && format == formatText:
+		s = `\x` + hex.EncodeToString(d.BytesVal)
+	default:
+		s = d.String()
+	}
+	b.putInt32(int32(len(s)))
+	b.writeString(s)
+}
+
+// decodeParam decodes the value of a parameter supplied in a Bind message
+// into a datum. Parameters with an unspecified type are decoded from their
+// text representation as an integer, float or boolean when possible, and as
+// a string otherwise, since placeholder types are not yet inferred from the
+// statement.
+func decodeParam(b []byte, typ oid.Oid, format formatCode) (driver.Datum, error) {
+	var d driver.Datum
+	if format == formatBinary {
+		switch typ {
+		case oid.T_bool:
+			if len(b) != 1 {
+				return d, util.Errorf("invalid binary bool: %q", b)
+			}
+			v := b[0] != 0
+			d.BoolVal = &v
+		case oid.T_int2, oid.T_int4, oid.T_int8:
+			var v int64
+			switch len(b) {
+			case 2:
+				v = int64(int16(binary.BigEndian.Uint16(b)))
+			case 4:
+				v = int64(int32(binary.BigEndian.Uint32(b)))
+			case 8:
+				v = int64(binary.BigEndian.Uint64(b))
+			default:
+				return d, util.Errorf("invalid binary integer: %q", b)
+			}
+			d.IntVal = &v
+		case oid.T_float4, oid.T_float8:
+			var v float64
+			switch len(b) {
+			case 4:
+				v = float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+			case 8:
+				v = math.Float64frombits(binary.BigEndian.Uint64(b))
+			default:
+				return d, util.Errorf("invalid binary float: %q", b)
+			}
+			d.FloatVal = &v
+		case oid.T_bytea:
+			d.BytesVal = append([]byte(nil), b...)
+		default:
+			v := string(b)
+			d.StringVal = &v
+		}
+		return d, nil
+	}
+
+	s := string(b)
+	switch typ {
+	case oid.T_bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return d, util.Errorf("invalid bool: %q", s)
+		}
+		d.BoolVal = &v
+	case oid.T_int2, oid.T_int4, oid.T_int8:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return d, util.Errorf("invalid integer: %q", s)
+		}
+		d.IntVal = &v
+	case oid.T_float4, oid.T_float8, oid.T_numeric:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return d, util.Errorf("invalid float: %q", s)
+		}
+		d.FloatVal = &v
+	case oid.T_bytea:
+		if strings.HasPrefix(s, `\x`) {
+			v, err := hex.DecodeString(s[2:])
+			if err != nil {
+				return d, util.Errorf("invalid bytea: %q", s)
+			}
+			d.BytesVal = v
+		} else {
+			d.BytesVal = append([]byte(nil), b...)
+		}
+	case oid.T_unknown, 0:
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			d.IntVal = &v
+		} else if v, err := strconv.ParseFloat(s, 64); err == nil {
+			d.FloatVal = &v
+		} else if s == "true" || s == "false" {
+			v := s == "true"
+			d.BoolVal = &v
+		} else {
+			d.StringVal = &s
+		}
+	default:
+		d.StringVal = &s
+	}
+	return d, nil
+}
@@ -0,0 +1,769 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/lib/pq/oid"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+type clientMessageType byte
+type serverMessageType byte
+
+const (
+	clientMsgSimpleQuery clientMessageType = 'Q'
+	clientMsgParse       clientMessageType = 'P'
+	clientMsgBind        clientMessageType = 'B'
+	clientMsgDescribe    clientMessageType = 'D'
+	clientMsgExecute     clientMessageType = 'E'
+	clientMsgSync        clientMessageType = 'S'
+	clientMsgClose       clientMessageType = 'C'
+	clientMsgFlush       clientMessageType = 'H'
+	clientMsgTerminate   clientMessageType = 'X'
+
+	serverMsgAuth                 serverMessageType = 'R'
+	serverMsgParameterStatus      serverMessageType = 'S'
+	serverMsgReady                serverMessageType = 'Z'
+	serverMsgRowDescription       serverMessageType = 'T'
+	serverMsgDataRow              serverMessageType = 'D'
+	serverMsgCommandComplete      serverMessageType = 'C'
+	serverMsgEmptyQuery           serverMessageType = 'I'
+	serverMsgErrorResponse        serverMessageType = 'E'
+	serverMsgParseComplete        serverMessageType = '1'
+	serverMsgBindComplete         serverMessageType = '2'
+	serverMsgCloseComplete        serverMessageType = '3'
+	serverMsgParameterDescription serverMessageType = 't'
+	serverMsgNoData               serverMessageType = 'n'
+)
+
+const (
+	authOK int32 = 0
+)
+
+// statusReportParams are the parameters reported to the client once the
+// connection has been authenticated.
+var statusReportParams = map[string]string{
+	"client_encoding":   "UTF8",
+	"DateStyle":         "ISO",
+	"integer_datetimes": "on",
+	"server_encoding":   "UTF8",
+	"server_version":    "9.5.0",
+}
+
+// preparedStatement is a statement created by a Parse message.
+type preparedStatement struct {
+	query string
+	// stmt is nil for an empty query.
+	stmt    parser.Statement
+	inTypes []oid.Oid
+	// columns are the names of the columns in the result of the statement.
+	columns []string
+}
+
+// preparedPortal is a prepared statement bound to parameters by a Bind
+// message.
+type preparedPortal struct {
+	stmt       preparedStatement
+	params     []driver.Datum
+	outFormats []formatCode
+}
+
+// v3Conn serves a connection speaking version 3.0 of the PostgreSQL
+// protocol.
+type v3Conn struct {
+	rd       *bufio.Reader
+	wr       *bufio.Writer
+	executor *sql.Server
+	readBuf  readBuffer
+	writeBuf writeBuffer
+
+	user    string
+	session []byte
+	// timeZone is the session time zone last reported to the client.
+	timeZone string
+
+	// ignoreTillSync is set when an error occurs while processing an
+	// extended query message. Subsequent messages are discarded until the
+	// next Sync message.
+	ignoreTillSync bool
+
+	preparedStatements map[string]preparedStatement
+	preparedPortals    map[string]preparedPortal
+}
+
+func newV3Conn(conn net.Conn, buf *readBuffer, executor *sql.Server) (*v3Conn, error) {
+	c := &v3Conn{
+		rd:                 bufio.NewReader(conn),
+		wr:                 bufio.NewWriter(conn),
+		executor:           executor,
+		preparedStatements: make(map[string]preparedStatement),
+		preparedPortals:    make(map[string]preparedPortal),
+	}
+	var session sql.Session
+	for {
+		key, err := buf.getString()
+		if err != nil {
+			return nil, util.Errorf("error reading option key: %s", err)
+		}
+		if len(key) == 0 {
+			break
+		}
+		value, err := buf.getString()
+		if err != nil {
+			return nil, util.Errorf("error reading option value: %s", err)
+		}
+		switch strings.ToLower(key) {
+		case "user":
+			c.user = value
+		case "database":
+			session.Database = value
+		case "timezone":
+			if _, err := time.LoadLocation(value); err != nil {
+				return nil, util.Errorf("unknown time zone %q", value)
+			}
+			session.TimeZone = value
+		}
+	}
+	if len(session.Database) > 0 || len(session.TimeZone) > 0 {
+		b, err := gogoproto.Marshal(&session)
+		if err != nil {
+			return nil, err
+		}
+		c.session = b
+	}
+	c.timeZone = session.TimeZoneName()
+	return c, nil
+}
+
+// serve authenticates the connection and then processes messages until
+// the client terminates the session or an I/O error occurs. Errors
+// executing statements are reported to the client.
+func (c *v3Conn) serve(insecure bool, tlsState *tls.ConnectionState) error {
+	authenticationHook, err := security.AuthenticationHook(insecure, tlsState)
+	if err == nil {
+		err = authenticationHook(&driver.Request{RequestHeader: driver.RequestHeader{User: c.user}})
+	}
+	if err != nil {
+		if err := c.sendError(err); err != nil {
+			return err
+		}
+		return c.wr.Flush()
+	}
+
+	c.writeBuf.initMsg(serverMsgAuth)
+	c.writeBuf.putInt32(authOK)
+	if err := c.writeBuf.finishMsg(c.wr); err != nil {
+		return err
+	}
+	for key, value := range statusReportParams {
+		if err := c.sendParameterStatus(key, value); err != nil {
+			return err
+		}
+	}
+	if err := c.sendParameterStatus("TimeZone", c.timeZone); err != nil {
+		return err
+	}
+	if err := c.sendReadyForQuery(); err != nil {
+		return err
+	}
+
+	for {
+		typ, err := c.readBuf.readTypedMsg(c.rd)
+		if err != nil {
+			return err
+		}
+		if c.ignoreTillSync && typ != clientMsgSync {
+			continue
+		}
+		switch typ {
+		case clientMsgSync:
+			c.ignoreTillSync = false
+			err = c.sendReadyForQuery()
+
+		case clientMsgSimpleQuery:
+			err = c.handleSimpleQuery()
+
+		case clientMsgTerminate:
+			return nil
+
+		case clientMsgParse:
+			err = c.handleParse()
+
+		case clientMsgDescribe:
+			err = c.handleDescribe()
+
+		case clientMsgClose:
+			err = c.handleClose()
+
+		case clientMsgBind:
+			err = c.handleBind()
+
+		case clientMsgExecute:
+			err = c.handleExecute()
+
+		case clientMsgFlush:
+			err = c.wr.Flush()
+
+		default:
+			err = c.sendError(util.Errorf("unrecognized client message type %c", typ))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *v3Conn) newRequest(query string, params []driver.Datum) driver.Request {
+	return driver.Request{
+		RequestHeader: driver.RequestHeader{User: c.user, Session: c.session},
+		Sql:           query,
+		Params:        params,
+	}
+}
+
+// newCursorRequest returns a request for the remaining results of a
+// previous request.
+func (c *v3Conn) newCursorRequest(cursor int64) driver.Request {
+	return driver.Request{
+		RequestHeader: driver.RequestHeader{User: c.user},
+		Cursor:        cursor,
+	}
+}
+
+// closeCursor discards the remaining results of a previous request,
+// which releases the descriptor leases held by its execution. It is
+// used when the results can't be sent to the client.
+func (c *v3Conn) closeCursor(cursor int64) {
+	req := c.newCursorRequest(cursor)
+	req.Close = true
+	_, _ = c.executor.Execute(req)
+}
+
+func (c *v3Conn) handleSimpleQuery() error {
+	query, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+
+	if err := c.executeQuery(query); err != nil {
+		return err
+	}
+	c.ignoreTillSync = false
+	return c.sendReadyForQuery()
+}
+
+// executeQuery executes the statements in a simple query, sending the
+// results of each of them to the client. The types of the result columns
+// are determined from the values returned.
+func (c *v3Conn) executeQuery(query string) error {
+	stmts, err := parser.Parse(query)
+	if err != nil {
+		return c.sendError(err)
+	}
+	if len(stmts) == 0 {
+		return c.sendEmptyQuery()
+	}
+
+	// The rows of a result may be returned in several chunks, the last
+	// result of a response being continued by the first result of the
+	// response to the request for its cursor.
+	req := c.newRequest(query, nil)
+	var types []pgType
+	var rows int
+	stmtIdx := 0
+	continued := false
+	var cursor int64
+	defer func() {
+		if cursor != 0 {
+			c.closeCursor(cursor)
+		}
+	}()
+	for {
+		resp, execErr := c.executor.Execute(req)
+		cursor = resp.Cursor
+		for i, result := range resp.Results {
+			if i > 0 || !continued {
+				types = resultTypes(result)
+				rows = 0
+				if len(result.Columns) > 0 {
+					if err := c.sendRowDescription(result.Columns, types, nil); err != nil {
+						return err
+					}
+				}
+			}
+			if err := c.sendDataRows(result.Rows, types, nil); err != nil {
+				return err
+			}
+			rows += len(result.Rows)
+			if i < len(resp.Results)-1 || resp.Cursor == 0 {
+				if err := c.sendCommandComplete(commandTag(stmts[stmtIdx], rows, result.RowsAffected)); err != nil {
+					return err
+				}
+				stmtIdx++
+			}
+		}
+		if execErr != nil {
+			return c.sendError(execErr)
+		}
+		if resp.Cursor == 0 {
+			return c.setSession(resp.Session)
+		}
+		req = c.newCursorRequest(resp.Cursor)
+		continued = true
+	}
+}
+
+// resultTypes returns the types of the columns of a result, determined from
+// the first non-NULL value of each column.
+func resultTypes(result driver.Result) []pgType {
+	types := make([]pgType, len(result.Columns))
+	for j := range types {
+		types[j] = textType
+		for _, row := range result.Rows {
+			if d := row.Values[j]; !isNull(d) {
+				types[j] = typeForDatum(d)
+				break
+			}
+		}
+	}
+	return types
+}
+
+func (c *v3Conn) handleParse() error {
+	name, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	query, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	numTypes, err := c.readBuf.getInt16()
+	if err != nil {
+		return err
+	}
+	inTypes := make([]oid.Oid, numTypes)
+	for i := range inTypes {
+		typ, err := c.readBuf.getInt32()
+		if err != nil {
+			return err
+		}
+		inTypes[i] = oid.Oid(typ)
+	}
+
+	if _, ok := c.preparedStatements[name]; ok && name != "" {
+		return c.sendError(util.Errorf("prepared statement %q already exists", name))
+	}
+	stmts, err := parser.Parse(query)
+	if err != nil {
+		return c.sendError(err)
+	}
+	if len(stmts) > 1 {
+		return c.sendError(util.Errorf("cannot insert multiple commands into a prepared statement"))
+	}
+
+	stmt := preparedStatement{query: query}
+	if len(stmts) == 1 {
+		stmt.stmt = stmts[0]
+		v := placeholderVisitor{}
+		parser.WalkStmt(&v, stmt.stmt)
+		for len(inTypes) < v.max {
+			inTypes = append(inTypes, oid.T_unknown)
+		}
+		for i, typ := range inTypes {
+			if typ == 0 {
+				inTypes[i] = oid.T_unknown
+			}
+		}
+		stmt.columns, err = c.executor.Prepare(c.newRequest(query, nil))
+		if err != nil {
+			return c.sendError(err)
+		}
+	}
+	stmt.inTypes = inTypes
+	c.preparedStatements[name] = stmt
+
+	c.writeBuf.initMsg(serverMsgParseComplete)
+	return c.writeBuf.finishMsg(c.wr)
+}
+
+func (c *v3Conn) handleDescribe() error {
+	typ, err := c.readBuf.getByte()
+	if err != nil {
+		return err
+	}
+	name, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+
+	var stmt preparedStatement
+	var outFormats []formatCode
+	switch typ {
+	case 'S':
+		var ok bool
+		if stmt, ok = c.preparedStatements[name]; !ok {
+			return c.sendError(util.Errorf("unknown prepared statement %q", name))
+		}
+		c.writeBuf.initMsg(serverMsgParameterDescription)
+		c.writeBuf.putInt16(int16(len(stmt.inTypes)))
+		for _, t := range stmt.inTypes {
+			c.writeBuf.putInt32(int32(t))
+		}
+		if err := c.writeBuf.finishMsg(c.wr); err != nil {
+			return err
+		}
+	case 'P':
+		portal, ok := c.preparedPortals[name]
+		if !ok {
+			return c.sendError(util.Errorf("unknown portal %q", name))
+		}
+		stmt, outFormats = portal.stmt, portal.outFormats
+	default:
+		return c.sendError(util.Errorf("invalid describe type %c", typ))
+	}
+
+	if len(stmt.columns) == 0 {
+		c.writeBuf.initMsg(serverMsgNoData)
+		return c.writeBuf.finishMsg(c.wr)
+	}
+	return c.sendRowDescription(stmt.columns, preparedTypes(stmt.columns), outFormats)
+}
+
+func (c *v3Conn) handleClose() error {
+	typ, err := c.readBuf.getByte()
+	if err != nil {
+		return err
+	}
+	name, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	switch typ {
+	case 'S':
+		delete(c.preparedStatements, name)
+	case 'P':
+		delete(c.preparedPortals, name)
+	default:
+		return c.sendError(util.Errorf("invalid close type %c", typ))
+	}
+	c.writeBuf.initMsg(serverMsgCloseComplete)
+	return c.writeBuf.finishMsg(c.wr)
+}
+
+func (c *v3Conn) handleBind() error {
+	portalName, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	stmtName, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	inFormats, err := c.readFormatCodes()
+	if err != nil {
+		return err
+	}
+	numParams, err := c.readBuf.getInt16()
+	if err != nil {
+		return err
+	}
+	params := make([]driver.Datum, numParams)
+	values := make([][]byte, numParams)
+	for i := range values {
+		n, err := c.readBuf.getInt32()
+		if err != nil {
+			return err
+		}
+		if n == -1 {
+			// NULL parameter.
+			continue
+		}
+		if values[i], err = c.readBuf.getBytes(int(n)); err != nil {
+			return err
+		}
+	}
+	outFormats, err := c.readFormatCodes()
+	if err != nil {
+		return err
+	}
+
+	stmt, ok := c.preparedStatements[stmtName]
+	if !ok {
+		return c.sendError(util.Errorf("unknown prepared statement %q", stmtName))
+	}
+	if len(values) != len(stmt.inTypes) {
+		return c.sendError(util.Errorf("expected %d arguments, got %d", len(stmt.inTypes), len(values)))
+	}
+	if len(inFormats) > 1 && len(inFormats) != len(values) {
+		return c.sendError(util.Errorf("expected %d parameter format codes, got %d",
+			len(values), len(inFormats)))
+	}
+	if len(outFormats) > 1 && len(outFormats) != len(stmt.columns) {
+		return c.sendError(util.Errorf("expected %d result format codes, got %d",
+			len(stmt.columns), len(outFormats)))
+	}
+	for i, b := range values {
+		if b == nil {
+			continue
+		}
+		if params[i], err = decodeParam(b, stmt.inTypes[i], formatFor(inFormats, i)); err != nil {
+			return c.sendError(util.Errorf("param $%d: %s", i+1, err))
+		}
+	}
+
+	c.preparedPortals[portalName] = preparedPortal{
+		stmt:       stmt,
+		params:     params,
+		outFormats: outFormats,
+	}
+	c.writeBuf.initMsg(serverMsgBindComplete)
+	return c.writeBuf.finishMsg(c.wr)
+}
+
+func (c *v3Conn) readFormatCodes() ([]formatCode, error) {
+	n, err := c.readBuf.getInt16()
+	if err != nil {
+		return nil, err
+	}
+	formats := make([]formatCode, n)
+	for i := range formats {
+		f, err := c.readBuf.getInt16()
+		if err != nil {
+			return nil, err
+		}
+		formats[i] = formatCode(f)
+	}
+	return formats, nil
+}
+
+func (c *v3Conn) handleExecute() error {
+	portalName, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	// The maximum number of rows to return is ignored: all of the rows are
+	// returned by the first Execute message.
+	if _, err := c.readBuf.getInt32(); err != nil {
+		return err
+	}
+
+	portal, ok := c.preparedPortals[portalName]
+	if !ok {
+		return c.sendError(util.Errorf("unknown portal %q", portalName))
+	}
+	if portal.stmt.stmt == nil {
+		return c.sendEmptyQuery()
+	}
+
+	req := c.newRequest(portal.stmt.query, portal.params)
+	rows := 0
+	var rowsAffected int64
+	var cursor int64
+	defer func() {
+		if cursor != 0 {
+			c.closeCursor(cursor)
+		}
+	}()
+	for {
+		resp, err := c.executor.Execute(req)
+		if err != nil {
+			return c.sendError(err)
+		}
+		cursor = resp.Cursor
+		for _, result := range resp.Results {
+			if err := c.sendDataRows(result.Rows, preparedTypes(result.Columns), portal.outFormats); err != nil {
+				return err
+			}
+			rows += len(result.Rows)
+			rowsAffected = result.RowsAffected
+		}
+		if resp.Cursor == 0 {
+			if err := c.setSession(resp.Session); err != nil {
+				return err
+			}
+			break
+		}
+		req = c.newCursorRequest(resp.Cursor)
+	}
+	return c.sendCommandComplete(commandTag(portal.stmt.stmt, rows, rowsAffected))
+}
+
+// setSession records the session returned with the results of a statement.
+// A change of the session time zone is reported to the client, as
+// PostgreSQL does for the TimeZone parameter.
+func (c *v3Conn) setSession(b []byte) error {
+	c.session = b
+	var session sql.Session
+	if err := gogoproto.Unmarshal(b, &session); err != nil {
+		return err
+	}
+	if tz := session.TimeZoneName(); tz != c.timeZone {
+		c.timeZone = tz
+		return c.sendParameterStatus("TimeZone", tz)
+	}
+	return nil
+}
+
+func (c *v3Conn) sendParameterStatus(key, value string) error {
+	c.writeBuf.initMsg(serverMsgParameterStatus)
+	c.writeBuf.writeCString(key)
+	c.writeBuf.writeCString(value)
+	return c.writeBuf.finishMsg(c.wr)
+}
+
+func (c *v3Conn) sendReadyForQuery() error {
+	c.writeBuf.initMsg(serverMsgReady)
+	_ = c.writeBuf.WriteByte('I')
+	if err := c.writeBuf.finishMsg(c.wr); err != nil {
+		return err
+	}
+	return c.wr.Flush()
+}
+
+func (c *v3Conn) sendEmptyQuery() error {
+	c.writeBuf.initMsg(serverMsgEmptyQuery)
+	return c.writeBuf.finishMsg(c.wr)
+}
+
+func (c *v3Conn) sendCommandComplete(tag string) error {
+	c.writeBuf.initMsg(serverMsgCommandComplete)
+	c.writeBuf.writeCString(tag)
+	return c.writeBuf.finishMsg(c.wr)
+}
+
+// sendError sends an ErrorResponse to the client. Any subsequent extended
+// query messages are ignored until the next Sync message.
+func (c *v3Conn) sendError(err error) error {
+	c.ignoreTillSync = true
+	c.writeBuf.initMsg(serverMsgErrorResponse)
+	_ = c.writeBuf.WriteByte('S')
+	c.writeBuf.writeCString("ERROR")
+	// "XX000" is the code for an internal error.
+	_ = c.writeBuf.WriteByte('C')
+	c.writeBuf.writeCString("XX000")
+	_ = c.writeBuf.WriteByte('M')
+	c.writeBuf.writeCString(err.Error())
+	_ = c.writeBuf.WriteByte(0)
+	return c.writeBuf.finishMsg(c.wr)
+}
+
+func (c *v3Conn) sendRowDescription(columns []string, types []pgType, formats []formatCode) error {
+	c.writeBuf.initMsg(serverMsgRowDescription)
+	c.writeBuf.putInt16(int16(len(columns)))
+	for i, name := range columns {
+		c.writeBuf.writeCString(name)
+		c.writeBuf.putInt32(0) // Table OID (optional).
+		c.writeBuf.putInt16(0) // Column attribute ID (optional).
+		c.writeBuf.putInt32(int32(types[i].oid))
+		c.writeBuf.putInt16(types[i].size)
+		c.writeBuf.putInt32(-1) // Type modifier.
+		c.writeBuf.putInt16(int16(formatFor(formats, i)))
+	}
+	return c.writeBuf.finishMsg(c.wr)
+}
+
+func (c *v3Conn) sendDataRows(rows []driver.Result_Row, types []pgType, formats []formatCode) error {
+	for _, row := range rows {
+		c.writeBuf.initMsg(serverMsgDataRow)
+		c.writeBuf.putInt16(int16(len(row.Values)))
+		for i, d := range row.Values {
+			c.writeBuf.writeDatum(d, types[i], formatFor(formats, i))
+		}
+		if err := c.writeBuf.finishMsg(c.wr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatFor returns the format of the i'th value given the format codes
+// supplied by the client: no codes means all values use the text format
+// and a single code applies to all values.
+func formatFor(formats []formatCode, i int) formatCode {
+	switch len(formats) {
+	case 0:
+		return formatText
+	case 1:
+		return formats[0]
+	}
+	return formats[i]
+}
+
+// preparedTypes returns the types of the result columns of a prepared
+// statement. The types of the values a statement returns are not known
+// until it is executed, so the columns are described as text.
+func preparedTypes(columns []string) []pgType {
+	types := make([]pgType, len(columns))
+	for i := range types {
+		types[i] = textType
+	}
+	return types
+}
+
+// commandTag returns the tag sent in the CommandComplete message for a
+// statement which returned the given number of rows and wrote the given
+// number of rows.
+func commandTag(stmt parser.Statement, rows int, rowsAffected int64) string {
+	switch stmt.(type) {
+	case *parser.Select, *parser.Union, parser.Values, *parser.ShowColumns,
+		*parser.ShowDatabases, *parser.ShowGrants, *parser.ShowIndex, *parser.ShowTables:
+		return fmt.Sprintf("SELECT %d", rows)
+	case *parser.Insert:
+		return fmt.Sprintf("INSERT 0 %d", rowsAffected)
+	case *parser.Update:
+		return fmt.Sprintf("UPDATE %d", rowsAffected)
+	case *parser.Delete:
+		return fmt.Sprintf("DELETE %d", rowsAffected)
+	case *parser.CreateDatabase:
+		return "CREATE DATABASE"
+	case *parser.CreateTable:
+		return "CREATE TABLE"
+	case *parser.DropDatabase:
+		return "DROP DATABASE"
+	case *parser.DropTable:
+		return "DROP TABLE"
+	}
+	return strings.SplitN(stmt.String(), " ", 2)[0]
+}
+
+// placeholderVisitor records the highest numbered placeholder in a
+// statement.
+type placeholderVisitor struct {
+	max int
+}
+
+var _ parser.Visitor = &placeholderVisitor{}
+
+func (v *placeholderVisitor) Visit(expr parser.Expr) parser.Expr {
+	if p, ok := expr.(parser.ValArg); ok && int(p) > v.max {
+		v.max = int(p)
+	}
+	return expr
+}
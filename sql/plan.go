@@ -18,20 +18,57 @@
 package sql
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/structured"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+
+	gogoproto "github.com/gogo/protobuf/proto"
 )
 
 // planner is the centerpiece of SQL statement execution combining session
 // state and database state with the logic for SQL execution.
 type planner struct {
-	db      *client.DB
-	session Session
+	db        *client.DB
+	user      string
+	session   Session
+	leaseMgr  *LeaseManager
+	leases    []*LeaseState
+	uniqueIDs *uniqueIDGenerator
+	queries   *queryRegistry
+	// rowsRead counts the table rows read by the current statement.
+	rowsRead int64
+	// rowsAffected counts the rows written by the current INSERT, UPDATE or
+	// DELETE statement.
+	rowsAffected int64
+	// readTimestamp is the timestamp at which the current statement's
+	// non-transactional scans read, so that all of the chunks of a
+	// result returned through a cursor are read at the same timestamp.
+	// It is set by the first such scan.
+	readTimestamp proto.Timestamp
+	// sequences caches the leased descriptors of the sequences used by the
+	// current statement.
+	sequences map[string]*structured.TableDescriptor
+}
+
+// descriptorProto is the interface implemented by both DatabaseDescriptor
+// and TableDescriptor.
+type descriptorProto interface {
+	gogoproto.Message
+	GetID() uint32
+	GetName() string
+	GetPrivileges() *structured.PrivilegeDescriptor
+	SetID(uint32)
+	TypeName() string
+	Validate() error
 }
 
 // makePlan creates the query plan for a single SQL statement. The returned
@@ -39,26 +76,52 @@ type planner struct {
 // in order to retrieve matching rows.
 func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 	switch n := stmt.(type) {
+	case *parser.AlterTable:
+		return p.AlterTable(n)
+	case *parser.CancelQuery:
+		return p.CancelQuery(n)
 	case *parser.CreateDatabase:
 		return p.CreateDatabase(n)
+	case *parser.CreateSequence:
+		return p.CreateSequence(n)
 	case *parser.CreateTable:
 		return p.CreateTable(n)
+	case *parser.CreateView:
+		return p.CreateView(n)
 	case *parser.Delete:
 		return p.Delete(n)
+	case *parser.DropDatabase:
+		return p.DropDatabase(n)
+	case *parser.DropTable:
+		return p.DropTable(n)
+	case *parser.DropView:
+		return p.DropView(n)
+	case *parser.Grant:
+		return p.Grant(n)
+	case *parser.Import:
+		return p.Import(n)
 	case *parser.Insert:
 		return p.Insert(n)
+	case *parser.Revoke:
+		return p.Revoke(n)
 	case *parser.Select:
 		return p.Select(n)
 	case *parser.Set:
 		return p.Set(n)
+	case *parser.Show:
+		return p.Show(n)
 	case *parser.ShowColumns:
 		return p.ShowColumns(n)
 	case *parser.ShowDatabases:
 		return p.ShowDatabases(n)
+	case *parser.ShowGrants:
+		return p.ShowGrants(n)
 	case *parser.ShowIndex:
 		return p.ShowIndex(n)
 	case *parser.ShowTables:
 		return p.ShowTables(n)
+	case *parser.Union:
+		return p.Union(n)
 	case *parser.Update:
 		return p.Update(n)
 	case parser.Values:
@@ -69,6 +132,14 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 }
 
 func (p *planner) getAliasedTableDesc(n parser.TableExpr) (*structured.TableDescriptor, error) {
+	table, err := getAliasedTableName(n)
+	if err != nil {
+		return nil, err
+	}
+	return p.getTableLease(table)
+}
+
+func getAliasedTableName(n parser.TableExpr) (parser.QualifiedName, error) {
 	ate, ok := n.(*parser.AliasedTableExpr)
 	if !ok {
 		return nil, util.Errorf("TODO(pmattis): unsupported FROM: %s", n)
@@ -77,38 +148,214 @@ func (p *planner) getAliasedTableDesc(n parser.TableExpr) (*structured.TableDesc
 	if !ok {
 		return nil, util.Errorf("TODO(pmattis): unsupported FROM: %s", n)
 	}
-	return p.getTableDesc(table)
+	return table, nil
 }
 
-func (p *planner) getTableDesc(qname parser.QualifiedName) (
+// getTableLease acquires a lease on the descriptor of the specified table and
+// returns the leased descriptor, which must not be modified. The lease is held
+// until releaseLeases is called at the end of the statement.
+func (p *planner) getTableLease(qname parser.QualifiedName) (
+	*structured.TableDescriptor, error) {
+	desc, err := p.getTableOrViewLease(qname)
+	if err != nil {
+		return nil, err
+	}
+	if desc.IsView() {
+		return nil, fmt.Errorf("\"%s\" is a view", qname)
+	}
+	return desc, nil
+}
+
+// getTableOrViewLease acquires a lease on the descriptor of the specified
+// table or view. See getTableLease.
+func (p *planner) getTableOrViewLease(qname parser.QualifiedName) (
+	*structured.TableDescriptor, error) {
+	desc, err := p.getDescLease(qname)
+	if err != nil {
+		return nil, err
+	}
+	if desc.IsSequence {
+		return nil, fmt.Errorf("\"%s\" is a sequence", qname)
+	}
+	return desc, nil
+}
+
+// getDescLease acquires a lease on the descriptor of the specified table or
+// sequence.
+func (p *planner) getDescLease(qname parser.QualifiedName) (
 	*structured.TableDescriptor, error) {
 	var err error
 	qname, err = p.normalizeTableName(qname)
 	if err != nil {
 		return nil, err
 	}
-	dbID, err := p.lookupDatabase(qname.Database())
+	// Try the cached name resolution first. The cache is only invalidated for
+	// names dropped on this node, so retry with a fresh lookup on failure.
+	lease, err := p.acquireTableLease(qname, true)
 	if err != nil {
-		return nil, err
+		if lease, err = p.acquireTableLease(qname, false); err != nil {
+			return nil, err
+		}
 	}
-	gr, err := p.db.Get(keys.MakeNameMetadataKey(dbID, qname.Table()))
+	p.leases = append(p.leases, lease)
+	return &lease.TableDescriptor, nil
+}
+
+func (p *planner) acquireTableLease(qname parser.QualifiedName, useCache bool) (
+	*LeaseState, error) {
+	dbID, err := p.lookupID(keys.MakeNameMetadataKey(structured.RootNamespaceID, qname.Database()), useCache)
 	if err != nil {
 		return nil, err
+	} else if dbID == 0 {
+		return nil, fmt.Errorf("database \"%s\" does not exist", qname.Database())
 	}
-	if !gr.Exists() {
+	tableID, err := p.lookupID(keys.MakeNameMetadataKey(dbID, qname.Table()), useCache)
+	if err != nil {
+		return nil, err
+	} else if tableID == 0 {
 		return nil, fmt.Errorf("table \"%s\" does not exist", qname)
 	}
-	descKey := gr.ValueBytes()
+	return p.leaseMgr.Acquire(tableID)
+}
+
+// lookupID returns the ID of the descriptor referenced by the name key, or 0
+// if the name key does not exist.
+func (p *planner) lookupID(nameKey proto.Key, useCache bool) (uint32, error) {
+	if useCache {
+		if id, ok := p.leaseMgr.cachedID(nameKey); ok {
+			return id, nil
+		}
+	}
+	gr, err := p.db.Get(nameKey)
+	if err != nil {
+		return 0, err
+	} else if !gr.Exists() {
+		p.leaseMgr.uncacheID(nameKey)
+		return 0, nil
+	}
+	_, id := encoding.DecodeUvarint(bytes.TrimPrefix(gr.ValueBytes(), keys.DescMetadataPrefix))
+	p.leaseMgr.cacheID(nameKey, uint32(id))
+	return uint32(id), nil
+}
+
+// releaseLeases releases the leases acquired while executing a statement.
+func (p *planner) releaseLeases() {
+	for _, lease := range p.leases {
+		if err := p.leaseMgr.Release(lease); err != nil {
+			log.Warning(err)
+		}
+	}
+	p.leases = nil
+	p.sequences = nil
+}
+
+func (p *planner) getTableDesc(qname parser.QualifiedName) (
+	*structured.TableDescriptor, error) {
+	var err error
+	qname, err = p.normalizeTableName(qname)
+	if err != nil {
+		return nil, err
+	}
+	dbDesc, err := p.getDatabaseDesc(qname.Database())
+	if err != nil {
+		return nil, err
+	}
 	desc := structured.TableDescriptor{}
-	if err := p.db.GetProto(descKey, &desc); err != nil {
+	if found, err := p.getDescriptor(keys.MakeNameMetadataKey(dbDesc.ID, qname.Table()), &desc); err != nil {
 		return nil, err
+	} else if !found {
+		return nil, fmt.Errorf("table \"%s\" does not exist", qname)
 	}
-	if err := desc.Validate(); err != nil {
+	return &desc, nil
+}
+
+// getDatabaseDesc looks up the database descriptor given its name.
+func (p *planner) getDatabaseDesc(name string) (*structured.DatabaseDescriptor, error) {
+	desc := structured.DatabaseDescriptor{}
+	if found, err := p.getDescriptor(keys.MakeNameMetadataKey(structured.RootNamespaceID, name), &desc); err != nil {
 		return nil, err
+	} else if !found {
+		return nil, fmt.Errorf("database \"%s\" does not exist", name)
 	}
 	return &desc, nil
 }
 
+// getDescriptor looks up the descriptor referenced by the name key and
+// unmarshals it into desc. Returns false if the name key does not exist.
+func (p *planner) getDescriptor(nameKey proto.Key, desc descriptorProto) (bool, error) {
+	gr, err := p.db.Get(nameKey)
+	if err != nil {
+		return false, err
+	} else if !gr.Exists() {
+		return false, nil
+	}
+	descKey := gr.ValueBytes()
+	if err := p.db.GetProto(descKey, desc); err != nil {
+		return false, err
+	}
+	return true, desc.Validate()
+}
+
+// createDescriptor allocates an ID for the descriptor and writes it along
+// with the name key referencing it. Returns false without writing anything
+// if the name key already exists and ifNotExists is true.
+func (p *planner) createDescriptor(nameKey proto.Key, desc descriptorProto, ifNotExists bool) (bool, error) {
+	// This isn't strictly necessary as the conditional put below will fail if
+	// the key already exists, but it seems good to avoid the descriptor ID
+	// allocation in most cases when the descriptor already exists.
+	if gr, err := p.db.Get(nameKey); err != nil {
+		return false, err
+	} else if gr.Exists() {
+		if ifNotExists {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s \"%s\" already exists", desc.TypeName(), desc.GetName())
+	}
+
+	ir, err := p.db.Inc(keys.DescIDGenerator, 1)
+	if err != nil {
+		return false, err
+	}
+	desc.SetID(uint32(ir.ValueInt() - 1))
+	if err := desc.Validate(); err != nil {
+		return false, err
+	}
+
+	// TODO(pmattis): Be cognizant of error messages when this is ported to the
+	// server. The error currently returned below is likely going to be difficult
+	// to interpret.
+	err = p.db.Txn(func(txn *client.Txn) error {
+		descKey := keys.MakeDescMetadataKey(desc.GetID())
+		b := &client.Batch{}
+		b.CPut(nameKey, descKey, nil)
+		b.Put(descKey, desc)
+		return txn.Commit(b)
+	})
+	return err == nil, err
+}
+
+// txn runs retryable in a transaction using the session's default
+// isolation level. It is used by statements which read and write table
+// data; schema changes always use serializable transactions.
+func (p *planner) txn(retryable func(txn *client.Txn) error) error {
+	return p.db.Txn(func(txn *client.Txn) error {
+		if p.session.DefaultIsolation == proto.SNAPSHOT {
+			txn.SetSnapshotIsolation()
+		}
+		return retryable(txn)
+	})
+}
+
+// checkPrivilege returns an error if the planner's user does not have the
+// specified privilege on the descriptor.
+func (p *planner) checkPrivilege(desc descriptorProto, priv privilege.Kind) error {
+	if desc.GetPrivileges().CheckPrivilege(p.user, priv) {
+		return nil
+	}
+	return fmt.Errorf("user %s does not have %s privilege on %s %s",
+		p.user, priv, desc.TypeName(), desc.GetName())
+}
+
 func (p *planner) normalizeTableName(qname parser.QualifiedName) (
 	parser.QualifiedName, error) {
 	if len(qname) == 0 {
@@ -122,16 +369,6 @@ func (p *planner) normalizeTableName(qname parser.QualifiedName) (
 	}
 	return qname, nil
 }
-func (p *planner) lookupDatabase(name string) (uint32, error) {
-	nameKey := keys.MakeNameMetadataKey(structured.RootNamespaceID, name)
-	gr, err := p.db.Get(nameKey)
-	if err != nil {
-		return 0, err
-	} else if !gr.Exists() {
-		return 0, fmt.Errorf("database \"%s\" does not exist", name)
-	}
-	return uint32(gr.ValueInt()), nil
-}
 
 // planNode defines the interface for executing a query or portion of a query.
 type planNode interface {
@@ -150,4 +387,4 @@ type planNode interface {
 var _ planNode = &scanNode{}
 var _ planNode = &valuesNode{}
 
-// TODO(pmattis): orderByNode, groupByNode, joinNode.
+// TODO(pmattis): groupByNode, joinNode.
@@ -0,0 +1,169 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+var errQueryCanceled = errors.New("query canceled")
+
+// queryIDCounterBits is the number of low-order bits of a query ID holding
+// the per-node counter. The remaining bits hold the ID of the node executing
+// the statement.
+const queryIDCounterBits = 32
+
+// RunningQuery describes a statement being executed by a server. The ID
+// identifies the statement in CANCEL QUERY and is unique across the cluster.
+type RunningQuery struct {
+	ID    int64     `json:"id"`
+	User  string    `json:"user"`
+	SQL   string    `json:"sql"`
+	Start time.Time `json:"start"`
+}
+
+// runningQuery holds the context of a running statement. Canceling the
+// context makes the statement's KV operations fail.
+type runningQuery struct {
+	RunningQuery
+	ctx     context.Context
+	cancel  context.CancelFunc
+	timeout time.Duration
+	stmt    parser.Statement
+	// rowsReturned is the number of result rows returned so far.
+	rowsReturned int64
+}
+
+// err translates the error of a failed statement into a cancellation or
+// timeout error if the statement's context is done.
+func (q *runningQuery) err(err error) error {
+	switch q.ctx.Err() {
+	case context.Canceled:
+		return errQueryCanceled
+	case context.DeadlineExceeded:
+		return fmt.Errorf("query exceeded the statement timeout of %s", q.timeout)
+	}
+	return err
+}
+
+// queryRegistry tracks the statements being executed by a server so that
+// they can be listed and canceled. Query IDs combine the ID of the node with
+// a counter so that the node executing a statement can be told from its ID.
+type queryRegistry struct {
+	nodeID uint32
+
+	mu      sync.Mutex // Protects the fields below
+	queries map[int64]*runningQuery
+	lastID  int64
+}
+
+func newQueryRegistry(nodeID uint32) *queryRegistry {
+	return &queryRegistry{nodeID: nodeID, queries: map[int64]*runningQuery{}}
+}
+
+// register adds a statement to the registry, returning it with a new
+// context which expires after timeout, if timeout is non-zero.
+func (r *queryRegistry) register(user, sql string, timeout time.Duration) *runningQuery {
+	q := &runningQuery{
+		RunningQuery: RunningQuery{User: user, SQL: sql, Start: time.Now()},
+		timeout:      timeout,
+	}
+	if timeout > 0 {
+		q.ctx, q.cancel = context.WithTimeout(context.Background(), timeout)
+	} else {
+		q.ctx, q.cancel = context.WithCancel(context.Background())
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastID++
+	q.ID = int64(r.nodeID)<<queryIDCounterBits | r.lastID&(1<<queryIDCounterBits-1)
+	r.queries[q.ID] = q
+	return q
+}
+
+// unregister removes a finished statement from the registry and releases
+// the resources of its context.
+func (r *queryRegistry) unregister(q *runningQuery) {
+	q.cancel()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.queries, q.ID)
+}
+
+// cancel cancels the statement with the specified ID on behalf of user.
+// Users other than root may only cancel their own statements. Statements
+// running on other nodes cannot be canceled through this node.
+func (r *queryRegistry) cancel(id int64, user string) error {
+	if nodeID := uint32(id >> queryIDCounterBits); nodeID != r.nodeID {
+		return fmt.Errorf("query %d is running on node %d; cancel it through a connection to that node",
+			id, nodeID)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.queries[id]
+	if !ok {
+		return fmt.Errorf("query %d not found", id)
+	}
+	if user != security.RootUser && user != q.User {
+		return fmt.Errorf("user %s does not have permission to cancel query %d", user, id)
+	}
+	q.cancel()
+	return nil
+}
+
+// list returns the running statements ordered by ID.
+func (r *queryRegistry) list() []RunningQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	queries := make([]RunningQuery, 0, len(r.queries))
+	for _, q := range r.queries {
+		queries = append(queries, q.RunningQuery)
+	}
+	sort.Sort(runningQueriesByID(queries))
+	return queries
+}
+
+type runningQueriesByID []RunningQuery
+
+func (q runningQueriesByID) Len() int           { return len(q) }
+func (q runningQueriesByID) Less(i, j int) bool { return q[i].ID < q[j].ID }
+func (q runningQueriesByID) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+// CancelQuery cancels a running statement.
+func (p *planner) CancelQuery(n *parser.CancelQuery) (planNode, error) {
+	val, err := parser.EvalExpr(n.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	id, ok := val.(parser.DInt)
+	if !ok {
+		return nil, util.Errorf("query ID must be an integer, not %s", val.Type())
+	}
+	if err := p.queries.cancel(int64(id), p.user); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
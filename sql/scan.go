@@ -30,16 +30,24 @@ import (
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
+// scanChunkSize is the maximum number of key/value pairs retrieved by a
+// scanNode at once.
+var scanChunkSize int64 = 10000
+
 // A scanNode handles scanning over the key/value pairs for a table and
 // reconstructing them into rows.
 type scanNode struct {
 	db         *client.DB
+	txn        *client.Txn // if set, the key/value pairs are read within txn
+	p          *planner    // provides the functions implemented by the planner
 	desc       *structured.TableDescriptor
 	columns    []string
 	err        error
 	primaryKey []byte            // the primary key of the current row
 	kvs        []client.KeyValue // the raw key/value pairs
 	kvIndex    int               // current index into the key/value pairs
+	spanStart  proto.Key         // the start of the span left to retrieve, if any
+	spanEnd    proto.Key         // the end of the span left to retrieve
 	vals       valMap            // the values in the current row
 	row        parser.DTuple     // the rendered row
 	filter     parser.Expr       // filtering expression for rows
@@ -66,14 +74,12 @@ func (n *scanNode) Next() bool {
 			n.kvs = []client.KeyValue{}
 			n.primaryKey = []byte{}
 		} else {
-			// Retrieve all of the keys that start with our index key prefix.
-			startKey := proto.Key(encodeIndexKeyPrefix(n.desc.ID, n.desc.Indexes[0].ID))
-			endKey := startKey.PrefixEnd()
-			// TODO(pmattis): Currently we retrieve all of the key/value pairs for
-			// the table. We could enhance this code so that it retrieves the
-			// key/value pairs in chunks.
-			n.kvs, n.err = n.db.Scan(startKey, endKey, 0)
-			if n.err != nil {
+			// Retrieve the keys that start with our index key prefix. They are
+			// retrieved in chunks so that the scan of a large table can be
+			// interrupted by canceling the statement.
+			n.spanStart = proto.Key(encodeIndexKeyPrefix(n.desc.ID, n.desc.Indexes[0].ID))
+			n.spanEnd = n.spanStart.PrefixEnd()
+			if !n.fetchKVs() {
 				return false
 			}
 		}
@@ -86,6 +92,13 @@ func (n *scanNode) Next() bool {
 	// column name. When the index key changes we output a row containing the
 	// current values.
 	for {
+		if n.kvIndex == len(n.kvs) && n.spanStart != nil {
+			// The rows continue in the next chunk of key/value pairs.
+			if !n.fetchKVs() {
+				return false
+			}
+		}
+
 		var kv client.KeyValue
 		if n.kvIndex < len(n.kvs) {
 			kv = n.kvs[n.kvIndex]
@@ -95,6 +108,9 @@ func (n *scanNode) Next() bool {
 			(n.kvIndex == len(n.kvs) || !bytes.HasPrefix(kv.Key, n.primaryKey)) {
 			// The current key belongs to a new row. Output the current row.
 			n.primaryKey = nil
+			if n.p != nil && n.desc != nil {
+				n.p.rowsRead++
+			}
 			var output bool
 			output, n.err = n.filterRow()
 			if n.err != nil {
@@ -113,8 +129,12 @@ func (n *scanNode) Next() bool {
 		}
 
 		if n.primaryKey == nil {
-			// This is the first key for the row, reset our vals map.
+			// This is the first key for the row, reset our vals map. NULL values
+			// are not stored, so every column starts out as NULL.
 			n.vals = valMap{}
+			for _, col := range n.desc.Columns {
+				n.vals[col.Name] = parser.DNull{}
+			}
 		}
 
 		var remaining []byte
@@ -143,6 +163,31 @@ func (n *scanNode) Next() bool {
 	}
 }
 
+// fetchKVs retrieves the next chunk of key/value pairs of the table.
+func (n *scanNode) fetchKVs() bool {
+	if n.txn != nil {
+		n.kvs, n.err = n.txn.Scan(n.spanStart, n.spanEnd, scanChunkSize)
+	} else {
+		if n.p.readTimestamp.Equal(proto.ZeroTimestamp) {
+			n.p.readTimestamp = n.p.leaseMgr.clock.Now()
+		}
+		n.kvs, n.err = n.db.ScanAt(n.spanStart, n.spanEnd, scanChunkSize, n.p.readTimestamp)
+	}
+	if n.err != nil {
+		return false
+	}
+	if n.kvs == nil {
+		n.kvs = []client.KeyValue{}
+	}
+	n.kvIndex = 0
+	if int64(len(n.kvs)) < scanChunkSize {
+		n.spanStart = nil
+	} else {
+		n.spanStart = proto.Key(n.kvs[len(n.kvs)-1].Key).Next()
+	}
+	return true
+}
+
 func (n *scanNode) Err() error {
 	return n.err
 }
@@ -151,10 +196,13 @@ func (n *scanNode) filterRow() (bool, error) {
 	if n.filter == nil {
 		return true, nil
 	}
-	d, err := parser.EvalExpr(n.filter, n.vals)
+	d, err := parser.EvalExpr(n.filter, funcEnv{env: n.vals, p: n.p})
 	if err != nil {
 		return false, err
 	}
+	if d == (parser.DNull{}) {
+		return false, nil
+	}
 	v, ok := d.(parser.DBool)
 	if !ok {
 		return false, fmt.Errorf("WHERE clause did not evaluate to a boolean")
@@ -168,7 +216,7 @@ func (n *scanNode) renderRow() error {
 	}
 	for i, e := range n.render {
 		var err error
-		n.row[i], err = parser.EvalExpr(e, n.vals)
+		n.row[i], err = parser.EvalExpr(e, funcEnv{env: n.vals, p: n.p})
 		if err != nil {
 			return err
 		}
@@ -21,11 +21,13 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/structured"
 	"github.com/cockroachdb/cockroach/util"
 )
 
 // Select selects rows from a single table.
+// Privileges: SELECT on table.
 func (p *planner) Select(n *parser.Select) (planNode, error) {
 	var desc *structured.TableDescriptor
 
@@ -34,30 +36,110 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 		// desc remains nil.
 
 	case 1:
-		var err error
-		desc, err = p.getAliasedTableDesc(n.From[0])
+		table, err := getAliasedTableName(n.From[0])
+		if err != nil {
+			return nil, err
+		}
+		desc, err = p.getTableOrViewLease(table)
 		if err != nil {
 			return nil, err
 		}
 
+		if err := p.checkPrivilege(desc, privilege.SELECT); err != nil {
+			return nil, err
+		}
+
 	default:
 		return nil, util.Errorf("TODO(pmattis): unsupported FROM: %s", n.From)
 	}
 
-	// Loop over the select expressions and expand them into the expressions
-	// we're going to use to generate the returned column set and the names for
-	// those columns.
-	exprs := make([]parser.Expr, 0, len(n.Exprs))
-	columns := make([]string, 0, len(n.Exprs))
-	for _, e := range n.Exprs {
+	var tableColumns []string
+	if desc != nil {
+		if desc.IsView() {
+			tableColumns = desc.ViewColumns
+		} else {
+			for _, col := range desc.Columns {
+				tableColumns = append(tableColumns, col.Name)
+			}
+		}
+	}
+	columns, exprs, err := expandSelectExprs(n.Exprs, tableColumns)
+	if err != nil {
+		return nil, err
+	}
+	// The ORDER BY expressions which are not result columns are rendered as
+	// additional columns.
+	ordering, renderColumns, exprs, err := resolveOrderBy(n.OrderBy, columns, exprs)
+	if err != nil {
+		return nil, err
+	}
+	distinct := n.Distinct != ""
+	if distinct && len(exprs) > len(columns) {
+		return nil, fmt.Errorf("for SELECT DISTINCT, ORDER BY expressions must appear in select list")
+	}
+	var filter parser.Expr
+	if n.Where != nil {
+		filter = n.Where.Expr
+	}
+
+	var plan planNode
+	if desc != nil && desc.IsView() {
+		plan, err = p.selectFromView(desc, renderColumns, exprs, filter)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		plan = &scanNode{
+			db:      p.db,
+			p:       p,
+			desc:    desc,
+			columns: renderColumns,
+			render:  exprs,
+			filter:  filter,
+		}
+	}
+	return p.orderDistinctLimit(plan, ordering, len(columns), distinct, n.Limit)
+}
+
+// orderDistinctLimit wraps a plan with the nodes sorting its rows, removing
+// duplicate rows and applying a LIMIT clause. Only the first numColumns
+// columns of the plan are returned, the remaining ones are only used for
+// sorting.
+func (p *planner) orderDistinctLimit(plan planNode, ordering []columnOrderInfo,
+	numColumns int, distinct bool, limit *parser.Limit) (planNode, error) {
+	if len(ordering) > 0 {
+		plan = &sortNode{plan: plan, ordering: ordering, numColumns: numColumns}
+	}
+	if distinct {
+		plan = newDistinctNode(plan, ordering)
+	}
+	if limit != nil {
+		count, offset, err := p.evalLimit(limit)
+		if err != nil {
+			return nil, err
+		}
+		plan = &limitNode{plan: plan, count: count, offset: offset}
+	}
+	return plan, nil
+}
+
+// expandSelectExprs loops over the select expressions and expands them into
+// the names of the returned columns and the expressions generating them. A
+// star expands to the named columns of the table, which are nil if there is
+// no table.
+func expandSelectExprs(selectExprs parser.SelectExprs, tableColumns []string) (
+	[]string, []parser.Expr, error) {
+	exprs := make([]parser.Expr, 0, len(selectExprs))
+	columns := make([]string, 0, len(selectExprs))
+	for _, e := range selectExprs {
 		switch t := e.(type) {
 		case *parser.StarExpr:
-			if desc == nil {
-				return nil, fmt.Errorf("* with no tables specified is not valid")
+			if tableColumns == nil {
+				return nil, nil, fmt.Errorf("* with no tables specified is not valid")
 			}
-			for _, col := range desc.Columns {
-				columns = append(columns, col.Name)
-				exprs = append(exprs, parser.QualifiedName{col.Name})
+			for _, name := range tableColumns {
+				columns = append(columns, name)
+				exprs = append(exprs, parser.QualifiedName{name})
 			}
 		case *parser.NonStarExpr:
 			exprs = append(exprs, t.Expr)
@@ -70,15 +152,5 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 			}
 		}
 	}
-
-	s := &scanNode{
-		db:      p.db,
-		desc:    desc,
-		columns: columns,
-		render:  exprs,
-	}
-	if n.Where != nil {
-		s.filter = n.Where.Expr
-	}
-	return s, nil
+	return columns, exprs, nil
 }
@@ -0,0 +1,218 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/hlc"
+)
+
+// CreateSequence creates a sequence. A sequence is described by a table
+// descriptor without columns and its current value is stored at the table
+// prefix of the descriptor ID.
+// Privileges: CREATE on database.
+// The new sequence inherits the privileges of its database.
+func (p *planner) CreateSequence(n *parser.CreateSequence) (planNode, error) {
+	var err error
+	n.Name, err = p.normalizeTableName(n.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	dbDesc, err := p.getDatabaseDesc(n.Name.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkPrivilege(dbDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
+	desc := structured.TableDescriptor{
+		Name:       n.Name.String(),
+		IsSequence: true,
+		Privileges: dbDesc.GetPrivileges(),
+	}
+	nameKey := keys.MakeNameMetadataKey(dbDesc.ID, n.Name.Table())
+	if _, err := p.createDescriptor(nameKey, &desc, n.IfNotExists); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// getSequenceLease returns the leased descriptor of the named sequence. The
+// descriptor is looked up once per statement.
+func (p *planner) getSequenceLease(name string) (*structured.TableDescriptor, error) {
+	qname, err := p.normalizeTableName(parser.QualifiedName(strings.Split(name, ".")))
+	if err != nil {
+		return nil, err
+	}
+	key := qname.String()
+	if desc, ok := p.sequences[key]; ok {
+		return desc, nil
+	}
+	desc, err := p.getDescLease(qname)
+	if err != nil {
+		return nil, err
+	}
+	if !desc.IsSequence {
+		return nil, fmt.Errorf("\"%s\" is not a sequence", qname)
+	}
+	if p.sequences == nil {
+		p.sequences = map[string]*structured.TableDescriptor{}
+	}
+	p.sequences[key] = desc
+	return desc, nil
+}
+
+// nextval increments the named sequence and returns its new value. The value
+// is remembered by the session for currval. Increments are not transactional:
+// a value is never returned twice, even if the statement fails.
+// Privileges: UPDATE on sequence.
+func (p *planner) nextval(name string) (parser.Datum, error) {
+	desc, err := p.getSequenceLease(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(desc, privilege.UPDATE); err != nil {
+		return nil, err
+	}
+	ir, err := p.db.Inc(proto.Key(encodeTablePrefix(desc.ID)), 1)
+	if err != nil {
+		return nil, err
+	}
+	val := ir.ValueInt()
+	p.session.setSequenceValue(desc.ID, val)
+	return parser.DInt(val), nil
+}
+
+// currval returns the value most recently returned by nextval for the named
+// sequence in the session.
+// Privileges: SELECT on sequence.
+func (p *planner) currval(name string) (parser.Datum, error) {
+	desc, err := p.getSequenceLease(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(desc, privilege.SELECT); err != nil {
+		return nil, err
+	}
+	for _, v := range p.session.SequenceValues {
+		if v.ID == desc.ID {
+			return parser.DInt(v.Value), nil
+		}
+	}
+	return nil, fmt.Errorf("currval of sequence \"%s\" is not yet defined in this session", name)
+}
+
+func (s *Session) setSequenceValue(id uint32, val int64) {
+	for i := range s.SequenceValues {
+		if s.SequenceValues[i].ID == id {
+			s.SequenceValues[i].Value = val
+			return
+		}
+	}
+	s.SequenceValues = append(s.SequenceValues, SequenceValue{ID: id, Value: val})
+}
+
+// funcEnv extends an environment with the functions implemented by the
+// planner, whose results depend on database or session state.
+type funcEnv struct {
+	env parser.Env
+	p   *planner
+}
+
+var _ parser.FuncEnv = funcEnv{}
+
+func (e funcEnv) Get(name string) (parser.Datum, bool) {
+	if e.env == nil {
+		return nil, false
+	}
+	return e.env.Get(name)
+}
+
+func (e funcEnv) EvalFunc(name string, args parser.DTuple) (parser.Datum, bool, error) {
+	switch name {
+	case "currval", "nextval":
+		if len(args) != 1 {
+			return nil, true, fmt.Errorf("incorrect number of arguments: 1 vs %d", len(args))
+		}
+		seq, ok := args[0].(parser.DString)
+		if !ok {
+			return nil, true, fmt.Errorf("argument type mismatch: string expected, but found %s",
+				args[0].Type())
+		}
+		var d parser.Datum
+		var err error
+		if name == "nextval" {
+			d, err = e.p.nextval(string(seq))
+		} else {
+			d, err = e.p.currval(string(seq))
+		}
+		return d, true, err
+
+	case "unique_rowid":
+		if len(args) != 0 {
+			return nil, true, fmt.Errorf("incorrect number of arguments: 0 vs %d", len(args))
+		}
+		if e.p.uniqueIDs == nil {
+			return nil, true, fmt.Errorf("unique row IDs are not available")
+		}
+		return parser.DInt(e.p.uniqueIDs.next()), true, nil
+	}
+	return nil, false, nil
+}
+
+// uniqueIDNodeBits is the number of low-order bits of a unique row ID
+// holding the node ID.
+const uniqueIDNodeBits = 15
+
+// uniqueIDGenerator generates the values of unique_rowid(). A unique row ID
+// combines the HLC wall time, in units of 10 microseconds, with the ID of the
+// node generating it. IDs generated by a node are strictly increasing, so
+// they are unique across the cluster as long as node IDs fit in
+// uniqueIDNodeBits, while IDs generated by different nodes at about the same
+// time are interleaved.
+type uniqueIDGenerator struct {
+	clock  *hlc.Clock
+	nodeID uint32
+
+	mu       sync.Mutex
+	lastTime int64
+}
+
+func newUniqueIDGenerator(nodeID uint32, clock *hlc.Clock) *uniqueIDGenerator {
+	return &uniqueIDGenerator{clock: clock, nodeID: nodeID}
+}
+
+func (g *uniqueIDGenerator) next() int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	t := g.clock.Now().WallTime / 10000
+	if t <= g.lastTime {
+		t = g.lastTime + 1
+	}
+	g.lastTime = t
+	return t<<uniqueIDNodeBits | int64(g.nodeID&(1<<uniqueIDNodeBits-1))
+}
@@ -0,0 +1,59 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestUniqueIDGenerator(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	manual := hlc.NewManualClock(1000000)
+	clock := hlc.NewClock(manual.UnixNano)
+	g1 := newUniqueIDGenerator(1, clock)
+	g2 := newUniqueIDGenerator(2, clock)
+
+	// IDs generated within the same clock tick are still increasing.
+	seen := map[int64]bool{}
+	var last int64
+	for i := 0; i < 10; i++ {
+		for _, g := range []*uniqueIDGenerator{g1, g2} {
+			id := g.next()
+			if seen[id] {
+				t.Fatalf("%d: duplicate ID %d", i, id)
+			}
+			seen[id] = true
+			if node := uint32(id & (1<<uniqueIDNodeBits - 1)); node != g.nodeID {
+				t.Fatalf("%d: expected node ID %d, but found %d", i, g.nodeID, node)
+			}
+		}
+		if id := g1.next(); id <= last {
+			t.Fatalf("%d: expected ID > %d, but found %d", i, last, id)
+		} else {
+			last = id
+		}
+	}
+
+	// Once the clock has moved past the IDs already generated, the IDs are
+	// derived from the clock again.
+	manual.Increment(1000000000)
+	if id, expected := g1.next(), (manual.UnixNano()/10000)<<uniqueIDNodeBits|1; id != expected {
+		t.Fatalf("expected ID %d, but found %d", expected, id)
+	}
+}
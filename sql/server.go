@@ -23,6 +23,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/client"
@@ -31,6 +33,8 @@ import (
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
 
 	gogoproto "github.com/gogo/protobuf/proto"
 )
@@ -41,16 +45,56 @@ var (
 	errEmptyDatabaseName = errors.New("empty database name")
 )
 
+// ResultChunkSize is the maximum number of rows returned in the response to
+// a single request. Larger results are returned in chunks.
+var ResultChunkSize = 1000
+
+// cursorTimeout is the duration after which a suspended execution which has
+// not been continued is discarded.
+var cursorTimeout = time.Minute
+
 // A Server provides an HTTP server endpoint serving the SQL API.
 // It accepts either JSON or serialized protobuf content types.
 type Server struct {
-	context *base.Context
-	db      *client.DB
+	context   *base.Context
+	db        *client.DB
+	leaseMgr  *LeaseManager
+	uniqueIDs *uniqueIDGenerator
+	queries   *queryRegistry
+	stats     *statementStatsRegistry
+	// slowQueryThreshold is the latency above which statements are logged,
+	// if non-zero.
+	slowQueryThreshold time.Duration
+
+	mu         sync.Mutex // Protects the fields below
+	cursors    map[int64]*execution
+	lastCursor int64
+}
+
+// NewServer allocates and returns a new Server. Table descriptors are
+// leased through the supplied LeaseManager.
+func NewServer(ctx *base.Context, db *client.DB, leaseMgr *LeaseManager) *Server {
+	return &Server{
+		context:   ctx,
+		db:        db,
+		leaseMgr:  leaseMgr,
+		uniqueIDs: newUniqueIDGenerator(leaseMgr.nodeID, leaseMgr.clock),
+		queries:   newQueryRegistry(leaseMgr.nodeID),
+		stats:     newStatementStatsRegistry(),
+		cursors:   make(map[int64]*execution),
+	}
 }
 
-// NewServer allocates and returns a new Server.
-func NewServer(ctx *base.Context, db *client.DB) *Server {
-	return &Server{context: ctx, db: db}
+// SetSlowQueryThreshold sets the latency above which statements are logged
+// along with their plans. Zero disables the logging.
+func (s *Server) SetSlowQueryThreshold(threshold time.Duration) {
+	s.slowQueryThreshold = threshold
+}
+
+// RunningQueries returns the statements being executed by the server,
+// including those whose results are being returned through a cursor.
+func (s *Server) RunningQueries() []RunningQuery {
+	return s.queries.list()
 }
 
 // ServeHTTP serves the SQL API by treating the request URL path
@@ -102,8 +146,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Send the Request for SQL execution and set the application-level error
-	// on the reply.
-	reply, err := s.exec(args)
+	// on the reply. The statement being executed is canceled if the client
+	// goes away.
+	var closed <-chan bool
+	if cn, ok := w.(http.CloseNotifier); ok {
+		closed = cn.CloseNotify()
+	}
+	reply, err := s.execute(args, closed)
 	if err != nil {
 		errProto := proto.Error{}
 		errProto.SetResponseGoError(err)
@@ -120,36 +169,242 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Write(body)
 }
 
-// exec executes the request. Any error encountered is returned; it is
-// the caller's responsibility to update the response.
-func (s *Server) exec(req driver.Request) (driver.Response, error) {
+// Execute the statement(s) in the given request and return a response.
+// Any error encountered is returned; it is the caller's responsibility to
+// update the response. The user in the request is assumed to have been
+// authenticated by the caller.
+//
+// At most ResultChunkSize rows are returned in a single response. If the
+// results of the request do not fit, the execution is suspended and the
+// response contains a cursor with which the remaining results can be
+// retrieved.
+func (s *Server) Execute(args driver.Request) (driver.Response, error) {
+	return s.execute(args, nil)
+}
+
+// execute is like Execute, but cancels the statement being executed if
+// the closed channel receives a value.
+func (s *Server) execute(args driver.Request, closed <-chan bool) (driver.Response, error) {
 	var resp driver.Response
 
-	// Pick up current session state.
-	planner := planner{db: s.db}
-	if req.Session != nil {
-		// TODO(tschottdorf) will have to validate the Session information (for
-		// instance, whether access to the stored database is permitted).
-		if err := gogoproto.Unmarshal(req.Session, &planner.session); err != nil {
+	var e *execution
+	if args.Cursor != 0 {
+		var err error
+		if e, err = s.takeCursor(args.Cursor, args.GetUser()); err != nil {
+			return resp, err
+		}
+		if args.Close {
+			e.close()
+			return resp, nil
+		}
+	} else {
+		// Pick up current session state.
+		planner, err := s.newPlanner(args)
+		if err != nil {
 			return resp, err
 		}
+		stmts, err := parser.Parse(args.Sql)
+		if err != nil {
+			return resp, err
+		}
+		e = &execution{
+			server:  s,
+			user:    args.GetUser(),
+			planner: planner,
+			stmts:   stmts,
+			params:  parameters(args.Params),
+		}
 	}
-	stmts, err := parser.Parse(req.Sql)
-	if err != nil {
+
+	if closed != nil {
+		finished := make(chan struct{})
+		defer close(finished)
+		go func() {
+			select {
+			case <-closed:
+				e.cancelQuery()
+			case <-finished:
+			}
+		}()
+	}
+
+	resp, done, err := e.run(ResultChunkSize)
+	if err != nil || done {
+		e.close()
 		return resp, err
 	}
-	for _, stmt := range stmts {
-		// TODO(pmattis): Fill placeholders.
-		var plan planNode
-		if plan, err = planner.makePlan(stmt); err != nil {
-			return resp, err
+	resp.Cursor = s.putCursor(e)
+	return resp, nil
+}
+
+// Start runs a goroutine which discards the suspended executions which
+// have not been continued within cursorTimeout, releasing the descriptor
+// leases they hold.
+func (s *Server) Start(stopper *stop.Stopper) {
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(cursorTimeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.mu.Lock()
+				s.expireCursorsLocked()
+				s.mu.Unlock()
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// takeCursor removes the suspended execution identified by the cursor from
+// the set of suspended executions and returns it. Executions which have not
+// been continued within cursorTimeout are discarded.
+func (s *Server) takeCursor(cursor int64, user string) (*execution, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expireCursorsLocked()
+	e, ok := s.cursors[cursor]
+	if !ok || e.user != user {
+		return nil, util.Errorf("unknown cursor %d", cursor)
+	}
+	delete(s.cursors, cursor)
+	return e, nil
+}
+
+// putCursor suspends an execution, returning the cursor with which it can
+// be continued.
+func (s *Server) putCursor(e *execution) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expireCursorsLocked()
+	if e.cursor == 0 {
+		s.lastCursor++
+		e.cursor = s.lastCursor
+	}
+	e.lastUsed = time.Now()
+	s.cursors[e.cursor] = e
+	return e.cursor
+}
+
+func (s *Server) expireCursorsLocked() {
+	now := time.Now()
+	for cursor, e := range s.cursors {
+		if now.Sub(e.lastUsed) > cursorTimeout {
+			e.close()
+			delete(s.cursors, cursor)
+		}
+	}
+}
+
+// execution holds the state of the execution of the statements in a
+// request.
+type execution struct {
+	server   *Server
+	cursor   int64
+	user     string
+	planner  *planner
+	stmts    []parser.Statement
+	params   parameters
+	lastUsed time.Time
+	// plan is the plan of the statement whose rows are being returned, or
+	// nil if the next statement has not been planned yet.
+	plan planNode
+
+	mu sync.Mutex // Protects query
+	// query is the registration of the statement being executed.
+	query *runningQuery
+}
+
+// startQuery registers the statement about to be executed and sets the
+// context of the planner's KV operations.
+func (e *execution) startQuery(stmt parser.Statement) {
+	timeout := time.Duration(e.planner.session.StatementTimeout)
+	q := e.planner.queries.register(e.user, stmt.String(), timeout)
+	q.stmt = stmt
+	e.planner.db = e.planner.db.WithContext(q.ctx)
+	e.planner.rowsRead = 0
+	e.planner.rowsAffected = 0
+	e.planner.readTimestamp = proto.ZeroTimestamp
+	e.mu.Lock()
+	e.query = q
+	e.mu.Unlock()
+}
+
+// finishQuery unregisters the statement being executed and records its
+// statistics. If the statement failed because it was canceled or timed
+// out, the returned error says so.
+func (e *execution) finishQuery(err error) error {
+	e.mu.Lock()
+	q := e.query
+	e.query = nil
+	e.mu.Unlock()
+	if q == nil {
+		return err
+	}
+	if err != nil {
+		err = q.err(err)
+	}
+	e.planner.queries.unregister(q)
+
+	latency := time.Since(q.Start)
+	if threshold := e.server.slowQueryThreshold; threshold > 0 && latency >= threshold {
+		if e.plan != nil {
+			log.Warningf("slow query (%s): %s\n%s", latency, q.SQL, explainPlan(e.plan))
+		} else {
+			log.Warningf("slow query (%s): %s", latency, q.SQL)
+		}
+	}
+	e.server.stats.record(statementFingerprint(q.stmt), latency,
+		e.planner.rowsRead, q.rowsReturned, err != nil)
+	return err
+}
+
+// cancelQuery cancels the statement being executed, if any.
+func (e *execution) cancelQuery() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.query != nil {
+		e.query.cancel()
+	}
+}
+
+// run executes statements until they are all done or limit rows have been
+// added to the response. The returned bool is true if all of the statements
+// have been executed, in which case the response contains the updated
+// session state.
+func (e *execution) run(limit int) (driver.Response, bool, error) {
+	var resp driver.Response
+	rows := 0
+	for {
+		if e.plan == nil {
+			if len(e.stmts) == 0 {
+				break
+			}
+			stmt := e.stmts[0]
+			e.stmts = e.stmts[1:]
+			if err := parser.FillStmtArgs(stmt, e.params); err != nil {
+				return resp, false, err
+			}
+			e.startQuery(stmt)
+			plan, err := e.planner.makePlan(stmt)
+			if err != nil {
+				return resp, false, e.finishQuery(err)
+			}
+			e.plan = plan
 		}
 
 		result := driver.Result{
-			Columns: plan.Columns(),
+			Columns:      e.plan.Columns(),
+			RowsAffected: e.planner.rowsAffected,
 		}
-		for plan.Next() {
-			values := plan.Values()
+		for rows < limit && e.plan.Next() {
+			// Rows which have already been computed are not returned once the
+			// statement has been canceled.
+			if err := e.query.ctx.Err(); err != nil {
+				return resp, false, e.finishQuery(err)
+			}
+			values := e.plan.Values()
 			row := driver.Result_Row{}
 			row.Values = make([]driver.Datum, 0, len(values))
 			for _, val := range values {
@@ -165,15 +420,110 @@ func (s *Server) exec(req driver.Request) (driver.Response, error) {
 				case parser.DNull:
 					row.Values = append(row.Values, driver.Datum{})
 				default:
-					return resp, util.Errorf("unsupported datum: %T", val)
+					return resp, false, e.finishQuery(util.Errorf("unsupported datum: %T", val))
 				}
 			}
 			result.Rows = append(result.Rows, row)
+			rows++
+			e.query.rowsReturned++
+		}
+		if err := e.plan.Err(); err != nil {
+			return resp, false, e.finishQuery(err)
 		}
 		resp.Results = append(resp.Results, result)
+		if rows >= limit {
+			// The plan may have more rows. They are returned in the response
+			// to the next request for the cursor.
+			return resp, false, nil
+		}
+
+		// Release the leases acquired by the statement so that later schema
+		// changes in the same request do not wait on them.
+		_ = e.finishQuery(nil)
+		e.plan = nil
+		e.planner.releaseLeases()
 	}
 
 	// Update session state.
-	resp.Session, err = gogoproto.Marshal(&planner.session)
-	return resp, err
+	session, err := gogoproto.Marshal(&e.planner.session)
+	resp.Session = session
+	return resp, true, err
+}
+
+// close releases the resources held by the execution.
+func (e *execution) close() {
+	_ = e.finishQuery(nil)
+	e.planner.releaseLeases()
+}
+
+// Prepare returns the names of the columns in the result of the single SQL
+// statement in the given request, without executing the statement.
+// Statements which do not return rows have no result columns. Placeholders
+// in the statement do not need to be bound.
+func (s *Server) Prepare(args driver.Request) ([]string, error) {
+	planner, err := s.newPlanner(args)
+	if err != nil {
+		return nil, err
+	}
+	defer planner.releaseLeases()
+	stmts, err := parser.Parse(args.Sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, util.Errorf("expected a single statement, but found %d", len(stmts))
+	}
+	switch stmts[0].(type) {
+	case *parser.Select, *parser.ShowColumns, *parser.ShowDatabases,
+		*parser.ShowGrants, *parser.ShowIndex, *parser.ShowTables, *parser.Show:
+		// Planning these statements does not evaluate any expressions or
+		// modify any data.
+		plan, err := planner.makePlan(stmts[0])
+		if err != nil {
+			return nil, err
+		}
+		return plan.Columns(), nil
+	}
+	return nil, nil
+}
+
+// newPlanner returns a planner for the user and session state in the
+// request.
+func (s *Server) newPlanner(args driver.Request) (*planner, error) {
+	p := &planner{db: s.db, user: args.GetUser(), leaseMgr: s.leaseMgr, uniqueIDs: s.uniqueIDs,
+		queries: s.queries}
+	if args.Session != nil {
+		// TODO(tschottdorf) will have to validate the Session information (for
+		// instance, whether access to the stored database is permitted).
+		if err := gogoproto.Unmarshal(args.Session, &p.session); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// parameters implements the parser.Args interface for the parameters
+// supplied with a request. Placeholders are numbered starting at 1.
+type parameters []driver.Datum
+
+var _ parser.Args = parameters{}
+
+func (p parameters) Arg(i int) (parser.Datum, bool) {
+	if i < 1 || i > len(p) {
+		return nil, false
+	}
+	d := p[i-1]
+	switch {
+	case d.BoolVal != nil:
+		return parser.DBool(*d.BoolVal), true
+	case d.IntVal != nil:
+		return parser.DInt(*d.IntVal), true
+	case d.FloatVal != nil:
+		return parser.DFloat(*d.FloatVal), true
+	case d.BytesVal != nil:
+		return parser.DString(d.BytesVal), true
+	case d.StringVal != nil:
+		return parser.DString(*d.StringVal), true
+	}
+	return parser.DNull{}, true
 }
@@ -9,12 +9,14 @@ It is generated from these files:
 	cockroach/sql/server.proto
 
 It has these top-level messages:
+	SequenceValue
 	Session
 */
 package sql
 
 import proto "github.com/gogo/protobuf/proto"
 import math "math"
+import cockroach_proto1 "github.com/cockroachdb/cockroach/proto"
 
 // discarding unused import gogoproto "gogoproto/gogo.pb"
 
@@ -25,8 +27,47 @@ import fmt "fmt"
 var _ = proto.Marshal
 var _ = math.Inf
 
+// SequenceValue is the value most recently returned by nextval for a
+// sequence in a session.
+type SequenceValue struct {
+	ID               uint32 `protobuf:"varint,1,opt,name=id" json:"id"`
+	Value            int64  `protobuf:"varint,2,opt,name=value" json:"value"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *SequenceValue) Reset()         { *m = SequenceValue{} }
+func (m *SequenceValue) String() string { return proto.CompactTextString(m) }
+func (*SequenceValue) ProtoMessage()    {}
+
+func (m *SequenceValue) GetID() uint32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *SequenceValue) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// Session holds the state of a SQL session, including the session
+// variables modified by SET. It is returned to the client after each
+// request and sent back with the next one.
 type Session struct {
-	Database         string `protobuf:"bytes,1,opt,name=database" json:"database"`
+	Database       string          `protobuf:"bytes,1,opt,name=database" json:"database"`
+	SequenceValues []SequenceValue `protobuf:"bytes,2,rep,name=sequence_values" json:"sequence_values"`
+	// The isolation level of the transactions run by statements.
+	DefaultIsolation cockroach_proto1.IsolationType `protobuf:"varint,3,opt,name=default_isolation,enum=cockroach.proto.IsolationType" json:"default_isolation"`
+	// The statement timeout in nanoseconds. Zero means no timeout.
+	StatementTimeout int64 `protobuf:"varint,4,opt,name=statement_timeout" json:"statement_timeout"`
+	// The name of the session time zone. Empty means UTC.
+	TimeZone string `protobuf:"bytes,5,opt,name=time_zone" json:"time_zone"`
+	// The format clients should use to display results. Empty means the
+	// client's default format.
+	OutputFormat     string `protobuf:"bytes,6,opt,name=output_format" json:"output_format"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -41,8 +82,116 @@ func (m *Session) GetDatabase() string {
 	return ""
 }
 
+func (m *Session) GetSequenceValues() []SequenceValue {
+	if m != nil {
+		return m.SequenceValues
+	}
+	return nil
+}
+
+func (m *Session) GetDefaultIsolation() cockroach_proto1.IsolationType {
+	if m != nil {
+		return m.DefaultIsolation
+	}
+	return cockroach_proto1.SERIALIZABLE
+}
+
+func (m *Session) GetStatementTimeout() int64 {
+	if m != nil {
+		return m.StatementTimeout
+	}
+	return 0
+}
+
+func (m *Session) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *Session) GetOutputFormat() string {
+	if m != nil {
+		return m.OutputFormat
+	}
+	return ""
+}
+
 func init() {
 }
+func (m *SequenceValue) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipServer(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
 func (m *Session) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -84,6 +233,105 @@ func (m *Session) Unmarshal(data []byte) error {
 			}
 			m.Database = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequenceValues = append(m.SequenceValues, SequenceValue{})
+			if err := m.SequenceValues[len(m.SequenceValues)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultIsolation", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DefaultIsolation |= (cockroach_proto1.IsolationType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatementTimeout", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.StatementTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputFormat = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -192,11 +440,34 @@ func skipServer(data []byte) (n int, err error) {
 	}
 	panic("unreachable")
 }
+func (m *SequenceValue) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovServer(uint64(m.ID))
+	n += 1 + sovServer(uint64(m.Value))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Session) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Database)
 	n += 1 + l + sovServer(uint64(l))
+	if len(m.SequenceValues) > 0 {
+		for _, e := range m.SequenceValues {
+			l = e.Size()
+			n += 1 + l + sovServer(uint64(l))
+		}
+	}
+	n += 1 + sovServer(uint64(m.DefaultIsolation))
+	n += 1 + sovServer(uint64(m.StatementTimeout))
+	l = len(m.TimeZone)
+	n += 1 + l + sovServer(uint64(l))
+	l = len(m.OutputFormat)
+	n += 1 + l + sovServer(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -216,6 +487,33 @@ func sovServer(x uint64) (n int) {
 func sozServer(x uint64) (n int) {
 	return sovServer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SequenceValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SequenceValue) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintServer(data, i, uint64(m.ID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintServer(data, i, uint64(m.Value))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *Session) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -235,6 +533,32 @@ func (m *Session) MarshalTo(data []byte) (n int, err error) {
 	i++
 	i = encodeVarintServer(data, i, uint64(len(m.Database)))
 	i += copy(data[i:], m.Database)
+	if len(m.SequenceValues) > 0 {
+		for _, msg := range m.SequenceValues {
+			data[i] = 0x12
+			i++
+			i = encodeVarintServer(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x18
+	i++
+	i = encodeVarintServer(data, i, uint64(m.DefaultIsolation))
+	data[i] = 0x20
+	i++
+	i = encodeVarintServer(data, i, uint64(m.StatementTimeout))
+	data[i] = 0x2a
+	i++
+	i = encodeVarintServer(data, i, uint64(len(m.TimeZone)))
+	i += copy(data[i:], m.TimeZone)
+	data[i] = 0x32
+	i++
+	i = encodeVarintServer(data, i, uint64(len(m.OutputFormat)))
+	i += copy(data[i:], m.OutputFormat)
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
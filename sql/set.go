@@ -19,34 +19,215 @@ package sql
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
 )
 
+// sessionVar describes a session variable which can be modified with SET
+// and displayed with SHOW.
+type sessionVar struct {
+	// set updates the session from the evaluated values of a SET
+	// statement. Nil values reset the variable to its default.
+	set func(s *Session, values []parser.Datum) error
+	// get returns the current value of the variable.
+	get func(s *Session) string
+}
+
+// sessionVars maps the lower-cased names of the session variables to their
+// descriptions.
+var sessionVars = map[string]sessionVar{
+	"database": {
+		set: func(s *Session, values []parser.Datum) error {
+			db, err := getStringVal("database", values)
+			if err != nil {
+				return err
+			}
+			s.Database = db
+			return nil
+		},
+		get: func(s *Session) string {
+			return s.Database
+		},
+	},
+
+	"default_transaction_isolation": {
+		set: func(s *Session, values []parser.Datum) error {
+			level, err := getStringVal("default_transaction_isolation", values)
+			if err != nil {
+				return err
+			}
+			switch strings.ToUpper(level) {
+			case "", proto.SERIALIZABLE.String():
+				s.DefaultIsolation = proto.SERIALIZABLE
+			case proto.SNAPSHOT.String():
+				s.DefaultIsolation = proto.SNAPSHOT
+			default:
+				return fmt.Errorf("default_transaction_isolation: unknown isolation level %q "+
+					"(expected SERIALIZABLE or SNAPSHOT)", level)
+			}
+			return nil
+		},
+		get: func(s *Session) string {
+			return s.DefaultIsolation.String()
+		},
+	},
+
+	"statement_timeout": {
+		set: func(s *Session, values []parser.Datum) error {
+			if values == nil {
+				s.StatementTimeout = 0
+				return nil
+			}
+			if len(values) != 1 {
+				return fmt.Errorf("statement_timeout: requires a single value")
+			}
+			var timeout time.Duration
+			switch t := values[0].(type) {
+			case parser.DInt:
+				// Integers are interpreted as milliseconds.
+				timeout = time.Duration(t) * time.Millisecond
+			case parser.DString:
+				var err error
+				if timeout, err = time.ParseDuration(string(t)); err != nil {
+					return fmt.Errorf("statement_timeout: %s", err)
+				}
+			default:
+				return fmt.Errorf("statement_timeout: requires an integer or a duration string: "+
+					"%s is a %s", t, t.Type())
+			}
+			if timeout < 0 {
+				return fmt.Errorf("statement_timeout: must not be negative")
+			}
+			s.StatementTimeout = int64(timeout)
+			return nil
+		},
+		get: func(s *Session) string {
+			return time.Duration(s.StatementTimeout).String()
+		},
+	},
+
+	"time_zone": {
+		set: func(s *Session, values []parser.Datum) error {
+			name, err := getStringVal("time_zone", values)
+			if err != nil {
+				return err
+			}
+			if _, err := time.LoadLocation(name); err != nil {
+				return fmt.Errorf("time_zone: unknown time zone %q", name)
+			}
+			s.TimeZone = name
+			return nil
+		},
+		get: func(s *Session) string {
+			return s.TimeZoneName()
+		},
+	},
+
+	"output_format": {
+		set: func(s *Session, values []parser.Datum) error {
+			format, err := getStringVal("output_format", values)
+			if err != nil {
+				return err
+			}
+			format = strings.ToLower(format)
+			switch format {
+			case "", "table", "csv", "tsv", "json":
+			default:
+				return fmt.Errorf("output_format: unknown format %q "+
+					"(expected table, csv, tsv or json)", format)
+			}
+			s.OutputFormat = format
+			return nil
+		},
+		get: func(s *Session) string {
+			if s.OutputFormat == "" {
+				return "table"
+			}
+			return s.OutputFormat
+		},
+	},
+}
+
+// TimeZoneName returns the name of the session's time zone, which is reported
+// to PostgreSQL clients as the TimeZone parameter.
+func (s *Session) TimeZoneName() string {
+	if s.TimeZone == "" {
+		return "UTC"
+	}
+	return s.TimeZone
+}
+
+// getStringVal returns the single string value of a SET statement for the
+// named variable, or "" if the variable is being reset to its default.
+func getStringVal(name string, values []parser.Datum) (string, error) {
+	if values == nil {
+		return "", nil
+	}
+	if len(values) != 1 {
+		return "", fmt.Errorf("%s: requires a single string value", name)
+	}
+	s, ok := values[0].(parser.DString)
+	if !ok {
+		return "", fmt.Errorf("%s: requires a single string value: %s is a %s",
+			name, values[0], values[0].Type())
+	}
+	return string(s), nil
+}
+
 // Set sets session variables.
 func (p *planner) Set(n *parser.Set) (planNode, error) {
-	// By using QualifiedName.String() here any variables that are keywords will
-	// be double quoted.
-	name := strings.ToLower(n.Name.String())
-	switch name {
-	case `"database"`: // Quoted: database is a reserved word
-		if len(n.Values) != 1 {
-			return nil, fmt.Errorf("database: requires a single string value")
+	name := strings.ToLower(strings.Join(n.Name, "."))
+	v, ok := sessionVars[name]
+	if !ok {
+		return nil, util.Errorf("unknown variable: %s", name)
+	}
+	var values []parser.Datum
+	if n.Values != nil {
+		values = make([]parser.Datum, 0, len(n.Values))
+		for _, expr := range n.Values {
+			val, err := parser.EvalExpr(expr, nil)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, val)
 		}
-		val, err := parser.EvalExpr(n.Values[0], nil)
-		if err != nil {
-			return nil, err
+	}
+	if err := v.set(&p.session, values); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// Show displays the value of a session variable, or of all the session
+// variables for SHOW ALL.
+func (p *planner) Show(n *parser.Show) (planNode, error) {
+	name := strings.ToLower(n.Name)
+	if name == "all" {
+		names := make([]string, 0, len(sessionVars))
+		for name := range sessionVars {
+			names = append(names, name)
 		}
-		if s, ok := val.(parser.DString); ok {
-			p.session.Database = string(s)
-		} else if !ok {
-			return nil, fmt.Errorf("database: requires a single string value: %s is a %s",
-				n.Values[0], val.Type())
+		sort.Strings(names)
+		v := &valuesNode{columns: []string{"Variable", "Value"}}
+		for _, name := range names {
+			v.rows = append(v.rows, []parser.Datum{
+				parser.DString(name),
+				parser.DString(sessionVars[name].get(&p.session)),
+			})
 		}
-	default:
+		return v, nil
+	}
+	v, ok := sessionVars[name]
+	if !ok {
 		return nil, util.Errorf("unknown variable: %s", name)
 	}
-	return &valuesNode{}, nil
+	return &valuesNode{
+		columns: []string{name},
+		rows:    []parser.DTuple{{parser.DString(v.get(&p.session))}},
+	}, nil
 }
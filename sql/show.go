@@ -27,7 +27,7 @@ import (
 
 // ShowColumns of a table.
 func (p *planner) ShowColumns(n *parser.ShowColumns) (planNode, error) {
-	desc, err := p.getTableDesc(n.Table)
+	desc, err := p.getTableLease(n.Table)
 	if err != nil {
 		return nil, err
 	}
@@ -57,9 +57,56 @@ func (p *planner) ShowDatabases(n *parser.ShowDatabases) (planNode, error) {
 	return v, nil
 }
 
+// ShowGrants returns grant details for the specified objects and users.
+// With no targets, the grants on the current database are shown.
+func (p *planner) ShowGrants(n *parser.ShowGrants) (planNode, error) {
+	targets := n.Targets
+	if targets == nil {
+		if p.session.Database == "" {
+			return nil, errNoDatabase
+		}
+		targets = &parser.TargetList{Databases: parser.NameList{p.session.Database}}
+	}
+	descriptors, err := p.getDescriptorsFromTargetList(*targets)
+	if err != nil {
+		return nil, err
+	}
+
+	objectType := "Database"
+	if targets.Tables != nil {
+		objectType = "Table"
+	}
+
+	// Build a map of the requested users for filtering.
+	var wantedUsers map[string]struct{}
+	if n.Grantees != nil {
+		wantedUsers = make(map[string]struct{}, len(n.Grantees))
+		for _, u := range n.Grantees {
+			wantedUsers[u] = struct{}{}
+		}
+	}
+
+	v := &valuesNode{columns: []string{objectType, "User", "Privileges"}}
+	for _, desc := range descriptors {
+		for _, userPriv := range desc.GetPrivileges().Show() {
+			if wantedUsers != nil {
+				if _, ok := wantedUsers[userPriv.User]; !ok {
+					continue
+				}
+			}
+			v.rows = append(v.rows, []parser.Datum{
+				parser.DString(desc.GetName()),
+				parser.DString(userPriv.User),
+				parser.DString(userPriv.Privileges),
+			})
+		}
+	}
+	return v, nil
+}
+
 // ShowIndex returns all the indexes for a table.
 func (p *planner) ShowIndex(n *parser.ShowIndex) (planNode, error) {
-	desc, err := p.getTableDesc(n.Table)
+	desc, err := p.getTableLease(n.Table)
 	if err != nil {
 		return nil, err
 	}
@@ -89,11 +136,11 @@ func (p *planner) ShowTables(n *parser.ShowTables) (planNode, error) {
 		}
 		n.Name = append(n.Name, p.session.Database)
 	}
-	dbID, err := p.lookupDatabase(n.Name.String())
+	dbDesc, err := p.getDatabaseDesc(n.Name.String())
 	if err != nil {
 		return nil, err
 	}
-	prefix := keys.MakeNameMetadataKey(dbID, "")
+	prefix := keys.MakeNameMetadataKey(dbDesc.ID, "")
 	sr, err := p.db.Scan(prefix, prefix.PrefixEnd(), 0)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,226 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// columnOrderInfo describes a column by which rows are ordered.
+type columnOrderInfo struct {
+	colIdx int
+	desc   bool
+}
+
+// resolveOrderBy resolves the ORDER BY clause of a query against its result
+// columns. An ORDER BY expression can be the 1-based position or the name of
+// a result column. If the render expressions of the query are supplied, it
+// can also be any other expression, which is rendered as an additional
+// column that is only used for sorting. Returns the ordering along with the
+// names and render expressions of the columns including the additional
+// ones.
+func resolveOrderBy(orderBy parser.OrderBy, columns []string, exprs []parser.Expr) (
+	[]columnOrderInfo, []string, []parser.Expr, error) {
+	if len(orderBy) == 0 {
+		return nil, columns, exprs, nil
+	}
+	numColumns := len(columns)
+	ordering := make([]columnOrderInfo, 0, len(orderBy))
+	for _, o := range orderBy {
+		idx := -1
+		switch t := o.Expr.(type) {
+		case parser.IntVal:
+			if t < 1 || int(t) > numColumns {
+				return nil, nil, nil, fmt.Errorf("ORDER BY position %d is not in select list", t)
+			}
+			idx = int(t) - 1
+		case parser.QualifiedName:
+			for i := 0; i < numColumns; i++ {
+				if columns[i] == t.String() {
+					idx = i
+					break
+				}
+			}
+		}
+		if idx == -1 && exprs != nil {
+			for i, e := range exprs {
+				if e.String() == o.Expr.String() {
+					idx = i
+					break
+				}
+			}
+			if idx == -1 {
+				idx = len(exprs)
+				columns = append(columns[:len(columns):len(columns)], o.Expr.String())
+				exprs = append(exprs[:len(exprs):len(exprs)], o.Expr)
+			}
+		}
+		if idx == -1 {
+			return nil, nil, nil, fmt.Errorf("ORDER BY term %s does not match a result column", o.Expr)
+		}
+		ordering = append(ordering, columnOrderInfo{colIdx: idx, desc: o.Direction == " DESC"})
+	}
+	return ordering, columns, exprs, nil
+}
+
+// sortNode sorts the rows of a plan. The columns following the first
+// numColumns columns are only used for sorting and are not returned.
+type sortNode struct {
+	plan       planNode
+	ordering   []columnOrderInfo
+	numColumns int
+	rows       []parser.DTuple
+	nextRow    int // The index of the next row.
+	sorted     bool
+	err        error
+}
+
+var _ planNode = &sortNode{}
+
+func (n *sortNode) Columns() []string {
+	return n.plan.Columns()[:n.numColumns]
+}
+
+func (n *sortNode) Values() parser.DTuple {
+	return n.rows[n.nextRow-1][:n.numColumns]
+}
+
+func (n *sortNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if !n.sorted {
+		n.sorted = true
+		for n.plan.Next() {
+			n.rows = append(n.rows, append(parser.DTuple(nil), n.plan.Values()...))
+		}
+		if n.err = n.plan.Err(); n.err != nil {
+			return false
+		}
+		sort.Stable(n)
+		if n.err != nil {
+			return false
+		}
+	}
+	if n.nextRow >= len(n.rows) {
+		return false
+	}
+	n.nextRow++
+	return true
+}
+
+func (n *sortNode) Err() error {
+	return n.err
+}
+
+// Len, Swap and Less implement sort.Interface. An error comparing two rows
+// is recorded and stops the iteration.
+func (n *sortNode) Len() int {
+	return len(n.rows)
+}
+
+func (n *sortNode) Swap(i, j int) {
+	n.rows[i], n.rows[j] = n.rows[j], n.rows[i]
+}
+
+func (n *sortNode) Less(i, j int) bool {
+	for _, o := range n.ordering {
+		c, err := compareDatums(n.rows[i][o.colIdx], n.rows[j][o.colIdx])
+		if err != nil {
+			if n.err == nil {
+				n.err = err
+			}
+			return false
+		}
+		if c != 0 {
+			if o.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+	}
+	return false
+}
+
+// compareDatums returns -1, 0 or 1 depending on whether a is less than,
+// equal to or greater than b. NULL sorts before all other values.
+func compareDatums(a, b parser.Datum) (int, error) {
+	if a == (parser.DNull{}) || b == (parser.DNull{}) {
+		switch {
+		case a == b:
+			return 0, nil
+		case a == (parser.DNull{}):
+			return -1, nil
+		}
+		return 1, nil
+	}
+	switch t := a.(type) {
+	case parser.DBool:
+		if v, ok := b.(parser.DBool); ok {
+			switch {
+			case t == v:
+				return 0, nil
+			case !bool(t):
+				return -1, nil
+			}
+			return 1, nil
+		}
+	case parser.DInt:
+		switch v := b.(type) {
+		case parser.DInt:
+			switch {
+			case t < v:
+				return -1, nil
+			case t > v:
+				return 1, nil
+			}
+			return 0, nil
+		case parser.DFloat:
+			return compareFloats(float64(t), float64(v)), nil
+		}
+	case parser.DFloat:
+		switch v := b.(type) {
+		case parser.DInt:
+			return compareFloats(float64(t), float64(v)), nil
+		case parser.DFloat:
+			return compareFloats(float64(t), float64(v)), nil
+		}
+	case parser.DString:
+		if v, ok := b.(parser.DString); ok {
+			switch {
+			case t < v:
+				return -1, nil
+			case t > v:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+	return 0, fmt.Errorf("unable to compare %s and %s", a.Type(), b.Type())
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,188 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// StatementLatencyBuckets are the upper bounds of the buckets of the
+// latency histograms of statement statistics. The histograms have a final
+// bucket for the latencies exceeding the last bound.
+var StatementLatencyBuckets = []time.Duration{
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+}
+
+// maxStatementFingerprints bounds the number of fingerprints for which
+// statistics are kept. Statements with new fingerprints are only counted in
+// the totals once the limit is reached.
+const maxStatementFingerprints = 1000
+
+// sqlTimeSeriesNameFmt is the format of the names of the time series
+// recording the statement statistics of a node.
+const sqlTimeSeriesNameFmt = "cr.node.sql.%s.%d"
+
+// StatementStats holds the statistics of the statements sharing a
+// fingerprint.
+type StatementStats struct {
+	Fingerprint       string `json:"fingerprint"`
+	Count             int64  `json:"count"`
+	Errors            int64  `json:"errors"`
+	RowsRead          int64  `json:"rowsRead"`
+	RowsReturned      int64  `json:"rowsReturned"`
+	TotalLatencyNanos int64  `json:"totalLatencyNanos"`
+	// LatencyHistogram counts the statements by latency, using the buckets
+	// defined by StatementLatencyBuckets.
+	LatencyHistogram []int64 `json:"latencyHistogram"`
+}
+
+func (s *StatementStats) record(latency time.Duration, rowsRead, rowsReturned int64, failed bool) {
+	if s.LatencyHistogram == nil {
+		s.LatencyHistogram = make([]int64, len(StatementLatencyBuckets)+1)
+	}
+	s.Count++
+	if failed {
+		s.Errors++
+	}
+	s.RowsRead += rowsRead
+	s.RowsReturned += rowsReturned
+	s.TotalLatencyNanos += latency.Nanoseconds()
+	bucket := sort.Search(len(StatementLatencyBuckets), func(i int) bool {
+		return latency < StatementLatencyBuckets[i]
+	})
+	s.LatencyHistogram[bucket]++
+}
+
+// statementStatsRegistry records statistics for the statements executed by
+// a server, grouped by fingerprint.
+type statementStatsRegistry struct {
+	mu     sync.Mutex // Protects the fields below
+	stats  map[string]*StatementStats
+	totals StatementStats
+}
+
+func newStatementStatsRegistry() *statementStatsRegistry {
+	return &statementStatsRegistry{stats: map[string]*StatementStats{}}
+}
+
+// record adds the execution of a statement to the statistics of its
+// fingerprint.
+func (r *statementStatsRegistry) record(fingerprint string, latency time.Duration,
+	rowsRead, rowsReturned int64, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.totals.record(latency, rowsRead, rowsReturned, failed)
+	s, ok := r.stats[fingerprint]
+	if !ok {
+		if len(r.stats) >= maxStatementFingerprints {
+			return
+		}
+		s = &StatementStats{Fingerprint: fingerprint}
+		r.stats[fingerprint] = s
+	}
+	s.record(latency, rowsRead, rowsReturned, failed)
+}
+
+// list returns a copy of the statistics ordered by fingerprint.
+func (r *statementStatsRegistry) list() []StatementStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := make([]StatementStats, 0, len(r.stats))
+	for _, s := range r.stats {
+		c := *s
+		c.LatencyHistogram = append([]int64(nil), s.LatencyHistogram...)
+		stats = append(stats, c)
+	}
+	sort.Sort(statementStatsByFingerprint(stats))
+	return stats
+}
+
+// getTotals returns the statistics of all of the statements.
+func (r *statementStatsRegistry) getTotals() StatementStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.totals
+}
+
+type statementStatsByFingerprint []StatementStats
+
+func (s statementStatsByFingerprint) Len() int           { return len(s) }
+func (s statementStatsByFingerprint) Less(i, j int) bool { return s[i].Fingerprint < s[j].Fingerprint }
+func (s statementStatsByFingerprint) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// literalStripper replaces the literals in expressions with an underscore.
+type literalStripper struct{}
+
+var _ parser.Visitor = literalStripper{}
+
+func (literalStripper) Visit(expr parser.Expr) parser.Expr {
+	switch expr.(type) {
+	case parser.StrVal, parser.IntVal, parser.NumVal,
+		parser.DString, parser.DInt, parser.DFloat:
+		return parser.QualifiedName{"_"}
+	}
+	return expr
+}
+
+// statementFingerprint returns the text of a statement with its string and
+// numeric literals replaced by underscores, so that statements differing
+// only in those literals share a fingerprint. The literals are replaced in
+// place, so the statement must not be executed afterwards.
+func statementFingerprint(stmt parser.Statement) string {
+	parser.WalkStmt(literalStripper{}, stmt)
+	return stmt.String()
+}
+
+// StatementStats returns the statistics of the statements executed by the
+// server, ordered by fingerprint.
+func (s *Server) StatementStats() []StatementStats {
+	return s.stats.list()
+}
+
+// GetTimeSeriesData implements the ts.DataSource interface, returning the
+// totals of the statistics of the statements executed by the server.
+func (s *Server) GetTimeSeriesData() []proto.TimeSeriesData {
+	totals := s.stats.getTotals()
+	now := s.leaseMgr.clock.PhysicalNow()
+	record := func(name string, value int64) proto.TimeSeriesData {
+		return proto.TimeSeriesData{
+			Name: fmt.Sprintf(sqlTimeSeriesNameFmt, name, s.leaseMgr.nodeID),
+			Datapoints: []*proto.TimeSeriesDatapoint{
+				{
+					TimestampNanos: now,
+					Value:          float64(value),
+				},
+			},
+		}
+	}
+	return []proto.TimeSeriesData{
+		record("statements", totals.Count),
+		record("errors", totals.Errors),
+		record("rows.read", totals.RowsRead),
+		record("rows.returned", totals.RowsReturned),
+		record("latency.ns", totals.TotalLatencyNanos),
+	}
+}
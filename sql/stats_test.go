@@ -0,0 +1,94 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+func TestStatementFingerprint(t *testing.T) {
+	testCases := []struct {
+		sql         string
+		fingerprint string
+	}{
+		{`SELECT k FROM t.kv WHERE k = 1 AND v = 'a'`,
+			`SELECT k FROM t.kv WHERE k = _ AND v = _`},
+		{`SELECT k FROM t.kv WHERE k = $1`,
+			`SELECT k FROM t.kv WHERE k = $1`},
+		{`INSERT INTO t.kv VALUES (1, 2.5), (3, 'four')`,
+			`INSERT INTO t.kv VALUES (_, _), (_, _)`},
+		{`DELETE FROM t.kv WHERE v IS NULL OR v = true`,
+			`DELETE FROM t.kv WHERE v IS NULL OR v = true`},
+		{`CREATE DATABASE t`,
+			`CREATE DATABASE t`},
+	}
+	for _, tc := range testCases {
+		stmts, err := parser.Parse(tc.sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fingerprint := statementFingerprint(stmts[0]); fingerprint != tc.fingerprint {
+			t.Errorf("%s: expected %s, but found %s", tc.sql, tc.fingerprint, fingerprint)
+		}
+	}
+}
+
+func TestStatementStatsRegistry(t *testing.T) {
+	r := newStatementStatsRegistry()
+	r.record("b", 500*time.Microsecond, 3, 1, false)
+	r.record("b", 50*time.Millisecond, 2, 0, true)
+	r.record("a", time.Minute, 0, 0, false)
+
+	expected := []StatementStats{
+		{Fingerprint: "a", Count: 1, TotalLatencyNanos: int64(time.Minute),
+			LatencyHistogram: []int64{0, 0, 0, 0, 0, 1}},
+		{Fingerprint: "b", Count: 2, Errors: 1, RowsRead: 5, RowsReturned: 1,
+			TotalLatencyNanos: int64(50500 * time.Microsecond),
+			LatencyHistogram:  []int64{1, 0, 1, 0, 0, 0}},
+	}
+	if stats := r.list(); !reflect.DeepEqual(expected, stats) {
+		t.Errorf("expected %+v, but found %+v", expected, stats)
+	}
+	if totals := r.getTotals(); totals.Count != 3 || totals.Errors != 1 || totals.RowsRead != 5 {
+		t.Errorf("unexpected totals %+v", totals)
+	}
+}
+
+func TestExplainPlan(t *testing.T) {
+	plan := &limitNode{
+		count: 10,
+		plan: &sortNode{
+			ordering: []columnOrderInfo{{colIdx: 1, desc: true}, {colIdx: 0}},
+			plan: &unionNode{
+				all:   true,
+				left:  &scanNode{columns: []string{"k", "v"}},
+				right: &valuesNode{rows: []parser.DTuple{{parser.DInt(1), parser.DInt(2)}}},
+			},
+		},
+	}
+	expected := `limit count=10 offset=0
+  sort -2,+1
+    union all
+      scan (k, v)
+      values 1 rows`
+	if s := explainPlan(plan); s != expected {
+		t.Errorf("expected\n%s\nbut found\n%s", expected, s)
+	}
+}
@@ -32,6 +32,8 @@ func makeTableDesc(p *parser.CreateTable) (structured.TableDescriptor, error) {
 	desc := structured.TableDescriptor{}
 	desc.Name = p.Table.String()
 
+	// The CHECK constraints are added once all of the columns are known.
+	var checks []*parser.CheckConstraint
 	for _, def := range p.Defs {
 		switch d := def.(type) {
 		case *parser.ColumnTableDef:
@@ -46,6 +48,15 @@ func makeTableDesc(p *parser.CreateTable) (structured.TableDescriptor, error) {
 			case *parser.IntType:
 				col.Type.Kind = structured.ColumnType_INT
 				col.Type.Width = int32(t.N)
+				if t.IsSerial() {
+					// SERIAL columns are NOT NULL and default to a unique row ID.
+					if d.DefaultExpr != nil {
+						return desc, fmt.Errorf("SERIAL column \"%s\" cannot have a default", d.Name)
+					}
+					col.Nullable = false
+					defaultExpr := "unique_rowid()"
+					col.DefaultExpr = &defaultExpr
+				}
 			case *parser.FloatType:
 				col.Type.Kind = structured.ColumnType_FLOAT
 				col.Type.Precision = int32(t.Prec)
@@ -67,7 +78,12 @@ func makeTableDesc(p *parser.CreateTable) (structured.TableDescriptor, error) {
 			case *parser.BlobType:
 				col.Type.Kind = structured.ColumnType_BLOB
 			}
+			if d.DefaultExpr != nil {
+				defaultExpr := d.DefaultExpr.String()
+				col.DefaultExpr = &defaultExpr
+			}
 			desc.Columns = append(desc.Columns, col)
+			checks = append(checks, d.Checks...)
 
 			// Create any associated index.
 			if d.PrimaryKey || d.Unique {
@@ -90,13 +106,30 @@ func makeTableDesc(p *parser.CreateTable) (structured.TableDescriptor, error) {
 				index.Name = "primary"
 			}
 			desc.Indexes = append(desc.Indexes, index)
+		case *parser.ForeignKeyTableDef:
+			// Foreign keys are resolved by the planner once the columns have been
+			// allocated IDs.
+		case *parser.CheckConstraintTableDef:
+			checks = append(checks, &parser.CheckConstraint{Name: d.Name, Expr: d.Expr})
 		default:
 			return desc, fmt.Errorf("unsupported table def: %T", def)
 		}
 	}
+	for _, c := range checks {
+		if err := addCheckConstraint(&desc, c.Name, c.Expr); err != nil {
+			return desc, err
+		}
+	}
 	return desc, nil
 }
 
+func encodeTablePrefix(tableID uint32) []byte {
+	var key []byte
+	key = append(key, keys.TableDataPrefix...)
+	key = encoding.EncodeUvarint(key, uint64(tableID))
+	return key
+}
+
 func encodeIndexKeyPrefix(tableID, indexID uint32) []byte {
 	var key []byte
 	key = append(key, keys.TableDataPrefix...)
@@ -0,0 +1,209 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// Union constructs a planNode from a UNION, INTERSECT or EXCEPT statement.
+// The ORDER BY and LIMIT clauses apply to the combined result.
+func (p *planner) Union(n *parser.Union) (planNode, error) {
+	left, err := p.makePlan(n.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.makePlan(n.Right)
+	if err != nil {
+		return nil, err
+	}
+	if len(left.Columns()) != len(right.Columns()) {
+		return nil, fmt.Errorf("each %s query must have the same number of columns: %d vs %d",
+			n.Type, len(left.Columns()), len(right.Columns()))
+	}
+
+	var plan planNode
+	switch n.Type {
+	case "UNION":
+		plan = &unionNode{left: left, right: right, all: n.All, seen: map[string]struct{}{}}
+	case "INTERSECT", "EXCEPT":
+		plan = &setOpNode{
+			left:      left,
+			right:     right,
+			all:       n.All,
+			intersect: n.Type == "INTERSECT",
+		}
+	default:
+		return nil, fmt.Errorf("unsupported set operation: %s", n.Type)
+	}
+
+	ordering, _, _, err := resolveOrderBy(n.OrderBy, left.Columns(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return p.orderDistinctLimit(plan, ordering, len(left.Columns()), false, n.Limit)
+}
+
+// unionNode returns the rows of the left plan followed by the rows of the
+// right plan. Unless all is set, duplicate rows are only returned once.
+type unionNode struct {
+	left, right planNode
+	all         bool
+	seen        map[string]struct{}
+	current     planNode
+	err         error
+}
+
+var _ planNode = &unionNode{}
+
+func (n *unionNode) Columns() []string {
+	return n.left.Columns()
+}
+
+func (n *unionNode) Values() parser.DTuple {
+	return n.current.Values()
+}
+
+func (n *unionNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if n.current == nil {
+		n.current = n.left
+	}
+	for {
+		if !n.current.Next() {
+			if n.err = n.current.Err(); n.err != nil || n.current == n.right {
+				return false
+			}
+			n.current = n.right
+			continue
+		}
+		if n.all {
+			return true
+		}
+		key, err := encodeRowKey(n.current.Values())
+		if err != nil {
+			n.err = err
+			return false
+		}
+		if _, ok := n.seen[key]; !ok {
+			n.seen[key] = struct{}{}
+			return true
+		}
+	}
+}
+
+func (n *unionNode) Err() error {
+	return n.err
+}
+
+// setOpNode returns the rows of the left plan which are (for INTERSECT) or
+// are not (for EXCEPT) returned by the right plan. If all is set, a row is
+// returned as many times as the difference or the minimum of the number of
+// times it is returned by both plans; otherwise duplicate rows are only
+// returned once.
+type setOpNode struct {
+	left, right planNode
+	all         bool
+	intersect   bool
+	// counts holds the number of times each row of the right plan remains to
+	// be matched.
+	counts map[string]int
+	err    error
+}
+
+var _ planNode = &setOpNode{}
+
+func (n *setOpNode) Columns() []string {
+	return n.left.Columns()
+}
+
+func (n *setOpNode) Values() parser.DTuple {
+	return n.left.Values()
+}
+
+func (n *setOpNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if n.counts == nil {
+		n.counts = map[string]int{}
+		for n.right.Next() {
+			key, err := encodeRowKey(n.right.Values())
+			if err != nil {
+				n.err = err
+				return false
+			}
+			n.counts[key]++
+		}
+		if n.err = n.right.Err(); n.err != nil {
+			return false
+		}
+	}
+	for n.left.Next() {
+		key, err := encodeRowKey(n.left.Values())
+		if err != nil {
+			n.err = err
+			return false
+		}
+		count := n.counts[key]
+		if n.intersect {
+			if count <= 0 {
+				continue
+			}
+			if n.all {
+				n.counts[key]--
+			} else {
+				// Matched rows are only returned once.
+				n.counts[key] = 0
+			}
+			return true
+		}
+		if count > 0 {
+			if n.all {
+				n.counts[key]--
+			}
+			continue
+		}
+		if !n.all {
+			// Returned rows are only returned once.
+			n.counts[key] = 1
+		}
+		return true
+	}
+	n.err = n.left.Err()
+	return false
+}
+
+func (n *setOpNode) Err() error {
+	return n.err
+}
+
+// encodeRowKey encodes the values of a row such that the encodings of two
+// rows are equal if and only if their values are.
+func encodeRowKey(row parser.DTuple) (string, error) {
+	var key []byte
+	for _, d := range row {
+		var err error
+		if key, err = encodeDatumKey(key, d); err != nil {
+			return "", err
+		}
+	}
+	return string(key), nil
+}
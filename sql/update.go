@@ -19,10 +19,20 @@ package sql
 
 import (
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util"
 )
 
 // Update TODO(pmattis): document.
+// Privileges: UPDATE on table.
 func (p *planner) Update(n *parser.Update) (planNode, error) {
+	tableDesc, err := p.getAliasedTableDesc(n.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkPrivilege(tableDesc, privilege.UPDATE); err != nil {
+		return nil, err
+	}
 	return nil, util.Errorf("TODO(pmattis): unimplemented")
 }
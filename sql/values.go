@@ -28,8 +28,8 @@ func (p *planner) Values(n parser.Values) (planNode, error) {
 	v := &valuesNode{
 		rows: make([]parser.DTuple, 0, len(n)),
 	}
-	for _, tuple := range n {
-		data, err := parser.EvalExpr(tuple, nil)
+	for i, tuple := range n {
+		data, err := parser.EvalExpr(tuple, funcEnv{p: p})
 		if err != nil {
 			return nil, err
 		}
@@ -37,6 +37,14 @@ func (p *planner) Values(n parser.Values) (planNode, error) {
 		if !ok {
 			return nil, fmt.Errorf("expected a tuple, but found %T", data)
 		}
+		// The columns are named column1, column2, etc.
+		if i == 0 {
+			for j := range vals {
+				v.columns = append(v.columns, fmt.Sprintf("column%d", j+1))
+			}
+		} else if len(vals) != len(v.columns) {
+			return nil, fmt.Errorf("VALUES lists must all be the same length")
+		}
 		v.rows = append(v.rows, vals)
 	}
 	return v, nil
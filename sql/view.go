@@ -0,0 +1,234 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// CreateView creates a view. The query of the view is stored with fully
+// qualified table names and planned whenever the view is selected from.
+// Privileges: CREATE on database and SELECT on the tables and views the
+// view selects from.
+// The new view inherits the privileges of its database.
+func (p *planner) CreateView(n *parser.CreateView) (planNode, error) {
+	var err error
+	n.Name, err = p.normalizeTableName(n.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	dbDesc, err := p.getDatabaseDesc(n.Name.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkPrivilege(dbDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
+	tables, err := p.normalizeSelectTableNames(n.AsSource)
+	if err != nil {
+		return nil, err
+	}
+	// Plan the query to verify that it is valid and that the user is allowed
+	// to select from the tables it references.
+	plan, err := p.makePlan(n.AsSource)
+	if err != nil {
+		return nil, err
+	}
+	columns := plan.Columns()
+	if len(n.ColumnNames) > 0 {
+		if len(n.ColumnNames) != len(columns) {
+			return nil, fmt.Errorf("view \"%s\" has %d columns, but %d column names were specified",
+				n.Name, len(columns), len(n.ColumnNames))
+		}
+		columns = n.ColumnNames
+	}
+
+	var dependsOn []uint32
+	for _, table := range tables {
+		desc, err := p.getTableDesc(table)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, id := range dependsOn {
+			found = found || id == desc.ID
+		}
+		if !found {
+			dependsOn = append(dependsOn, desc.ID)
+		}
+	}
+
+	desc := structured.TableDescriptor{
+		Name:        n.Name.String(),
+		ViewQuery:   n.AsSource.String(),
+		ViewColumns: columns,
+		DependsOn:   dependsOn,
+		Privileges:  dbDesc.GetPrivileges(),
+	}
+	nameKey := keys.MakeNameMetadataKey(dbDesc.ID, n.Name.Table())
+	if _, err := p.createDescriptor(nameKey, &desc, false); err != nil {
+		return nil, err
+	}
+	// Record the view in the descriptors of the tables it selects from, so
+	// that they cannot be dropped without dropping the view.
+	if err := p.updateReferencedBy(desc.ID, dependsOn, true); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// normalizeSelectTableNames qualifies the table names within the FROM
+// clauses of a SELECT statement with the database of the session and
+// returns them.
+func (p *planner) normalizeSelectTableNames(stmt parser.SelectStatement) (
+	[]parser.QualifiedName, error) {
+	switch t := stmt.(type) {
+	case *parser.Select:
+		var tables []parser.QualifiedName
+		for _, from := range t.From {
+			table, err := getAliasedTableName(from)
+			if err != nil {
+				return nil, err
+			}
+			table, err = p.normalizeTableName(table)
+			if err != nil {
+				return nil, err
+			}
+			from.(*parser.AliasedTableExpr).Expr = table
+			tables = append(tables, table)
+		}
+		return tables, nil
+	case *parser.Union:
+		left, err := p.normalizeSelectTableNames(t.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := p.normalizeSelectTableNames(t.Right)
+		if err != nil {
+			return nil, err
+		}
+		return append(left, right...), nil
+	}
+	return nil, nil
+}
+
+// selectFromView plans a SELECT from a view. The query of the view is
+// planned as the source of the rows, which are then filtered and rendered
+// referring to the columns of the view by name.
+func (p *planner) selectFromView(desc *structured.TableDescriptor, columns []string,
+	exprs []parser.Expr, filter parser.Expr) (planNode, error) {
+	stmts, err := parser.Parse(desc.ViewQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("invalid query for view \"%s\": %s", desc.Name, desc.ViewQuery)
+	}
+	source, err := p.makePlan(stmts[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(source.Columns()) != len(desc.ViewColumns) {
+		return nil, fmt.Errorf("view \"%s\" has %d columns, but its query returns %d",
+			desc.Name, len(desc.ViewColumns), len(source.Columns()))
+	}
+	return &renderNode{
+		p:             p,
+		source:        source,
+		sourceColumns: desc.ViewColumns,
+		columns:       columns,
+		render:        exprs,
+		filter:        filter,
+	}, nil
+}
+
+// renderNode filters and renders the rows of a source plan. The
+// expressions refer to the values of the source by column name.
+type renderNode struct {
+	p             *planner
+	source        planNode
+	sourceColumns []string
+	columns       []string
+	render        []parser.Expr
+	filter        parser.Expr
+	row           parser.DTuple
+	err           error
+}
+
+var _ planNode = &renderNode{}
+
+func (n *renderNode) Columns() []string {
+	return n.columns
+}
+
+func (n *renderNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *renderNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	for n.source.Next() {
+		vals := valMap{}
+		for i, v := range n.source.Values() {
+			vals[n.sourceColumns[i]] = v
+		}
+		env := funcEnv{env: vals, p: n.p}
+
+		if n.filter != nil {
+			d, err := parser.EvalExpr(n.filter, env)
+			if err != nil {
+				n.err = err
+				return false
+			}
+			if d == (parser.DNull{}) {
+				continue
+			}
+			v, ok := d.(parser.DBool)
+			if !ok {
+				n.err = fmt.Errorf("WHERE clause did not evaluate to a boolean")
+				return false
+			}
+			if !v {
+				continue
+			}
+		}
+
+		n.row = make(parser.DTuple, len(n.render))
+		for i, e := range n.render {
+			n.row[i], n.err = parser.EvalExpr(e, env)
+			if n.err != nil {
+				return false
+			}
+		}
+		return true
+	}
+	n.err = n.source.Err()
+	return false
+}
+
+func (n *renderNode) Err() error {
+	return n.err
+}
@@ -0,0 +1,125 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// formatChanges returns a compact representation of changes for
+// comparison in tests.
+func formatChanges(changes []proto.ChangeEvent) []string {
+	var result []string
+	for _, c := range changes {
+		if c.Value == nil {
+			result = append(result, string(c.Key)+"=<deleted>")
+		} else {
+			result = append(result, string(c.Key)+"="+string(c.Value.Bytes))
+		}
+	}
+	return result
+}
+
+// TestRangeChanges verifies that the changes committed to a range are
+// returned by Changes requests, both from the range's data and from
+// the changes recorded as commands are applied, and that unresolved
+// intents hold back the resolved timestamp.
+func TestRangeChanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, stopper := createTestStore(t)
+	defer stopper.Stop()
+	db := store.DB()
+
+	expectChanges := func(since proto.Timestamp, expected ...string) proto.Timestamp {
+		changes, resolved, err := db.Changes("a", "z", since)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !since.Less(resolved) {
+			t.Errorf("expected resolved timestamp %s to advance past %s", resolved, since)
+		}
+		for _, c := range changes {
+			if !since.Less(c.Timestamp) || resolved.Less(c.Timestamp) {
+				t.Errorf("change %+v outside of (%s, %s]", c, since, resolved)
+			}
+		}
+		if actual := formatChanges(changes); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("expected changes %v; got %v", expected, actual)
+		}
+		return resolved
+	}
+
+	// Changes committed before the first request are read from the
+	// range's data.
+	if err := db.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Put("b", "2"); err != nil {
+		t.Fatal(err)
+	}
+	resolved := expectChanges(proto.ZeroTimestamp, "a=1", "b=2")
+
+	// Subsequent changes, including deletions, are recorded as they
+	// are applied.
+	if err := db.Put("c", "3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Del("a"); err != nil {
+		t.Fatal(err)
+	}
+	resolved = expectChanges(resolved, "c=3", "a=<deleted>")
+
+	// Transactional writes are not reported until committed, and the
+	// resolved timestamp stays below their intents.
+	var pendingResolved proto.Timestamp
+	if err := db.Txn(func(txn *client.Txn) error {
+		if err := txn.Put("d", "4"); err != nil {
+			return err
+		}
+		changes, r, err := db.Changes("a", "z", resolved)
+		if err != nil {
+			return err
+		}
+		if len(changes) != 0 {
+			t.Errorf("expected no changes while the transaction is pending; got %v", formatChanges(changes))
+		}
+		pendingResolved = r
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		changes, _, err := db.Changes("a", "z", resolved)
+		if err != nil {
+			return err
+		}
+		if actual := formatChanges(changes); !reflect.DeepEqual(actual, []string{"d=4"}) {
+			return util.Errorf("expected committed change d=4; got %v", actual)
+		}
+		if !pendingResolved.Less(changes[0].Timestamp) {
+			t.Errorf("resolved timestamp %s of pending transaction not below its commit at %s",
+				pendingResolved, changes[0].Timestamp)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,185 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestReapQueue verifies that enqueued messages are reaped in the order
+// they were enqueued and are only removed if the reaping transaction
+// commits.
+func TestReapQueue(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, stopper := createTestStore(t)
+	defer stopper.Stop()
+	db := store.DB()
+
+	inbox := proto.Key("inbox")
+	for i := 0; i < 3; i++ {
+		if err := db.EnqueueMessage(inbox, fmt.Sprintf("msg%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A message enqueued transactionally is only visible after commit.
+	if err := db.Txn(func(txn *client.Txn) error {
+		return txn.EnqueueMessage(inbox, "msg3")
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reaping outside of a transaction is not allowed.
+	b := db.NewBatch()
+	b.ReapQueue(inbox, 0)
+	if err := db.Run(b); err == nil {
+		t.Fatal("expected error reaping outside of a transaction")
+	}
+
+	// Messages reaped by an aborted transaction remain queued.
+	abortErr := util.Errorf("abort")
+	if err := db.Txn(func(txn *client.Txn) error {
+		msgs, err := txn.ReapQueue(inbox, 2)
+		if err != nil {
+			return err
+		}
+		if len(msgs) != 2 {
+			t.Errorf("expected 2 messages; got %d", len(msgs))
+		}
+		return abortErr
+	}); err != abortErr {
+		t.Fatalf("expected %s; got %v", abortErr, err)
+	}
+
+	var reaped []string
+	for len(reaped) < 4 {
+		if err := db.Txn(func(txn *client.Txn) error {
+			msgs, err := txn.ReapQueue(inbox, 3)
+			if err != nil {
+				return err
+			}
+			if len(msgs) == 0 {
+				return util.Errorf("queue unexpectedly empty after %v", reaped)
+			}
+			for _, msg := range msgs {
+				reaped = append(reaped, string(msg.ValueBytes()))
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expected := []string{"msg0", "msg1", "msg2", "msg3"}; fmt.Sprint(reaped) != fmt.Sprint(expected) {
+		t.Errorf("expected messages %v; got %v", expected, reaped)
+	}
+
+	// The queue is now empty.
+	if err := db.Txn(func(txn *client.Txn) error {
+		msgs, err := txn.ReapQueue(inbox, 0)
+		if err == nil && len(msgs) != 0 {
+			err = util.Errorf("expected empty queue; got %d messages", len(msgs))
+		}
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestEnqueueUpdate verifies that enqueued updates are applied by the
+// update queue, and that updates failing a condition are discarded.
+func TestEnqueueUpdate(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, stopper := createTestStore(t)
+	defer stopper.Stop()
+	db := store.DB()
+
+	update := db.NewBatch()
+	update.Put("a", "1")
+	update.Inc("b", 2)
+	update.EnqueueMessage("inbox", "updated")
+	if err := db.EnqueueUpdate(update); err != nil {
+		t.Fatal(err)
+	}
+	// The condition of this update fails, so none of its writes apply.
+	failed := db.NewBatch()
+	failed.Put("c", "1")
+	failed.CPut("a", "2", "wrong")
+	if err := db.EnqueueUpdate(failed); err != nil {
+		t.Fatal(err)
+	}
+	// Updates are applied in key order, so once this update is applied
+	// the failed one has been processed as well.
+	last := db.NewBatch()
+	last.Put("d", "1")
+	if err := db.EnqueueUpdate(last); err != nil {
+		t.Fatal(err)
+	}
+	// Non-transactional writes cannot be enqueued.
+	invalid := db.NewBatch()
+	invalid.Get("a")
+	if err := db.EnqueueUpdate(invalid); err == nil {
+		t.Fatal("expected error enqueueing a read")
+	}
+
+	// The updates are not applied synchronously.
+	if kv, err := db.Get("a"); err != nil {
+		t.Fatal(err)
+	} else if kv.Exists() {
+		t.Fatalf("expected update to be deferred; got %s", kv.ValueBytes())
+	}
+
+	util.SucceedsWithin(t, time.Second, func() error {
+		store.ForceUpdateQueueScan(t)
+		if kv, err := db.Get("d"); err != nil {
+			return err
+		} else if !kv.Exists() {
+			return util.Errorf("updates not yet applied")
+		}
+		return nil
+	})
+	if kv, err := db.Get("a"); err != nil {
+		t.Fatal(err)
+	} else if v := string(kv.ValueBytes()); v != "1" {
+		t.Errorf("expected a=1; got %q", v)
+	}
+	if kv, err := db.Get("b"); err != nil {
+		t.Fatal(err)
+	} else if kv.ValueInt() != 2 {
+		t.Errorf("expected b=2; got %d", kv.ValueInt())
+	}
+	if err := db.Txn(func(txn *client.Txn) error {
+		msgs, err := txn.ReapQueue("inbox", 0)
+		if err == nil && len(msgs) != 1 {
+			err = util.Errorf("expected 1 message; got %d", len(msgs))
+		}
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The failed update was discarded without applying any of its writes.
+	if kv, err := db.Get("c"); err != nil {
+		t.Fatal(err)
+	} else if kv.Exists() {
+		t.Errorf("expected c to be unset; got %q", kv.ValueBytes())
+	}
+}
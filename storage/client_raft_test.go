@@ -254,6 +254,109 @@ func TestReplicateRange(t *testing.T) {
 	})
 }
 
+// TestFollowerReadBelowClosedTimestamp verifies that a follower serves
+// reads at timestamps closed by the leader and redirects other reads.
+func TestFollowerReadBelowClosedTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := storage.TestStoreContext
+	ctx.ClosedTimestampLag = 10
+	mtc := &multiTestContext{storeContext: &ctx}
+	mtc.Start(t, 2)
+	defer mtc.Stop()
+
+	rng, err := mtc.stores[0].GetRange(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.ChangeReplicas(proto.ADD_REPLICA,
+		proto.Replica{
+			NodeID:  mtc.stores[1].Ident.NodeID,
+			StoreID: mtc.stores[1].Ident.StoreID,
+		}); err != nil {
+		t.Fatal(err)
+	}
+
+	incArgs := incrementArgs([]byte("a"), 5, 1, mtc.stores[0].StoreID())
+	if _, err := mtc.stores[0].ExecuteCmd(context.Background(), &incArgs); err != nil {
+		t.Fatal(err)
+	}
+	writeTS := incArgs.Timestamp
+
+	// A write proposed after the lag has passed closes the timestamp of
+	// the first write, once any other writes in flight have completed.
+	util.SucceedsWithin(t, 1*time.Second, func() error {
+		mtc.manualClock.Increment(100)
+		incArgs := incrementArgs([]byte("b"), 1, 1, mtc.stores[0].StoreID())
+		if _, err := mtc.stores[0].ExecuteCmd(context.Background(), &incArgs); err != nil {
+			return err
+		}
+		getArgs := getArgs([]byte("a"), 1, mtc.stores[1].StoreID())
+		getArgs.Timestamp = writeTS
+		reply, err := mtc.stores[1].ExecuteCmd(context.Background(), &getArgs)
+		if err != nil {
+			return err
+		}
+		if v := mustGetInteger(reply.(*proto.GetResponse).Value); v != 5 {
+			return util.Errorf("failed to read correct data: %d", v)
+		}
+		return nil
+	})
+
+	// A read at the present can only be served by the leader.
+	getArgs := getArgs([]byte("a"), 1, mtc.stores[1].StoreID())
+	getArgs.Timestamp = mtc.clock.Now()
+	if _, err := mtc.stores[1].ExecuteCmd(context.Background(), &getArgs); err == nil {
+		t.Fatal("expected follower to refuse read above closed timestamp")
+	} else if _, ok := err.(*proto.NotLeaderError); !ok {
+		t.Fatalf("expected NotLeaderError; got %s", err)
+	}
+}
+
+// TestFollowerReadOnIdleRange verifies that the leader keeps closing
+// timestamps on a range which sees no further writes.
+func TestFollowerReadOnIdleRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := storage.TestStoreContext
+	ctx.ClosedTimestampLag = 10
+	mtc := &multiTestContext{storeContext: &ctx}
+	mtc.Start(t, 2)
+	defer mtc.Stop()
+
+	rng, err := mtc.stores[0].GetRange(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.ChangeReplicas(proto.ADD_REPLICA,
+		proto.Replica{
+			NodeID:  mtc.stores[1].Ident.NodeID,
+			StoreID: mtc.stores[1].Ident.StoreID,
+		}); err != nil {
+		t.Fatal(err)
+	}
+
+	incArgs := incrementArgs([]byte("a"), 5, 1, mtc.stores[0].StoreID())
+	if _, err := mtc.stores[0].ExecuteCmd(context.Background(), &incArgs); err != nil {
+		t.Fatal(err)
+	}
+	writeTS := incArgs.Timestamp
+	mtc.manualClock.Increment(100)
+
+	// Without any further writes, the lease heartbeat closes the
+	// timestamp of the write.
+	util.SucceedsWithin(t, 1*time.Second, func() error {
+		getArgs := getArgs([]byte("a"), 1, mtc.stores[1].StoreID())
+		getArgs.Timestamp = writeTS
+		reply, err := mtc.stores[1].ExecuteCmd(context.Background(), &getArgs)
+		if err != nil {
+			return err
+		}
+		if v := mustGetInteger(reply.(*proto.GetResponse).Value); v != 5 {
+			return util.Errorf("failed to read correct data: %d", v)
+		}
+		return nil
+	})
+}
+
 // TestRestoreReplicas ensures that consensus group membership is properly
 // persisted to disk and restored when a node is stopped and restarted.
 func TestRestoreReplicas(t *testing.T) {
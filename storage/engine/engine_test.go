@@ -244,6 +244,60 @@ func TestEngineBatch(t *testing.T) {
 	}, t)
 }
 
+// TestEngineReverseIteration verifies that iterators over an engine
+// and over a batch with pending writes and deletions can be
+// positioned with SeekReverse and moved backwards with Prev.
+func TestEngineReverseIteration(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	runWithAllEngines(func(engine Engine, t *testing.T) {
+		for _, k := range []string{"a", "b", "c", "d"} {
+			if err := engine.Put(proto.EncodedKey(k), []byte(k)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		batch := engine.NewBatch()
+		defer batch.Close()
+		if err := batch.Clear(proto.EncodedKey("c")); err != nil {
+			t.Fatal(err)
+		}
+		for _, k := range []string{"bb", "e"} {
+			if err := batch.Put(proto.EncodedKey(k), []byte(k)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		testCases := []struct {
+			eng      Engine
+			seek     string
+			expected []string
+		}{
+			{engine, "", []string{"d", "c", "b", "a"}},
+			{engine, "bb", []string{"b", "a"}},
+			{engine, "c", []string{"c", "b", "a"}},
+			{batch, "", []string{"e", "d", "bb", "b", "a"}},
+			{batch, "c", []string{"bb", "b", "a"}},
+			{batch, "dd", []string{"d", "bb", "b", "a"}},
+		}
+		for i, test := range testCases {
+			iter := test.eng.NewIterator()
+			var keys []string
+			for iter.SeekReverse([]byte(test.seek)); iter.Valid(); iter.Prev() {
+				if key := string(iter.Key()); key != string(iter.Value()) {
+					t.Errorf("%d: unexpected value %q at key %q", i, iter.Value(), key)
+				}
+				keys = append(keys, string(iter.Key()))
+			}
+			if err := iter.Error(); err != nil {
+				t.Errorf("%d: %s", i, err)
+			}
+			iter.Close()
+			if !reflect.DeepEqual(keys, test.expected) {
+				t.Errorf("%d: expected keys %v; got %v", i, test.expected, keys)
+			}
+		}
+	}, t)
+}
+
 func TestEnginePutGetDelete(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	runWithAllEngines(func(engine Engine, t *testing.T) {
@@ -68,10 +68,13 @@ func (gc *GarbageCollector) Filter(keys []proto.EncodedKey, values [][]byte) pro
 			log.Errorf("unable to unmarshal MVCC value %q: %v", key, err)
 			return proto.ZeroTimestamp
 		}
+		// A value which expired before the GC timestamp can no longer be
+		// read and is treated like a deletion tombstone.
+		deleted := mvccVal.Deleted || gc.IsExpired(mvccVal.Value)
 		if i == 0 {
 			// If the first value isn't a deletion tombstone, don't consider
 			// it for GC. It should always survive if non-deleted.
-			if !mvccVal.Deleted {
+			if !deleted {
 				survivors = true
 				continue
 			}
@@ -80,7 +83,7 @@ func (gc *GarbageCollector) Filter(keys []proto.EncodedKey, values [][]byte) pro
 		if ts.Less(gc.expiration) {
 			delTS = ts
 			break
-		} else if !mvccVal.Deleted {
+		} else if !deleted {
 			survivors = true
 		}
 	}
@@ -92,3 +95,10 @@ func (gc *GarbageCollector) Filter(keys []proto.EncodedKey, values [][]byte) pro
 	}
 	return delTS
 }
+
+// IsExpired returns whether the value expired before the GC
+// timestamp, that is, whether no read within the GC policy's TTL
+// could still observe it.
+func (gc *GarbageCollector) IsExpired(value *proto.Value) bool {
+	return value != nil && value.Expiration != nil && value.Expiration.Less(gc.expiration)
+}
@@ -47,6 +47,14 @@ func serializedMVCCValue(deleted bool, t *testing.T) []byte {
 	return data
 }
 
+func serializedExpiringMVCCValue(expiration proto.Timestamp, t *testing.T) []byte {
+	data, err := gogoproto.Marshal(&MVCCValue{Value: &proto.Value{Expiration: &expiration}})
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	return data
+}
+
 // TestGarbageCollectorFilter verifies the filter policies for
 // different sorts of MVCC keys.
 func TestGarbageCollectorFilter(t *testing.T) {
@@ -55,6 +63,8 @@ func TestGarbageCollectorFilter(t *testing.T) {
 	gcB := NewGarbageCollector(makeTS(0, 0), proto.GCPolicy{TTLSeconds: 2})
 	n := serializedMVCCValue(false, t)
 	d := serializedMVCCValue(true, t)
+	e := serializedExpiringMVCCValue(makeTS(1E9, 0), t)
+	f := serializedExpiringMVCCValue(makeTS(10E9, 0), t)
 	testData := []struct {
 		gc       *GarbageCollector
 		time     proto.Timestamp
@@ -79,6 +89,11 @@ func TestGarbageCollectorFilter(t *testing.T) {
 		{gcA, makeTS(5E9, 0), aKeys, [][]byte{n, n, n}, makeTS(1E9, 1)},
 		{gcB, makeTS(5E9, 0), bKeys, [][]byte{n, n}, makeTS(1E9, 0)},
 		{gcB, makeTS(5E9, 0), bKeys, [][]byte{d, n}, makeTS(2E9, 0)},
+		{gcA, makeTS(2E9, 0), aKeys, [][]byte{e, n, n}, proto.ZeroTimestamp},
+		{gcA, makeTS(3E9, 0), aKeys, [][]byte{e, n, n}, makeTS(2E9, 0)},
+		{gcA, makeTS(3E9, 0), aKeys, [][]byte{f, n, n}, makeTS(1E9, 1)},
+		{gcB, makeTS(3E9, 0), bKeys, [][]byte{e, n}, proto.ZeroTimestamp},
+		{gcB, makeTS(4E9, 0), bKeys, [][]byte{e, n}, makeTS(2E9, 0)},
 	}
 	for i, test := range testData {
 		test.gc.expiration = test.time
@@ -325,6 +325,21 @@ func updateStatsOnAbort(ms *MVCCStats, key proto.Key, origMetaKeySize, origMetaV
 	}
 }
 
+// updateStatsOnExpire moves the contribution of an expired, latest
+// value of a key from the live counters to the GC'able bytes age stat,
+// as though the value had been deleted. This precedes garbage
+// collection of the key, which expects exactly that accounting.
+func updateStatsOnExpire(ms *MVCCStats, key proto.Key, metaKeySize, metaValSize int64, meta *MVCCMetadata, ageSeconds int64) {
+	ok, sys := updateStatsForKey(ms, key)
+	if !ok || sys {
+		return
+	}
+	totalBytes := metaKeySize + metaValSize + meta.KeyBytes + meta.ValBytes
+	ms.LiveBytes -= totalBytes
+	ms.LiveCount--
+	ms.GCBytesAge += MVCCComputeGCBytesAge(totalBytes, ageSeconds)
+}
+
 // updateStatsOnGC updates stat counters after garbage collection
 // by subtracting key and value byte counts, updating key and
 // value counts, and updating the GC'able bytes age. If meta is
@@ -621,6 +636,11 @@ func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey,
 		if err := value.Value.Verify(key); err != nil {
 			return nil, nil, err
 		}
+		// Values which have expired as of the read timestamp are
+		// treated as though they had been deleted.
+		if exp := value.Value.Expiration; exp != nil && !timestamp.Less(*exp) {
+			return nil, ignoredIntents, nil
+		}
 	} else if !value.Deleted {
 		// Sanity check.
 		panic(fmt.Sprintf("encountered MVCC value at key %q with a nil proto.Value but with !Deleted: %+v", key, value))
@@ -1041,6 +1061,182 @@ func MVCCIterate(engine Engine, startKey, endKey proto.Key, timestamp proto.Time
 	return intents, wiErr
 }
 
+// MVCCReverseScan scans the key range specified by start key through
+// end key in descending key order, up to some maximum number of
+// results. Specify max=0 for unbounded scans.
+func MVCCReverseScan(engine Engine, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
+	consistent bool, txn *proto.Transaction) ([]proto.KeyValue, []proto.Intent, error) {
+	res := []proto.KeyValue{}
+	intents, err := MVCCReverseIterate(engine, key, endKey, timestamp, consistent, txn, func(kv proto.KeyValue) (bool, error) {
+		res = append(res, kv)
+		if max != 0 && max == int64(len(res)) {
+			return true, nil
+		}
+		return false, nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, intents, nil
+}
+
+// MVCCReverseIterate iterates over the key range specified by start
+// and end keys in descending key order, invoking f() with each
+// key/value pair as in MVCCIterate. The iterator steps back from the
+// end key to the last version of each preceding key, whose metadata
+// is then read with the usual intent and version handling.
+func MVCCReverseIterate(engine Engine, startKey, endKey proto.Key, timestamp proto.Timestamp,
+	consistent bool, txn *proto.Transaction, f func(proto.KeyValue) (bool, error)) ([]proto.Intent, error) {
+	if !consistent && txn != nil {
+		return nil, util.Errorf("cannot allow inconsistent reads within a transaction")
+	}
+	if len(endKey) == 0 {
+		return nil, emptyKeyError()
+	}
+
+	buf := getBufferPool.Get().(*getBuffer)
+	defer getBufferPool.Put(buf)
+
+	// We store encStartKey and encKey in the same buffer to avoid memory
+	// allocations. encKey is the exclusive upper bound of the keys which
+	// remain to be visited.
+	encStartKey := mvccEncodeKey(buf.key[0:0], startKey)
+	keyBuf := encStartKey[len(encStartKey):]
+	encKey := mvccEncodeKey(keyBuf, endKey)
+
+	iter := engine.NewIterator()
+	defer iter.Close()
+	getValue := func(engine Engine, start, end proto.EncodedKey,
+		msg gogoproto.Message) (proto.EncodedKey, error) {
+		iter.Seek(start)
+		if !iter.Valid() {
+			return nil, iter.Error()
+		}
+		key := iter.Key()
+		if bytes.Compare(key, end) >= 0 {
+			return nil, iter.Error()
+		}
+		return key, iter.ValueProto(msg)
+	}
+
+	var intents []proto.Intent
+	var wiErr error
+
+	for {
+		// Step back to the last key below the bound. Versions sort after
+		// the metadata of their key, so this is the oldest version of the
+		// preceding key, or its metadata if it has no versions.
+		iter.SeekReverse(encKey)
+		if iter.Valid() && bytes.Equal(iter.Key(), encKey) {
+			iter.Prev()
+		}
+		if !iter.Valid() || bytes.Compare(iter.Key(), encStartKey) < 0 {
+			if err := iter.Error(); err != nil {
+				return nil, err
+			}
+			break
+		}
+		key, _, _ := MVCCDecodeKey(iter.Key())
+		metaKey := mvccEncodeKey(keyBuf, key)
+		encKey = metaKey
+		iter.Seek(metaKey)
+		if !iter.Valid() || !bytes.Equal(iter.Key(), metaKey) {
+			if err := iter.Error(); err != nil {
+				return nil, err
+			}
+			return nil, util.Errorf("missing MVCC metadata for key %q", key)
+		}
+		if err := iter.ValueProto(&buf.meta); err != nil {
+			return nil, err
+		}
+		value, newIntents, err := mvccGetInternal(engine, key, metaKey, timestamp, consistent, txn, getValue, buf)
+		intents = append(intents, newIntents...)
+		if value != nil {
+			done, err := f(proto.KeyValue{Key: key, Value: *value})
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				break
+			}
+		}
+
+		if err != nil {
+			switch tErr := err.(type) {
+			case *proto.WriteIntentError:
+				if wiErr == nil {
+					wiErr = tErr
+				} else {
+					wiErr.(*proto.WriteIntentError).Intents = append(wiErr.(*proto.WriteIntentError).Intents, tErr.Intents...)
+				}
+			default:
+				return nil, err
+			}
+		}
+	}
+	return intents, wiErr
+}
+
+// MVCCChanges returns the committed versions of keys in [key, endKey)
+// with timestamps in the interval (since, until], along with the
+// unresolved write intents in the span. Deletions are returned with a
+// nil Value. Changes are sorted by key and, for each key, in ascending
+// timestamp order. Inline values are never returned as they are not
+// versioned.
+func MVCCChanges(engine Engine, key, endKey proto.Key, since, until proto.Timestamp) ([]proto.ChangeEvent, []proto.Intent, error) {
+	if len(endKey) == 0 {
+		return nil, nil, emptyKeyError()
+	}
+
+	var changes []proto.ChangeEvent
+	var intents []proto.Intent
+	// Versions are stored newest first, so the changes to each key are
+	// gathered separately and reversed once the key is complete.
+	var keyChanges []proto.ChangeEvent
+	flush := func() {
+		for i := len(keyChanges) - 1; i >= 0; i-- {
+			changes = append(changes, keyChanges[i])
+		}
+		keyChanges = keyChanges[:0]
+	}
+	meta := &MVCCMetadata{}
+	err := engine.Iterate(MVCCEncodeKey(key), MVCCEncodeKey(endKey), func(kv proto.RawKeyValue) (bool, error) {
+		k, ts, isValue := MVCCDecodeKey(kv.Key)
+		if !isValue {
+			flush()
+			meta.Reset()
+			if err := gogoproto.Unmarshal(kv.Value, meta); err != nil {
+				return true, util.Errorf("unable to decode MVCCMetadata: %s", err)
+			}
+			if meta.Txn != nil {
+				intents = append(intents, proto.Intent{Key: k, Txn: *meta.Txn})
+			}
+			return false, nil
+		}
+		if meta.Txn != nil && ts.Equal(meta.Timestamp) {
+			// The most recent version is an intent, not a committed change.
+			return false, nil
+		}
+		if !since.Less(ts) || until.Less(ts) {
+			return false, nil
+		}
+		value := &MVCCValue{}
+		if err := gogoproto.Unmarshal(kv.Value, value); err != nil {
+			return true, util.Errorf("unable to decode MVCCValue: %s", err)
+		}
+		change := proto.ChangeEvent{Key: k, Timestamp: ts}
+		if !value.Deleted {
+			change.Value = value.Value
+			change.Value.Timestamp = &ts
+		}
+		keyChanges = append(keyChanges, change)
+		return false, nil
+	})
+	flush()
+	return changes, intents, err
+}
+
 // MVCCResolveWriteIntent either commits or aborts (rolls back) an
 // extant write intent for a given txn according to commit parameter.
 // ResolveWriteIntent will skip write intents of other txns.
@@ -1095,7 +1291,11 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timesta
 		newMeta := *meta
 		newMeta.Timestamp = txn.Timestamp
 		if pushed { // keep intent if we're pushing timestamp
-			newMeta.Txn = txn
+			// The intent keeps the sequence number of the write which
+			// laid it down.
+			pushedTxn := *txn
+			pushedTxn.Sequence = meta.Txn.Sequence
+			newMeta.Txn = &pushedTxn
 		} else {
 			newMeta.Txn = nil
 		}
@@ -1135,10 +1335,52 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timesta
 		return nil
 	}
 
-	// Otherwise, we're deleting the intent. We must find the next
-	// versioned value and reset the metadata's latest timestamp. If
-	// there are no other versioned values, we delete the metadata
-	// key.
+	// Otherwise, we're deleting the intent.
+	return mvccAbortIntent(engine, ms, key, metaKey, meta, origMetaKeySize, origMetaValSize, timestamp)
+}
+
+// MVCCRollbackWriteIntent removes the write intent of txn on the
+// specified key if the intent was written in txn's epoch after the
+// savepoint created at txn's sequence number. If restore is not nil,
+// the intent is instead replaced in place by an intent of txn for the
+// restored value or deletion. Other intents are left alone.
+func MVCCRollbackWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction,
+	restore *MVCCValue) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	if txn == nil {
+		return util.Error("no txn specified")
+	}
+
+	metaKey := MVCCEncodeKey(key)
+	meta := &MVCCMetadata{}
+	ok, origMetaKeySize, origMetaValSize, err := engine.GetProto(metaKey, meta)
+	if err != nil {
+		return err
+	}
+	if !ok || meta.Txn == nil || !bytes.Equal(meta.Txn.ID, txn.ID) ||
+		meta.Txn.Epoch != txn.Epoch || meta.Txn.Sequence <= txn.Sequence {
+		return nil
+	}
+	if restore != nil {
+		if restore.Deleted {
+			return MVCCDelete(engine, ms, key, timestamp, txn)
+		}
+		value := *restore.Value
+		value.Timestamp = nil
+		return MVCCPut(engine, ms, key, timestamp, value, txn)
+	}
+	return mvccAbortIntent(engine, ms, key, metaKey, meta, origMetaKeySize, origMetaValSize, timestamp)
+}
+
+// mvccAbortIntent deletes the write intent described by meta. We must
+// find the next versioned value and reset the metadata's latest
+// timestamp. If there are no other versioned values, we delete the
+// metadata key.
+func mvccAbortIntent(engine Engine, ms *MVCCStats, key proto.Key, metaKey proto.EncodedKey, meta *MVCCMetadata,
+	origMetaKeySize, origMetaValSize int64, timestamp proto.Timestamp) error {
+	origAgeSeconds := timestamp.WallTime/1E9 - meta.Timestamp.WallTime/1E9
 
 	// First clear the intent value.
 	latestKey := MVCCEncodeVersionKey(key, meta.Timestamp)
@@ -1168,8 +1410,7 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timesta
 		}
 		// Get the bytes for the next version so we have size for stat counts.
 		value := MVCCValue{}
-		var valueSize int64
-		ok, _, valueSize, err = engine.GetProto(kvs[0].Key, &value)
+		ok, _, valueSize, err := engine.GetProto(kvs[0].Key, &value)
 		if err != nil || !ok {
 			return util.Errorf("unable to fetch previous version for key %q (%t): %s", kvs[0].Key, ok, err)
 		}
@@ -1198,6 +1439,36 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timesta
 // txn. ResolveWriteIntentRange will skip write intents of other
 // txns. Specify max=0 for unbounded resolves.
 func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey proto.Key, max int64, timestamp proto.Timestamp, txn *proto.Transaction) (int64, error) {
+	return mvccResolveWriteIntentRange(engine, ms, key, endKey, max, timestamp, txn, MVCCResolveWriteIntent)
+}
+
+// MVCCRollbackWriteIntentRange removes the write intents of txn in the
+// range specified by start and end keys which were written after the
+// savepoint created at txn's sequence number, or replaces them by
+// restore. See MVCCRollbackWriteIntent. Unlike the resolution of
+// intents, the rollback fails if any of the intents can't be rolled
+// back. Specify max=0 for unbounded rollbacks.
+func MVCCRollbackWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey proto.Key, max int64, timestamp proto.Timestamp, txn *proto.Transaction,
+	restore *MVCCValue) (int64, error) {
+	var rollbackErr error
+	num, err := mvccResolveWriteIntentRange(engine, ms, key, endKey, max, timestamp, txn,
+		func(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction) error {
+			err := MVCCRollbackWriteIntent(engine, ms, key, timestamp, txn, restore)
+			if err != nil && rollbackErr == nil {
+				rollbackErr = err
+			}
+			return err
+		})
+	if err == nil {
+		err = rollbackErr
+	}
+	return num, err
+}
+
+// mvccResolveWriteIntentRange applies resolve to the write intents in
+// the range specified by start and end keys.
+func mvccResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey proto.Key, max int64, timestamp proto.Timestamp, txn *proto.Transaction,
+	resolve func(Engine, *MVCCStats, proto.Key, proto.Timestamp, *proto.Transaction) error) (int64, error) {
 	if txn == nil {
 		return 0, util.Error("no txn specified")
 	}
@@ -1221,7 +1492,7 @@ func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey proto
 		if isValue {
 			return 0, util.Errorf("expected an MVCC metadata key: %s", kvs[0].Key)
 		}
-		err = MVCCResolveWriteIntent(engine, ms, currentKey, timestamp, txn)
+		err = resolve(engine, ms, currentKey, timestamp, txn)
 		if err != nil {
 			log.Warningf("failed to resolve intent for key %q: %v", currentKey, err)
 		} else {
@@ -1259,13 +1530,21 @@ func MVCCGarbageCollect(engine Engine, ms *MVCCStats, keys []proto.InternalGCReq
 			return util.Errorf("unable to marshal mvcc meta: %s", err)
 		}
 		if !gcKey.Timestamp.Less(meta.Timestamp) {
-			if !meta.Deleted {
-				return util.Errorf("request to GC non-deleted, latest value of %q", gcKey.Key)
-			}
 			if meta.Txn != nil {
 				return util.Errorf("request to GC intent at %q", gcKey.Key)
 			}
 			ageSeconds := timestamp.WallTime/1E9 - meta.Timestamp.WallTime/1E9
+			if !meta.Deleted {
+				// The latest value may only be GC'd if it has expired.
+				latest := &MVCCValue{}
+				if _, _, _, err := engine.GetProto(MVCCEncodeVersionKey(gcKey.Key, meta.Timestamp), latest); err != nil {
+					return err
+				}
+				if latest.Value == nil || latest.Value.Expiration == nil || timestamp.Less(*latest.Value.Expiration) {
+					return util.Errorf("request to GC non-deleted, latest value of %q", gcKey.Key)
+				}
+				updateStatsOnExpire(ms, gcKey.Key, int64(len(iter.Key())), int64(len(iter.Value())), meta, ageSeconds)
+			}
 			updateStatsOnGC(ms, gcKey.Key, int64(len(iter.Key())), int64(len(iter.Value())), meta, ageSeconds)
 			if err := engine.Clear(iter.Key()); err != nil {
 				return err
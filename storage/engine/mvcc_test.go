@@ -429,6 +429,49 @@ func TestMVCCGetNoMoreOldVersion(t *testing.T) {
 	}
 }
 
+// TestMVCCGetExpired verifies that a value is visible to reads only
+// at timestamps before its expiration.
+func TestMVCCGetExpired(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	exp := makeTS(3, 0)
+	value := proto.Value{Bytes: []byte("expiring"), Expiration: &exp}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(1, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, test := range []struct {
+		ts     proto.Timestamp
+		expGet bool
+		expLen int
+	}{
+		{makeTS(2, 0), true, 2},
+		{makeTS(2, 5), true, 2},
+		{makeTS(3, 0), false, 1},
+		{makeTS(4, 0), false, 1},
+	} {
+		val, _, err := MVCCGet(engine, testKey1, test.ts, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (val != nil) != test.expGet {
+			t.Errorf("%d: expected value visible=%t; got %+v", i, test.expGet, val)
+		}
+		kvs, _, err := MVCCScan(engine, testKey1, testKey4, 0, test.ts, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != test.expLen {
+			t.Errorf("%d: expected %d scanned values; got %d", i, test.expLen, len(kvs))
+		}
+	}
+}
+
 // TestMVCCGetUncertainty verifies that the appropriate error results when
 // a transaction reads a key at a timestamp that has versions newer than that
 // timestamp, but older than the transaction's MaxTimestamp.
@@ -1067,6 +1110,191 @@ func TestMVCCScanInconsistent(t *testing.T) {
 	}
 }
 
+func TestMVCCReverseScan(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	ts1 := makeTS(1, 0)
+	ts3 := makeTS(3, 0)
+	ts4 := makeTS(4, 0)
+	err := MVCCPut(engine, nil, testKey1, ts1, value1, nil)
+	err = MVCCPut(engine, nil, testKey2, ts1, value2, nil)
+	err = MVCCPut(engine, nil, testKey2, ts3, value3, nil)
+	err = MVCCPut(engine, nil, testKey3, ts1, value3, nil)
+	err = MVCCPut(engine, nil, testKey3, ts4, value2, nil)
+	err = MVCCPut(engine, nil, testKey4, ts1, value4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		key, endKey proto.Key
+		max         int64
+		ts          proto.Timestamp
+		expKVs      []proto.KeyValue
+	}{
+		{testKey1, testKey4, 0, ts1, []proto.KeyValue{
+			{Key: testKey3, Value: proto.Value{Bytes: value3.Bytes, Timestamp: &ts1}},
+			{Key: testKey2, Value: proto.Value{Bytes: value2.Bytes, Timestamp: &ts1}},
+			{Key: testKey1, Value: proto.Value{Bytes: value1.Bytes, Timestamp: &ts1}},
+		}},
+		{testKey2, testKey4, 0, ts4, []proto.KeyValue{
+			{Key: testKey3, Value: proto.Value{Bytes: value2.Bytes, Timestamp: &ts4}},
+			{Key: testKey2, Value: proto.Value{Bytes: value3.Bytes, Timestamp: &ts3}},
+		}},
+		{proto.KeyMin, proto.KeyMax, 2, ts3, []proto.KeyValue{
+			{Key: testKey4, Value: proto.Value{Bytes: value4.Bytes, Timestamp: &ts1}},
+			{Key: testKey3, Value: proto.Value{Bytes: value3.Bytes, Timestamp: &ts1}},
+		}},
+		{testKey4.Next(), proto.KeyMax, 0, ts4, []proto.KeyValue{}},
+	}
+	for i, test := range testCases {
+		kvs, _, err := MVCCReverseScan(engine, test.key, test.endKey, test.max, test.ts, true, nil)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(kvs, test.expKVs) {
+			t.Errorf("%d: expected %v; got %v", i, test.expKVs, kvs)
+		}
+	}
+}
+
+// TestMVCCReverseScanIntents verifies that a reverse scan skips or
+// reports intents the same way a forward scan does, but only for the
+// keys it visits.
+func TestMVCCReverseScanIntents(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	ts1 := makeTS(1, 0)
+	ts2 := makeTS(2, 0)
+	ts4 := makeTS(4, 0)
+	if err := MVCCPut(engine, nil, testKey1, ts1, value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, ts2, value2, txn1); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, ts1, value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, ts2, value3, txn2); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey4, ts4, value4, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// An inconsistent scan returns the committed values and the intents.
+	kvs, intents, err := MVCCReverseScan(engine, testKey1, testKey4.Next(), 0, makeTS(5, 0), false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expIntents := []proto.Intent{
+		{Key: testKey3, Txn: *txn2},
+		{Key: testKey1, Txn: *txn1},
+	}
+	if !reflect.DeepEqual(intents, expIntents) {
+		t.Errorf("expected intents %+v; got %+v", expIntents, intents)
+	}
+	expKVs := []proto.KeyValue{
+		{Key: testKey4, Value: proto.Value{Bytes: value4.Bytes, Timestamp: &ts4}},
+		{Key: testKey2, Value: proto.Value{Bytes: value2.Bytes, Timestamp: &ts1}},
+		{Key: testKey1, Value: proto.Value{Bytes: value1.Bytes, Timestamp: &ts1}},
+	}
+	if !reflect.DeepEqual(kvs, expKVs) {
+		t.Errorf("expected key values %v; got %v", expKVs, kvs)
+	}
+
+	// A consistent scan within txn1 sees its own intent and reports
+	// the intent of txn2.
+	_, _, err = MVCCReverseScan(engine, testKey1, testKey4.Next(), 0, makeTS(5, 0), true, txn1)
+	wiErr, ok := err.(*proto.WriteIntentError)
+	if !ok {
+		t.Fatalf("expected write intent error; got %v", err)
+	}
+	if len(wiErr.Intents) != 1 || !bytes.Equal(wiErr.Intents[0].Key, testKey3) {
+		t.Errorf("unexpected intents %+v", wiErr.Intents)
+	}
+
+	// Stopping before the intent means it is never encountered.
+	kvs, _, err = MVCCReverseScan(engine, testKey1, testKey4.Next(), 1, makeTS(5, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 1 || !bytes.Equal(kvs[0].Key, testKey4) {
+		t.Errorf("unexpected key values %v", kvs)
+	}
+}
+
+// TestMVCCChanges verifies that MVCCChanges returns the committed
+// versions in the requested interval, in ascending timestamp order
+// per key, along with the unresolved intents.
+func TestMVCCChanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	ts1 := makeTS(1, 0)
+	ts2 := makeTS(2, 0)
+	ts3 := makeTS(3, 0)
+	if err := MVCCPut(engine, nil, testKey1, ts1, value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, ts2, value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey1, ts3, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, ts2, value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, ts3, value3, txn1); err != nil {
+		t.Fatal(err)
+	}
+	// Inline values are not versioned and never reported.
+	if err := MVCCPut(engine, nil, testKey3, proto.ZeroTimestamp, value3, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, intents, err := MVCCChanges(engine, testKey1, testKey4, ts1, ts3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expChanges := []proto.ChangeEvent{
+		{Key: testKey1, Value: &proto.Value{Bytes: value2.Bytes, Timestamp: &ts2}, Timestamp: ts2},
+		{Key: testKey1, Timestamp: ts3},
+		{Key: testKey2, Value: &proto.Value{Bytes: value2.Bytes, Timestamp: &ts2}, Timestamp: ts2},
+	}
+	if !reflect.DeepEqual(changes, expChanges) {
+		t.Errorf("expected changes %+v; got %+v", expChanges, changes)
+	}
+	expIntents := []proto.Intent{{Key: testKey2, Txn: *txn1}}
+	if !reflect.DeepEqual(intents, expIntents) {
+		t.Errorf("expected intents %+v; got %+v", expIntents, intents)
+	}
+
+	// Once the intent is committed, it is reported as a change.
+	txn := *txn1Commit
+	txn.Timestamp = ts3
+	if err := MVCCResolveWriteIntent(engine, nil, testKey2, ts3, &txn); err != nil {
+		t.Fatal(err)
+	}
+	changes, intents, err = MVCCChanges(engine, testKey2, testKey4, ts2, ts3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expChanges = []proto.ChangeEvent{
+		{Key: testKey2, Value: &proto.Value{Bytes: value3.Bytes, Timestamp: &ts3}, Timestamp: ts3},
+	}
+	if !reflect.DeepEqual(changes, expChanges) || len(intents) != 0 {
+		t.Errorf("expected changes %+v and no intents; got %+v, %+v", expChanges, changes, intents)
+	}
+}
+
 func TestMVCCDeleteRange(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	engine := createTestEngine()
@@ -1689,6 +1917,134 @@ func TestMVCCResolveTxnRange(t *testing.T) {
 	}
 }
 
+// TestMVCCRollbackTxnRange verifies that rolling back to a savepoint
+// removes only the intents written after it, including pushed ones.
+func TestMVCCRollbackTxnRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	txn := *txn1
+	txn.Sequence = 1
+	if err := MVCCPut(engine, nil, testKey2, makeTS(0, 2), value2, &txn); err != nil {
+		t.Fatal(err)
+	}
+	txn.Sequence = 2
+	if err := MVCCPut(engine, nil, testKey1, makeTS(0, 2), value4, &txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(0, 2), value3, &txn); err != nil {
+		t.Fatal(err)
+	}
+	// Push the intent on key3; it must keep its sequence number.
+	pushed := makeTxn(&txn, makeTS(0, 3))
+	pushed.Sequence = 0
+	if err := MVCCResolveWriteIntent(engine, nil, testKey3, makeTS(0, 3), pushed); err != nil {
+		t.Fatal(err)
+	}
+
+	savepoint := txn
+	savepoint.Sequence = 1
+	if _, err := MVCCRollbackWriteIntentRange(engine, nil, testKey1, testKey4, 0, makeTS(0, 3), &savepoint, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	value, _, err := MVCCGet(engine, testKey1, makeTS(0, 3), true, &txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value1.Bytes, value.Bytes) {
+		t.Errorf("expected value %q on key1; got %+v", value1.Bytes, value)
+	}
+	value, _, err = MVCCGet(engine, testKey2, makeTS(0, 3), true, &txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value2.Bytes, value.Bytes) {
+		t.Errorf("expected value %q on key2; got %+v", value2.Bytes, value)
+	}
+	value, _, err = MVCCGet(engine, testKey3, makeTS(0, 3), true, &txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != nil {
+		t.Errorf("expected key3 to be rolled back; got %+v", value)
+	}
+}
+
+// TestMVCCRollbackTxnRangeRestore verifies that rolling back to a
+// savepoint with a value to restore replaces the intents written after
+// the savepoint by intents for the restored value.
+func TestMVCCRollbackTxnRangeRestore(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	for _, key := range []proto.Key{testKey1, testKey2} {
+		if err := MVCCPut(engine, nil, key, makeTS(0, 1), value1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	txn := *txn1
+	txn.Sequence = 1
+	if err := MVCCPut(engine, nil, testKey1, makeTS(0, 2), value2, &txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey2, makeTS(0, 2), &txn); err != nil {
+		t.Fatal(err)
+	}
+	txn.Sequence = 2
+	for _, key := range []proto.Key{testKey1, testKey2} {
+		if err := MVCCPut(engine, nil, key, makeTS(0, 2), value3, &txn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	savepoint := txn
+	savepoint.Sequence = 1
+	if _, err := MVCCRollbackWriteIntentRange(engine, nil, testKey1, testKey1.Next(), 0, makeTS(0, 2), &savepoint,
+		&MVCCValue{Value: &value2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MVCCRollbackWriteIntentRange(engine, nil, testKey2, testKey2.Next(), 0, makeTS(0, 2), &savepoint,
+		&MVCCValue{Deleted: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	value, _, err := MVCCGet(engine, testKey1, makeTS(0, 2), true, &txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value2.Bytes, value.Bytes) {
+		t.Errorf("expected value %q on key1; got %+v", value2.Bytes, value)
+	}
+	value, _, err = MVCCGet(engine, testKey2, makeTS(0, 2), true, &txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != nil {
+		t.Errorf("expected key2 to be deleted; got %+v", value)
+	}
+	// The restored values are still intents of the transaction.
+	if _, _, err := MVCCGet(engine, testKey1, makeTS(0, 2), true, nil); err == nil {
+		t.Error("expected a write intent error reading key1 outside the transaction")
+	}
+	// A second rollback to the same savepoint leaves them alone.
+	if _, err := MVCCRollbackWriteIntentRange(engine, nil, testKey1, testKey3, 0, makeTS(0, 2), &savepoint, nil); err != nil {
+		t.Fatal(err)
+	}
+	value, _, err = MVCCGet(engine, testKey1, makeTS(0, 2), true, &txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value2.Bytes, value.Bytes) {
+		t.Errorf("expected value %q on key1; got %+v", value2.Bytes, value)
+	}
+}
+
 func TestValidSplitKeys(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	testCases := []struct {
@@ -2395,6 +2751,49 @@ func TestMVCCGarbageCollectNonDeleted(t *testing.T) {
 	}
 }
 
+// TestMVCCGarbageCollectExpired verifies that the first value for a
+// key can be GC'd once it has expired, and that stats are updated
+// accordingly.
+func TestMVCCGarbageCollectExpired(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	ms := &MVCCStats{}
+	bytes := []byte("value")
+	ts1 := makeTS(1E9, 0)
+	ts2 := makeTS(2E9, 0)
+	ts3 := makeTS(3E9, 0)
+	val1 := proto.Value{Bytes: bytes, Timestamp: &ts1}
+	val2 := proto.Value{Bytes: bytes, Timestamp: &ts2, Expiration: &ts3}
+	key := proto.Key("a")
+	for _, val := range []proto.Value{val1, val2} {
+		if err := MVCCPut(engine, ms, key, *val.Timestamp, val, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Manually advance aggregate gc'able bytes age by one second.
+	ms.GCBytesAge += ms.KeyBytes + ms.ValBytes - ms.LiveBytes
+
+	keys := []proto.InternalGCRequest_GCKey{
+		{Key: key, Timestamp: ts2},
+	}
+	if err := MVCCGarbageCollect(engine, ms, keys, ts2); err == nil {
+		t.Fatal("expected error garbage collecting a value before its expiration")
+	}
+	if err := MVCCGarbageCollect(engine, ms, keys, ts3); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := Scan(engine, MVCCEncodeKey(proto.KeyMin), MVCCEncodeKey(proto.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 0 {
+		t.Fatalf("expected all values to be GC'd; got %d kvs", len(kvs))
+	}
+	verifyStats("verification", ms, &MVCCStats{}, t)
+}
+
 // TestMVCCGarbageCollectIntent verifies that an intent cannot be GC'd.
 func TestMVCCGarbageCollectIntent(t *testing.T) {
 	defer leaktest.AfterTest(t)
@@ -732,6 +732,21 @@ func (r *rocksDBIterator) Seek(key []byte) {
 	}
 }
 
+func (r *rocksDBIterator) SeekReverse(key []byte) {
+	if len(key) == 0 {
+		C.DBIterSeekToLast(r.iter)
+		return
+	}
+	C.DBIterSeek(r.iter, goToCSlice(key))
+	// Seek positioned the iterator at the first key >= key; back up
+	// unless it is an exact match.
+	if !r.Valid() {
+		C.DBIterSeekToLast(r.iter)
+	} else if !bytes.Equal(r.Key(), key) {
+		C.DBIterPrev(r.iter)
+	}
+}
+
 func (r *rocksDBIterator) Valid() bool {
 	return C.DBIterValid(r.iter) == 1
 }
@@ -740,6 +755,10 @@ func (r *rocksDBIterator) Next() {
 	C.DBIterNext(r.iter)
 }
 
+func (r *rocksDBIterator) Prev() {
+	C.DBIterPrev(r.iter)
+}
+
 func (r *rocksDBIterator) Key() proto.EncodedKey {
 	// The data returned by rocksdb_iter_{key,value} is not meant to be
 	// freed by the client. It is a direct reference to the data managed
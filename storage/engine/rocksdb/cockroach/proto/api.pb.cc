@@ -72,12 +72,45 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* ScanResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ScanResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ReverseScanRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ReverseScanRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ReverseScanResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ReverseScanResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ChangesRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ChangesRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ChangeEvent_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ChangeEvent_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ChangesResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ChangesResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* EndTransactionRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   EndTransactionRequest_reflection_ = NULL;
 const ::google::protobuf::Descriptor* EndTransactionResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   EndTransactionResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ReapQueueRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ReapQueueRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ReapQueueResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ReapQueueResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* EnqueueUpdateRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  EnqueueUpdateRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* EnqueueUpdateResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  EnqueueUpdateResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* EnqueueMessageRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  EnqueueMessageRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* EnqueueMessageResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  EnqueueMessageResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* RequestUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RequestUnion_reflection_ = NULL;
@@ -90,6 +123,11 @@ struct RequestUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeRequest* delete_range_;
   const ::cockroach::proto::ScanRequest* scan_;
   const ::cockroach::proto::EndTransactionRequest* end_transaction_;
+  const ::cockroach::proto::ReverseScanRequest* reverse_scan_;
+  const ::cockroach::proto::ReapQueueRequest* reap_queue_;
+  const ::cockroach::proto::EnqueueUpdateRequest* enqueue_update_;
+  const ::cockroach::proto::EnqueueMessageRequest* enqueue_message_;
+  const ::cockroach::proto::ChangesRequest* changes_;
 }* RequestUnion_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* ResponseUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -103,6 +141,11 @@ struct ResponseUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeResponse* delete_range_;
   const ::cockroach::proto::ScanResponse* scan_;
   const ::cockroach::proto::EndTransactionResponse* end_transaction_;
+  const ::cockroach::proto::ReverseScanResponse* reverse_scan_;
+  const ::cockroach::proto::ReapQueueResponse* reap_queue_;
+  const ::cockroach::proto::EnqueueUpdateResponse* enqueue_update_;
+  const ::cockroach::proto::EnqueueMessageResponse* enqueue_message_;
+  const ::cockroach::proto::ChangesResponse* changes_;
 }* ResponseUnion_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* BatchRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -150,7 +193,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClientCmdID, _internal_metadata_),
       -1);
   RequestHeader_descriptor_ = file->message_type(1);
-  static const int RequestHeader_offsets_[10] = {
+  static const int RequestHeader_offsets_[11] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, cmd_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, key_),
@@ -161,6 +204,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, user_priority_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, read_consistency_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, max_staleness_),
   };
   RequestHeader_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -410,7 +454,90 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(ScanResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, _internal_metadata_),
       -1);
-  EndTransactionRequest_descriptor_ = file->message_type(17);
+  ReverseScanRequest_descriptor_ = file->message_type(17);
+  static const int ReverseScanRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, max_results_),
+  };
+  ReverseScanRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ReverseScanRequest_descriptor_,
+      ReverseScanRequest::default_instance_,
+      ReverseScanRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ReverseScanRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, _internal_metadata_),
+      -1);
+  ReverseScanResponse_descriptor_ = file->message_type(18);
+  static const int ReverseScanResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, rows_),
+  };
+  ReverseScanResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ReverseScanResponse_descriptor_,
+      ReverseScanResponse::default_instance_,
+      ReverseScanResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ReverseScanResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, _internal_metadata_),
+      -1);
+  ChangesRequest_descriptor_ = file->message_type(19);
+  static const int ChangesRequest_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesRequest, since_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesRequest, max_wait_),
+  };
+  ChangesRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ChangesRequest_descriptor_,
+      ChangesRequest::default_instance_,
+      ChangesRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ChangesRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesRequest, _internal_metadata_),
+      -1);
+  ChangeEvent_descriptor_ = file->message_type(20);
+  static const int ChangeEvent_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangeEvent, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangeEvent, value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangeEvent, timestamp_),
+  };
+  ChangeEvent_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ChangeEvent_descriptor_,
+      ChangeEvent::default_instance_,
+      ChangeEvent_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangeEvent, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ChangeEvent),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangeEvent, _internal_metadata_),
+      -1);
+  ChangesResponse_descriptor_ = file->message_type(21);
+  static const int ChangesResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesResponse, events_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesResponse, resolved_),
+  };
+  ChangesResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ChangesResponse_descriptor_,
+      ChangesResponse::default_instance_,
+      ChangesResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ChangesResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangesResponse, _internal_metadata_),
+      -1);
+  EndTransactionRequest_descriptor_ = file->message_type(22);
   static const int EndTransactionRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, commit_),
@@ -427,7 +554,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(EndTransactionRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, _internal_metadata_),
       -1);
-  EndTransactionResponse_descriptor_ = file->message_type(18);
+  EndTransactionResponse_descriptor_ = file->message_type(23);
   static const int EndTransactionResponse_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, commit_wait_),
@@ -444,8 +571,102 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(EndTransactionResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, _internal_metadata_),
       -1);
-  RequestUnion_descriptor_ = file->message_type(19);
-  static const int RequestUnion_offsets_[9] = {
+  ReapQueueRequest_descriptor_ = file->message_type(24);
+  static const int ReapQueueRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueRequest, max_results_),
+  };
+  ReapQueueRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ReapQueueRequest_descriptor_,
+      ReapQueueRequest::default_instance_,
+      ReapQueueRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ReapQueueRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueRequest, _internal_metadata_),
+      -1);
+  ReapQueueResponse_descriptor_ = file->message_type(25);
+  static const int ReapQueueResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueResponse, messages_),
+  };
+  ReapQueueResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ReapQueueResponse_descriptor_,
+      ReapQueueResponse::default_instance_,
+      ReapQueueResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ReapQueueResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueResponse, _internal_metadata_),
+      -1);
+  EnqueueUpdateRequest_descriptor_ = file->message_type(26);
+  static const int EnqueueUpdateRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateRequest, update_),
+  };
+  EnqueueUpdateRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      EnqueueUpdateRequest_descriptor_,
+      EnqueueUpdateRequest::default_instance_,
+      EnqueueUpdateRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(EnqueueUpdateRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateRequest, _internal_metadata_),
+      -1);
+  EnqueueUpdateResponse_descriptor_ = file->message_type(27);
+  static const int EnqueueUpdateResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateResponse, header_),
+  };
+  EnqueueUpdateResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      EnqueueUpdateResponse_descriptor_,
+      EnqueueUpdateResponse::default_instance_,
+      EnqueueUpdateResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(EnqueueUpdateResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateResponse, _internal_metadata_),
+      -1);
+  EnqueueMessageRequest_descriptor_ = file->message_type(28);
+  static const int EnqueueMessageRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageRequest, msg_),
+  };
+  EnqueueMessageRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      EnqueueMessageRequest_descriptor_,
+      EnqueueMessageRequest::default_instance_,
+      EnqueueMessageRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(EnqueueMessageRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageRequest, _internal_metadata_),
+      -1);
+  EnqueueMessageResponse_descriptor_ = file->message_type(29);
+  static const int EnqueueMessageResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageResponse, header_),
+  };
+  EnqueueMessageResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      EnqueueMessageResponse_descriptor_,
+      EnqueueMessageResponse::default_instance_,
+      EnqueueMessageResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(EnqueueMessageResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageResponse, _internal_metadata_),
+      -1);
+  RequestUnion_descriptor_ = file->message_type(30);
+  static const int RequestUnion_offsets_[14] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, conditional_put_),
@@ -454,6 +675,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, reverse_scan_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, reap_queue_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, enqueue_update_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, enqueue_message_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, changes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, value_),
   };
   RequestUnion_reflection_ =
@@ -469,8 +695,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(RequestUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, _internal_metadata_),
       -1);
-  ResponseUnion_descriptor_ = file->message_type(20);
-  static const int ResponseUnion_offsets_[9] = {
+  ResponseUnion_descriptor_ = file->message_type(31);
+  static const int ResponseUnion_offsets_[14] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, conditional_put_),
@@ -479,6 +705,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, reverse_scan_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, reap_queue_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, enqueue_update_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, enqueue_message_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, changes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, value_),
   };
   ResponseUnion_reflection_ =
@@ -494,7 +725,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(ResponseUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, _internal_metadata_),
       -1);
-  BatchRequest_descriptor_ = file->message_type(21);
+  BatchRequest_descriptor_ = file->message_type(32);
   static const int BatchRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, requests_),
@@ -510,7 +741,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(BatchRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, _internal_metadata_),
       -1);
-  BatchResponse_descriptor_ = file->message_type(22);
+  BatchResponse_descriptor_ = file->message_type(33);
   static const int BatchResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, responses_),
@@ -526,7 +757,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(BatchResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, _internal_metadata_),
       -1);
-  AdminSplitRequest_descriptor_ = file->message_type(23);
+  AdminSplitRequest_descriptor_ = file->message_type(34);
   static const int AdminSplitRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, split_key_),
@@ -542,7 +773,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(AdminSplitRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, _internal_metadata_),
       -1);
-  AdminSplitResponse_descriptor_ = file->message_type(24);
+  AdminSplitResponse_descriptor_ = file->message_type(35);
   static const int AdminSplitResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, header_),
   };
@@ -557,7 +788,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(AdminSplitResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, _internal_metadata_),
       -1);
-  AdminMergeRequest_descriptor_ = file->message_type(25);
+  AdminMergeRequest_descriptor_ = file->message_type(36);
   static const int AdminMergeRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, header_),
   };
@@ -572,7 +803,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(AdminMergeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, _internal_metadata_),
       -1);
-  AdminMergeResponse_descriptor_ = file->message_type(26);
+  AdminMergeResponse_descriptor_ = file->message_type(37);
   static const int AdminMergeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeResponse, header_),
   };
@@ -634,10 +865,32 @@ void protobuf_RegisterTypes(const ::std::string&) {
       ScanRequest_descriptor_, &ScanRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       ScanResponse_descriptor_, &ScanResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ReverseScanRequest_descriptor_, &ReverseScanRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ReverseScanResponse_descriptor_, &ReverseScanResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ChangesRequest_descriptor_, &ChangesRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ChangeEvent_descriptor_, &ChangeEvent::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ChangesResponse_descriptor_, &ChangesResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       EndTransactionRequest_descriptor_, &EndTransactionRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       EndTransactionResponse_descriptor_, &EndTransactionResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ReapQueueRequest_descriptor_, &ReapQueueRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ReapQueueResponse_descriptor_, &ReapQueueResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      EnqueueUpdateRequest_descriptor_, &EnqueueUpdateRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      EnqueueUpdateResponse_descriptor_, &EnqueueUpdateResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      EnqueueMessageRequest_descriptor_, &EnqueueMessageRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      EnqueueMessageResponse_descriptor_, &EnqueueMessageResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       RequestUnion_descriptor_, &RequestUnion::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -693,10 +946,32 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto() {
   delete ScanRequest_reflection_;
   delete ScanResponse::default_instance_;
   delete ScanResponse_reflection_;
+  delete ReverseScanRequest::default_instance_;
+  delete ReverseScanRequest_reflection_;
+  delete ReverseScanResponse::default_instance_;
+  delete ReverseScanResponse_reflection_;
+  delete ChangesRequest::default_instance_;
+  delete ChangesRequest_reflection_;
+  delete ChangeEvent::default_instance_;
+  delete ChangeEvent_reflection_;
+  delete ChangesResponse::default_instance_;
+  delete ChangesResponse_reflection_;
   delete EndTransactionRequest::default_instance_;
   delete EndTransactionRequest_reflection_;
   delete EndTransactionResponse::default_instance_;
   delete EndTransactionResponse_reflection_;
+  delete ReapQueueRequest::default_instance_;
+  delete ReapQueueRequest_reflection_;
+  delete ReapQueueResponse::default_instance_;
+  delete ReapQueueResponse_reflection_;
+  delete EnqueueUpdateRequest::default_instance_;
+  delete EnqueueUpdateRequest_reflection_;
+  delete EnqueueUpdateResponse::default_instance_;
+  delete EnqueueUpdateResponse_reflection_;
+  delete EnqueueMessageRequest::default_instance_;
+  delete EnqueueMessageRequest_reflection_;
+  delete EnqueueMessageResponse::default_instance_;
+  delete EnqueueMessageResponse_reflection_;
   delete RequestUnion::default_instance_;
   delete RequestUnion_default_oneof_instance_;
   delete RequestUnion_reflection_;
@@ -733,7 +1008,7 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
     "roach/proto/data.proto\032\034cockroach/proto/"
     "errors.proto\032\024gogoproto/gogo.proto\"<\n\013Cl"
     "ientCmdID\022\027\n\twall_time\030\001 \001(\003B\004\310\336\037\000\022\024\n\006ra"
-    "ndom\030\002 \001(\003B\004\310\336\037\000\"\254\003\n\rRequestHeader\0223\n\tti"
+    "ndom\030\002 \001(\003B\004\310\336\037\000\"\311\003\n\rRequestHeader\0223\n\tti"
     "mestamp\030\001 \001(\0132\032.cockroach.proto.Timestam"
     "pB\004\310\336\037\000\022;\n\006cmd_id\030\002 \001(\0132\034.cockroach.prot"
     "o.ClientCmdIDB\r\310\336\037\000\342\336\037\005CmdID\022\024\n\003key\030\003 \001("
@@ -744,96 +1019,146 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
     "ority\030\010 \001(\005:\0011\022)\n\003txn\030\t \001(\0132\034.cockroach."
     "proto.Transaction\022D\n\020read_consistency\030\n "
     "\001(\0162$.cockroach.proto.ReadConsistencyTyp"
-    "eB\004\310\336\037\000\"\227\001\n\016ResponseHeader\022%\n\005error\030\001 \001("
-    "\0132\026.cockroach.proto.Error\0223\n\ttimestamp\030\002"
-    " \001(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\022)"
-    "\n\003txn\030\003 \001(\0132\034.cockroach.proto.Transactio"
-    "n\"F\n\nGetRequest\0228\n\006header\030\001 \001(\0132\036.cockro"
-    "ach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"o\n\013Get"
-    "Response\0229\n\006header\030\001 \001(\0132\037.cockroach.pro"
-    "to.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022%\n\005value\030\002 \001"
-    "(\0132\026.cockroach.proto.Value\"s\n\nPutRequest"
-    "\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Reque"
-    "stHeaderB\010\310\336\037\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.cock"
-    "roach.proto.ValueB\004\310\336\037\000\"H\n\013PutResponse\0229"
-    "\n\006header\030\001 \001(\0132\037.cockroach.proto.Respons"
-    "eHeaderB\010\310\336\037\000\320\336\037\001\"\251\001\n\025ConditionalPutRequ"
+    "eB\004\310\336\037\000\022\033\n\rmax_staleness\030\013 \001(\003B\004\310\336\037\000\"\227\001\n"
+    "\016ResponseHeader\022%\n\005error\030\001 \001(\0132\026.cockroa"
+    "ch.proto.Error\0223\n\ttimestamp\030\002 \001(\0132\032.cock"
+    "roach.proto.TimestampB\004\310\336\037\000\022)\n\003txn\030\003 \001(\013"
+    "2\034.cockroach.proto.Transaction\"F\n\nGetReq"
+    "uest\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.R"
+    "equestHeaderB\010\310\336\037\000\320\336\037\001\"o\n\013GetResponse\0229\n"
+    "\006header\030\001 \001(\0132\037.cockroach.proto.Response"
+    "HeaderB\010\310\336\037\000\320\336\037\001\022%\n\005value\030\002 \001(\0132\026.cockro"
+    "ach.proto.Value\"s\n\nPutRequest\0228\n\006header\030"
+    "\001 \001(\0132\036.cockroach.proto.RequestHeaderB\010\310"
+    "\336\037\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.cockroach.proto"
+    ".ValueB\004\310\336\037\000\"H\n\013PutResponse\0229\n\006header\030\001 "
+    "\001(\0132\037.cockroach.proto.ResponseHeaderB\010\310\336"
+    "\037\000\320\336\037\001\"\251\001\n\025ConditionalPutRequest\0228\n\006head"
+    "er\030\001 \001(\0132\036.cockroach.proto.RequestHeader"
+    "B\010\310\336\037\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.cockroach.pr"
+    "oto.ValueB\004\310\336\037\000\022)\n\texp_value\030\003 \001(\0132\026.coc"
+    "kroach.proto.Value\"S\n\026ConditionalPutResp"
+    "onse\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.R"
+    "esponseHeaderB\010\310\336\037\000\320\336\037\001\"e\n\020IncrementRequ"
     "est\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Re"
-    "questHeaderB\010\310\336\037\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.c"
-    "ockroach.proto.ValueB\004\310\336\037\000\022)\n\texp_value\030"
-    "\003 \001(\0132\026.cockroach.proto.Value\"S\n\026Conditi"
-    "onalPutResponse\0229\n\006header\030\001 \001(\0132\037.cockro"
-    "ach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"e\n\020In"
-    "crementRequest\0228\n\006header\030\001 \001(\0132\036.cockroa"
-    "ch.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\027\n\tincr"
-    "ement\030\002 \001(\003B\004\310\336\037\000\"g\n\021IncrementResponse\0229"
-    "\n\006header\030\001 \001(\0132\037.cockroach.proto.Respons"
-    "eHeaderB\010\310\336\037\000\320\336\037\001\022\027\n\tnew_value\030\002 \001(\003B\004\310\336"
-    "\037\000\"I\n\rDeleteRequest\0228\n\006header\030\001 \001(\0132\036.co"
-    "ckroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"K\n"
-    "\016DeleteResponse\0229\n\006header\030\001 \001(\0132\037.cockro"
-    "ach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"s\n\022De"
-    "leteRangeRequest\0228\n\006header\030\001 \001(\0132\036.cockr"
-    "oach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022#\n\025ma"
-    "x_entries_to_delete\030\002 \001(\003B\004\310\336\037\000\"k\n\023Delet"
-    "eRangeResponse\0229\n\006header\030\001 \001(\0132\037.cockroa"
-    "ch.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013num"
-    "_deleted\030\002 \001(\003B\004\310\336\037\000\"b\n\013ScanRequest\0228\n\006h"
+    "questHeaderB\010\310\336\037\000\320\336\037\001\022\027\n\tincrement\030\002 \001(\003"
+    "B\004\310\336\037\000\"g\n\021IncrementResponse\0229\n\006header\030\001 "
+    "\001(\0132\037.cockroach.proto.ResponseHeaderB\010\310\336"
+    "\037\000\320\336\037\001\022\027\n\tnew_value\030\002 \001(\003B\004\310\336\037\000\"I\n\rDelet"
+    "eRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.pro"
+    "to.RequestHeaderB\010\310\336\037\000\320\336\037\001\"K\n\016DeleteResp"
+    "onse\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.R"
+    "esponseHeaderB\010\310\336\037\000\320\336\037\001\"s\n\022DeleteRangeRe"
+    "quest\0228\n\006header\030\001 \001(\0132\036.cockroach.proto."
+    "RequestHeaderB\010\310\336\037\000\320\336\037\001\022#\n\025max_entries_t"
+    "o_delete\030\002 \001(\003B\004\310\336\037\000\"k\n\023DeleteRangeRespo"
+    "nse\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Re"
+    "sponseHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013num_deleted\030\002 "
+    "\001(\003B\004\310\336\037\000\"b\n\013ScanRequest\0228\n\006header\030\001 \001(\013"
+    "2\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336"
+    "\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037\000\"x\n\014ScanRes"
+    "ponse\0229\n\006header\030\001 \001(\0132\037.cockroach.proto."
+    "ResponseHeaderB\010\310\336\037\000\320\336\037\001\022-\n\004rows\030\002 \003(\0132\031"
+    ".cockroach.proto.KeyValueB\004\310\336\037\000\"i\n\022Rever"
+    "seScanRequest\0228\n\006header\030\001 \001(\0132\036.cockroac"
+    "h.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013max_r"
+    "esults\030\002 \001(\003B\004\310\336\037\000\"\177\n\023ReverseScanRespons"
+    "e\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Resp"
+    "onseHeaderB\010\310\336\037\000\320\336\037\001\022-\n\004rows\030\002 \003(\0132\031.coc"
+    "kroach.proto.KeyValueB\004\310\336\037\000\"\223\001\n\016ChangesR"
+    "equest\0228\n\006header\030\001 \001(\0132\036.cockroach.proto"
+    ".RequestHeaderB\010\310\336\037\000\320\336\037\001\022/\n\005since\030\002 \001(\0132"
+    "\032.cockroach.proto.TimestampB\004\310\336\037\000\022\026\n\010max"
+    "_wait\030\003 \001(\003B\004\310\336\037\000\"\177\n\013ChangeEvent\022\024\n\003key\030"
+    "\001 \001(\014B\007\372\336\037\003Key\022%\n\005value\030\002 \001(\0132\026.cockroac"
+    "h.proto.Value\0223\n\ttimestamp\030\003 \001(\0132\032.cockr"
+    "oach.proto.TimestampB\004\310\336\037\000\"\264\001\n\017ChangesRe"
+    "sponse\0229\n\006header\030\001 \001(\0132\037.cockroach.proto"
+    ".ResponseHeaderB\010\310\336\037\000\320\336\037\001\0222\n\006events\030\002 \003("
+    "\0132\034.cockroach.proto.ChangeEventB\004\310\336\037\000\0222\n"
+    "\010resolved\030\003 \001(\0132\032.cockroach.proto.Timest"
+    "ampB\004\310\336\037\000\"\260\001\n\025EndTransactionRequest\0228\n\006h"
     "eader\030\001 \001(\0132\036.cockroach.proto.RequestHea"
-    "derB\010\310\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037\000"
-    "\"x\n\014ScanResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022-\n\004r"
-    "ows\030\002 \003(\0132\031.cockroach.proto.KeyValueB\004\310\336"
-    "\037\000\"\260\001\n\025EndTransactionRequest\0228\n\006header\030\001"
-    " \001(\0132\036.cockroach.proto.RequestHeaderB\010\310\336"
-    "\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B\004\310\336\037\000\022G\n\027internal"
-    "_commit_trigger\030\003 \001(\0132&.cockroach.proto."
-    "InternalCommitTrigger\"\211\001\n\026EndTransaction"
-    "Response\0229\n\006header\030\001 \001(\0132\037.cockroach.pro"
-    "to.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013commit_wa"
-    "it\030\002 \001(\003B\004\310\336\037\000\022\031\n\010resolved\030\003 \003(\014B\007\372\336\037\003Ke"
-    "y\"\320\003\n\014RequestUnion\022*\n\003get\030\002 \001(\0132\033.cockro"
-    "ach.proto.GetRequestH\000\022*\n\003put\030\003 \001(\0132\033.co"
-    "ckroach.proto.PutRequestH\000\022A\n\017conditiona"
-    "l_put\030\004 \001(\0132&.cockroach.proto.Conditiona"
-    "lPutRequestH\000\0226\n\tincrement\030\005 \001(\0132!.cockr"
-    "oach.proto.IncrementRequestH\000\0220\n\006delete\030"
-    "\006 \001(\0132\036.cockroach.proto.DeleteRequestH\000\022"
-    ";\n\014delete_range\030\007 \001(\0132#.cockroach.proto."
-    "DeleteRangeRequestH\000\022,\n\004scan\030\010 \001(\0132\034.coc"
-    "kroach.proto.ScanRequestH\000\022A\n\017end_transa"
-    "ction\030\t \001(\0132&.cockroach.proto.EndTransac"
-    "tionRequestH\000:\004\310\240\037\001B\007\n\005value\"\331\003\n\rRespons"
-    "eUnion\022+\n\003get\030\002 \001(\0132\034.cockroach.proto.Ge"
-    "tResponseH\000\022+\n\003put\030\003 \001(\0132\034.cockroach.pro"
-    "to.PutResponseH\000\022B\n\017conditional_put\030\004 \001("
-    "\0132\'.cockroach.proto.ConditionalPutRespon"
-    "seH\000\0227\n\tincrement\030\005 \001(\0132\".cockroach.prot"
-    "o.IncrementResponseH\000\0221\n\006delete\030\006 \001(\0132\037."
-    "cockroach.proto.DeleteResponseH\000\022<\n\014dele"
-    "te_range\030\007 \001(\0132$.cockroach.proto.DeleteR"
-    "angeResponseH\000\022-\n\004scan\030\010 \001(\0132\035.cockroach"
-    ".proto.ScanResponseH\000\022B\n\017end_transaction"
-    "\030\t \001(\0132\'.cockroach.proto.EndTransactionR"
-    "esponseH\000:\004\310\240\037\001B\007\n\005value\"\177\n\014BatchRequest"
-    "\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Reque"
-    "stHeaderB\010\310\336\037\000\320\336\037\001\0225\n\010requests\030\002 \003(\0132\035.c"
-    "ockroach.proto.RequestUnionB\004\310\336\037\000\"\203\001\n\rBa"
-    "tchResponse\0229\n\006header\030\001 \001(\0132\037.cockroach."
-    "proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0227\n\trespon"
-    "ses\030\002 \003(\0132\036.cockroach.proto.ResponseUnio"
-    "nB\004\310\336\037\000\"i\n\021AdminSplitRequest\0228\n\006header\030\001"
+    "derB\010\310\336\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B\004\310\336\037\000\022G\n\027i"
+    "nternal_commit_trigger\030\003 \001(\0132&.cockroach"
+    ".proto.InternalCommitTrigger\"\211\001\n\026EndTran"
+    "sactionResponse\0229\n\006header\030\001 \001(\0132\037.cockro"
+    "ach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013co"
+    "mmit_wait\030\002 \001(\003B\004\310\336\037\000\022\031\n\010resolved\030\003 \003(\014B"
+    "\007\372\336\037\003Key\"g\n\020ReapQueueRequest\0228\n\006header\030\001"
     " \001(\0132\036.cockroach.proto.RequestHeaderB\010\310\336"
-    "\037\000\320\336\037\001\022\032\n\tsplit_key\030\002 \001(\014B\007\372\336\037\003Key\"O\n\022Ad"
-    "minSplitResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"M\n\021A"
-    "dminMergeRequest\0228\n\006header\030\001 \001(\0132\036.cockr"
-    "oach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"O\n\022Ad"
-    "minMergeResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001*L\n\023R"
-    "eadConsistencyType\022\016\n\nCONSISTENT\020\000\022\r\n\tCO"
-    "NSENSUS\020\001\022\020\n\014INCONSISTENT\020\002\032\004\210\243\036\000B\023Z\005pro"
-    "to\340\342\036\001\310\342\036\001\320\342\036\001", 4214);
+    "\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037\000\"\201\001\n\021Re"
+    "apQueueResponse\0229\n\006header\030\001 \001(\0132\037.cockro"
+    "ach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0221\n\010me"
+    "ssages\030\002 \003(\0132\031.cockroach.proto.KeyValueB"
+    "\004\310\336\037\000\"\205\001\n\024EnqueueUpdateRequest\0228\n\006header"
+    "\030\001 \001(\0132\036.cockroach.proto.RequestHeaderB\010"
+    "\310\336\037\000\320\336\037\001\0223\n\006update\030\002 \001(\0132\035.cockroach.pro"
+    "to.BatchRequestB\004\310\336\037\000\"R\n\025EnqueueUpdateRe"
+    "sponse\0229\n\006header\030\001 \001(\0132\037.cockroach.proto"
+    ".ResponseHeaderB\010\310\336\037\000\320\336\037\001\"|\n\025EnqueueMess"
+    "ageRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.p"
+    "roto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022)\n\003msg\030\002 \001("
+    "\0132\026.cockroach.proto.ValueB\004\310\336\037\000\"S\n\026Enque"
+    "ueMessageResponse\0229\n\006header\030\001 \001(\0132\037.cock"
+    "roach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\376\005\n"
+    "\014RequestUnion\022*\n\003get\030\002 \001(\0132\033.cockroach.p"
+    "roto.GetRequestH\000\022*\n\003put\030\003 \001(\0132\033.cockroa"
+    "ch.proto.PutRequestH\000\022A\n\017conditional_put"
+    "\030\004 \001(\0132&.cockroach.proto.ConditionalPutR"
+    "equestH\000\0226\n\tincrement\030\005 \001(\0132!.cockroach."
+    "proto.IncrementRequestH\000\0220\n\006delete\030\006 \001(\013"
+    "2\036.cockroach.proto.DeleteRequestH\000\022;\n\014de"
+    "lete_range\030\007 \001(\0132#.cockroach.proto.Delet"
+    "eRangeRequestH\000\022,\n\004scan\030\010 \001(\0132\034.cockroac"
+    "h.proto.ScanRequestH\000\022A\n\017end_transaction"
+    "\030\t \001(\0132&.cockroach.proto.EndTransactionR"
+    "equestH\000\022;\n\014reverse_scan\030\n \001(\0132#.cockroa"
+    "ch.proto.ReverseScanRequestH\000\0227\n\nreap_qu"
+    "eue\030\013 \001(\0132!.cockroach.proto.ReapQueueReq"
+    "uestH\000\022\?\n\016enqueue_update\030\014 \001(\0132%.cockroa"
+    "ch.proto.EnqueueUpdateRequestH\000\022A\n\017enque"
+    "ue_message\030\r \001(\0132&.cockroach.proto.Enque"
+    "ueMessageRequestH\000\0222\n\007changes\030\016 \001(\0132\037.co"
+    "ckroach.proto.ChangesRequestH\000:\004\310\240\037\001B\007\n\005"
+    "value\"\214\006\n\rResponseUnion\022+\n\003get\030\002 \001(\0132\034.c"
+    "ockroach.proto.GetResponseH\000\022+\n\003put\030\003 \001("
+    "\0132\034.cockroach.proto.PutResponseH\000\022B\n\017con"
+    "ditional_put\030\004 \001(\0132\'.cockroach.proto.Con"
+    "ditionalPutResponseH\000\0227\n\tincrement\030\005 \001(\013"
+    "2\".cockroach.proto.IncrementResponseH\000\0221"
+    "\n\006delete\030\006 \001(\0132\037.cockroach.proto.DeleteR"
+    "esponseH\000\022<\n\014delete_range\030\007 \001(\0132$.cockro"
+    "ach.proto.DeleteRangeResponseH\000\022-\n\004scan\030"
+    "\010 \001(\0132\035.cockroach.proto.ScanResponseH\000\022B"
+    "\n\017end_transaction\030\t \001(\0132\'.cockroach.prot"
+    "o.EndTransactionResponseH\000\022<\n\014reverse_sc"
+    "an\030\n \001(\0132$.cockroach.proto.ReverseScanRe"
+    "sponseH\000\0228\n\nreap_queue\030\013 \001(\0132\".cockroach"
+    ".proto.ReapQueueResponseH\000\022@\n\016enqueue_up"
+    "date\030\014 \001(\0132&.cockroach.proto.EnqueueUpda"
+    "teResponseH\000\022B\n\017enqueue_message\030\r \001(\0132\'."
+    "cockroach.proto.EnqueueMessageResponseH\000"
+    "\0223\n\007changes\030\016 \001(\0132 .cockroach.proto.Chan"
+    "gesResponseH\000:\004\310\240\037\001B\007\n\005value\"\177\n\014BatchReq"
+    "uest\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.R"
+    "equestHeaderB\010\310\336\037\000\320\336\037\001\0225\n\010requests\030\002 \003(\013"
+    "2\035.cockroach.proto.RequestUnionB\004\310\336\037\000\"\203\001"
+    "\n\rBatchResponse\0229\n\006header\030\001 \001(\0132\037.cockro"
+    "ach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0227\n\tre"
+    "sponses\030\002 \003(\0132\036.cockroach.proto.Response"
+    "UnionB\004\310\336\037\000\"i\n\021AdminSplitRequest\0228\n\006head"
+    "er\030\001 \001(\0132\036.cockroach.proto.RequestHeader"
+    "B\010\310\336\037\000\320\336\037\001\022\032\n\tsplit_key\030\002 \001(\014B\007\372\336\037\003Key\"O"
+    "\n\022AdminSplitResponse\0229\n\006header\030\001 \001(\0132\037.c"
+    "ockroach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\""
+    "M\n\021AdminMergeRequest\0228\n\006header\030\001 \001(\0132\036.c"
+    "ockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"O"
+    "\n\022AdminMergeResponse\0229\n\006header\030\001 \001(\0132\037.c"
+    "ockroach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001*"
+    "L\n\023ReadConsistencyType\022\016\n\nCONSISTENT\020\000\022\r"
+    "\n\tCONSENSUS\020\001\022\020\n\014INCONSISTENT\020\002\032\004\210\243\036\000B\023Z"
+    "\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 6218);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/api.proto", &protobuf_RegisterTypes);
   ClientCmdID::default_instance_ = new ClientCmdID();
@@ -853,8 +1178,19 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
   DeleteRangeResponse::default_instance_ = new DeleteRangeResponse();
   ScanRequest::default_instance_ = new ScanRequest();
   ScanResponse::default_instance_ = new ScanResponse();
+  ReverseScanRequest::default_instance_ = new ReverseScanRequest();
+  ReverseScanResponse::default_instance_ = new ReverseScanResponse();
+  ChangesRequest::default_instance_ = new ChangesRequest();
+  ChangeEvent::default_instance_ = new ChangeEvent();
+  ChangesResponse::default_instance_ = new ChangesResponse();
   EndTransactionRequest::default_instance_ = new EndTransactionRequest();
   EndTransactionResponse::default_instance_ = new EndTransactionResponse();
+  ReapQueueRequest::default_instance_ = new ReapQueueRequest();
+  ReapQueueResponse::default_instance_ = new ReapQueueResponse();
+  EnqueueUpdateRequest::default_instance_ = new EnqueueUpdateRequest();
+  EnqueueUpdateResponse::default_instance_ = new EnqueueUpdateResponse();
+  EnqueueMessageRequest::default_instance_ = new EnqueueMessageRequest();
+  EnqueueMessageResponse::default_instance_ = new EnqueueMessageResponse();
   RequestUnion::default_instance_ = new RequestUnion();
   RequestUnion_default_oneof_instance_ = new RequestUnionOneofInstance();
   ResponseUnion::default_instance_ = new ResponseUnion();
@@ -882,8 +1218,19 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
   DeleteRangeResponse::default_instance_->InitAsDefaultInstance();
   ScanRequest::default_instance_->InitAsDefaultInstance();
   ScanResponse::default_instance_->InitAsDefaultInstance();
+  ReverseScanRequest::default_instance_->InitAsDefaultInstance();
+  ReverseScanResponse::default_instance_->InitAsDefaultInstance();
+  ChangesRequest::default_instance_->InitAsDefaultInstance();
+  ChangeEvent::default_instance_->InitAsDefaultInstance();
+  ChangesResponse::default_instance_->InitAsDefaultInstance();
   EndTransactionRequest::default_instance_->InitAsDefaultInstance();
   EndTransactionResponse::default_instance_->InitAsDefaultInstance();
+  ReapQueueRequest::default_instance_->InitAsDefaultInstance();
+  ReapQueueResponse::default_instance_->InitAsDefaultInstance();
+  EnqueueUpdateRequest::default_instance_->InitAsDefaultInstance();
+  EnqueueUpdateResponse::default_instance_->InitAsDefaultInstance();
+  EnqueueMessageRequest::default_instance_->InitAsDefaultInstance();
+  EnqueueMessageResponse::default_instance_->InitAsDefaultInstance();
   RequestUnion::default_instance_->InitAsDefaultInstance();
   ResponseUnion::default_instance_->InitAsDefaultInstance();
   BatchRequest::default_instance_->InitAsDefaultInstance();
@@ -1275,6 +1622,7 @@ const int RequestHeader::kRaftIdFieldNumber;
 const int RequestHeader::kUserPriorityFieldNumber;
 const int RequestHeader::kTxnFieldNumber;
 const int RequestHeader::kReadConsistencyFieldNumber;
+const int RequestHeader::kMaxStalenessFieldNumber;
 #endif  // !_MSC_VER
 
 RequestHeader::RequestHeader()
@@ -1311,6 +1659,7 @@ void RequestHeader::SharedCtor() {
   user_priority_ = 1;
   txn_ = NULL;
   read_consistency_ = 0;
+  max_staleness_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1357,6 +1706,14 @@ RequestHeader* RequestHeader::New(::google::protobuf::Arena* arena) const {
 }
 
 void RequestHeader::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<RequestHeader*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
   if (_has_bits_[0 / 32] & 255u) {
     if (has_timestamp()) {
       if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
@@ -1379,12 +1736,16 @@ void RequestHeader::Clear() {
     raft_id_ = GOOGLE_LONGLONG(0);
     user_priority_ = 1;
   }
-  if (_has_bits_[8 / 32] & 768u) {
+  if (_has_bits_[8 / 32] & 1792u) {
+    ZR_(read_consistency_, max_staleness_);
     if (has_txn()) {
       if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
     }
-    read_consistency_ = 0;
   }
+
+#undef ZR_HELPER_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
@@ -1541,6 +1902,21 @@ bool RequestHeader::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(88)) goto parse_max_staleness;
+        break;
+      }
+
+      // optional int64 max_staleness = 11;
+      case 11: {
+        if (tag == 88) {
+         parse_max_staleness:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_staleness_)));
+          set_has_max_staleness();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1632,6 +2008,11 @@ void RequestHeader::SerializeWithCachedSizes(
       10, this->read_consistency(), output);
   }
 
+  // optional int64 max_staleness = 11;
+  if (has_max_staleness()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(11, this->max_staleness(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1711,6 +2092,11 @@ void RequestHeader::SerializeWithCachedSizes(
       10, this->read_consistency(), target);
   }
 
+  // optional int64 max_staleness = 11;
+  if (has_max_staleness()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(11, this->max_staleness(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1780,7 +2166,7 @@ int RequestHeader::ByteSize() const {
     }
 
   }
-  if (_has_bits_[8 / 32] & 768) {
+  if (_has_bits_[8 / 32] & 1792) {
     // optional .cockroach.proto.Transaction txn = 9;
     if (has_txn()) {
       total_size += 1 +
@@ -1794,6 +2180,13 @@ int RequestHeader::ByteSize() const {
         ::google::protobuf::internal::WireFormatLite::EnumSize(this->read_consistency());
     }
 
+    // optional int64 max_staleness = 11;
+    if (has_max_staleness()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_staleness());
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -1856,6 +2249,9 @@ void RequestHeader::MergeFrom(const RequestHeader& from) {
     if (from.has_read_consistency()) {
       set_read_consistency(from.read_consistency());
     }
+    if (from.has_max_staleness()) {
+      set_max_staleness(from.max_staleness());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -1894,6 +2290,7 @@ void RequestHeader::InternalSwap(RequestHeader* other) {
   std::swap(user_priority_, other->user_priority_);
   std::swap(txn_, other->txn_);
   std::swap(read_consistency_, other->read_consistency_);
+  std::swap(max_staleness_, other->max_staleness_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -2314,6 +2711,30 @@ void RequestHeader::clear_read_consistency() {
   // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.read_consistency)
 }
 
+// optional int64 max_staleness = 11;
+bool RequestHeader::has_max_staleness() const {
+  return (_has_bits_[0] & 0x00000400u) != 0;
+}
+void RequestHeader::set_has_max_staleness() {
+  _has_bits_[0] |= 0x00000400u;
+}
+void RequestHeader::clear_has_max_staleness() {
+  _has_bits_[0] &= ~0x00000400u;
+}
+void RequestHeader::clear_max_staleness() {
+  max_staleness_ = GOOGLE_LONGLONG(0);
+  clear_has_max_staleness();
+}
+ ::google::protobuf::int64 RequestHeader::max_staleness() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.max_staleness)
+  return max_staleness_;
+}
+ void RequestHeader::set_max_staleness(::google::protobuf::int64 value) {
+  set_has_max_staleness();
+  max_staleness_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.max_staleness)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -7509,84 +7930,77 @@ ScanResponse::mutable_rows() {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EndTransactionRequest::kHeaderFieldNumber;
-const int EndTransactionRequest::kCommitFieldNumber;
-const int EndTransactionRequest::kInternalCommitTriggerFieldNumber;
+const int ReverseScanRequest::kHeaderFieldNumber;
+const int ReverseScanRequest::kMaxResultsFieldNumber;
 #endif  // !_MSC_VER
 
-EndTransactionRequest::EndTransactionRequest()
+ReverseScanRequest::ReverseScanRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ReverseScanRequest)
 }
 
-void EndTransactionRequest::InitAsDefaultInstance() {
+void ReverseScanRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
-  internal_commit_trigger_ = const_cast< ::cockroach::proto::InternalCommitTrigger*>(&::cockroach::proto::InternalCommitTrigger::default_instance());
 }
 
-EndTransactionRequest::EndTransactionRequest(const EndTransactionRequest& from)
+ReverseScanRequest::ReverseScanRequest(const ReverseScanRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ReverseScanRequest)
 }
 
-void EndTransactionRequest::SharedCtor() {
+void ReverseScanRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  commit_ = false;
-  internal_commit_trigger_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EndTransactionRequest::~EndTransactionRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.EndTransactionRequest)
+ReverseScanRequest::~ReverseScanRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ReverseScanRequest)
   SharedDtor();
 }
 
-void EndTransactionRequest::SharedDtor() {
+void ReverseScanRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete internal_commit_trigger_;
   }
 }
 
-void EndTransactionRequest::SetCachedSize(int size) const {
+void ReverseScanRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EndTransactionRequest::descriptor() {
+const ::google::protobuf::Descriptor* ReverseScanRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EndTransactionRequest_descriptor_;
+  return ReverseScanRequest_descriptor_;
 }
 
-const EndTransactionRequest& EndTransactionRequest::default_instance() {
+const ReverseScanRequest& ReverseScanRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-EndTransactionRequest* EndTransactionRequest::default_instance_ = NULL;
+ReverseScanRequest* ReverseScanRequest::default_instance_ = NULL;
 
-EndTransactionRequest* EndTransactionRequest::New(::google::protobuf::Arena* arena) const {
-  EndTransactionRequest* n = new EndTransactionRequest;
+ReverseScanRequest* ReverseScanRequest::New(::google::protobuf::Arena* arena) const {
+  ReverseScanRequest* n = new ReverseScanRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void EndTransactionRequest::Clear() {
-  if (_has_bits_[0 / 32] & 7u) {
+void ReverseScanRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
-    commit_ = false;
-    if (has_internal_commit_trigger()) {
-      if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
-    }
+    max_results_ = GOOGLE_LONGLONG(0);
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -7594,11 +8008,11 @@ void EndTransactionRequest::Clear() {
   }
 }
 
-bool EndTransactionRequest::MergePartialFromCodedStream(
+bool ReverseScanRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ReverseScanRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -7612,31 +8026,18 @@ bool EndTransactionRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_commit;
+        if (input->ExpectTag(16)) goto parse_max_results;
         break;
       }
 
-      // optional bool commit = 2;
+      // optional int64 max_results = 2;
       case 2: {
         if (tag == 16) {
-         parse_commit:
+         parse_max_results:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &commit_)));
-          set_has_commit();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_internal_commit_trigger;
-        break;
-      }
-
-      // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_internal_commit_trigger:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_commit_trigger()));
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
         } else {
           goto handle_unusual;
         }
@@ -7658,44 +8059,38 @@ bool EndTransactionRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ReverseScanRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ReverseScanRequest)
   return false;
 #undef DO_
 }
 
-void EndTransactionRequest::SerializeWithCachedSizes(
+void ReverseScanRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ReverseScanRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional bool commit = 2;
-  if (has_commit()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->commit(), output);
-  }
-
-  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-  if (has_internal_commit_trigger()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, *this->internal_commit_trigger_, output);
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ReverseScanRequest)
 }
 
-::google::protobuf::uint8* EndTransactionRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ReverseScanRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ReverseScanRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -7703,30 +8098,23 @@ void EndTransactionRequest::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // optional bool commit = 2;
-  if (has_commit()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->commit(), target);
-  }
-
-  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-  if (has_internal_commit_trigger()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, *this->internal_commit_trigger_, target);
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ReverseScanRequest)
   return target;
 }
 
-int EndTransactionRequest::ByteSize() const {
+int ReverseScanRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 7) {
+  if (_has_bits_[0 / 32] & 3) {
     // optional .cockroach.proto.RequestHeader header = 1;
     if (has_header()) {
       total_size += 1 +
@@ -7734,16 +8122,11 @@ int EndTransactionRequest::ByteSize() const {
           *this->header_);
     }
 
-    // optional bool commit = 2;
-    if (has_commit()) {
-      total_size += 1 + 1;
-    }
-
-    // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-    if (has_internal_commit_trigger()) {
+    // optional int64 max_results = 2;
+    if (has_max_results()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->internal_commit_trigger_);
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
     }
 
   }
@@ -7758,10 +8141,10 @@ int EndTransactionRequest::ByteSize() const {
   return total_size;
 }
 
-void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ReverseScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const EndTransactionRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const EndTransactionRequest>(
+  const ReverseScanRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ReverseScanRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7770,17 +8153,14 @@ void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void EndTransactionRequest::MergeFrom(const EndTransactionRequest& from) {
+void ReverseScanRequest::MergeFrom(const ReverseScanRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_commit()) {
-      set_commit(from.commit());
-    }
-    if (from.has_internal_commit_trigger()) {
-      mutable_internal_commit_trigger()->::cockroach::proto::InternalCommitTrigger::MergeFrom(from.internal_commit_trigger());
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -7788,80 +8168,79 @@ void EndTransactionRequest::MergeFrom(const EndTransactionRequest& from) {
   }
 }
 
-void EndTransactionRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ReverseScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EndTransactionRequest::CopyFrom(const EndTransactionRequest& from) {
+void ReverseScanRequest::CopyFrom(const ReverseScanRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EndTransactionRequest::IsInitialized() const {
+bool ReverseScanRequest::IsInitialized() const {
 
   return true;
 }
 
-void EndTransactionRequest::Swap(EndTransactionRequest* other) {
+void ReverseScanRequest::Swap(ReverseScanRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void EndTransactionRequest::InternalSwap(EndTransactionRequest* other) {
+void ReverseScanRequest::InternalSwap(ReverseScanRequest* other) {
   std::swap(header_, other->header_);
-  std::swap(commit_, other->commit_);
-  std::swap(internal_commit_trigger_, other->internal_commit_trigger_);
+  std::swap(max_results_, other->max_results_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata EndTransactionRequest::GetMetadata() const {
+::google::protobuf::Metadata ReverseScanRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EndTransactionRequest_descriptor_;
-  metadata.reflection = EndTransactionRequest_reflection_;
+  metadata.descriptor = ReverseScanRequest_descriptor_;
+  metadata.reflection = ReverseScanRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// EndTransactionRequest
+// ReverseScanRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-bool EndTransactionRequest::has_header() const {
+bool ReverseScanRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void EndTransactionRequest::set_has_header() {
+void ReverseScanRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void EndTransactionRequest::clear_has_header() {
+void ReverseScanRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void EndTransactionRequest::clear_header() {
+void ReverseScanRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
- const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
+ const ::cockroach::proto::RequestHeader& ReverseScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReverseScanRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
- ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
+ ::cockroach::proto::RequestHeader* ReverseScanRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReverseScanRequest.header)
   return header_;
 }
- ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
+ ::cockroach::proto::RequestHeader* ReverseScanRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
- void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+ void ReverseScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7869,74 +8248,31 @@ void EndTransactionRequest::clear_header() {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReverseScanRequest.header)
 }
 
-// optional bool commit = 2;
-bool EndTransactionRequest::has_commit() const {
+// optional int64 max_results = 2;
+bool ReverseScanRequest::has_max_results() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-void EndTransactionRequest::set_has_commit() {
+void ReverseScanRequest::set_has_max_results() {
   _has_bits_[0] |= 0x00000002u;
 }
-void EndTransactionRequest::clear_has_commit() {
+void ReverseScanRequest::clear_has_max_results() {
   _has_bits_[0] &= ~0x00000002u;
 }
-void EndTransactionRequest::clear_commit() {
-  commit_ = false;
-  clear_has_commit();
-}
- bool EndTransactionRequest::commit() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
-  return commit_;
-}
- void EndTransactionRequest::set_commit(bool value) {
-  set_has_commit();
-  commit_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
-}
-
-// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-bool EndTransactionRequest::has_internal_commit_trigger() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-void EndTransactionRequest::set_has_internal_commit_trigger() {
-  _has_bits_[0] |= 0x00000004u;
-}
-void EndTransactionRequest::clear_has_internal_commit_trigger() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-void EndTransactionRequest::clear_internal_commit_trigger() {
-  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
-  clear_has_internal_commit_trigger();
-}
- const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
-}
- ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
-  set_has_internal_commit_trigger();
-  if (internal_commit_trigger_ == NULL) {
-    internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_;
+void ReverseScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
 }
- ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
-  clear_has_internal_commit_trigger();
-  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
-  internal_commit_trigger_ = NULL;
-  return temp;
+ ::google::protobuf::int64 ReverseScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReverseScanRequest.max_results)
+  return max_results_;
 }
- void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
-  delete internal_commit_trigger_;
-  internal_commit_trigger_ = internal_commit_trigger;
-  if (internal_commit_trigger) {
-    set_has_internal_commit_trigger();
-  } else {
-    clear_has_internal_commit_trigger();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+ void ReverseScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ReverseScanRequest.max_results)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -7944,92 +8280,86 @@ void EndTransactionRequest::clear_internal_commit_trigger() {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EndTransactionResponse::kHeaderFieldNumber;
-const int EndTransactionResponse::kCommitWaitFieldNumber;
-const int EndTransactionResponse::kResolvedFieldNumber;
+const int ReverseScanResponse::kHeaderFieldNumber;
+const int ReverseScanResponse::kRowsFieldNumber;
 #endif  // !_MSC_VER
 
-EndTransactionResponse::EndTransactionResponse()
+ReverseScanResponse::ReverseScanResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ReverseScanResponse)
 }
 
-void EndTransactionResponse::InitAsDefaultInstance() {
+void ReverseScanResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
 
-EndTransactionResponse::EndTransactionResponse(const EndTransactionResponse& from)
+ReverseScanResponse::ReverseScanResponse(const ReverseScanResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ReverseScanResponse)
 }
 
-void EndTransactionResponse::SharedCtor() {
-  ::google::protobuf::internal::GetEmptyString();
+void ReverseScanResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  commit_wait_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EndTransactionResponse::~EndTransactionResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.EndTransactionResponse)
+ReverseScanResponse::~ReverseScanResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ReverseScanResponse)
   SharedDtor();
 }
 
-void EndTransactionResponse::SharedDtor() {
+void ReverseScanResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void EndTransactionResponse::SetCachedSize(int size) const {
+void ReverseScanResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EndTransactionResponse::descriptor() {
+const ::google::protobuf::Descriptor* ReverseScanResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EndTransactionResponse_descriptor_;
+  return ReverseScanResponse_descriptor_;
 }
 
-const EndTransactionResponse& EndTransactionResponse::default_instance() {
+const ReverseScanResponse& ReverseScanResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-EndTransactionResponse* EndTransactionResponse::default_instance_ = NULL;
+ReverseScanResponse* ReverseScanResponse::default_instance_ = NULL;
 
-EndTransactionResponse* EndTransactionResponse::New(::google::protobuf::Arena* arena) const {
-  EndTransactionResponse* n = new EndTransactionResponse;
+ReverseScanResponse* ReverseScanResponse::New(::google::protobuf::Arena* arena) const {
+  ReverseScanResponse* n = new ReverseScanResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void EndTransactionResponse::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-    }
-    commit_wait_ = GOOGLE_LONGLONG(0);
+void ReverseScanResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   }
-  resolved_.Clear();
+  rows_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool EndTransactionResponse::MergePartialFromCodedStream(
+bool ReverseScanResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ReverseScanResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -8043,35 +8373,23 @@ bool EndTransactionResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_commit_wait;
+        if (input->ExpectTag(18)) goto parse_rows;
         break;
       }
 
-      // optional int64 commit_wait = 2;
+      // repeated .cockroach.proto.KeyValue rows = 2;
       case 2: {
-        if (tag == 16) {
-         parse_commit_wait:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &commit_wait_)));
-          set_has_commit_wait();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_resolved;
-        break;
-      }
-
-      // repeated bytes resolved = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_resolved:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->add_resolved()));
+        if (tag == 18) {
+         parse_rows:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_rows()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_resolved;
+        if (input->ExpectTag(18)) goto parse_loop_rows;
+        input->UnsafeDecrementRecursionDepth();
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -8090,44 +8408,39 @@ bool EndTransactionResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ReverseScanResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ReverseScanResponse)
   return false;
 #undef DO_
 }
 
-void EndTransactionResponse::SerializeWithCachedSizes(
+void ReverseScanResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ReverseScanResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional int64 commit_wait = 2;
-  if (has_commit_wait()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->commit_wait(), output);
-  }
-
-  // repeated bytes resolved = 3;
-  for (int i = 0; i < this->resolved_size(); i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytes(
-      3, this->resolved(i), output);
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->rows(i), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ReverseScanResponse)
 }
 
-::google::protobuf::uint8* EndTransactionResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ReverseScanResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ReverseScanResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -8135,49 +8448,37 @@ void EndTransactionResponse::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // optional int64 commit_wait = 2;
-  if (has_commit_wait()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->commit_wait(), target);
-  }
-
-  // repeated bytes resolved = 3;
-  for (int i = 0; i < this->resolved_size(); i++) {
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
     target = ::google::protobuf::internal::WireFormatLite::
-      WriteBytesToArray(3, this->resolved(i), target);
+      WriteMessageNoVirtualToArray(
+        2, this->rows(i), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ReverseScanResponse)
   return target;
 }
 
-int EndTransactionResponse::ByteSize() const {
+int ReverseScanResponse::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3) {
-    // optional .cockroach.proto.ResponseHeader header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional int64 commit_wait = 2;
-    if (has_commit_wait()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->commit_wait());
-    }
-
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
-  // repeated bytes resolved = 3;
-  total_size += 1 * this->resolved_size();
-  for (int i = 0; i < this->resolved_size(); i++) {
-    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
-      this->resolved(i));
+
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  total_size += 1 * this->rows_size();
+  for (int i = 0; i < this->rows_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->rows(i));
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -8191,10 +8492,10 @@ int EndTransactionResponse::ByteSize() const {
   return total_size;
 }
 
-void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void ReverseScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const EndTransactionResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const EndTransactionResponse>(
+  const ReverseScanResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ReverseScanResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -8203,96 +8504,92 @@ void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from)
   }
 }
 
-void EndTransactionResponse::MergeFrom(const EndTransactionResponse& from) {
+void ReverseScanResponse::MergeFrom(const ReverseScanResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  resolved_.MergeFrom(from.resolved_);
+  rows_.MergeFrom(from.rows_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
-    if (from.has_commit_wait()) {
-      set_commit_wait(from.commit_wait());
-    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void EndTransactionResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void ReverseScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EndTransactionResponse::CopyFrom(const EndTransactionResponse& from) {
+void ReverseScanResponse::CopyFrom(const ReverseScanResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EndTransactionResponse::IsInitialized() const {
+bool ReverseScanResponse::IsInitialized() const {
 
   return true;
 }
 
-void EndTransactionResponse::Swap(EndTransactionResponse* other) {
+void ReverseScanResponse::Swap(ReverseScanResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void EndTransactionResponse::InternalSwap(EndTransactionResponse* other) {
+void ReverseScanResponse::InternalSwap(ReverseScanResponse* other) {
   std::swap(header_, other->header_);
-  std::swap(commit_wait_, other->commit_wait_);
-  resolved_.UnsafeArenaSwap(&other->resolved_);
+  rows_.UnsafeArenaSwap(&other->rows_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata EndTransactionResponse::GetMetadata() const {
+::google::protobuf::Metadata ReverseScanResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EndTransactionResponse_descriptor_;
-  metadata.reflection = EndTransactionResponse_reflection_;
+  metadata.descriptor = ReverseScanResponse_descriptor_;
+  metadata.reflection = ReverseScanResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// EndTransactionResponse
+// ReverseScanResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-bool EndTransactionResponse::has_header() const {
+bool ReverseScanResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void EndTransactionResponse::set_has_header() {
+void ReverseScanResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void EndTransactionResponse::clear_has_header() {
+void ReverseScanResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void EndTransactionResponse::clear_header() {
+void ReverseScanResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
- const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
+ const ::cockroach::proto::ResponseHeader& ReverseScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReverseScanResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
- ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
+ ::cockroach::proto::ResponseHeader* ReverseScanResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReverseScanResponse.header)
   return header_;
 }
- ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
+ ::cockroach::proto::ResponseHeader* ReverseScanResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
- void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+ void ReverseScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8300,85 +8597,4288 @@ void EndTransactionResponse::clear_header() {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReverseScanResponse.header)
 }
 
-// optional int64 commit_wait = 2;
-bool EndTransactionResponse::has_commit_wait() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// repeated .cockroach.proto.KeyValue rows = 2;
+int ReverseScanResponse::rows_size() const {
+  return rows_.size();
 }
-void EndTransactionResponse::set_has_commit_wait() {
-  _has_bits_[0] |= 0x00000002u;
+void ReverseScanResponse::clear_rows() {
+  rows_.Clear();
 }
-void EndTransactionResponse::clear_has_commit_wait() {
-  _has_bits_[0] &= ~0x00000002u;
+ const ::cockroach::proto::KeyValue& ReverseScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReverseScanResponse.rows)
+  return rows_.Get(index);
 }
-void EndTransactionResponse::clear_commit_wait() {
-  commit_wait_ = GOOGLE_LONGLONG(0);
-  clear_has_commit_wait();
+ ::cockroach::proto::KeyValue* ReverseScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReverseScanResponse.rows)
+  return rows_.Mutable(index);
 }
- ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
-  return commit_wait_;
+ ::cockroach::proto::KeyValue* ReverseScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ReverseScanResponse.rows)
+  return rows_.Add();
 }
- void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
-  set_has_commit_wait();
-  commit_wait_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+ReverseScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ReverseScanResponse.rows)
+  return rows_;
 }
-
-// repeated bytes resolved = 3;
-int EndTransactionResponse::resolved_size() const {
-  return resolved_.size();
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+ReverseScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ReverseScanResponse.rows)
+  return &rows_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ChangesRequest::kHeaderFieldNumber;
+const int ChangesRequest::kSinceFieldNumber;
+const int ChangesRequest::kMaxWaitFieldNumber;
+#endif  // !_MSC_VER
+
+ChangesRequest::ChangesRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ChangesRequest)
+}
+
+void ChangesRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  since_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+ChangesRequest::ChangesRequest(const ChangesRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ChangesRequest)
+}
+
+void ChangesRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  since_ = NULL;
+  max_wait_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ChangesRequest::~ChangesRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ChangesRequest)
+  SharedDtor();
+}
+
+void ChangesRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete since_;
+  }
+}
+
+void ChangesRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ChangesRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ChangesRequest_descriptor_;
+}
+
+const ChangesRequest& ChangesRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ChangesRequest* ChangesRequest::default_instance_ = NULL;
+
+ChangesRequest* ChangesRequest::New(::google::protobuf::Arena* arena) const {
+  ChangesRequest* n = new ChangesRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ChangesRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_since()) {
+      if (since_ != NULL) since_->::cockroach::proto::Timestamp::Clear();
+    }
+    max_wait_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ChangesRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ChangesRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_since;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp since = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_since:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_since()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_max_wait;
+        break;
+      }
+
+      // optional int64 max_wait = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_max_wait:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_wait_)));
+          set_has_max_wait();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ChangesRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ChangesRequest)
+  return false;
+#undef DO_
+}
+
+void ChangesRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ChangesRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.Timestamp since = 2;
+  if (has_since()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->since_, output);
+  }
+
+  // optional int64 max_wait = 3;
+  if (has_max_wait()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->max_wait(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ChangesRequest)
+}
+
+::google::protobuf::uint8* ChangesRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ChangesRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.Timestamp since = 2;
+  if (has_since()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->since_, target);
+  }
+
+  // optional int64 max_wait = 3;
+  if (has_max_wait()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->max_wait(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ChangesRequest)
+  return target;
+}
+
+int ChangesRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Timestamp since = 2;
+    if (has_since()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->since_);
+    }
+
+    // optional int64 max_wait = 3;
+    if (has_max_wait()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_wait());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ChangesRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ChangesRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ChangesRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ChangesRequest::MergeFrom(const ChangesRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_since()) {
+      mutable_since()->::cockroach::proto::Timestamp::MergeFrom(from.since());
+    }
+    if (from.has_max_wait()) {
+      set_max_wait(from.max_wait());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ChangesRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ChangesRequest::CopyFrom(const ChangesRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ChangesRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ChangesRequest::Swap(ChangesRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ChangesRequest::InternalSwap(ChangesRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(since_, other->since_);
+  std::swap(max_wait_, other->max_wait_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ChangesRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ChangesRequest_descriptor_;
+  metadata.reflection = ChangesRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ChangesRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool ChangesRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ChangesRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ChangesRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ChangesRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& ChangesRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* ChangesRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* ChangesRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ChangesRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangesRequest.header)
+}
+
+// optional .cockroach.proto.Timestamp since = 2;
+bool ChangesRequest::has_since() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ChangesRequest::set_has_since() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ChangesRequest::clear_has_since() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ChangesRequest::clear_since() {
+  if (since_ != NULL) since_->::cockroach::proto::Timestamp::Clear();
+  clear_has_since();
+}
+ const ::cockroach::proto::Timestamp& ChangesRequest::since() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesRequest.since)
+  return since_ != NULL ? *since_ : *default_instance_->since_;
+}
+ ::cockroach::proto::Timestamp* ChangesRequest::mutable_since() {
+  set_has_since();
+  if (since_ == NULL) {
+    since_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesRequest.since)
+  return since_;
+}
+ ::cockroach::proto::Timestamp* ChangesRequest::release_since() {
+  clear_has_since();
+  ::cockroach::proto::Timestamp* temp = since_;
+  since_ = NULL;
+  return temp;
+}
+ void ChangesRequest::set_allocated_since(::cockroach::proto::Timestamp* since) {
+  delete since_;
+  since_ = since;
+  if (since) {
+    set_has_since();
+  } else {
+    clear_has_since();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangesRequest.since)
+}
+
+// optional int64 max_wait = 3;
+bool ChangesRequest::has_max_wait() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void ChangesRequest::set_has_max_wait() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void ChangesRequest::clear_has_max_wait() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void ChangesRequest::clear_max_wait() {
+  max_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_max_wait();
+}
+ ::google::protobuf::int64 ChangesRequest::max_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesRequest.max_wait)
+  return max_wait_;
+}
+ void ChangesRequest::set_max_wait(::google::protobuf::int64 value) {
+  set_has_max_wait();
+  max_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ChangesRequest.max_wait)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ChangeEvent::kKeyFieldNumber;
+const int ChangeEvent::kValueFieldNumber;
+const int ChangeEvent::kTimestampFieldNumber;
+#endif  // !_MSC_VER
+
+ChangeEvent::ChangeEvent()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ChangeEvent)
+}
+
+void ChangeEvent::InitAsDefaultInstance() {
+  value_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
+  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+ChangeEvent::ChangeEvent(const ChangeEvent& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ChangeEvent)
+}
+
+void ChangeEvent::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_ = NULL;
+  timestamp_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ChangeEvent::~ChangeEvent() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ChangeEvent)
+  SharedDtor();
+}
+
+void ChangeEvent::SharedDtor() {
+  key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+    delete value_;
+    delete timestamp_;
+  }
+}
+
+void ChangeEvent::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ChangeEvent::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ChangeEvent_descriptor_;
+}
+
+const ChangeEvent& ChangeEvent::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ChangeEvent* ChangeEvent::default_instance_ = NULL;
+
+ChangeEvent* ChangeEvent::New(::google::protobuf::Arena* arena) const {
+  ChangeEvent* n = new ChangeEvent;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ChangeEvent::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_key()) {
+      key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+    }
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ChangeEvent::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ChangeEvent)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional .cockroach.proto.Value value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp timestamp = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ChangeEvent)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ChangeEvent)
+  return false;
+#undef DO_
+}
+
+void ChangeEvent::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ChangeEvent)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->value_, output);
+  }
+
+  // optional .cockroach.proto.Timestamp timestamp = 3;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, *this->timestamp_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ChangeEvent)
+}
+
+::google::protobuf::uint8* ChangeEvent::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ChangeEvent)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->value_, target);
+  }
+
+  // optional .cockroach.proto.Timestamp timestamp = 3;
+  if (has_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, *this->timestamp_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ChangeEvent)
+  return target;
+}
+
+int ChangeEvent::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional .cockroach.proto.Value value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->value_);
+    }
+
+    // optional .cockroach.proto.Timestamp timestamp = 3;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->timestamp_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ChangeEvent::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ChangeEvent* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ChangeEvent>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ChangeEvent::MergeFrom(const ChangeEvent& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_has_key();
+      key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.key_);
+    }
+    if (from.has_value()) {
+      mutable_value()->::cockroach::proto::Value::MergeFrom(from.value());
+    }
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ChangeEvent::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ChangeEvent::CopyFrom(const ChangeEvent& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ChangeEvent::IsInitialized() const {
+
+  return true;
+}
+
+void ChangeEvent::Swap(ChangeEvent* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ChangeEvent::InternalSwap(ChangeEvent* other) {
+  key_.Swap(&other->key_);
+  std::swap(value_, other->value_);
+  std::swap(timestamp_, other->timestamp_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ChangeEvent::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ChangeEvent_descriptor_;
+  metadata.reflection = ChangeEvent_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ChangeEvent
+
+// optional bytes key = 1;
+bool ChangeEvent::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ChangeEvent::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ChangeEvent::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ChangeEvent::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_key();
+}
+ const ::std::string& ChangeEvent::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeEvent.key)
+  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void ChangeEvent::set_key(const ::std::string& value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeEvent.key)
+}
+ void ChangeEvent::set_key(const char* value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.ChangeEvent.key)
+}
+ void ChangeEvent::set_key(const void* value, size_t size) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.ChangeEvent.key)
+}
+ ::std::string* ChangeEvent::mutable_key() {
+  set_has_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeEvent.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* ChangeEvent::release_key() {
+  clear_has_key();
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void ChangeEvent::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    set_has_key();
+  } else {
+    clear_has_key();
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeEvent.key)
+}
+
+// optional .cockroach.proto.Value value = 2;
+bool ChangeEvent::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ChangeEvent::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ChangeEvent::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ChangeEvent::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+ const ::cockroach::proto::Value& ChangeEvent::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeEvent.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+ ::cockroach::proto::Value* ChangeEvent::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeEvent.value)
+  return value_;
+}
+ ::cockroach::proto::Value* ChangeEvent::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+ void ChangeEvent::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeEvent.value)
+}
+
+// optional .cockroach.proto.Timestamp timestamp = 3;
+bool ChangeEvent::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void ChangeEvent::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void ChangeEvent::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void ChangeEvent::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+ const ::cockroach::proto::Timestamp& ChangeEvent::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeEvent.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+ ::cockroach::proto::Timestamp* ChangeEvent::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) {
+    timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeEvent.timestamp)
+  return timestamp_;
+}
+ ::cockroach::proto::Timestamp* ChangeEvent::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+ void ChangeEvent::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeEvent.timestamp)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ChangesResponse::kHeaderFieldNumber;
+const int ChangesResponse::kEventsFieldNumber;
+const int ChangesResponse::kResolvedFieldNumber;
+#endif  // !_MSC_VER
+
+ChangesResponse::ChangesResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ChangesResponse)
+}
+
+void ChangesResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  resolved_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+ChangesResponse::ChangesResponse(const ChangesResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ChangesResponse)
+}
+
+void ChangesResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  resolved_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ChangesResponse::~ChangesResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ChangesResponse)
+  SharedDtor();
+}
+
+void ChangesResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete resolved_;
+  }
+}
+
+void ChangesResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ChangesResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ChangesResponse_descriptor_;
+}
+
+const ChangesResponse& ChangesResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ChangesResponse* ChangesResponse::default_instance_ = NULL;
+
+ChangesResponse* ChangesResponse::New(::google::protobuf::Arena* arena) const {
+  ChangesResponse* n = new ChangesResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ChangesResponse::Clear() {
+  if (_has_bits_[0 / 32] & 5u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_resolved()) {
+      if (resolved_ != NULL) resolved_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+  events_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ChangesResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ChangesResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_events;
+        break;
+      }
+
+      // repeated .cockroach.proto.ChangeEvent events = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_events:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_events:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_events()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_loop_events;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectTag(26)) goto parse_resolved;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp resolved = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_resolved:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_resolved()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ChangesResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ChangesResponse)
+  return false;
+#undef DO_
+}
+
+void ChangesResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ChangesResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // repeated .cockroach.proto.ChangeEvent events = 2;
+  for (unsigned int i = 0, n = this->events_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->events(i), output);
+  }
+
+  // optional .cockroach.proto.Timestamp resolved = 3;
+  if (has_resolved()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, *this->resolved_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ChangesResponse)
+}
+
+::google::protobuf::uint8* ChangesResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ChangesResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // repeated .cockroach.proto.ChangeEvent events = 2;
+  for (unsigned int i = 0, n = this->events_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->events(i), target);
+  }
+
+  // optional .cockroach.proto.Timestamp resolved = 3;
+  if (has_resolved()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, *this->resolved_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ChangesResponse)
+  return target;
+}
+
+int ChangesResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 5) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Timestamp resolved = 3;
+    if (has_resolved()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->resolved_);
+    }
+
+  }
+  // repeated .cockroach.proto.ChangeEvent events = 2;
+  total_size += 1 * this->events_size();
+  for (int i = 0; i < this->events_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->events(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ChangesResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ChangesResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ChangesResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ChangesResponse::MergeFrom(const ChangesResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  events_.MergeFrom(from.events_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_resolved()) {
+      mutable_resolved()->::cockroach::proto::Timestamp::MergeFrom(from.resolved());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ChangesResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ChangesResponse::CopyFrom(const ChangesResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ChangesResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ChangesResponse::Swap(ChangesResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ChangesResponse::InternalSwap(ChangesResponse* other) {
+  std::swap(header_, other->header_);
+  events_.UnsafeArenaSwap(&other->events_);
+  std::swap(resolved_, other->resolved_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ChangesResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ChangesResponse_descriptor_;
+  metadata.reflection = ChangesResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ChangesResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool ChangesResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ChangesResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ChangesResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ChangesResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& ChangesResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* ChangesResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* ChangesResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ChangesResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangesResponse.header)
+}
+
+// repeated .cockroach.proto.ChangeEvent events = 2;
+int ChangesResponse::events_size() const {
+  return events_.size();
+}
+void ChangesResponse::clear_events() {
+  events_.Clear();
+}
+ const ::cockroach::proto::ChangeEvent& ChangesResponse::events(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesResponse.events)
+  return events_.Get(index);
+}
+ ::cockroach::proto::ChangeEvent* ChangesResponse::mutable_events(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesResponse.events)
+  return events_.Mutable(index);
+}
+ ::cockroach::proto::ChangeEvent* ChangesResponse::add_events() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ChangesResponse.events)
+  return events_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ChangeEvent >&
+ChangesResponse::events() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ChangesResponse.events)
+  return events_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ChangeEvent >*
+ChangesResponse::mutable_events() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ChangesResponse.events)
+  return &events_;
+}
+
+// optional .cockroach.proto.Timestamp resolved = 3;
+bool ChangesResponse::has_resolved() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void ChangesResponse::set_has_resolved() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void ChangesResponse::clear_has_resolved() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void ChangesResponse::clear_resolved() {
+  if (resolved_ != NULL) resolved_->::cockroach::proto::Timestamp::Clear();
+  clear_has_resolved();
+}
+ const ::cockroach::proto::Timestamp& ChangesResponse::resolved() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesResponse.resolved)
+  return resolved_ != NULL ? *resolved_ : *default_instance_->resolved_;
+}
+ ::cockroach::proto::Timestamp* ChangesResponse::mutable_resolved() {
+  set_has_resolved();
+  if (resolved_ == NULL) {
+    resolved_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesResponse.resolved)
+  return resolved_;
+}
+ ::cockroach::proto::Timestamp* ChangesResponse::release_resolved() {
+  clear_has_resolved();
+  ::cockroach::proto::Timestamp* temp = resolved_;
+  resolved_ = NULL;
+  return temp;
+}
+ void ChangesResponse::set_allocated_resolved(::cockroach::proto::Timestamp* resolved) {
+  delete resolved_;
+  resolved_ = resolved;
+  if (resolved) {
+    set_has_resolved();
+  } else {
+    clear_has_resolved();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangesResponse.resolved)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EndTransactionRequest::kHeaderFieldNumber;
+const int EndTransactionRequest::kCommitFieldNumber;
+const int EndTransactionRequest::kInternalCommitTriggerFieldNumber;
+#endif  // !_MSC_VER
+
+EndTransactionRequest::EndTransactionRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EndTransactionRequest)
+}
+
+void EndTransactionRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  internal_commit_trigger_ = const_cast< ::cockroach::proto::InternalCommitTrigger*>(&::cockroach::proto::InternalCommitTrigger::default_instance());
+}
+
+EndTransactionRequest::EndTransactionRequest(const EndTransactionRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EndTransactionRequest)
+}
+
+void EndTransactionRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  commit_ = false;
+  internal_commit_trigger_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EndTransactionRequest::~EndTransactionRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EndTransactionRequest)
+  SharedDtor();
+}
+
+void EndTransactionRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete internal_commit_trigger_;
+  }
+}
+
+void EndTransactionRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EndTransactionRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EndTransactionRequest_descriptor_;
+}
+
+const EndTransactionRequest& EndTransactionRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+EndTransactionRequest* EndTransactionRequest::default_instance_ = NULL;
+
+EndTransactionRequest* EndTransactionRequest::New(::google::protobuf::Arena* arena) const {
+  EndTransactionRequest* n = new EndTransactionRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void EndTransactionRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    commit_ = false;
+    if (has_internal_commit_trigger()) {
+      if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool EndTransactionRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EndTransactionRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_commit;
+        break;
+      }
+
+      // optional bool commit = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_commit:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &commit_)));
+          set_has_commit();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_internal_commit_trigger;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_internal_commit_trigger:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_commit_trigger()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EndTransactionRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EndTransactionRequest)
+  return false;
+#undef DO_
+}
+
+void EndTransactionRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EndTransactionRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional bool commit = 2;
+  if (has_commit()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->commit(), output);
+  }
+
+  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+  if (has_internal_commit_trigger()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, *this->internal_commit_trigger_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EndTransactionRequest)
+}
+
+::google::protobuf::uint8* EndTransactionRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EndTransactionRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional bool commit = 2;
+  if (has_commit()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->commit(), target);
+  }
+
+  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+  if (has_internal_commit_trigger()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, *this->internal_commit_trigger_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EndTransactionRequest)
+  return target;
+}
+
+int EndTransactionRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional bool commit = 2;
+    if (has_commit()) {
+      total_size += 1 + 1;
+    }
+
+    // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+    if (has_internal_commit_trigger()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->internal_commit_trigger_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const EndTransactionRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const EndTransactionRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EndTransactionRequest::MergeFrom(const EndTransactionRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_commit()) {
+      set_commit(from.commit());
+    }
+    if (from.has_internal_commit_trigger()) {
+      mutable_internal_commit_trigger()->::cockroach::proto::InternalCommitTrigger::MergeFrom(from.internal_commit_trigger());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void EndTransactionRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EndTransactionRequest::CopyFrom(const EndTransactionRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EndTransactionRequest::IsInitialized() const {
+
+  return true;
+}
+
+void EndTransactionRequest::Swap(EndTransactionRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void EndTransactionRequest::InternalSwap(EndTransactionRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(commit_, other->commit_);
+  std::swap(internal_commit_trigger_, other->internal_commit_trigger_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata EndTransactionRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EndTransactionRequest_descriptor_;
+  metadata.reflection = EndTransactionRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// EndTransactionRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool EndTransactionRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void EndTransactionRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void EndTransactionRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void EndTransactionRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
+}
+
+// optional bool commit = 2;
+bool EndTransactionRequest::has_commit() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void EndTransactionRequest::set_has_commit() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void EndTransactionRequest::clear_has_commit() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void EndTransactionRequest::clear_commit() {
+  commit_ = false;
+  clear_has_commit();
+}
+ bool EndTransactionRequest::commit() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
+  return commit_;
+}
+ void EndTransactionRequest::set_commit(bool value) {
+  set_has_commit();
+  commit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
+}
+
+// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+bool EndTransactionRequest::has_internal_commit_trigger() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void EndTransactionRequest::set_has_internal_commit_trigger() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void EndTransactionRequest::clear_has_internal_commit_trigger() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void EndTransactionRequest::clear_internal_commit_trigger() {
+  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
+  clear_has_internal_commit_trigger();
+}
+ const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
+}
+ ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
+  set_has_internal_commit_trigger();
+  if (internal_commit_trigger_ == NULL) {
+    internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_;
+}
+ ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
+  clear_has_internal_commit_trigger();
+  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
+  internal_commit_trigger_ = NULL;
+  return temp;
+}
+ void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
+  delete internal_commit_trigger_;
+  internal_commit_trigger_ = internal_commit_trigger;
+  if (internal_commit_trigger) {
+    set_has_internal_commit_trigger();
+  } else {
+    clear_has_internal_commit_trigger();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EndTransactionResponse::kHeaderFieldNumber;
+const int EndTransactionResponse::kCommitWaitFieldNumber;
+const int EndTransactionResponse::kResolvedFieldNumber;
+#endif  // !_MSC_VER
+
+EndTransactionResponse::EndTransactionResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EndTransactionResponse)
+}
+
+void EndTransactionResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+EndTransactionResponse::EndTransactionResponse(const EndTransactionResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EndTransactionResponse)
+}
+
+void EndTransactionResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EndTransactionResponse::~EndTransactionResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EndTransactionResponse)
+  SharedDtor();
+}
+
+void EndTransactionResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void EndTransactionResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EndTransactionResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EndTransactionResponse_descriptor_;
+}
+
+const EndTransactionResponse& EndTransactionResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+EndTransactionResponse* EndTransactionResponse::default_instance_ = NULL;
+
+EndTransactionResponse* EndTransactionResponse::New(::google::protobuf::Arena* arena) const {
+  EndTransactionResponse* n = new EndTransactionResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void EndTransactionResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    commit_wait_ = GOOGLE_LONGLONG(0);
+  }
+  resolved_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool EndTransactionResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EndTransactionResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_commit_wait;
+        break;
+      }
+
+      // optional int64 commit_wait = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_commit_wait:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &commit_wait_)));
+          set_has_commit_wait();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_resolved;
+        break;
+      }
+
+      // repeated bytes resolved = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_resolved:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_resolved()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_resolved;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EndTransactionResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EndTransactionResponse)
+  return false;
+#undef DO_
+}
+
+void EndTransactionResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EndTransactionResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional int64 commit_wait = 2;
+  if (has_commit_wait()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->commit_wait(), output);
+  }
+
+  // repeated bytes resolved = 3;
+  for (int i = 0; i < this->resolved_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      3, this->resolved(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EndTransactionResponse)
+}
+
+::google::protobuf::uint8* EndTransactionResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EndTransactionResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional int64 commit_wait = 2;
+  if (has_commit_wait()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->commit_wait(), target);
+  }
+
+  // repeated bytes resolved = 3;
+  for (int i = 0; i < this->resolved_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(3, this->resolved(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EndTransactionResponse)
+  return target;
+}
+
+int EndTransactionResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional int64 commit_wait = 2;
+    if (has_commit_wait()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->commit_wait());
+    }
+
+  }
+  // repeated bytes resolved = 3;
+  total_size += 1 * this->resolved_size();
+  for (int i = 0; i < this->resolved_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->resolved(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const EndTransactionResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const EndTransactionResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EndTransactionResponse::MergeFrom(const EndTransactionResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  resolved_.MergeFrom(from.resolved_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_commit_wait()) {
+      set_commit_wait(from.commit_wait());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void EndTransactionResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EndTransactionResponse::CopyFrom(const EndTransactionResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EndTransactionResponse::IsInitialized() const {
+
+  return true;
+}
+
+void EndTransactionResponse::Swap(EndTransactionResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void EndTransactionResponse::InternalSwap(EndTransactionResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(commit_wait_, other->commit_wait_);
+  resolved_.UnsafeArenaSwap(&other->resolved_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata EndTransactionResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EndTransactionResponse_descriptor_;
+  metadata.reflection = EndTransactionResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// EndTransactionResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool EndTransactionResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void EndTransactionResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void EndTransactionResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void EndTransactionResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
+}
+
+// optional int64 commit_wait = 2;
+bool EndTransactionResponse::has_commit_wait() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void EndTransactionResponse::set_has_commit_wait() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void EndTransactionResponse::clear_has_commit_wait() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void EndTransactionResponse::clear_commit_wait() {
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_commit_wait();
+}
+ ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
+  return commit_wait_;
+}
+ void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
+  set_has_commit_wait();
+  commit_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
+}
+
+// repeated bytes resolved = 3;
+int EndTransactionResponse::resolved_size() const {
+  return resolved_.size();
 }
 void EndTransactionResponse::clear_resolved() {
   resolved_.Clear();
 }
- const ::std::string& EndTransactionResponse::resolved(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Get(index);
+ const ::std::string& EndTransactionResponse::resolved(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Get(index);
+}
+ ::std::string* EndTransactionResponse::mutable_resolved(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Mutable(index);
+}
+ void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
+  resolved_.Mutable(index)->assign(value);
+}
+ void EndTransactionResponse::set_resolved(int index, const char* value) {
+  resolved_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
+}
+ void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
+  resolved_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
+}
+ ::std::string* EndTransactionResponse::add_resolved() {
+  return resolved_.Add();
+}
+ void EndTransactionResponse::add_resolved(const ::std::string& value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
+}
+ void EndTransactionResponse::add_resolved(const char* value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
+}
+ void EndTransactionResponse::add_resolved(const void* value, size_t size) {
+  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
+}
+ const ::google::protobuf::RepeatedPtrField< ::std::string>&
+EndTransactionResponse::resolved() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_;
+}
+ ::google::protobuf::RepeatedPtrField< ::std::string>*
+EndTransactionResponse::mutable_resolved() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
+  return &resolved_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ReapQueueRequest::kHeaderFieldNumber;
+const int ReapQueueRequest::kMaxResultsFieldNumber;
+#endif  // !_MSC_VER
+
+ReapQueueRequest::ReapQueueRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ReapQueueRequest)
+}
+
+void ReapQueueRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+ReapQueueRequest::ReapQueueRequest(const ReapQueueRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ReapQueueRequest)
+}
+
+void ReapQueueRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ReapQueueRequest::~ReapQueueRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ReapQueueRequest)
+  SharedDtor();
+}
+
+void ReapQueueRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ReapQueueRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ReapQueueRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ReapQueueRequest_descriptor_;
+}
+
+const ReapQueueRequest& ReapQueueRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ReapQueueRequest* ReapQueueRequest::default_instance_ = NULL;
+
+ReapQueueRequest* ReapQueueRequest::New(::google::protobuf::Arena* arena) const {
+  ReapQueueRequest* n = new ReapQueueRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ReapQueueRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    max_results_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ReapQueueRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ReapQueueRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_max_results;
+        break;
+      }
+
+      // optional int64 max_results = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_results:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ReapQueueRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ReapQueueRequest)
+  return false;
+#undef DO_
+}
+
+void ReapQueueRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ReapQueueRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ReapQueueRequest)
+}
+
+::google::protobuf::uint8* ReapQueueRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ReapQueueRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ReapQueueRequest)
+  return target;
+}
+
+int ReapQueueRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional int64 max_results = 2;
+    if (has_max_results()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ReapQueueRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ReapQueueRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ReapQueueRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ReapQueueRequest::MergeFrom(const ReapQueueRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ReapQueueRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ReapQueueRequest::CopyFrom(const ReapQueueRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ReapQueueRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ReapQueueRequest::Swap(ReapQueueRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ReapQueueRequest::InternalSwap(ReapQueueRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(max_results_, other->max_results_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ReapQueueRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ReapQueueRequest_descriptor_;
+  metadata.reflection = ReapQueueRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ReapQueueRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool ReapQueueRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ReapQueueRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ReapQueueRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ReapQueueRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& ReapQueueRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReapQueueRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* ReapQueueRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReapQueueRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* ReapQueueRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ReapQueueRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReapQueueRequest.header)
+}
+
+// optional int64 max_results = 2;
+bool ReapQueueRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ReapQueueRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ReapQueueRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ReapQueueRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+ ::google::protobuf::int64 ReapQueueRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReapQueueRequest.max_results)
+  return max_results_;
+}
+ void ReapQueueRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ReapQueueRequest.max_results)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ReapQueueResponse::kHeaderFieldNumber;
+const int ReapQueueResponse::kMessagesFieldNumber;
+#endif  // !_MSC_VER
+
+ReapQueueResponse::ReapQueueResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ReapQueueResponse)
+}
+
+void ReapQueueResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+ReapQueueResponse::ReapQueueResponse(const ReapQueueResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ReapQueueResponse)
+}
+
+void ReapQueueResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ReapQueueResponse::~ReapQueueResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ReapQueueResponse)
+  SharedDtor();
+}
+
+void ReapQueueResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ReapQueueResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ReapQueueResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ReapQueueResponse_descriptor_;
+}
+
+const ReapQueueResponse& ReapQueueResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ReapQueueResponse* ReapQueueResponse::default_instance_ = NULL;
+
+ReapQueueResponse* ReapQueueResponse::New(::google::protobuf::Arena* arena) const {
+  ReapQueueResponse* n = new ReapQueueResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ReapQueueResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  messages_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ReapQueueResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ReapQueueResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_messages;
+        break;
+      }
+
+      // repeated .cockroach.proto.KeyValue messages = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_messages:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_messages:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_messages()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_loop_messages;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ReapQueueResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ReapQueueResponse)
+  return false;
+#undef DO_
+}
+
+void ReapQueueResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ReapQueueResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // repeated .cockroach.proto.KeyValue messages = 2;
+  for (unsigned int i = 0, n = this->messages_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->messages(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ReapQueueResponse)
+}
+
+::google::protobuf::uint8* ReapQueueResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ReapQueueResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // repeated .cockroach.proto.KeyValue messages = 2;
+  for (unsigned int i = 0, n = this->messages_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->messages(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ReapQueueResponse)
+  return target;
+}
+
+int ReapQueueResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  // repeated .cockroach.proto.KeyValue messages = 2;
+  total_size += 1 * this->messages_size();
+  for (int i = 0; i < this->messages_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->messages(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ReapQueueResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ReapQueueResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ReapQueueResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ReapQueueResponse::MergeFrom(const ReapQueueResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  messages_.MergeFrom(from.messages_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ReapQueueResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ReapQueueResponse::CopyFrom(const ReapQueueResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ReapQueueResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ReapQueueResponse::Swap(ReapQueueResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ReapQueueResponse::InternalSwap(ReapQueueResponse* other) {
+  std::swap(header_, other->header_);
+  messages_.UnsafeArenaSwap(&other->messages_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ReapQueueResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ReapQueueResponse_descriptor_;
+  metadata.reflection = ReapQueueResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ReapQueueResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool ReapQueueResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ReapQueueResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ReapQueueResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ReapQueueResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& ReapQueueResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReapQueueResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* ReapQueueResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReapQueueResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* ReapQueueResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ReapQueueResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReapQueueResponse.header)
+}
+
+// repeated .cockroach.proto.KeyValue messages = 2;
+int ReapQueueResponse::messages_size() const {
+  return messages_.size();
+}
+void ReapQueueResponse::clear_messages() {
+  messages_.Clear();
+}
+ const ::cockroach::proto::KeyValue& ReapQueueResponse::messages(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReapQueueResponse.messages)
+  return messages_.Get(index);
+}
+ ::cockroach::proto::KeyValue* ReapQueueResponse::mutable_messages(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReapQueueResponse.messages)
+  return messages_.Mutable(index);
+}
+ ::cockroach::proto::KeyValue* ReapQueueResponse::add_messages() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ReapQueueResponse.messages)
+  return messages_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+ReapQueueResponse::messages() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ReapQueueResponse.messages)
+  return messages_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+ReapQueueResponse::mutable_messages() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ReapQueueResponse.messages)
+  return &messages_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EnqueueUpdateRequest::kHeaderFieldNumber;
+const int EnqueueUpdateRequest::kUpdateFieldNumber;
+#endif  // !_MSC_VER
+
+EnqueueUpdateRequest::EnqueueUpdateRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EnqueueUpdateRequest)
+}
+
+void EnqueueUpdateRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  update_ = const_cast< ::cockroach::proto::BatchRequest*>(&::cockroach::proto::BatchRequest::default_instance());
+}
+
+EnqueueUpdateRequest::EnqueueUpdateRequest(const EnqueueUpdateRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EnqueueUpdateRequest)
+}
+
+void EnqueueUpdateRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  update_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EnqueueUpdateRequest::~EnqueueUpdateRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EnqueueUpdateRequest)
+  SharedDtor();
+}
+
+void EnqueueUpdateRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete update_;
+  }
+}
+
+void EnqueueUpdateRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EnqueueUpdateRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EnqueueUpdateRequest_descriptor_;
+}
+
+const EnqueueUpdateRequest& EnqueueUpdateRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+EnqueueUpdateRequest* EnqueueUpdateRequest::default_instance_ = NULL;
+
+EnqueueUpdateRequest* EnqueueUpdateRequest::New(::google::protobuf::Arena* arena) const {
+  EnqueueUpdateRequest* n = new EnqueueUpdateRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void EnqueueUpdateRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_update()) {
+      if (update_ != NULL) update_->::cockroach::proto::BatchRequest::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool EnqueueUpdateRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EnqueueUpdateRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_update;
+        break;
+      }
+
+      // optional .cockroach.proto.BatchRequest update = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_update:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_update()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EnqueueUpdateRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EnqueueUpdateRequest)
+  return false;
+#undef DO_
+}
+
+void EnqueueUpdateRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EnqueueUpdateRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.BatchRequest update = 2;
+  if (has_update()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->update_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EnqueueUpdateRequest)
+}
+
+::google::protobuf::uint8* EnqueueUpdateRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EnqueueUpdateRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.BatchRequest update = 2;
+  if (has_update()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->update_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EnqueueUpdateRequest)
+  return target;
+}
+
+int EnqueueUpdateRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.BatchRequest update = 2;
+    if (has_update()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->update_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EnqueueUpdateRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const EnqueueUpdateRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const EnqueueUpdateRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EnqueueUpdateRequest::MergeFrom(const EnqueueUpdateRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_update()) {
+      mutable_update()->::cockroach::proto::BatchRequest::MergeFrom(from.update());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void EnqueueUpdateRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EnqueueUpdateRequest::CopyFrom(const EnqueueUpdateRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EnqueueUpdateRequest::IsInitialized() const {
+
+  return true;
+}
+
+void EnqueueUpdateRequest::Swap(EnqueueUpdateRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void EnqueueUpdateRequest::InternalSwap(EnqueueUpdateRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(update_, other->update_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata EnqueueUpdateRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EnqueueUpdateRequest_descriptor_;
+  metadata.reflection = EnqueueUpdateRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// EnqueueUpdateRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool EnqueueUpdateRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void EnqueueUpdateRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void EnqueueUpdateRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void EnqueueUpdateRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& EnqueueUpdateRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueUpdateRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* EnqueueUpdateRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueUpdateRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* EnqueueUpdateRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void EnqueueUpdateRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueUpdateRequest.header)
+}
+
+// optional .cockroach.proto.BatchRequest update = 2;
+bool EnqueueUpdateRequest::has_update() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void EnqueueUpdateRequest::set_has_update() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void EnqueueUpdateRequest::clear_has_update() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void EnqueueUpdateRequest::clear_update() {
+  if (update_ != NULL) update_->::cockroach::proto::BatchRequest::Clear();
+  clear_has_update();
+}
+ const ::cockroach::proto::BatchRequest& EnqueueUpdateRequest::update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueUpdateRequest.update)
+  return update_ != NULL ? *update_ : *default_instance_->update_;
+}
+ ::cockroach::proto::BatchRequest* EnqueueUpdateRequest::mutable_update() {
+  set_has_update();
+  if (update_ == NULL) {
+    update_ = new ::cockroach::proto::BatchRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueUpdateRequest.update)
+  return update_;
+}
+ ::cockroach::proto::BatchRequest* EnqueueUpdateRequest::release_update() {
+  clear_has_update();
+  ::cockroach::proto::BatchRequest* temp = update_;
+  update_ = NULL;
+  return temp;
+}
+ void EnqueueUpdateRequest::set_allocated_update(::cockroach::proto::BatchRequest* update) {
+  delete update_;
+  update_ = update;
+  if (update) {
+    set_has_update();
+  } else {
+    clear_has_update();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueUpdateRequest.update)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EnqueueUpdateResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+EnqueueUpdateResponse::EnqueueUpdateResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EnqueueUpdateResponse)
+}
+
+void EnqueueUpdateResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+EnqueueUpdateResponse::EnqueueUpdateResponse(const EnqueueUpdateResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EnqueueUpdateResponse)
+}
+
+void EnqueueUpdateResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EnqueueUpdateResponse::~EnqueueUpdateResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EnqueueUpdateResponse)
+  SharedDtor();
+}
+
+void EnqueueUpdateResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void EnqueueUpdateResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EnqueueUpdateResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EnqueueUpdateResponse_descriptor_;
+}
+
+const EnqueueUpdateResponse& EnqueueUpdateResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+EnqueueUpdateResponse* EnqueueUpdateResponse::default_instance_ = NULL;
+
+EnqueueUpdateResponse* EnqueueUpdateResponse::New(::google::protobuf::Arena* arena) const {
+  EnqueueUpdateResponse* n = new EnqueueUpdateResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void EnqueueUpdateResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool EnqueueUpdateResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EnqueueUpdateResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EnqueueUpdateResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EnqueueUpdateResponse)
+  return false;
+#undef DO_
+}
+
+void EnqueueUpdateResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EnqueueUpdateResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EnqueueUpdateResponse)
+}
+
+::google::protobuf::uint8* EnqueueUpdateResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EnqueueUpdateResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EnqueueUpdateResponse)
+  return target;
+}
+
+int EnqueueUpdateResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EnqueueUpdateResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const EnqueueUpdateResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const EnqueueUpdateResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EnqueueUpdateResponse::MergeFrom(const EnqueueUpdateResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void EnqueueUpdateResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EnqueueUpdateResponse::CopyFrom(const EnqueueUpdateResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EnqueueUpdateResponse::IsInitialized() const {
+
+  return true;
+}
+
+void EnqueueUpdateResponse::Swap(EnqueueUpdateResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void EnqueueUpdateResponse::InternalSwap(EnqueueUpdateResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata EnqueueUpdateResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EnqueueUpdateResponse_descriptor_;
+  metadata.reflection = EnqueueUpdateResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// EnqueueUpdateResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool EnqueueUpdateResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void EnqueueUpdateResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void EnqueueUpdateResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void EnqueueUpdateResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& EnqueueUpdateResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueUpdateResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* EnqueueUpdateResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueUpdateResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* EnqueueUpdateResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void EnqueueUpdateResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueUpdateResponse.header)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EnqueueMessageRequest::kHeaderFieldNumber;
+const int EnqueueMessageRequest::kMsgFieldNumber;
+#endif  // !_MSC_VER
+
+EnqueueMessageRequest::EnqueueMessageRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EnqueueMessageRequest)
+}
+
+void EnqueueMessageRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  msg_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
+}
+
+EnqueueMessageRequest::EnqueueMessageRequest(const EnqueueMessageRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EnqueueMessageRequest)
+}
+
+void EnqueueMessageRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  msg_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EnqueueMessageRequest::~EnqueueMessageRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EnqueueMessageRequest)
+  SharedDtor();
+}
+
+void EnqueueMessageRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete msg_;
+  }
+}
+
+void EnqueueMessageRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EnqueueMessageRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EnqueueMessageRequest_descriptor_;
+}
+
+const EnqueueMessageRequest& EnqueueMessageRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+EnqueueMessageRequest* EnqueueMessageRequest::default_instance_ = NULL;
+
+EnqueueMessageRequest* EnqueueMessageRequest::New(::google::protobuf::Arena* arena) const {
+  EnqueueMessageRequest* n = new EnqueueMessageRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void EnqueueMessageRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_msg()) {
+      if (msg_ != NULL) msg_->::cockroach::proto::Value::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool EnqueueMessageRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EnqueueMessageRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_msg;
+        break;
+      }
+
+      // optional .cockroach.proto.Value msg = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_msg:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_msg()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EnqueueMessageRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EnqueueMessageRequest)
+  return false;
+#undef DO_
+}
+
+void EnqueueMessageRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EnqueueMessageRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.Value msg = 2;
+  if (has_msg()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->msg_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EnqueueMessageRequest)
+}
+
+::google::protobuf::uint8* EnqueueMessageRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EnqueueMessageRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.Value msg = 2;
+  if (has_msg()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->msg_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EnqueueMessageRequest)
+  return target;
+}
+
+int EnqueueMessageRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Value msg = 2;
+    if (has_msg()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->msg_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EnqueueMessageRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const EnqueueMessageRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const EnqueueMessageRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EnqueueMessageRequest::MergeFrom(const EnqueueMessageRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_msg()) {
+      mutable_msg()->::cockroach::proto::Value::MergeFrom(from.msg());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void EnqueueMessageRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EnqueueMessageRequest::CopyFrom(const EnqueueMessageRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EnqueueMessageRequest::IsInitialized() const {
+
+  return true;
+}
+
+void EnqueueMessageRequest::Swap(EnqueueMessageRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void EnqueueMessageRequest::InternalSwap(EnqueueMessageRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(msg_, other->msg_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata EnqueueMessageRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EnqueueMessageRequest_descriptor_;
+  metadata.reflection = EnqueueMessageRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// EnqueueMessageRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool EnqueueMessageRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void EnqueueMessageRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void EnqueueMessageRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void EnqueueMessageRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& EnqueueMessageRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueMessageRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* EnqueueMessageRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueMessageRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* EnqueueMessageRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void EnqueueMessageRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueMessageRequest.header)
+}
+
+// optional .cockroach.proto.Value msg = 2;
+bool EnqueueMessageRequest::has_msg() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void EnqueueMessageRequest::set_has_msg() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void EnqueueMessageRequest::clear_has_msg() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void EnqueueMessageRequest::clear_msg() {
+  if (msg_ != NULL) msg_->::cockroach::proto::Value::Clear();
+  clear_has_msg();
+}
+ const ::cockroach::proto::Value& EnqueueMessageRequest::msg() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueMessageRequest.msg)
+  return msg_ != NULL ? *msg_ : *default_instance_->msg_;
+}
+ ::cockroach::proto::Value* EnqueueMessageRequest::mutable_msg() {
+  set_has_msg();
+  if (msg_ == NULL) {
+    msg_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueMessageRequest.msg)
+  return msg_;
+}
+ ::cockroach::proto::Value* EnqueueMessageRequest::release_msg() {
+  clear_has_msg();
+  ::cockroach::proto::Value* temp = msg_;
+  msg_ = NULL;
+  return temp;
+}
+ void EnqueueMessageRequest::set_allocated_msg(::cockroach::proto::Value* msg) {
+  delete msg_;
+  msg_ = msg;
+  if (msg) {
+    set_has_msg();
+  } else {
+    clear_has_msg();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueMessageRequest.msg)
 }
- ::std::string* EndTransactionResponse::mutable_resolved(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Mutable(index);
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EnqueueMessageResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+EnqueueMessageResponse::EnqueueMessageResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EnqueueMessageResponse)
 }
- void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
-  resolved_.Mutable(index)->assign(value);
+
+void EnqueueMessageResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
- void EndTransactionResponse::set_resolved(int index, const char* value) {
-  resolved_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
+
+EnqueueMessageResponse::EnqueueMessageResponse(const EnqueueMessageResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EnqueueMessageResponse)
 }
- void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
-  resolved_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
+
+void EnqueueMessageResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
- ::std::string* EndTransactionResponse::add_resolved() {
-  return resolved_.Add();
+
+EnqueueMessageResponse::~EnqueueMessageResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EnqueueMessageResponse)
+  SharedDtor();
 }
- void EndTransactionResponse::add_resolved(const ::std::string& value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
+
+void EnqueueMessageResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
 }
- void EndTransactionResponse::add_resolved(const char* value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
+
+void EnqueueMessageResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
- void EndTransactionResponse::add_resolved(const void* value, size_t size) {
-  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
+const ::google::protobuf::Descriptor* EnqueueMessageResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EnqueueMessageResponse_descriptor_;
 }
- const ::google::protobuf::RepeatedPtrField< ::std::string>&
-EndTransactionResponse::resolved() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_;
+
+const EnqueueMessageResponse& EnqueueMessageResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
 }
- ::google::protobuf::RepeatedPtrField< ::std::string>*
-EndTransactionResponse::mutable_resolved() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
-  return &resolved_;
+
+EnqueueMessageResponse* EnqueueMessageResponse::default_instance_ = NULL;
+
+EnqueueMessageResponse* EnqueueMessageResponse::New(::google::protobuf::Arena* arena) const {
+  EnqueueMessageResponse* n = new EnqueueMessageResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void EnqueueMessageResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool EnqueueMessageResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EnqueueMessageResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EnqueueMessageResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EnqueueMessageResponse)
+  return false;
+#undef DO_
+}
+
+void EnqueueMessageResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EnqueueMessageResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EnqueueMessageResponse)
+}
+
+::google::protobuf::uint8* EnqueueMessageResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EnqueueMessageResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EnqueueMessageResponse)
+  return target;
+}
+
+int EnqueueMessageResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EnqueueMessageResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const EnqueueMessageResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const EnqueueMessageResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EnqueueMessageResponse::MergeFrom(const EnqueueMessageResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void EnqueueMessageResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EnqueueMessageResponse::CopyFrom(const EnqueueMessageResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EnqueueMessageResponse::IsInitialized() const {
+
+  return true;
+}
+
+void EnqueueMessageResponse::Swap(EnqueueMessageResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void EnqueueMessageResponse::InternalSwap(EnqueueMessageResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata EnqueueMessageResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EnqueueMessageResponse_descriptor_;
+  metadata.reflection = EnqueueMessageResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// EnqueueMessageResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool EnqueueMessageResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void EnqueueMessageResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void EnqueueMessageResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void EnqueueMessageResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& EnqueueMessageResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueMessageResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* EnqueueMessageResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueMessageResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* EnqueueMessageResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void EnqueueMessageResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueMessageResponse.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -8394,6 +12894,11 @@ const int RequestUnion::kDeleteFieldNumber;
 const int RequestUnion::kDeleteRangeFieldNumber;
 const int RequestUnion::kScanFieldNumber;
 const int RequestUnion::kEndTransactionFieldNumber;
+const int RequestUnion::kReverseScanFieldNumber;
+const int RequestUnion::kReapQueueFieldNumber;
+const int RequestUnion::kEnqueueUpdateFieldNumber;
+const int RequestUnion::kEnqueueMessageFieldNumber;
+const int RequestUnion::kChangesFieldNumber;
 #endif  // !_MSC_VER
 
 RequestUnion::RequestUnion()
@@ -8411,6 +12916,11 @@ void RequestUnion::InitAsDefaultInstance() {
   RequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
   RequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
   RequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  RequestUnion_default_oneof_instance_->reverse_scan_ = const_cast< ::cockroach::proto::ReverseScanRequest*>(&::cockroach::proto::ReverseScanRequest::default_instance());
+  RequestUnion_default_oneof_instance_->reap_queue_ = const_cast< ::cockroach::proto::ReapQueueRequest*>(&::cockroach::proto::ReapQueueRequest::default_instance());
+  RequestUnion_default_oneof_instance_->enqueue_update_ = const_cast< ::cockroach::proto::EnqueueUpdateRequest*>(&::cockroach::proto::EnqueueUpdateRequest::default_instance());
+  RequestUnion_default_oneof_instance_->enqueue_message_ = const_cast< ::cockroach::proto::EnqueueMessageRequest*>(&::cockroach::proto::EnqueueMessageRequest::default_instance());
+  RequestUnion_default_oneof_instance_->changes_ = const_cast< ::cockroach::proto::ChangesRequest*>(&::cockroach::proto::ChangesRequest::default_instance());
 }
 
 RequestUnion::RequestUnion(const RequestUnion& from)
@@ -8499,6 +13009,26 @@ void RequestUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kReverseScan: {
+      delete value_.reverse_scan_;
+      break;
+    }
+    case kReapQueue: {
+      delete value_.reap_queue_;
+      break;
+    }
+    case kEnqueueUpdate: {
+      delete value_.enqueue_update_;
+      break;
+    }
+    case kEnqueueMessage: {
+      delete value_.enqueue_message_;
+      break;
+    }
+    case kChanges: {
+      delete value_.changes_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -8624,6 +13154,71 @@ bool RequestUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(82)) goto parse_reverse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_reverse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reverse_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_reap_queue;
+        break;
+      }
+
+      // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_reap_queue:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reap_queue()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_enqueue_update;
+        break;
+      }
+
+      // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_enqueue_update:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_update()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_enqueue_message;
+        break;
+      }
+
+      // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_enqueue_message:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_message()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_changes;
+        break;
+      }
+
+      // optional .cockroach.proto.ChangesRequest changes = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_changes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_changes()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -8701,6 +13296,36 @@ void RequestUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  if (has_reverse_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.reverse_scan_, output);
+  }
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  if (has_reap_queue()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.reap_queue_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  if (has_enqueue_update()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.enqueue_update_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  if (has_enqueue_message()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.enqueue_message_, output);
+  }
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  if (has_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.changes_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -8767,6 +13392,41 @@ void RequestUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  if (has_reverse_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.reverse_scan_, target);
+  }
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  if (has_reap_queue()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.reap_queue_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  if (has_enqueue_update()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.enqueue_update_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  if (has_enqueue_message()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.enqueue_message_, target);
+  }
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  if (has_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.changes_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -8835,6 +13495,41 @@ int RequestUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+    case kReverseScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reverse_scan_);
+      break;
+    }
+    // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+    case kReapQueue: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reap_queue_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+    case kEnqueueUpdate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_update_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+    case kEnqueueMessage: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_message_);
+      break;
+    }
+    // optional .cockroach.proto.ChangesRequest changes = 14;
+    case kChanges: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.changes_);
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -8897,6 +13592,26 @@ void RequestUnion::MergeFrom(const RequestUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
       break;
     }
+    case kReverseScan: {
+      mutable_reverse_scan()->::cockroach::proto::ReverseScanRequest::MergeFrom(from.reverse_scan());
+      break;
+    }
+    case kReapQueue: {
+      mutable_reap_queue()->::cockroach::proto::ReapQueueRequest::MergeFrom(from.reap_queue());
+      break;
+    }
+    case kEnqueueUpdate: {
+      mutable_enqueue_update()->::cockroach::proto::EnqueueUpdateRequest::MergeFrom(from.enqueue_update());
+      break;
+    }
+    case kEnqueueMessage: {
+      mutable_enqueue_message()->::cockroach::proto::EnqueueMessageRequest::MergeFrom(from.enqueue_message());
+      break;
+    }
+    case kChanges: {
+      mutable_changes()->::cockroach::proto::ChangesRequest::MergeFrom(from.changes());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -9229,89 +13944,319 @@ bool RequestUnion::has_scan() const {
 void RequestUnion::set_has_scan() {
   _oneof_case_[0] = kScan;
 }
-void RequestUnion::clear_scan() {
-  if (has_scan()) {
-    delete value_.scan_;
+void RequestUnion::clear_scan() {
+  if (has_scan()) {
+    delete value_.scan_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanRequest& RequestUnion::scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.scan)
+  return has_scan() ? *value_.scan_
+                      : ::cockroach::proto::ScanRequest::default_instance();
+}
+ ::cockroach::proto::ScanRequest* RequestUnion::mutable_scan() {
+  if (!has_scan()) {
+    clear_value();
+    set_has_scan();
+    value_.scan_ = new ::cockroach::proto::ScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.scan)
+  return value_.scan_;
+}
+ ::cockroach::proto::ScanRequest* RequestUnion::release_scan() {
+  if (has_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRequest* temp = value_.scan_;
+    value_.scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_scan(::cockroach::proto::ScanRequest* scan) {
+  clear_value();
+  if (scan) {
+    set_has_scan();
+    value_.scan_ = scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.scan)
+}
+
+// optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+bool RequestUnion::has_end_transaction() const {
+  return value_case() == kEndTransaction;
+}
+void RequestUnion::set_has_end_transaction() {
+  _oneof_case_[0] = kEndTransaction;
+}
+void RequestUnion::clear_end_transaction() {
+  if (has_end_transaction()) {
+    delete value_.end_transaction_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EndTransactionRequest& RequestUnion::end_transaction() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.end_transaction)
+  return has_end_transaction() ? *value_.end_transaction_
+                      : ::cockroach::proto::EndTransactionRequest::default_instance();
+}
+ ::cockroach::proto::EndTransactionRequest* RequestUnion::mutable_end_transaction() {
+  if (!has_end_transaction()) {
+    clear_value();
+    set_has_end_transaction();
+    value_.end_transaction_ = new ::cockroach::proto::EndTransactionRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.end_transaction)
+  return value_.end_transaction_;
+}
+ ::cockroach::proto::EndTransactionRequest* RequestUnion::release_end_transaction() {
+  if (has_end_transaction()) {
+    clear_has_value();
+    ::cockroach::proto::EndTransactionRequest* temp = value_.end_transaction_;
+    value_.end_transaction_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction) {
+  clear_value();
+  if (end_transaction) {
+    set_has_end_transaction();
+    value_.end_transaction_ = end_transaction;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.end_transaction)
+}
+
+// optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+bool RequestUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+void RequestUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+void RequestUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReverseScanRequest& RequestUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanRequest::default_instance();
+}
+ ::cockroach::proto::ReverseScanRequest* RequestUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+ ::cockroach::proto::ReverseScanRequest* RequestUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanRequest* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+bool RequestUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+void RequestUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+void RequestUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReapQueueRequest& RequestUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueRequest::default_instance();
+}
+ ::cockroach::proto::ReapQueueRequest* RequestUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.reap_queue)
+  return value_.reap_queue_;
+}
+ ::cockroach::proto::ReapQueueRequest* RequestUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueRequest* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+bool RequestUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+void RequestUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+void RequestUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueUpdateRequest& RequestUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateRequest::default_instance();
+}
+ ::cockroach::proto::EnqueueUpdateRequest* RequestUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+ ::cockroach::proto::EnqueueUpdateRequest* RequestUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateRequest* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+bool RequestUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+void RequestUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+void RequestUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
     clear_has_value();
   }
 }
- const ::cockroach::proto::ScanRequest& RequestUnion::scan() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.scan)
-  return has_scan() ? *value_.scan_
-                      : ::cockroach::proto::ScanRequest::default_instance();
+ const ::cockroach::proto::EnqueueMessageRequest& RequestUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageRequest::default_instance();
 }
- ::cockroach::proto::ScanRequest* RequestUnion::mutable_scan() {
-  if (!has_scan()) {
+ ::cockroach::proto::EnqueueMessageRequest* RequestUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
     clear_value();
-    set_has_scan();
-    value_.scan_ = new ::cockroach::proto::ScanRequest;
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageRequest;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.scan)
-  return value_.scan_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.enqueue_message)
+  return value_.enqueue_message_;
 }
- ::cockroach::proto::ScanRequest* RequestUnion::release_scan() {
-  if (has_scan()) {
+ ::cockroach::proto::EnqueueMessageRequest* RequestUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
     clear_has_value();
-    ::cockroach::proto::ScanRequest* temp = value_.scan_;
-    value_.scan_ = NULL;
+    ::cockroach::proto::EnqueueMessageRequest* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
- void RequestUnion::set_allocated_scan(::cockroach::proto::ScanRequest* scan) {
+ void RequestUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message) {
   clear_value();
-  if (scan) {
-    set_has_scan();
-    value_.scan_ = scan;
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.scan)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.enqueue_message)
 }
 
-// optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-bool RequestUnion::has_end_transaction() const {
-  return value_case() == kEndTransaction;
+// optional .cockroach.proto.ChangesRequest changes = 14;
+bool RequestUnion::has_changes() const {
+  return value_case() == kChanges;
 }
-void RequestUnion::set_has_end_transaction() {
-  _oneof_case_[0] = kEndTransaction;
+void RequestUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
 }
-void RequestUnion::clear_end_transaction() {
-  if (has_end_transaction()) {
-    delete value_.end_transaction_;
+void RequestUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
     clear_has_value();
   }
 }
- const ::cockroach::proto::EndTransactionRequest& RequestUnion::end_transaction() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.end_transaction)
-  return has_end_transaction() ? *value_.end_transaction_
-                      : ::cockroach::proto::EndTransactionRequest::default_instance();
+ const ::cockroach::proto::ChangesRequest& RequestUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesRequest::default_instance();
 }
- ::cockroach::proto::EndTransactionRequest* RequestUnion::mutable_end_transaction() {
-  if (!has_end_transaction()) {
+ ::cockroach::proto::ChangesRequest* RequestUnion::mutable_changes() {
+  if (!has_changes()) {
     clear_value();
-    set_has_end_transaction();
-    value_.end_transaction_ = new ::cockroach::proto::EndTransactionRequest;
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesRequest;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.end_transaction)
-  return value_.end_transaction_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.changes)
+  return value_.changes_;
 }
- ::cockroach::proto::EndTransactionRequest* RequestUnion::release_end_transaction() {
-  if (has_end_transaction()) {
+ ::cockroach::proto::ChangesRequest* RequestUnion::release_changes() {
+  if (has_changes()) {
     clear_has_value();
-    ::cockroach::proto::EndTransactionRequest* temp = value_.end_transaction_;
-    value_.end_transaction_ = NULL;
+    ::cockroach::proto::ChangesRequest* temp = value_.changes_;
+    value_.changes_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
- void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction) {
+ void RequestUnion::set_allocated_changes(::cockroach::proto::ChangesRequest* changes) {
   clear_value();
-  if (end_transaction) {
-    set_has_end_transaction();
-    value_.end_transaction_ = end_transaction;
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.end_transaction)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.changes)
 }
 
 bool RequestUnion::has_value() const {
@@ -9336,6 +14281,11 @@ const int ResponseUnion::kDeleteFieldNumber;
 const int ResponseUnion::kDeleteRangeFieldNumber;
 const int ResponseUnion::kScanFieldNumber;
 const int ResponseUnion::kEndTransactionFieldNumber;
+const int ResponseUnion::kReverseScanFieldNumber;
+const int ResponseUnion::kReapQueueFieldNumber;
+const int ResponseUnion::kEnqueueUpdateFieldNumber;
+const int ResponseUnion::kEnqueueMessageFieldNumber;
+const int ResponseUnion::kChangesFieldNumber;
 #endif  // !_MSC_VER
 
 ResponseUnion::ResponseUnion()
@@ -9353,6 +14303,11 @@ void ResponseUnion::InitAsDefaultInstance() {
   ResponseUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
   ResponseUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanResponse*>(&::cockroach::proto::ScanResponse::default_instance());
   ResponseUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->reverse_scan_ = const_cast< ::cockroach::proto::ReverseScanResponse*>(&::cockroach::proto::ReverseScanResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->reap_queue_ = const_cast< ::cockroach::proto::ReapQueueResponse*>(&::cockroach::proto::ReapQueueResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->enqueue_update_ = const_cast< ::cockroach::proto::EnqueueUpdateResponse*>(&::cockroach::proto::EnqueueUpdateResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->enqueue_message_ = const_cast< ::cockroach::proto::EnqueueMessageResponse*>(&::cockroach::proto::EnqueueMessageResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->changes_ = const_cast< ::cockroach::proto::ChangesResponse*>(&::cockroach::proto::ChangesResponse::default_instance());
 }
 
 ResponseUnion::ResponseUnion(const ResponseUnion& from)
@@ -9441,6 +14396,26 @@ void ResponseUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kReverseScan: {
+      delete value_.reverse_scan_;
+      break;
+    }
+    case kReapQueue: {
+      delete value_.reap_queue_;
+      break;
+    }
+    case kEnqueueUpdate: {
+      delete value_.enqueue_update_;
+      break;
+    }
+    case kEnqueueMessage: {
+      delete value_.enqueue_message_;
+      break;
+    }
+    case kChanges: {
+      delete value_.changes_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -9566,6 +14541,71 @@ bool ResponseUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(82)) goto parse_reverse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_reverse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reverse_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_reap_queue;
+        break;
+      }
+
+      // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_reap_queue:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reap_queue()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_enqueue_update;
+        break;
+      }
+
+      // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_enqueue_update:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_update()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_enqueue_message;
+        break;
+      }
+
+      // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_enqueue_message:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_message()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_changes;
+        break;
+      }
+
+      // optional .cockroach.proto.ChangesResponse changes = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_changes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_changes()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -9643,6 +14683,36 @@ void ResponseUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+  if (has_reverse_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.reverse_scan_, output);
+  }
+
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+  if (has_reap_queue()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.reap_queue_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+  if (has_enqueue_update()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.enqueue_update_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+  if (has_enqueue_message()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.enqueue_message_, output);
+  }
+
+  // optional .cockroach.proto.ChangesResponse changes = 14;
+  if (has_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.changes_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -9709,6 +14779,41 @@ void ResponseUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+  if (has_reverse_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.reverse_scan_, target);
+  }
+
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+  if (has_reap_queue()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.reap_queue_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+  if (has_enqueue_update()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.enqueue_update_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+  if (has_enqueue_message()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.enqueue_message_, target);
+  }
+
+  // optional .cockroach.proto.ChangesResponse changes = 14;
+  if (has_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.changes_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -9777,6 +14882,41 @@ int ResponseUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+    case kReverseScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reverse_scan_);
+      break;
+    }
+    // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+    case kReapQueue: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reap_queue_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+    case kEnqueueUpdate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_update_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+    case kEnqueueMessage: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_message_);
+      break;
+    }
+    // optional .cockroach.proto.ChangesResponse changes = 14;
+    case kChanges: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.changes_);
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -9839,6 +14979,26 @@ void ResponseUnion::MergeFrom(const ResponseUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
       break;
     }
+    case kReverseScan: {
+      mutable_reverse_scan()->::cockroach::proto::ReverseScanResponse::MergeFrom(from.reverse_scan());
+      break;
+    }
+    case kReapQueue: {
+      mutable_reap_queue()->::cockroach::proto::ReapQueueResponse::MergeFrom(from.reap_queue());
+      break;
+    }
+    case kEnqueueUpdate: {
+      mutable_enqueue_update()->::cockroach::proto::EnqueueUpdateResponse::MergeFrom(from.enqueue_update());
+      break;
+    }
+    case kEnqueueMessage: {
+      mutable_enqueue_message()->::cockroach::proto::EnqueueMessageResponse::MergeFrom(from.enqueue_message());
+      break;
+    }
+    case kChanges: {
+      mutable_changes()->::cockroach::proto::ChangesResponse::MergeFrom(from.changes());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -10256,6 +15416,236 @@ void ResponseUnion::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.end_transaction)
 }
 
+// optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+bool ResponseUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+void ResponseUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+void ResponseUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReverseScanResponse& ResponseUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanResponse::default_instance();
+}
+ ::cockroach::proto::ReverseScanResponse* ResponseUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+ ::cockroach::proto::ReverseScanResponse* ResponseUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanResponse* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanResponse* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+bool ResponseUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+void ResponseUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+void ResponseUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReapQueueResponse& ResponseUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueResponse::default_instance();
+}
+ ::cockroach::proto::ReapQueueResponse* ResponseUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.reap_queue)
+  return value_.reap_queue_;
+}
+ ::cockroach::proto::ReapQueueResponse* ResponseUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueResponse* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+bool ResponseUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+void ResponseUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+void ResponseUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueUpdateResponse& ResponseUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateResponse::default_instance();
+}
+ ::cockroach::proto::EnqueueUpdateResponse* ResponseUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+ ::cockroach::proto::EnqueueUpdateResponse* ResponseUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateResponse* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+bool ResponseUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+void ResponseUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+void ResponseUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueMessageResponse& ResponseUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageResponse::default_instance();
+}
+ ::cockroach::proto::EnqueueMessageResponse* ResponseUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.enqueue_message)
+  return value_.enqueue_message_;
+}
+ ::cockroach::proto::EnqueueMessageResponse* ResponseUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageResponse* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.enqueue_message)
+}
+
+// optional .cockroach.proto.ChangesResponse changes = 14;
+bool ResponseUnion::has_changes() const {
+  return value_case() == kChanges;
+}
+void ResponseUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
+}
+void ResponseUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ChangesResponse& ResponseUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesResponse::default_instance();
+}
+ ::cockroach::proto::ChangesResponse* ResponseUnion::mutable_changes() {
+  if (!has_changes()) {
+    clear_value();
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.changes)
+  return value_.changes_;
+}
+ ::cockroach::proto::ChangesResponse* ResponseUnion::release_changes() {
+  if (has_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ChangesResponse* temp = value_.changes_;
+    value_.changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_changes(::cockroach::proto::ChangesResponse* changes) {
+  clear_value();
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.changes)
+}
+
 bool ResponseUnion::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -59,8 +59,19 @@ class DeleteRangeRequest;
 class DeleteRangeResponse;
 class ScanRequest;
 class ScanResponse;
+class ReverseScanRequest;
+class ReverseScanResponse;
+class ChangesRequest;
+class ChangeEvent;
+class ChangesResponse;
 class EndTransactionRequest;
 class EndTransactionResponse;
+class ReapQueueRequest;
+class ReapQueueResponse;
+class EnqueueUpdateRequest;
+class EnqueueUpdateResponse;
+class EnqueueMessageRequest;
+class EnqueueMessageResponse;
 class RequestUnion;
 class ResponseUnion;
 class BatchRequest;
@@ -348,6 +359,13 @@ class RequestHeader : public ::google::protobuf::Message {
   ::cockroach::proto::ReadConsistencyType read_consistency() const;
   void set_read_consistency(::cockroach::proto::ReadConsistencyType value);
 
+  // optional int64 max_staleness = 11;
+  bool has_max_staleness() const;
+  void clear_max_staleness();
+  static const int kMaxStalenessFieldNumber = 11;
+  ::google::protobuf::int64 max_staleness() const;
+  void set_max_staleness(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.RequestHeader)
  private:
   inline void set_has_timestamp();
@@ -370,6 +388,8 @@ class RequestHeader : public ::google::protobuf::Message {
   inline void clear_has_txn();
   inline void set_has_read_consistency();
   inline void clear_has_read_consistency();
+  inline void set_has_max_staleness();
+  inline void clear_has_max_staleness();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -384,6 +404,7 @@ class RequestHeader : public ::google::protobuf::Message {
   ::cockroach::proto::Transaction* txn_;
   ::google::protobuf::int32 user_priority_;
   int read_consistency_;
+  ::google::protobuf::int64 max_staleness_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
@@ -1893,14 +1914,14 @@ class ScanResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class EndTransactionRequest : public ::google::protobuf::Message {
+class ReverseScanRequest : public ::google::protobuf::Message {
  public:
-  EndTransactionRequest();
-  virtual ~EndTransactionRequest();
+  ReverseScanRequest();
+  virtual ~ReverseScanRequest();
 
-  EndTransactionRequest(const EndTransactionRequest& from);
+  ReverseScanRequest(const ReverseScanRequest& from);
 
-  inline EndTransactionRequest& operator=(const EndTransactionRequest& from) {
+  inline ReverseScanRequest& operator=(const ReverseScanRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1914,19 +1935,19 @@ class EndTransactionRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const EndTransactionRequest& default_instance();
+  static const ReverseScanRequest& default_instance();
 
-  void Swap(EndTransactionRequest* other);
+  void Swap(ReverseScanRequest* other);
 
   // implements Message ----------------------------------------------
 
-  inline EndTransactionRequest* New() const { return New(NULL); }
+  inline ReverseScanRequest* New() const { return New(NULL); }
 
-  EndTransactionRequest* New(::google::protobuf::Arena* arena) const;
+  ReverseScanRequest* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const EndTransactionRequest& from);
-  void MergeFrom(const EndTransactionRequest& from);
+  void CopyFrom(const ReverseScanRequest& from);
+  void MergeFrom(const ReverseScanRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1941,7 +1962,7 @@ class EndTransactionRequest : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(EndTransactionRequest* other);
+  void InternalSwap(ReverseScanRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -1966,54 +1987,42 @@ class EndTransactionRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* release_header();
   void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // optional bool commit = 2;
-  bool has_commit() const;
-  void clear_commit();
-  static const int kCommitFieldNumber = 2;
-  bool commit() const;
-  void set_commit(bool value);
-
-  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-  bool has_internal_commit_trigger() const;
-  void clear_internal_commit_trigger();
-  static const int kInternalCommitTriggerFieldNumber = 3;
-  const ::cockroach::proto::InternalCommitTrigger& internal_commit_trigger() const;
-  ::cockroach::proto::InternalCommitTrigger* mutable_internal_commit_trigger();
-  ::cockroach::proto::InternalCommitTrigger* release_internal_commit_trigger();
-  void set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger);
+  // optional int64 max_results = 2;
+  bool has_max_results() const;
+  void clear_max_results();
+  static const int kMaxResultsFieldNumber = 2;
+  ::google::protobuf::int64 max_results() const;
+  void set_max_results(::google::protobuf::int64 value);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ReverseScanRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
-  inline void set_has_commit();
-  inline void clear_has_commit();
-  inline void set_has_internal_commit_trigger();
-  inline void clear_has_internal_commit_trigger();
+  inline void set_has_max_results();
+  inline void clear_has_max_results();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
-  ::cockroach::proto::InternalCommitTrigger* internal_commit_trigger_;
-  bool commit_;
+  ::google::protobuf::int64 max_results_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static EndTransactionRequest* default_instance_;
+  static ReverseScanRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class EndTransactionResponse : public ::google::protobuf::Message {
+class ReverseScanResponse : public ::google::protobuf::Message {
  public:
-  EndTransactionResponse();
-  virtual ~EndTransactionResponse();
+  ReverseScanResponse();
+  virtual ~ReverseScanResponse();
 
-  EndTransactionResponse(const EndTransactionResponse& from);
+  ReverseScanResponse(const ReverseScanResponse& from);
 
-  inline EndTransactionResponse& operator=(const EndTransactionResponse& from) {
+  inline ReverseScanResponse& operator=(const ReverseScanResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2027,19 +2036,19 @@ class EndTransactionResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const EndTransactionResponse& default_instance();
+  static const ReverseScanResponse& default_instance();
 
-  void Swap(EndTransactionResponse* other);
+  void Swap(ReverseScanResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline EndTransactionResponse* New() const { return New(NULL); }
+  inline ReverseScanResponse* New() const { return New(NULL); }
 
-  EndTransactionResponse* New(::google::protobuf::Arena* arena) const;
+  ReverseScanResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const EndTransactionResponse& from);
-  void MergeFrom(const EndTransactionResponse& from);
+  void CopyFrom(const ReverseScanResponse& from);
+  void MergeFrom(const ReverseScanResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2054,7 +2063,7 @@ class EndTransactionResponse : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(EndTransactionResponse* other);
+  void InternalSwap(ReverseScanResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2079,59 +2088,45 @@ class EndTransactionResponse : public ::google::protobuf::Message {
   ::cockroach::proto::ResponseHeader* release_header();
   void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // optional int64 commit_wait = 2;
-  bool has_commit_wait() const;
-  void clear_commit_wait();
-  static const int kCommitWaitFieldNumber = 2;
-  ::google::protobuf::int64 commit_wait() const;
-  void set_commit_wait(::google::protobuf::int64 value);
-
-  // repeated bytes resolved = 3;
-  int resolved_size() const;
-  void clear_resolved();
-  static const int kResolvedFieldNumber = 3;
-  const ::std::string& resolved(int index) const;
-  ::std::string* mutable_resolved(int index);
-  void set_resolved(int index, const ::std::string& value);
-  void set_resolved(int index, const char* value);
-  void set_resolved(int index, const void* value, size_t size);
-  ::std::string* add_resolved();
-  void add_resolved(const ::std::string& value);
-  void add_resolved(const char* value);
-  void add_resolved(const void* value, size_t size);
-  const ::google::protobuf::RepeatedPtrField< ::std::string>& resolved() const;
-  ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_resolved();
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  int rows_size() const;
+  void clear_rows();
+  static const int kRowsFieldNumber = 2;
+  const ::cockroach::proto::KeyValue& rows(int index) const;
+  ::cockroach::proto::KeyValue* mutable_rows(int index);
+  ::cockroach::proto::KeyValue* add_rows();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+      rows() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+      mutable_rows();
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ReverseScanResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
-  inline void set_has_commit_wait();
-  inline void clear_has_commit_wait();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::ResponseHeader* header_;
-  ::google::protobuf::int64 commit_wait_;
-  ::google::protobuf::RepeatedPtrField< ::std::string> resolved_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue > rows_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static EndTransactionResponse* default_instance_;
+  static ReverseScanResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class RequestUnion : public ::google::protobuf::Message {
+class ChangesRequest : public ::google::protobuf::Message {
  public:
-  RequestUnion();
-  virtual ~RequestUnion();
+  ChangesRequest();
+  virtual ~ChangesRequest();
 
-  RequestUnion(const RequestUnion& from);
+  ChangesRequest(const ChangesRequest& from);
 
-  inline RequestUnion& operator=(const RequestUnion& from) {
+  inline ChangesRequest& operator=(const ChangesRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2145,31 +2140,19 @@ class RequestUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RequestUnion& default_instance();
-
-  enum ValueCase {
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    VALUE_NOT_SET = 0,
-  };
+  static const ChangesRequest& default_instance();
 
-  void Swap(RequestUnion* other);
+  void Swap(ChangesRequest* other);
 
   // implements Message ----------------------------------------------
 
-  inline RequestUnion* New() const { return New(NULL); }
+  inline ChangesRequest* New() const { return New(NULL); }
 
-  RequestUnion* New(::google::protobuf::Arena* arena) const;
+  ChangesRequest* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RequestUnion& from);
-  void MergeFrom(const RequestUnion& from);
+  void CopyFrom(const ChangesRequest& from);
+  void MergeFrom(const ChangesRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2184,7 +2167,7 @@ class RequestUnion : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(RequestUnion* other);
+  void InternalSwap(ChangesRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2200,127 +2183,63 @@ class RequestUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.GetRequest get = 2;
-  bool has_get() const;
-  void clear_get();
-  static const int kGetFieldNumber = 2;
-  const ::cockroach::proto::GetRequest& get() const;
-  ::cockroach::proto::GetRequest* mutable_get();
-  ::cockroach::proto::GetRequest* release_get();
-  void set_allocated_get(::cockroach::proto::GetRequest* get);
-
-  // optional .cockroach.proto.PutRequest put = 3;
-  bool has_put() const;
-  void clear_put();
-  static const int kPutFieldNumber = 3;
-  const ::cockroach::proto::PutRequest& put() const;
-  ::cockroach::proto::PutRequest* mutable_put();
-  ::cockroach::proto::PutRequest* release_put();
-  void set_allocated_put(::cockroach::proto::PutRequest* put);
-
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  bool has_conditional_put() const;
-  void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
-  ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
-  ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
-  void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
-
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  bool has_increment() const;
-  void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  const ::cockroach::proto::IncrementRequest& increment() const;
-  ::cockroach::proto::IncrementRequest* mutable_increment();
-  ::cockroach::proto::IncrementRequest* release_increment();
-  void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
-
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  bool has_delete_() const;
-  void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  const ::cockroach::proto::DeleteRequest& delete_() const;
-  ::cockroach::proto::DeleteRequest* mutable_delete_();
-  ::cockroach::proto::DeleteRequest* release_delete_();
-  void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
-
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  bool has_delete_range() const;
-  void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
-  ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
-  ::cockroach::proto::DeleteRangeRequest* release_delete_range();
-  void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
-
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  bool has_scan() const;
-  void clear_scan();
-  static const int kScanFieldNumber = 8;
-  const ::cockroach::proto::ScanRequest& scan() const;
-  ::cockroach::proto::ScanRequest* mutable_scan();
-  ::cockroach::proto::ScanRequest* release_scan();
-  void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
-
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  bool has_end_transaction() const;
-  void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
-  ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
-  ::cockroach::proto::EndTransactionRequest* release_end_transaction();
-  void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.RequestUnion)
+  // optional .cockroach.proto.Timestamp since = 2;
+  bool has_since() const;
+  void clear_since();
+  static const int kSinceFieldNumber = 2;
+  const ::cockroach::proto::Timestamp& since() const;
+  ::cockroach::proto::Timestamp* mutable_since();
+  ::cockroach::proto::Timestamp* release_since();
+  void set_allocated_since(::cockroach::proto::Timestamp* since);
+
+  // optional int64 max_wait = 3;
+  bool has_max_wait() const;
+  void clear_max_wait();
+  static const int kMaxWaitFieldNumber = 3;
+  ::google::protobuf::int64 max_wait() const;
+  void set_max_wait(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ChangesRequest)
  private:
-  inline void set_has_get();
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_scan();
-  inline void set_has_end_transaction();
-
-  inline bool has_value() const;
-  void clear_value();
-  inline void clear_has_value();
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_since();
+  inline void clear_has_since();
+  inline void set_has_max_wait();
+  inline void clear_has_max_wait();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  union ValueUnion {
-    ValueUnion() {}
-    ::cockroach::proto::GetRequest* get_;
-    ::cockroach::proto::PutRequest* put_;
-    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
-    ::cockroach::proto::IncrementRequest* increment_;
-    ::cockroach::proto::DeleteRequest* delete__;
-    ::cockroach::proto::DeleteRangeRequest* delete_range_;
-    ::cockroach::proto::ScanRequest* scan_;
-    ::cockroach::proto::EndTransactionRequest* end_transaction_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Timestamp* since_;
+  ::google::protobuf::int64 max_wait_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static RequestUnion* default_instance_;
+  static ChangesRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class ResponseUnion : public ::google::protobuf::Message {
+class ChangeEvent : public ::google::protobuf::Message {
  public:
-  ResponseUnion();
-  virtual ~ResponseUnion();
+  ChangeEvent();
+  virtual ~ChangeEvent();
 
-  ResponseUnion(const ResponseUnion& from);
+  ChangeEvent(const ChangeEvent& from);
 
-  inline ResponseUnion& operator=(const ResponseUnion& from) {
+  inline ChangeEvent& operator=(const ChangeEvent& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2334,31 +2253,19 @@ class ResponseUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ResponseUnion& default_instance();
-
-  enum ValueCase {
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    VALUE_NOT_SET = 0,
-  };
+  static const ChangeEvent& default_instance();
 
-  void Swap(ResponseUnion* other);
+  void Swap(ChangeEvent* other);
 
   // implements Message ----------------------------------------------
 
-  inline ResponseUnion* New() const { return New(NULL); }
+  inline ChangeEvent* New() const { return New(NULL); }
 
-  ResponseUnion* New(::google::protobuf::Arena* arena) const;
+  ChangeEvent* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ResponseUnion& from);
-  void MergeFrom(const ResponseUnion& from);
+  void CopyFrom(const ChangeEvent& from);
+  void MergeFrom(const ChangeEvent& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2373,7 +2280,7 @@ class ResponseUnion : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(ResponseUnion* other);
+  void InternalSwap(ChangeEvent* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2389,127 +2296,68 @@ class ResponseUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.GetResponse get = 2;
-  bool has_get() const;
-  void clear_get();
-  static const int kGetFieldNumber = 2;
-  const ::cockroach::proto::GetResponse& get() const;
-  ::cockroach::proto::GetResponse* mutable_get();
-  ::cockroach::proto::GetResponse* release_get();
-  void set_allocated_get(::cockroach::proto::GetResponse* get);
-
-  // optional .cockroach.proto.PutResponse put = 3;
-  bool has_put() const;
-  void clear_put();
-  static const int kPutFieldNumber = 3;
-  const ::cockroach::proto::PutResponse& put() const;
-  ::cockroach::proto::PutResponse* mutable_put();
-  ::cockroach::proto::PutResponse* release_put();
-  void set_allocated_put(::cockroach::proto::PutResponse* put);
-
-  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-  bool has_conditional_put() const;
-  void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  const ::cockroach::proto::ConditionalPutResponse& conditional_put() const;
-  ::cockroach::proto::ConditionalPutResponse* mutable_conditional_put();
-  ::cockroach::proto::ConditionalPutResponse* release_conditional_put();
-  void set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put);
-
-  // optional .cockroach.proto.IncrementResponse increment = 5;
-  bool has_increment() const;
-  void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  const ::cockroach::proto::IncrementResponse& increment() const;
-  ::cockroach::proto::IncrementResponse* mutable_increment();
-  ::cockroach::proto::IncrementResponse* release_increment();
-  void set_allocated_increment(::cockroach::proto::IncrementResponse* increment);
-
-  // optional .cockroach.proto.DeleteResponse delete = 6;
-  bool has_delete_() const;
-  void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  const ::cockroach::proto::DeleteResponse& delete_() const;
-  ::cockroach::proto::DeleteResponse* mutable_delete_();
-  ::cockroach::proto::DeleteResponse* release_delete_();
-  void set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_);
-
-  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-  bool has_delete_range() const;
-  void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  const ::cockroach::proto::DeleteRangeResponse& delete_range() const;
-  ::cockroach::proto::DeleteRangeResponse* mutable_delete_range();
-  ::cockroach::proto::DeleteRangeResponse* release_delete_range();
-  void set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range);
+  // optional bytes key = 1;
+  bool has_key() const;
+  void clear_key();
+  static const int kKeyFieldNumber = 1;
+  const ::std::string& key() const;
+  void set_key(const ::std::string& value);
+  void set_key(const char* value);
+  void set_key(const void* value, size_t size);
+  ::std::string* mutable_key();
+  ::std::string* release_key();
+  void set_allocated_key(::std::string* key);
 
-  // optional .cockroach.proto.ScanResponse scan = 8;
-  bool has_scan() const;
-  void clear_scan();
-  static const int kScanFieldNumber = 8;
-  const ::cockroach::proto::ScanResponse& scan() const;
-  ::cockroach::proto::ScanResponse* mutable_scan();
-  ::cockroach::proto::ScanResponse* release_scan();
-  void set_allocated_scan(::cockroach::proto::ScanResponse* scan);
+  // optional .cockroach.proto.Value value = 2;
+  bool has_value() const;
+  void clear_value();
+  static const int kValueFieldNumber = 2;
+  const ::cockroach::proto::Value& value() const;
+  ::cockroach::proto::Value* mutable_value();
+  ::cockroach::proto::Value* release_value();
+  void set_allocated_value(::cockroach::proto::Value* value);
 
-  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-  bool has_end_transaction() const;
-  void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  const ::cockroach::proto::EndTransactionResponse& end_transaction() const;
-  ::cockroach::proto::EndTransactionResponse* mutable_end_transaction();
-  ::cockroach::proto::EndTransactionResponse* release_end_transaction();
-  void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
+  // optional .cockroach.proto.Timestamp timestamp = 3;
+  bool has_timestamp() const;
+  void clear_timestamp();
+  static const int kTimestampFieldNumber = 3;
+  const ::cockroach::proto::Timestamp& timestamp() const;
+  ::cockroach::proto::Timestamp* mutable_timestamp();
+  ::cockroach::proto::Timestamp* release_timestamp();
+  void set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp);
 
-  ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.ResponseUnion)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ChangeEvent)
  private:
-  inline void set_has_get();
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_scan();
-  inline void set_has_end_transaction();
-
-  inline bool has_value() const;
-  void clear_value();
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_value();
   inline void clear_has_value();
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  union ValueUnion {
-    ValueUnion() {}
-    ::cockroach::proto::GetResponse* get_;
-    ::cockroach::proto::PutResponse* put_;
-    ::cockroach::proto::ConditionalPutResponse* conditional_put_;
-    ::cockroach::proto::IncrementResponse* increment_;
-    ::cockroach::proto::DeleteResponse* delete__;
-    ::cockroach::proto::DeleteRangeResponse* delete_range_;
-    ::cockroach::proto::ScanResponse* scan_;
-    ::cockroach::proto::EndTransactionResponse* end_transaction_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
+  ::google::protobuf::internal::ArenaStringPtr key_;
+  ::cockroach::proto::Value* value_;
+  ::cockroach::proto::Timestamp* timestamp_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static ResponseUnion* default_instance_;
+  static ChangeEvent* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class BatchRequest : public ::google::protobuf::Message {
+class ChangesResponse : public ::google::protobuf::Message {
  public:
-  BatchRequest();
-  virtual ~BatchRequest();
+  ChangesResponse();
+  virtual ~ChangesResponse();
 
-  BatchRequest(const BatchRequest& from);
+  ChangesResponse(const ChangesResponse& from);
 
-  inline BatchRequest& operator=(const BatchRequest& from) {
+  inline ChangesResponse& operator=(const ChangesResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2523,19 +2371,19 @@ class BatchRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchRequest& default_instance();
+  static const ChangesResponse& default_instance();
 
-  void Swap(BatchRequest* other);
+  void Swap(ChangesResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline BatchRequest* New() const { return New(NULL); }
+  inline ChangesResponse* New() const { return New(NULL); }
 
-  BatchRequest* New(::google::protobuf::Arena* arena) const;
+  ChangesResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchRequest& from);
-  void MergeFrom(const BatchRequest& from);
+  void CopyFrom(const ChangesResponse& from);
+  void MergeFrom(const ChangesResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2550,7 +2398,7 @@ class BatchRequest : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(BatchRequest* other);
+  void InternalSwap(ChangesResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2566,54 +2414,66 @@ class BatchRequest : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.RequestHeader header = 1;
+  // optional .cockroach.proto.ResponseHeader header = 1;
   bool has_header() const;
   void clear_header();
   static const int kHeaderFieldNumber = 1;
-  const ::cockroach::proto::RequestHeader& header() const;
-  ::cockroach::proto::RequestHeader* mutable_header();
-  ::cockroach::proto::RequestHeader* release_header();
-  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // repeated .cockroach.proto.RequestUnion requests = 2;
-  int requests_size() const;
-  void clear_requests();
-  static const int kRequestsFieldNumber = 2;
-  const ::cockroach::proto::RequestUnion& requests(int index) const;
-  ::cockroach::proto::RequestUnion* mutable_requests(int index);
-  ::cockroach::proto::RequestUnion* add_requests();
-  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
-      requests() const;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
-      mutable_requests();
+  // repeated .cockroach.proto.ChangeEvent events = 2;
+  int events_size() const;
+  void clear_events();
+  static const int kEventsFieldNumber = 2;
+  const ::cockroach::proto::ChangeEvent& events(int index) const;
+  ::cockroach::proto::ChangeEvent* mutable_events(int index);
+  ::cockroach::proto::ChangeEvent* add_events();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ChangeEvent >&
+      events() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ChangeEvent >*
+      mutable_events();
+
+  // optional .cockroach.proto.Timestamp resolved = 3;
+  bool has_resolved() const;
+  void clear_resolved();
+  static const int kResolvedFieldNumber = 3;
+  const ::cockroach::proto::Timestamp& resolved() const;
+  ::cockroach::proto::Timestamp* mutable_resolved();
+  ::cockroach::proto::Timestamp* release_resolved();
+  void set_allocated_resolved(::cockroach::proto::Timestamp* resolved);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchRequest)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ChangesResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_resolved();
+  inline void clear_has_resolved();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::proto::RequestHeader* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion > requests_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ChangeEvent > events_;
+  ::cockroach::proto::Timestamp* resolved_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static BatchRequest* default_instance_;
+  static ChangesResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class BatchResponse : public ::google::protobuf::Message {
+class EndTransactionRequest : public ::google::protobuf::Message {
  public:
-  BatchResponse();
-  virtual ~BatchResponse();
+  EndTransactionRequest();
+  virtual ~EndTransactionRequest();
 
-  BatchResponse(const BatchResponse& from);
+  EndTransactionRequest(const EndTransactionRequest& from);
 
-  inline BatchResponse& operator=(const BatchResponse& from) {
+  inline EndTransactionRequest& operator=(const EndTransactionRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2627,19 +2487,19 @@ class BatchResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchResponse& default_instance();
+  static const EndTransactionRequest& default_instance();
 
-  void Swap(BatchResponse* other);
+  void Swap(EndTransactionRequest* other);
 
   // implements Message ----------------------------------------------
 
-  inline BatchResponse* New() const { return New(NULL); }
+  inline EndTransactionRequest* New() const { return New(NULL); }
 
-  BatchResponse* New(::google::protobuf::Arena* arena) const;
+  EndTransactionRequest* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchResponse& from);
-  void MergeFrom(const BatchResponse& from);
+  void CopyFrom(const EndTransactionRequest& from);
+  void MergeFrom(const EndTransactionRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2654,7 +2514,7 @@ class BatchResponse : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(BatchResponse* other);
+  void InternalSwap(EndTransactionRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2670,54 +2530,63 @@ class BatchResponse : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ResponseHeader header = 1;
+  // optional .cockroach.proto.RequestHeader header = 1;
   bool has_header() const;
   void clear_header();
   static const int kHeaderFieldNumber = 1;
-  const ::cockroach::proto::ResponseHeader& header() const;
-  ::cockroach::proto::ResponseHeader* mutable_header();
-  ::cockroach::proto::ResponseHeader* release_header();
-  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // repeated .cockroach.proto.ResponseUnion responses = 2;
-  int responses_size() const;
-  void clear_responses();
-  static const int kResponsesFieldNumber = 2;
-  const ::cockroach::proto::ResponseUnion& responses(int index) const;
-  ::cockroach::proto::ResponseUnion* mutable_responses(int index);
-  ::cockroach::proto::ResponseUnion* add_responses();
-  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
-      responses() const;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
-      mutable_responses();
+  // optional bool commit = 2;
+  bool has_commit() const;
+  void clear_commit();
+  static const int kCommitFieldNumber = 2;
+  bool commit() const;
+  void set_commit(bool value);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchResponse)
+  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+  bool has_internal_commit_trigger() const;
+  void clear_internal_commit_trigger();
+  static const int kInternalCommitTriggerFieldNumber = 3;
+  const ::cockroach::proto::InternalCommitTrigger& internal_commit_trigger() const;
+  ::cockroach::proto::InternalCommitTrigger* mutable_internal_commit_trigger();
+  ::cockroach::proto::InternalCommitTrigger* release_internal_commit_trigger();
+  void set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EndTransactionRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_commit();
+  inline void clear_has_commit();
+  inline void set_has_internal_commit_trigger();
+  inline void clear_has_internal_commit_trigger();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::proto::ResponseHeader* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion > responses_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::InternalCommitTrigger* internal_commit_trigger_;
+  bool commit_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static BatchResponse* default_instance_;
+  static EndTransactionRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminSplitRequest : public ::google::protobuf::Message {
+class EndTransactionResponse : public ::google::protobuf::Message {
  public:
-  AdminSplitRequest();
-  virtual ~AdminSplitRequest();
+  EndTransactionResponse();
+  virtual ~EndTransactionResponse();
 
-  AdminSplitRequest(const AdminSplitRequest& from);
+  EndTransactionResponse(const EndTransactionResponse& from);
 
-  inline AdminSplitRequest& operator=(const AdminSplitRequest& from) {
+  inline EndTransactionResponse& operator=(const EndTransactionResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2731,19 +2600,19 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminSplitRequest& default_instance();
+  static const EndTransactionResponse& default_instance();
 
-  void Swap(AdminSplitRequest* other);
+  void Swap(EndTransactionResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline AdminSplitRequest* New() const { return New(NULL); }
+  inline EndTransactionResponse* New() const { return New(NULL); }
 
-  AdminSplitRequest* New(::google::protobuf::Arena* arena) const;
+  EndTransactionResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminSplitRequest& from);
-  void MergeFrom(const AdminSplitRequest& from);
+  void CopyFrom(const EndTransactionResponse& from);
+  void MergeFrom(const EndTransactionResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2758,7 +2627,125 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(AdminSplitRequest* other);
+  void InternalSwap(EndTransactionResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // optional int64 commit_wait = 2;
+  bool has_commit_wait() const;
+  void clear_commit_wait();
+  static const int kCommitWaitFieldNumber = 2;
+  ::google::protobuf::int64 commit_wait() const;
+  void set_commit_wait(::google::protobuf::int64 value);
+
+  // repeated bytes resolved = 3;
+  int resolved_size() const;
+  void clear_resolved();
+  static const int kResolvedFieldNumber = 3;
+  const ::std::string& resolved(int index) const;
+  ::std::string* mutable_resolved(int index);
+  void set_resolved(int index, const ::std::string& value);
+  void set_resolved(int index, const char* value);
+  void set_resolved(int index, const void* value, size_t size);
+  ::std::string* add_resolved();
+  void add_resolved(const ::std::string& value);
+  void add_resolved(const char* value);
+  void add_resolved(const void* value, size_t size);
+  const ::google::protobuf::RepeatedPtrField< ::std::string>& resolved() const;
+  ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_resolved();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EndTransactionResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_commit_wait();
+  inline void clear_has_commit_wait();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::int64 commit_wait_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> resolved_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static EndTransactionResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ReapQueueRequest : public ::google::protobuf::Message {
+ public:
+  ReapQueueRequest();
+  virtual ~ReapQueueRequest();
+
+  ReapQueueRequest(const ReapQueueRequest& from);
+
+  inline ReapQueueRequest& operator=(const ReapQueueRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ReapQueueRequest& default_instance();
+
+  void Swap(ReapQueueRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ReapQueueRequest* New() const { return New(NULL); }
+
+  ReapQueueRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ReapQueueRequest& from);
+  void MergeFrom(const ReapQueueRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ReapQueueRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2783,47 +2770,42 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* release_header();
   void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // optional bytes split_key = 2;
-  bool has_split_key() const;
-  void clear_split_key();
-  static const int kSplitKeyFieldNumber = 2;
-  const ::std::string& split_key() const;
-  void set_split_key(const ::std::string& value);
-  void set_split_key(const char* value);
-  void set_split_key(const void* value, size_t size);
-  ::std::string* mutable_split_key();
-  ::std::string* release_split_key();
-  void set_allocated_split_key(::std::string* split_key);
+  // optional int64 max_results = 2;
+  bool has_max_results() const;
+  void clear_max_results();
+  static const int kMaxResultsFieldNumber = 2;
+  ::google::protobuf::int64 max_results() const;
+  void set_max_results(::google::protobuf::int64 value);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ReapQueueRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
-  inline void set_has_split_key();
-  inline void clear_has_split_key();
+  inline void set_has_max_results();
+  inline void clear_has_max_results();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
-  ::google::protobuf::internal::ArenaStringPtr split_key_;
+  ::google::protobuf::int64 max_results_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminSplitRequest* default_instance_;
+  static ReapQueueRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminSplitResponse : public ::google::protobuf::Message {
+class ReapQueueResponse : public ::google::protobuf::Message {
  public:
-  AdminSplitResponse();
-  virtual ~AdminSplitResponse();
+  ReapQueueResponse();
+  virtual ~ReapQueueResponse();
 
-  AdminSplitResponse(const AdminSplitResponse& from);
+  ReapQueueResponse(const ReapQueueResponse& from);
 
-  inline AdminSplitResponse& operator=(const AdminSplitResponse& from) {
+  inline ReapQueueResponse& operator=(const ReapQueueResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2837,19 +2819,19 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminSplitResponse& default_instance();
+  static const ReapQueueResponse& default_instance();
 
-  void Swap(AdminSplitResponse* other);
+  void Swap(ReapQueueResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline AdminSplitResponse* New() const { return New(NULL); }
+  inline ReapQueueResponse* New() const { return New(NULL); }
 
-  AdminSplitResponse* New(::google::protobuf::Arena* arena) const;
+  ReapQueueResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminSplitResponse& from);
-  void MergeFrom(const AdminSplitResponse& from);
+  void CopyFrom(const ReapQueueResponse& from);
+  void MergeFrom(const ReapQueueResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2864,7 +2846,7 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(AdminSplitResponse* other);
+  void InternalSwap(ReapQueueResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2889,7 +2871,19 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   ::cockroach::proto::ResponseHeader* release_header();
   void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitResponse)
+  // repeated .cockroach.proto.KeyValue messages = 2;
+  int messages_size() const;
+  void clear_messages();
+  static const int kMessagesFieldNumber = 2;
+  const ::cockroach::proto::KeyValue& messages(int index) const;
+  ::cockroach::proto::KeyValue* mutable_messages(int index);
+  ::cockroach::proto::KeyValue* add_messages();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+      messages() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+      mutable_messages();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ReapQueueResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
@@ -2898,23 +2892,24 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue > messages_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminSplitResponse* default_instance_;
+  static ReapQueueResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminMergeRequest : public ::google::protobuf::Message {
+class EnqueueUpdateRequest : public ::google::protobuf::Message {
  public:
-  AdminMergeRequest();
-  virtual ~AdminMergeRequest();
+  EnqueueUpdateRequest();
+  virtual ~EnqueueUpdateRequest();
 
-  AdminMergeRequest(const AdminMergeRequest& from);
+  EnqueueUpdateRequest(const EnqueueUpdateRequest& from);
 
-  inline AdminMergeRequest& operator=(const AdminMergeRequest& from) {
+  inline EnqueueUpdateRequest& operator=(const EnqueueUpdateRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2928,19 +2923,19 @@ class AdminMergeRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminMergeRequest& default_instance();
+  static const EnqueueUpdateRequest& default_instance();
 
-  void Swap(AdminMergeRequest* other);
+  void Swap(EnqueueUpdateRequest* other);
 
   // implements Message ----------------------------------------------
 
-  inline AdminMergeRequest* New() const { return New(NULL); }
+  inline EnqueueUpdateRequest* New() const { return New(NULL); }
 
-  AdminMergeRequest* New(::google::protobuf::Arena* arena) const;
+  EnqueueUpdateRequest* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminMergeRequest& from);
-  void MergeFrom(const AdminMergeRequest& from);
+  void CopyFrom(const EnqueueUpdateRequest& from);
+  void MergeFrom(const EnqueueUpdateRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2955,7 +2950,7 @@ class AdminMergeRequest : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(AdminMergeRequest* other);
+  void InternalSwap(EnqueueUpdateRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2980,32 +2975,44 @@ class AdminMergeRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* release_header();
   void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeRequest)
+  // optional .cockroach.proto.BatchRequest update = 2;
+  bool has_update() const;
+  void clear_update();
+  static const int kUpdateFieldNumber = 2;
+  const ::cockroach::proto::BatchRequest& update() const;
+  ::cockroach::proto::BatchRequest* mutable_update();
+  ::cockroach::proto::BatchRequest* release_update();
+  void set_allocated_update(::cockroach::proto::BatchRequest* update);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EnqueueUpdateRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_update();
+  inline void clear_has_update();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::BatchRequest* update_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminMergeRequest* default_instance_;
+  static EnqueueUpdateRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminMergeResponse : public ::google::protobuf::Message {
+class EnqueueUpdateResponse : public ::google::protobuf::Message {
  public:
-  AdminMergeResponse();
-  virtual ~AdminMergeResponse();
+  EnqueueUpdateResponse();
+  virtual ~EnqueueUpdateResponse();
 
-  AdminMergeResponse(const AdminMergeResponse& from);
+  EnqueueUpdateResponse(const EnqueueUpdateResponse& from);
 
-  inline AdminMergeResponse& operator=(const AdminMergeResponse& from) {
+  inline EnqueueUpdateResponse& operator=(const EnqueueUpdateResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -3019,19 +3026,19 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminMergeResponse& default_instance();
+  static const EnqueueUpdateResponse& default_instance();
 
-  void Swap(AdminMergeResponse* other);
+  void Swap(EnqueueUpdateResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline AdminMergeResponse* New() const { return New(NULL); }
+  inline EnqueueUpdateResponse* New() const { return New(NULL); }
 
-  AdminMergeResponse* New(::google::protobuf::Arena* arena) const;
+  EnqueueUpdateResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminMergeResponse& from);
-  void MergeFrom(const AdminMergeResponse& from);
+  void CopyFrom(const EnqueueUpdateResponse& from);
+  void MergeFrom(const EnqueueUpdateResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -3046,7 +3053,7 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(AdminMergeResponse* other);
+  void InternalSwap(EnqueueUpdateResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -3071,7 +3078,7 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   ::cockroach::proto::ResponseHeader* release_header();
   void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EnqueueUpdateResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
@@ -3085,642 +3092,2777 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminMergeResponse* default_instance_;
+  static EnqueueUpdateResponse* default_instance_;
 };
-// ===================================================================
+// -------------------------------------------------------------------
 
+class EnqueueMessageRequest : public ::google::protobuf::Message {
+ public:
+  EnqueueMessageRequest();
+  virtual ~EnqueueMessageRequest();
 
-// ===================================================================
+  EnqueueMessageRequest(const EnqueueMessageRequest& from);
 
-#if !PROTOBUF_INLINE_NOT_IN_HEADERS
-// ClientCmdID
+  inline EnqueueMessageRequest& operator=(const EnqueueMessageRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-// optional int64 wall_time = 1;
-inline bool ClientCmdID::has_wall_time() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void ClientCmdID::set_has_wall_time() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void ClientCmdID::clear_has_wall_time() {
-  _has_bits_[0] &= ~0x00000001u;
-}
-inline void ClientCmdID::clear_wall_time() {
-  wall_time_ = GOOGLE_LONGLONG(0);
-  clear_has_wall_time();
-}
-inline ::google::protobuf::int64 ClientCmdID::wall_time() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.wall_time)
-  return wall_time_;
-}
-inline void ClientCmdID::set_wall_time(::google::protobuf::int64 value) {
-  set_has_wall_time();
-  wall_time_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.wall_time)
-}
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
 
-// optional int64 random = 2;
-inline bool ClientCmdID::has_random() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void ClientCmdID::set_has_random() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void ClientCmdID::clear_has_random() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void ClientCmdID::clear_random() {
-  random_ = GOOGLE_LONGLONG(0);
-  clear_has_random();
-}
-inline ::google::protobuf::int64 ClientCmdID::random() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.random)
-  return random_;
-}
-inline void ClientCmdID::set_random(::google::protobuf::int64 value) {
-  set_has_random();
-  random_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.random)
-}
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
 
-// -------------------------------------------------------------------
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const EnqueueMessageRequest& default_instance();
 
-// RequestHeader
+  void Swap(EnqueueMessageRequest* other);
 
-// optional .cockroach.proto.Timestamp timestamp = 1;
-inline bool RequestHeader::has_timestamp() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void RequestHeader::set_has_timestamp() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void RequestHeader::clear_has_timestamp() {
+  // implements Message ----------------------------------------------
+
+  inline EnqueueMessageRequest* New() const { return New(NULL); }
+
+  EnqueueMessageRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const EnqueueMessageRequest& from);
+  void MergeFrom(const EnqueueMessageRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(EnqueueMessageRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional .cockroach.proto.Value msg = 2;
+  bool has_msg() const;
+  void clear_msg();
+  static const int kMsgFieldNumber = 2;
+  const ::cockroach::proto::Value& msg() const;
+  ::cockroach::proto::Value* mutable_msg();
+  ::cockroach::proto::Value* release_msg();
+  void set_allocated_msg(::cockroach::proto::Value* msg);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EnqueueMessageRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_msg();
+  inline void clear_has_msg();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Value* msg_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static EnqueueMessageRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class EnqueueMessageResponse : public ::google::protobuf::Message {
+ public:
+  EnqueueMessageResponse();
+  virtual ~EnqueueMessageResponse();
+
+  EnqueueMessageResponse(const EnqueueMessageResponse& from);
+
+  inline EnqueueMessageResponse& operator=(const EnqueueMessageResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const EnqueueMessageResponse& default_instance();
+
+  void Swap(EnqueueMessageResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline EnqueueMessageResponse* New() const { return New(NULL); }
+
+  EnqueueMessageResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const EnqueueMessageResponse& from);
+  void MergeFrom(const EnqueueMessageResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(EnqueueMessageResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EnqueueMessageResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static EnqueueMessageResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RequestUnion : public ::google::protobuf::Message {
+ public:
+  RequestUnion();
+  virtual ~RequestUnion();
+
+  RequestUnion(const RequestUnion& from);
+
+  inline RequestUnion& operator=(const RequestUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RequestUnion& default_instance();
+
+  enum ValueCase {
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kReverseScan = 10,
+    kReapQueue = 11,
+    kEnqueueUpdate = 12,
+    kEnqueueMessage = 13,
+    kChanges = 14,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(RequestUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  inline RequestUnion* New() const { return New(NULL); }
+
+  RequestUnion* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RequestUnion& from);
+  void MergeFrom(const RequestUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(RequestUnion* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.GetRequest get = 2;
+  bool has_get() const;
+  void clear_get();
+  static const int kGetFieldNumber = 2;
+  const ::cockroach::proto::GetRequest& get() const;
+  ::cockroach::proto::GetRequest* mutable_get();
+  ::cockroach::proto::GetRequest* release_get();
+  void set_allocated_get(::cockroach::proto::GetRequest* get);
+
+  // optional .cockroach.proto.PutRequest put = 3;
+  bool has_put() const;
+  void clear_put();
+  static const int kPutFieldNumber = 3;
+  const ::cockroach::proto::PutRequest& put() const;
+  ::cockroach::proto::PutRequest* mutable_put();
+  ::cockroach::proto::PutRequest* release_put();
+  void set_allocated_put(::cockroach::proto::PutRequest* put);
+
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  bool has_conditional_put() const;
+  void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
+  ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
+  ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
+  void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
+
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  bool has_increment() const;
+  void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  const ::cockroach::proto::IncrementRequest& increment() const;
+  ::cockroach::proto::IncrementRequest* mutable_increment();
+  ::cockroach::proto::IncrementRequest* release_increment();
+  void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
+
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  bool has_delete_() const;
+  void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  const ::cockroach::proto::DeleteRequest& delete_() const;
+  ::cockroach::proto::DeleteRequest* mutable_delete_();
+  ::cockroach::proto::DeleteRequest* release_delete_();
+  void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
+
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  bool has_delete_range() const;
+  void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
+  ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
+  ::cockroach::proto::DeleteRangeRequest* release_delete_range();
+  void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
+
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  bool has_scan() const;
+  void clear_scan();
+  static const int kScanFieldNumber = 8;
+  const ::cockroach::proto::ScanRequest& scan() const;
+  ::cockroach::proto::ScanRequest* mutable_scan();
+  ::cockroach::proto::ScanRequest* release_scan();
+  void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
+
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  bool has_end_transaction() const;
+  void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
+  ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
+  ::cockroach::proto::EndTransactionRequest* release_end_transaction();
+  void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
+
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  bool has_reverse_scan() const;
+  void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 10;
+  const ::cockroach::proto::ReverseScanRequest& reverse_scan() const;
+  ::cockroach::proto::ReverseScanRequest* mutable_reverse_scan();
+  ::cockroach::proto::ReverseScanRequest* release_reverse_scan();
+  void set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan);
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  bool has_reap_queue() const;
+  void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 11;
+  const ::cockroach::proto::ReapQueueRequest& reap_queue() const;
+  ::cockroach::proto::ReapQueueRequest* mutable_reap_queue();
+  ::cockroach::proto::ReapQueueRequest* release_reap_queue();
+  void set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue);
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  bool has_enqueue_update() const;
+  void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 12;
+  const ::cockroach::proto::EnqueueUpdateRequest& enqueue_update() const;
+  ::cockroach::proto::EnqueueUpdateRequest* mutable_enqueue_update();
+  ::cockroach::proto::EnqueueUpdateRequest* release_enqueue_update();
+  void set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update);
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  bool has_enqueue_message() const;
+  void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 13;
+  const ::cockroach::proto::EnqueueMessageRequest& enqueue_message() const;
+  ::cockroach::proto::EnqueueMessageRequest* mutable_enqueue_message();
+  ::cockroach::proto::EnqueueMessageRequest* release_enqueue_message();
+  void set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message);
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  bool has_changes() const;
+  void clear_changes();
+  static const int kChangesFieldNumber = 14;
+  const ::cockroach::proto::ChangesRequest& changes() const;
+  ::cockroach::proto::ChangesRequest* mutable_changes();
+  ::cockroach::proto::ChangesRequest* release_changes();
+  void set_allocated_changes(::cockroach::proto::ChangesRequest* changes);
+
+  ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RequestUnion)
+ private:
+  inline void set_has_get();
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_scan();
+  inline void set_has_end_transaction();
+  inline void set_has_reverse_scan();
+  inline void set_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void set_has_enqueue_message();
+  inline void set_has_changes();
+
+  inline bool has_value() const;
+  void clear_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  union ValueUnion {
+    ValueUnion() {}
+    ::cockroach::proto::GetRequest* get_;
+    ::cockroach::proto::PutRequest* put_;
+    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
+    ::cockroach::proto::IncrementRequest* increment_;
+    ::cockroach::proto::DeleteRequest* delete__;
+    ::cockroach::proto::DeleteRangeRequest* delete_range_;
+    ::cockroach::proto::ScanRequest* scan_;
+    ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::ReverseScanRequest* reverse_scan_;
+    ::cockroach::proto::ReapQueueRequest* reap_queue_;
+    ::cockroach::proto::EnqueueUpdateRequest* enqueue_update_;
+    ::cockroach::proto::EnqueueMessageRequest* enqueue_message_;
+    ::cockroach::proto::ChangesRequest* changes_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static RequestUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ResponseUnion : public ::google::protobuf::Message {
+ public:
+  ResponseUnion();
+  virtual ~ResponseUnion();
+
+  ResponseUnion(const ResponseUnion& from);
+
+  inline ResponseUnion& operator=(const ResponseUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ResponseUnion& default_instance();
+
+  enum ValueCase {
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kReverseScan = 10,
+    kReapQueue = 11,
+    kEnqueueUpdate = 12,
+    kEnqueueMessage = 13,
+    kChanges = 14,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(ResponseUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ResponseUnion* New() const { return New(NULL); }
+
+  ResponseUnion* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ResponseUnion& from);
+  void MergeFrom(const ResponseUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ResponseUnion* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.GetResponse get = 2;
+  bool has_get() const;
+  void clear_get();
+  static const int kGetFieldNumber = 2;
+  const ::cockroach::proto::GetResponse& get() const;
+  ::cockroach::proto::GetResponse* mutable_get();
+  ::cockroach::proto::GetResponse* release_get();
+  void set_allocated_get(::cockroach::proto::GetResponse* get);
+
+  // optional .cockroach.proto.PutResponse put = 3;
+  bool has_put() const;
+  void clear_put();
+  static const int kPutFieldNumber = 3;
+  const ::cockroach::proto::PutResponse& put() const;
+  ::cockroach::proto::PutResponse* mutable_put();
+  ::cockroach::proto::PutResponse* release_put();
+  void set_allocated_put(::cockroach::proto::PutResponse* put);
+
+  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+  bool has_conditional_put() const;
+  void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  const ::cockroach::proto::ConditionalPutResponse& conditional_put() const;
+  ::cockroach::proto::ConditionalPutResponse* mutable_conditional_put();
+  ::cockroach::proto::ConditionalPutResponse* release_conditional_put();
+  void set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put);
+
+  // optional .cockroach.proto.IncrementResponse increment = 5;
+  bool has_increment() const;
+  void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  const ::cockroach::proto::IncrementResponse& increment() const;
+  ::cockroach::proto::IncrementResponse* mutable_increment();
+  ::cockroach::proto::IncrementResponse* release_increment();
+  void set_allocated_increment(::cockroach::proto::IncrementResponse* increment);
+
+  // optional .cockroach.proto.DeleteResponse delete = 6;
+  bool has_delete_() const;
+  void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  const ::cockroach::proto::DeleteResponse& delete_() const;
+  ::cockroach::proto::DeleteResponse* mutable_delete_();
+  ::cockroach::proto::DeleteResponse* release_delete_();
+  void set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_);
+
+  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+  bool has_delete_range() const;
+  void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  const ::cockroach::proto::DeleteRangeResponse& delete_range() const;
+  ::cockroach::proto::DeleteRangeResponse* mutable_delete_range();
+  ::cockroach::proto::DeleteRangeResponse* release_delete_range();
+  void set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range);
+
+  // optional .cockroach.proto.ScanResponse scan = 8;
+  bool has_scan() const;
+  void clear_scan();
+  static const int kScanFieldNumber = 8;
+  const ::cockroach::proto::ScanResponse& scan() const;
+  ::cockroach::proto::ScanResponse* mutable_scan();
+  ::cockroach::proto::ScanResponse* release_scan();
+  void set_allocated_scan(::cockroach::proto::ScanResponse* scan);
+
+  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+  bool has_end_transaction() const;
+  void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  const ::cockroach::proto::EndTransactionResponse& end_transaction() const;
+  ::cockroach::proto::EndTransactionResponse* mutable_end_transaction();
+  ::cockroach::proto::EndTransactionResponse* release_end_transaction();
+  void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
+
+  // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+  bool has_reverse_scan() const;
+  void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 10;
+  const ::cockroach::proto::ReverseScanResponse& reverse_scan() const;
+  ::cockroach::proto::ReverseScanResponse* mutable_reverse_scan();
+  ::cockroach::proto::ReverseScanResponse* release_reverse_scan();
+  void set_allocated_reverse_scan(::cockroach::proto::ReverseScanResponse* reverse_scan);
+
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+  bool has_reap_queue() const;
+  void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 11;
+  const ::cockroach::proto::ReapQueueResponse& reap_queue() const;
+  ::cockroach::proto::ReapQueueResponse* mutable_reap_queue();
+  ::cockroach::proto::ReapQueueResponse* release_reap_queue();
+  void set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue);
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+  bool has_enqueue_update() const;
+  void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 12;
+  const ::cockroach::proto::EnqueueUpdateResponse& enqueue_update() const;
+  ::cockroach::proto::EnqueueUpdateResponse* mutable_enqueue_update();
+  ::cockroach::proto::EnqueueUpdateResponse* release_enqueue_update();
+  void set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update);
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+  bool has_enqueue_message() const;
+  void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 13;
+  const ::cockroach::proto::EnqueueMessageResponse& enqueue_message() const;
+  ::cockroach::proto::EnqueueMessageResponse* mutable_enqueue_message();
+  ::cockroach::proto::EnqueueMessageResponse* release_enqueue_message();
+  void set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message);
+
+  // optional .cockroach.proto.ChangesResponse changes = 14;
+  bool has_changes() const;
+  void clear_changes();
+  static const int kChangesFieldNumber = 14;
+  const ::cockroach::proto::ChangesResponse& changes() const;
+  ::cockroach::proto::ChangesResponse* mutable_changes();
+  ::cockroach::proto::ChangesResponse* release_changes();
+  void set_allocated_changes(::cockroach::proto::ChangesResponse* changes);
+
+  ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ResponseUnion)
+ private:
+  inline void set_has_get();
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_scan();
+  inline void set_has_end_transaction();
+  inline void set_has_reverse_scan();
+  inline void set_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void set_has_enqueue_message();
+  inline void set_has_changes();
+
+  inline bool has_value() const;
+  void clear_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  union ValueUnion {
+    ValueUnion() {}
+    ::cockroach::proto::GetResponse* get_;
+    ::cockroach::proto::PutResponse* put_;
+    ::cockroach::proto::ConditionalPutResponse* conditional_put_;
+    ::cockroach::proto::IncrementResponse* increment_;
+    ::cockroach::proto::DeleteResponse* delete__;
+    ::cockroach::proto::DeleteRangeResponse* delete_range_;
+    ::cockroach::proto::ScanResponse* scan_;
+    ::cockroach::proto::EndTransactionResponse* end_transaction_;
+    ::cockroach::proto::ReverseScanResponse* reverse_scan_;
+    ::cockroach::proto::ReapQueueResponse* reap_queue_;
+    ::cockroach::proto::EnqueueUpdateResponse* enqueue_update_;
+    ::cockroach::proto::EnqueueMessageResponse* enqueue_message_;
+    ::cockroach::proto::ChangesResponse* changes_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ResponseUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchRequest : public ::google::protobuf::Message {
+ public:
+  BatchRequest();
+  virtual ~BatchRequest();
+
+  BatchRequest(const BatchRequest& from);
+
+  inline BatchRequest& operator=(const BatchRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchRequest& default_instance();
+
+  void Swap(BatchRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline BatchRequest* New() const { return New(NULL); }
+
+  BatchRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchRequest& from);
+  void MergeFrom(const BatchRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(BatchRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // repeated .cockroach.proto.RequestUnion requests = 2;
+  int requests_size() const;
+  void clear_requests();
+  static const int kRequestsFieldNumber = 2;
+  const ::cockroach::proto::RequestUnion& requests(int index) const;
+  ::cockroach::proto::RequestUnion* mutable_requests(int index);
+  ::cockroach::proto::RequestUnion* add_requests();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
+      requests() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
+      mutable_requests();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion > requests_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchResponse : public ::google::protobuf::Message {
+ public:
+  BatchResponse();
+  virtual ~BatchResponse();
+
+  BatchResponse(const BatchResponse& from);
+
+  inline BatchResponse& operator=(const BatchResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchResponse& default_instance();
+
+  void Swap(BatchResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline BatchResponse* New() const { return New(NULL); }
+
+  BatchResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchResponse& from);
+  void MergeFrom(const BatchResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(BatchResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // repeated .cockroach.proto.ResponseUnion responses = 2;
+  int responses_size() const;
+  void clear_responses();
+  static const int kResponsesFieldNumber = 2;
+  const ::cockroach::proto::ResponseUnion& responses(int index) const;
+  ::cockroach::proto::ResponseUnion* mutable_responses(int index);
+  ::cockroach::proto::ResponseUnion* add_responses();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
+      responses() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
+      mutable_responses();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion > responses_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminSplitRequest : public ::google::protobuf::Message {
+ public:
+  AdminSplitRequest();
+  virtual ~AdminSplitRequest();
+
+  AdminSplitRequest(const AdminSplitRequest& from);
+
+  inline AdminSplitRequest& operator=(const AdminSplitRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminSplitRequest& default_instance();
+
+  void Swap(AdminSplitRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminSplitRequest* New() const { return New(NULL); }
+
+  AdminSplitRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminSplitRequest& from);
+  void MergeFrom(const AdminSplitRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminSplitRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional bytes split_key = 2;
+  bool has_split_key() const;
+  void clear_split_key();
+  static const int kSplitKeyFieldNumber = 2;
+  const ::std::string& split_key() const;
+  void set_split_key(const ::std::string& value);
+  void set_split_key(const char* value);
+  void set_split_key(const void* value, size_t size);
+  ::std::string* mutable_split_key();
+  ::std::string* release_split_key();
+  void set_allocated_split_key(::std::string* split_key);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_split_key();
+  inline void clear_has_split_key();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::internal::ArenaStringPtr split_key_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminSplitRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminSplitResponse : public ::google::protobuf::Message {
+ public:
+  AdminSplitResponse();
+  virtual ~AdminSplitResponse();
+
+  AdminSplitResponse(const AdminSplitResponse& from);
+
+  inline AdminSplitResponse& operator=(const AdminSplitResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminSplitResponse& default_instance();
+
+  void Swap(AdminSplitResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminSplitResponse* New() const { return New(NULL); }
+
+  AdminSplitResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminSplitResponse& from);
+  void MergeFrom(const AdminSplitResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminSplitResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminSplitResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminMergeRequest : public ::google::protobuf::Message {
+ public:
+  AdminMergeRequest();
+  virtual ~AdminMergeRequest();
+
+  AdminMergeRequest(const AdminMergeRequest& from);
+
+  inline AdminMergeRequest& operator=(const AdminMergeRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminMergeRequest& default_instance();
+
+  void Swap(AdminMergeRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminMergeRequest* New() const { return New(NULL); }
+
+  AdminMergeRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminMergeRequest& from);
+  void MergeFrom(const AdminMergeRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminMergeRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminMergeRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminMergeResponse : public ::google::protobuf::Message {
+ public:
+  AdminMergeResponse();
+  virtual ~AdminMergeResponse();
+
+  AdminMergeResponse(const AdminMergeResponse& from);
+
+  inline AdminMergeResponse& operator=(const AdminMergeResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminMergeResponse& default_instance();
+
+  void Swap(AdminMergeResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminMergeResponse* New() const { return New(NULL); }
+
+  AdminMergeResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminMergeResponse& from);
+  void MergeFrom(const AdminMergeResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminMergeResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminMergeResponse* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+#if !PROTOBUF_INLINE_NOT_IN_HEADERS
+// ClientCmdID
+
+// optional int64 wall_time = 1;
+inline bool ClientCmdID::has_wall_time() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ClientCmdID::set_has_wall_time() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ClientCmdID::clear_has_wall_time() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ClientCmdID::clear_wall_time() {
+  wall_time_ = GOOGLE_LONGLONG(0);
+  clear_has_wall_time();
+}
+inline ::google::protobuf::int64 ClientCmdID::wall_time() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.wall_time)
+  return wall_time_;
+}
+inline void ClientCmdID::set_wall_time(::google::protobuf::int64 value) {
+  set_has_wall_time();
+  wall_time_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.wall_time)
+}
+
+// optional int64 random = 2;
+inline bool ClientCmdID::has_random() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ClientCmdID::set_has_random() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ClientCmdID::clear_has_random() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ClientCmdID::clear_random() {
+  random_ = GOOGLE_LONGLONG(0);
+  clear_has_random();
+}
+inline ::google::protobuf::int64 ClientCmdID::random() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.random)
+  return random_;
+}
+inline void ClientCmdID::set_random(::google::protobuf::int64 value) {
+  set_has_random();
+  random_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.random)
+}
+
+// -------------------------------------------------------------------
+
+// RequestHeader
+
+// optional .cockroach.proto.Timestamp timestamp = 1;
+inline bool RequestHeader::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RequestHeader::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RequestHeader::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RequestHeader::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& RequestHeader::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::proto::Timestamp* RequestHeader::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) {
+    timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* RequestHeader::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.timestamp)
+}
+
+// optional .cockroach.proto.ClientCmdID cmd_id = 2;
+inline bool RequestHeader::has_cmd_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RequestHeader::set_has_cmd_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RequestHeader::clear_has_cmd_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void RequestHeader::clear_cmd_id() {
+  if (cmd_id_ != NULL) cmd_id_->::cockroach::proto::ClientCmdID::Clear();
+  clear_has_cmd_id();
+}
+inline const ::cockroach::proto::ClientCmdID& RequestHeader::cmd_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.cmd_id)
+  return cmd_id_ != NULL ? *cmd_id_ : *default_instance_->cmd_id_;
+}
+inline ::cockroach::proto::ClientCmdID* RequestHeader::mutable_cmd_id() {
+  set_has_cmd_id();
+  if (cmd_id_ == NULL) {
+    cmd_id_ = new ::cockroach::proto::ClientCmdID;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.cmd_id)
+  return cmd_id_;
+}
+inline ::cockroach::proto::ClientCmdID* RequestHeader::release_cmd_id() {
+  clear_has_cmd_id();
+  ::cockroach::proto::ClientCmdID* temp = cmd_id_;
+  cmd_id_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_cmd_id(::cockroach::proto::ClientCmdID* cmd_id) {
+  delete cmd_id_;
+  cmd_id_ = cmd_id;
+  if (cmd_id) {
+    set_has_cmd_id();
+  } else {
+    clear_has_cmd_id();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.cmd_id)
+}
+
+// optional bytes key = 3;
+inline bool RequestHeader::has_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RequestHeader::set_has_key() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RequestHeader::clear_has_key() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RequestHeader::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_key();
+}
+inline const ::std::string& RequestHeader::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.key)
+  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_key(const ::std::string& value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.key)
+}
+inline void RequestHeader::set_key(const char* value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.key)
+}
+inline void RequestHeader::set_key(const void* value, size_t size) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.key)
+}
+inline ::std::string* RequestHeader::mutable_key() {
+  set_has_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RequestHeader::release_key() {
+  clear_has_key();
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    set_has_key();
+  } else {
+    clear_has_key();
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.key)
+}
+
+// optional bytes end_key = 4;
+inline bool RequestHeader::has_end_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void RequestHeader::set_has_end_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void RequestHeader::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void RequestHeader::clear_end_key() {
+  end_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_end_key();
+}
+inline const ::std::string& RequestHeader::end_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.end_key)
+  return end_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.end_key)
+}
+inline void RequestHeader::set_end_key(const char* value) {
+  set_has_end_key();
+  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.end_key)
+}
+inline void RequestHeader::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.end_key)
+}
+inline ::std::string* RequestHeader::mutable_end_key() {
+  set_has_end_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.end_key)
+  return end_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RequestHeader::release_end_key() {
+  clear_has_end_key();
+  return end_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_allocated_end_key(::std::string* end_key) {
+  if (end_key != NULL) {
+    set_has_end_key();
+  } else {
+    clear_has_end_key();
+  }
+  end_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), end_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.end_key)
+}
+
+// optional string user = 5;
+inline bool RequestHeader::has_user() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void RequestHeader::set_has_user() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void RequestHeader::clear_has_user() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void RequestHeader::clear_user() {
+  user_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_user();
+}
+inline const ::std::string& RequestHeader::user() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user)
+  return user_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_user(const ::std::string& value) {
+  set_has_user();
+  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user)
+}
+inline void RequestHeader::set_user(const char* value) {
+  set_has_user();
+  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.user)
+}
+inline void RequestHeader::set_user(const char* value, size_t size) {
+  set_has_user();
+  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.user)
+}
+inline ::std::string* RequestHeader::mutable_user() {
+  set_has_user();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.user)
+  return user_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RequestHeader::release_user() {
+  clear_has_user();
+  return user_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_allocated_user(::std::string* user) {
+  if (user != NULL) {
+    set_has_user();
+  } else {
+    clear_has_user();
+  }
+  user_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), user);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.user)
+}
+
+// optional .cockroach.proto.Replica replica = 6;
+inline bool RequestHeader::has_replica() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void RequestHeader::set_has_replica() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void RequestHeader::clear_has_replica() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void RequestHeader::clear_replica() {
+  if (replica_ != NULL) replica_->::cockroach::proto::Replica::Clear();
+  clear_has_replica();
+}
+inline const ::cockroach::proto::Replica& RequestHeader::replica() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.replica)
+  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
+}
+inline ::cockroach::proto::Replica* RequestHeader::mutable_replica() {
+  set_has_replica();
+  if (replica_ == NULL) {
+    replica_ = new ::cockroach::proto::Replica;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.replica)
+  return replica_;
+}
+inline ::cockroach::proto::Replica* RequestHeader::release_replica() {
+  clear_has_replica();
+  ::cockroach::proto::Replica* temp = replica_;
+  replica_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_replica(::cockroach::proto::Replica* replica) {
+  delete replica_;
+  replica_ = replica;
+  if (replica) {
+    set_has_replica();
+  } else {
+    clear_has_replica();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.replica)
+}
+
+// optional int64 raft_id = 7;
+inline bool RequestHeader::has_raft_id() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void RequestHeader::set_has_raft_id() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void RequestHeader::clear_has_raft_id() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void RequestHeader::clear_raft_id() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_id();
+}
+inline ::google::protobuf::int64 RequestHeader::raft_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.raft_id)
+  return raft_id_;
+}
+inline void RequestHeader::set_raft_id(::google::protobuf::int64 value) {
+  set_has_raft_id();
+  raft_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.raft_id)
+}
+
+// optional int32 user_priority = 8 [default = 1];
+inline bool RequestHeader::has_user_priority() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+inline void RequestHeader::set_has_user_priority() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void RequestHeader::clear_has_user_priority() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void RequestHeader::clear_user_priority() {
+  user_priority_ = 1;
+  clear_has_user_priority();
+}
+inline ::google::protobuf::int32 RequestHeader::user_priority() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user_priority)
+  return user_priority_;
+}
+inline void RequestHeader::set_user_priority(::google::protobuf::int32 value) {
+  set_has_user_priority();
+  user_priority_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user_priority)
+}
+
+// optional .cockroach.proto.Transaction txn = 9;
+inline bool RequestHeader::has_txn() const {
+  return (_has_bits_[0] & 0x00000100u) != 0;
+}
+inline void RequestHeader::set_has_txn() {
+  _has_bits_[0] |= 0x00000100u;
+}
+inline void RequestHeader::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000100u;
+}
+inline void RequestHeader::clear_txn() {
+  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::cockroach::proto::Transaction& RequestHeader::txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::cockroach::proto::Transaction* RequestHeader::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) {
+    txn_ = new ::cockroach::proto::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.txn)
+  return txn_;
+}
+inline ::cockroach::proto::Transaction* RequestHeader::release_txn() {
+  clear_has_txn();
+  ::cockroach::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.txn)
+}
+
+// optional .cockroach.proto.ReadConsistencyType read_consistency = 10;
+inline bool RequestHeader::has_read_consistency() const {
+  return (_has_bits_[0] & 0x00000200u) != 0;
+}
+inline void RequestHeader::set_has_read_consistency() {
+  _has_bits_[0] |= 0x00000200u;
+}
+inline void RequestHeader::clear_has_read_consistency() {
+  _has_bits_[0] &= ~0x00000200u;
+}
+inline void RequestHeader::clear_read_consistency() {
+  read_consistency_ = 0;
+  clear_has_read_consistency();
+}
+inline ::cockroach::proto::ReadConsistencyType RequestHeader::read_consistency() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.read_consistency)
+  return static_cast< ::cockroach::proto::ReadConsistencyType >(read_consistency_);
+}
+inline void RequestHeader::set_read_consistency(::cockroach::proto::ReadConsistencyType value) {
+  assert(::cockroach::proto::ReadConsistencyType_IsValid(value));
+  set_has_read_consistency();
+  read_consistency_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.read_consistency)
+}
+
+// optional int64 max_staleness = 11;
+inline bool RequestHeader::has_max_staleness() const {
+  return (_has_bits_[0] & 0x00000400u) != 0;
+}
+inline void RequestHeader::set_has_max_staleness() {
+  _has_bits_[0] |= 0x00000400u;
+}
+inline void RequestHeader::clear_has_max_staleness() {
+  _has_bits_[0] &= ~0x00000400u;
+}
+inline void RequestHeader::clear_max_staleness() {
+  max_staleness_ = GOOGLE_LONGLONG(0);
+  clear_has_max_staleness();
+}
+inline ::google::protobuf::int64 RequestHeader::max_staleness() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.max_staleness)
+  return max_staleness_;
+}
+inline void RequestHeader::set_max_staleness(::google::protobuf::int64 value) {
+  set_has_max_staleness();
+  max_staleness_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.max_staleness)
+}
+
+// -------------------------------------------------------------------
+
+// ResponseHeader
+
+// optional .cockroach.proto.Error error = 1;
+inline bool ResponseHeader::has_error() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ResponseHeader::set_has_error() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ResponseHeader::clear_has_error() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ResponseHeader::clear_error() {
+  if (error_ != NULL) error_->::cockroach::proto::Error::Clear();
+  clear_has_error();
+}
+inline const ::cockroach::proto::Error& ResponseHeader::error() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.error)
+  return error_ != NULL ? *error_ : *default_instance_->error_;
+}
+inline ::cockroach::proto::Error* ResponseHeader::mutable_error() {
+  set_has_error();
+  if (error_ == NULL) {
+    error_ = new ::cockroach::proto::Error;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.error)
+  return error_;
+}
+inline ::cockroach::proto::Error* ResponseHeader::release_error() {
+  clear_has_error();
+  ::cockroach::proto::Error* temp = error_;
+  error_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_error(::cockroach::proto::Error* error) {
+  delete error_;
+  error_ = error;
+  if (error) {
+    set_has_error();
+  } else {
+    clear_has_error();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.error)
+}
+
+// optional .cockroach.proto.Timestamp timestamp = 2;
+inline bool ResponseHeader::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ResponseHeader::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ResponseHeader::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ResponseHeader::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& ResponseHeader::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ResponseHeader::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) {
+    timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ResponseHeader::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.timestamp)
+}
+
+// optional .cockroach.proto.Transaction txn = 3;
+inline bool ResponseHeader::has_txn() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ResponseHeader::set_has_txn() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ResponseHeader::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ResponseHeader::clear_txn() {
+  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::cockroach::proto::Transaction& ResponseHeader::txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::cockroach::proto::Transaction* ResponseHeader::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) {
+    txn_ = new ::cockroach::proto::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.txn)
+  return txn_;
+}
+inline ::cockroach::proto::Transaction* ResponseHeader::release_txn() {
+  clear_has_txn();
+  ::cockroach::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.txn)
+}
+
+// -------------------------------------------------------------------
+
+// GetRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool GetRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GetRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GetRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GetRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& GetRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* GetRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* GetRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void GetRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// GetResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool GetResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GetResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GetResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GetResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& GetResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* GetResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* GetResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void GetResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool GetResponse::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void GetResponse::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void GetResponse::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void GetResponse::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& GetResponse::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* GetResponse::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* GetResponse::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void GetResponse::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool PutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void PutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void PutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void PutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& PutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* PutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* PutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void PutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool PutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void PutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void PutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void PutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& PutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* PutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* PutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void PutRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool PutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void PutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void PutResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
+inline void PutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Timestamp& RequestHeader::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline const ::cockroach::proto::ResponseHeader& PutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Timestamp* RequestHeader::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) {
-    timestamp_ = new ::cockroach::proto::Timestamp;
+inline ::cockroach::proto::ResponseHeader* PutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.timestamp)
-  return timestamp_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::Timestamp* RequestHeader::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::cockroach::proto::ResponseHeader* PutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void PutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_timestamp();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutResponse.header)
 }
 
-// optional .cockroach.proto.ClientCmdID cmd_id = 2;
-inline bool RequestHeader::has_cmd_id() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// -------------------------------------------------------------------
+
+// ConditionalPutRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ConditionalPutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_cmd_id() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ConditionalPutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_cmd_id() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ConditionalPutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_cmd_id() {
-  if (cmd_id_ != NULL) cmd_id_->::cockroach::proto::ClientCmdID::Clear();
-  clear_has_cmd_id();
+inline void ConditionalPutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::ClientCmdID& RequestHeader::cmd_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.cmd_id)
-  return cmd_id_ != NULL ? *cmd_id_ : *default_instance_->cmd_id_;
+inline const ::cockroach::proto::RequestHeader& ConditionalPutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ClientCmdID* RequestHeader::mutable_cmd_id() {
-  set_has_cmd_id();
-  if (cmd_id_ == NULL) {
-    cmd_id_ = new ::cockroach::proto::ClientCmdID;
+inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.cmd_id)
-  return cmd_id_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.header)
+  return header_;
 }
-inline ::cockroach::proto::ClientCmdID* RequestHeader::release_cmd_id() {
-  clear_has_cmd_id();
-  ::cockroach::proto::ClientCmdID* temp = cmd_id_;
-  cmd_id_ = NULL;
+inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_cmd_id(::cockroach::proto::ClientCmdID* cmd_id) {
-  delete cmd_id_;
-  cmd_id_ = cmd_id;
-  if (cmd_id) {
-    set_has_cmd_id();
+inline void ConditionalPutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_cmd_id();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.cmd_id)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.header)
 }
 
-// optional bytes key = 3;
-inline bool RequestHeader::has_key() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void RequestHeader::set_has_key() {
-  _has_bits_[0] |= 0x00000004u;
-}
-inline void RequestHeader::clear_has_key() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-inline void RequestHeader::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_key();
+// optional .cockroach.proto.Value value = 2;
+inline bool ConditionalPutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline const ::std::string& RequestHeader::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.key)
-  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline void ConditionalPutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RequestHeader::set_key(const ::std::string& value) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.key)
+inline void ConditionalPutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RequestHeader::set_key(const char* value) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.key)
+inline void ConditionalPutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
 }
-inline void RequestHeader::set_key(const void* value, size_t size) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.key)
+inline const ::cockroach::proto::Value& ConditionalPutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline ::std::string* RequestHeader::mutable_key() {
-  set_has_key();
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.value)
+  return value_;
 }
-inline ::std::string* RequestHeader::release_key() {
-  clear_has_key();
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::Value* ConditionalPutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
 }
-inline void RequestHeader::set_allocated_key(::std::string* key) {
-  if (key != NULL) {
-    set_has_key();
+inline void ConditionalPutRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
   } else {
-    clear_has_key();
+    clear_has_value();
   }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.value)
 }
 
-// optional bytes end_key = 4;
-inline bool RequestHeader::has_end_key() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
-}
-inline void RequestHeader::set_has_end_key() {
-  _has_bits_[0] |= 0x00000008u;
-}
-inline void RequestHeader::clear_has_end_key() {
-  _has_bits_[0] &= ~0x00000008u;
-}
-inline void RequestHeader::clear_end_key() {
-  end_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_end_key();
+// optional .cockroach.proto.Value exp_value = 3;
+inline bool ConditionalPutRequest::has_exp_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline const ::std::string& RequestHeader::end_key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.end_key)
-  return end_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline void ConditionalPutRequest::set_has_exp_value() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void RequestHeader::set_end_key(const ::std::string& value) {
-  set_has_end_key();
-  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.end_key)
+inline void ConditionalPutRequest::clear_has_exp_value() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void RequestHeader::set_end_key(const char* value) {
-  set_has_end_key();
-  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.end_key)
+inline void ConditionalPutRequest::clear_exp_value() {
+  if (exp_value_ != NULL) exp_value_->::cockroach::proto::Value::Clear();
+  clear_has_exp_value();
 }
-inline void RequestHeader::set_end_key(const void* value, size_t size) {
-  set_has_end_key();
-  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.end_key)
+inline const ::cockroach::proto::Value& ConditionalPutRequest::exp_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.exp_value)
+  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
 }
-inline ::std::string* RequestHeader::mutable_end_key() {
-  set_has_end_key();
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.end_key)
-  return end_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_exp_value() {
+  set_has_exp_value();
+  if (exp_value_ == NULL) {
+    exp_value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.exp_value)
+  return exp_value_;
 }
-inline ::std::string* RequestHeader::release_end_key() {
-  clear_has_end_key();
-  return end_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::Value* ConditionalPutRequest::release_exp_value() {
+  clear_has_exp_value();
+  ::cockroach::proto::Value* temp = exp_value_;
+  exp_value_ = NULL;
+  return temp;
 }
-inline void RequestHeader::set_allocated_end_key(::std::string* end_key) {
-  if (end_key != NULL) {
-    set_has_end_key();
+inline void ConditionalPutRequest::set_allocated_exp_value(::cockroach::proto::Value* exp_value) {
+  delete exp_value_;
+  exp_value_ = exp_value;
+  if (exp_value) {
+    set_has_exp_value();
   } else {
-    clear_has_end_key();
+    clear_has_exp_value();
   }
-  end_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), end_key);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.end_key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.exp_value)
 }
 
-// optional string user = 5;
-inline bool RequestHeader::has_user() const {
-  return (_has_bits_[0] & 0x00000010u) != 0;
-}
-inline void RequestHeader::set_has_user() {
-  _has_bits_[0] |= 0x00000010u;
-}
-inline void RequestHeader::clear_has_user() {
-  _has_bits_[0] &= ~0x00000010u;
-}
-inline void RequestHeader::clear_user() {
-  user_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_user();
+// -------------------------------------------------------------------
+
+// ConditionalPutResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ConditionalPutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline const ::std::string& RequestHeader::user() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user)
-  return user_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline void ConditionalPutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::set_user(const ::std::string& value) {
-  set_has_user();
-  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user)
+inline void ConditionalPutResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::set_user(const char* value) {
-  set_has_user();
-  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.user)
+inline void ConditionalPutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline void RequestHeader::set_user(const char* value, size_t size) {
-  set_has_user();
-  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.user)
+inline const ::cockroach::proto::ResponseHeader& ConditionalPutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::std::string* RequestHeader::mutable_user() {
-  set_has_user();
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.user)
-  return user_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutResponse.header)
+  return header_;
 }
-inline ::std::string* RequestHeader::release_user() {
-  clear_has_user();
-  return user_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline void RequestHeader::set_allocated_user(::std::string* user) {
-  if (user != NULL) {
-    set_has_user();
+inline void ConditionalPutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_user();
+    clear_has_header();
   }
-  user_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), user);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.user)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutResponse.header)
 }
 
-// optional .cockroach.proto.Replica replica = 6;
-inline bool RequestHeader::has_replica() const {
-  return (_has_bits_[0] & 0x00000020u) != 0;
+// -------------------------------------------------------------------
+
+// IncrementRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool IncrementRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_replica() {
-  _has_bits_[0] |= 0x00000020u;
+inline void IncrementRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_replica() {
-  _has_bits_[0] &= ~0x00000020u;
+inline void IncrementRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_replica() {
-  if (replica_ != NULL) replica_->::cockroach::proto::Replica::Clear();
-  clear_has_replica();
+inline void IncrementRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Replica& RequestHeader::replica() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.replica)
-  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
+inline const ::cockroach::proto::RequestHeader& IncrementRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Replica* RequestHeader::mutable_replica() {
-  set_has_replica();
-  if (replica_ == NULL) {
-    replica_ = new ::cockroach::proto::Replica;
+inline ::cockroach::proto::RequestHeader* IncrementRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.replica)
-  return replica_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementRequest.header)
+  return header_;
 }
-inline ::cockroach::proto::Replica* RequestHeader::release_replica() {
-  clear_has_replica();
-  ::cockroach::proto::Replica* temp = replica_;
-  replica_ = NULL;
+inline ::cockroach::proto::RequestHeader* IncrementRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_replica(::cockroach::proto::Replica* replica) {
-  delete replica_;
-  replica_ = replica;
-  if (replica) {
-    set_has_replica();
+inline void IncrementRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_replica();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.replica)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementRequest.header)
 }
 
-// optional int64 raft_id = 7;
-inline bool RequestHeader::has_raft_id() const {
-  return (_has_bits_[0] & 0x00000040u) != 0;
+// optional int64 increment = 2;
+inline bool IncrementRequest::has_increment() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RequestHeader::set_has_raft_id() {
-  _has_bits_[0] |= 0x00000040u;
+inline void IncrementRequest::set_has_increment() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RequestHeader::clear_has_raft_id() {
-  _has_bits_[0] &= ~0x00000040u;
+inline void IncrementRequest::clear_has_increment() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RequestHeader::clear_raft_id() {
-  raft_id_ = GOOGLE_LONGLONG(0);
-  clear_has_raft_id();
+inline void IncrementRequest::clear_increment() {
+  increment_ = GOOGLE_LONGLONG(0);
+  clear_has_increment();
 }
-inline ::google::protobuf::int64 RequestHeader::raft_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.raft_id)
-  return raft_id_;
+inline ::google::protobuf::int64 IncrementRequest::increment() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.increment)
+  return increment_;
 }
-inline void RequestHeader::set_raft_id(::google::protobuf::int64 value) {
-  set_has_raft_id();
-  raft_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.raft_id)
+inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
+  set_has_increment();
+  increment_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementRequest.increment)
 }
 
-// optional int32 user_priority = 8 [default = 1];
-inline bool RequestHeader::has_user_priority() const {
-  return (_has_bits_[0] & 0x00000080u) != 0;
+// -------------------------------------------------------------------
+
+// IncrementResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool IncrementResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_user_priority() {
-  _has_bits_[0] |= 0x00000080u;
+inline void IncrementResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_user_priority() {
-  _has_bits_[0] &= ~0x00000080u;
+inline void IncrementResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_user_priority() {
-  user_priority_ = 1;
-  clear_has_user_priority();
+inline void IncrementResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& IncrementResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* IncrementResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* IncrementResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void IncrementResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementResponse.header)
+}
+
+// optional int64 new_value = 2;
+inline bool IncrementResponse::has_new_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void IncrementResponse::set_has_new_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void IncrementResponse::clear_has_new_value() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline ::google::protobuf::int32 RequestHeader::user_priority() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user_priority)
-  return user_priority_;
+inline void IncrementResponse::clear_new_value() {
+  new_value_ = GOOGLE_LONGLONG(0);
+  clear_has_new_value();
 }
-inline void RequestHeader::set_user_priority(::google::protobuf::int32 value) {
-  set_has_user_priority();
-  user_priority_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user_priority)
+inline ::google::protobuf::int64 IncrementResponse::new_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.new_value)
+  return new_value_;
+}
+inline void IncrementResponse::set_new_value(::google::protobuf::int64 value) {
+  set_has_new_value();
+  new_value_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementResponse.new_value)
 }
 
-// optional .cockroach.proto.Transaction txn = 9;
-inline bool RequestHeader::has_txn() const {
-  return (_has_bits_[0] & 0x00000100u) != 0;
+// -------------------------------------------------------------------
+
+// DeleteRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool DeleteRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_txn() {
-  _has_bits_[0] |= 0x00000100u;
+inline void DeleteRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_txn() {
-  _has_bits_[0] &= ~0x00000100u;
+inline void DeleteRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_txn() {
-  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_txn();
+inline void DeleteRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Transaction& RequestHeader::txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+inline const ::cockroach::proto::RequestHeader& DeleteRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Transaction* RequestHeader::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) {
-    txn_ = new ::cockroach::proto::Transaction;
+inline ::cockroach::proto::RequestHeader* DeleteRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.txn)
-  return txn_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRequest.header)
+  return header_;
 }
-inline ::cockroach::proto::Transaction* RequestHeader::release_txn() {
-  clear_has_txn();
-  ::cockroach::proto::Transaction* temp = txn_;
-  txn_ = NULL;
+inline ::cockroach::proto::RequestHeader* DeleteRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
+inline void DeleteRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_txn();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRequest.header)
 }
 
-// optional .cockroach.proto.ReadConsistencyType read_consistency = 10;
-inline bool RequestHeader::has_read_consistency() const {
-  return (_has_bits_[0] & 0x00000200u) != 0;
+// -------------------------------------------------------------------
+
+// DeleteResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool DeleteResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_read_consistency() {
-  _has_bits_[0] |= 0x00000200u;
+inline void DeleteResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_read_consistency() {
-  _has_bits_[0] &= ~0x00000200u;
+inline void DeleteResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_read_consistency() {
-  read_consistency_ = 0;
-  clear_has_read_consistency();
+inline void DeleteResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::ReadConsistencyType RequestHeader::read_consistency() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.read_consistency)
-  return static_cast< ::cockroach::proto::ReadConsistencyType >(read_consistency_);
+inline const ::cockroach::proto::ResponseHeader& DeleteResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline void RequestHeader::set_read_consistency(::cockroach::proto::ReadConsistencyType value) {
-  assert(::cockroach::proto::ReadConsistencyType_IsValid(value));
-  set_has_read_consistency();
-  read_consistency_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.read_consistency)
+inline ::cockroach::proto::ResponseHeader* DeleteResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* DeleteResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// ResponseHeader
+// DeleteRangeRequest
 
-// optional .cockroach.proto.Error error = 1;
-inline bool ResponseHeader::has_error() const {
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool DeleteRangeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseHeader::set_has_error() {
+inline void DeleteRangeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseHeader::clear_has_error() {
+inline void DeleteRangeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResponseHeader::clear_error() {
-  if (error_ != NULL) error_->::cockroach::proto::Error::Clear();
-  clear_has_error();
+inline void DeleteRangeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Error& ResponseHeader::error() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.error)
-  return error_ != NULL ? *error_ : *default_instance_->error_;
+inline const ::cockroach::proto::RequestHeader& DeleteRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Error* ResponseHeader::mutable_error() {
-  set_has_error();
-  if (error_ == NULL) {
-    error_ = new ::cockroach::proto::Error;
+inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.error)
-  return error_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeRequest.header)
+  return header_;
 }
-inline ::cockroach::proto::Error* ResponseHeader::release_error() {
-  clear_has_error();
-  ::cockroach::proto::Error* temp = error_;
-  error_ = NULL;
+inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void ResponseHeader::set_allocated_error(::cockroach::proto::Error* error) {
-  delete error_;
-  error_ = error;
-  if (error) {
-    set_has_error();
+inline void DeleteRangeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_error();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.error)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeRequest.header)
 }
 
-// optional .cockroach.proto.Timestamp timestamp = 2;
-inline bool ResponseHeader::has_timestamp() const {
+// optional int64 max_entries_to_delete = 2;
+inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResponseHeader::set_has_timestamp() {
+inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ResponseHeader::clear_has_timestamp() {
+inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ResponseHeader::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
+inline void DeleteRangeRequest::clear_max_entries_to_delete() {
+  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
+  clear_has_max_entries_to_delete();
 }
-inline const ::cockroach::proto::Timestamp& ResponseHeader::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
+  return max_entries_to_delete_;
 }
-inline ::cockroach::proto::Timestamp* ResponseHeader::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) {
-    timestamp_ = new ::cockroach::proto::Timestamp;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.timestamp)
-  return timestamp_;
+inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
+  set_has_max_entries_to_delete();
+  max_entries_to_delete_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteRangeResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool DeleteRangeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void DeleteRangeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void DeleteRangeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void DeleteRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& DeleteRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::Timestamp* ResponseHeader::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void ResponseHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void DeleteRangeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_timestamp();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeResponse.header)
 }
 
-// optional .cockroach.proto.Transaction txn = 3;
-inline bool ResponseHeader::has_txn() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void ResponseHeader::set_has_txn() {
-  _has_bits_[0] |= 0x00000004u;
-}
-inline void ResponseHeader::clear_has_txn() {
-  _has_bits_[0] &= ~0x00000004u;
+// optional int64 num_deleted = 2;
+inline bool DeleteRangeResponse::has_num_deleted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResponseHeader::clear_txn() {
-  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_txn();
+inline void DeleteRangeResponse::set_has_num_deleted() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline const ::cockroach::proto::Transaction& ResponseHeader::txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+inline void DeleteRangeResponse::clear_has_num_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline ::cockroach::proto::Transaction* ResponseHeader::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) {
-    txn_ = new ::cockroach::proto::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.txn)
-  return txn_;
+inline void DeleteRangeResponse::clear_num_deleted() {
+  num_deleted_ = GOOGLE_LONGLONG(0);
+  clear_has_num_deleted();
 }
-inline ::cockroach::proto::Transaction* ResponseHeader::release_txn() {
-  clear_has_txn();
-  ::cockroach::proto::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.num_deleted)
+  return num_deleted_;
 }
-inline void ResponseHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.txn)
+inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
+  set_has_num_deleted();
+  num_deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeResponse.num_deleted)
 }
 
 // -------------------------------------------------------------------
 
-// GetRequest
+// ScanRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool GetRequest::has_header() const {
+inline bool ScanRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void GetRequest::set_has_header() {
+inline void ScanRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void GetRequest::clear_has_header() {
+inline void ScanRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void GetRequest::clear_header() {
+inline void ScanRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& GetRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetRequest.header)
+inline const ::cockroach::proto::RequestHeader& ScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* GetRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* ScanRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* GetRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* ScanRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void GetRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3728,46 +5870,70 @@ inline void GetRequest::set_allocated_header(::cockroach::proto::RequestHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRequest.header)
+}
+
+// optional int64 max_results = 2;
+inline bool ScanRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ScanRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ScanRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+inline ::google::protobuf::int64 ScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.max_results)
+  return max_results_;
+}
+inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
 }
 
 // -------------------------------------------------------------------
 
-// GetResponse
+// ScanResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool GetResponse::has_header() const {
+inline bool ScanResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void GetResponse::set_has_header() {
+inline void ScanResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void GetResponse::clear_has_header() {
+inline void ScanResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void GetResponse::clear_header() {
+inline void ScanResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& GetResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.header)
+inline const ::cockroach::proto::ResponseHeader& ScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* GetResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* ScanResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* GetResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* ScanResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void GetResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3775,89 +5941,76 @@ inline void GetResponse::set_allocated_header(::cockroach::proto::ResponseHeader
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.header)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool GetResponse::has_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void GetResponse::set_has_value() {
-  _has_bits_[0] |= 0x00000002u;
+// repeated .cockroach.proto.KeyValue rows = 2;
+inline int ScanResponse::rows_size() const {
+  return rows_.size();
 }
-inline void GetResponse::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ScanResponse::clear_rows() {
+  rows_.Clear();
 }
-inline void GetResponse::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline const ::cockroach::proto::KeyValue& ScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.rows)
+  return rows_.Get(index);
 }
-inline const ::cockroach::proto::Value& GetResponse::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline ::cockroach::proto::KeyValue* ScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.rows)
+  return rows_.Mutable(index);
 }
-inline ::cockroach::proto::Value* GetResponse::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::proto::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.value)
-  return value_;
+inline ::cockroach::proto::KeyValue* ScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanResponse.rows)
+  return rows_.Add();
 }
-inline ::cockroach::proto::Value* GetResponse::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+ScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanResponse.rows)
+  return rows_;
 }
-inline void GetResponse::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.value)
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+ScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanResponse.rows)
+  return &rows_;
 }
 
 // -------------------------------------------------------------------
 
-// PutRequest
+// ReverseScanRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool PutRequest::has_header() const {
+inline bool ReverseScanRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PutRequest::set_has_header() {
+inline void ReverseScanRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void PutRequest::clear_has_header() {
+inline void ReverseScanRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void PutRequest::clear_header() {
+inline void ReverseScanRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& PutRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.header)
+inline const ::cockroach::proto::RequestHeader& ReverseScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReverseScanRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* PutRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* ReverseScanRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReverseScanRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* PutRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* ReverseScanRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void PutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void ReverseScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3865,89 +6018,70 @@ inline void PutRequest::set_allocated_header(::cockroach::proto::RequestHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReverseScanRequest.header)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool PutRequest::has_value() const {
+// optional int64 max_results = 2;
+inline bool ReverseScanRequest::has_max_results() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void PutRequest::set_has_value() {
+inline void ReverseScanRequest::set_has_max_results() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void PutRequest::clear_has_value() {
+inline void ReverseScanRequest::clear_has_max_results() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void PutRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
-}
-inline const ::cockroach::proto::Value& PutRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
-}
-inline ::cockroach::proto::Value* PutRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::proto::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.value)
-  return value_;
-}
-inline ::cockroach::proto::Value* PutRequest::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline void ReverseScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
 }
-inline void PutRequest::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.value)
+inline ::google::protobuf::int64 ReverseScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReverseScanRequest.max_results)
+  return max_results_;
+}
+inline void ReverseScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ReverseScanRequest.max_results)
 }
 
 // -------------------------------------------------------------------
 
-// PutResponse
+// ReverseScanResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool PutResponse::has_header() const {
+inline bool ReverseScanResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PutResponse::set_has_header() {
+inline void ReverseScanResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void PutResponse::clear_has_header() {
+inline void ReverseScanResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void PutResponse::clear_header() {
+inline void ReverseScanResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& PutResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutResponse.header)
+inline const ::cockroach::proto::ResponseHeader& ReverseScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReverseScanResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* PutResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* ReverseScanResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReverseScanResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* PutResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* ReverseScanResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void PutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void ReverseScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3955,46 +6089,76 @@ inline void PutResponse::set_allocated_header(::cockroach::proto::ResponseHeader
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReverseScanResponse.header)
+}
+
+// repeated .cockroach.proto.KeyValue rows = 2;
+inline int ReverseScanResponse::rows_size() const {
+  return rows_.size();
+}
+inline void ReverseScanResponse::clear_rows() {
+  rows_.Clear();
+}
+inline const ::cockroach::proto::KeyValue& ReverseScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReverseScanResponse.rows)
+  return rows_.Get(index);
+}
+inline ::cockroach::proto::KeyValue* ReverseScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReverseScanResponse.rows)
+  return rows_.Mutable(index);
+}
+inline ::cockroach::proto::KeyValue* ReverseScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ReverseScanResponse.rows)
+  return rows_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+ReverseScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ReverseScanResponse.rows)
+  return rows_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+ReverseScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ReverseScanResponse.rows)
+  return &rows_;
 }
 
 // -------------------------------------------------------------------
 
-// ConditionalPutRequest
+// ChangesRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool ConditionalPutRequest::has_header() const {
+inline bool ChangesRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ConditionalPutRequest::set_has_header() {
+inline void ChangesRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ConditionalPutRequest::clear_has_header() {
+inline void ChangesRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ConditionalPutRequest::clear_header() {
+inline void ChangesRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& ConditionalPutRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.header)
+inline const ::cockroach::proto::RequestHeader& ChangesRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* ChangesRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* ChangesRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ConditionalPutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void ChangesRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4002,250 +6166,256 @@ inline void ConditionalPutRequest::set_allocated_header(::cockroach::proto::Requ
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangesRequest.header)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool ConditionalPutRequest::has_value() const {
+// optional .cockroach.proto.Timestamp since = 2;
+inline bool ChangesRequest::has_since() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ConditionalPutRequest::set_has_value() {
+inline void ChangesRequest::set_has_since() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ConditionalPutRequest::clear_has_value() {
+inline void ChangesRequest::clear_has_since() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ConditionalPutRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline void ChangesRequest::clear_since() {
+  if (since_ != NULL) since_->::cockroach::proto::Timestamp::Clear();
+  clear_has_since();
 }
-inline const ::cockroach::proto::Value& ConditionalPutRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline const ::cockroach::proto::Timestamp& ChangesRequest::since() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesRequest.since)
+  return since_ != NULL ? *since_ : *default_instance_->since_;
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::proto::Value;
+inline ::cockroach::proto::Timestamp* ChangesRequest::mutable_since() {
+  set_has_since();
+  if (since_ == NULL) {
+    since_ = new ::cockroach::proto::Timestamp;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.value)
-  return value_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesRequest.since)
+  return since_;
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
+inline ::cockroach::proto::Timestamp* ChangesRequest::release_since() {
+  clear_has_since();
+  ::cockroach::proto::Timestamp* temp = since_;
+  since_ = NULL;
   return temp;
 }
-inline void ConditionalPutRequest::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
+inline void ChangesRequest::set_allocated_since(::cockroach::proto::Timestamp* since) {
+  delete since_;
+  since_ = since;
+  if (since) {
+    set_has_since();
   } else {
-    clear_has_value();
+    clear_has_since();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.value)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangesRequest.since)
 }
 
-// optional .cockroach.proto.Value exp_value = 3;
-inline bool ConditionalPutRequest::has_exp_value() const {
+// optional int64 max_wait = 3;
+inline bool ChangesRequest::has_max_wait() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void ConditionalPutRequest::set_has_exp_value() {
+inline void ChangesRequest::set_has_max_wait() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void ConditionalPutRequest::clear_has_exp_value() {
+inline void ChangesRequest::clear_has_max_wait() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void ConditionalPutRequest::clear_exp_value() {
-  if (exp_value_ != NULL) exp_value_->::cockroach::proto::Value::Clear();
-  clear_has_exp_value();
-}
-inline const ::cockroach::proto::Value& ConditionalPutRequest::exp_value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.exp_value)
-  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
-}
-inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_exp_value() {
-  set_has_exp_value();
-  if (exp_value_ == NULL) {
-    exp_value_ = new ::cockroach::proto::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.exp_value)
-  return exp_value_;
+inline void ChangesRequest::clear_max_wait() {
+  max_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_max_wait();
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::release_exp_value() {
-  clear_has_exp_value();
-  ::cockroach::proto::Value* temp = exp_value_;
-  exp_value_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 ChangesRequest::max_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesRequest.max_wait)
+  return max_wait_;
 }
-inline void ConditionalPutRequest::set_allocated_exp_value(::cockroach::proto::Value* exp_value) {
-  delete exp_value_;
-  exp_value_ = exp_value;
-  if (exp_value) {
-    set_has_exp_value();
-  } else {
-    clear_has_exp_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.exp_value)
+inline void ChangesRequest::set_max_wait(::google::protobuf::int64 value) {
+  set_has_max_wait();
+  max_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ChangesRequest.max_wait)
 }
 
 // -------------------------------------------------------------------
 
-// ConditionalPutResponse
+// ChangeEvent
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool ConditionalPutResponse::has_header() const {
+// optional bytes key = 1;
+inline bool ChangeEvent::has_key() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ConditionalPutResponse::set_has_header() {
+inline void ChangeEvent::set_has_key() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ConditionalPutResponse::clear_has_header() {
+inline void ChangeEvent::clear_has_key() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ConditionalPutResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void ChangeEvent::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_key();
 }
-inline const ::cockroach::proto::ResponseHeader& ConditionalPutResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::std::string& ChangeEvent::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeEvent.key)
+  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::proto::ResponseHeader;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutResponse.header)
-  return header_;
+inline void ChangeEvent::set_key(const ::std::string& value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeEvent.key)
 }
-inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline void ChangeEvent::set_key(const char* value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.ChangeEvent.key)
 }
-inline void ConditionalPutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ChangeEvent::set_key(const void* value, size_t size) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.ChangeEvent.key)
+}
+inline ::std::string* ChangeEvent::mutable_key() {
+  set_has_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeEvent.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* ChangeEvent::release_key() {
+  clear_has_key();
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void ChangeEvent::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    set_has_key();
   } else {
-    clear_has_header();
+    clear_has_key();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutResponse.header)
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeEvent.key)
 }
 
-// -------------------------------------------------------------------
-
-// IncrementRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool IncrementRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.proto.Value value = 2;
+inline bool ChangeEvent::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void IncrementRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ChangeEvent::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void IncrementRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ChangeEvent::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void IncrementRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void ChangeEvent::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
 }
-inline const ::cockroach::proto::RequestHeader& IncrementRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::proto::Value& ChangeEvent::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeEvent.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline ::cockroach::proto::RequestHeader* IncrementRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::proto::RequestHeader;
+inline ::cockroach::proto::Value* ChangeEvent::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementRequest.header)
-  return header_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeEvent.value)
+  return value_;
 }
-inline ::cockroach::proto::RequestHeader* IncrementRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
+inline ::cockroach::proto::Value* ChangeEvent::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
   return temp;
 }
-inline void IncrementRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ChangeEvent::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
   } else {
-    clear_has_header();
+    clear_has_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeEvent.value)
 }
 
-// optional int64 increment = 2;
-inline bool IncrementRequest::has_increment() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .cockroach.proto.Timestamp timestamp = 3;
+inline bool ChangeEvent::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void IncrementRequest::set_has_increment() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ChangeEvent::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void IncrementRequest::clear_has_increment() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ChangeEvent::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void IncrementRequest::clear_increment() {
-  increment_ = GOOGLE_LONGLONG(0);
-  clear_has_increment();
+inline void ChangeEvent::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
 }
-inline ::google::protobuf::int64 IncrementRequest::increment() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.increment)
-  return increment_;
+inline const ::cockroach::proto::Timestamp& ChangeEvent::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeEvent.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
 }
-inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
-  set_has_increment();
-  increment_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementRequest.increment)
+inline ::cockroach::proto::Timestamp* ChangeEvent::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) {
+    timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeEvent.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ChangeEvent::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void ChangeEvent::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeEvent.timestamp)
 }
 
 // -------------------------------------------------------------------
 
-// IncrementResponse
+// ChangesResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool IncrementResponse::has_header() const {
+inline bool ChangesResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void IncrementResponse::set_has_header() {
+inline void ChangesResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void IncrementResponse::clear_has_header() {
+inline void ChangesResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void IncrementResponse::clear_header() {
+inline void ChangesResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& IncrementResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.header)
+inline const ::cockroach::proto::ResponseHeader& ChangesResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* IncrementResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* ChangesResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* IncrementResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* ChangesResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void IncrementResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void ChangesResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4253,70 +6423,119 @@ inline void IncrementResponse::set_allocated_header(::cockroach::proto::Response
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangesResponse.header)
 }
 
-// optional int64 new_value = 2;
-inline bool IncrementResponse::has_new_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// repeated .cockroach.proto.ChangeEvent events = 2;
+inline int ChangesResponse::events_size() const {
+  return events_.size();
 }
-inline void IncrementResponse::set_has_new_value() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ChangesResponse::clear_events() {
+  events_.Clear();
 }
-inline void IncrementResponse::clear_has_new_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline const ::cockroach::proto::ChangeEvent& ChangesResponse::events(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesResponse.events)
+  return events_.Get(index);
 }
-inline void IncrementResponse::clear_new_value() {
-  new_value_ = GOOGLE_LONGLONG(0);
-  clear_has_new_value();
+inline ::cockroach::proto::ChangeEvent* ChangesResponse::mutable_events(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesResponse.events)
+  return events_.Mutable(index);
 }
-inline ::google::protobuf::int64 IncrementResponse::new_value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.new_value)
-  return new_value_;
+inline ::cockroach::proto::ChangeEvent* ChangesResponse::add_events() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ChangesResponse.events)
+  return events_.Add();
 }
-inline void IncrementResponse::set_new_value(::google::protobuf::int64 value) {
-  set_has_new_value();
-  new_value_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementResponse.new_value)
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ChangeEvent >&
+ChangesResponse::events() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ChangesResponse.events)
+  return events_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ChangeEvent >*
+ChangesResponse::mutable_events() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ChangesResponse.events)
+  return &events_;
+}
+
+// optional .cockroach.proto.Timestamp resolved = 3;
+inline bool ChangesResponse::has_resolved() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ChangesResponse::set_has_resolved() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ChangesResponse::clear_has_resolved() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ChangesResponse::clear_resolved() {
+  if (resolved_ != NULL) resolved_->::cockroach::proto::Timestamp::Clear();
+  clear_has_resolved();
+}
+inline const ::cockroach::proto::Timestamp& ChangesResponse::resolved() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangesResponse.resolved)
+  return resolved_ != NULL ? *resolved_ : *default_instance_->resolved_;
+}
+inline ::cockroach::proto::Timestamp* ChangesResponse::mutable_resolved() {
+  set_has_resolved();
+  if (resolved_ == NULL) {
+    resolved_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangesResponse.resolved)
+  return resolved_;
+}
+inline ::cockroach::proto::Timestamp* ChangesResponse::release_resolved() {
+  clear_has_resolved();
+  ::cockroach::proto::Timestamp* temp = resolved_;
+  resolved_ = NULL;
+  return temp;
+}
+inline void ChangesResponse::set_allocated_resolved(::cockroach::proto::Timestamp* resolved) {
+  delete resolved_;
+  resolved_ = resolved;
+  if (resolved) {
+    set_has_resolved();
+  } else {
+    clear_has_resolved();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangesResponse.resolved)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRequest
+// EndTransactionRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool DeleteRequest::has_header() const {
+inline bool EndTransactionRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRequest::set_has_header() {
+inline void EndTransactionRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRequest::clear_has_header() {
+inline void EndTransactionRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRequest::clear_header() {
+inline void EndTransactionRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& DeleteRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRequest.header)
+inline const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* DeleteRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* DeleteRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4324,93 +6543,113 @@ inline void DeleteRequest::set_allocated_header(::cockroach::proto::RequestHeade
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
 }
 
-// -------------------------------------------------------------------
-
-// DeleteResponse
+// optional bool commit = 2;
+inline bool EndTransactionRequest::has_commit() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void EndTransactionRequest::set_has_commit() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void EndTransactionRequest::clear_has_commit() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void EndTransactionRequest::clear_commit() {
+  commit_ = false;
+  clear_has_commit();
+}
+inline bool EndTransactionRequest::commit() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
+  return commit_;
+}
+inline void EndTransactionRequest::set_commit(bool value) {
+  set_has_commit();
+  commit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
+}
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool DeleteResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+inline bool EndTransactionRequest::has_internal_commit_trigger() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void DeleteResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void EndTransactionRequest::set_has_internal_commit_trigger() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void DeleteResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void DeleteResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void EndTransactionRequest::clear_internal_commit_trigger() {
+  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
+  clear_has_internal_commit_trigger();
 }
-inline const ::cockroach::proto::ResponseHeader& DeleteResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
 }
-inline ::cockroach::proto::ResponseHeader* DeleteResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::proto::ResponseHeader;
+inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
+  set_has_internal_commit_trigger();
+  if (internal_commit_trigger_ == NULL) {
+    internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteResponse.header)
-  return header_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_;
 }
-inline ::cockroach::proto::ResponseHeader* DeleteResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
+  clear_has_internal_commit_trigger();
+  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
+  internal_commit_trigger_ = NULL;
   return temp;
 }
-inline void DeleteResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
+  delete internal_commit_trigger_;
+  internal_commit_trigger_ = internal_commit_trigger;
+  if (internal_commit_trigger) {
+    set_has_internal_commit_trigger();
   } else {
-    clear_has_header();
+    clear_has_internal_commit_trigger();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRangeRequest
+// EndTransactionResponse
 
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool DeleteRangeRequest::has_header() const {
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool EndTransactionResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRangeRequest::set_has_header() {
+inline void EndTransactionResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRangeRequest::clear_has_header() {
+inline void EndTransactionResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRangeRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+inline void EndTransactionResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& DeleteRangeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.header)
+inline const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::proto::RequestHeader;
+    header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::release_header() {
+inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
   clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
+  ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRangeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4418,141 +6657,124 @@ inline void DeleteRangeRequest::set_allocated_header(::cockroach::proto::Request
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
 }
 
-// optional int64 max_entries_to_delete = 2;
-inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
+// optional int64 commit_wait = 2;
+inline bool EndTransactionResponse::has_commit_wait() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
+inline void EndTransactionResponse::set_has_commit_wait() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
+inline void EndTransactionResponse::clear_has_commit_wait() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void DeleteRangeRequest::clear_max_entries_to_delete() {
-  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
-  clear_has_max_entries_to_delete();
+inline void EndTransactionResponse::clear_commit_wait() {
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_commit_wait();
 }
-inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
-  return max_entries_to_delete_;
+inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
+  return commit_wait_;
 }
-inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
-  set_has_max_entries_to_delete();
-  max_entries_to_delete_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
+inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
+  set_has_commit_wait();
+  commit_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
 }
 
-// -------------------------------------------------------------------
-
-// DeleteRangeResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool DeleteRangeResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void DeleteRangeResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void DeleteRangeResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+// repeated bytes resolved = 3;
+inline int EndTransactionResponse::resolved_size() const {
+  return resolved_.size();
 }
-inline void DeleteRangeResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void EndTransactionResponse::clear_resolved() {
+  resolved_.Clear();
 }
-inline const ::cockroach::proto::ResponseHeader& DeleteRangeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::std::string& EndTransactionResponse::resolved(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Get(index);
 }
-inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::proto::ResponseHeader;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeResponse.header)
-  return header_;
+inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Mutable(index);
 }
-inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
+  resolved_.Mutable(index)->assign(value);
 }
-inline void DeleteRangeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeResponse.header)
+inline void EndTransactionResponse::set_resolved(int index, const char* value) {
+  resolved_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
 }
-
-// optional int64 num_deleted = 2;
-inline bool DeleteRangeResponse::has_num_deleted() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
+  resolved_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
 }
-inline void DeleteRangeResponse::set_has_num_deleted() {
-  _has_bits_[0] |= 0x00000002u;
+inline ::std::string* EndTransactionResponse::add_resolved() {
+  return resolved_.Add();
 }
-inline void DeleteRangeResponse::clear_has_num_deleted() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
 }
-inline void DeleteRangeResponse::clear_num_deleted() {
-  num_deleted_ = GOOGLE_LONGLONG(0);
-  clear_has_num_deleted();
+inline void EndTransactionResponse::add_resolved(const char* value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
 }
-inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.num_deleted)
-  return num_deleted_;
+inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
+  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
 }
-inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
-  set_has_num_deleted();
-  num_deleted_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeResponse.num_deleted)
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+EndTransactionResponse::resolved() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+EndTransactionResponse::mutable_resolved() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
+  return &resolved_;
 }
 
 // -------------------------------------------------------------------
 
-// ScanRequest
+// ReapQueueRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool ScanRequest::has_header() const {
+inline bool ReapQueueRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ScanRequest::set_has_header() {
+inline void ReapQueueRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ScanRequest::clear_has_header() {
+inline void ReapQueueRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ScanRequest::clear_header() {
+inline void ReapQueueRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& ScanRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.header)
+inline const ::cockroach::proto::RequestHeader& ReapQueueRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReapQueueRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* ScanRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* ReapQueueRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReapQueueRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* ScanRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* ReapQueueRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void ReapQueueRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4560,70 +6782,70 @@ inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReapQueueRequest.header)
 }
 
 // optional int64 max_results = 2;
-inline bool ScanRequest::has_max_results() const {
+inline bool ReapQueueRequest::has_max_results() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ScanRequest::set_has_max_results() {
+inline void ReapQueueRequest::set_has_max_results() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ScanRequest::clear_has_max_results() {
+inline void ReapQueueRequest::clear_has_max_results() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ScanRequest::clear_max_results() {
+inline void ReapQueueRequest::clear_max_results() {
   max_results_ = GOOGLE_LONGLONG(0);
   clear_has_max_results();
 }
-inline ::google::protobuf::int64 ScanRequest::max_results() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.max_results)
+inline ::google::protobuf::int64 ReapQueueRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReapQueueRequest.max_results)
   return max_results_;
 }
-inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
+inline void ReapQueueRequest::set_max_results(::google::protobuf::int64 value) {
   set_has_max_results();
   max_results_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
+  // @@protoc_insertion_point(field_set:cockroach.proto.ReapQueueRequest.max_results)
 }
 
 // -------------------------------------------------------------------
 
-// ScanResponse
+// ReapQueueResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool ScanResponse::has_header() const {
+inline bool ReapQueueResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ScanResponse::set_has_header() {
+inline void ReapQueueResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ScanResponse::clear_has_header() {
+inline void ReapQueueResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ScanResponse::clear_header() {
+inline void ReapQueueResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& ScanResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.header)
+inline const ::cockroach::proto::ResponseHeader& ReapQueueResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReapQueueResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* ScanResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* ReapQueueResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReapQueueResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* ScanResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* ReapQueueResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void ReapQueueResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4631,76 +6853,76 @@ inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeade
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReapQueueResponse.header)
 }
 
-// repeated .cockroach.proto.KeyValue rows = 2;
-inline int ScanResponse::rows_size() const {
-  return rows_.size();
+// repeated .cockroach.proto.KeyValue messages = 2;
+inline int ReapQueueResponse::messages_size() const {
+  return messages_.size();
 }
-inline void ScanResponse::clear_rows() {
-  rows_.Clear();
+inline void ReapQueueResponse::clear_messages() {
+  messages_.Clear();
 }
-inline const ::cockroach::proto::KeyValue& ScanResponse::rows(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.rows)
-  return rows_.Get(index);
+inline const ::cockroach::proto::KeyValue& ReapQueueResponse::messages(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReapQueueResponse.messages)
+  return messages_.Get(index);
 }
-inline ::cockroach::proto::KeyValue* ScanResponse::mutable_rows(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.rows)
-  return rows_.Mutable(index);
+inline ::cockroach::proto::KeyValue* ReapQueueResponse::mutable_messages(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReapQueueResponse.messages)
+  return messages_.Mutable(index);
 }
-inline ::cockroach::proto::KeyValue* ScanResponse::add_rows() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.ScanResponse.rows)
-  return rows_.Add();
+inline ::cockroach::proto::KeyValue* ReapQueueResponse::add_messages() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ReapQueueResponse.messages)
+  return messages_.Add();
 }
 inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
-ScanResponse::rows() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.ScanResponse.rows)
-  return rows_;
+ReapQueueResponse::messages() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ReapQueueResponse.messages)
+  return messages_;
 }
 inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
-ScanResponse::mutable_rows() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanResponse.rows)
-  return &rows_;
+ReapQueueResponse::mutable_messages() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ReapQueueResponse.messages)
+  return &messages_;
 }
 
 // -------------------------------------------------------------------
 
-// EndTransactionRequest
+// EnqueueUpdateRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool EndTransactionRequest::has_header() const {
+inline bool EnqueueUpdateRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionRequest::set_has_header() {
+inline void EnqueueUpdateRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionRequest::clear_has_header() {
+inline void EnqueueUpdateRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionRequest::clear_header() {
+inline void EnqueueUpdateRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
+inline const ::cockroach::proto::RequestHeader& EnqueueUpdateRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueUpdateRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* EnqueueUpdateRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueUpdateRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* EnqueueUpdateRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void EnqueueUpdateRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4708,113 +6930,136 @@ inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::Requ
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueUpdateRequest.header)
 }
 
-// optional bool commit = 2;
-inline bool EndTransactionRequest::has_commit() const {
+// optional .cockroach.proto.BatchRequest update = 2;
+inline bool EnqueueUpdateRequest::has_update() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void EndTransactionRequest::set_has_commit() {
+inline void EnqueueUpdateRequest::set_has_update() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void EndTransactionRequest::clear_has_commit() {
+inline void EnqueueUpdateRequest::clear_has_update() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void EndTransactionRequest::clear_commit() {
-  commit_ = false;
-  clear_has_commit();
+inline void EnqueueUpdateRequest::clear_update() {
+  if (update_ != NULL) update_->::cockroach::proto::BatchRequest::Clear();
+  clear_has_update();
 }
-inline bool EndTransactionRequest::commit() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
-  return commit_;
+inline const ::cockroach::proto::BatchRequest& EnqueueUpdateRequest::update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueUpdateRequest.update)
+  return update_ != NULL ? *update_ : *default_instance_->update_;
 }
-inline void EndTransactionRequest::set_commit(bool value) {
-  set_has_commit();
-  commit_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
+inline ::cockroach::proto::BatchRequest* EnqueueUpdateRequest::mutable_update() {
+  set_has_update();
+  if (update_ == NULL) {
+    update_ = new ::cockroach::proto::BatchRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueUpdateRequest.update)
+  return update_;
+}
+inline ::cockroach::proto::BatchRequest* EnqueueUpdateRequest::release_update() {
+  clear_has_update();
+  ::cockroach::proto::BatchRequest* temp = update_;
+  update_ = NULL;
+  return temp;
+}
+inline void EnqueueUpdateRequest::set_allocated_update(::cockroach::proto::BatchRequest* update) {
+  delete update_;
+  update_ = update;
+  if (update) {
+    set_has_update();
+  } else {
+    clear_has_update();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueUpdateRequest.update)
 }
 
-// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-inline bool EndTransactionRequest::has_internal_commit_trigger() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// -------------------------------------------------------------------
+
+// EnqueueUpdateResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool EnqueueUpdateResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionRequest::set_has_internal_commit_trigger() {
-  _has_bits_[0] |= 0x00000004u;
+inline void EnqueueUpdateResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void EnqueueUpdateResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionRequest::clear_internal_commit_trigger() {
-  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
-  clear_has_internal_commit_trigger();
+inline void EnqueueUpdateResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
+inline const ::cockroach::proto::ResponseHeader& EnqueueUpdateResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueUpdateResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
-  set_has_internal_commit_trigger();
-  if (internal_commit_trigger_ == NULL) {
-    internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
+inline ::cockroach::proto::ResponseHeader* EnqueueUpdateResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueUpdateResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
-  clear_has_internal_commit_trigger();
-  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
-  internal_commit_trigger_ = NULL;
+inline ::cockroach::proto::ResponseHeader* EnqueueUpdateResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
-  delete internal_commit_trigger_;
-  internal_commit_trigger_ = internal_commit_trigger;
-  if (internal_commit_trigger) {
-    set_has_internal_commit_trigger();
+inline void EnqueueUpdateResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_internal_commit_trigger();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueUpdateResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// EndTransactionResponse
+// EnqueueMessageRequest
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool EndTransactionResponse::has_header() const {
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool EnqueueMessageRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionResponse::set_has_header() {
+inline void EnqueueMessageRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionResponse::clear_has_header() {
+inline void EnqueueMessageRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+inline void EnqueueMessageRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
+inline const ::cockroach::proto::RequestHeader& EnqueueMessageRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueMessageRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
+inline ::cockroach::proto::RequestHeader* EnqueueMessageRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
-    header_ = new ::cockroach::proto::ResponseHeader;
+    header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueMessageRequest.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
+inline ::cockroach::proto::RequestHeader* EnqueueMessageRequest::release_header() {
   clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
+  ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void EnqueueMessageRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4822,85 +7067,97 @@ inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::Res
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueMessageRequest.header)
 }
 
-// optional int64 commit_wait = 2;
-inline bool EndTransactionResponse::has_commit_wait() const {
+// optional .cockroach.proto.Value msg = 2;
+inline bool EnqueueMessageRequest::has_msg() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void EndTransactionResponse::set_has_commit_wait() {
+inline void EnqueueMessageRequest::set_has_msg() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void EndTransactionResponse::clear_has_commit_wait() {
+inline void EnqueueMessageRequest::clear_has_msg() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void EndTransactionResponse::clear_commit_wait() {
-  commit_wait_ = GOOGLE_LONGLONG(0);
-  clear_has_commit_wait();
-}
-inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
-  return commit_wait_;
-}
-inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
-  set_has_commit_wait();
-  commit_wait_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
-}
-
-// repeated bytes resolved = 3;
-inline int EndTransactionResponse::resolved_size() const {
-  return resolved_.size();
+inline void EnqueueMessageRequest::clear_msg() {
+  if (msg_ != NULL) msg_->::cockroach::proto::Value::Clear();
+  clear_has_msg();
 }
-inline void EndTransactionResponse::clear_resolved() {
-  resolved_.Clear();
+inline const ::cockroach::proto::Value& EnqueueMessageRequest::msg() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueMessageRequest.msg)
+  return msg_ != NULL ? *msg_ : *default_instance_->msg_;
 }
-inline const ::std::string& EndTransactionResponse::resolved(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Get(index);
+inline ::cockroach::proto::Value* EnqueueMessageRequest::mutable_msg() {
+  set_has_msg();
+  if (msg_ == NULL) {
+    msg_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueMessageRequest.msg)
+  return msg_;
 }
-inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Mutable(index);
+inline ::cockroach::proto::Value* EnqueueMessageRequest::release_msg() {
+  clear_has_msg();
+  ::cockroach::proto::Value* temp = msg_;
+  msg_ = NULL;
+  return temp;
 }
-inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
-  resolved_.Mutable(index)->assign(value);
+inline void EnqueueMessageRequest::set_allocated_msg(::cockroach::proto::Value* msg) {
+  delete msg_;
+  msg_ = msg;
+  if (msg) {
+    set_has_msg();
+  } else {
+    clear_has_msg();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueMessageRequest.msg)
 }
-inline void EndTransactionResponse::set_resolved(int index, const char* value) {
-  resolved_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
+
+// -------------------------------------------------------------------
+
+// EnqueueMessageResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool EnqueueMessageResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
-  resolved_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
+inline void EnqueueMessageResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline ::std::string* EndTransactionResponse::add_resolved() {
-  return resolved_.Add();
+inline void EnqueueMessageResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
+inline void EnqueueMessageResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline void EndTransactionResponse::add_resolved(const char* value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
+inline const ::cockroach::proto::ResponseHeader& EnqueueMessageResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EnqueueMessageResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
-  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
+inline ::cockroach::proto::ResponseHeader* EnqueueMessageResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EnqueueMessageResponse.header)
+  return header_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
-EndTransactionResponse::resolved() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_;
+inline ::cockroach::proto::ResponseHeader* EnqueueMessageResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline ::google::protobuf::RepeatedPtrField< ::std::string>*
-EndTransactionResponse::mutable_resolved() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
-  return &resolved_;
+inline void EnqueueMessageResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EnqueueMessageResponse.header)
 }
 
 // -------------------------------------------------------------------
@@ -5275,6 +7532,236 @@ inline void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndT
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.end_transaction)
 }
 
+// optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+inline bool RequestUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+inline void RequestUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+inline void RequestUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReverseScanRequest& RequestUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanRequest::default_instance();
+}
+inline ::cockroach::proto::ReverseScanRequest* RequestUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+inline ::cockroach::proto::ReverseScanRequest* RequestUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanRequest* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+inline bool RequestUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+inline void RequestUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+inline void RequestUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReapQueueRequest& RequestUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueRequest::default_instance();
+}
+inline ::cockroach::proto::ReapQueueRequest* RequestUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.reap_queue)
+  return value_.reap_queue_;
+}
+inline ::cockroach::proto::ReapQueueRequest* RequestUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueRequest* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+inline bool RequestUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+inline void RequestUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+inline void RequestUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueUpdateRequest& RequestUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateRequest::default_instance();
+}
+inline ::cockroach::proto::EnqueueUpdateRequest* RequestUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+inline ::cockroach::proto::EnqueueUpdateRequest* RequestUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateRequest* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+inline bool RequestUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+inline void RequestUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+inline void RequestUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueMessageRequest& RequestUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageRequest::default_instance();
+}
+inline ::cockroach::proto::EnqueueMessageRequest* RequestUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.enqueue_message)
+  return value_.enqueue_message_;
+}
+inline ::cockroach::proto::EnqueueMessageRequest* RequestUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageRequest* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.enqueue_message)
+}
+
+// optional .cockroach.proto.ChangesRequest changes = 14;
+inline bool RequestUnion::has_changes() const {
+  return value_case() == kChanges;
+}
+inline void RequestUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
+}
+inline void RequestUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ChangesRequest& RequestUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesRequest::default_instance();
+}
+inline ::cockroach::proto::ChangesRequest* RequestUnion::mutable_changes() {
+  if (!has_changes()) {
+    clear_value();
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.changes)
+  return value_.changes_;
+}
+inline ::cockroach::proto::ChangesRequest* RequestUnion::release_changes() {
+  if (has_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ChangesRequest* temp = value_.changes_;
+    value_.changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_changes(::cockroach::proto::ChangesRequest* changes) {
+  clear_value();
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.changes)
+}
+
 inline bool RequestUnion::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -5656,6 +8143,236 @@ inline void ResponseUnion::set_allocated_end_transaction(::cockroach::proto::End
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.end_transaction)
 }
 
+// optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+inline bool ResponseUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+inline void ResponseUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+inline void ResponseUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReverseScanResponse& ResponseUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanResponse::default_instance();
+}
+inline ::cockroach::proto::ReverseScanResponse* ResponseUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+inline ::cockroach::proto::ReverseScanResponse* ResponseUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanResponse* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanResponse* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+inline bool ResponseUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+inline void ResponseUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+inline void ResponseUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReapQueueResponse& ResponseUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueResponse::default_instance();
+}
+inline ::cockroach::proto::ReapQueueResponse* ResponseUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.reap_queue)
+  return value_.reap_queue_;
+}
+inline ::cockroach::proto::ReapQueueResponse* ResponseUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueResponse* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+inline bool ResponseUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+inline void ResponseUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+inline void ResponseUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueUpdateResponse& ResponseUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateResponse::default_instance();
+}
+inline ::cockroach::proto::EnqueueUpdateResponse* ResponseUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+inline ::cockroach::proto::EnqueueUpdateResponse* ResponseUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateResponse* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+inline bool ResponseUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+inline void ResponseUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+inline void ResponseUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueMessageResponse& ResponseUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageResponse::default_instance();
+}
+inline ::cockroach::proto::EnqueueMessageResponse* ResponseUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.enqueue_message)
+  return value_.enqueue_message_;
+}
+inline ::cockroach::proto::EnqueueMessageResponse* ResponseUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageResponse* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.enqueue_message)
+}
+
+// optional .cockroach.proto.ChangesResponse changes = 14;
+inline bool ResponseUnion::has_changes() const {
+  return value_case() == kChanges;
+}
+inline void ResponseUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
+}
+inline void ResponseUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ChangesResponse& ResponseUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesResponse::default_instance();
+}
+inline ::cockroach::proto::ChangesResponse* ResponseUnion::mutable_changes() {
+  if (!has_changes()) {
+    clear_value();
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.changes)
+  return value_.changes_;
+}
+inline ::cockroach::proto::ChangesResponse* ResponseUnion::release_changes() {
+  if (has_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ChangesResponse* temp = value_.changes_;
+    value_.changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_changes(::cockroach::proto::ChangesResponse* changes) {
+  clear_value();
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.changes)
+}
+
 inline bool ResponseUnion::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -6113,6 +8830,28 @@ inline void AdminMergeResponse::set_allocated_header(::cockroach::proto::Respons
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -93,11 +93,12 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Timestamp, _internal_metadata_),
       -1);
   Value_descriptor_ = file->message_type(1);
-  static const int Value_offsets_[4] = {
+  static const int Value_offsets_[5] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, checksum_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, tag_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, expiration_),
   };
   Value_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -243,7 +244,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeList, _internal_metadata_),
       -1);
   Transaction_descriptor_ = file->message_type(10);
-  static const int Transaction_offsets_[12] = {
+  static const int Transaction_offsets_[13] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, name_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, key_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, id_),
@@ -256,6 +257,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, orig_timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, max_timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, certain_nodes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, sequence_),
   };
   Transaction_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -302,9 +304,10 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Intent, _internal_metadata_),
       -1);
   GCMetadata_descriptor_ = file->message_type(13);
-  static const int GCMetadata_offsets_[2] = {
+  static const int GCMetadata_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, last_scan_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, oldest_intent_nanos_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, next_expiration_nanos_),
   };
   GCMetadata_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -408,62 +411,65 @@ void protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto() {
     "proto\032\034cockroach/proto/config.proto\032\024gog"
     "oproto/gogo.proto\"A\n\tTimestamp\022\027\n\twall_t"
     "ime\030\001 \001(\003B\004\310\336\037\000\022\025\n\007logical\030\002 \001(\005B\004\310\336\037\000:\004"
-    "\230\240\037\000\"d\n\005Value\022\r\n\005bytes\030\001 \001(\014\022\020\n\010checksum"
-    "\030\003 \001(\007\022-\n\ttimestamp\030\004 \001(\0132\032.cockroach.pr"
-    "oto.Timestamp\022\013\n\003tag\030\005 \001(\t\"M\n\010KeyValue\022\024"
-    "\n\003key\030\001 \001(\014B\007\372\336\037\003Key\022+\n\005value\030\002 \001(\0132\026.co"
-    "ckroach.proto.ValueB\004\310\336\037\000\"9\n\013RawKeyValue"
-    "\022\033\n\003key\030\001 \001(\014B\016\372\336\037\nEncodedKey\022\r\n\005value\030\002"
-    " \001(\014\"\214\001\n\nStoreIdent\022%\n\ncluster_id\030\001 \001(\tB"
-    "\021\310\336\037\000\342\336\037\tClusterID\022)\n\007node_id\030\002 \001(\005B\030\310\336\037"
-    "\000\342\336\037\006NodeID\372\336\037\006NodeID\022,\n\010store_id\030\003 \001(\005B"
-    "\032\310\336\037\000\342\336\037\007StoreID\372\336\037\007StoreID\"\206\001\n\014SplitTri"
-    "gger\022<\n\014updated_desc\030\001 \001(\0132 .cockroach.p"
-    "roto.RangeDescriptorB\004\310\336\037\000\0228\n\010new_desc\030\002"
-    " \001(\0132 .cockroach.proto.RangeDescriptorB\004"
-    "\310\336\037\000\"\210\001\n\014MergeTrigger\022<\n\014updated_desc\030\001 "
-    "\001(\0132 .cockroach.proto.RangeDescriptorB\004\310"
-    "\336\037\000\022:\n\020subsumed_raft_id\030\002 \001(\003B \310\336\037\000\342\336\037\016S"
-    "ubsumedRaftID\372\336\037\006RaftID\"\351\001\n\025ChangeReplic"
-    "asTrigger\022)\n\007node_id\030\001 \001(\005B\030\310\336\037\000\342\336\037\006Node"
-    "ID\372\336\037\006NodeID\022,\n\010store_id\030\002 \001(\005B\032\310\336\037\000\342\336\037\007"
-    "StoreID\372\336\037\007StoreID\022=\n\013change_type\030\003 \001(\0162"
-    "\".cockroach.proto.ReplicaChangeTypeB\004\310\336\037"
-    "\000\0228\n\020updated_replicas\030\004 \003(\0132\030.cockroach."
-    "proto.ReplicaB\004\310\336\037\000\"\346\001\n\025InternalCommitTr"
-    "igger\0224\n\rsplit_trigger\030\001 \001(\0132\035.cockroach"
-    ".proto.SplitTrigger\0224\n\rmerge_trigger\030\002 \001"
-    "(\0132\035.cockroach.proto.MergeTrigger\022G\n\027cha"
-    "nge_replicas_trigger\030\003 \001(\0132&.cockroach.p"
-    "roto.ChangeReplicasTrigger\022\030\n\007intents\030\004 "
-    "\003(\014B\007\372\336\037\003Key\"\035\n\010NodeList\022\021\n\005nodes\030\001 \003(\005B"
-    "\002\020\001\"\205\004\n\013Transaction\022\022\n\004name\030\001 \001(\tB\004\310\336\037\000\022"
-    "\024\n\003key\030\002 \001(\014B\007\372\336\037\003Key\022\022\n\002id\030\003 \001(\014B\006\342\336\037\002I"
-    "D\022\026\n\010priority\030\004 \001(\005B\004\310\336\037\000\0227\n\tisolation\030\005"
-    " \001(\0162\036.cockroach.proto.IsolationTypeB\004\310\336"
-    "\037\000\0228\n\006status\030\006 \001(\0162\".cockroach.proto.Tra"
-    "nsactionStatusB\004\310\336\037\000\022\023\n\005epoch\030\007 \001(\005B\004\310\336\037"
-    "\000\0222\n\016last_heartbeat\030\010 \001(\0132\032.cockroach.pr"
-    "oto.Timestamp\0223\n\ttimestamp\030\t \001(\0132\032.cockr"
-    "oach.proto.TimestampB\004\310\336\037\000\0228\n\016orig_times"
-    "tamp\030\n \001(\0132\032.cockroach.proto.TimestampB\004"
-    "\310\336\037\000\0227\n\rmax_timestamp\030\013 \001(\0132\032.cockroach."
-    "proto.TimestampB\004\310\336\037\000\0226\n\rcertain_nodes\030\014"
-    " \001(\0132\031.cockroach.proto.NodeListB\004\310\336\037\000:\004\230"
-    "\240\037\000\"\254\001\n\005Lease\022/\n\005start\030\001 \001(\0132\032.cockroach"
-    ".proto.TimestampB\004\310\336\037\000\0224\n\nexpiration\030\002 \001"
-    "(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\0226\n\014"
-    "raft_node_id\030\003 \001(\004B \310\336\037\000\342\336\037\nRaftNodeID\372\336"
-    "\037\nRaftNodeID:\004\230\240\037\000\"O\n\006Intent\022\024\n\003key\030\001 \001("
-    "\014B\007\372\336\037\003Key\022/\n\003txn\030\002 \001(\0132\034.cockroach.prot"
-    "o.TransactionB\004\310\336\037\000\"H\n\nGCMetadata\022\035\n\017las"
-    "t_scan_nanos\030\001 \001(\003B\004\310\336\037\000\022\033\n\023oldest_inten"
-    "t_nanos\030\002 \001(\003*>\n\021ReplicaChangeType\022\017\n\013AD"
-    "D_REPLICA\020\000\022\022\n\016REMOVE_REPLICA\020\001\032\004\210\243\036\000*5\n"
-    "\rIsolationType\022\020\n\014SERIALIZABLE\020\000\022\014\n\010SNAP"
-    "SHOT\020\001\032\004\210\243\036\000*B\n\021TransactionStatus\022\013\n\007PEN"
-    "DING\020\000\022\r\n\tCOMMITTED\020\001\022\013\n\007ABORTED\020\002\032\004\210\243\036\000"
-    "B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 2381);
+    "\230\240\037\000\"\224\001\n\005Value\022\r\n\005bytes\030\001 \001(\014\022\020\n\010checksu"
+    "m\030\003 \001(\007\022-\n\ttimestamp\030\004 \001(\0132\032.cockroach.p"
+    "roto.Timestamp\022\013\n\003tag\030\005 \001(\t\022.\n\nexpiratio"
+    "n\030\006 \001(\0132\032.cockroach.proto.Timestamp\"M\n\010K"
+    "eyValue\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003Key\022+\n\005value\030\002"
+    " \001(\0132\026.cockroach.proto.ValueB\004\310\336\037\000\"9\n\013Ra"
+    "wKeyValue\022\033\n\003key\030\001 \001(\014B\016\372\336\037\nEncodedKey\022\r"
+    "\n\005value\030\002 \001(\014\"\214\001\n\nStoreIdent\022%\n\ncluster_"
+    "id\030\001 \001(\tB\021\310\336\037\000\342\336\037\tClusterID\022)\n\007node_id\030\002"
+    " \001(\005B\030\310\336\037\000\342\336\037\006NodeID\372\336\037\006NodeID\022,\n\010store_"
+    "id\030\003 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\372\336\037\007StoreID\"\206\001\n"
+    "\014SplitTrigger\022<\n\014updated_desc\030\001 \001(\0132 .co"
+    "ckroach.proto.RangeDescriptorB\004\310\336\037\000\0228\n\010n"
+    "ew_desc\030\002 \001(\0132 .cockroach.proto.RangeDes"
+    "criptorB\004\310\336\037\000\"\210\001\n\014MergeTrigger\022<\n\014update"
+    "d_desc\030\001 \001(\0132 .cockroach.proto.RangeDesc"
+    "riptorB\004\310\336\037\000\022:\n\020subsumed_raft_id\030\002 \001(\003B "
+    "\310\336\037\000\342\336\037\016SubsumedRaftID\372\336\037\006RaftID\"\351\001\n\025Cha"
+    "ngeReplicasTrigger\022)\n\007node_id\030\001 \001(\005B\030\310\336\037"
+    "\000\342\336\037\006NodeID\372\336\037\006NodeID\022,\n\010store_id\030\002 \001(\005B"
+    "\032\310\336\037\000\342\336\037\007StoreID\372\336\037\007StoreID\022=\n\013change_ty"
+    "pe\030\003 \001(\0162\".cockroach.proto.ReplicaChange"
+    "TypeB\004\310\336\037\000\0228\n\020updated_replicas\030\004 \003(\0132\030.c"
+    "ockroach.proto.ReplicaB\004\310\336\037\000\"\346\001\n\025Interna"
+    "lCommitTrigger\0224\n\rsplit_trigger\030\001 \001(\0132\035."
+    "cockroach.proto.SplitTrigger\0224\n\rmerge_tr"
+    "igger\030\002 \001(\0132\035.cockroach.proto.MergeTrigg"
+    "er\022G\n\027change_replicas_trigger\030\003 \001(\0132&.co"
+    "ckroach.proto.ChangeReplicasTrigger\022\030\n\007i"
+    "ntents\030\004 \003(\014B\007\372\336\037\003Key\"\035\n\010NodeList\022\021\n\005nod"
+    "es\030\001 \003(\005B\002\020\001\"\235\004\n\013Transaction\022\022\n\004name\030\001 \001"
+    "(\tB\004\310\336\037\000\022\024\n\003key\030\002 \001(\014B\007\372\336\037\003Key\022\022\n\002id\030\003 \001"
+    "(\014B\006\342\336\037\002ID\022\026\n\010priority\030\004 \001(\005B\004\310\336\037\000\0227\n\tis"
+    "olation\030\005 \001(\0162\036.cockroach.proto.Isolatio"
+    "nTypeB\004\310\336\037\000\0228\n\006status\030\006 \001(\0162\".cockroach."
+    "proto.TransactionStatusB\004\310\336\037\000\022\023\n\005epoch\030\007"
+    " \001(\005B\004\310\336\037\000\0222\n\016last_heartbeat\030\010 \001(\0132\032.coc"
+    "kroach.proto.Timestamp\0223\n\ttimestamp\030\t \001("
+    "\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\0228\n\016o"
+    "rig_timestamp\030\n \001(\0132\032.cockroach.proto.Ti"
+    "mestampB\004\310\336\037\000\0227\n\rmax_timestamp\030\013 \001(\0132\032.c"
+    "ockroach.proto.TimestampB\004\310\336\037\000\0226\n\rcertai"
+    "n_nodes\030\014 \001(\0132\031.cockroach.proto.NodeList"
+    "B\004\310\336\037\000\022\026\n\010sequence\030\r \001(\005B\004\310\336\037\000:\004\230\240\037\000\"\254\001\n"
+    "\005Lease\022/\n\005start\030\001 \001(\0132\032.cockroach.proto."
+    "TimestampB\004\310\336\037\000\0224\n\nexpiration\030\002 \001(\0132\032.co"
+    "ckroach.proto.TimestampB\004\310\336\037\000\0226\n\014raft_no"
+    "de_id\030\003 \001(\004B \310\336\037\000\342\336\037\nRaftNodeID\372\336\037\nRaftN"
+    "odeID:\004\230\240\037\000\"O\n\006Intent\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003"
+    "Key\022/\n\003txn\030\002 \001(\0132\034.cockroach.proto.Trans"
+    "actionB\004\310\336\037\000\"g\n\nGCMetadata\022\035\n\017last_scan_"
+    "nanos\030\001 \001(\003B\004\310\336\037\000\022\033\n\023oldest_intent_nanos"
+    "\030\002 \001(\003\022\035\n\025next_expiration_nanos\030\003 \001(\003*>\n"
+    "\021ReplicaChangeType\022\017\n\013ADD_REPLICA\020\000\022\022\n\016R"
+    "EMOVE_REPLICA\020\001\032\004\210\243\036\000*5\n\rIsolationType\022\020"
+    "\n\014SERIALIZABLE\020\000\022\014\n\010SNAPSHOT\020\001\032\004\210\243\036\000*B\n\021"
+    "TransactionStatus\022\013\n\007PENDING\020\000\022\r\n\tCOMMIT"
+    "TED\020\001\022\013\n\007ABORTED\020\002\032\004\210\243\036\000B\023Z\005proto\340\342\036\001\310\342\036"
+    "\001\320\342\036\001", 2485);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/data.proto", &protobuf_RegisterTypes);
   Timestamp::default_instance_ = new Timestamp();
@@ -899,6 +905,7 @@ const int Value::kBytesFieldNumber;
 const int Value::kChecksumFieldNumber;
 const int Value::kTimestampFieldNumber;
 const int Value::kTagFieldNumber;
+const int Value::kExpirationFieldNumber;
 #endif  // !_MSC_VER
 
 Value::Value()
@@ -909,6 +916,7 @@ Value::Value()
 
 void Value::InitAsDefaultInstance() {
   timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  expiration_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
 }
 
 Value::Value(const Value& from)
@@ -926,6 +934,7 @@ void Value::SharedCtor() {
   checksum_ = 0u;
   timestamp_ = NULL;
   tag_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  expiration_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -939,6 +948,7 @@ void Value::SharedDtor() {
   tag_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != default_instance_) {
     delete timestamp_;
+    delete expiration_;
   }
 }
 
@@ -968,7 +978,7 @@ Value* Value::New(::google::protobuf::Arena* arena) const {
 }
 
 void Value::Clear() {
-  if (_has_bits_[0 / 32] & 15u) {
+  if (_has_bits_[0 / 32] & 31u) {
     if (has_bytes()) {
       bytes_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     }
@@ -979,6 +989,9 @@ void Value::Clear() {
     if (has_tag()) {
       tag_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     }
+    if (has_expiration()) {
+      if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -1049,6 +1062,19 @@ bool Value::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(50)) goto parse_expiration;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp expiration = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_expiration:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_expiration()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1105,6 +1131,12 @@ void Value::SerializeWithCachedSizes(
       5, this->tag(), output);
   }
 
+  // optional .cockroach.proto.Timestamp expiration = 6;
+  if (has_expiration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, *this->expiration_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1145,6 +1177,13 @@ void Value::SerializeWithCachedSizes(
         5, this->tag(), target);
   }
 
+  // optional .cockroach.proto.Timestamp expiration = 6;
+  if (has_expiration()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, *this->expiration_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1156,7 +1195,7 @@ void Value::SerializeWithCachedSizes(
 int Value::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 15) {
+  if (_has_bits_[0 / 32] & 31) {
     // optional bytes bytes = 1;
     if (has_bytes()) {
       total_size += 1 +
@@ -1183,6 +1222,13 @@ int Value::ByteSize() const {
           this->tag());
     }
 
+    // optional .cockroach.proto.Timestamp expiration = 6;
+    if (has_expiration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->expiration_);
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -1224,6 +1270,9 @@ void Value::MergeFrom(const Value& from) {
       set_has_tag();
       tag_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.tag_);
     }
+    if (from.has_expiration()) {
+      mutable_expiration()->::cockroach::proto::Timestamp::MergeFrom(from.expiration());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -1256,6 +1305,7 @@ void Value::InternalSwap(Value* other) {
   std::swap(checksum_, other->checksum_);
   std::swap(timestamp_, other->timestamp_);
   tag_.Swap(&other->tag_);
+  std::swap(expiration_, other->expiration_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -1445,6 +1495,49 @@ void Value::clear_tag() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.tag)
 }
 
+// optional .cockroach.proto.Timestamp expiration = 6;
+bool Value::has_expiration() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+void Value::set_has_expiration() {
+  _has_bits_[0] |= 0x00000010u;
+}
+void Value::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+void Value::clear_expiration() {
+  if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+  clear_has_expiration();
+}
+ const ::cockroach::proto::Timestamp& Value::expiration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.expiration)
+  return expiration_ != NULL ? *expiration_ : *default_instance_->expiration_;
+}
+ ::cockroach::proto::Timestamp* Value::mutable_expiration() {
+  set_has_expiration();
+  if (expiration_ == NULL) {
+    expiration_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.expiration)
+  return expiration_;
+}
+ ::cockroach::proto::Timestamp* Value::release_expiration() {
+  clear_has_expiration();
+  ::cockroach::proto::Timestamp* temp = expiration_;
+  expiration_ = NULL;
+  return temp;
+}
+ void Value::set_allocated_expiration(::cockroach::proto::Timestamp* expiration) {
+  delete expiration_;
+  expiration_ = expiration;
+  if (expiration) {
+    set_has_expiration();
+  } else {
+    clear_has_expiration();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.expiration)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -4732,6 +4825,7 @@ const int Transaction::kTimestampFieldNumber;
 const int Transaction::kOrigTimestampFieldNumber;
 const int Transaction::kMaxTimestampFieldNumber;
 const int Transaction::kCertainNodesFieldNumber;
+const int Transaction::kSequenceFieldNumber;
 #endif  // !_MSC_VER
 
 Transaction::Transaction()
@@ -4771,6 +4865,7 @@ void Transaction::SharedCtor() {
   orig_timestamp_ = NULL;
   max_timestamp_ = NULL;
   certain_nodes_ = NULL;
+  sequence_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -4841,7 +4936,7 @@ void Transaction::Clear() {
       if (last_heartbeat_ != NULL) last_heartbeat_->::cockroach::proto::Timestamp::Clear();
     }
   }
-  if (_has_bits_[8 / 32] & 3840u) {
+  if (_has_bits_[8 / 32] & 7936u) {
     if (has_timestamp()) {
       if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
     }
@@ -4854,6 +4949,7 @@ void Transaction::Clear() {
     if (has_certain_nodes()) {
       if (certain_nodes_ != NULL) certain_nodes_->::cockroach::proto::NodeList::Clear();
     }
+    sequence_ = 0;
   }
 
 #undef ZR_HELPER_
@@ -5048,6 +5144,21 @@ bool Transaction::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(104)) goto parse_sequence;
+        break;
+      }
+
+      // optional int32 sequence = 13;
+      case 13: {
+        if (tag == 104) {
+         parse_sequence:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &sequence_)));
+          set_has_sequence();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -5151,6 +5262,11 @@ void Transaction::SerializeWithCachedSizes(
       12, *this->certain_nodes_, output);
   }
 
+  // optional int32 sequence = 13;
+  if (has_sequence()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(13, this->sequence(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -5243,6 +5359,11 @@ void Transaction::SerializeWithCachedSizes(
         12, *this->certain_nodes_, target);
   }
 
+  // optional int32 sequence = 13;
+  if (has_sequence()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(13, this->sequence(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -5310,7 +5431,7 @@ int Transaction::ByteSize() const {
     }
 
   }
-  if (_has_bits_[8 / 32] & 3840) {
+  if (_has_bits_[8 / 32] & 7936) {
     // optional .cockroach.proto.Timestamp timestamp = 9;
     if (has_timestamp()) {
       total_size += 1 +
@@ -5339,6 +5460,13 @@ int Transaction::ByteSize() const {
           *this->certain_nodes_);
     }
 
+    // optional int32 sequence = 13;
+    if (has_sequence()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->sequence());
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -5407,6 +5535,9 @@ void Transaction::MergeFrom(const Transaction& from) {
     if (from.has_certain_nodes()) {
       mutable_certain_nodes()->::cockroach::proto::NodeList::MergeFrom(from.certain_nodes());
     }
+    if (from.has_sequence()) {
+      set_sequence(from.sequence());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -5447,6 +5578,7 @@ void Transaction::InternalSwap(Transaction* other) {
   std::swap(orig_timestamp_, other->orig_timestamp_);
   std::swap(max_timestamp_, other->max_timestamp_);
   std::swap(certain_nodes_, other->certain_nodes_);
+  std::swap(sequence_, other->sequence_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -5935,6 +6067,30 @@ void Transaction::clear_certain_nodes() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.certain_nodes)
 }
 
+// optional int32 sequence = 13;
+bool Transaction::has_sequence() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+void Transaction::set_has_sequence() {
+  _has_bits_[0] |= 0x00001000u;
+}
+void Transaction::clear_has_sequence() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+void Transaction::clear_sequence() {
+  sequence_ = 0;
+  clear_has_sequence();
+}
+ ::google::protobuf::int32 Transaction::sequence() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.sequence)
+  return sequence_;
+}
+ void Transaction::set_sequence(::google::protobuf::int32 value) {
+  set_has_sequence();
+  sequence_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.sequence)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -6764,6 +6920,7 @@ void Intent::clear_txn() {
 #ifndef _MSC_VER
 const int GCMetadata::kLastScanNanosFieldNumber;
 const int GCMetadata::kOldestIntentNanosFieldNumber;
+const int GCMetadata::kNextExpirationNanosFieldNumber;
 #endif  // !_MSC_VER
 
 GCMetadata::GCMetadata()
@@ -6787,6 +6944,7 @@ void GCMetadata::SharedCtor() {
   _cached_size_ = 0;
   last_scan_nanos_ = GOOGLE_LONGLONG(0);
   oldest_intent_nanos_ = GOOGLE_LONGLONG(0);
+  next_expiration_nanos_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -6834,7 +6992,7 @@ void GCMetadata::Clear() {
            ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
 } while (0)
 
-  ZR_(last_scan_nanos_, oldest_intent_nanos_);
+  ZR_(last_scan_nanos_, next_expiration_nanos_);
 
 #undef ZR_HELPER_
 #undef ZR_
@@ -6880,6 +7038,21 @@ bool GCMetadata::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(24)) goto parse_next_expiration_nanos;
+        break;
+      }
+
+      // optional int64 next_expiration_nanos = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_next_expiration_nanos:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &next_expiration_nanos_)));
+          set_has_next_expiration_nanos();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6919,6 +7092,11 @@ void GCMetadata::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->oldest_intent_nanos(), output);
   }
 
+  // optional int64 next_expiration_nanos = 3;
+  if (has_next_expiration_nanos()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->next_expiration_nanos(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -6939,6 +7117,11 @@ void GCMetadata::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->oldest_intent_nanos(), target);
   }
 
+  // optional int64 next_expiration_nanos = 3;
+  if (has_next_expiration_nanos()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->next_expiration_nanos(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -6950,7 +7133,7 @@ void GCMetadata::SerializeWithCachedSizes(
 int GCMetadata::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3) {
+  if (_has_bits_[0 / 32] & 7) {
     // optional int64 last_scan_nanos = 1;
     if (has_last_scan_nanos()) {
       total_size += 1 +
@@ -6965,6 +7148,13 @@ int GCMetadata::ByteSize() const {
           this->oldest_intent_nanos());
     }
 
+    // optional int64 next_expiration_nanos = 3;
+    if (has_next_expiration_nanos()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->next_expiration_nanos());
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -6998,6 +7188,9 @@ void GCMetadata::MergeFrom(const GCMetadata& from) {
     if (from.has_oldest_intent_nanos()) {
       set_oldest_intent_nanos(from.oldest_intent_nanos());
     }
+    if (from.has_next_expiration_nanos()) {
+      set_next_expiration_nanos(from.next_expiration_nanos());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -7028,6 +7221,7 @@ void GCMetadata::Swap(GCMetadata* other) {
 void GCMetadata::InternalSwap(GCMetadata* other) {
   std::swap(last_scan_nanos_, other->last_scan_nanos_);
   std::swap(oldest_intent_nanos_, other->oldest_intent_nanos_);
+  std::swap(next_expiration_nanos_, other->next_expiration_nanos_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -7092,6 +7286,30 @@ void GCMetadata::clear_oldest_intent_nanos() {
   // @@protoc_insertion_point(field_set:cockroach.proto.GCMetadata.oldest_intent_nanos)
 }
 
+// optional int64 next_expiration_nanos = 3;
+bool GCMetadata::has_next_expiration_nanos() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void GCMetadata::set_has_next_expiration_nanos() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void GCMetadata::clear_has_next_expiration_nanos() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void GCMetadata::clear_next_expiration_nanos() {
+  next_expiration_nanos_ = GOOGLE_LONGLONG(0);
+  clear_has_next_expiration_nanos();
+}
+ ::google::protobuf::int64 GCMetadata::next_expiration_nanos() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GCMetadata.next_expiration_nanos)
+  return next_expiration_nanos_;
+}
+ void GCMetadata::set_next_expiration_nanos(::google::protobuf::int64 value) {
+  set_has_next_expiration_nanos();
+  next_expiration_nanos_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GCMetadata.next_expiration_nanos)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // @@protoc_insertion_point(namespace_scope)
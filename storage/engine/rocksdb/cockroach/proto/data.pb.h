@@ -318,6 +318,15 @@ class Value : public ::google::protobuf::Message {
   ::std::string* release_tag();
   void set_allocated_tag(::std::string* tag);
 
+  // optional .cockroach.proto.Timestamp expiration = 6;
+  bool has_expiration() const;
+  void clear_expiration();
+  static const int kExpirationFieldNumber = 6;
+  const ::cockroach::proto::Timestamp& expiration() const;
+  ::cockroach::proto::Timestamp* mutable_expiration();
+  ::cockroach::proto::Timestamp* release_expiration();
+  void set_allocated_expiration(::cockroach::proto::Timestamp* expiration);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.Value)
  private:
   inline void set_has_bytes();
@@ -328,6 +337,8 @@ class Value : public ::google::protobuf::Message {
   inline void clear_has_timestamp();
   inline void set_has_tag();
   inline void clear_has_tag();
+  inline void set_has_expiration();
+  inline void clear_has_expiration();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -335,6 +346,7 @@ class Value : public ::google::protobuf::Message {
   ::google::protobuf::internal::ArenaStringPtr bytes_;
   ::cockroach::proto::Timestamp* timestamp_;
   ::google::protobuf::internal::ArenaStringPtr tag_;
+  ::cockroach::proto::Timestamp* expiration_;
   ::google::protobuf::uint32 checksum_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
@@ -1398,6 +1410,13 @@ class Transaction : public ::google::protobuf::Message {
   ::cockroach::proto::NodeList* release_certain_nodes();
   void set_allocated_certain_nodes(::cockroach::proto::NodeList* certain_nodes);
 
+  // optional int32 sequence = 13;
+  bool has_sequence() const;
+  void clear_sequence();
+  static const int kSequenceFieldNumber = 13;
+  ::google::protobuf::int32 sequence() const;
+  void set_sequence(::google::protobuf::int32 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.Transaction)
  private:
   inline void set_has_name();
@@ -1424,6 +1443,8 @@ class Transaction : public ::google::protobuf::Message {
   inline void clear_has_max_timestamp();
   inline void set_has_certain_nodes();
   inline void clear_has_certain_nodes();
+  inline void set_has_sequence();
+  inline void clear_has_sequence();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -1440,6 +1461,7 @@ class Transaction : public ::google::protobuf::Message {
   ::cockroach::proto::Timestamp* orig_timestamp_;
   ::cockroach::proto::Timestamp* max_timestamp_;
   ::cockroach::proto::NodeList* certain_nodes_;
+  ::google::protobuf::int32 sequence_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
@@ -1746,18 +1768,28 @@ class GCMetadata : public ::google::protobuf::Message {
   ::google::protobuf::int64 oldest_intent_nanos() const;
   void set_oldest_intent_nanos(::google::protobuf::int64 value);
 
+  // optional int64 next_expiration_nanos = 3;
+  bool has_next_expiration_nanos() const;
+  void clear_next_expiration_nanos();
+  static const int kNextExpirationNanosFieldNumber = 3;
+  ::google::protobuf::int64 next_expiration_nanos() const;
+  void set_next_expiration_nanos(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.GCMetadata)
  private:
   inline void set_has_last_scan_nanos();
   inline void clear_has_last_scan_nanos();
   inline void set_has_oldest_intent_nanos();
   inline void clear_has_oldest_intent_nanos();
+  inline void set_has_next_expiration_nanos();
+  inline void clear_has_next_expiration_nanos();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::google::protobuf::int64 last_scan_nanos_;
   ::google::protobuf::int64 oldest_intent_nanos_;
+  ::google::protobuf::int64 next_expiration_nanos_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
@@ -1998,6 +2030,49 @@ inline void Value::set_allocated_tag(::std::string* tag) {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.tag)
 }
 
+// optional .cockroach.proto.Timestamp expiration = 6;
+inline bool Value::has_expiration() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void Value::set_has_expiration() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void Value::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void Value::clear_expiration() {
+  if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+  clear_has_expiration();
+}
+inline const ::cockroach::proto::Timestamp& Value::expiration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.expiration)
+  return expiration_ != NULL ? *expiration_ : *default_instance_->expiration_;
+}
+inline ::cockroach::proto::Timestamp* Value::mutable_expiration() {
+  set_has_expiration();
+  if (expiration_ == NULL) {
+    expiration_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.expiration)
+  return expiration_;
+}
+inline ::cockroach::proto::Timestamp* Value::release_expiration() {
+  clear_has_expiration();
+  ::cockroach::proto::Timestamp* temp = expiration_;
+  expiration_ = NULL;
+  return temp;
+}
+inline void Value::set_allocated_expiration(::cockroach::proto::Timestamp* expiration) {
+  delete expiration_;
+  expiration_ = expiration;
+  if (expiration) {
+    set_has_expiration();
+  } else {
+    clear_has_expiration();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.expiration)
+}
+
 // -------------------------------------------------------------------
 
 // KeyValue
@@ -3278,6 +3353,30 @@ inline void Transaction::set_allocated_certain_nodes(::cockroach::proto::NodeLis
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.certain_nodes)
 }
 
+// optional int32 sequence = 13;
+inline bool Transaction::has_sequence() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void Transaction::set_has_sequence() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void Transaction::clear_has_sequence() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void Transaction::clear_sequence() {
+  sequence_ = 0;
+  clear_has_sequence();
+}
+inline ::google::protobuf::int32 Transaction::sequence() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.sequence)
+  return sequence_;
+}
+inline void Transaction::set_sequence(::google::protobuf::int32 value) {
+  set_has_sequence();
+  sequence_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.sequence)
+}
+
 // -------------------------------------------------------------------
 
 // Lease
@@ -3544,6 +3643,30 @@ inline void GCMetadata::set_oldest_intent_nanos(::google::protobuf::int64 value)
   // @@protoc_insertion_point(field_set:cockroach.proto.GCMetadata.oldest_intent_nanos)
 }
 
+// optional int64 next_expiration_nanos = 3;
+inline bool GCMetadata::has_next_expiration_nanos() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void GCMetadata::set_has_next_expiration_nanos() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void GCMetadata::clear_has_next_expiration_nanos() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void GCMetadata::clear_next_expiration_nanos() {
+  next_expiration_nanos_ = GOOGLE_LONGLONG(0);
+  clear_has_next_expiration_nanos();
+}
+inline ::google::protobuf::int64 GCMetadata::next_expiration_nanos() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GCMetadata.next_expiration_nanos)
+  return next_expiration_nanos_;
+}
+inline void GCMetadata::set_next_expiration_nanos(::google::protobuf::int64 value) {
+  set_has_next_expiration_nanos();
+  next_expiration_nanos_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GCMetadata.next_expiration_nanos)
+}
+
 #endif  // !PROTOBUF_INLINE_NOT_IN_HEADERS
 // -------------------------------------------------------------------
 
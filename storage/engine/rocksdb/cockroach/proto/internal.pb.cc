@@ -48,6 +48,15 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* InternalPushTxnResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   InternalPushTxnResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* TxnWaitEdge_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  TxnWaitEdge_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalQueryTxnRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalQueryTxnRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalQueryTxnResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalQueryTxnResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* InternalResolveIntentRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   InternalResolveIntentRequest_reflection_ = NULL;
@@ -90,6 +99,11 @@ struct InternalRequestUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeRequest* delete_range_;
   const ::cockroach::proto::ScanRequest* scan_;
   const ::cockroach::proto::EndTransactionRequest* end_transaction_;
+  const ::cockroach::proto::ReverseScanRequest* reverse_scan_;
+  const ::cockroach::proto::ReapQueueRequest* reap_queue_;
+  const ::cockroach::proto::EnqueueUpdateRequest* enqueue_update_;
+  const ::cockroach::proto::EnqueueMessageRequest* enqueue_message_;
+  const ::cockroach::proto::ChangesRequest* changes_;
   const ::cockroach::proto::InternalPushTxnRequest* internal_push_txn_;
   const ::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent_;
   const ::cockroach::proto::InternalResolveIntentRangeRequest* internal_resolve_intent_range_;
@@ -106,6 +120,11 @@ struct InternalResponseUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeResponse* delete_range_;
   const ::cockroach::proto::ScanResponse* scan_;
   const ::cockroach::proto::EndTransactionResponse* end_transaction_;
+  const ::cockroach::proto::ReverseScanResponse* reverse_scan_;
+  const ::cockroach::proto::ReapQueueResponse* reap_queue_;
+  const ::cockroach::proto::EnqueueUpdateResponse* enqueue_update_;
+  const ::cockroach::proto::EnqueueMessageResponse* enqueue_message_;
+  const ::cockroach::proto::ChangesResponse* changes_;
   const ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
   const ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
   const ::cockroach::proto::InternalResolveIntentRangeResponse* internal_resolve_intent_range_;
@@ -126,6 +145,9 @@ struct ReadWriteCmdResponseOneofInstance {
   const ::cockroach::proto::DeleteResponse* delete__;
   const ::cockroach::proto::DeleteRangeResponse* delete_range_;
   const ::cockroach::proto::EndTransactionResponse* end_transaction_;
+  const ::cockroach::proto::ReapQueueResponse* reap_queue_;
+  const ::cockroach::proto::EnqueueUpdateResponse* enqueue_update_;
+  const ::cockroach::proto::EnqueueMessageResponse* enqueue_message_;
   const ::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
   const ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
   const ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
@@ -134,6 +156,7 @@ struct ReadWriteCmdResponseOneofInstance {
   const ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
   const ::cockroach::proto::InternalGCResponse* internal_gc_;
   const ::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease_;
+  const ::cockroach::proto::InternalBatchResponse* internal_batch_;
 }* ReadWriteCmdResponse_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* InternalRaftCommandUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -147,6 +170,11 @@ struct InternalRaftCommandUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeRequest* delete_range_;
   const ::cockroach::proto::ScanRequest* scan_;
   const ::cockroach::proto::EndTransactionRequest* end_transaction_;
+  const ::cockroach::proto::ReverseScanRequest* reverse_scan_;
+  const ::cockroach::proto::ReapQueueRequest* reap_queue_;
+  const ::cockroach::proto::EnqueueUpdateRequest* enqueue_update_;
+  const ::cockroach::proto::EnqueueMessageRequest* enqueue_message_;
+  const ::cockroach::proto::ChangesRequest* changes_;
   const ::cockroach::proto::BatchRequest* batch_;
   const ::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup_;
   const ::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
@@ -196,10 +224,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       "cockroach/proto/internal.proto");
   GOOGLE_CHECK(file != NULL);
   InternalRangeLookupRequest_descriptor_ = file->message_type(0);
-  static const int InternalRangeLookupRequest_offsets_[3] = {
+  static const int InternalRangeLookupRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeLookupRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeLookupRequest, max_ranges_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeLookupRequest, ignore_intents_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeLookupRequest, reverse_),
   };
   InternalRangeLookupRequest_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -259,10 +288,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalHeartbeatTxnResponse, _internal_metadata_),
       -1);
   InternalGCRequest_descriptor_ = file->message_type(4);
-  static const int InternalGCRequest_offsets_[3] = {
+  static const int InternalGCRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, gc_meta_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, keys_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, next_expiration_seq_),
   };
   InternalGCRequest_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -341,7 +371,57 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalPushTxnResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPushTxnResponse, _internal_metadata_),
       -1);
-  InternalResolveIntentRequest_descriptor_ = file->message_type(8);
+  TxnWaitEdge_descriptor_ = file->message_type(8);
+  static const int TxnWaitEdge_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TxnWaitEdge, pusher_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TxnWaitEdge, pusher_priority_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TxnWaitEdge, pushee_id_),
+  };
+  TxnWaitEdge_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      TxnWaitEdge_descriptor_,
+      TxnWaitEdge::default_instance_,
+      TxnWaitEdge_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TxnWaitEdge, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(TxnWaitEdge),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TxnWaitEdge, _internal_metadata_),
+      -1);
+  InternalQueryTxnRequest_descriptor_ = file->message_type(9);
+  static const int InternalQueryTxnRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnRequest, queried_txn_),
+  };
+  InternalQueryTxnRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      InternalQueryTxnRequest_descriptor_,
+      InternalQueryTxnRequest::default_instance_,
+      InternalQueryTxnRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(InternalQueryTxnRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnRequest, _internal_metadata_),
+      -1);
+  InternalQueryTxnResponse_descriptor_ = file->message_type(10);
+  static const int InternalQueryTxnResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, queried_txn_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, waits_),
+  };
+  InternalQueryTxnResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      InternalQueryTxnResponse_descriptor_,
+      InternalQueryTxnResponse::default_instance_,
+      InternalQueryTxnResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(InternalQueryTxnResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, _internal_metadata_),
+      -1);
+  InternalResolveIntentRequest_descriptor_ = file->message_type(11);
   static const int InternalResolveIntentRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRequest, header_),
   };
@@ -356,7 +436,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalResolveIntentRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRequest, _internal_metadata_),
       -1);
-  InternalResolveIntentResponse_descriptor_ = file->message_type(9);
+  InternalResolveIntentResponse_descriptor_ = file->message_type(12);
   static const int InternalResolveIntentResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentResponse, header_),
   };
@@ -371,9 +451,12 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalResolveIntentResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentResponse, _internal_metadata_),
       -1);
-  InternalResolveIntentRangeRequest_descriptor_ = file->message_type(10);
-  static const int InternalResolveIntentRangeRequest_offsets_[1] = {
+  InternalResolveIntentRangeRequest_descriptor_ = file->message_type(13);
+  static const int InternalResolveIntentRangeRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeRequest, rollback_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeRequest, restore_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeRequest, restore_deleted_),
   };
   InternalResolveIntentRangeRequest_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -386,7 +469,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalResolveIntentRangeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeRequest, _internal_metadata_),
       -1);
-  InternalResolveIntentRangeResponse_descriptor_ = file->message_type(11);
+  InternalResolveIntentRangeResponse_descriptor_ = file->message_type(14);
   static const int InternalResolveIntentRangeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeResponse, header_),
   };
@@ -401,7 +484,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalResolveIntentRangeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeResponse, _internal_metadata_),
       -1);
-  InternalMergeRequest_descriptor_ = file->message_type(12);
+  InternalMergeRequest_descriptor_ = file->message_type(15);
   static const int InternalMergeRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalMergeRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalMergeRequest, value_),
@@ -417,7 +500,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalMergeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalMergeRequest, _internal_metadata_),
       -1);
-  InternalMergeResponse_descriptor_ = file->message_type(13);
+  InternalMergeResponse_descriptor_ = file->message_type(16);
   static const int InternalMergeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalMergeResponse, header_),
   };
@@ -432,7 +515,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalMergeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalMergeResponse, _internal_metadata_),
       -1);
-  InternalTruncateLogRequest_descriptor_ = file->message_type(14);
+  InternalTruncateLogRequest_descriptor_ = file->message_type(17);
   static const int InternalTruncateLogRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTruncateLogRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTruncateLogRequest, index_),
@@ -448,7 +531,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalTruncateLogRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTruncateLogRequest, _internal_metadata_),
       -1);
-  InternalTruncateLogResponse_descriptor_ = file->message_type(15);
+  InternalTruncateLogResponse_descriptor_ = file->message_type(18);
   static const int InternalTruncateLogResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTruncateLogResponse, header_),
   };
@@ -463,7 +546,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalTruncateLogResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTruncateLogResponse, _internal_metadata_),
       -1);
-  InternalLeaderLeaseRequest_descriptor_ = file->message_type(16);
+  InternalLeaderLeaseRequest_descriptor_ = file->message_type(19);
   static const int InternalLeaderLeaseRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, lease_),
@@ -479,7 +562,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalLeaderLeaseRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, _internal_metadata_),
       -1);
-  InternalLeaderLeaseResponse_descriptor_ = file->message_type(17);
+  InternalLeaderLeaseResponse_descriptor_ = file->message_type(20);
   static const int InternalLeaderLeaseResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseResponse, header_),
   };
@@ -494,8 +577,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalLeaderLeaseResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseResponse, _internal_metadata_),
       -1);
-  InternalRequestUnion_descriptor_ = file->message_type(18);
-  static const int InternalRequestUnion_offsets_[12] = {
+  InternalRequestUnion_descriptor_ = file->message_type(21);
+  static const int InternalRequestUnion_offsets_[17] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, conditional_put_),
@@ -504,6 +587,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, reverse_scan_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, reap_queue_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, enqueue_update_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, enqueue_message_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, changes_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, internal_push_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, internal_resolve_intent_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, internal_resolve_intent_range_),
@@ -522,8 +610,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalRequestUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRequestUnion, _internal_metadata_),
       -1);
-  InternalResponseUnion_descriptor_ = file->message_type(19);
-  static const int InternalResponseUnion_offsets_[12] = {
+  InternalResponseUnion_descriptor_ = file->message_type(22);
+  static const int InternalResponseUnion_offsets_[17] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, conditional_put_),
@@ -532,6 +620,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, reverse_scan_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, reap_queue_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, enqueue_update_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, enqueue_message_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, changes_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, internal_push_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, internal_resolve_intent_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, internal_resolve_intent_range_),
@@ -550,7 +643,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalResponseUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResponseUnion, _internal_metadata_),
       -1);
-  InternalBatchRequest_descriptor_ = file->message_type(20);
+  InternalBatchRequest_descriptor_ = file->message_type(23);
   static const int InternalBatchRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchRequest, requests_),
@@ -566,7 +659,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalBatchRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchRequest, _internal_metadata_),
       -1);
-  InternalBatchResponse_descriptor_ = file->message_type(21);
+  InternalBatchResponse_descriptor_ = file->message_type(24);
   static const int InternalBatchResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchResponse, responses_),
@@ -582,14 +675,17 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalBatchResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchResponse, _internal_metadata_),
       -1);
-  ReadWriteCmdResponse_descriptor_ = file->message_type(22);
-  static const int ReadWriteCmdResponse_offsets_[15] = {
+  ReadWriteCmdResponse_descriptor_ = file->message_type(25);
+  static const int ReadWriteCmdResponse_offsets_[19] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, conditional_put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, increment_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, delete__),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, reap_queue_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, enqueue_update_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, enqueue_message_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_heartbeat_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_push_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_resolve_intent_),
@@ -598,6 +694,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_truncate_log_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_gc_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_leader_lease_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_batch_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, value_),
   };
   ReadWriteCmdResponse_reflection_ =
@@ -613,8 +710,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(ReadWriteCmdResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, _internal_metadata_),
       -1);
-  InternalRaftCommandUnion_descriptor_ = file->message_type(23);
-  static const int InternalRaftCommandUnion_offsets_[20] = {
+  InternalRaftCommandUnion_descriptor_ = file->message_type(26);
+  static const int InternalRaftCommandUnion_offsets_[25] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, conditional_put_),
@@ -623,6 +720,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, reverse_scan_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, reap_queue_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, enqueue_update_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, enqueue_message_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, changes_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, batch_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_range_lookup_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_heartbeat_txn_),
@@ -649,11 +751,12 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalRaftCommandUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, _internal_metadata_),
       -1);
-  InternalRaftCommand_descriptor_ = file->message_type(24);
-  static const int InternalRaftCommand_offsets_[3] = {
+  InternalRaftCommand_descriptor_ = file->message_type(27);
+  static const int InternalRaftCommand_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, raft_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, origin_node_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, cmd_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, closed_timestamp_),
   };
   InternalRaftCommand_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -666,7 +769,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalRaftCommand),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, _internal_metadata_),
       -1);
-  RaftMessageRequest_descriptor_ = file->message_type(25);
+  RaftMessageRequest_descriptor_ = file->message_type(28);
   static const int RaftMessageRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftMessageRequest, group_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftMessageRequest, msg_),
@@ -682,7 +785,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(RaftMessageRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftMessageRequest, _internal_metadata_),
       -1);
-  RaftMessageResponse_descriptor_ = file->message_type(26);
+  RaftMessageResponse_descriptor_ = file->message_type(29);
   static const int RaftMessageResponse_offsets_[1] = {
   };
   RaftMessageResponse_reflection_ =
@@ -696,7 +799,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(RaftMessageResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftMessageResponse, _internal_metadata_),
       -1);
-  InternalTimeSeriesData_descriptor_ = file->message_type(27);
+  InternalTimeSeriesData_descriptor_ = file->message_type(30);
   static const int InternalTimeSeriesData_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, start_timestamp_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, sample_duration_nanos_),
@@ -713,7 +816,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalTimeSeriesData),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, _internal_metadata_),
       -1);
-  InternalTimeSeriesSample_descriptor_ = file->message_type(28);
+  InternalTimeSeriesSample_descriptor_ = file->message_type(31);
   static const int InternalTimeSeriesSample_offsets_[5] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, offset_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, count_),
@@ -732,7 +835,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(InternalTimeSeriesSample),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, _internal_metadata_),
       -1);
-  RaftTruncatedState_descriptor_ = file->message_type(29);
+  RaftTruncatedState_descriptor_ = file->message_type(32);
   static const int RaftTruncatedState_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftTruncatedState, index_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftTruncatedState, term_),
@@ -748,7 +851,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       sizeof(RaftTruncatedState),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftTruncatedState, _internal_metadata_),
       -1);
-  RaftSnapshotData_descriptor_ = file->message_type(30);
+  RaftSnapshotData_descriptor_ = file->message_type(33);
   static const int RaftSnapshotData_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftSnapshotData, range_descriptor_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftSnapshotData, kv_),
@@ -812,6 +915,12 @@ void protobuf_RegisterTypes(const ::std::string&) {
       InternalPushTxnRequest_descriptor_, &InternalPushTxnRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       InternalPushTxnResponse_descriptor_, &InternalPushTxnResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      TxnWaitEdge_descriptor_, &TxnWaitEdge::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      InternalQueryTxnRequest_descriptor_, &InternalQueryTxnRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      InternalQueryTxnResponse_descriptor_, &InternalQueryTxnResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       InternalResolveIntentRequest_descriptor_, &InternalResolveIntentRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -883,6 +992,12 @@ void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto() {
   delete InternalPushTxnRequest_reflection_;
   delete InternalPushTxnResponse::default_instance_;
   delete InternalPushTxnResponse_reflection_;
+  delete TxnWaitEdge::default_instance_;
+  delete TxnWaitEdge_reflection_;
+  delete InternalQueryTxnRequest::default_instance_;
+  delete InternalQueryTxnRequest_reflection_;
+  delete InternalQueryTxnResponse::default_instance_;
+  delete InternalQueryTxnResponse_reflection_;
   delete InternalResolveIntentRequest::default_instance_;
   delete InternalResolveIntentRequest_reflection_;
   delete InternalResolveIntentResponse::default_instance_;
@@ -951,46 +1066,61 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
     "\n\036cockroach/proto/internal.proto\022\017cockro"
     "ach.proto\032\031cockroach/proto/api.proto\032\034co"
     "ckroach/proto/config.proto\032\032cockroach/pr"
-    "oto/data.proto\032\024gogoproto/gogo.proto\"\216\001\n"
+    "oto/data.proto\032\024gogoproto/gogo.proto\"\245\001\n"
     "\032InternalRangeLookupRequest\0228\n\006header\030\001 "
     "\001(\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037"
     "\000\320\336\037\001\022\030\n\nmax_ranges\030\002 \001(\005B\004\310\336\037\000\022\034\n\016ignor"
-    "e_intents\030\003 \001(\010B\004\310\336\037\000\"\220\001\n\033InternalRangeL"
-    "ookupResponse\0229\n\006header\030\001 \001(\0132\037.cockroac"
-    "h.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0226\n\006rang"
-    "es\030\002 \003(\0132 .cockroach.proto.RangeDescript"
-    "orB\004\310\336\037\000\"W\n\033InternalHeartbeatTxnRequest\022"
-    "8\n\006header\030\001 \001(\0132\036.cockroach.proto.Reques"
-    "tHeaderB\010\310\336\037\000\320\336\037\001\"Y\n\034InternalHeartbeatTx"
-    "nResponse\0229\n\006header\030\001 \001(\0132\037.cockroach.pr"
-    "oto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\235\002\n\021Interna"
-    "lGCRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.p"
-    "roto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022<\n\007gc_meta\030"
-    "\002 \001(\0132\033.cockroach.proto.GCMetadataB\016\310\336\037\000"
-    "\342\336\037\006GCMeta\022<\n\004keys\030\003 \003(\0132(.cockroach.pro"
-    "to.InternalGCRequest.GCKeyB\004\310\336\037\000\032R\n\005GCKe"
-    "y\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003Key\0223\n\ttimestamp\030\002 \001"
-    "(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\"O\n\022"
-    "InternalGCResponse\0229\n\006header\030\001 \001(\0132\037.coc"
-    "kroach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\214\002"
-    "\n\026InternalPushTxnRequest\0228\n\006header\030\001 \001(\013"
-    "2\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336"
-    "\037\001\0226\n\npushee_txn\030\002 \001(\0132\034.cockroach.proto"
-    ".TransactionB\004\310\336\037\000\022-\n\003now\030\003 \001(\0132\032.cockro"
-    "ach.proto.TimestampB\004\310\336\037\000\0225\n\tpush_type\030\004"
-    " \001(\0162\034.cockroach.proto.PushTxnTypeB\004\310\336\037\000"
-    "\022\032\n\014range_lookup\030\005 \001(\010B\004\310\336\037\000\"\206\001\n\027Interna"
-    "lPushTxnResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0220\n\np"
-    "ushee_txn\030\002 \001(\0132\034.cockroach.proto.Transa"
-    "ction\"X\n\034InternalResolveIntentRequest\0228\n"
-    "\006header\030\001 \001(\0132\036.cockroach.proto.RequestH"
-    "eaderB\010\310\336\037\000\320\336\037\001\"Z\n\035InternalResolveIntent"
-    "Response\0229\n\006header\030\001 \001(\0132\037.cockroach.pro"
-    "to.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"]\n!InternalR"
-    "esolveIntentRangeRequest\0228\n\006header\030\001 \001(\013"
-    "2\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336"
-    "\037\001\"_\n\"InternalResolveIntentRangeResponse"
+    "e_intents\030\003 \001(\010B\004\310\336\037\000\022\025\n\007reverse\030\004 \001(\010B\004"
+    "\310\336\037\000\"\220\001\n\033InternalRangeLookupResponse\0229\n\006"
+    "header\030\001 \001(\0132\037.cockroach.proto.ResponseH"
+    "eaderB\010\310\336\037\000\320\336\037\001\0226\n\006ranges\030\002 \003(\0132 .cockro"
+    "ach.proto.RangeDescriptorB\004\310\336\037\000\"W\n\033Inter"
+    "nalHeartbeatTxnRequest\0228\n\006header\030\001 \001(\0132\036"
+    ".cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001"
+    "\"Y\n\034InternalHeartbeatTxnResponse\0229\n\006head"
+    "er\030\001 \001(\0132\037.cockroach.proto.ResponseHeade"
+    "rB\010\310\336\037\000\320\336\037\001\"\300\002\n\021InternalGCRequest\0228\n\006hea"
+    "der\030\001 \001(\0132\036.cockroach.proto.RequestHeade"
+    "rB\010\310\336\037\000\320\336\037\001\022<\n\007gc_meta\030\002 \001(\0132\033.cockroach"
+    ".proto.GCMetadataB\016\310\336\037\000\342\336\037\006GCMeta\022<\n\004key"
+    "s\030\003 \003(\0132(.cockroach.proto.InternalGCRequ"
+    "est.GCKeyB\004\310\336\037\000\022!\n\023next_expiration_seq\030\004"
+    " \001(\004B\004\310\336\037\000\032R\n\005GCKey\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003Ke"
+    "y\0223\n\ttimestamp\030\002 \001(\0132\032.cockroach.proto.T"
+    "imestampB\004\310\336\037\000\"O\n\022InternalGCResponse\0229\n\006"
+    "header\030\001 \001(\0132\037.cockroach.proto.ResponseH"
+    "eaderB\010\310\336\037\000\320\336\037\001\"\214\002\n\026InternalPushTxnReque"
+    "st\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Req"
+    "uestHeaderB\010\310\336\037\000\320\336\037\001\0226\n\npushee_txn\030\002 \001(\013"
+    "2\034.cockroach.proto.TransactionB\004\310\336\037\000\022-\n\003"
+    "now\030\003 \001(\0132\032.cockroach.proto.TimestampB\004\310"
+    "\336\037\000\0225\n\tpush_type\030\004 \001(\0162\034.cockroach.proto"
+    ".PushTxnTypeB\004\310\336\037\000\022\032\n\014range_lookup\030\005 \001(\010"
+    "B\004\310\336\037\000\"\206\001\n\027InternalPushTxnResponse\0229\n\006he"
+    "ader\030\001 \001(\0132\037.cockroach.proto.ResponseHea"
+    "derB\010\310\336\037\000\320\336\037\001\0220\n\npushee_txn\030\002 \001(\0132\034.cock"
+    "roach.proto.Transaction\"n\n\013TxnWaitEdge\022\037"
+    "\n\tpusher_id\030\001 \001(\014B\014\342\336\037\010PusherID\022\035\n\017pushe"
+    "r_priority\030\002 \001(\005B\004\310\336\037\000\022\037\n\tpushee_id\030\003 \001("
+    "\014B\014\342\336\037\010PusheeID\"\214\001\n\027InternalQueryTxnRequ"
+    "est\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Re"
+    "questHeaderB\010\310\336\037\000\320\336\037\001\0227\n\013queried_txn\030\002 \001"
+    "(\0132\034.cockroach.proto.TransactionB\004\310\336\037\000\"\273"
+    "\001\n\030InternalQueryTxnResponse\0229\n\006header\030\001 "
+    "\001(\0132\037.cockroach.proto.ResponseHeaderB\010\310\336"
+    "\037\000\320\336\037\001\0221\n\013queried_txn\030\002 \001(\0132\034.cockroach."
+    "proto.Transaction\0221\n\005waits\030\003 \003(\0132\034.cockr"
+    "oach.proto.TxnWaitEdgeB\004\310\336\037\000\"X\n\034Internal"
+    "ResolveIntentRequest\0228\n\006header\030\001 \001(\0132\036.c"
+    "ockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"Z"
+    "\n\035InternalResolveIntentResponse\0229\n\006heade"
+    "r\030\001 \001(\0132\037.cockroach.proto.ResponseHeader"
+    "B\010\310\336\037\000\320\336\037\001\"\275\001\n!InternalResolveIntentRang"
+    "eRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.pro"
+    "to.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\026\n\010rollback\030\002"
+    " \001(\010B\004\310\336\037\000\022\'\n\007restore\030\003 \001(\0132\026.cockroach."
+    "proto.Value\022\035\n\017restore_deleted\030\004 \001(\010B\004\310\336"
+    "\037\000\"_\n\"InternalResolveIntentRangeResponse"
     "\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Respo"
     "nseHeaderB\010\310\336\037\000\320\336\037\001\"}\n\024InternalMergeRequ"
     "est\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Re"
@@ -1008,7 +1138,7 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
     "\n\005lease\030\002 \001(\0132\026.cockroach.proto.LeaseB\004\310"
     "\336\037\000\"X\n\033InternalLeaderLeaseResponse\0229\n\006he"
     "ader\030\001 \001(\0132\037.cockroach.proto.ResponseHea"
-    "derB\010\310\336\037\000\320\336\037\001\"\315\005\n\024InternalRequestUnion\022*"
+    "derB\010\310\336\037\000\320\336\037\001\"\373\007\n\024InternalRequestUnion\022*"
     "\n\003get\030\002 \001(\0132\033.cockroach.proto.GetRequest"
     "H\000\022*\n\003put\030\003 \001(\0132\033.cockroach.proto.PutReq"
     "uestH\000\022A\n\017conditional_put\030\004 \001(\0132&.cockro"
@@ -1019,121 +1149,152 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
     "\0132#.cockroach.proto.DeleteRangeRequestH\000"
     "\022,\n\004scan\030\010 \001(\0132\034.cockroach.proto.ScanReq"
     "uestH\000\022A\n\017end_transaction\030\t \001(\0132&.cockro"
-    "ach.proto.EndTransactionRequestH\000\022D\n\021int"
-    "ernal_push_txn\030\036 \001(\0132\'.cockroach.proto.I"
-    "nternalPushTxnRequestH\000\022P\n\027internal_reso"
-    "lve_intent\030\037 \001(\0132-.cockroach.proto.Inter"
-    "nalResolveIntentRequestH\000\022[\n\035internal_re"
-    "solve_intent_range\030  \001(\01322.cockroach.pro"
-    "to.InternalResolveIntentRangeRequestH\000:\004"
-    "\310\240\037\001B\007\n\005value\"\331\005\n\025InternalResponseUnion\022"
-    "+\n\003get\030\002 \001(\0132\034.cockroach.proto.GetRespon"
-    "seH\000\022+\n\003put\030\003 \001(\0132\034.cockroach.proto.PutR"
-    "esponseH\000\022B\n\017conditional_put\030\004 \001(\0132\'.coc"
-    "kroach.proto.ConditionalPutResponseH\000\0227\n"
-    "\tincrement\030\005 \001(\0132\".cockroach.proto.Incre"
-    "mentResponseH\000\0221\n\006delete\030\006 \001(\0132\037.cockroa"
-    "ch.proto.DeleteResponseH\000\022<\n\014delete_rang"
-    "e\030\007 \001(\0132$.cockroach.proto.DeleteRangeRes"
-    "ponseH\000\022-\n\004scan\030\010 \001(\0132\035.cockroach.proto."
-    "ScanResponseH\000\022B\n\017end_transaction\030\t \001(\0132"
-    "\'.cockroach.proto.EndTransactionResponse"
-    "H\000\022E\n\021internal_push_txn\030\036 \001(\0132(.cockroac"
-    "h.proto.InternalPushTxnResponseH\000\022Q\n\027int"
-    "ernal_resolve_intent\030\037 \001(\0132..cockroach.p"
-    "roto.InternalResolveIntentResponseH\000\022\\\n\035"
-    "internal_resolve_intent_range\030  \001(\01323.co"
-    "ckroach.proto.InternalResolveIntentRange"
-    "ResponseH\000:\004\310\240\037\001B\007\n\005value\"\217\001\n\024InternalBa"
-    "tchRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.p"
-    "roto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022=\n\010requests"
-    "\030\002 \003(\0132%.cockroach.proto.InternalRequest"
-    "UnionB\004\310\336\037\000\"\223\001\n\025InternalBatchResponse\0229\n"
-    "\006header\030\001 \001(\0132\037.cockroach.proto.Response"
-    "HeaderB\010\310\336\037\000\320\336\037\001\022\?\n\tresponses\030\002 \003(\0132&.co"
-    "ckroach.proto.InternalResponseUnionB\004\310\336\037"
-    "\000\"\351\007\n\024ReadWriteCmdResponse\022+\n\003put\030\001 \001(\0132"
+    "ach.proto.EndTransactionRequestH\000\022;\n\014rev"
+    "erse_scan\030\n \001(\0132#.cockroach.proto.Revers"
+    "eScanRequestH\000\0227\n\nreap_queue\030\013 \001(\0132!.coc"
+    "kroach.proto.ReapQueueRequestH\000\022\?\n\016enque"
+    "ue_update\030\014 \001(\0132%.cockroach.proto.Enqueu"
+    "eUpdateRequestH\000\022A\n\017enqueue_message\030\r \001("
+    "\0132&.cockroach.proto.EnqueueMessageReques"
+    "tH\000\0222\n\007changes\030\016 \001(\0132\037.cockroach.proto.C"
+    "hangesRequestH\000\022D\n\021internal_push_txn\030\036 \001"
+    "(\0132\'.cockroach.proto.InternalPushTxnRequ"
+    "estH\000\022P\n\027internal_resolve_intent\030\037 \001(\0132-"
+    ".cockroach.proto.InternalResolveIntentRe"
+    "questH\000\022[\n\035internal_resolve_intent_range"
+    "\030  \001(\01322.cockroach.proto.InternalResolve"
+    "IntentRangeRequestH\000:\004\310\240\037\001B\007\n\005value\"\214\010\n\025"
+    "InternalResponseUnion\022+\n\003get\030\002 \001(\0132\034.coc"
+    "kroach.proto.GetResponseH\000\022+\n\003put\030\003 \001(\0132"
     "\034.cockroach.proto.PutResponseH\000\022B\n\017condi"
-    "tional_put\030\002 \001(\0132\'.cockroach.proto.Condi"
-    "tionalPutResponseH\000\0227\n\tincrement\030\003 \001(\0132\""
+    "tional_put\030\004 \001(\0132\'.cockroach.proto.Condi"
+    "tionalPutResponseH\000\0227\n\tincrement\030\005 \001(\0132\""
     ".cockroach.proto.IncrementResponseH\000\0221\n\006"
-    "delete\030\004 \001(\0132\037.cockroach.proto.DeleteRes"
-    "ponseH\000\022<\n\014delete_range\030\005 \001(\0132$.cockroac"
-    "h.proto.DeleteRangeResponseH\000\022B\n\017end_tra"
-    "nsaction\030\006 \001(\0132\'.cockroach.proto.EndTran"
-    "sactionResponseH\000\022O\n\026internal_heartbeat_"
-    "txn\030\n \001(\0132-.cockroach.proto.InternalHear"
-    "tbeatTxnResponseH\000\022E\n\021internal_push_txn\030"
-    "\013 \001(\0132(.cockroach.proto.InternalPushTxnR"
-    "esponseH\000\022Q\n\027internal_resolve_intent\030\014 \001"
-    "(\0132..cockroach.proto.InternalResolveInte"
-    "ntResponseH\000\022\\\n\035internal_resolve_intent_"
-    "range\030\r \001(\01323.cockroach.proto.InternalRe"
-    "solveIntentRangeResponseH\000\022@\n\016internal_m"
-    "erge\030\016 \001(\0132&.cockroach.proto.InternalMer"
-    "geResponseH\000\022M\n\025internal_truncate_log\030\017 "
-    "\001(\0132,.cockroach.proto.InternalTruncateLo"
-    "gResponseH\000\022:\n\013internal_gc\030\020 \001(\0132#.cockr"
-    "oach.proto.InternalGCResponseH\000\022M\n\025inter"
-    "nal_leader_lease\030\021 \001(\0132,.cockroach.proto"
-    ".InternalLeaderLeaseResponseH\000:\004\310\240\037\001B\007\n\005"
-    "value\"\212\n\n\030InternalRaftCommandUnion\022*\n\003ge"
-    "t\030\002 \001(\0132\033.cockroach.proto.GetRequestH\000\022*"
-    "\n\003put\030\003 \001(\0132\033.cockroach.proto.PutRequest"
-    "H\000\022A\n\017conditional_put\030\004 \001(\0132&.cockroach."
-    "proto.ConditionalPutRequestH\000\0226\n\tincreme"
-    "nt\030\005 \001(\0132!.cockroach.proto.IncrementRequ"
-    "estH\000\0220\n\006delete\030\006 \001(\0132\036.cockroach.proto."
-    "DeleteRequestH\000\022;\n\014delete_range\030\007 \001(\0132#."
-    "cockroach.proto.DeleteRangeRequestH\000\022,\n\004"
-    "scan\030\010 \001(\0132\034.cockroach.proto.ScanRequest"
-    "H\000\022A\n\017end_transaction\030\t \001(\0132&.cockroach."
-    "proto.EndTransactionRequestH\000\022.\n\005batch\030\036"
-    " \001(\0132\035.cockroach.proto.BatchRequestH\000\022L\n"
-    "\025internal_range_lookup\030\037 \001(\0132+.cockroach"
-    ".proto.InternalRangeLookupRequestH\000\022N\n\026i"
-    "nternal_heartbeat_txn\030  \001(\0132,.cockroach."
-    "proto.InternalHeartbeatTxnRequestH\000\022D\n\021i"
-    "nternal_push_txn\030! \001(\0132\'.cockroach.proto"
-    ".InternalPushTxnRequestH\000\022P\n\027internal_re"
-    "solve_intent\030\" \001(\0132-.cockroach.proto.Int"
-    "ernalResolveIntentRequestH\000\022[\n\035internal_"
-    "resolve_intent_range\030# \001(\01322.cockroach.p"
-    "roto.InternalResolveIntentRangeRequestH\000"
-    "\022H\n\027internal_merge_response\030$ \001(\0132%.cock"
-    "roach.proto.InternalMergeRequestH\000\022L\n\025in"
-    "ternal_truncate_log\030% \001(\0132+.cockroach.pr"
-    "oto.InternalTruncateLogRequestH\000\022I\n\013inte"
-    "rnal_gc\030& \001(\0132\".cockroach.proto.Internal"
-    "GCRequestB\016\342\336\037\nInternalGCH\000\022E\n\016internal_"
-    "lease\030\' \001(\0132+.cockroach.proto.InternalLe"
-    "aderLeaseRequestH\000\022\?\n\016internal_batch\030( \001"
-    "(\0132%.cockroach.proto.InternalBatchReques"
-    "tH\000:\004\310\240\037\001B\007\n\005value\"\272\001\n\023InternalRaftComma"
-    "nd\022)\n\007raft_id\030\001 \001(\003B\030\310\336\037\000\342\336\037\006RaftID\372\336\037\006R"
-    "aftID\022:\n\016origin_node_id\030\002 \001(\004B\"\310\336\037\000\342\336\037\014O"
-    "riginNodeID\372\336\037\nRaftNodeID\022<\n\003cmd\030\003 \001(\0132)"
-    ".cockroach.proto.InternalRaftCommandUnio"
-    "nB\004\310\336\037\000\"N\n\022RaftMessageRequest\022+\n\010group_i"
-    "d\030\001 \001(\004B\031\310\336\037\000\342\336\037\007GroupID\372\336\037\006RaftID\022\013\n\003ms"
-    "g\030\002 \001(\014\"\025\n\023RaftMessageResponse\"\236\001\n\026Inter"
-    "nalTimeSeriesData\022#\n\025start_timestamp_nan"
-    "os\030\001 \001(\003B\004\310\336\037\000\022#\n\025sample_duration_nanos\030"
-    "\002 \001(\003B\004\310\336\037\000\022:\n\007samples\030\003 \003(\0132).cockroach"
-    ".proto.InternalTimeSeriesSample\"r\n\030Inter"
-    "nalTimeSeriesSample\022\024\n\006offset\030\001 \001(\005B\004\310\336\037"
-    "\000\022\023\n\005count\030\006 \001(\rB\004\310\336\037\000\022\021\n\003sum\030\007 \001(\001B\004\310\336\037"
-    "\000\022\013\n\003max\030\010 \001(\001\022\013\n\003min\030\t \001(\001\"=\n\022RaftTrunc"
-    "atedState\022\023\n\005index\030\001 \001(\004B\004\310\336\037\000\022\022\n\004term\030\002"
-    " \001(\004B\004\310\336\037\000\"\274\001\n\020RaftSnapshotData\022@\n\020range"
-    "_descriptor\030\001 \001(\0132 .cockroach.proto.Rang"
-    "eDescriptorB\004\310\336\037\000\022>\n\002KV\030\002 \003(\0132*.cockroac"
-    "h.proto.RaftSnapshotData.KeyValueB\006\342\336\037\002K"
-    "V\032&\n\010KeyValue\022\013\n\003key\030\001 \001(\014\022\r\n\005value\030\002 \001("
-    "\014*G\n\013PushTxnType\022\022\n\016PUSH_TIMESTAMP\020\000\022\r\n\t"
-    "ABORT_TXN\020\001\022\017\n\013CLEANUP_TXN\020\002\032\004\210\243\036\000*%\n\021In"
-    "ternalValueType\022\n\n\006_CR_TS\020\001\032\004\210\243\036\000B\023Z\005pro"
-    "to\340\342\036\001\310\342\036\001\320\342\036\001", 7414);
+    "delete\030\006 \001(\0132\037.cockroach.proto.DeleteRes"
+    "ponseH\000\022<\n\014delete_range\030\007 \001(\0132$.cockroac"
+    "h.proto.DeleteRangeResponseH\000\022-\n\004scan\030\010 "
+    "\001(\0132\035.cockroach.proto.ScanResponseH\000\022B\n\017"
+    "end_transaction\030\t \001(\0132\'.cockroach.proto."
+    "EndTransactionResponseH\000\022<\n\014reverse_scan"
+    "\030\n \001(\0132$.cockroach.proto.ReverseScanResp"
+    "onseH\000\0228\n\nreap_queue\030\013 \001(\0132\".cockroach.p"
+    "roto.ReapQueueResponseH\000\022@\n\016enqueue_upda"
+    "te\030\014 \001(\0132&.cockroach.proto.EnqueueUpdate"
+    "ResponseH\000\022B\n\017enqueue_message\030\r \001(\0132\'.co"
+    "ckroach.proto.EnqueueMessageResponseH\000\0223"
+    "\n\007changes\030\016 \001(\0132 .cockroach.proto.Change"
+    "sResponseH\000\022E\n\021internal_push_txn\030\036 \001(\0132("
+    ".cockroach.proto.InternalPushTxnResponse"
+    "H\000\022Q\n\027internal_resolve_intent\030\037 \001(\0132..co"
+    "ckroach.proto.InternalResolveIntentRespo"
+    "nseH\000\022\\\n\035internal_resolve_intent_range\030 "
+    " \001(\01323.cockroach.proto.InternalResolveIn"
+    "tentRangeResponseH\000:\004\310\240\037\001B\007\n\005value\"\217\001\n\024I"
+    "nternalBatchRequest\0228\n\006header\030\001 \001(\0132\036.co"
+    "ckroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022=\n"
+    "\010requests\030\002 \003(\0132%.cockroach.proto.Intern"
+    "alRequestUnionB\004\310\336\037\000\"\223\001\n\025InternalBatchRe"
+    "sponse\0229\n\006header\030\001 \001(\0132\037.cockroach.proto"
+    ".ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\?\n\tresponses\030\002"
+    " \003(\0132&.cockroach.proto.InternalResponseU"
+    "nionB\004\310\336\037\000\"\353\t\n\024ReadWriteCmdResponse\022+\n\003p"
+    "ut\030\001 \001(\0132\034.cockroach.proto.PutResponseH\000"
+    "\022B\n\017conditional_put\030\002 \001(\0132\'.cockroach.pr"
+    "oto.ConditionalPutResponseH\000\0227\n\tincremen"
+    "t\030\003 \001(\0132\".cockroach.proto.IncrementRespo"
+    "nseH\000\0221\n\006delete\030\004 \001(\0132\037.cockroach.proto."
+    "DeleteResponseH\000\022<\n\014delete_range\030\005 \001(\0132$"
+    ".cockroach.proto.DeleteRangeResponseH\000\022B"
+    "\n\017end_transaction\030\006 \001(\0132\'.cockroach.prot"
+    "o.EndTransactionResponseH\000\0228\n\nreap_queue"
+    "\030\007 \001(\0132\".cockroach.proto.ReapQueueRespon"
+    "seH\000\022@\n\016enqueue_update\030\010 \001(\0132&.cockroach"
+    ".proto.EnqueueUpdateResponseH\000\022B\n\017enqueu"
+    "e_message\030\t \001(\0132\'.cockroach.proto.Enqueu"
+    "eMessageResponseH\000\022O\n\026internal_heartbeat"
+    "_txn\030\n \001(\0132-.cockroach.proto.InternalHea"
+    "rtbeatTxnResponseH\000\022E\n\021internal_push_txn"
+    "\030\013 \001(\0132(.cockroach.proto.InternalPushTxn"
+    "ResponseH\000\022Q\n\027internal_resolve_intent\030\014 "
+    "\001(\0132..cockroach.proto.InternalResolveInt"
+    "entResponseH\000\022\\\n\035internal_resolve_intent"
+    "_range\030\r \001(\01323.cockroach.proto.InternalR"
+    "esolveIntentRangeResponseH\000\022@\n\016internal_"
+    "merge\030\016 \001(\0132&.cockroach.proto.InternalMe"
+    "rgeResponseH\000\022M\n\025internal_truncate_log\030\017"
+    " \001(\0132,.cockroach.proto.InternalTruncateL"
+    "ogResponseH\000\022:\n\013internal_gc\030\020 \001(\0132#.cock"
+    "roach.proto.InternalGCResponseH\000\022M\n\025inte"
+    "rnal_leader_lease\030\021 \001(\0132,.cockroach.prot"
+    "o.InternalLeaderLeaseResponseH\000\022@\n\016inter"
+    "nal_batch\030\022 \001(\0132&.cockroach.proto.Intern"
+    "alBatchResponseH\000:\004\310\240\037\001B\007\n\005value\"\270\014\n\030Int"
+    "ernalRaftCommandUnion\022*\n\003get\030\002 \001(\0132\033.coc"
+    "kroach.proto.GetRequestH\000\022*\n\003put\030\003 \001(\0132\033"
+    ".cockroach.proto.PutRequestH\000\022A\n\017conditi"
+    "onal_put\030\004 \001(\0132&.cockroach.proto.Conditi"
+    "onalPutRequestH\000\0226\n\tincrement\030\005 \001(\0132!.co"
+    "ckroach.proto.IncrementRequestH\000\0220\n\006dele"
+    "te\030\006 \001(\0132\036.cockroach.proto.DeleteRequest"
+    "H\000\022;\n\014delete_range\030\007 \001(\0132#.cockroach.pro"
+    "to.DeleteRangeRequestH\000\022,\n\004scan\030\010 \001(\0132\034."
+    "cockroach.proto.ScanRequestH\000\022A\n\017end_tra"
+    "nsaction\030\t \001(\0132&.cockroach.proto.EndTran"
+    "sactionRequestH\000\022;\n\014reverse_scan\030\n \001(\0132#"
+    ".cockroach.proto.ReverseScanRequestH\000\0227\n"
+    "\nreap_queue\030\013 \001(\0132!.cockroach.proto.Reap"
+    "QueueRequestH\000\022\?\n\016enqueue_update\030\014 \001(\0132%"
+    ".cockroach.proto.EnqueueUpdateRequestH\000\022"
+    "A\n\017enqueue_message\030\r \001(\0132&.cockroach.pro"
+    "to.EnqueueMessageRequestH\000\0222\n\007changes\030\016 "
+    "\001(\0132\037.cockroach.proto.ChangesRequestH\000\022."
+    "\n\005batch\030\036 \001(\0132\035.cockroach.proto.BatchReq"
+    "uestH\000\022L\n\025internal_range_lookup\030\037 \001(\0132+."
+    "cockroach.proto.InternalRangeLookupReque"
+    "stH\000\022N\n\026internal_heartbeat_txn\030  \001(\0132,.c"
+    "ockroach.proto.InternalHeartbeatTxnReque"
+    "stH\000\022D\n\021internal_push_txn\030! \001(\0132\'.cockro"
+    "ach.proto.InternalPushTxnRequestH\000\022P\n\027in"
+    "ternal_resolve_intent\030\" \001(\0132-.cockroach."
+    "proto.InternalResolveIntentRequestH\000\022[\n\035"
+    "internal_resolve_intent_range\030# \001(\01322.co"
+    "ckroach.proto.InternalResolveIntentRange"
+    "RequestH\000\022H\n\027internal_merge_response\030$ \001"
+    "(\0132%.cockroach.proto.InternalMergeReques"
+    "tH\000\022L\n\025internal_truncate_log\030% \001(\0132+.coc"
+    "kroach.proto.InternalTruncateLogRequestH"
+    "\000\022I\n\013internal_gc\030& \001(\0132\".cockroach.proto"
+    ".InternalGCRequestB\016\342\336\037\nInternalGCH\000\022E\n\016"
+    "internal_lease\030\' \001(\0132+.cockroach.proto.I"
+    "nternalLeaderLeaseRequestH\000\022\?\n\016internal_"
+    "batch\030( \001(\0132%.cockroach.proto.InternalBa"
+    "tchRequestH\000:\004\310\240\037\001B\007\n\005value\"\366\001\n\023Internal"
+    "RaftCommand\022)\n\007raft_id\030\001 \001(\003B\030\310\336\037\000\342\336\037\006Ra"
+    "ftID\372\336\037\006RaftID\022:\n\016origin_node_id\030\002 \001(\004B\""
+    "\310\336\037\000\342\336\037\014OriginNodeID\372\336\037\nRaftNodeID\022<\n\003cm"
+    "d\030\003 \001(\0132).cockroach.proto.InternalRaftCo"
+    "mmandUnionB\004\310\336\037\000\022:\n\020closed_timestamp\030\004 \001"
+    "(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\"N\n\022"
+    "RaftMessageRequest\022+\n\010group_id\030\001 \001(\004B\031\310\336"
+    "\037\000\342\336\037\007GroupID\372\336\037\006RaftID\022\013\n\003msg\030\002 \001(\014\"\025\n\023"
+    "RaftMessageResponse\"\236\001\n\026InternalTimeSeri"
+    "esData\022#\n\025start_timestamp_nanos\030\001 \001(\003B\004\310"
+    "\336\037\000\022#\n\025sample_duration_nanos\030\002 \001(\003B\004\310\336\037\000"
+    "\022:\n\007samples\030\003 \003(\0132).cockroach.proto.Inte"
+    "rnalTimeSeriesSample\"r\n\030InternalTimeSeri"
+    "esSample\022\024\n\006offset\030\001 \001(\005B\004\310\336\037\000\022\023\n\005count\030"
+    "\006 \001(\rB\004\310\336\037\000\022\021\n\003sum\030\007 \001(\001B\004\310\336\037\000\022\013\n\003max\030\010 "
+    "\001(\001\022\013\n\003min\030\t \001(\001\"=\n\022RaftTruncatedState\022\023"
+    "\n\005index\030\001 \001(\004B\004\310\336\037\000\022\022\n\004term\030\002 \001(\004B\004\310\336\037\000\""
+    "\274\001\n\020RaftSnapshotData\022@\n\020range_descriptor"
+    "\030\001 \001(\0132 .cockroach.proto.RangeDescriptor"
+    "B\004\310\336\037\000\022>\n\002KV\030\002 \003(\0132*.cockroach.proto.Raf"
+    "tSnapshotData.KeyValueB\006\342\336\037\002KV\032&\n\010KeyVal"
+    "ue\022\013\n\003key\030\001 \001(\014\022\r\n\005value\030\002 \001(\014*G\n\013PushTx"
+    "nType\022\022\n\016PUSH_TIMESTAMP\020\000\022\r\n\tABORT_TXN\020\001"
+    "\022\017\n\013CLEANUP_TXN\020\002\032\004\210\243\036\000*%\n\021InternalValue"
+    "Type\022\n\n\006_CR_TS\020\001\032\004\210\243\036\000B\023Z\005proto\340\342\036\001\310\342\036\001\320"
+    "\342\036\001", 9243);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/internal.proto", &protobuf_RegisterTypes);
   InternalRangeLookupRequest::default_instance_ = new InternalRangeLookupRequest();
@@ -1145,6 +1306,9 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
   InternalGCResponse::default_instance_ = new InternalGCResponse();
   InternalPushTxnRequest::default_instance_ = new InternalPushTxnRequest();
   InternalPushTxnResponse::default_instance_ = new InternalPushTxnResponse();
+  TxnWaitEdge::default_instance_ = new TxnWaitEdge();
+  InternalQueryTxnRequest::default_instance_ = new InternalQueryTxnRequest();
+  InternalQueryTxnResponse::default_instance_ = new InternalQueryTxnResponse();
   InternalResolveIntentRequest::default_instance_ = new InternalResolveIntentRequest();
   InternalResolveIntentResponse::default_instance_ = new InternalResolveIntentResponse();
   InternalResolveIntentRangeRequest::default_instance_ = new InternalResolveIntentRangeRequest();
@@ -1182,6 +1346,9 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
   InternalGCResponse::default_instance_->InitAsDefaultInstance();
   InternalPushTxnRequest::default_instance_->InitAsDefaultInstance();
   InternalPushTxnResponse::default_instance_->InitAsDefaultInstance();
+  TxnWaitEdge::default_instance_->InitAsDefaultInstance();
+  InternalQueryTxnRequest::default_instance_->InitAsDefaultInstance();
+  InternalQueryTxnResponse::default_instance_->InitAsDefaultInstance();
   InternalResolveIntentRequest::default_instance_->InitAsDefaultInstance();
   InternalResolveIntentResponse::default_instance_->InitAsDefaultInstance();
   InternalResolveIntentRangeRequest::default_instance_->InitAsDefaultInstance();
@@ -1260,6 +1427,7 @@ static void MergeFromFail(int line) {
 const int InternalRangeLookupRequest::kHeaderFieldNumber;
 const int InternalRangeLookupRequest::kMaxRangesFieldNumber;
 const int InternalRangeLookupRequest::kIgnoreIntentsFieldNumber;
+const int InternalRangeLookupRequest::kReverseFieldNumber;
 #endif  // !_MSC_VER
 
 InternalRangeLookupRequest::InternalRangeLookupRequest()
@@ -1285,6 +1453,7 @@ void InternalRangeLookupRequest::SharedCtor() {
   header_ = NULL;
   max_ranges_ = 0;
   ignore_intents_ = false;
+  reverse_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1333,8 +1502,8 @@ void InternalRangeLookupRequest::Clear() {
            ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
 } while (0)
 
-  if (_has_bits_[0 / 32] & 7u) {
-    ZR_(max_ranges_, ignore_intents_);
+  if (_has_bits_[0 / 32] & 15u) {
+    ZR_(max_ranges_, reverse_);
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
@@ -1397,6 +1566,21 @@ bool InternalRangeLookupRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(32)) goto parse_reverse;
+        break;
+      }
+
+      // optional bool reverse = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_reverse:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &reverse_)));
+          set_has_reverse();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1442,6 +1626,11 @@ void InternalRangeLookupRequest::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->ignore_intents(), output);
   }
 
+  // optional bool reverse = 4;
+  if (has_reverse()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->reverse(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1469,6 +1658,11 @@ void InternalRangeLookupRequest::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->ignore_intents(), target);
   }
 
+  // optional bool reverse = 4;
+  if (has_reverse()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->reverse(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1480,7 +1674,7 @@ void InternalRangeLookupRequest::SerializeWithCachedSizes(
 int InternalRangeLookupRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 7) {
+  if (_has_bits_[0 / 32] & 15) {
     // optional .cockroach.proto.RequestHeader header = 1;
     if (has_header()) {
       total_size += 1 +
@@ -1500,6 +1694,11 @@ int InternalRangeLookupRequest::ByteSize() const {
       total_size += 1 + 1;
     }
 
+    // optional bool reverse = 4;
+    if (has_reverse()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -1536,6 +1735,9 @@ void InternalRangeLookupRequest::MergeFrom(const InternalRangeLookupRequest& fro
     if (from.has_ignore_intents()) {
       set_ignore_intents(from.ignore_intents());
     }
+    if (from.has_reverse()) {
+      set_reverse(from.reverse());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -1567,6 +1769,7 @@ void InternalRangeLookupRequest::InternalSwap(InternalRangeLookupRequest* other)
   std::swap(header_, other->header_);
   std::swap(max_ranges_, other->max_ranges_);
   std::swap(ignore_intents_, other->ignore_intents_);
+  std::swap(reverse_, other->reverse_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -1674,6 +1877,30 @@ void InternalRangeLookupRequest::clear_ignore_intents() {
   // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.ignore_intents)
 }
 
+// optional bool reverse = 4;
+bool InternalRangeLookupRequest::has_reverse() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void InternalRangeLookupRequest::set_has_reverse() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void InternalRangeLookupRequest::clear_has_reverse() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void InternalRangeLookupRequest::clear_reverse() {
+  reverse_ = false;
+  clear_has_reverse();
+}
+ bool InternalRangeLookupRequest::reverse() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupRequest.reverse)
+  return reverse_;
+}
+ void InternalRangeLookupRequest::set_reverse(bool value) {
+  set_has_reverse();
+  reverse_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.reverse)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -2888,6 +3115,7 @@ void InternalGCRequest_GCKey::InternalSwap(InternalGCRequest_GCKey* other) {
 const int InternalGCRequest::kHeaderFieldNumber;
 const int InternalGCRequest::kGcMetaFieldNumber;
 const int InternalGCRequest::kKeysFieldNumber;
+const int InternalGCRequest::kNextExpirationSeqFieldNumber;
 #endif  // !_MSC_VER
 
 InternalGCRequest::InternalGCRequest()
@@ -2913,6 +3141,7 @@ void InternalGCRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   gc_meta_ = NULL;
+  next_expiration_seq_ = GOOGLE_ULONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -2954,13 +3183,14 @@ InternalGCRequest* InternalGCRequest::New(::google::protobuf::Arena* arena) cons
 }
 
 void InternalGCRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
+  if (_has_bits_[0 / 32] & 11u) {
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
     if (has_gc_meta()) {
       if (gc_meta_ != NULL) gc_meta_->::cockroach::proto::GCMetadata::Clear();
     }
+    next_expiration_seq_ = GOOGLE_ULONGLONG(0);
   }
   keys_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
@@ -3017,6 +3247,21 @@ bool InternalGCRequest::MergePartialFromCodedStream(
         }
         if (input->ExpectTag(26)) goto parse_loop_keys;
         input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectTag(32)) goto parse_next_expiration_seq;
+        break;
+      }
+
+      // optional uint64 next_expiration_seq = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_next_expiration_seq:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &next_expiration_seq_)));
+          set_has_next_expiration_seq();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3064,6 +3309,11 @@ void InternalGCRequest::SerializeWithCachedSizes(
       3, this->keys(i), output);
   }
 
+  // optional uint64 next_expiration_seq = 4;
+  if (has_next_expiration_seq()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(4, this->next_expiration_seq(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -3095,6 +3345,11 @@ void InternalGCRequest::SerializeWithCachedSizes(
         3, this->keys(i), target);
   }
 
+  // optional uint64 next_expiration_seq = 4;
+  if (has_next_expiration_seq()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(4, this->next_expiration_seq(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -3106,7 +3361,7 @@ void InternalGCRequest::SerializeWithCachedSizes(
 int InternalGCRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3) {
+  if (_has_bits_[0 / 32] & 11) {
     // optional .cockroach.proto.RequestHeader header = 1;
     if (has_header()) {
       total_size += 1 +
@@ -3121,6 +3376,13 @@ int InternalGCRequest::ByteSize() const {
           *this->gc_meta_);
     }
 
+    // optional uint64 next_expiration_seq = 4;
+    if (has_next_expiration_seq()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->next_expiration_seq());
+    }
+
   }
   // repeated .cockroach.proto.InternalGCRequest.GCKey keys = 3;
   total_size += 1 * this->keys_size();
@@ -3163,6 +3425,9 @@ void InternalGCRequest::MergeFrom(const InternalGCRequest& from) {
     if (from.has_gc_meta()) {
       mutable_gc_meta()->::cockroach::proto::GCMetadata::MergeFrom(from.gc_meta());
     }
+    if (from.has_next_expiration_seq()) {
+      set_next_expiration_seq(from.next_expiration_seq());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -3194,6 +3459,7 @@ void InternalGCRequest::InternalSwap(InternalGCRequest* other) {
   std::swap(header_, other->header_);
   std::swap(gc_meta_, other->gc_meta_);
   keys_.UnsafeArenaSwap(&other->keys_);
+  std::swap(next_expiration_seq_, other->next_expiration_seq_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -3426,6 +3692,30 @@ InternalGCRequest::mutable_keys() {
   return &keys_;
 }
 
+// optional uint64 next_expiration_seq = 4;
+bool InternalGCRequest::has_next_expiration_seq() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void InternalGCRequest::set_has_next_expiration_seq() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void InternalGCRequest::clear_has_next_expiration_seq() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void InternalGCRequest::clear_next_expiration_seq() {
+  next_expiration_seq_ = GOOGLE_ULONGLONG(0);
+  clear_has_next_expiration_seq();
+}
+ ::google::protobuf::uint64 InternalGCRequest::next_expiration_seq() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.next_expiration_seq)
+  return next_expiration_seq_;
+}
+ void InternalGCRequest::set_next_expiration_seq(::google::protobuf::uint64 value) {
+  set_has_next_expiration_seq();
+  next_expiration_seq_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalGCRequest.next_expiration_seq)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -4691,72 +4981,83 @@ void InternalPushTxnResponse::clear_pushee_txn() {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int InternalResolveIntentRequest::kHeaderFieldNumber;
+const int TxnWaitEdge::kPusherIdFieldNumber;
+const int TxnWaitEdge::kPusherPriorityFieldNumber;
+const int TxnWaitEdge::kPusheeIdFieldNumber;
 #endif  // !_MSC_VER
 
-InternalResolveIntentRequest::InternalResolveIntentRequest()
+TxnWaitEdge::TxnWaitEdge()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.InternalResolveIntentRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.TxnWaitEdge)
 }
 
-void InternalResolveIntentRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+void TxnWaitEdge::InitAsDefaultInstance() {
 }
 
-InternalResolveIntentRequest::InternalResolveIntentRequest(const InternalResolveIntentRequest& from)
+TxnWaitEdge::TxnWaitEdge(const TxnWaitEdge& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalResolveIntentRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.TxnWaitEdge)
 }
 
-void InternalResolveIntentRequest::SharedCtor() {
+void TxnWaitEdge::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  header_ = NULL;
+  pusher_id_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  pusher_priority_ = 0;
+  pushee_id_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-InternalResolveIntentRequest::~InternalResolveIntentRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.InternalResolveIntentRequest)
+TxnWaitEdge::~TxnWaitEdge() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.TxnWaitEdge)
   SharedDtor();
 }
 
-void InternalResolveIntentRequest::SharedDtor() {
+void TxnWaitEdge::SharedDtor() {
+  pusher_id_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  pushee_id_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != default_instance_) {
-    delete header_;
   }
 }
 
-void InternalResolveIntentRequest::SetCachedSize(int size) const {
+void TxnWaitEdge::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* InternalResolveIntentRequest::descriptor() {
+const ::google::protobuf::Descriptor* TxnWaitEdge::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return InternalResolveIntentRequest_descriptor_;
+  return TxnWaitEdge_descriptor_;
 }
 
-const InternalResolveIntentRequest& InternalResolveIntentRequest::default_instance() {
+const TxnWaitEdge& TxnWaitEdge::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   return *default_instance_;
 }
 
-InternalResolveIntentRequest* InternalResolveIntentRequest::default_instance_ = NULL;
+TxnWaitEdge* TxnWaitEdge::default_instance_ = NULL;
 
-InternalResolveIntentRequest* InternalResolveIntentRequest::New(::google::protobuf::Arena* arena) const {
-  InternalResolveIntentRequest* n = new InternalResolveIntentRequest;
+TxnWaitEdge* TxnWaitEdge::New(::google::protobuf::Arena* arena) const {
+  TxnWaitEdge* n = new TxnWaitEdge;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void InternalResolveIntentRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+void TxnWaitEdge::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_pusher_id()) {
+      pusher_id_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+    pusher_priority_ = 0;
+    if (has_pushee_id()) {
+      pushee_id_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -4764,21 +5065,49 @@ void InternalResolveIntentRequest::Clear() {
   }
 }
 
-bool InternalResolveIntentRequest::MergePartialFromCodedStream(
+bool TxnWaitEdge::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalResolveIntentRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.TxnWaitEdge)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.RequestHeader header = 1;
+      // optional bytes pusher_id = 1;
       case 1: {
         if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_header()));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_pusher_id()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_pusher_priority;
+        break;
+      }
+
+      // optional int32 pusher_priority = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_pusher_priority:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &pusher_priority_)));
+          set_has_pusher_priority();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_pushee_id;
+        break;
+      }
+
+      // optional bytes pushee_id = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_pushee_id:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_pushee_id()));
         } else {
           goto handle_unusual;
         }
@@ -4800,58 +5129,97 @@ bool InternalResolveIntentRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalResolveIntentRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.TxnWaitEdge)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalResolveIntentRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.TxnWaitEdge)
   return false;
 #undef DO_
 }
 
-void InternalResolveIntentRequest::SerializeWithCachedSizes(
+void TxnWaitEdge::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalResolveIntentRequest)
-  // optional .cockroach.proto.RequestHeader header = 1;
-  if (has_header()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, *this->header_, output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.TxnWaitEdge)
+  // optional bytes pusher_id = 1;
+  if (has_pusher_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->pusher_id(), output);
+  }
+
+  // optional int32 pusher_priority = 2;
+  if (has_pusher_priority()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->pusher_priority(), output);
+  }
+
+  // optional bytes pushee_id = 3;
+  if (has_pushee_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->pushee_id(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalResolveIntentRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.TxnWaitEdge)
 }
 
-::google::protobuf::uint8* InternalResolveIntentRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* TxnWaitEdge::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalResolveIntentRequest)
-  // optional .cockroach.proto.RequestHeader header = 1;
-  if (has_header()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, *this->header_, target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.TxnWaitEdge)
+  // optional bytes pusher_id = 1;
+  if (has_pusher_id()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->pusher_id(), target);
+  }
+
+  // optional int32 pusher_priority = 2;
+  if (has_pusher_priority()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->pusher_priority(), target);
+  }
+
+  // optional bytes pushee_id = 3;
+  if (has_pushee_id()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->pushee_id(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalResolveIntentRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.TxnWaitEdge)
   return target;
 }
 
-int InternalResolveIntentRequest::ByteSize() const {
+int TxnWaitEdge::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.proto.RequestHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 7) {
+    // optional bytes pusher_id = 1;
+    if (has_pusher_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->pusher_id());
+    }
+
+    // optional int32 pusher_priority = 2;
+    if (has_pusher_priority()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->pusher_priority());
+    }
 
+    // optional bytes pushee_id = 3;
+    if (has_pushee_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->pushee_id());
+    }
+
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -4863,10 +5231,10 @@ int InternalResolveIntentRequest::ByteSize() const {
   return total_size;
 }
 
-void InternalResolveIntentRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void TxnWaitEdge::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const InternalResolveIntentRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const InternalResolveIntentRequest>(
+  const TxnWaitEdge* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const TxnWaitEdge>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -4875,11 +5243,19 @@ void InternalResolveIntentRequest::MergeFrom(const ::google::protobuf::Message&
   }
 }
 
-void InternalResolveIntentRequest::MergeFrom(const InternalResolveIntentRequest& from) {
+void TxnWaitEdge::MergeFrom(const TxnWaitEdge& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_header()) {
-      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    if (from.has_pusher_id()) {
+      set_has_pusher_id();
+      pusher_id_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.pusher_id_);
+    }
+    if (from.has_pusher_priority()) {
+      set_pusher_priority(from.pusher_priority());
+    }
+    if (from.has_pushee_id()) {
+      set_has_pushee_id();
+      pushee_id_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.pushee_id_);
     }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
@@ -4887,104 +5263,1296 @@ void InternalResolveIntentRequest::MergeFrom(const InternalResolveIntentRequest&
   }
 }
 
-void InternalResolveIntentRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void TxnWaitEdge::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void InternalResolveIntentRequest::CopyFrom(const InternalResolveIntentRequest& from) {
+void TxnWaitEdge::CopyFrom(const TxnWaitEdge& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool InternalResolveIntentRequest::IsInitialized() const {
+bool TxnWaitEdge::IsInitialized() const {
 
   return true;
 }
 
-void InternalResolveIntentRequest::Swap(InternalResolveIntentRequest* other) {
+void TxnWaitEdge::Swap(TxnWaitEdge* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void InternalResolveIntentRequest::InternalSwap(InternalResolveIntentRequest* other) {
-  std::swap(header_, other->header_);
+void TxnWaitEdge::InternalSwap(TxnWaitEdge* other) {
+  pusher_id_.Swap(&other->pusher_id_);
+  std::swap(pusher_priority_, other->pusher_priority_);
+  pushee_id_.Swap(&other->pushee_id_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata InternalResolveIntentRequest::GetMetadata() const {
+::google::protobuf::Metadata TxnWaitEdge::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = InternalResolveIntentRequest_descriptor_;
-  metadata.reflection = InternalResolveIntentRequest_reflection_;
+  metadata.descriptor = TxnWaitEdge_descriptor_;
+  metadata.reflection = TxnWaitEdge_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// InternalResolveIntentRequest
+// TxnWaitEdge
 
-// optional .cockroach.proto.RequestHeader header = 1;
-bool InternalResolveIntentRequest::has_header() const {
+// optional bytes pusher_id = 1;
+bool TxnWaitEdge::has_pusher_id() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void InternalResolveIntentRequest::set_has_header() {
+void TxnWaitEdge::set_has_pusher_id() {
   _has_bits_[0] |= 0x00000001u;
 }
-void InternalResolveIntentRequest::clear_has_header() {
+void TxnWaitEdge::clear_has_pusher_id() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void InternalResolveIntentRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+void TxnWaitEdge::clear_pusher_id() {
+  pusher_id_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_pusher_id();
 }
- const ::cockroach::proto::RequestHeader& InternalResolveIntentRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+ const ::std::string& TxnWaitEdge::pusher_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.TxnWaitEdge.pusher_id)
+  return pusher_id_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
- ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::proto::RequestHeader;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentRequest.header)
-  return header_;
+ void TxnWaitEdge::set_pusher_id(const ::std::string& value) {
+  set_has_pusher_id();
+  pusher_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.TxnWaitEdge.pusher_id)
 }
- ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+ void TxnWaitEdge::set_pusher_id(const char* value) {
+  set_has_pusher_id();
+  pusher_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.TxnWaitEdge.pusher_id)
 }
- void InternalResolveIntentRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+ void TxnWaitEdge::set_pusher_id(const void* value, size_t size) {
+  set_has_pusher_id();
+  pusher_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.TxnWaitEdge.pusher_id)
+}
+ ::std::string* TxnWaitEdge::mutable_pusher_id() {
+  set_has_pusher_id();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.TxnWaitEdge.pusher_id)
+  return pusher_id_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* TxnWaitEdge::release_pusher_id() {
+  clear_has_pusher_id();
+  return pusher_id_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void TxnWaitEdge::set_allocated_pusher_id(::std::string* pusher_id) {
+  if (pusher_id != NULL) {
+    set_has_pusher_id();
   } else {
-    clear_has_header();
+    clear_has_pusher_id();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRequest.header)
+  pusher_id_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), pusher_id);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.TxnWaitEdge.pusher_id)
 }
 
-#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
-
-// ===================================================================
-
-#ifndef _MSC_VER
-const int InternalResolveIntentResponse::kHeaderFieldNumber;
-#endif  // !_MSC_VER
-
-InternalResolveIntentResponse::InternalResolveIntentResponse()
-  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
-  SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.InternalResolveIntentResponse)
+// optional int32 pusher_priority = 2;
+bool TxnWaitEdge::has_pusher_priority() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-
-void InternalResolveIntentResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+void TxnWaitEdge::set_has_pusher_priority() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void TxnWaitEdge::clear_has_pusher_priority() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void TxnWaitEdge::clear_pusher_priority() {
+  pusher_priority_ = 0;
+  clear_has_pusher_priority();
+}
+ ::google::protobuf::int32 TxnWaitEdge::pusher_priority() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.TxnWaitEdge.pusher_priority)
+  return pusher_priority_;
+}
+ void TxnWaitEdge::set_pusher_priority(::google::protobuf::int32 value) {
+  set_has_pusher_priority();
+  pusher_priority_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.TxnWaitEdge.pusher_priority)
+}
+
+// optional bytes pushee_id = 3;
+bool TxnWaitEdge::has_pushee_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void TxnWaitEdge::set_has_pushee_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void TxnWaitEdge::clear_has_pushee_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void TxnWaitEdge::clear_pushee_id() {
+  pushee_id_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_pushee_id();
+}
+ const ::std::string& TxnWaitEdge::pushee_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.TxnWaitEdge.pushee_id)
+  return pushee_id_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void TxnWaitEdge::set_pushee_id(const ::std::string& value) {
+  set_has_pushee_id();
+  pushee_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.TxnWaitEdge.pushee_id)
+}
+ void TxnWaitEdge::set_pushee_id(const char* value) {
+  set_has_pushee_id();
+  pushee_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.TxnWaitEdge.pushee_id)
+}
+ void TxnWaitEdge::set_pushee_id(const void* value, size_t size) {
+  set_has_pushee_id();
+  pushee_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.TxnWaitEdge.pushee_id)
+}
+ ::std::string* TxnWaitEdge::mutable_pushee_id() {
+  set_has_pushee_id();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.TxnWaitEdge.pushee_id)
+  return pushee_id_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* TxnWaitEdge::release_pushee_id() {
+  clear_has_pushee_id();
+  return pushee_id_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void TxnWaitEdge::set_allocated_pushee_id(::std::string* pushee_id) {
+  if (pushee_id != NULL) {
+    set_has_pushee_id();
+  } else {
+    clear_has_pushee_id();
+  }
+  pushee_id_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), pushee_id);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.TxnWaitEdge.pushee_id)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalQueryTxnRequest::kHeaderFieldNumber;
+const int InternalQueryTxnRequest::kQueriedTxnFieldNumber;
+#endif  // !_MSC_VER
+
+InternalQueryTxnRequest::InternalQueryTxnRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalQueryTxnRequest)
+}
+
+void InternalQueryTxnRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  queried_txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
+}
+
+InternalQueryTxnRequest::InternalQueryTxnRequest(const InternalQueryTxnRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalQueryTxnRequest)
+}
+
+void InternalQueryTxnRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  queried_txn_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalQueryTxnRequest::~InternalQueryTxnRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalQueryTxnRequest)
+  SharedDtor();
+}
+
+void InternalQueryTxnRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete queried_txn_;
+  }
+}
+
+void InternalQueryTxnRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalQueryTxnRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalQueryTxnRequest_descriptor_;
+}
+
+const InternalQueryTxnRequest& InternalQueryTxnRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalQueryTxnRequest* InternalQueryTxnRequest::default_instance_ = NULL;
+
+InternalQueryTxnRequest* InternalQueryTxnRequest::New(::google::protobuf::Arena* arena) const {
+  InternalQueryTxnRequest* n = new InternalQueryTxnRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void InternalQueryTxnRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_queried_txn()) {
+      if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool InternalQueryTxnRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalQueryTxnRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_queried_txn;
+        break;
+      }
+
+      // optional .cockroach.proto.Transaction queried_txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_queried_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_queried_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalQueryTxnRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalQueryTxnRequest)
+  return false;
+#undef DO_
+}
+
+void InternalQueryTxnRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalQueryTxnRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  if (has_queried_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->queried_txn_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalQueryTxnRequest)
+}
+
+::google::protobuf::uint8* InternalQueryTxnRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalQueryTxnRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  if (has_queried_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->queried_txn_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalQueryTxnRequest)
+  return target;
+}
+
+int InternalQueryTxnRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Transaction queried_txn = 2;
+    if (has_queried_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->queried_txn_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalQueryTxnRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const InternalQueryTxnRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const InternalQueryTxnRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalQueryTxnRequest::MergeFrom(const InternalQueryTxnRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_queried_txn()) {
+      mutable_queried_txn()->::cockroach::proto::Transaction::MergeFrom(from.queried_txn());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void InternalQueryTxnRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalQueryTxnRequest::CopyFrom(const InternalQueryTxnRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalQueryTxnRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalQueryTxnRequest::Swap(InternalQueryTxnRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void InternalQueryTxnRequest::InternalSwap(InternalQueryTxnRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(queried_txn_, other->queried_txn_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata InternalQueryTxnRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalQueryTxnRequest_descriptor_;
+  metadata.reflection = InternalQueryTxnRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// InternalQueryTxnRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool InternalQueryTxnRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void InternalQueryTxnRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void InternalQueryTxnRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void InternalQueryTxnRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& InternalQueryTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* InternalQueryTxnRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* InternalQueryTxnRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void InternalQueryTxnRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnRequest.header)
+}
+
+// optional .cockroach.proto.Transaction queried_txn = 2;
+bool InternalQueryTxnRequest::has_queried_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void InternalQueryTxnRequest::set_has_queried_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void InternalQueryTxnRequest::clear_has_queried_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void InternalQueryTxnRequest::clear_queried_txn() {
+  if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_queried_txn();
+}
+ const ::cockroach::proto::Transaction& InternalQueryTxnRequest::queried_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnRequest.queried_txn)
+  return queried_txn_ != NULL ? *queried_txn_ : *default_instance_->queried_txn_;
+}
+ ::cockroach::proto::Transaction* InternalQueryTxnRequest::mutable_queried_txn() {
+  set_has_queried_txn();
+  if (queried_txn_ == NULL) {
+    queried_txn_ = new ::cockroach::proto::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnRequest.queried_txn)
+  return queried_txn_;
+}
+ ::cockroach::proto::Transaction* InternalQueryTxnRequest::release_queried_txn() {
+  clear_has_queried_txn();
+  ::cockroach::proto::Transaction* temp = queried_txn_;
+  queried_txn_ = NULL;
+  return temp;
+}
+ void InternalQueryTxnRequest::set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn) {
+  delete queried_txn_;
+  queried_txn_ = queried_txn;
+  if (queried_txn) {
+    set_has_queried_txn();
+  } else {
+    clear_has_queried_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnRequest.queried_txn)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalQueryTxnResponse::kHeaderFieldNumber;
+const int InternalQueryTxnResponse::kQueriedTxnFieldNumber;
+const int InternalQueryTxnResponse::kWaitsFieldNumber;
+#endif  // !_MSC_VER
+
+InternalQueryTxnResponse::InternalQueryTxnResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalQueryTxnResponse)
+}
+
+void InternalQueryTxnResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  queried_txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
+}
+
+InternalQueryTxnResponse::InternalQueryTxnResponse(const InternalQueryTxnResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalQueryTxnResponse)
+}
+
+void InternalQueryTxnResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  queried_txn_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalQueryTxnResponse::~InternalQueryTxnResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalQueryTxnResponse)
+  SharedDtor();
+}
+
+void InternalQueryTxnResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete queried_txn_;
+  }
+}
+
+void InternalQueryTxnResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalQueryTxnResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalQueryTxnResponse_descriptor_;
+}
+
+const InternalQueryTxnResponse& InternalQueryTxnResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalQueryTxnResponse* InternalQueryTxnResponse::default_instance_ = NULL;
+
+InternalQueryTxnResponse* InternalQueryTxnResponse::New(::google::protobuf::Arena* arena) const {
+  InternalQueryTxnResponse* n = new InternalQueryTxnResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void InternalQueryTxnResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_queried_txn()) {
+      if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+    }
+  }
+  waits_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool InternalQueryTxnResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalQueryTxnResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_queried_txn;
+        break;
+      }
+
+      // optional .cockroach.proto.Transaction queried_txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_queried_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_queried_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_waits;
+        break;
+      }
+
+      // repeated .cockroach.proto.TxnWaitEdge waits = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_waits:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_waits:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_waits()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_loop_waits;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalQueryTxnResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalQueryTxnResponse)
+  return false;
+#undef DO_
+}
+
+void InternalQueryTxnResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalQueryTxnResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  if (has_queried_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->queried_txn_, output);
+  }
+
+  // repeated .cockroach.proto.TxnWaitEdge waits = 3;
+  for (unsigned int i = 0, n = this->waits_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->waits(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalQueryTxnResponse)
+}
+
+::google::protobuf::uint8* InternalQueryTxnResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalQueryTxnResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  if (has_queried_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->queried_txn_, target);
+  }
+
+  // repeated .cockroach.proto.TxnWaitEdge waits = 3;
+  for (unsigned int i = 0, n = this->waits_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->waits(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalQueryTxnResponse)
+  return target;
+}
+
+int InternalQueryTxnResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Transaction queried_txn = 2;
+    if (has_queried_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->queried_txn_);
+    }
+
+  }
+  // repeated .cockroach.proto.TxnWaitEdge waits = 3;
+  total_size += 1 * this->waits_size();
+  for (int i = 0; i < this->waits_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->waits(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalQueryTxnResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const InternalQueryTxnResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const InternalQueryTxnResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalQueryTxnResponse::MergeFrom(const InternalQueryTxnResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  waits_.MergeFrom(from.waits_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_queried_txn()) {
+      mutable_queried_txn()->::cockroach::proto::Transaction::MergeFrom(from.queried_txn());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void InternalQueryTxnResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalQueryTxnResponse::CopyFrom(const InternalQueryTxnResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalQueryTxnResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalQueryTxnResponse::Swap(InternalQueryTxnResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void InternalQueryTxnResponse::InternalSwap(InternalQueryTxnResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(queried_txn_, other->queried_txn_);
+  waits_.UnsafeArenaSwap(&other->waits_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata InternalQueryTxnResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalQueryTxnResponse_descriptor_;
+  metadata.reflection = InternalQueryTxnResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// InternalQueryTxnResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool InternalQueryTxnResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void InternalQueryTxnResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void InternalQueryTxnResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void InternalQueryTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& InternalQueryTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* InternalQueryTxnResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* InternalQueryTxnResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void InternalQueryTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnResponse.header)
+}
+
+// optional .cockroach.proto.Transaction queried_txn = 2;
+bool InternalQueryTxnResponse::has_queried_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void InternalQueryTxnResponse::set_has_queried_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void InternalQueryTxnResponse::clear_has_queried_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void InternalQueryTxnResponse::clear_queried_txn() {
+  if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_queried_txn();
+}
+ const ::cockroach::proto::Transaction& InternalQueryTxnResponse::queried_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+  return queried_txn_ != NULL ? *queried_txn_ : *default_instance_->queried_txn_;
+}
+ ::cockroach::proto::Transaction* InternalQueryTxnResponse::mutable_queried_txn() {
+  set_has_queried_txn();
+  if (queried_txn_ == NULL) {
+    queried_txn_ = new ::cockroach::proto::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+  return queried_txn_;
+}
+ ::cockroach::proto::Transaction* InternalQueryTxnResponse::release_queried_txn() {
+  clear_has_queried_txn();
+  ::cockroach::proto::Transaction* temp = queried_txn_;
+  queried_txn_ = NULL;
+  return temp;
+}
+ void InternalQueryTxnResponse::set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn) {
+  delete queried_txn_;
+  queried_txn_ = queried_txn;
+  if (queried_txn) {
+    set_has_queried_txn();
+  } else {
+    clear_has_queried_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+}
+
+// repeated .cockroach.proto.TxnWaitEdge waits = 3;
+int InternalQueryTxnResponse::waits_size() const {
+  return waits_.size();
+}
+void InternalQueryTxnResponse::clear_waits() {
+  waits_.Clear();
+}
+ const ::cockroach::proto::TxnWaitEdge& InternalQueryTxnResponse::waits(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.waits)
+  return waits_.Get(index);
+}
+ ::cockroach::proto::TxnWaitEdge* InternalQueryTxnResponse::mutable_waits(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.waits)
+  return waits_.Mutable(index);
+}
+ ::cockroach::proto::TxnWaitEdge* InternalQueryTxnResponse::add_waits() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.InternalQueryTxnResponse.waits)
+  return waits_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TxnWaitEdge >&
+InternalQueryTxnResponse::waits() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.InternalQueryTxnResponse.waits)
+  return waits_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TxnWaitEdge >*
+InternalQueryTxnResponse::mutable_waits() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalQueryTxnResponse.waits)
+  return &waits_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalResolveIntentRequest::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalResolveIntentRequest::InternalResolveIntentRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalResolveIntentRequest)
+}
+
+void InternalResolveIntentRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+InternalResolveIntentRequest::InternalResolveIntentRequest(const InternalResolveIntentRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalResolveIntentRequest)
+}
+
+void InternalResolveIntentRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalResolveIntentRequest::~InternalResolveIntentRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalResolveIntentRequest)
+  SharedDtor();
+}
+
+void InternalResolveIntentRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalResolveIntentRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalResolveIntentRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalResolveIntentRequest_descriptor_;
+}
+
+const InternalResolveIntentRequest& InternalResolveIntentRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalResolveIntentRequest* InternalResolveIntentRequest::default_instance_ = NULL;
+
+InternalResolveIntentRequest* InternalResolveIntentRequest::New(::google::protobuf::Arena* arena) const {
+  InternalResolveIntentRequest* n = new InternalResolveIntentRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void InternalResolveIntentRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool InternalResolveIntentRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalResolveIntentRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalResolveIntentRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalResolveIntentRequest)
+  return false;
+#undef DO_
+}
+
+void InternalResolveIntentRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalResolveIntentRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalResolveIntentRequest)
+}
+
+::google::protobuf::uint8* InternalResolveIntentRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalResolveIntentRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalResolveIntentRequest)
+  return target;
+}
+
+int InternalResolveIntentRequest::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalResolveIntentRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const InternalResolveIntentRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const InternalResolveIntentRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalResolveIntentRequest::MergeFrom(const InternalResolveIntentRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void InternalResolveIntentRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalResolveIntentRequest::CopyFrom(const InternalResolveIntentRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalResolveIntentRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalResolveIntentRequest::Swap(InternalResolveIntentRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void InternalResolveIntentRequest::InternalSwap(InternalResolveIntentRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata InternalResolveIntentRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalResolveIntentRequest_descriptor_;
+  metadata.reflection = InternalResolveIntentRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// InternalResolveIntentRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool InternalResolveIntentRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void InternalResolveIntentRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void InternalResolveIntentRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void InternalResolveIntentRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& InternalResolveIntentRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void InternalResolveIntentRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRequest.header)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalResolveIntentResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalResolveIntentResponse::InternalResolveIntentResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalResolveIntentResponse)
+}
+
+void InternalResolveIntentResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
 
 InternalResolveIntentResponse::InternalResolveIntentResponse(const InternalResolveIntentResponse& from)
@@ -5258,6 +6826,9 @@ void InternalResolveIntentResponse::clear_header() {
 
 #ifndef _MSC_VER
 const int InternalResolveIntentRangeRequest::kHeaderFieldNumber;
+const int InternalResolveIntentRangeRequest::kRollbackFieldNumber;
+const int InternalResolveIntentRangeRequest::kRestoreFieldNumber;
+const int InternalResolveIntentRangeRequest::kRestoreDeletedFieldNumber;
 #endif  // !_MSC_VER
 
 InternalResolveIntentRangeRequest::InternalResolveIntentRangeRequest()
@@ -5268,6 +6839,7 @@ InternalResolveIntentRangeRequest::InternalResolveIntentRangeRequest()
 
 void InternalResolveIntentRangeRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  restore_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
 }
 
 InternalResolveIntentRangeRequest::InternalResolveIntentRangeRequest(const InternalResolveIntentRangeRequest& from)
@@ -5281,6 +6853,9 @@ InternalResolveIntentRangeRequest::InternalResolveIntentRangeRequest(const Inter
 void InternalResolveIntentRangeRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  rollback_ = false;
+  restore_ = NULL;
+  restore_deleted_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -5292,6 +6867,7 @@ InternalResolveIntentRangeRequest::~InternalResolveIntentRangeRequest() {
 void InternalResolveIntentRangeRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete restore_;
   }
 }
 
@@ -5321,8 +6897,15 @@ InternalResolveIntentRangeRequest* InternalResolveIntentRangeRequest::New(::goog
 }
 
 void InternalResolveIntentRangeRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  if (_has_bits_[0 / 32] & 15u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    rollback_ = false;
+    if (has_restore()) {
+      if (restore_ != NULL) restore_->::cockroach::proto::Value::Clear();
+    }
+    restore_deleted_ = false;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -5348,6 +6931,49 @@ bool InternalResolveIntentRangeRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_rollback;
+        break;
+      }
+
+      // optional bool rollback = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_rollback:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &rollback_)));
+          set_has_rollback();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_restore;
+        break;
+      }
+
+      // optional .cockroach.proto.Value restore = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_restore:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_restore()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_restore_deleted;
+        break;
+      }
+
+      // optional bool restore_deleted = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_restore_deleted:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &restore_deleted_)));
+          set_has_restore_deleted();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -5383,6 +7009,22 @@ void InternalResolveIntentRangeRequest::SerializeWithCachedSizes(
       1, *this->header_, output);
   }
 
+  // optional bool rollback = 2;
+  if (has_rollback()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->rollback(), output);
+  }
+
+  // optional .cockroach.proto.Value restore = 3;
+  if (has_restore()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, *this->restore_, output);
+  }
+
+  // optional bool restore_deleted = 4;
+  if (has_restore_deleted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->restore_deleted(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -5400,6 +7042,23 @@ void InternalResolveIntentRangeRequest::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
+  // optional bool rollback = 2;
+  if (has_rollback()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->rollback(), target);
+  }
+
+  // optional .cockroach.proto.Value restore = 3;
+  if (has_restore()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, *this->restore_, target);
+  }
+
+  // optional bool restore_deleted = 4;
+  if (has_restore_deleted()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->restore_deleted(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -5411,13 +7070,32 @@ void InternalResolveIntentRangeRequest::SerializeWithCachedSizes(
 int InternalResolveIntentRangeRequest::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.proto.RequestHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 15) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional bool rollback = 2;
+    if (has_rollback()) {
+      total_size += 1 + 1;
+    }
+
+    // optional .cockroach.proto.Value restore = 3;
+    if (has_restore()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->restore_);
+    }
+
+    // optional bool restore_deleted = 4;
+    if (has_restore_deleted()) {
+      total_size += 1 + 1;
+    }
 
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -5447,6 +7125,15 @@ void InternalResolveIntentRangeRequest::MergeFrom(const InternalResolveIntentRan
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
+    if (from.has_rollback()) {
+      set_rollback(from.rollback());
+    }
+    if (from.has_restore()) {
+      mutable_restore()->::cockroach::proto::Value::MergeFrom(from.restore());
+    }
+    if (from.has_restore_deleted()) {
+      set_restore_deleted(from.restore_deleted());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -5476,6 +7163,9 @@ void InternalResolveIntentRangeRequest::Swap(InternalResolveIntentRangeRequest*
 }
 void InternalResolveIntentRangeRequest::InternalSwap(InternalResolveIntentRangeRequest* other) {
   std::swap(header_, other->header_);
+  std::swap(rollback_, other->rollback_);
+  std::swap(restore_, other->restore_);
+  std::swap(restore_deleted_, other->restore_deleted_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -5535,6 +7225,97 @@ void InternalResolveIntentRangeRequest::clear_header() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRangeRequest.header)
 }
 
+// optional bool rollback = 2;
+bool InternalResolveIntentRangeRequest::has_rollback() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void InternalResolveIntentRangeRequest::set_has_rollback() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void InternalResolveIntentRangeRequest::clear_has_rollback() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void InternalResolveIntentRangeRequest::clear_rollback() {
+  rollback_ = false;
+  clear_has_rollback();
+}
+ bool InternalResolveIntentRangeRequest::rollback() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRangeRequest.rollback)
+  return rollback_;
+}
+ void InternalResolveIntentRangeRequest::set_rollback(bool value) {
+  set_has_rollback();
+  rollback_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalResolveIntentRangeRequest.rollback)
+}
+
+// optional .cockroach.proto.Value restore = 3;
+bool InternalResolveIntentRangeRequest::has_restore() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void InternalResolveIntentRangeRequest::set_has_restore() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void InternalResolveIntentRangeRequest::clear_has_restore() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void InternalResolveIntentRangeRequest::clear_restore() {
+  if (restore_ != NULL) restore_->::cockroach::proto::Value::Clear();
+  clear_has_restore();
+}
+ const ::cockroach::proto::Value& InternalResolveIntentRangeRequest::restore() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRangeRequest.restore)
+  return restore_ != NULL ? *restore_ : *default_instance_->restore_;
+}
+ ::cockroach::proto::Value* InternalResolveIntentRangeRequest::mutable_restore() {
+  set_has_restore();
+  if (restore_ == NULL) {
+    restore_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentRangeRequest.restore)
+  return restore_;
+}
+ ::cockroach::proto::Value* InternalResolveIntentRangeRequest::release_restore() {
+  clear_has_restore();
+  ::cockroach::proto::Value* temp = restore_;
+  restore_ = NULL;
+  return temp;
+}
+ void InternalResolveIntentRangeRequest::set_allocated_restore(::cockroach::proto::Value* restore) {
+  delete restore_;
+  restore_ = restore;
+  if (restore) {
+    set_has_restore();
+  } else {
+    clear_has_restore();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRangeRequest.restore)
+}
+
+// optional bool restore_deleted = 4;
+bool InternalResolveIntentRangeRequest::has_restore_deleted() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void InternalResolveIntentRangeRequest::set_has_restore_deleted() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void InternalResolveIntentRangeRequest::clear_has_restore_deleted() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void InternalResolveIntentRangeRequest::clear_restore_deleted() {
+  restore_deleted_ = false;
+  clear_has_restore_deleted();
+}
+ bool InternalResolveIntentRangeRequest::restore_deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRangeRequest.restore_deleted)
+  return restore_deleted_;
+}
+ void InternalResolveIntentRangeRequest::set_restore_deleted(bool value) {
+  set_has_restore_deleted();
+  restore_deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalResolveIntentRangeRequest.restore_deleted)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -7778,6 +9559,11 @@ const int InternalRequestUnion::kDeleteFieldNumber;
 const int InternalRequestUnion::kDeleteRangeFieldNumber;
 const int InternalRequestUnion::kScanFieldNumber;
 const int InternalRequestUnion::kEndTransactionFieldNumber;
+const int InternalRequestUnion::kReverseScanFieldNumber;
+const int InternalRequestUnion::kReapQueueFieldNumber;
+const int InternalRequestUnion::kEnqueueUpdateFieldNumber;
+const int InternalRequestUnion::kEnqueueMessageFieldNumber;
+const int InternalRequestUnion::kChangesFieldNumber;
 const int InternalRequestUnion::kInternalPushTxnFieldNumber;
 const int InternalRequestUnion::kInternalResolveIntentFieldNumber;
 const int InternalRequestUnion::kInternalResolveIntentRangeFieldNumber;
@@ -7798,6 +9584,11 @@ void InternalRequestUnion::InitAsDefaultInstance() {
   InternalRequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->reverse_scan_ = const_cast< ::cockroach::proto::ReverseScanRequest*>(&::cockroach::proto::ReverseScanRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->reap_queue_ = const_cast< ::cockroach::proto::ReapQueueRequest*>(&::cockroach::proto::ReapQueueRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->enqueue_update_ = const_cast< ::cockroach::proto::EnqueueUpdateRequest*>(&::cockroach::proto::EnqueueUpdateRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->enqueue_message_ = const_cast< ::cockroach::proto::EnqueueMessageRequest*>(&::cockroach::proto::EnqueueMessageRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->changes_ = const_cast< ::cockroach::proto::ChangesRequest*>(&::cockroach::proto::ChangesRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnRequest*>(&::cockroach::proto::InternalPushTxnRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentRequest*>(&::cockroach::proto::InternalResolveIntentRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->internal_resolve_intent_range_ = const_cast< ::cockroach::proto::InternalResolveIntentRangeRequest*>(&::cockroach::proto::InternalResolveIntentRangeRequest::default_instance());
@@ -7889,6 +9680,26 @@ void InternalRequestUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kReverseScan: {
+      delete value_.reverse_scan_;
+      break;
+    }
+    case kReapQueue: {
+      delete value_.reap_queue_;
+      break;
+    }
+    case kEnqueueUpdate: {
+      delete value_.enqueue_update_;
+      break;
+    }
+    case kEnqueueMessage: {
+      delete value_.enqueue_message_;
+      break;
+    }
+    case kChanges: {
+      delete value_.changes_;
+      break;
+    }
     case kInternalPushTxn: {
       delete value_.internal_push_txn_;
       break;
@@ -7987,42 +9798,107 @@ bool InternalRequestUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(58)) goto parse_delete_range;
+        if (input->ExpectTag(58)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanRequest scan = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_reverse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_reverse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reverse_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_reap_queue;
+        break;
+      }
+
+      // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_reap_queue:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reap_queue()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_enqueue_update;
         break;
       }
 
-      // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_delete_range:
+      // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_enqueue_update:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
+               input, mutable_enqueue_update()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(66)) goto parse_scan;
+        if (input->ExpectTag(106)) goto parse_enqueue_message;
         break;
       }
 
-      // optional .cockroach.proto.ScanRequest scan = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_scan:
+      // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_enqueue_message:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_scan()));
+               input, mutable_enqueue_message()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(74)) goto parse_end_transaction;
+        if (input->ExpectTag(114)) goto parse_changes;
         break;
       }
 
-      // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_end_transaction:
+      // optional .cockroach.proto.ChangesRequest changes = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_changes:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
+               input, mutable_changes()));
         } else {
           goto handle_unusual;
         }
@@ -8142,6 +10018,36 @@ void InternalRequestUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  if (has_reverse_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.reverse_scan_, output);
+  }
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  if (has_reap_queue()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.reap_queue_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  if (has_enqueue_update()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.enqueue_update_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  if (has_enqueue_message()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.enqueue_message_, output);
+  }
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  if (has_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.changes_, output);
+  }
+
   // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
   if (has_internal_push_txn()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -8226,6 +10132,41 @@ void InternalRequestUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  if (has_reverse_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.reverse_scan_, target);
+  }
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  if (has_reap_queue()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.reap_queue_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  if (has_enqueue_update()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.enqueue_update_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  if (has_enqueue_message()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.enqueue_message_, target);
+  }
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  if (has_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.changes_, target);
+  }
+
   // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
   if (has_internal_push_txn()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -8315,6 +10256,41 @@ int InternalRequestUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+    case kReverseScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reverse_scan_);
+      break;
+    }
+    // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+    case kReapQueue: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reap_queue_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+    case kEnqueueUpdate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_update_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+    case kEnqueueMessage: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_message_);
+      break;
+    }
+    // optional .cockroach.proto.ChangesRequest changes = 14;
+    case kChanges: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.changes_);
+      break;
+    }
     // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
     case kInternalPushTxn: {
       total_size += 2 +
@@ -8398,6 +10374,26 @@ void InternalRequestUnion::MergeFrom(const InternalRequestUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
       break;
     }
+    case kReverseScan: {
+      mutable_reverse_scan()->::cockroach::proto::ReverseScanRequest::MergeFrom(from.reverse_scan());
+      break;
+    }
+    case kReapQueue: {
+      mutable_reap_queue()->::cockroach::proto::ReapQueueRequest::MergeFrom(from.reap_queue());
+      break;
+    }
+    case kEnqueueUpdate: {
+      mutable_enqueue_update()->::cockroach::proto::EnqueueUpdateRequest::MergeFrom(from.enqueue_update());
+      break;
+    }
+    case kEnqueueMessage: {
+      mutable_enqueue_message()->::cockroach::proto::EnqueueMessageRequest::MergeFrom(from.enqueue_message());
+      break;
+    }
+    case kChanges: {
+      mutable_changes()->::cockroach::proto::ChangesRequest::MergeFrom(from.changes());
+      break;
+    }
     case kInternalPushTxn: {
       mutable_internal_push_txn()->::cockroach::proto::InternalPushTxnRequest::MergeFrom(from.internal_push_txn());
       break;
@@ -8827,6 +10823,236 @@ void InternalRequestUnion::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.end_transaction)
 }
 
+// optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+bool InternalRequestUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+void InternalRequestUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+void InternalRequestUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReverseScanRequest& InternalRequestUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanRequest::default_instance();
+}
+ ::cockroach::proto::ReverseScanRequest* InternalRequestUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+ ::cockroach::proto::ReverseScanRequest* InternalRequestUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanRequest* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+bool InternalRequestUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+void InternalRequestUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+void InternalRequestUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReapQueueRequest& InternalRequestUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueRequest::default_instance();
+}
+ ::cockroach::proto::ReapQueueRequest* InternalRequestUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.reap_queue)
+  return value_.reap_queue_;
+}
+ ::cockroach::proto::ReapQueueRequest* InternalRequestUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueRequest* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+bool InternalRequestUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+void InternalRequestUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+void InternalRequestUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueUpdateRequest& InternalRequestUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateRequest::default_instance();
+}
+ ::cockroach::proto::EnqueueUpdateRequest* InternalRequestUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+ ::cockroach::proto::EnqueueUpdateRequest* InternalRequestUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateRequest* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+bool InternalRequestUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+void InternalRequestUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+void InternalRequestUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueMessageRequest& InternalRequestUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageRequest::default_instance();
+}
+ ::cockroach::proto::EnqueueMessageRequest* InternalRequestUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.enqueue_message)
+  return value_.enqueue_message_;
+}
+ ::cockroach::proto::EnqueueMessageRequest* InternalRequestUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageRequest* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.enqueue_message)
+}
+
+// optional .cockroach.proto.ChangesRequest changes = 14;
+bool InternalRequestUnion::has_changes() const {
+  return value_case() == kChanges;
+}
+void InternalRequestUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
+}
+void InternalRequestUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ChangesRequest& InternalRequestUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesRequest::default_instance();
+}
+ ::cockroach::proto::ChangesRequest* InternalRequestUnion::mutable_changes() {
+  if (!has_changes()) {
+    clear_value();
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.changes)
+  return value_.changes_;
+}
+ ::cockroach::proto::ChangesRequest* InternalRequestUnion::release_changes() {
+  if (has_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ChangesRequest* temp = value_.changes_;
+    value_.changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_changes(::cockroach::proto::ChangesRequest* changes) {
+  clear_value();
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.changes)
+}
+
 // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
 bool InternalRequestUnion::has_internal_push_txn() const {
   return value_case() == kInternalPushTxn;
@@ -8987,6 +11213,11 @@ const int InternalResponseUnion::kDeleteFieldNumber;
 const int InternalResponseUnion::kDeleteRangeFieldNumber;
 const int InternalResponseUnion::kScanFieldNumber;
 const int InternalResponseUnion::kEndTransactionFieldNumber;
+const int InternalResponseUnion::kReverseScanFieldNumber;
+const int InternalResponseUnion::kReapQueueFieldNumber;
+const int InternalResponseUnion::kEnqueueUpdateFieldNumber;
+const int InternalResponseUnion::kEnqueueMessageFieldNumber;
+const int InternalResponseUnion::kChangesFieldNumber;
 const int InternalResponseUnion::kInternalPushTxnFieldNumber;
 const int InternalResponseUnion::kInternalResolveIntentFieldNumber;
 const int InternalResponseUnion::kInternalResolveIntentRangeFieldNumber;
@@ -9007,6 +11238,11 @@ void InternalResponseUnion::InitAsDefaultInstance() {
   InternalResponseUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanResponse*>(&::cockroach::proto::ScanResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->reverse_scan_ = const_cast< ::cockroach::proto::ReverseScanResponse*>(&::cockroach::proto::ReverseScanResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->reap_queue_ = const_cast< ::cockroach::proto::ReapQueueResponse*>(&::cockroach::proto::ReapQueueResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->enqueue_update_ = const_cast< ::cockroach::proto::EnqueueUpdateResponse*>(&::cockroach::proto::EnqueueUpdateResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->enqueue_message_ = const_cast< ::cockroach::proto::EnqueueMessageResponse*>(&::cockroach::proto::EnqueueMessageResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->changes_ = const_cast< ::cockroach::proto::ChangesResponse*>(&::cockroach::proto::ChangesResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnResponse*>(&::cockroach::proto::InternalPushTxnResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentResponse*>(&::cockroach::proto::InternalResolveIntentResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->internal_resolve_intent_range_ = const_cast< ::cockroach::proto::InternalResolveIntentRangeResponse*>(&::cockroach::proto::InternalResolveIntentRangeResponse::default_instance());
@@ -9098,6 +11334,26 @@ void InternalResponseUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kReverseScan: {
+      delete value_.reverse_scan_;
+      break;
+    }
+    case kReapQueue: {
+      delete value_.reap_queue_;
+      break;
+    }
+    case kEnqueueUpdate: {
+      delete value_.enqueue_update_;
+      break;
+    }
+    case kEnqueueMessage: {
+      delete value_.enqueue_message_;
+      break;
+    }
+    case kChanges: {
+      delete value_.changes_;
+      break;
+    }
     case kInternalPushTxn: {
       delete value_.internal_push_txn_;
       break;
@@ -9179,59 +11435,124 @@ bool InternalResponseUnion::MergePartialFromCodedStream(
         if (tag == 42) {
          parse_increment:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_increment()));
+               input, mutable_increment()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_delete;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteResponse delete = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanResponse scan = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_reverse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_reverse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reverse_scan()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(50)) goto parse_delete;
+        if (input->ExpectTag(90)) goto parse_reap_queue;
         break;
       }
 
-      // optional .cockroach.proto.DeleteResponse delete = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_delete:
+      // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_reap_queue:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_()));
+               input, mutable_reap_queue()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(58)) goto parse_delete_range;
+        if (input->ExpectTag(98)) goto parse_enqueue_update;
         break;
       }
 
-      // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_delete_range:
+      // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_enqueue_update:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
+               input, mutable_enqueue_update()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(66)) goto parse_scan;
+        if (input->ExpectTag(106)) goto parse_enqueue_message;
         break;
       }
 
-      // optional .cockroach.proto.ScanResponse scan = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_scan:
+      // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_enqueue_message:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_scan()));
+               input, mutable_enqueue_message()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(74)) goto parse_end_transaction;
+        if (input->ExpectTag(114)) goto parse_changes;
         break;
       }
 
-      // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_end_transaction:
+      // optional .cockroach.proto.ChangesResponse changes = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_changes:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
+               input, mutable_changes()));
         } else {
           goto handle_unusual;
         }
@@ -9351,6 +11672,36 @@ void InternalResponseUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+  if (has_reverse_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.reverse_scan_, output);
+  }
+
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+  if (has_reap_queue()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.reap_queue_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+  if (has_enqueue_update()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.enqueue_update_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+  if (has_enqueue_message()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.enqueue_message_, output);
+  }
+
+  // optional .cockroach.proto.ChangesResponse changes = 14;
+  if (has_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.changes_, output);
+  }
+
   // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
   if (has_internal_push_txn()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -9435,6 +11786,41 @@ void InternalResponseUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+  if (has_reverse_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.reverse_scan_, target);
+  }
+
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+  if (has_reap_queue()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.reap_queue_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+  if (has_enqueue_update()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.enqueue_update_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+  if (has_enqueue_message()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.enqueue_message_, target);
+  }
+
+  // optional .cockroach.proto.ChangesResponse changes = 14;
+  if (has_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.changes_, target);
+  }
+
   // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
   if (has_internal_push_txn()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -9524,6 +11910,41 @@ int InternalResponseUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+    case kReverseScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reverse_scan_);
+      break;
+    }
+    // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+    case kReapQueue: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reap_queue_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+    case kEnqueueUpdate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_update_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+    case kEnqueueMessage: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_message_);
+      break;
+    }
+    // optional .cockroach.proto.ChangesResponse changes = 14;
+    case kChanges: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.changes_);
+      break;
+    }
     // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
     case kInternalPushTxn: {
       total_size += 2 +
@@ -9607,6 +12028,26 @@ void InternalResponseUnion::MergeFrom(const InternalResponseUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
       break;
     }
+    case kReverseScan: {
+      mutable_reverse_scan()->::cockroach::proto::ReverseScanResponse::MergeFrom(from.reverse_scan());
+      break;
+    }
+    case kReapQueue: {
+      mutable_reap_queue()->::cockroach::proto::ReapQueueResponse::MergeFrom(from.reap_queue());
+      break;
+    }
+    case kEnqueueUpdate: {
+      mutable_enqueue_update()->::cockroach::proto::EnqueueUpdateResponse::MergeFrom(from.enqueue_update());
+      break;
+    }
+    case kEnqueueMessage: {
+      mutable_enqueue_message()->::cockroach::proto::EnqueueMessageResponse::MergeFrom(from.enqueue_message());
+      break;
+    }
+    case kChanges: {
+      mutable_changes()->::cockroach::proto::ChangesResponse::MergeFrom(from.changes());
+      break;
+    }
     case kInternalPushTxn: {
       mutable_internal_push_txn()->::cockroach::proto::InternalPushTxnResponse::MergeFrom(from.internal_push_txn());
       break;
@@ -9925,115 +12366,345 @@ void InternalResponseUnion::clear_delete_range() {
   // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.delete_range)
   return value_.delete_range_;
 }
- ::cockroach::proto::DeleteRangeResponse* InternalResponseUnion::release_delete_range() {
-  if (has_delete_range()) {
+ ::cockroach::proto::DeleteRangeResponse* InternalResponseUnion::release_delete_range() {
+  if (has_delete_range()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRangeResponse* temp = value_.delete_range_;
+    value_.delete_range_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range) {
+  clear_value();
+  if (delete_range) {
+    set_has_delete_range();
+    value_.delete_range_ = delete_range;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.delete_range)
+}
+
+// optional .cockroach.proto.ScanResponse scan = 8;
+bool InternalResponseUnion::has_scan() const {
+  return value_case() == kScan;
+}
+void InternalResponseUnion::set_has_scan() {
+  _oneof_case_[0] = kScan;
+}
+void InternalResponseUnion::clear_scan() {
+  if (has_scan()) {
+    delete value_.scan_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanResponse& InternalResponseUnion::scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.scan)
+  return has_scan() ? *value_.scan_
+                      : ::cockroach::proto::ScanResponse::default_instance();
+}
+ ::cockroach::proto::ScanResponse* InternalResponseUnion::mutable_scan() {
+  if (!has_scan()) {
+    clear_value();
+    set_has_scan();
+    value_.scan_ = new ::cockroach::proto::ScanResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.scan)
+  return value_.scan_;
+}
+ ::cockroach::proto::ScanResponse* InternalResponseUnion::release_scan() {
+  if (has_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ScanResponse* temp = value_.scan_;
+    value_.scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_scan(::cockroach::proto::ScanResponse* scan) {
+  clear_value();
+  if (scan) {
+    set_has_scan();
+    value_.scan_ = scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.scan)
+}
+
+// optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+bool InternalResponseUnion::has_end_transaction() const {
+  return value_case() == kEndTransaction;
+}
+void InternalResponseUnion::set_has_end_transaction() {
+  _oneof_case_[0] = kEndTransaction;
+}
+void InternalResponseUnion::clear_end_transaction() {
+  if (has_end_transaction()) {
+    delete value_.end_transaction_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EndTransactionResponse& InternalResponseUnion::end_transaction() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.end_transaction)
+  return has_end_transaction() ? *value_.end_transaction_
+                      : ::cockroach::proto::EndTransactionResponse::default_instance();
+}
+ ::cockroach::proto::EndTransactionResponse* InternalResponseUnion::mutable_end_transaction() {
+  if (!has_end_transaction()) {
+    clear_value();
+    set_has_end_transaction();
+    value_.end_transaction_ = new ::cockroach::proto::EndTransactionResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.end_transaction)
+  return value_.end_transaction_;
+}
+ ::cockroach::proto::EndTransactionResponse* InternalResponseUnion::release_end_transaction() {
+  if (has_end_transaction()) {
+    clear_has_value();
+    ::cockroach::proto::EndTransactionResponse* temp = value_.end_transaction_;
+    value_.end_transaction_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction) {
+  clear_value();
+  if (end_transaction) {
+    set_has_end_transaction();
+    value_.end_transaction_ = end_transaction;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.end_transaction)
+}
+
+// optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+bool InternalResponseUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+void InternalResponseUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+void InternalResponseUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReverseScanResponse& InternalResponseUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanResponse::default_instance();
+}
+ ::cockroach::proto::ReverseScanResponse* InternalResponseUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+ ::cockroach::proto::ReverseScanResponse* InternalResponseUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanResponse* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanResponse* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+bool InternalResponseUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+void InternalResponseUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+void InternalResponseUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReapQueueResponse& InternalResponseUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueResponse::default_instance();
+}
+ ::cockroach::proto::ReapQueueResponse* InternalResponseUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.reap_queue)
+  return value_.reap_queue_;
+}
+ ::cockroach::proto::ReapQueueResponse* InternalResponseUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueResponse* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+bool InternalResponseUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+void InternalResponseUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+void InternalResponseUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueUpdateResponse& InternalResponseUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateResponse::default_instance();
+}
+ ::cockroach::proto::EnqueueUpdateResponse* InternalResponseUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+ ::cockroach::proto::EnqueueUpdateResponse* InternalResponseUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
     clear_has_value();
-    ::cockroach::proto::DeleteRangeResponse* temp = value_.delete_range_;
-    value_.delete_range_ = NULL;
+    ::cockroach::proto::EnqueueUpdateResponse* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
- void InternalResponseUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range) {
+ void InternalResponseUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update) {
   clear_value();
-  if (delete_range) {
-    set_has_delete_range();
-    value_.delete_range_ = delete_range;
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.delete_range)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.enqueue_update)
 }
 
-// optional .cockroach.proto.ScanResponse scan = 8;
-bool InternalResponseUnion::has_scan() const {
-  return value_case() == kScan;
+// optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+bool InternalResponseUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
 }
-void InternalResponseUnion::set_has_scan() {
-  _oneof_case_[0] = kScan;
+void InternalResponseUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
 }
-void InternalResponseUnion::clear_scan() {
-  if (has_scan()) {
-    delete value_.scan_;
+void InternalResponseUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
     clear_has_value();
   }
 }
- const ::cockroach::proto::ScanResponse& InternalResponseUnion::scan() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.scan)
-  return has_scan() ? *value_.scan_
-                      : ::cockroach::proto::ScanResponse::default_instance();
+ const ::cockroach::proto::EnqueueMessageResponse& InternalResponseUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageResponse::default_instance();
 }
- ::cockroach::proto::ScanResponse* InternalResponseUnion::mutable_scan() {
-  if (!has_scan()) {
+ ::cockroach::proto::EnqueueMessageResponse* InternalResponseUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
     clear_value();
-    set_has_scan();
-    value_.scan_ = new ::cockroach::proto::ScanResponse;
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageResponse;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.scan)
-  return value_.scan_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.enqueue_message)
+  return value_.enqueue_message_;
 }
- ::cockroach::proto::ScanResponse* InternalResponseUnion::release_scan() {
-  if (has_scan()) {
+ ::cockroach::proto::EnqueueMessageResponse* InternalResponseUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
     clear_has_value();
-    ::cockroach::proto::ScanResponse* temp = value_.scan_;
-    value_.scan_ = NULL;
+    ::cockroach::proto::EnqueueMessageResponse* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
- void InternalResponseUnion::set_allocated_scan(::cockroach::proto::ScanResponse* scan) {
+ void InternalResponseUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message) {
   clear_value();
-  if (scan) {
-    set_has_scan();
-    value_.scan_ = scan;
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.scan)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.enqueue_message)
 }
 
-// optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-bool InternalResponseUnion::has_end_transaction() const {
-  return value_case() == kEndTransaction;
+// optional .cockroach.proto.ChangesResponse changes = 14;
+bool InternalResponseUnion::has_changes() const {
+  return value_case() == kChanges;
 }
-void InternalResponseUnion::set_has_end_transaction() {
-  _oneof_case_[0] = kEndTransaction;
+void InternalResponseUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
 }
-void InternalResponseUnion::clear_end_transaction() {
-  if (has_end_transaction()) {
-    delete value_.end_transaction_;
+void InternalResponseUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
     clear_has_value();
   }
 }
- const ::cockroach::proto::EndTransactionResponse& InternalResponseUnion::end_transaction() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.end_transaction)
-  return has_end_transaction() ? *value_.end_transaction_
-                      : ::cockroach::proto::EndTransactionResponse::default_instance();
+ const ::cockroach::proto::ChangesResponse& InternalResponseUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesResponse::default_instance();
 }
- ::cockroach::proto::EndTransactionResponse* InternalResponseUnion::mutable_end_transaction() {
-  if (!has_end_transaction()) {
+ ::cockroach::proto::ChangesResponse* InternalResponseUnion::mutable_changes() {
+  if (!has_changes()) {
     clear_value();
-    set_has_end_transaction();
-    value_.end_transaction_ = new ::cockroach::proto::EndTransactionResponse;
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesResponse;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.end_transaction)
-  return value_.end_transaction_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.changes)
+  return value_.changes_;
 }
- ::cockroach::proto::EndTransactionResponse* InternalResponseUnion::release_end_transaction() {
-  if (has_end_transaction()) {
+ ::cockroach::proto::ChangesResponse* InternalResponseUnion::release_changes() {
+  if (has_changes()) {
     clear_has_value();
-    ::cockroach::proto::EndTransactionResponse* temp = value_.end_transaction_;
-    value_.end_transaction_ = NULL;
+    ::cockroach::proto::ChangesResponse* temp = value_.changes_;
+    value_.changes_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
- void InternalResponseUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction) {
+ void InternalResponseUnion::set_allocated_changes(::cockroach::proto::ChangesResponse* changes) {
   clear_value();
-  if (end_transaction) {
-    set_has_end_transaction();
-    value_.end_transaction_ = end_transaction;
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.end_transaction)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.changes)
 }
 
 // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
@@ -10904,6 +13575,9 @@ const int ReadWriteCmdResponse::kIncrementFieldNumber;
 const int ReadWriteCmdResponse::kDeleteFieldNumber;
 const int ReadWriteCmdResponse::kDeleteRangeFieldNumber;
 const int ReadWriteCmdResponse::kEndTransactionFieldNumber;
+const int ReadWriteCmdResponse::kReapQueueFieldNumber;
+const int ReadWriteCmdResponse::kEnqueueUpdateFieldNumber;
+const int ReadWriteCmdResponse::kEnqueueMessageFieldNumber;
 const int ReadWriteCmdResponse::kInternalHeartbeatTxnFieldNumber;
 const int ReadWriteCmdResponse::kInternalPushTxnFieldNumber;
 const int ReadWriteCmdResponse::kInternalResolveIntentFieldNumber;
@@ -10912,6 +13586,7 @@ const int ReadWriteCmdResponse::kInternalMergeFieldNumber;
 const int ReadWriteCmdResponse::kInternalTruncateLogFieldNumber;
 const int ReadWriteCmdResponse::kInternalGcFieldNumber;
 const int ReadWriteCmdResponse::kInternalLeaderLeaseFieldNumber;
+const int ReadWriteCmdResponse::kInternalBatchFieldNumber;
 #endif  // !_MSC_VER
 
 ReadWriteCmdResponse::ReadWriteCmdResponse()
@@ -10927,6 +13602,9 @@ void ReadWriteCmdResponse::InitAsDefaultInstance() {
   ReadWriteCmdResponse_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteResponse*>(&::cockroach::proto::DeleteResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->reap_queue_ = const_cast< ::cockroach::proto::ReapQueueResponse*>(&::cockroach::proto::ReapQueueResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->enqueue_update_ = const_cast< ::cockroach::proto::EnqueueUpdateResponse*>(&::cockroach::proto::EnqueueUpdateResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->enqueue_message_ = const_cast< ::cockroach::proto::EnqueueMessageResponse*>(&::cockroach::proto::EnqueueMessageResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_heartbeat_txn_ = const_cast< ::cockroach::proto::InternalHeartbeatTxnResponse*>(&::cockroach::proto::InternalHeartbeatTxnResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnResponse*>(&::cockroach::proto::InternalPushTxnResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentResponse*>(&::cockroach::proto::InternalResolveIntentResponse::default_instance());
@@ -10935,6 +13613,7 @@ void ReadWriteCmdResponse::InitAsDefaultInstance() {
   ReadWriteCmdResponse_default_oneof_instance_->internal_truncate_log_ = const_cast< ::cockroach::proto::InternalTruncateLogResponse*>(&::cockroach::proto::InternalTruncateLogResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_gc_ = const_cast< ::cockroach::proto::InternalGCResponse*>(&::cockroach::proto::InternalGCResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_leader_lease_ = const_cast< ::cockroach::proto::InternalLeaderLeaseResponse*>(&::cockroach::proto::InternalLeaderLeaseResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->internal_batch_ = const_cast< ::cockroach::proto::InternalBatchResponse*>(&::cockroach::proto::InternalBatchResponse::default_instance());
 }
 
 ReadWriteCmdResponse::ReadWriteCmdResponse(const ReadWriteCmdResponse& from)
@@ -11015,6 +13694,18 @@ void ReadWriteCmdResponse::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kReapQueue: {
+      delete value_.reap_queue_;
+      break;
+    }
+    case kEnqueueUpdate: {
+      delete value_.enqueue_update_;
+      break;
+    }
+    case kEnqueueMessage: {
+      delete value_.enqueue_message_;
+      break;
+    }
     case kInternalHeartbeatTxn: {
       delete value_.internal_heartbeat_txn_;
       break;
@@ -11047,6 +13738,10 @@ void ReadWriteCmdResponse::clear_value() {
       delete value_.internal_leader_lease_;
       break;
     }
+    case kInternalBatch: {
+      delete value_.internal_batch_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -11146,6 +13841,45 @@ bool ReadWriteCmdResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(58)) goto parse_reap_queue;
+        break;
+      }
+
+      // optional .cockroach.proto.ReapQueueResponse reap_queue = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_reap_queue:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reap_queue()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_enqueue_update;
+        break;
+      }
+
+      // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_enqueue_update:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_update()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_enqueue_message;
+        break;
+      }
+
+      // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_enqueue_message:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_message()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectTag(82)) goto parse_internal_heartbeat_txn;
         break;
       }
@@ -11250,6 +13984,19 @@ bool ReadWriteCmdResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(146)) goto parse_internal_batch;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalBatchResponse internal_batch = 18;
+      case 18: {
+        if (tag == 146) {
+         parse_internal_batch:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_batch()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -11315,6 +14062,24 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
       6, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 7;
+  if (has_reap_queue()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, *value_.reap_queue_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 8;
+  if (has_enqueue_update()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, *value_.enqueue_update_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 9;
+  if (has_enqueue_message()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, *value_.enqueue_message_, output);
+  }
+
   // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
   if (has_internal_heartbeat_txn()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -11363,6 +14128,12 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
       17, *value_.internal_leader_lease_, output);
   }
 
+  // optional .cockroach.proto.InternalBatchResponse internal_batch = 18;
+  if (has_internal_batch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      18, *value_.internal_batch_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -11415,6 +14186,27 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
         6, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 7;
+  if (has_reap_queue()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, *value_.reap_queue_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 8;
+  if (has_enqueue_update()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, *value_.enqueue_update_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 9;
+  if (has_enqueue_message()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, *value_.enqueue_message_, target);
+  }
+
   // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
   if (has_internal_heartbeat_txn()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -11471,6 +14263,13 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
         17, *value_.internal_leader_lease_, target);
   }
 
+  // optional .cockroach.proto.InternalBatchResponse internal_batch = 18;
+  if (has_internal_batch()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        18, *value_.internal_batch_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -11525,6 +14324,27 @@ int ReadWriteCmdResponse::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.ReapQueueResponse reap_queue = 7;
+    case kReapQueue: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reap_queue_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 8;
+    case kEnqueueUpdate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_update_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 9;
+    case kEnqueueMessage: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_message_);
+      break;
+    }
     // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
     case kInternalHeartbeatTxn: {
       total_size += 1 +
@@ -11581,6 +14401,13 @@ int ReadWriteCmdResponse::ByteSize() const {
           *value_.internal_leader_lease_);
       break;
     }
+    // optional .cockroach.proto.InternalBatchResponse internal_batch = 18;
+    case kInternalBatch: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.internal_batch_);
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -11635,6 +14462,18 @@ void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
       break;
     }
+    case kReapQueue: {
+      mutable_reap_queue()->::cockroach::proto::ReapQueueResponse::MergeFrom(from.reap_queue());
+      break;
+    }
+    case kEnqueueUpdate: {
+      mutable_enqueue_update()->::cockroach::proto::EnqueueUpdateResponse::MergeFrom(from.enqueue_update());
+      break;
+    }
+    case kEnqueueMessage: {
+      mutable_enqueue_message()->::cockroach::proto::EnqueueMessageResponse::MergeFrom(from.enqueue_message());
+      break;
+    }
     case kInternalHeartbeatTxn: {
       mutable_internal_heartbeat_txn()->::cockroach::proto::InternalHeartbeatTxnResponse::MergeFrom(from.internal_heartbeat_txn());
       break;
@@ -11667,6 +14506,10 @@ void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
       mutable_internal_leader_lease()->::cockroach::proto::InternalLeaderLeaseResponse::MergeFrom(from.internal_leader_lease());
       break;
     }
+    case kInternalBatch: {
+      mutable_internal_batch()->::cockroach::proto::InternalBatchResponse::MergeFrom(from.internal_batch());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -11992,6 +14835,144 @@ void ReadWriteCmdResponse::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.end_transaction)
 }
 
+// optional .cockroach.proto.ReapQueueResponse reap_queue = 7;
+bool ReadWriteCmdResponse::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+void ReadWriteCmdResponse::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+void ReadWriteCmdResponse::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReapQueueResponse& ReadWriteCmdResponse::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueResponse::default_instance();
+}
+ ::cockroach::proto::ReapQueueResponse* ReadWriteCmdResponse::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.reap_queue)
+  return value_.reap_queue_;
+}
+ ::cockroach::proto::ReapQueueResponse* ReadWriteCmdResponse::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueResponse* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ReadWriteCmdResponse::set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 8;
+bool ReadWriteCmdResponse::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+void ReadWriteCmdResponse::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+void ReadWriteCmdResponse::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueUpdateResponse& ReadWriteCmdResponse::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateResponse::default_instance();
+}
+ ::cockroach::proto::EnqueueUpdateResponse* ReadWriteCmdResponse::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.enqueue_update)
+  return value_.enqueue_update_;
+}
+ ::cockroach::proto::EnqueueUpdateResponse* ReadWriteCmdResponse::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateResponse* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ReadWriteCmdResponse::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 9;
+bool ReadWriteCmdResponse::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+void ReadWriteCmdResponse::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+void ReadWriteCmdResponse::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueMessageResponse& ReadWriteCmdResponse::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageResponse::default_instance();
+}
+ ::cockroach::proto::EnqueueMessageResponse* ReadWriteCmdResponse::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.enqueue_message)
+  return value_.enqueue_message_;
+}
+ ::cockroach::proto::EnqueueMessageResponse* ReadWriteCmdResponse::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageResponse* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ReadWriteCmdResponse::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.enqueue_message)
+}
+
 // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
 bool ReadWriteCmdResponse::has_internal_heartbeat_txn() const {
   return value_case() == kInternalHeartbeatTxn;
@@ -12357,7 +15338,53 @@ void ReadWriteCmdResponse::clear_internal_leader_lease() {
     set_has_internal_leader_lease();
     value_.internal_leader_lease_ = internal_leader_lease;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.internal_leader_lease)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.internal_leader_lease)
+}
+
+// optional .cockroach.proto.InternalBatchResponse internal_batch = 18;
+bool ReadWriteCmdResponse::has_internal_batch() const {
+  return value_case() == kInternalBatch;
+}
+void ReadWriteCmdResponse::set_has_internal_batch() {
+  _oneof_case_[0] = kInternalBatch;
+}
+void ReadWriteCmdResponse::clear_internal_batch() {
+  if (has_internal_batch()) {
+    delete value_.internal_batch_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::InternalBatchResponse& ReadWriteCmdResponse::internal_batch() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.internal_batch)
+  return has_internal_batch() ? *value_.internal_batch_
+                      : ::cockroach::proto::InternalBatchResponse::default_instance();
+}
+ ::cockroach::proto::InternalBatchResponse* ReadWriteCmdResponse::mutable_internal_batch() {
+  if (!has_internal_batch()) {
+    clear_value();
+    set_has_internal_batch();
+    value_.internal_batch_ = new ::cockroach::proto::InternalBatchResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.internal_batch)
+  return value_.internal_batch_;
+}
+ ::cockroach::proto::InternalBatchResponse* ReadWriteCmdResponse::release_internal_batch() {
+  if (has_internal_batch()) {
+    clear_has_value();
+    ::cockroach::proto::InternalBatchResponse* temp = value_.internal_batch_;
+    value_.internal_batch_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ReadWriteCmdResponse::set_allocated_internal_batch(::cockroach::proto::InternalBatchResponse* internal_batch) {
+  clear_value();
+  if (internal_batch) {
+    set_has_internal_batch();
+    value_.internal_batch_ = internal_batch;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.internal_batch)
 }
 
 bool ReadWriteCmdResponse::has_value() const {
@@ -12382,6 +15409,11 @@ const int InternalRaftCommandUnion::kDeleteFieldNumber;
 const int InternalRaftCommandUnion::kDeleteRangeFieldNumber;
 const int InternalRaftCommandUnion::kScanFieldNumber;
 const int InternalRaftCommandUnion::kEndTransactionFieldNumber;
+const int InternalRaftCommandUnion::kReverseScanFieldNumber;
+const int InternalRaftCommandUnion::kReapQueueFieldNumber;
+const int InternalRaftCommandUnion::kEnqueueUpdateFieldNumber;
+const int InternalRaftCommandUnion::kEnqueueMessageFieldNumber;
+const int InternalRaftCommandUnion::kChangesFieldNumber;
 const int InternalRaftCommandUnion::kBatchFieldNumber;
 const int InternalRaftCommandUnion::kInternalRangeLookupFieldNumber;
 const int InternalRaftCommandUnion::kInternalHeartbeatTxnFieldNumber;
@@ -12410,6 +15442,11 @@ void InternalRaftCommandUnion::InitAsDefaultInstance() {
   InternalRaftCommandUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->reverse_scan_ = const_cast< ::cockroach::proto::ReverseScanRequest*>(&::cockroach::proto::ReverseScanRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->reap_queue_ = const_cast< ::cockroach::proto::ReapQueueRequest*>(&::cockroach::proto::ReapQueueRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->enqueue_update_ = const_cast< ::cockroach::proto::EnqueueUpdateRequest*>(&::cockroach::proto::EnqueueUpdateRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->enqueue_message_ = const_cast< ::cockroach::proto::EnqueueMessageRequest*>(&::cockroach::proto::EnqueueMessageRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->changes_ = const_cast< ::cockroach::proto::ChangesRequest*>(&::cockroach::proto::ChangesRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->batch_ = const_cast< ::cockroach::proto::BatchRequest*>(&::cockroach::proto::BatchRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->internal_range_lookup_ = const_cast< ::cockroach::proto::InternalRangeLookupRequest*>(&::cockroach::proto::InternalRangeLookupRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->internal_heartbeat_txn_ = const_cast< ::cockroach::proto::InternalHeartbeatTxnRequest*>(&::cockroach::proto::InternalHeartbeatTxnRequest::default_instance());
@@ -12509,6 +15546,26 @@ void InternalRaftCommandUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kReverseScan: {
+      delete value_.reverse_scan_;
+      break;
+    }
+    case kReapQueue: {
+      delete value_.reap_queue_;
+      break;
+    }
+    case kEnqueueUpdate: {
+      delete value_.enqueue_update_;
+      break;
+    }
+    case kEnqueueMessage: {
+      delete value_.enqueue_message_;
+      break;
+    }
+    case kChanges: {
+      delete value_.changes_;
+      break;
+    }
     case kBatch: {
       delete value_.batch_;
       break;
@@ -12678,6 +15735,71 @@ bool InternalRaftCommandUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(82)) goto parse_reverse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_reverse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reverse_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_reap_queue;
+        break;
+      }
+
+      // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_reap_queue:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reap_queue()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_enqueue_update;
+        break;
+      }
+
+      // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_enqueue_update:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_update()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_enqueue_message;
+        break;
+      }
+
+      // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_enqueue_message:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_message()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_changes;
+        break;
+      }
+
+      // optional .cockroach.proto.ChangesRequest changes = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_changes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_changes()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectTag(242)) goto parse_batch;
         break;
       }
@@ -12898,6 +16020,36 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  if (has_reverse_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.reverse_scan_, output);
+  }
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  if (has_reap_queue()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.reap_queue_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  if (has_enqueue_update()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.enqueue_update_, output);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  if (has_enqueue_message()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.enqueue_message_, output);
+  }
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  if (has_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.changes_, output);
+  }
+
   // optional .cockroach.proto.BatchRequest batch = 30;
   if (has_batch()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -13030,6 +16182,41 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  if (has_reverse_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.reverse_scan_, target);
+  }
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  if (has_reap_queue()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.reap_queue_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  if (has_enqueue_update()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.enqueue_update_, target);
+  }
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  if (has_enqueue_message()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.enqueue_message_, target);
+  }
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  if (has_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.changes_, target);
+  }
+
   // optional .cockroach.proto.BatchRequest batch = 30;
   if (has_batch()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -13175,6 +16362,41 @@ int InternalRaftCommandUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+    case kReverseScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reverse_scan_);
+      break;
+    }
+    // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+    case kReapQueue: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.reap_queue_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+    case kEnqueueUpdate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_update_);
+      break;
+    }
+    // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+    case kEnqueueMessage: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.enqueue_message_);
+      break;
+    }
+    // optional .cockroach.proto.ChangesRequest changes = 14;
+    case kChanges: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.changes_);
+      break;
+    }
     // optional .cockroach.proto.BatchRequest batch = 30;
     case kBatch: {
       total_size += 2 +
@@ -13314,6 +16536,26 @@ void InternalRaftCommandUnion::MergeFrom(const InternalRaftCommandUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
       break;
     }
+    case kReverseScan: {
+      mutable_reverse_scan()->::cockroach::proto::ReverseScanRequest::MergeFrom(from.reverse_scan());
+      break;
+    }
+    case kReapQueue: {
+      mutable_reap_queue()->::cockroach::proto::ReapQueueRequest::MergeFrom(from.reap_queue());
+      break;
+    }
+    case kEnqueueUpdate: {
+      mutable_enqueue_update()->::cockroach::proto::EnqueueUpdateRequest::MergeFrom(from.enqueue_update());
+      break;
+    }
+    case kEnqueueMessage: {
+      mutable_enqueue_message()->::cockroach::proto::EnqueueMessageRequest::MergeFrom(from.enqueue_message());
+      break;
+    }
+    case kChanges: {
+      mutable_changes()->::cockroach::proto::ChangesRequest::MergeFrom(from.changes());
+      break;
+    }
     case kBatch: {
       mutable_batch()->::cockroach::proto::BatchRequest::MergeFrom(from.batch());
       break;
@@ -13775,6 +17017,236 @@ void InternalRaftCommandUnion::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.end_transaction)
 }
 
+// optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+bool InternalRaftCommandUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+void InternalRaftCommandUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+void InternalRaftCommandUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReverseScanRequest& InternalRaftCommandUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanRequest::default_instance();
+}
+ ::cockroach::proto::ReverseScanRequest* InternalRaftCommandUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+ ::cockroach::proto::ReverseScanRequest* InternalRaftCommandUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanRequest* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+bool InternalRaftCommandUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+void InternalRaftCommandUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+void InternalRaftCommandUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ReapQueueRequest& InternalRaftCommandUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueRequest::default_instance();
+}
+ ::cockroach::proto::ReapQueueRequest* InternalRaftCommandUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.reap_queue)
+  return value_.reap_queue_;
+}
+ ::cockroach::proto::ReapQueueRequest* InternalRaftCommandUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueRequest* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+bool InternalRaftCommandUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+void InternalRaftCommandUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+void InternalRaftCommandUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueUpdateRequest& InternalRaftCommandUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateRequest::default_instance();
+}
+ ::cockroach::proto::EnqueueUpdateRequest* InternalRaftCommandUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+ ::cockroach::proto::EnqueueUpdateRequest* InternalRaftCommandUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateRequest* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+bool InternalRaftCommandUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+void InternalRaftCommandUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+void InternalRaftCommandUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::EnqueueMessageRequest& InternalRaftCommandUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageRequest::default_instance();
+}
+ ::cockroach::proto::EnqueueMessageRequest* InternalRaftCommandUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.enqueue_message)
+  return value_.enqueue_message_;
+}
+ ::cockroach::proto::EnqueueMessageRequest* InternalRaftCommandUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageRequest* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.enqueue_message)
+}
+
+// optional .cockroach.proto.ChangesRequest changes = 14;
+bool InternalRaftCommandUnion::has_changes() const {
+  return value_case() == kChanges;
+}
+void InternalRaftCommandUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
+}
+void InternalRaftCommandUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ChangesRequest& InternalRaftCommandUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesRequest::default_instance();
+}
+ ::cockroach::proto::ChangesRequest* InternalRaftCommandUnion::mutable_changes() {
+  if (!has_changes()) {
+    clear_value();
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.changes)
+  return value_.changes_;
+}
+ ::cockroach::proto::ChangesRequest* InternalRaftCommandUnion::release_changes() {
+  if (has_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ChangesRequest* temp = value_.changes_;
+    value_.changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_changes(::cockroach::proto::ChangesRequest* changes) {
+  clear_value();
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.changes)
+}
+
 // optional .cockroach.proto.BatchRequest batch = 30;
 bool InternalRaftCommandUnion::has_batch() const {
   return value_case() == kBatch;
@@ -14298,6 +17770,7 @@ InternalRaftCommandUnion::ValueCase InternalRaftCommandUnion::value_case() const
 const int InternalRaftCommand::kRaftIdFieldNumber;
 const int InternalRaftCommand::kOriginNodeIdFieldNumber;
 const int InternalRaftCommand::kCmdFieldNumber;
+const int InternalRaftCommand::kClosedTimestampFieldNumber;
 #endif  // !_MSC_VER
 
 InternalRaftCommand::InternalRaftCommand()
@@ -14308,6 +17781,7 @@ InternalRaftCommand::InternalRaftCommand()
 
 void InternalRaftCommand::InitAsDefaultInstance() {
   cmd_ = const_cast< ::cockroach::proto::InternalRaftCommandUnion*>(&::cockroach::proto::InternalRaftCommandUnion::default_instance());
+  closed_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
 }
 
 InternalRaftCommand::InternalRaftCommand(const InternalRaftCommand& from)
@@ -14323,6 +17797,7 @@ void InternalRaftCommand::SharedCtor() {
   raft_id_ = GOOGLE_LONGLONG(0);
   origin_node_id_ = GOOGLE_ULONGLONG(0);
   cmd_ = NULL;
+  closed_timestamp_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -14334,6 +17809,7 @@ InternalRaftCommand::~InternalRaftCommand() {
 void InternalRaftCommand::SharedDtor() {
   if (this != default_instance_) {
     delete cmd_;
+    delete closed_timestamp_;
   }
 }
 
@@ -14371,11 +17847,14 @@ void InternalRaftCommand::Clear() {
            ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
 } while (0)
 
-  if (_has_bits_[0 / 32] & 7u) {
+  if (_has_bits_[0 / 32] & 15u) {
     ZR_(raft_id_, origin_node_id_);
     if (has_cmd()) {
       if (cmd_ != NULL) cmd_->::cockroach::proto::InternalRaftCommandUnion::Clear();
     }
+    if (has_closed_timestamp()) {
+      if (closed_timestamp_ != NULL) closed_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
   }
 
 #undef ZR_HELPER_
@@ -14435,6 +17914,19 @@ bool InternalRaftCommand::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(34)) goto parse_closed_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_closed_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_closed_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -14480,6 +17972,12 @@ void InternalRaftCommand::SerializeWithCachedSizes(
       3, *this->cmd_, output);
   }
 
+  // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+  if (has_closed_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, *this->closed_timestamp_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -14507,6 +18005,13 @@ void InternalRaftCommand::SerializeWithCachedSizes(
         3, *this->cmd_, target);
   }
 
+  // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+  if (has_closed_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, *this->closed_timestamp_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -14518,7 +18023,7 @@ void InternalRaftCommand::SerializeWithCachedSizes(
 int InternalRaftCommand::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 7) {
+  if (_has_bits_[0 / 32] & 15) {
     // optional int64 raft_id = 1;
     if (has_raft_id()) {
       total_size += 1 +
@@ -14540,6 +18045,13 @@ int InternalRaftCommand::ByteSize() const {
           *this->cmd_);
     }
 
+    // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+    if (has_closed_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->closed_timestamp_);
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -14576,6 +18088,9 @@ void InternalRaftCommand::MergeFrom(const InternalRaftCommand& from) {
     if (from.has_cmd()) {
       mutable_cmd()->::cockroach::proto::InternalRaftCommandUnion::MergeFrom(from.cmd());
     }
+    if (from.has_closed_timestamp()) {
+      mutable_closed_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.closed_timestamp());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -14607,6 +18122,7 @@ void InternalRaftCommand::InternalSwap(InternalRaftCommand* other) {
   std::swap(raft_id_, other->raft_id_);
   std::swap(origin_node_id_, other->origin_node_id_);
   std::swap(cmd_, other->cmd_);
+  std::swap(closed_timestamp_, other->closed_timestamp_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -14714,6 +18230,49 @@ void InternalRaftCommand::clear_cmd() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommand.cmd)
 }
 
+// optional .cockroach.proto.Timestamp closed_timestamp = 4;
+bool InternalRaftCommand::has_closed_timestamp() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void InternalRaftCommand::set_has_closed_timestamp() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void InternalRaftCommand::clear_has_closed_timestamp() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void InternalRaftCommand::clear_closed_timestamp() {
+  if (closed_timestamp_ != NULL) closed_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_closed_timestamp();
+}
+ const ::cockroach::proto::Timestamp& InternalRaftCommand::closed_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommand.closed_timestamp)
+  return closed_timestamp_ != NULL ? *closed_timestamp_ : *default_instance_->closed_timestamp_;
+}
+ ::cockroach::proto::Timestamp* InternalRaftCommand::mutable_closed_timestamp() {
+  set_has_closed_timestamp();
+  if (closed_timestamp_ == NULL) {
+    closed_timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommand.closed_timestamp)
+  return closed_timestamp_;
+}
+ ::cockroach::proto::Timestamp* InternalRaftCommand::release_closed_timestamp() {
+  clear_has_closed_timestamp();
+  ::cockroach::proto::Timestamp* temp = closed_timestamp_;
+  closed_timestamp_ = NULL;
+  return temp;
+}
+ void InternalRaftCommand::set_allocated_closed_timestamp(::cockroach::proto::Timestamp* closed_timestamp) {
+  delete closed_timestamp_;
+  closed_timestamp_ = closed_timestamp;
+  if (closed_timestamp) {
+    set_has_closed_timestamp();
+  } else {
+    clear_has_closed_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommand.closed_timestamp)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -51,6 +51,9 @@ class InternalGCRequest_GCKey;
 class InternalGCResponse;
 class InternalPushTxnRequest;
 class InternalPushTxnResponse;
+class TxnWaitEdge;
+class InternalQueryTxnRequest;
+class InternalQueryTxnResponse;
 class InternalResolveIntentRequest;
 class InternalResolveIntentResponse;
 class InternalResolveIntentRangeRequest;
@@ -203,6 +206,13 @@ class InternalRangeLookupRequest : public ::google::protobuf::Message {
   bool ignore_intents() const;
   void set_ignore_intents(bool value);
 
+  // optional bool reverse = 4;
+  bool has_reverse() const;
+  void clear_reverse();
+  static const int kReverseFieldNumber = 4;
+  bool reverse() const;
+  void set_reverse(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRangeLookupRequest)
  private:
   inline void set_has_header();
@@ -211,6 +221,8 @@ class InternalRangeLookupRequest : public ::google::protobuf::Message {
   inline void clear_has_max_ranges();
   inline void set_has_ignore_intents();
   inline void clear_has_ignore_intents();
+  inline void set_has_reverse();
+  inline void clear_has_reverse();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -218,6 +230,7 @@ class InternalRangeLookupRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* header_;
   ::google::protobuf::int32 max_ranges_;
   bool ignore_intents_;
+  bool reverse_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
@@ -715,12 +728,21 @@ class InternalGCRequest : public ::google::protobuf::Message {
   ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalGCRequest_GCKey >*
       mutable_keys();
 
+  // optional uint64 next_expiration_seq = 4;
+  bool has_next_expiration_seq() const;
+  void clear_next_expiration_seq();
+  static const int kNextExpirationSeqFieldNumber = 4;
+  ::google::protobuf::uint64 next_expiration_seq() const;
+  void set_next_expiration_seq(::google::protobuf::uint64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.InternalGCRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_gc_meta();
   inline void clear_has_gc_meta();
+  inline void set_has_next_expiration_seq();
+  inline void clear_has_next_expiration_seq();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -728,6 +750,7 @@ class InternalGCRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* header_;
   ::cockroach::proto::GCMetadata* gc_meta_;
   ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalGCRequest_GCKey > keys_;
+  ::google::protobuf::uint64 next_expiration_seq_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
@@ -1066,6 +1089,344 @@ class InternalPushTxnResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class TxnWaitEdge : public ::google::protobuf::Message {
+ public:
+  TxnWaitEdge();
+  virtual ~TxnWaitEdge();
+
+  TxnWaitEdge(const TxnWaitEdge& from);
+
+  inline TxnWaitEdge& operator=(const TxnWaitEdge& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const TxnWaitEdge& default_instance();
+
+  void Swap(TxnWaitEdge* other);
+
+  // implements Message ----------------------------------------------
+
+  inline TxnWaitEdge* New() const { return New(NULL); }
+
+  TxnWaitEdge* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const TxnWaitEdge& from);
+  void MergeFrom(const TxnWaitEdge& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(TxnWaitEdge* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes pusher_id = 1;
+  bool has_pusher_id() const;
+  void clear_pusher_id();
+  static const int kPusherIdFieldNumber = 1;
+  const ::std::string& pusher_id() const;
+  void set_pusher_id(const ::std::string& value);
+  void set_pusher_id(const char* value);
+  void set_pusher_id(const void* value, size_t size);
+  ::std::string* mutable_pusher_id();
+  ::std::string* release_pusher_id();
+  void set_allocated_pusher_id(::std::string* pusher_id);
+
+  // optional int32 pusher_priority = 2;
+  bool has_pusher_priority() const;
+  void clear_pusher_priority();
+  static const int kPusherPriorityFieldNumber = 2;
+  ::google::protobuf::int32 pusher_priority() const;
+  void set_pusher_priority(::google::protobuf::int32 value);
+
+  // optional bytes pushee_id = 3;
+  bool has_pushee_id() const;
+  void clear_pushee_id();
+  static const int kPusheeIdFieldNumber = 3;
+  const ::std::string& pushee_id() const;
+  void set_pushee_id(const ::std::string& value);
+  void set_pushee_id(const char* value);
+  void set_pushee_id(const void* value, size_t size);
+  ::std::string* mutable_pushee_id();
+  ::std::string* release_pushee_id();
+  void set_allocated_pushee_id(::std::string* pushee_id);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.TxnWaitEdge)
+ private:
+  inline void set_has_pusher_id();
+  inline void clear_has_pusher_id();
+  inline void set_has_pusher_priority();
+  inline void clear_has_pusher_priority();
+  inline void set_has_pushee_id();
+  inline void clear_has_pushee_id();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::internal::ArenaStringPtr pusher_id_;
+  ::google::protobuf::internal::ArenaStringPtr pushee_id_;
+  ::google::protobuf::int32 pusher_priority_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static TxnWaitEdge* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalQueryTxnRequest : public ::google::protobuf::Message {
+ public:
+  InternalQueryTxnRequest();
+  virtual ~InternalQueryTxnRequest();
+
+  InternalQueryTxnRequest(const InternalQueryTxnRequest& from);
+
+  inline InternalQueryTxnRequest& operator=(const InternalQueryTxnRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalQueryTxnRequest& default_instance();
+
+  void Swap(InternalQueryTxnRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline InternalQueryTxnRequest* New() const { return New(NULL); }
+
+  InternalQueryTxnRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalQueryTxnRequest& from);
+  void MergeFrom(const InternalQueryTxnRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(InternalQueryTxnRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  bool has_queried_txn() const;
+  void clear_queried_txn();
+  static const int kQueriedTxnFieldNumber = 2;
+  const ::cockroach::proto::Transaction& queried_txn() const;
+  ::cockroach::proto::Transaction* mutable_queried_txn();
+  ::cockroach::proto::Transaction* release_queried_txn();
+  void set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalQueryTxnRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_queried_txn();
+  inline void clear_has_queried_txn();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Transaction* queried_txn_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalQueryTxnRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalQueryTxnResponse : public ::google::protobuf::Message {
+ public:
+  InternalQueryTxnResponse();
+  virtual ~InternalQueryTxnResponse();
+
+  InternalQueryTxnResponse(const InternalQueryTxnResponse& from);
+
+  inline InternalQueryTxnResponse& operator=(const InternalQueryTxnResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalQueryTxnResponse& default_instance();
+
+  void Swap(InternalQueryTxnResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline InternalQueryTxnResponse* New() const { return New(NULL); }
+
+  InternalQueryTxnResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalQueryTxnResponse& from);
+  void MergeFrom(const InternalQueryTxnResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(InternalQueryTxnResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  bool has_queried_txn() const;
+  void clear_queried_txn();
+  static const int kQueriedTxnFieldNumber = 2;
+  const ::cockroach::proto::Transaction& queried_txn() const;
+  ::cockroach::proto::Transaction* mutable_queried_txn();
+  ::cockroach::proto::Transaction* release_queried_txn();
+  void set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn);
+
+  // repeated .cockroach.proto.TxnWaitEdge waits = 3;
+  int waits_size() const;
+  void clear_waits();
+  static const int kWaitsFieldNumber = 3;
+  const ::cockroach::proto::TxnWaitEdge& waits(int index) const;
+  ::cockroach::proto::TxnWaitEdge* mutable_waits(int index);
+  ::cockroach::proto::TxnWaitEdge* add_waits();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TxnWaitEdge >&
+      waits() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TxnWaitEdge >*
+      mutable_waits();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalQueryTxnResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_queried_txn();
+  inline void clear_has_queried_txn();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::cockroach::proto::Transaction* queried_txn_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TxnWaitEdge > waits_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalQueryTxnResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class InternalResolveIntentRequest : public ::google::protobuf::Message {
  public:
   InternalResolveIntentRequest();
@@ -1321,15 +1682,47 @@ class InternalResolveIntentRangeRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* release_header();
   void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
+  // optional bool rollback = 2;
+  bool has_rollback() const;
+  void clear_rollback();
+  static const int kRollbackFieldNumber = 2;
+  bool rollback() const;
+  void set_rollback(bool value);
+
+  // optional .cockroach.proto.Value restore = 3;
+  bool has_restore() const;
+  void clear_restore();
+  static const int kRestoreFieldNumber = 3;
+  const ::cockroach::proto::Value& restore() const;
+  ::cockroach::proto::Value* mutable_restore();
+  ::cockroach::proto::Value* release_restore();
+  void set_allocated_restore(::cockroach::proto::Value* restore);
+
+  // optional bool restore_deleted = 4;
+  bool has_restore_deleted() const;
+  void clear_restore_deleted();
+  static const int kRestoreDeletedFieldNumber = 4;
+  bool restore_deleted() const;
+  void set_restore_deleted(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.InternalResolveIntentRangeRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_rollback();
+  inline void clear_has_rollback();
+  inline void set_has_restore();
+  inline void clear_has_restore();
+  inline void set_has_restore_deleted();
+  inline void clear_has_restore_deleted();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Value* restore_;
+  bool rollback_;
+  bool restore_deleted_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
@@ -2042,6 +2435,11 @@ class InternalRequestUnion : public ::google::protobuf::Message {
     kDeleteRange = 7,
     kScan = 8,
     kEndTransaction = 9,
+    kReverseScan = 10,
+    kReapQueue = 11,
+    kEnqueueUpdate = 12,
+    kEnqueueMessage = 13,
+    kChanges = 14,
     kInternalPushTxn = 30,
     kInternalResolveIntent = 31,
     kInternalResolveIntentRange = 32,
@@ -2161,6 +2559,51 @@ class InternalRequestUnion : public ::google::protobuf::Message {
   ::cockroach::proto::EndTransactionRequest* release_end_transaction();
   void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
 
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  bool has_reverse_scan() const;
+  void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 10;
+  const ::cockroach::proto::ReverseScanRequest& reverse_scan() const;
+  ::cockroach::proto::ReverseScanRequest* mutable_reverse_scan();
+  ::cockroach::proto::ReverseScanRequest* release_reverse_scan();
+  void set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan);
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  bool has_reap_queue() const;
+  void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 11;
+  const ::cockroach::proto::ReapQueueRequest& reap_queue() const;
+  ::cockroach::proto::ReapQueueRequest* mutable_reap_queue();
+  ::cockroach::proto::ReapQueueRequest* release_reap_queue();
+  void set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue);
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  bool has_enqueue_update() const;
+  void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 12;
+  const ::cockroach::proto::EnqueueUpdateRequest& enqueue_update() const;
+  ::cockroach::proto::EnqueueUpdateRequest* mutable_enqueue_update();
+  ::cockroach::proto::EnqueueUpdateRequest* release_enqueue_update();
+  void set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update);
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  bool has_enqueue_message() const;
+  void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 13;
+  const ::cockroach::proto::EnqueueMessageRequest& enqueue_message() const;
+  ::cockroach::proto::EnqueueMessageRequest* mutable_enqueue_message();
+  ::cockroach::proto::EnqueueMessageRequest* release_enqueue_message();
+  void set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message);
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  bool has_changes() const;
+  void clear_changes();
+  static const int kChangesFieldNumber = 14;
+  const ::cockroach::proto::ChangesRequest& changes() const;
+  ::cockroach::proto::ChangesRequest* mutable_changes();
+  ::cockroach::proto::ChangesRequest* release_changes();
+  void set_allocated_changes(::cockroach::proto::ChangesRequest* changes);
+
   // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
   bool has_internal_push_txn() const;
   void clear_internal_push_txn();
@@ -2199,6 +2642,11 @@ class InternalRequestUnion : public ::google::protobuf::Message {
   inline void set_has_delete_range();
   inline void set_has_scan();
   inline void set_has_end_transaction();
+  inline void set_has_reverse_scan();
+  inline void set_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void set_has_enqueue_message();
+  inline void set_has_changes();
   inline void set_has_internal_push_txn();
   inline void set_has_internal_resolve_intent();
   inline void set_has_internal_resolve_intent_range();
@@ -2220,6 +2668,11 @@ class InternalRequestUnion : public ::google::protobuf::Message {
     ::cockroach::proto::DeleteRangeRequest* delete_range_;
     ::cockroach::proto::ScanRequest* scan_;
     ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::ReverseScanRequest* reverse_scan_;
+    ::cockroach::proto::ReapQueueRequest* reap_queue_;
+    ::cockroach::proto::EnqueueUpdateRequest* enqueue_update_;
+    ::cockroach::proto::EnqueueMessageRequest* enqueue_message_;
+    ::cockroach::proto::ChangesRequest* changes_;
     ::cockroach::proto::InternalPushTxnRequest* internal_push_txn_;
     ::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent_;
     ::cockroach::proto::InternalResolveIntentRangeRequest* internal_resolve_intent_range_;
@@ -2267,6 +2720,11 @@ class InternalResponseUnion : public ::google::protobuf::Message {
     kDeleteRange = 7,
     kScan = 8,
     kEndTransaction = 9,
+    kReverseScan = 10,
+    kReapQueue = 11,
+    kEnqueueUpdate = 12,
+    kEnqueueMessage = 13,
+    kChanges = 14,
     kInternalPushTxn = 30,
     kInternalResolveIntent = 31,
     kInternalResolveIntentRange = 32,
@@ -2386,6 +2844,51 @@ class InternalResponseUnion : public ::google::protobuf::Message {
   ::cockroach::proto::EndTransactionResponse* release_end_transaction();
   void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
 
+  // optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+  bool has_reverse_scan() const;
+  void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 10;
+  const ::cockroach::proto::ReverseScanResponse& reverse_scan() const;
+  ::cockroach::proto::ReverseScanResponse* mutable_reverse_scan();
+  ::cockroach::proto::ReverseScanResponse* release_reverse_scan();
+  void set_allocated_reverse_scan(::cockroach::proto::ReverseScanResponse* reverse_scan);
+
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+  bool has_reap_queue() const;
+  void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 11;
+  const ::cockroach::proto::ReapQueueResponse& reap_queue() const;
+  ::cockroach::proto::ReapQueueResponse* mutable_reap_queue();
+  ::cockroach::proto::ReapQueueResponse* release_reap_queue();
+  void set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue);
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+  bool has_enqueue_update() const;
+  void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 12;
+  const ::cockroach::proto::EnqueueUpdateResponse& enqueue_update() const;
+  ::cockroach::proto::EnqueueUpdateResponse* mutable_enqueue_update();
+  ::cockroach::proto::EnqueueUpdateResponse* release_enqueue_update();
+  void set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update);
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+  bool has_enqueue_message() const;
+  void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 13;
+  const ::cockroach::proto::EnqueueMessageResponse& enqueue_message() const;
+  ::cockroach::proto::EnqueueMessageResponse* mutable_enqueue_message();
+  ::cockroach::proto::EnqueueMessageResponse* release_enqueue_message();
+  void set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message);
+
+  // optional .cockroach.proto.ChangesResponse changes = 14;
+  bool has_changes() const;
+  void clear_changes();
+  static const int kChangesFieldNumber = 14;
+  const ::cockroach::proto::ChangesResponse& changes() const;
+  ::cockroach::proto::ChangesResponse* mutable_changes();
+  ::cockroach::proto::ChangesResponse* release_changes();
+  void set_allocated_changes(::cockroach::proto::ChangesResponse* changes);
+
   // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
   bool has_internal_push_txn() const;
   void clear_internal_push_txn();
@@ -2424,6 +2927,11 @@ class InternalResponseUnion : public ::google::protobuf::Message {
   inline void set_has_delete_range();
   inline void set_has_scan();
   inline void set_has_end_transaction();
+  inline void set_has_reverse_scan();
+  inline void set_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void set_has_enqueue_message();
+  inline void set_has_changes();
   inline void set_has_internal_push_txn();
   inline void set_has_internal_resolve_intent();
   inline void set_has_internal_resolve_intent_range();
@@ -2445,6 +2953,11 @@ class InternalResponseUnion : public ::google::protobuf::Message {
     ::cockroach::proto::DeleteRangeResponse* delete_range_;
     ::cockroach::proto::ScanResponse* scan_;
     ::cockroach::proto::EndTransactionResponse* end_transaction_;
+    ::cockroach::proto::ReverseScanResponse* reverse_scan_;
+    ::cockroach::proto::ReapQueueResponse* reap_queue_;
+    ::cockroach::proto::EnqueueUpdateResponse* enqueue_update_;
+    ::cockroach::proto::EnqueueMessageResponse* enqueue_message_;
+    ::cockroach::proto::ChangesResponse* changes_;
     ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
     ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
     ::cockroach::proto::InternalResolveIntentRangeResponse* internal_resolve_intent_range_;
@@ -2698,6 +3211,9 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     kDelete = 4,
     kDeleteRange = 5,
     kEndTransaction = 6,
+    kReapQueue = 7,
+    kEnqueueUpdate = 8,
+    kEnqueueMessage = 9,
     kInternalHeartbeatTxn = 10,
     kInternalPushTxn = 11,
     kInternalResolveIntent = 12,
@@ -2706,6 +3222,7 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     kInternalTruncateLog = 15,
     kInternalGc = 16,
     kInternalLeaderLease = 17,
+    kInternalBatch = 18,
     VALUE_NOT_SET = 0,
   };
 
@@ -2804,6 +3321,33 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   ::cockroach::proto::EndTransactionResponse* release_end_transaction();
   void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
 
+  // optional .cockroach.proto.ReapQueueResponse reap_queue = 7;
+  bool has_reap_queue() const;
+  void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 7;
+  const ::cockroach::proto::ReapQueueResponse& reap_queue() const;
+  ::cockroach::proto::ReapQueueResponse* mutable_reap_queue();
+  ::cockroach::proto::ReapQueueResponse* release_reap_queue();
+  void set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue);
+
+  // optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 8;
+  bool has_enqueue_update() const;
+  void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 8;
+  const ::cockroach::proto::EnqueueUpdateResponse& enqueue_update() const;
+  ::cockroach::proto::EnqueueUpdateResponse* mutable_enqueue_update();
+  ::cockroach::proto::EnqueueUpdateResponse* release_enqueue_update();
+  void set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update);
+
+  // optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 9;
+  bool has_enqueue_message() const;
+  void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 9;
+  const ::cockroach::proto::EnqueueMessageResponse& enqueue_message() const;
+  ::cockroach::proto::EnqueueMessageResponse* mutable_enqueue_message();
+  ::cockroach::proto::EnqueueMessageResponse* release_enqueue_message();
+  void set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message);
+
   // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
   bool has_internal_heartbeat_txn() const;
   void clear_internal_heartbeat_txn();
@@ -2876,6 +3420,15 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   ::cockroach::proto::InternalLeaderLeaseResponse* release_internal_leader_lease();
   void set_allocated_internal_leader_lease(::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease);
 
+  // optional .cockroach.proto.InternalBatchResponse internal_batch = 18;
+  bool has_internal_batch() const;
+  void clear_internal_batch();
+  static const int kInternalBatchFieldNumber = 18;
+  const ::cockroach::proto::InternalBatchResponse& internal_batch() const;
+  ::cockroach::proto::InternalBatchResponse* mutable_internal_batch();
+  ::cockroach::proto::InternalBatchResponse* release_internal_batch();
+  void set_allocated_internal_batch(::cockroach::proto::InternalBatchResponse* internal_batch);
+
   ValueCase value_case() const;
   // @@protoc_insertion_point(class_scope:cockroach.proto.ReadWriteCmdResponse)
  private:
@@ -2885,6 +3438,9 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   inline void set_has_delete_();
   inline void set_has_delete_range();
   inline void set_has_end_transaction();
+  inline void set_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void set_has_enqueue_message();
   inline void set_has_internal_heartbeat_txn();
   inline void set_has_internal_push_txn();
   inline void set_has_internal_resolve_intent();
@@ -2893,6 +3449,7 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   inline void set_has_internal_truncate_log();
   inline void set_has_internal_gc();
   inline void set_has_internal_leader_lease();
+  inline void set_has_internal_batch();
 
   inline bool has_value() const;
   void clear_value();
@@ -2909,6 +3466,9 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     ::cockroach::proto::DeleteResponse* delete__;
     ::cockroach::proto::DeleteRangeResponse* delete_range_;
     ::cockroach::proto::EndTransactionResponse* end_transaction_;
+    ::cockroach::proto::ReapQueueResponse* reap_queue_;
+    ::cockroach::proto::EnqueueUpdateResponse* enqueue_update_;
+    ::cockroach::proto::EnqueueMessageResponse* enqueue_message_;
     ::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
     ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
     ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
@@ -2917,6 +3477,7 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
     ::cockroach::proto::InternalGCResponse* internal_gc_;
     ::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease_;
+    ::cockroach::proto::InternalBatchResponse* internal_batch_;
   } value_;
   ::google::protobuf::uint32 _oneof_case_[1];
 
@@ -2961,6 +3522,11 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
     kDeleteRange = 7,
     kScan = 8,
     kEndTransaction = 9,
+    kReverseScan = 10,
+    kReapQueue = 11,
+    kEnqueueUpdate = 12,
+    kEnqueueMessage = 13,
+    kChanges = 14,
     kBatch = 30,
     kInternalRangeLookup = 31,
     kInternalHeartbeatTxn = 32,
@@ -3088,6 +3654,51 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
   ::cockroach::proto::EndTransactionRequest* release_end_transaction();
   void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
 
+  // optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+  bool has_reverse_scan() const;
+  void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 10;
+  const ::cockroach::proto::ReverseScanRequest& reverse_scan() const;
+  ::cockroach::proto::ReverseScanRequest* mutable_reverse_scan();
+  ::cockroach::proto::ReverseScanRequest* release_reverse_scan();
+  void set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan);
+
+  // optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+  bool has_reap_queue() const;
+  void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 11;
+  const ::cockroach::proto::ReapQueueRequest& reap_queue() const;
+  ::cockroach::proto::ReapQueueRequest* mutable_reap_queue();
+  ::cockroach::proto::ReapQueueRequest* release_reap_queue();
+  void set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue);
+
+  // optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+  bool has_enqueue_update() const;
+  void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 12;
+  const ::cockroach::proto::EnqueueUpdateRequest& enqueue_update() const;
+  ::cockroach::proto::EnqueueUpdateRequest* mutable_enqueue_update();
+  ::cockroach::proto::EnqueueUpdateRequest* release_enqueue_update();
+  void set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update);
+
+  // optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+  bool has_enqueue_message() const;
+  void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 13;
+  const ::cockroach::proto::EnqueueMessageRequest& enqueue_message() const;
+  ::cockroach::proto::EnqueueMessageRequest* mutable_enqueue_message();
+  ::cockroach::proto::EnqueueMessageRequest* release_enqueue_message();
+  void set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message);
+
+  // optional .cockroach.proto.ChangesRequest changes = 14;
+  bool has_changes() const;
+  void clear_changes();
+  static const int kChangesFieldNumber = 14;
+  const ::cockroach::proto::ChangesRequest& changes() const;
+  ::cockroach::proto::ChangesRequest* mutable_changes();
+  ::cockroach::proto::ChangesRequest* release_changes();
+  void set_allocated_changes(::cockroach::proto::ChangesRequest* changes);
+
   // optional .cockroach.proto.BatchRequest batch = 30;
   bool has_batch() const;
   void clear_batch();
@@ -3198,6 +3809,11 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
   inline void set_has_delete_range();
   inline void set_has_scan();
   inline void set_has_end_transaction();
+  inline void set_has_reverse_scan();
+  inline void set_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void set_has_enqueue_message();
+  inline void set_has_changes();
   inline void set_has_batch();
   inline void set_has_internal_range_lookup();
   inline void set_has_internal_heartbeat_txn();
@@ -3227,6 +3843,11 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
     ::cockroach::proto::DeleteRangeRequest* delete_range_;
     ::cockroach::proto::ScanRequest* scan_;
     ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::ReverseScanRequest* reverse_scan_;
+    ::cockroach::proto::ReapQueueRequest* reap_queue_;
+    ::cockroach::proto::EnqueueUpdateRequest* enqueue_update_;
+    ::cockroach::proto::EnqueueMessageRequest* enqueue_message_;
+    ::cockroach::proto::ChangesRequest* changes_;
     ::cockroach::proto::BatchRequest* batch_;
     ::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup_;
     ::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
@@ -3337,6 +3958,15 @@ class InternalRaftCommand : public ::google::protobuf::Message {
   ::cockroach::proto::InternalRaftCommandUnion* release_cmd();
   void set_allocated_cmd(::cockroach::proto::InternalRaftCommandUnion* cmd);
 
+  // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+  bool has_closed_timestamp() const;
+  void clear_closed_timestamp();
+  static const int kClosedTimestampFieldNumber = 4;
+  const ::cockroach::proto::Timestamp& closed_timestamp() const;
+  ::cockroach::proto::Timestamp* mutable_closed_timestamp();
+  ::cockroach::proto::Timestamp* release_closed_timestamp();
+  void set_allocated_closed_timestamp(::cockroach::proto::Timestamp* closed_timestamp);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRaftCommand)
  private:
   inline void set_has_raft_id();
@@ -3345,6 +3975,8 @@ class InternalRaftCommand : public ::google::protobuf::Message {
   inline void clear_has_origin_node_id();
   inline void set_has_cmd();
   inline void clear_has_cmd();
+  inline void set_has_closed_timestamp();
+  inline void clear_has_closed_timestamp();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -3352,6 +3984,7 @@ class InternalRaftCommand : public ::google::protobuf::Message {
   ::google::protobuf::int64 raft_id_;
   ::google::protobuf::uint64 origin_node_id_;
   ::cockroach::proto::InternalRaftCommandUnion* cmd_;
+  ::cockroach::proto::Timestamp* closed_timestamp_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
@@ -4196,6 +4829,30 @@ inline void InternalRangeLookupRequest::set_ignore_intents(bool value) {
   // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.ignore_intents)
 }
 
+// optional bool reverse = 4;
+inline bool InternalRangeLookupRequest::has_reverse() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalRangeLookupRequest::set_has_reverse() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalRangeLookupRequest::clear_has_reverse() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalRangeLookupRequest::clear_reverse() {
+  reverse_ = false;
+  clear_has_reverse();
+}
+inline bool InternalRangeLookupRequest::reverse() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupRequest.reverse)
+  return reverse_;
+}
+inline void InternalRangeLookupRequest::set_reverse(bool value) {
+  set_has_reverse();
+  reverse_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.reverse)
+}
+
 // -------------------------------------------------------------------
 
 // InternalRangeLookupResponse
@@ -4587,6 +5244,30 @@ InternalGCRequest::mutable_keys() {
   return &keys_;
 }
 
+// optional uint64 next_expiration_seq = 4;
+inline bool InternalGCRequest::has_next_expiration_seq() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalGCRequest::set_has_next_expiration_seq() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalGCRequest::clear_has_next_expiration_seq() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalGCRequest::clear_next_expiration_seq() {
+  next_expiration_seq_ = GOOGLE_ULONGLONG(0);
+  clear_has_next_expiration_seq();
+}
+inline ::google::protobuf::uint64 InternalGCRequest::next_expiration_seq() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.next_expiration_seq)
+  return next_expiration_seq_;
+}
+inline void InternalGCRequest::set_next_expiration_seq(::google::protobuf::uint64 value) {
+  set_has_next_expiration_seq();
+  next_expiration_seq_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalGCRequest.next_expiration_seq)
+}
+
 // -------------------------------------------------------------------
 
 // InternalGCResponse
@@ -4908,13 +5589,357 @@ inline void InternalPushTxnResponse::set_allocated_pushee_txn(::cockroach::proto
 
 // -------------------------------------------------------------------
 
-// InternalResolveIntentRequest
+// TxnWaitEdge
 
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalResolveIntentRequest::has_header() const {
+// optional bytes pusher_id = 1;
+inline bool TxnWaitEdge::has_pusher_id() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalResolveIntentRequest::set_has_header() {
+inline void TxnWaitEdge::set_has_pusher_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void TxnWaitEdge::clear_has_pusher_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void TxnWaitEdge::clear_pusher_id() {
+  pusher_id_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_pusher_id();
+}
+inline const ::std::string& TxnWaitEdge::pusher_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.TxnWaitEdge.pusher_id)
+  return pusher_id_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void TxnWaitEdge::set_pusher_id(const ::std::string& value) {
+  set_has_pusher_id();
+  pusher_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.TxnWaitEdge.pusher_id)
+}
+inline void TxnWaitEdge::set_pusher_id(const char* value) {
+  set_has_pusher_id();
+  pusher_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.TxnWaitEdge.pusher_id)
+}
+inline void TxnWaitEdge::set_pusher_id(const void* value, size_t size) {
+  set_has_pusher_id();
+  pusher_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.TxnWaitEdge.pusher_id)
+}
+inline ::std::string* TxnWaitEdge::mutable_pusher_id() {
+  set_has_pusher_id();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.TxnWaitEdge.pusher_id)
+  return pusher_id_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* TxnWaitEdge::release_pusher_id() {
+  clear_has_pusher_id();
+  return pusher_id_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void TxnWaitEdge::set_allocated_pusher_id(::std::string* pusher_id) {
+  if (pusher_id != NULL) {
+    set_has_pusher_id();
+  } else {
+    clear_has_pusher_id();
+  }
+  pusher_id_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), pusher_id);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.TxnWaitEdge.pusher_id)
+}
+
+// optional int32 pusher_priority = 2;
+inline bool TxnWaitEdge::has_pusher_priority() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void TxnWaitEdge::set_has_pusher_priority() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void TxnWaitEdge::clear_has_pusher_priority() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void TxnWaitEdge::clear_pusher_priority() {
+  pusher_priority_ = 0;
+  clear_has_pusher_priority();
+}
+inline ::google::protobuf::int32 TxnWaitEdge::pusher_priority() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.TxnWaitEdge.pusher_priority)
+  return pusher_priority_;
+}
+inline void TxnWaitEdge::set_pusher_priority(::google::protobuf::int32 value) {
+  set_has_pusher_priority();
+  pusher_priority_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.TxnWaitEdge.pusher_priority)
+}
+
+// optional bytes pushee_id = 3;
+inline bool TxnWaitEdge::has_pushee_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void TxnWaitEdge::set_has_pushee_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void TxnWaitEdge::clear_has_pushee_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void TxnWaitEdge::clear_pushee_id() {
+  pushee_id_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_pushee_id();
+}
+inline const ::std::string& TxnWaitEdge::pushee_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.TxnWaitEdge.pushee_id)
+  return pushee_id_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void TxnWaitEdge::set_pushee_id(const ::std::string& value) {
+  set_has_pushee_id();
+  pushee_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.TxnWaitEdge.pushee_id)
+}
+inline void TxnWaitEdge::set_pushee_id(const char* value) {
+  set_has_pushee_id();
+  pushee_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.TxnWaitEdge.pushee_id)
+}
+inline void TxnWaitEdge::set_pushee_id(const void* value, size_t size) {
+  set_has_pushee_id();
+  pushee_id_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.TxnWaitEdge.pushee_id)
+}
+inline ::std::string* TxnWaitEdge::mutable_pushee_id() {
+  set_has_pushee_id();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.TxnWaitEdge.pushee_id)
+  return pushee_id_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* TxnWaitEdge::release_pushee_id() {
+  clear_has_pushee_id();
+  return pushee_id_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void TxnWaitEdge::set_allocated_pushee_id(::std::string* pushee_id) {
+  if (pushee_id != NULL) {
+    set_has_pushee_id();
+  } else {
+    clear_has_pushee_id();
+  }
+  pushee_id_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), pushee_id);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.TxnWaitEdge.pushee_id)
+}
+
+// -------------------------------------------------------------------
+
+// InternalQueryTxnRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool InternalQueryTxnRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalQueryTxnRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalQueryTxnRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalQueryTxnRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& InternalQueryTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalQueryTxnRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalQueryTxnRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalQueryTxnRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnRequest.header)
+}
+
+// optional .cockroach.proto.Transaction queried_txn = 2;
+inline bool InternalQueryTxnRequest::has_queried_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalQueryTxnRequest::set_has_queried_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalQueryTxnRequest::clear_has_queried_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalQueryTxnRequest::clear_queried_txn() {
+  if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_queried_txn();
+}
+inline const ::cockroach::proto::Transaction& InternalQueryTxnRequest::queried_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnRequest.queried_txn)
+  return queried_txn_ != NULL ? *queried_txn_ : *default_instance_->queried_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalQueryTxnRequest::mutable_queried_txn() {
+  set_has_queried_txn();
+  if (queried_txn_ == NULL) {
+    queried_txn_ = new ::cockroach::proto::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnRequest.queried_txn)
+  return queried_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalQueryTxnRequest::release_queried_txn() {
+  clear_has_queried_txn();
+  ::cockroach::proto::Transaction* temp = queried_txn_;
+  queried_txn_ = NULL;
+  return temp;
+}
+inline void InternalQueryTxnRequest::set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn) {
+  delete queried_txn_;
+  queried_txn_ = queried_txn;
+  if (queried_txn) {
+    set_has_queried_txn();
+  } else {
+    clear_has_queried_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnRequest.queried_txn)
+}
+
+// -------------------------------------------------------------------
+
+// InternalQueryTxnResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool InternalQueryTxnResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalQueryTxnResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalQueryTxnResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalQueryTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& InternalQueryTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalQueryTxnResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalQueryTxnResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalQueryTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnResponse.header)
+}
+
+// optional .cockroach.proto.Transaction queried_txn = 2;
+inline bool InternalQueryTxnResponse::has_queried_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalQueryTxnResponse::set_has_queried_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalQueryTxnResponse::clear_has_queried_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalQueryTxnResponse::clear_queried_txn() {
+  if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_queried_txn();
+}
+inline const ::cockroach::proto::Transaction& InternalQueryTxnResponse::queried_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+  return queried_txn_ != NULL ? *queried_txn_ : *default_instance_->queried_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalQueryTxnResponse::mutable_queried_txn() {
+  set_has_queried_txn();
+  if (queried_txn_ == NULL) {
+    queried_txn_ = new ::cockroach::proto::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+  return queried_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalQueryTxnResponse::release_queried_txn() {
+  clear_has_queried_txn();
+  ::cockroach::proto::Transaction* temp = queried_txn_;
+  queried_txn_ = NULL;
+  return temp;
+}
+inline void InternalQueryTxnResponse::set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn) {
+  delete queried_txn_;
+  queried_txn_ = queried_txn;
+  if (queried_txn) {
+    set_has_queried_txn();
+  } else {
+    clear_has_queried_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+}
+
+// repeated .cockroach.proto.TxnWaitEdge waits = 3;
+inline int InternalQueryTxnResponse::waits_size() const {
+  return waits_.size();
+}
+inline void InternalQueryTxnResponse::clear_waits() {
+  waits_.Clear();
+}
+inline const ::cockroach::proto::TxnWaitEdge& InternalQueryTxnResponse::waits(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.waits)
+  return waits_.Get(index);
+}
+inline ::cockroach::proto::TxnWaitEdge* InternalQueryTxnResponse::mutable_waits(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.waits)
+  return waits_.Mutable(index);
+}
+inline ::cockroach::proto::TxnWaitEdge* InternalQueryTxnResponse::add_waits() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.InternalQueryTxnResponse.waits)
+  return waits_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TxnWaitEdge >&
+InternalQueryTxnResponse::waits() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.InternalQueryTxnResponse.waits)
+  return waits_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TxnWaitEdge >*
+InternalQueryTxnResponse::mutable_waits() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalQueryTxnResponse.waits)
+  return &waits_;
+}
+
+// -------------------------------------------------------------------
+
+// InternalResolveIntentRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool InternalResolveIntentRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalResolveIntentRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
 inline void InternalResolveIntentRequest::clear_has_header() {
@@ -5047,6 +6072,97 @@ inline void InternalResolveIntentRangeRequest::set_allocated_header(::cockroach:
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRangeRequest.header)
 }
 
+// optional bool rollback = 2;
+inline bool InternalResolveIntentRangeRequest::has_rollback() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalResolveIntentRangeRequest::set_has_rollback() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalResolveIntentRangeRequest::clear_has_rollback() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalResolveIntentRangeRequest::clear_rollback() {
+  rollback_ = false;
+  clear_has_rollback();
+}
+inline bool InternalResolveIntentRangeRequest::rollback() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRangeRequest.rollback)
+  return rollback_;
+}
+inline void InternalResolveIntentRangeRequest::set_rollback(bool value) {
+  set_has_rollback();
+  rollback_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalResolveIntentRangeRequest.rollback)
+}
+
+// optional .cockroach.proto.Value restore = 3;
+inline bool InternalResolveIntentRangeRequest::has_restore() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalResolveIntentRangeRequest::set_has_restore() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalResolveIntentRangeRequest::clear_has_restore() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalResolveIntentRangeRequest::clear_restore() {
+  if (restore_ != NULL) restore_->::cockroach::proto::Value::Clear();
+  clear_has_restore();
+}
+inline const ::cockroach::proto::Value& InternalResolveIntentRangeRequest::restore() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRangeRequest.restore)
+  return restore_ != NULL ? *restore_ : *default_instance_->restore_;
+}
+inline ::cockroach::proto::Value* InternalResolveIntentRangeRequest::mutable_restore() {
+  set_has_restore();
+  if (restore_ == NULL) {
+    restore_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentRangeRequest.restore)
+  return restore_;
+}
+inline ::cockroach::proto::Value* InternalResolveIntentRangeRequest::release_restore() {
+  clear_has_restore();
+  ::cockroach::proto::Value* temp = restore_;
+  restore_ = NULL;
+  return temp;
+}
+inline void InternalResolveIntentRangeRequest::set_allocated_restore(::cockroach::proto::Value* restore) {
+  delete restore_;
+  restore_ = restore;
+  if (restore) {
+    set_has_restore();
+  } else {
+    clear_has_restore();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRangeRequest.restore)
+}
+
+// optional bool restore_deleted = 4;
+inline bool InternalResolveIntentRangeRequest::has_restore_deleted() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalResolveIntentRangeRequest::set_has_restore_deleted() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalResolveIntentRangeRequest::clear_has_restore_deleted() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalResolveIntentRangeRequest::clear_restore_deleted() {
+  restore_deleted_ = false;
+  clear_has_restore_deleted();
+}
+inline bool InternalResolveIntentRangeRequest::restore_deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRangeRequest.restore_deleted)
+  return restore_deleted_;
+}
+inline void InternalResolveIntentRangeRequest::set_restore_deleted(bool value) {
+  set_has_restore_deleted();
+  restore_deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalResolveIntentRangeRequest.restore_deleted)
+}
+
 // -------------------------------------------------------------------
 
 // InternalResolveIntentRangeResponse
@@ -5858,6 +6974,236 @@ inline void InternalRequestUnion::set_allocated_end_transaction(::cockroach::pro
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.end_transaction)
 }
 
+// optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+inline bool InternalRequestUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+inline void InternalRequestUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+inline void InternalRequestUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReverseScanRequest& InternalRequestUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanRequest::default_instance();
+}
+inline ::cockroach::proto::ReverseScanRequest* InternalRequestUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+inline ::cockroach::proto::ReverseScanRequest* InternalRequestUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanRequest* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+inline bool InternalRequestUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+inline void InternalRequestUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+inline void InternalRequestUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReapQueueRequest& InternalRequestUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueRequest::default_instance();
+}
+inline ::cockroach::proto::ReapQueueRequest* InternalRequestUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.reap_queue)
+  return value_.reap_queue_;
+}
+inline ::cockroach::proto::ReapQueueRequest* InternalRequestUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueRequest* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+inline bool InternalRequestUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+inline void InternalRequestUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+inline void InternalRequestUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueUpdateRequest& InternalRequestUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateRequest::default_instance();
+}
+inline ::cockroach::proto::EnqueueUpdateRequest* InternalRequestUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+inline ::cockroach::proto::EnqueueUpdateRequest* InternalRequestUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateRequest* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+inline bool InternalRequestUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+inline void InternalRequestUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+inline void InternalRequestUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueMessageRequest& InternalRequestUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageRequest::default_instance();
+}
+inline ::cockroach::proto::EnqueueMessageRequest* InternalRequestUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.enqueue_message)
+  return value_.enqueue_message_;
+}
+inline ::cockroach::proto::EnqueueMessageRequest* InternalRequestUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageRequest* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.enqueue_message)
+}
+
+// optional .cockroach.proto.ChangesRequest changes = 14;
+inline bool InternalRequestUnion::has_changes() const {
+  return value_case() == kChanges;
+}
+inline void InternalRequestUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
+}
+inline void InternalRequestUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ChangesRequest& InternalRequestUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesRequest::default_instance();
+}
+inline ::cockroach::proto::ChangesRequest* InternalRequestUnion::mutable_changes() {
+  if (!has_changes()) {
+    clear_value();
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.changes)
+  return value_.changes_;
+}
+inline ::cockroach::proto::ChangesRequest* InternalRequestUnion::release_changes() {
+  if (has_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ChangesRequest* temp = value_.changes_;
+    value_.changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_changes(::cockroach::proto::ChangesRequest* changes) {
+  clear_value();
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.changes)
+}
+
 // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
 inline bool InternalRequestUnion::has_internal_push_txn() const {
   return value_case() == kInternalPushTxn;
@@ -6292,89 +7638,319 @@ inline bool InternalResponseUnion::has_scan() const {
 inline void InternalResponseUnion::set_has_scan() {
   _oneof_case_[0] = kScan;
 }
-inline void InternalResponseUnion::clear_scan() {
-  if (has_scan()) {
-    delete value_.scan_;
+inline void InternalResponseUnion::clear_scan() {
+  if (has_scan()) {
+    delete value_.scan_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanResponse& InternalResponseUnion::scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.scan)
+  return has_scan() ? *value_.scan_
+                      : ::cockroach::proto::ScanResponse::default_instance();
+}
+inline ::cockroach::proto::ScanResponse* InternalResponseUnion::mutable_scan() {
+  if (!has_scan()) {
+    clear_value();
+    set_has_scan();
+    value_.scan_ = new ::cockroach::proto::ScanResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.scan)
+  return value_.scan_;
+}
+inline ::cockroach::proto::ScanResponse* InternalResponseUnion::release_scan() {
+  if (has_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ScanResponse* temp = value_.scan_;
+    value_.scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_scan(::cockroach::proto::ScanResponse* scan) {
+  clear_value();
+  if (scan) {
+    set_has_scan();
+    value_.scan_ = scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.scan)
+}
+
+// optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+inline bool InternalResponseUnion::has_end_transaction() const {
+  return value_case() == kEndTransaction;
+}
+inline void InternalResponseUnion::set_has_end_transaction() {
+  _oneof_case_[0] = kEndTransaction;
+}
+inline void InternalResponseUnion::clear_end_transaction() {
+  if (has_end_transaction()) {
+    delete value_.end_transaction_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EndTransactionResponse& InternalResponseUnion::end_transaction() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.end_transaction)
+  return has_end_transaction() ? *value_.end_transaction_
+                      : ::cockroach::proto::EndTransactionResponse::default_instance();
+}
+inline ::cockroach::proto::EndTransactionResponse* InternalResponseUnion::mutable_end_transaction() {
+  if (!has_end_transaction()) {
+    clear_value();
+    set_has_end_transaction();
+    value_.end_transaction_ = new ::cockroach::proto::EndTransactionResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.end_transaction)
+  return value_.end_transaction_;
+}
+inline ::cockroach::proto::EndTransactionResponse* InternalResponseUnion::release_end_transaction() {
+  if (has_end_transaction()) {
+    clear_has_value();
+    ::cockroach::proto::EndTransactionResponse* temp = value_.end_transaction_;
+    value_.end_transaction_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction) {
+  clear_value();
+  if (end_transaction) {
+    set_has_end_transaction();
+    value_.end_transaction_ = end_transaction;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.end_transaction)
+}
+
+// optional .cockroach.proto.ReverseScanResponse reverse_scan = 10;
+inline bool InternalResponseUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+inline void InternalResponseUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+inline void InternalResponseUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReverseScanResponse& InternalResponseUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanResponse::default_instance();
+}
+inline ::cockroach::proto::ReverseScanResponse* InternalResponseUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+inline ::cockroach::proto::ReverseScanResponse* InternalResponseUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanResponse* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanResponse* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueResponse reap_queue = 11;
+inline bool InternalResponseUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+inline void InternalResponseUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+inline void InternalResponseUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReapQueueResponse& InternalResponseUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueResponse::default_instance();
+}
+inline ::cockroach::proto::ReapQueueResponse* InternalResponseUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.reap_queue)
+  return value_.reap_queue_;
+}
+inline ::cockroach::proto::ReapQueueResponse* InternalResponseUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueResponse* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 12;
+inline bool InternalResponseUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+inline void InternalResponseUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+inline void InternalResponseUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueUpdateResponse& InternalResponseUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateResponse::default_instance();
+}
+inline ::cockroach::proto::EnqueueUpdateResponse* InternalResponseUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+inline ::cockroach::proto::EnqueueUpdateResponse* InternalResponseUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateResponse* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 13;
+inline bool InternalResponseUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+inline void InternalResponseUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+inline void InternalResponseUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::ScanResponse& InternalResponseUnion::scan() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.scan)
-  return has_scan() ? *value_.scan_
-                      : ::cockroach::proto::ScanResponse::default_instance();
+inline const ::cockroach::proto::EnqueueMessageResponse& InternalResponseUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageResponse::default_instance();
 }
-inline ::cockroach::proto::ScanResponse* InternalResponseUnion::mutable_scan() {
-  if (!has_scan()) {
+inline ::cockroach::proto::EnqueueMessageResponse* InternalResponseUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
     clear_value();
-    set_has_scan();
-    value_.scan_ = new ::cockroach::proto::ScanResponse;
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageResponse;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.scan)
-  return value_.scan_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.enqueue_message)
+  return value_.enqueue_message_;
 }
-inline ::cockroach::proto::ScanResponse* InternalResponseUnion::release_scan() {
-  if (has_scan()) {
+inline ::cockroach::proto::EnqueueMessageResponse* InternalResponseUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
     clear_has_value();
-    ::cockroach::proto::ScanResponse* temp = value_.scan_;
-    value_.scan_ = NULL;
+    ::cockroach::proto::EnqueueMessageResponse* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void InternalResponseUnion::set_allocated_scan(::cockroach::proto::ScanResponse* scan) {
+inline void InternalResponseUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message) {
   clear_value();
-  if (scan) {
-    set_has_scan();
-    value_.scan_ = scan;
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.scan)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.enqueue_message)
 }
 
-// optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-inline bool InternalResponseUnion::has_end_transaction() const {
-  return value_case() == kEndTransaction;
+// optional .cockroach.proto.ChangesResponse changes = 14;
+inline bool InternalResponseUnion::has_changes() const {
+  return value_case() == kChanges;
 }
-inline void InternalResponseUnion::set_has_end_transaction() {
-  _oneof_case_[0] = kEndTransaction;
+inline void InternalResponseUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
 }
-inline void InternalResponseUnion::clear_end_transaction() {
-  if (has_end_transaction()) {
-    delete value_.end_transaction_;
+inline void InternalResponseUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::EndTransactionResponse& InternalResponseUnion::end_transaction() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.end_transaction)
-  return has_end_transaction() ? *value_.end_transaction_
-                      : ::cockroach::proto::EndTransactionResponse::default_instance();
+inline const ::cockroach::proto::ChangesResponse& InternalResponseUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesResponse::default_instance();
 }
-inline ::cockroach::proto::EndTransactionResponse* InternalResponseUnion::mutable_end_transaction() {
-  if (!has_end_transaction()) {
+inline ::cockroach::proto::ChangesResponse* InternalResponseUnion::mutable_changes() {
+  if (!has_changes()) {
     clear_value();
-    set_has_end_transaction();
-    value_.end_transaction_ = new ::cockroach::proto::EndTransactionResponse;
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesResponse;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.end_transaction)
-  return value_.end_transaction_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.changes)
+  return value_.changes_;
 }
-inline ::cockroach::proto::EndTransactionResponse* InternalResponseUnion::release_end_transaction() {
-  if (has_end_transaction()) {
+inline ::cockroach::proto::ChangesResponse* InternalResponseUnion::release_changes() {
+  if (has_changes()) {
     clear_has_value();
-    ::cockroach::proto::EndTransactionResponse* temp = value_.end_transaction_;
-    value_.end_transaction_ = NULL;
+    ::cockroach::proto::ChangesResponse* temp = value_.changes_;
+    value_.changes_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void InternalResponseUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction) {
+inline void InternalResponseUnion::set_allocated_changes(::cockroach::proto::ChangesResponse* changes) {
   clear_value();
-  if (end_transaction) {
-    set_has_end_transaction();
-    value_.end_transaction_ = end_transaction;
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.end_transaction)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.changes)
 }
 
 // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
@@ -6958,6 +8534,144 @@ inline void ReadWriteCmdResponse::set_allocated_end_transaction(::cockroach::pro
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.end_transaction)
 }
 
+// optional .cockroach.proto.ReapQueueResponse reap_queue = 7;
+inline bool ReadWriteCmdResponse::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+inline void ReadWriteCmdResponse::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+inline void ReadWriteCmdResponse::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReapQueueResponse& ReadWriteCmdResponse::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueResponse::default_instance();
+}
+inline ::cockroach::proto::ReapQueueResponse* ReadWriteCmdResponse::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.reap_queue)
+  return value_.reap_queue_;
+}
+inline ::cockroach::proto::ReapQueueResponse* ReadWriteCmdResponse::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueResponse* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_reap_queue(::cockroach::proto::ReapQueueResponse* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateResponse enqueue_update = 8;
+inline bool ReadWriteCmdResponse::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+inline void ReadWriteCmdResponse::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+inline void ReadWriteCmdResponse::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueUpdateResponse& ReadWriteCmdResponse::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateResponse::default_instance();
+}
+inline ::cockroach::proto::EnqueueUpdateResponse* ReadWriteCmdResponse::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.enqueue_update)
+  return value_.enqueue_update_;
+}
+inline ::cockroach::proto::EnqueueUpdateResponse* ReadWriteCmdResponse::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateResponse* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateResponse* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageResponse enqueue_message = 9;
+inline bool ReadWriteCmdResponse::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+inline void ReadWriteCmdResponse::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+inline void ReadWriteCmdResponse::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueMessageResponse& ReadWriteCmdResponse::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageResponse::default_instance();
+}
+inline ::cockroach::proto::EnqueueMessageResponse* ReadWriteCmdResponse::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.enqueue_message)
+  return value_.enqueue_message_;
+}
+inline ::cockroach::proto::EnqueueMessageResponse* ReadWriteCmdResponse::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageResponse* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageResponse* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.enqueue_message)
+}
+
 // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
 inline bool ReadWriteCmdResponse::has_internal_heartbeat_txn() const {
   return value_case() == kInternalHeartbeatTxn;
@@ -7326,6 +9040,52 @@ inline void ReadWriteCmdResponse::set_allocated_internal_leader_lease(::cockroac
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.internal_leader_lease)
 }
 
+// optional .cockroach.proto.InternalBatchResponse internal_batch = 18;
+inline bool ReadWriteCmdResponse::has_internal_batch() const {
+  return value_case() == kInternalBatch;
+}
+inline void ReadWriteCmdResponse::set_has_internal_batch() {
+  _oneof_case_[0] = kInternalBatch;
+}
+inline void ReadWriteCmdResponse::clear_internal_batch() {
+  if (has_internal_batch()) {
+    delete value_.internal_batch_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InternalBatchResponse& ReadWriteCmdResponse::internal_batch() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.internal_batch)
+  return has_internal_batch() ? *value_.internal_batch_
+                      : ::cockroach::proto::InternalBatchResponse::default_instance();
+}
+inline ::cockroach::proto::InternalBatchResponse* ReadWriteCmdResponse::mutable_internal_batch() {
+  if (!has_internal_batch()) {
+    clear_value();
+    set_has_internal_batch();
+    value_.internal_batch_ = new ::cockroach::proto::InternalBatchResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.internal_batch)
+  return value_.internal_batch_;
+}
+inline ::cockroach::proto::InternalBatchResponse* ReadWriteCmdResponse::release_internal_batch() {
+  if (has_internal_batch()) {
+    clear_has_value();
+    ::cockroach::proto::InternalBatchResponse* temp = value_.internal_batch_;
+    value_.internal_batch_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_internal_batch(::cockroach::proto::InternalBatchResponse* internal_batch) {
+  clear_value();
+  if (internal_batch) {
+    set_has_internal_batch();
+    value_.internal_batch_ = internal_batch;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.internal_batch)
+}
+
 inline bool ReadWriteCmdResponse::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -7707,6 +9467,236 @@ inline void InternalRaftCommandUnion::set_allocated_end_transaction(::cockroach:
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.end_transaction)
 }
 
+// optional .cockroach.proto.ReverseScanRequest reverse_scan = 10;
+inline bool InternalRaftCommandUnion::has_reverse_scan() const {
+  return value_case() == kReverseScan;
+}
+inline void InternalRaftCommandUnion::set_has_reverse_scan() {
+  _oneof_case_[0] = kReverseScan;
+}
+inline void InternalRaftCommandUnion::clear_reverse_scan() {
+  if (has_reverse_scan()) {
+    delete value_.reverse_scan_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReverseScanRequest& InternalRaftCommandUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.reverse_scan)
+  return has_reverse_scan() ? *value_.reverse_scan_
+                      : ::cockroach::proto::ReverseScanRequest::default_instance();
+}
+inline ::cockroach::proto::ReverseScanRequest* InternalRaftCommandUnion::mutable_reverse_scan() {
+  if (!has_reverse_scan()) {
+    clear_value();
+    set_has_reverse_scan();
+    value_.reverse_scan_ = new ::cockroach::proto::ReverseScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.reverse_scan)
+  return value_.reverse_scan_;
+}
+inline ::cockroach::proto::ReverseScanRequest* InternalRaftCommandUnion::release_reverse_scan() {
+  if (has_reverse_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ReverseScanRequest* temp = value_.reverse_scan_;
+    value_.reverse_scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_reverse_scan(::cockroach::proto::ReverseScanRequest* reverse_scan) {
+  clear_value();
+  if (reverse_scan) {
+    set_has_reverse_scan();
+    value_.reverse_scan_ = reverse_scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.reverse_scan)
+}
+
+// optional .cockroach.proto.ReapQueueRequest reap_queue = 11;
+inline bool InternalRaftCommandUnion::has_reap_queue() const {
+  return value_case() == kReapQueue;
+}
+inline void InternalRaftCommandUnion::set_has_reap_queue() {
+  _oneof_case_[0] = kReapQueue;
+}
+inline void InternalRaftCommandUnion::clear_reap_queue() {
+  if (has_reap_queue()) {
+    delete value_.reap_queue_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ReapQueueRequest& InternalRaftCommandUnion::reap_queue() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.reap_queue)
+  return has_reap_queue() ? *value_.reap_queue_
+                      : ::cockroach::proto::ReapQueueRequest::default_instance();
+}
+inline ::cockroach::proto::ReapQueueRequest* InternalRaftCommandUnion::mutable_reap_queue() {
+  if (!has_reap_queue()) {
+    clear_value();
+    set_has_reap_queue();
+    value_.reap_queue_ = new ::cockroach::proto::ReapQueueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.reap_queue)
+  return value_.reap_queue_;
+}
+inline ::cockroach::proto::ReapQueueRequest* InternalRaftCommandUnion::release_reap_queue() {
+  if (has_reap_queue()) {
+    clear_has_value();
+    ::cockroach::proto::ReapQueueRequest* temp = value_.reap_queue_;
+    value_.reap_queue_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_reap_queue(::cockroach::proto::ReapQueueRequest* reap_queue) {
+  clear_value();
+  if (reap_queue) {
+    set_has_reap_queue();
+    value_.reap_queue_ = reap_queue;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.reap_queue)
+}
+
+// optional .cockroach.proto.EnqueueUpdateRequest enqueue_update = 12;
+inline bool InternalRaftCommandUnion::has_enqueue_update() const {
+  return value_case() == kEnqueueUpdate;
+}
+inline void InternalRaftCommandUnion::set_has_enqueue_update() {
+  _oneof_case_[0] = kEnqueueUpdate;
+}
+inline void InternalRaftCommandUnion::clear_enqueue_update() {
+  if (has_enqueue_update()) {
+    delete value_.enqueue_update_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueUpdateRequest& InternalRaftCommandUnion::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.enqueue_update)
+  return has_enqueue_update() ? *value_.enqueue_update_
+                      : ::cockroach::proto::EnqueueUpdateRequest::default_instance();
+}
+inline ::cockroach::proto::EnqueueUpdateRequest* InternalRaftCommandUnion::mutable_enqueue_update() {
+  if (!has_enqueue_update()) {
+    clear_value();
+    set_has_enqueue_update();
+    value_.enqueue_update_ = new ::cockroach::proto::EnqueueUpdateRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.enqueue_update)
+  return value_.enqueue_update_;
+}
+inline ::cockroach::proto::EnqueueUpdateRequest* InternalRaftCommandUnion::release_enqueue_update() {
+  if (has_enqueue_update()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueUpdateRequest* temp = value_.enqueue_update_;
+    value_.enqueue_update_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_enqueue_update(::cockroach::proto::EnqueueUpdateRequest* enqueue_update) {
+  clear_value();
+  if (enqueue_update) {
+    set_has_enqueue_update();
+    value_.enqueue_update_ = enqueue_update;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.enqueue_update)
+}
+
+// optional .cockroach.proto.EnqueueMessageRequest enqueue_message = 13;
+inline bool InternalRaftCommandUnion::has_enqueue_message() const {
+  return value_case() == kEnqueueMessage;
+}
+inline void InternalRaftCommandUnion::set_has_enqueue_message() {
+  _oneof_case_[0] = kEnqueueMessage;
+}
+inline void InternalRaftCommandUnion::clear_enqueue_message() {
+  if (has_enqueue_message()) {
+    delete value_.enqueue_message_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EnqueueMessageRequest& InternalRaftCommandUnion::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.enqueue_message)
+  return has_enqueue_message() ? *value_.enqueue_message_
+                      : ::cockroach::proto::EnqueueMessageRequest::default_instance();
+}
+inline ::cockroach::proto::EnqueueMessageRequest* InternalRaftCommandUnion::mutable_enqueue_message() {
+  if (!has_enqueue_message()) {
+    clear_value();
+    set_has_enqueue_message();
+    value_.enqueue_message_ = new ::cockroach::proto::EnqueueMessageRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.enqueue_message)
+  return value_.enqueue_message_;
+}
+inline ::cockroach::proto::EnqueueMessageRequest* InternalRaftCommandUnion::release_enqueue_message() {
+  if (has_enqueue_message()) {
+    clear_has_value();
+    ::cockroach::proto::EnqueueMessageRequest* temp = value_.enqueue_message_;
+    value_.enqueue_message_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_enqueue_message(::cockroach::proto::EnqueueMessageRequest* enqueue_message) {
+  clear_value();
+  if (enqueue_message) {
+    set_has_enqueue_message();
+    value_.enqueue_message_ = enqueue_message;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.enqueue_message)
+}
+
+// optional .cockroach.proto.ChangesRequest changes = 14;
+inline bool InternalRaftCommandUnion::has_changes() const {
+  return value_case() == kChanges;
+}
+inline void InternalRaftCommandUnion::set_has_changes() {
+  _oneof_case_[0] = kChanges;
+}
+inline void InternalRaftCommandUnion::clear_changes() {
+  if (has_changes()) {
+    delete value_.changes_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ChangesRequest& InternalRaftCommandUnion::changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.changes)
+  return has_changes() ? *value_.changes_
+                      : ::cockroach::proto::ChangesRequest::default_instance();
+}
+inline ::cockroach::proto::ChangesRequest* InternalRaftCommandUnion::mutable_changes() {
+  if (!has_changes()) {
+    clear_value();
+    set_has_changes();
+    value_.changes_ = new ::cockroach::proto::ChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.changes)
+  return value_.changes_;
+}
+inline ::cockroach::proto::ChangesRequest* InternalRaftCommandUnion::release_changes() {
+  if (has_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ChangesRequest* temp = value_.changes_;
+    value_.changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_changes(::cockroach::proto::ChangesRequest* changes) {
+  clear_value();
+  if (changes) {
+    set_has_changes();
+    value_.changes_ = changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.changes)
+}
+
 // optional .cockroach.proto.BatchRequest batch = 30;
 inline bool InternalRaftCommandUnion::has_batch() const {
   return value_case() == kBatch;
@@ -8317,6 +10307,49 @@ inline void InternalRaftCommand::set_allocated_cmd(::cockroach::proto::InternalR
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommand.cmd)
 }
 
+// optional .cockroach.proto.Timestamp closed_timestamp = 4;
+inline bool InternalRaftCommand::has_closed_timestamp() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalRaftCommand::set_has_closed_timestamp() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalRaftCommand::clear_has_closed_timestamp() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalRaftCommand::clear_closed_timestamp() {
+  if (closed_timestamp_ != NULL) closed_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_closed_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& InternalRaftCommand::closed_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommand.closed_timestamp)
+  return closed_timestamp_ != NULL ? *closed_timestamp_ : *default_instance_->closed_timestamp_;
+}
+inline ::cockroach::proto::Timestamp* InternalRaftCommand::mutable_closed_timestamp() {
+  set_has_closed_timestamp();
+  if (closed_timestamp_ == NULL) {
+    closed_timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommand.closed_timestamp)
+  return closed_timestamp_;
+}
+inline ::cockroach::proto::Timestamp* InternalRaftCommand::release_closed_timestamp() {
+  clear_has_closed_timestamp();
+  ::cockroach::proto::Timestamp* temp = closed_timestamp_;
+  closed_timestamp_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommand::set_allocated_closed_timestamp(::cockroach::proto::Timestamp* closed_timestamp) {
+  delete closed_timestamp_;
+  closed_timestamp_ = closed_timestamp;
+  if (closed_timestamp) {
+    set_has_closed_timestamp();
+  } else {
+    clear_has_closed_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommand.closed_timestamp)
+}
+
 // -------------------------------------------------------------------
 
 // RaftMessageRequest
@@ -8912,6 +10945,12 @@ RaftSnapshotData::mutable_kv() {
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
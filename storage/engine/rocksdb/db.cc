@@ -139,6 +139,12 @@ const cockroach::proto::ResponseHeader* GetResponseHeader(const cockroach::proto
     return &rwResp.delete_range().header();
   } else if (rwResp.has_end_transaction()) {
     return &rwResp.end_transaction().header();
+  } else if (rwResp.has_reap_queue()) {
+    return &rwResp.reap_queue().header();
+  } else if (rwResp.has_enqueue_update()) {
+    return &rwResp.enqueue_update().header();
+  } else if (rwResp.has_enqueue_message()) {
+    return &rwResp.enqueue_message().header();
   } else if (rwResp.has_internal_heartbeat_txn()) {
     return &rwResp.internal_heartbeat_txn().header();
   } else if (rwResp.has_internal_gc()) {
@@ -151,6 +157,8 @@ const cockroach::proto::ResponseHeader* GetResponseHeader(const cockroach::proto
     return &rwResp.internal_merge().header();
   } else if (rwResp.has_internal_truncate_log()) {
     return &rwResp.internal_truncate_log().header();
+  } else if (rwResp.has_internal_batch()) {
+    return &rwResp.internal_batch().header();
   }
   return NULL;
 }
@@ -869,9 +877,7 @@ class BaseDeltaIterator : public rocksdb::Iterator {
   }
 
   void SeekToLast() override {
-    base_iterator_->SeekToLast();
-    delta_iterator_->SeekToLast();
-    UpdateCurrent();
+    SeekToLastBefore(NULL);
   }
 
   void Seek(const rocksdb::Slice& k) override {
@@ -888,7 +894,12 @@ class BaseDeltaIterator : public rocksdb::Iterator {
   }
 
   void Prev() override {
-    status_ = rocksdb::Status::NotSupported("Prev() not supported");
+    if (!Valid()) {
+      status_ = rocksdb::Status::NotSupported("Prev() on invalid iterator");
+      return;
+    }
+    const std::string target = key().ToString();
+    SeekToLastBefore(&target);
   }
 
   rocksdb::Slice key() const override {
@@ -994,6 +1005,62 @@ class BaseDeltaIterator : public rocksdb::Iterator {
   void AdvanceBase() {
     base_iterator_->Next();
   }
+  // Positions the base or delta iterator at its last entry before
+  // target, or at its last entry if target is NULL. Returns false if
+  // there is no such entry.
+  template <typename Iter>
+  static bool SeekBefore(Iter* iter, const std::string* target) {
+    if (target == NULL) {
+      iter->SeekToLast();
+      return iter->Valid();
+    }
+    iter->Seek(*target);
+    if (iter->Valid()) {
+      iter->Prev();
+    } else {
+      iter->SeekToLast();
+    }
+    return iter->Valid();
+  }
+  // Positions the iterator at the last key before target, or at the
+  // last key if target is NULL. The largest key before target in
+  // either the base or the delta iterator is a candidate; seeking to
+  // it merges the batch's records for the key as forward iteration
+  // does. If the candidate turns out to be deleted by the batch, the
+  // search continues before it.
+  void SeekToLastBefore(const std::string* target) {
+    std::string bound;
+    if (target != NULL) {
+      bound = *target;
+    }
+    const std::string* limit = target;
+    for (;;) {
+      std::string candidate;
+      bool found = false;
+      if (SeekBefore(base_iterator_.get(), limit)) {
+        candidate = base_iterator_->key().ToString();
+        found = true;
+      }
+      if (SeekBefore(delta_iterator_.get(), limit)) {
+        const rocksdb::Slice delta_key = delta_iterator_->Entry().key;
+        if (!found || comparator_->Compare(delta_key, candidate) > 0) {
+          candidate = delta_key.ToString();
+        }
+        found = true;
+      }
+      if (!found) {
+        // Both iterators are exhausted.
+        UpdateCurrent();
+        return;
+      }
+      Seek(candidate);
+      if (Valid() && key() == rocksdb::Slice(candidate)) {
+        return;
+      }
+      bound = candidate;
+      limit = &bound;
+    }
+  }
   bool BaseValid() const { return base_iterator_->Valid(); }
   bool DeltaValid() const { return delta_iterator_->Valid(); }
   void UpdateCurrent() {
@@ -1226,6 +1293,10 @@ void DBIterNext(DBIterator* iter) {
   iter->rep->Next();
 }
 
+void DBIterPrev(DBIterator* iter) {
+  iter->rep->Prev();
+}
+
 DBSlice DBIterKey(DBIterator* iter) {
   return ToDBSlice(iter->rep->key());
 }
@@ -46,6 +46,9 @@ const (
 	// intentAgeThreshold is the threshold after which an extant intent
 	// will be resolved.
 	intentAgeThreshold = 2 * time.Hour // 2 hour
+	// expirationPriority is the score added to total range priority
+	// when the range contains values eligible for GC by expiration.
+	expirationPriority = 1
 )
 
 // gcQueue manages a queue of ranges slated to be scanned in their
@@ -57,7 +60,9 @@ const (
 //  - Resolve extant write intents and determine oldest non-resolvable
 //    intent.
 //
-// The shouldQueue function combines the need for both tasks into a
+//  - GC of values which have passed their expiration.
+//
+// The shouldQueue function combines the need for all tasks into a
 // single priority. If any task is overdue, shouldQueue returns true.
 type gcQueue struct {
 	*baseQueue
@@ -100,6 +105,24 @@ func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool,
 	if intentScore > 1 {
 		priority += intentScore
 	}
+	// Expired values become eligible for GC once no read within the
+	// policy's TTL can observe them.
+	gcMeta, err := rng.GetGCMetadata()
+	if err != nil {
+		log.Errorf("GC metadata: %s", err)
+		return
+	}
+	next, _, err := loadNextExpiration(rng.rm.Engine(), rng.Desc().RaftID)
+	if err != nil {
+		log.Errorf("next expiration: %s", err)
+		return
+	}
+	if n := gcMeta.NextExpirationNanos; n != nil && (next == 0 || *n < next) {
+		next = *n
+	}
+	if next != 0 && next+int64(policy.TTLSeconds)*1E9 < now.WallTime {
+		priority += expirationPriority
+	}
 	shouldQ = priority > 0
 	return
 }
@@ -133,12 +156,31 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 			RaftID:    rng.Desc().RaftID,
 		},
 	}
+	// The scan sees the values whose expirations have been noted so far.
+	// The GC clears the noted expiration unless another has been noted
+	// since the snapshot was taken.
+	noted, seq, err := loadNextExpiration(snap, rng.Desc().RaftID)
+	if err != nil {
+		return err
+	}
+	if noted != 0 {
+		gcArgs.NextExpirationSeq = seq
+	}
 	var mu sync.Mutex
 	var oldestIntentNanos int64 = math.MaxInt64
 	var wg sync.WaitGroup
 	var expBaseKey proto.Key
 	var keys []proto.EncodedKey
 	var vals [][]byte
+	var nextExpiration *int64
+
+	// updateNextExpiration lowers the time at which the next expiring
+	// value will become eligible for GC.
+	updateNextExpiration := func(nanos int64) {
+		if nextExpiration == nil || nanos < *nextExpiration {
+			nextExpiration = gogoproto.Int64(nanos)
+		}
+	}
 
 	// updateOldestIntent atomically updates the oldest intent.
 	updateOldestIntent := func(intentNanos int64) {
@@ -176,12 +218,31 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 					startIdx = 2
 				}
 				// See if any values may be GC'd.
-				if gcTS := gc.Filter(keys[startIdx:], vals[startIdx:]); !gcTS.Equal(proto.ZeroTimestamp) {
+				gcTS := gc.Filter(keys[startIdx:], vals[startIdx:])
+				if !gcTS.Equal(proto.ZeroTimestamp) {
 					// TODO(spencer): need to split the requests up into
 					// multiple requests in the event that more than X keys
 					// are added to the request.
 					gcArgs.Keys = append(gcArgs.Keys, proto.InternalGCRequest_GCKey{Key: expBaseKey, Timestamp: gcTS})
 				}
+				// If the latest value expires and survives this GC, note
+				// when it will become eligible. An expired value which
+				// survives does so only until the next version below it
+				// falls outside the TTL.
+				if startIdx < len(keys) {
+					_, latestTS, _ := engine.MVCCDecodeKey(keys[startIdx])
+					latest := engine.MVCCValue{}
+					if err := gogoproto.Unmarshal(vals[startIdx], &latest); err != nil {
+						log.Errorf("unable to unmarshal MVCC value for key %q: %s", keys[startIdx], err)
+					} else if latest.Value != nil && latest.Value.Expiration != nil && gcTS.Less(latestTS) {
+						if !gc.IsExpired(latest.Value) {
+							updateNextExpiration(latest.Value.Expiration.WallTime)
+						} else if startIdx+1 < len(keys) {
+							_, ts, _ := engine.MVCCDecodeKey(keys[startIdx+1])
+							updateNextExpiration(ts.WallTime)
+						}
+					}
+				}
 			}
 		}
 	}
@@ -210,10 +271,19 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	// Handle last collected set of keys/vals.
 	processKeysAndValues()
 
-	// Set start and end keys.
+	// Set start and end keys. Even if there's nothing to GC, the GC
+	// metadata is updated if it may hold a stale expiration.
 	switch len(gcArgs.Keys) {
 	case 0:
-		return nil
+		prevMeta, err := rng.GetGCMetadata()
+		if err != nil {
+			return err
+		}
+		if prevMeta.NextExpirationNanos == nil && nextExpiration == nil && gcArgs.NextExpirationSeq == 0 {
+			return nil
+		}
+		gcArgs.Key = rng.Desc().StartKey
+		gcArgs.EndKey = gcArgs.Key.Next()
 	case 1:
 		gcArgs.Key = gcArgs.Keys[0].Key
 		gcArgs.EndKey = gcArgs.Key.Next()
@@ -225,6 +295,7 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	// Wait for any outstanding intent resolves and set oldest extant intent.
 	wg.Wait()
 	gcMeta.OldestIntentNanos = gogoproto.Int64(oldestIntentNanos)
+	gcMeta.NextExpirationNanos = nextExpiration
 
 	// Send GC request through range.
 	gcArgs.GCMeta = *gcMeta
@@ -19,6 +19,7 @@ package storage
 
 import (
 	"math"
+	"reflect"
 	"testing"
 	"time"
 
@@ -289,6 +290,102 @@ func TestGCQueueProcess(t *testing.T) {
 	}
 }
 
+// TestGCQueueExpiration verifies that expiring values cause the range
+// to be queued for GC and are removed once they've expired for longer
+// than the GC TTL.
+func TestGCQueueExpiration(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+
+	ts1 := makeTS(now-47*60*60*1E9, 0) // 47h old
+	ts2 := makeTS(now-20*60*60*1E9, 0) // 20h old
+	exp1 := makeTS(now-46*60*60*1E9, 0)
+	exp2 := makeTS(now-10*60*60*1E9, 0)
+
+	data := []struct {
+		key proto.Key
+		ts  proto.Timestamp
+		exp *proto.Timestamp
+	}{
+		// Expired more than the GC TTL ago; expect all values to GC.
+		{proto.Key("a"), ts1, &exp1},
+		// Expired, but less than the GC TTL ago; expect it to remain.
+		{proto.Key("b"), ts2, &exp2},
+		// Never expires.
+		{proto.Key("c"), ts1, nil},
+	}
+	for i, datum := range data {
+		pArgs := putArgs(datum.key, []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		pArgs.Timestamp = datum.ts
+		pArgs.Value.Expiration = datum.exp
+		if _, err := tc.rng.AddCmd(tc.rng.context(), &pArgs); err != nil {
+			t.Fatalf("%d: could not put data: %s", i, err)
+		}
+	}
+
+	// The writes persist the earliest expiration alongside the values,
+	// leaving the GC metadata alone.
+	if gcMeta, err := tc.rng.GetGCMetadata(); err != nil {
+		t.Fatal(err)
+	} else if next := gcMeta.NextExpirationNanos; next != nil {
+		t.Errorf("expected no next expiration in the GC metadata; got %d", *next)
+	}
+	if noted, _, err := loadNextExpiration(tc.store.Engine(), tc.rng.Desc().RaftID); err != nil {
+		t.Fatal(err)
+	} else if noted != exp1.WallTime {
+		t.Errorf("expected noted next expiration nanos=%d; got %d", exp1.WallTime, noted)
+	}
+
+	gcQ := newGCQueue()
+	if shouldQ, _ := gcQ.shouldQueue(tc.clock.Now(), tc.rng); !shouldQ {
+		t.Fatal("expected range with expired values to be queued")
+	}
+	if err := gcQ.process(tc.clock.Now(), tc.rng); err != nil {
+		t.Fatal(err)
+	}
+
+	kvs, err := engine.Scan(tc.store.Engine(), engine.MVCCEncodeKey(proto.Key("a")), engine.MVCCEncodeKey(proto.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var remaining []proto.Key
+	for _, kv := range kvs {
+		if key, _, isValue := engine.MVCCDecodeKey(kv.Key); !isValue {
+			remaining = append(remaining, key)
+		}
+	}
+	if expKeys := []proto.Key{proto.Key("b"), proto.Key("c")}; !reflect.DeepEqual(remaining, expKeys) {
+		t.Errorf("expected keys %q; got %q", expKeys, remaining)
+	}
+
+	// The next expiration is the one which remains; the range needn't
+	// be queued again until it has expired for longer than the GC TTL.
+	gcMeta, err := tc.rng.GetGCMetadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next := gcMeta.NextExpirationNanos; next == nil || *next != exp2.WallTime {
+		t.Errorf("expected next expiration nanos=%d; got %v", exp2.WallTime, next)
+	}
+	if noted, _, err := loadNextExpiration(tc.store.Engine(), tc.rng.Desc().RaftID); err != nil {
+		t.Fatal(err)
+	} else if noted != 0 {
+		t.Errorf("expected noted next expiration to be cleared; got %d", noted)
+	}
+	if shouldQ, _ := gcQ.shouldQueue(tc.clock.Now(), tc.rng); shouldQ {
+		t.Error("expected range not to be queued")
+	}
+	tc.manualClock.Set(exp2.WallTime + 25*60*60*1E9)
+	if shouldQ, _ := gcQ.shouldQueue(tc.clock.Now(), tc.rng); !shouldQ {
+		t.Error("expected range to be queued once remaining value is eligible for GC")
+	}
+}
+
 // TestGCQueueLookupGCPolicy verifies the hierarchical lookup of GC
 // policy in the event that the longest matching key prefix does not
 // have a zone configured.
@@ -38,6 +38,7 @@ import (
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/cockroachdb/cockroach/util/stop"
@@ -126,10 +127,15 @@ var tsCacheMethods = [...]bool{
 	proto.ConditionalPut:             true,
 	proto.Increment:                  true,
 	proto.Scan:                       true,
+	proto.ReverseScan:                true,
+	proto.Changes:                    true,
 	proto.Delete:                     true,
 	proto.DeleteRange:                true,
+	proto.ReapQueue:                  true,
+	proto.EnqueueMessage:             true,
 	proto.InternalResolveIntent:      true,
 	proto.InternalResolveIntentRange: true,
+	proto.InternalBatch:              true,
 }
 
 // usesTimestampCache returns true if the request affects or is
@@ -142,6 +148,24 @@ func usesTimestampCache(r proto.Request) bool {
 	return tsCacheMethods[m]
 }
 
+// tsCacheRequests returns the requests whose keys are checked against
+// and recorded in the timestamp cache on behalf of args. A batch is
+// represented by those of its constituent requests which use the
+// timestamp cache.
+func tsCacheRequests(args proto.Request) []proto.Request {
+	bArgs, ok := args.(*proto.InternalBatchRequest)
+	if !ok {
+		return []proto.Request{args}
+	}
+	var reqs []proto.Request
+	for i := range bArgs.Requests {
+		if req := bArgs.Requests[i].GetValue().(proto.Request); usesTimestampCache(req) {
+			reqs = append(reqs, req)
+		}
+	}
+	return reqs
+}
+
 // A pendingCmd holds a done channel for a command sent to Raft. Once
 // committed to the Raft log, the command is executed and the result returned
 // via the done channel.
@@ -166,6 +190,8 @@ type rangeManager interface {
 	Stopper() *stop.Stopper
 	EventFeed() StoreEventFeed
 	Context(context.Context) context.Context
+	closedTimestampLag() time.Duration
+	txnWaitTimeout() time.Duration
 	resolveWriteIntentError(context.Context, *proto.WriteIntentError, *Range, proto.Request, proto.PushTxnType) error
 
 	// Range manipulation methods.
@@ -202,21 +228,31 @@ type Range struct {
 	lease        unsafe.Pointer // Information for leader lease, updated atomically
 	llMu         sync.Mutex     // Synchronizes readers' requests for leader lease
 	respCache    *ResponseCache // Provides idempotence for retries
+	changesMu    sync.Mutex     // Protects changes
+	changes      *changeBuffer  // Recent changes; nil until first requested
+	txnWaitQueue *txnWaitQueue  // Pushes waiting for txns with records in the range
 
 	sync.RWMutex                 // Protects the following fields:
 	cmdQ         *CommandQueue   // Enforce at most one command is running per key(s)
 	tsCache      *TimestampCache // Most recent timestamps for keys / key ranges
 	pendingCmds  map[cmdIDKey]*pendingCmd
+	// Timestamps of writes which have passed the timestamp cache but
+	// not yet completed, keyed by command queue key.
+	inflightWrites map[interface{}]proto.Timestamp
+	// Timestamp at or below which no further writes will be applied.
+	closedTS proto.Timestamp
 }
 
 // NewRange initializes the range using the given metadata.
 func NewRange(desc *proto.RangeDescriptor, rm rangeManager) (*Range, error) {
 	r := &Range{
-		rm:          rm,
-		cmdQ:        NewCommandQueue(),
-		tsCache:     NewTimestampCache(rm.Clock()),
-		respCache:   NewResponseCache(desc.RaftID),
-		pendingCmds: map[cmdIDKey]*pendingCmd{},
+		rm:             rm,
+		cmdQ:           NewCommandQueue(),
+		tsCache:        NewTimestampCache(rm.Clock()),
+		respCache:      NewResponseCache(desc.RaftID),
+		pendingCmds:    map[cmdIDKey]*pendingCmd{},
+		inflightWrites: map[interface{}]proto.Timestamp{},
+		txnWaitQueue:   newTxnWaitQueue(),
 	}
 	r.setDescWithoutProcessUpdate(desc)
 
@@ -400,6 +436,30 @@ func (r *Range) redirectOnOrAcquireLeaderLease(trace *tracer.Trace, timestamp pr
 	return err
 }
 
+// heartbeatLeaderLease extends the leader lease if this replica holds
+// it and the range's closed timestamp has fallen more than slack
+// behind the store's closed timestamp lag. Closed timestamps
+// are carried by Raft commands, so without this an idle range would
+// never close a timestamp; the lease extension carries one instead.
+func (r *Range) heartbeatLeaderLease(slack time.Duration) error {
+	r.llMu.Lock()
+	defer r.llMu.Unlock()
+
+	now := r.rm.Clock().Now()
+	if lease := r.getLease(); !lease.OwnedBy(r.rm.RaftNodeID()) || !lease.Covers(now) {
+		return nil
+	}
+	threshold := now
+	threshold.WallTime -= (r.rm.closedTimestampLag() + slack).Nanoseconds()
+	r.RLock()
+	closed := r.closedTS
+	r.RUnlock()
+	if threshold.Less(closed) {
+		return nil
+	}
+	return r.requestLeaderLease(now)
+}
+
 // WaitForLeaderLease is used from unittests to wait until this range
 // has the leader lease.
 func (r *Range) WaitForLeaderLease(t util.Tester) {
@@ -426,6 +486,7 @@ func (r *Range) Desc() *proto.RangeDescriptor {
 // descriptor update.
 func (r *Range) setDesc(desc *proto.RangeDescriptor) error {
 	r.setDescWithoutProcessUpdate(desc)
+	r.resetChanges()
 	if r.rm == nil {
 		// r.rm is null in some tests.
 		return nil
@@ -473,6 +534,82 @@ func (r *Range) GetGCMetadata() (*proto.GCMetadata, error) {
 	return gcMeta, nil
 }
 
+// noteExpirations records the earliest expiration of the values written
+// by a command in the batch which applies it, so that the GC queue will
+// remove the values once they have expired. Each note advances the
+// sequence number stored alongside the expiration; InternalGC clears the
+// expiration only if no note was made after the GC queue's scan.
+func (r *Range) noteExpirations(batch engine.Engine, args proto.Request) error {
+	nanos := minExpiration(args)
+	if nanos == 0 {
+		return nil
+	}
+	raftID := r.Desc().RaftID
+	noted, seq, err := loadNextExpiration(batch, raftID)
+	if err != nil {
+		return err
+	}
+	if noted != 0 && noted < nanos {
+		nanos = noted
+	}
+	return setNextExpiration(batch, raftID, nanos, seq+1)
+}
+
+// minExpiration returns the earliest expiration, in nanoseconds, of the
+// values written by the command, or zero if none of them expire.
+func minExpiration(args proto.Request) int64 {
+	var value *proto.Value
+	switch t := args.(type) {
+	case *proto.PutRequest:
+		value = &t.Value
+	case *proto.ConditionalPutRequest:
+		value = &t.Value
+	case *proto.InternalBatchRequest:
+		var min int64
+		for i := range t.Requests {
+			nanos := minExpiration(t.Requests[i].GetValue().(proto.Request))
+			if nanos != 0 && (min == 0 || nanos < min) {
+				min = nanos
+			}
+		}
+		return min
+	default:
+		return 0
+	}
+	if value.Expiration == nil {
+		return 0
+	}
+	return value.Expiration.WallTime
+}
+
+// loadNextExpiration reads the earliest expiration, in nanoseconds, noted
+// for the range since the GC queue last cleared it, along with the
+// sequence number of the latest note. The expiration is zero if none
+// is noted.
+func loadNextExpiration(eng engine.Engine, raftID proto.RaftID) (int64, uint64, error) {
+	v, _, err := engine.MVCCGet(eng, keys.RangeNextExpirationKey(raftID),
+		proto.ZeroTimestamp, true, nil)
+	if err != nil || v == nil {
+		return 0, 0, err
+	}
+	if len(v.Bytes) != 16 {
+		return 0, 0, util.Errorf("malformed next expiration for range %d: %q", raftID, v.Bytes)
+	}
+	b, nanos := encoding.DecodeUint64(v.Bytes)
+	_, seq := encoding.DecodeUint64(b)
+	return int64(nanos), seq, nil
+}
+
+// setNextExpiration persists the earliest noted expiration along with
+// the sequence number of the note.
+func setNextExpiration(eng engine.Engine, raftID proto.RaftID, nanos int64, seq uint64) error {
+	return engine.MVCCPut(eng, nil, /* stats */
+		keys.RangeNextExpirationKey(raftID),
+		proto.ZeroTimestamp,
+		proto.Value{Bytes: encoding.EncodeUint64(encoding.EncodeUint64(nil, uint64(nanos)), seq)},
+		nil /* txn */)
+}
+
 // GetLastVerificationTimestamp reads the timestamp at which the range's
 // data was last verified.
 func (r *Range) GetLastVerificationTimestamp() (proto.Timestamp, error) {
@@ -513,6 +650,9 @@ func (r *Range) AddCmd(ctx context.Context, args proto.Request) (proto.Response,
 	} else if proto.IsWrite(args) {
 		defer trace.Epoch("read-write path")()
 		reply, err = r.addWriteCmd(ctx, args, nil)
+		if pushArgs, ok := args.(*proto.InternalPushTxnRequest); ok {
+			reply, err = r.maybeWaitForPush(ctx, pushArgs, reply, err)
+		}
 	} else {
 		panic(fmt.Sprintf("don't know how to handle command %T", args))
 	}
@@ -555,9 +695,12 @@ func (r *Range) endCmd(cmdKey interface{}, args proto.Request, err error, readOn
 	r.Lock()
 	if err == nil && usesTimestampCache(args) {
 		header := args.Header()
-		r.tsCache.Add(header.Key, header.EndKey, header.Timestamp, header.Txn.GetID(), readOnly)
+		for _, req := range tsCacheRequests(args) {
+			r.tsCache.Add(req.Header().Key, req.Header().EndKey, header.Timestamp, header.Txn.GetID(), readOnly)
+		}
 	}
 	r.cmdQ.Remove(cmdKey)
+	delete(r.inflightWrites, cmdKey)
 	r.Unlock()
 }
 
@@ -599,6 +742,12 @@ func (r *Range) addReadOnlyCmd(ctx context.Context, args proto.Request) (proto.R
 		return nil, err
 	}
 
+	if header.MaxStaleness < 0 {
+		return nil, util.Errorf("max staleness %d is negative", header.MaxStaleness)
+	} else if header.MaxStaleness != 0 && header.ReadConsistency != proto.INCONSISTENT {
+		return nil, util.Error("max staleness requires inconsistent reads")
+	}
+
 	// If read-consistency is set to INCONSISTENT, run directly.
 	if header.ReadConsistency == proto.INCONSISTENT {
 		// But disallow any inconsistent reads within txns.
@@ -607,12 +756,18 @@ func (r *Range) addReadOnlyCmd(ctx context.Context, args proto.Request) (proto.R
 		}
 		if header.Timestamp.Equal(proto.ZeroTimestamp) {
 			header.Timestamp = r.rm.Clock().Now()
+			header.Timestamp.WallTime -= header.MaxStaleness
 		}
-		reply, intents, err := r.executeCmd(r.rm.Engine(), nil, args)
-		if err == nil {
-			r.handleSkippedIntents(args, intents)
+		// A read with bounded staleness must see all writes at or below
+		// its timestamp. Unless this replica has closed that timestamp,
+		// the read is served by the leader like a consistent read.
+		if header.MaxStaleness == 0 || r.canServeFollowerRead(args) {
+			reply, intents, err := r.executeCmd(r.rm.Engine(), nil, args)
+			if err == nil {
+				r.handleSkippedIntents(args, intents)
+			}
+			return reply, err
 		}
-		return reply, err
 	} else if header.ReadConsistency == proto.CONSENSUS {
 		return nil, util.Error("consensus reads not implemented")
 	}
@@ -621,10 +776,13 @@ func (r *Range) addReadOnlyCmd(ctx context.Context, args proto.Request) (proto.R
 	// overlapping commands until this command completes.
 	cmdKey := r.beginCmd(header, true)
 
-	// This replica must have leader lease to process a consistent read.
-	if err := r.redirectOnOrAcquireLeaderLease(tracer.FromCtx(ctx), header.Timestamp); err != nil {
-		r.endCmd(cmdKey, args, err, true /* readOnly */)
-		return nil, err
+	// This replica must have leader lease to process a consistent read,
+	// unless the read is at a timestamp which has been closed.
+	if !r.canServeFollowerRead(args) {
+		if err := r.redirectOnOrAcquireLeaderLease(tracer.FromCtx(ctx), header.Timestamp); err != nil {
+			r.endCmd(cmdKey, args, err, true /* readOnly */)
+			return nil, err
+		}
 	}
 
 	// Execute read-only command.
@@ -690,10 +848,14 @@ func (r *Range) addWriteCmd(ctx context.Context, args proto.Request, wg *sync.Wa
 	// writes, send WriteTooOldError; for reads, update the write's
 	// timestamp. When the write returns, the updated timestamp will
 	// inform the final commit timestamp.
+	r.Lock()
 	if usesTimestampCache(args) {
-		r.Lock()
-		rTS, wTS := r.tsCache.GetMax(header.Key, header.EndKey, header.Txn.GetID())
-		r.Unlock()
+		var rTS, wTS proto.Timestamp
+		for _, req := range tsCacheRequests(args) {
+			reqRTS, reqWTS := r.tsCache.GetMax(req.Header().Key, req.Header().EndKey, header.Txn.GetID())
+			rTS.Forward(reqRTS)
+			wTS.Forward(reqWTS)
+		}
 
 		// Always push the timestamp forward if there's been a read which
 		// occurred after our txn timestamp.
@@ -712,6 +874,13 @@ func (r *Range) addWriteCmd(ctx context.Context, args proto.Request, wg *sync.Wa
 			}
 		}
 	}
+	// Until the write completes, no timestamp at or above its own may
+	// be closed. This applies to all writes, including those which
+	// don't consult the timestamp cache (e.g. EndTransaction and intent
+	// resolution), and is done while still holding the lock to avoid
+	// racing with the closing of a timestamp.
+	r.inflightWrites[cmdKey] = header.Timestamp
+	r.Unlock()
 
 	defer trace.Epoch("raft")()
 
@@ -756,12 +925,61 @@ func (r *Range) proposeRaftCommand(ctx context.Context, args proto.Request) (<-c
 	idKey := makeCmdIDKey(cmdID)
 	r.Lock()
 	r.pendingCmds[idKey] = pendingCmd
+	// Lease requests only close a timestamp when extending the lease
+	// held by this replica; see heartbeatLeaderLease.
+	if args.Method() != proto.InternalLeaderLease || r.getLease().OwnedBy(r.rm.RaftNodeID()) {
+		raftCmd.ClosedTimestamp = r.closeTimestampLocked()
+	}
 	r.Unlock()
 	errChan := r.rm.ProposeRaftCommand(idKey, raftCmd)
 
 	return errChan, pendingCmd
 }
 
+// closeTimestampLocked returns a timestamp at or below which this
+// replica will propose no further writes and raises the low water mark
+// of the timestamp cache accordingly. The timestamp lags the present
+// by the store's closed timestamp lag and precedes all writes which
+// have passed the timestamp cache but not yet completed. Returns the
+// zero timestamp if the store doesn't close timestamps. The range
+// lock must be held.
+func (r *Range) closeTimestampLocked() proto.Timestamp {
+	lag := r.rm.closedTimestampLag()
+	if lag <= 0 {
+		return proto.ZeroTimestamp
+	}
+	closed := r.rm.Clock().Now()
+	closed.WallTime -= lag.Nanoseconds()
+	for _, ts := range r.inflightWrites {
+		if !closed.Less(ts) {
+			closed = ts.Prev()
+		}
+	}
+	r.tsCache.SetLowWater(closed)
+	return closed
+}
+
+// canServeFollowerRead returns whether the read may be served without
+// the leader lease. This is the case for reads of data at timestamps
+// which have been closed, as all writes at or below them have been
+// applied. For transactional reads, the uncertainty interval must be
+// closed as well.
+func (r *Range) canServeFollowerRead(args proto.Request) bool {
+	switch args.(type) {
+	case *proto.GetRequest, *proto.ScanRequest, *proto.ReverseScanRequest:
+	default:
+		return false
+	}
+	header := args.Header()
+	timestamp := header.Timestamp
+	if header.Txn != nil {
+		timestamp.Forward(header.Txn.MaxTimestamp)
+	}
+	r.RLock()
+	defer r.RUnlock()
+	return !r.closedTS.Less(timestamp)
+}
+
 // processRaftCommand processes a raft command by unpacking the command
 // struct to get args and reply and then applying the command to the
 // state machine via applyRaftCommand(). The error result is sent on
@@ -787,14 +1005,26 @@ func (r *Range) processRaftCommand(idKey cmdIDKey, index uint64, raftCmd proto.I
 		ctx = r.context()
 	}
 
+	// The closed timestamp is only valid if the command was proposed
+	// under the leader lease; see applyRaftCommandInBatch.
+	originNode := proto.RaftNodeID(raftCmd.OriginNodeID)
+	lease := r.getLease()
+	closed := lease.OwnedBy(originNode) && lease.Covers(args.Header().Timestamp)
+
 	execDone := tracer.FromCtx(ctx).Epoch(fmt.Sprintf("applying %s", args.Method()))
 	// applyRaftCommand will return "expected" errors, but may also indicate
 	// replica corruption (as of now, signaled by a replicaCorruptionError).
 	// We feed its return through maybeSetCorrupt to act when that happens.
-	reply, err := r.applyRaftCommand(ctx, index, proto.RaftNodeID(raftCmd.OriginNodeID), args)
+	reply, err := r.applyRaftCommand(ctx, index, originNode, args)
 	err = r.maybeSetCorrupt(err)
 	execDone()
 
+	if closed {
+		r.Lock()
+		r.closedTS.Forward(raftCmd.ClosedTimestamp)
+		r.Unlock()
+	}
+
 	if cmd != nil {
 		cmd.done <- proto.ResponseWithError{reply, err}
 	} else if err != nil && log.V(1) {
@@ -825,6 +1055,13 @@ func (r *Range) applyRaftCommand(ctx context.Context, index uint64, originNode p
 	batch, reply, rErr := r.applyRaftCommandInBatch(ctx, index, originNode, args, &ms)
 	defer batch.Close()
 
+	// Note when the values written expire.
+	if rErr == nil && proto.IsWrite(args) {
+		if err := r.noteExpirations(batch, args); err != nil {
+			log.Fatalc(ctx, "noting expirations in a batch should never fail: %s", err)
+		}
+	}
+
 	// Advance the last applied index and commit the batch.
 	if err := setAppliedIndex(batch, r.Desc().RaftID, index); err != nil {
 		log.Fatalc(ctx, "setting applied index in a batch should never fail: %s", err)
@@ -841,6 +1078,10 @@ func (r *Range) applyRaftCommand(ctx context.Context, index uint64, originNode p
 	if rErr == nil && proto.IsWrite(args) {
 		// Publish update to event feed.
 		r.rm.EventFeed().updateRange(r, args.Method(), &ms)
+		// Record committed changes for Changes requests.
+		r.recordChanges(args, reply)
+		// Wake up pushes waiting for a transaction whose record changed.
+		r.txnWaitQueue.maybeUpdateTxn(reply)
 		// If the commit succeeded, potentially add range to split queue.
 		r.maybeAddToSplitQueue()
 		// Maybe update gossip configs if the command is not part of a transaction.
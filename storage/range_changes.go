@@ -0,0 +1,268 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// maxBufferedChanges is the maximum number of changes buffered in
+// memory per range for serving Changes requests. Requests for changes
+// older than those buffered are served by scanning the range's data.
+const maxBufferedChanges = 10000
+
+// A changeBuffer records the changes committed to a range and the
+// write intents pending on it as commands are applied. This allows
+// Changes requests to be served without scanning the range's data.
+type changeBuffer struct {
+	// lowWater is the timestamp after which the buffer holds every
+	// change. It advances as changes are evicted from the buffer.
+	lowWater proto.Timestamp
+	changes  []proto.ChangeEvent
+	// intents maps the keys with unresolved write intents to the
+	// intents' timestamps.
+	intents map[string]proto.Timestamp
+}
+
+// newChangeBuffer returns a change buffer holding changes committed
+// after lowWater and the given unresolved intents.
+func newChangeBuffer(lowWater proto.Timestamp, intents []proto.Intent) *changeBuffer {
+	cb := &changeBuffer{
+		lowWater: lowWater,
+		intents:  map[string]proto.Timestamp{},
+	}
+	for _, intent := range intents {
+		cb.intents[string(intent.Key)] = intent.Txn.Timestamp
+	}
+	return cb
+}
+
+// update records the changes committed to keys in [key, endKey) at
+// the commit timestamp, if not nil, and refreshes the intents pending
+// on the span. The committed changes and intents are read from eng,
+// which must reflect the command which committed them.
+func (cb *changeBuffer) update(eng engine.Engine, key, endKey proto.Key, commit *proto.Timestamp) error {
+	var since, until proto.Timestamp
+	if commit != nil {
+		since, until = commit.Prev(), *commit
+	}
+	changes, intents, err := engine.MVCCChanges(eng, key, endKey, since, until)
+	if err != nil {
+		return err
+	}
+	for k := range cb.intents {
+		if !proto.Key(k).Less(key) && proto.Key(k).Less(endKey) {
+			delete(cb.intents, k)
+		}
+	}
+	for _, intent := range intents {
+		cb.intents[string(intent.Key)] = intent.Txn.Timestamp
+	}
+	cb.changes = append(cb.changes, changes...)
+	if len(cb.changes) > maxBufferedChanges {
+		// Evict the older half of the buffer.
+		n := len(cb.changes) / 2
+		for _, change := range cb.changes[:n] {
+			cb.lowWater.Forward(change.Timestamp)
+		}
+		cb.changes = append([]proto.ChangeEvent(nil), cb.changes[n:]...)
+	}
+	return nil
+}
+
+// resolved returns the timestamp at or below which no further changes
+// will be committed to [key, endKey), given that no writes will be
+// committed at or below timestamp outside of the pending intents.
+func (cb *changeBuffer) resolved(key, endKey proto.Key, timestamp proto.Timestamp) proto.Timestamp {
+	resolved := timestamp
+	for k, ts := range cb.intents {
+		if !proto.Key(k).Less(key) && proto.Key(k).Less(endKey) && !resolved.Less(ts) {
+			resolved = ts.Prev()
+		}
+	}
+	return resolved
+}
+
+// Changes returns the changes committed to keys in the span of args
+// with timestamps in (args.Since, resolved], where resolved is the
+// timestamp at or below which no further changes will be committed to
+// the span. Changes is a read at args.Timestamp, so subsequent writes
+// to the span are committed at later timestamps; the resolved
+// timestamp is lowered below any unresolved write intents.
+func (r *Range) Changes(batch engine.Engine, args proto.ChangesRequest) (proto.ChangesResponse, error) {
+	var reply proto.ChangesResponse
+
+	if args.Txn != nil {
+		return reply, util.Errorf("cannot fetch changes as part of a transaction")
+	}
+	r.changesMu.Lock()
+	cb, err := r.getChangeBufferLocked(batch, args.Timestamp)
+	if err != nil {
+		r.changesMu.Unlock()
+		return reply, err
+	}
+	reply.Resolved = cb.resolved(args.Key, args.EndKey, args.Timestamp)
+	reply.Resolved.Forward(args.Since)
+	scan := args.Since.Less(cb.lowWater)
+	if !scan {
+		for _, change := range cb.changes {
+			if !change.Key.Less(args.Key) && change.Key.Less(args.EndKey) &&
+				args.Since.Less(change.Timestamp) && !reply.Resolved.Less(change.Timestamp) {
+				reply.Events = append(reply.Events, change)
+			}
+		}
+	}
+	r.changesMu.Unlock()
+
+	if scan {
+		// The buffer does not go back far enough; scan the span instead.
+		if reply.Events, _, err = engine.MVCCChanges(batch, args.Key, args.EndKey, args.Since, reply.Resolved); err != nil {
+			return reply, err
+		}
+	}
+	sort.Sort(proto.ChangeEvents(reply.Events))
+	return reply, nil
+}
+
+// getChangeBufferLocked returns the range's change buffer, creating it
+// if necessary. A new buffer is seeded with the range's unresolved
+// write intents and holds the changes committed after the given
+// timestamp plus the maximum clock offset, beyond which no changes
+// have been committed yet. r.changesMu must be held.
+func (r *Range) getChangeBufferLocked(eng engine.Engine, timestamp proto.Timestamp) (*changeBuffer, error) {
+	if r.changes != nil {
+		return r.changes, nil
+	}
+	start, end := r.changesSpan()
+	_, intents, err := engine.MVCCChanges(eng, start, end, proto.ZeroTimestamp, proto.ZeroTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	r.changes = newChangeBuffer(timestamp.Add(r.rm.Clock().MaxOffset().Nanoseconds(), 0), intents)
+	return r.changes, nil
+}
+
+// changesSpan returns the span of keys for which the range tracks
+// changes. Local keys are excluded.
+func (r *Range) changesSpan() (proto.Key, proto.Key) {
+	desc := r.Desc()
+	start := desc.StartKey
+	if start.Less(keys.LocalMax) {
+		start = keys.LocalMax
+	}
+	return start, desc.EndKey
+}
+
+// resetChanges discards the range's change buffer. It is called
+// whenever the range's bounds or data are replaced wholesale; a new
+// buffer is created by the next Changes request.
+func (r *Range) resetChanges() {
+	r.changesMu.Lock()
+	r.changes = nil
+	r.changesMu.Unlock()
+}
+
+// recordChanges updates the range's change buffer, if any, with the
+// changes committed and the intents written or resolved by args.
+func (r *Range) recordChanges(args proto.Request, reply proto.Response) {
+	r.changesMu.Lock()
+	defer r.changesMu.Unlock()
+	cb := r.changes
+	if cb == nil {
+		return
+	}
+
+	header := args.Header()
+	var commit *proto.Timestamp
+	spans := [][2]proto.Key{{header.Key, header.EndKey}}
+	switch t := args.(type) {
+	case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.IncrementRequest,
+		*proto.DeleteRequest, *proto.DeleteRangeRequest:
+		if header.Txn == nil {
+			commit = &header.Timestamp
+		}
+	case *proto.InternalResolveIntentRequest:
+		if t.Txn.Status == proto.COMMITTED {
+			commit = &t.Txn.Timestamp
+		}
+	case *proto.InternalResolveIntentRangeRequest:
+		if t.Txn.Status == proto.COMMITTED {
+			commit = &t.Txn.Timestamp
+		}
+	case *proto.EndTransactionRequest:
+		// Intents local to the transaction record may have been resolved
+		// as part of the commit.
+		etReply := reply.(*proto.EndTransactionResponse)
+		if etReply.Txn == nil || len(etReply.Resolved) == 0 {
+			return
+		}
+		if etReply.Txn.Status == proto.COMMITTED {
+			commit = &etReply.Txn.Timestamp
+		}
+		spans = spans[:0]
+		for _, key := range etReply.Resolved {
+			spans = append(spans, [2]proto.Key{key, nil})
+		}
+	case *proto.InternalBatchRequest:
+		// A transaction committed in one phase applies its writes
+		// directly at the commit timestamp.
+		bReply := reply.(*proto.InternalBatchResponse)
+		if bReply.Txn == nil || bReply.Txn.Status != proto.COMMITTED {
+			return
+		}
+		commit = &bReply.Txn.Timestamp
+		spans = spans[:0]
+		for i := range t.Requests {
+			switch req := t.Requests[i].GetValue().(type) {
+			case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.IncrementRequest,
+				*proto.DeleteRequest, *proto.DeleteRangeRequest:
+				h := req.(proto.Request).Header()
+				spans = append(spans, [2]proto.Key{h.Key, h.EndKey})
+			}
+		}
+	default:
+		return
+	}
+
+	start, end := r.changesSpan()
+	for _, span := range spans {
+		key, endKey := span[0], span[1]
+		if len(endKey) == 0 {
+			endKey = key.Next()
+		}
+		// Restrict the span to the keys tracked by the buffer.
+		if key.Less(start) {
+			key = start
+		}
+		if end.Less(endKey) {
+			endKey = end
+		}
+		if !key.Less(endKey) {
+			continue
+		}
+		if err := cb.update(r.rm.Engine(), key, endKey, commit); err != nil {
+			log.Warningf("range %d: discarding change buffer: %s", r.Desc().RaftID, err)
+			r.changes = nil
+			return
+		}
+	}
+}
@@ -87,10 +87,30 @@ func (r *Range) executeCmd(batch engine.Engine, ms *engine.MVCCStats, args proto
 		var resp proto.ScanResponse
 		resp, intents, err = r.Scan(batch, *tArgs)
 		reply = &resp
+	case *proto.ReverseScanRequest:
+		var resp proto.ReverseScanResponse
+		resp, intents, err = r.ReverseScan(batch, *tArgs)
+		reply = &resp
+	case *proto.ChangesRequest:
+		var resp proto.ChangesResponse
+		resp, err = r.Changes(batch, *tArgs)
+		reply = &resp
 	case *proto.EndTransactionRequest:
 		var resp proto.EndTransactionResponse
 		resp, err = r.EndTransaction(batch, ms, *tArgs)
 		reply = &resp
+	case *proto.ReapQueueRequest:
+		var resp proto.ReapQueueResponse
+		resp, intents, err = r.ReapQueue(batch, ms, *tArgs)
+		reply = &resp
+	case *proto.EnqueueUpdateRequest:
+		var resp proto.EnqueueUpdateResponse
+		resp, err = r.EnqueueUpdate(batch, ms, *tArgs)
+		reply = &resp
+	case *proto.EnqueueMessageRequest:
+		var resp proto.EnqueueMessageResponse
+		resp, err = r.EnqueueMessage(batch, ms, *tArgs)
+		reply = &resp
 	case *proto.InternalRangeLookupRequest:
 		var resp proto.InternalRangeLookupResponse
 		resp, intents, err = r.InternalRangeLookup(batch, *tArgs)
@@ -107,6 +127,10 @@ func (r *Range) executeCmd(batch engine.Engine, ms *engine.MVCCStats, args proto
 		var resp proto.InternalPushTxnResponse
 		resp, err = r.InternalPushTxn(batch, ms, *tArgs)
 		reply = &resp
+	case *proto.InternalQueryTxnRequest:
+		var resp proto.InternalQueryTxnResponse
+		resp, err = r.InternalQueryTxn(batch, *tArgs)
+		reply = &resp
 	case *proto.InternalResolveIntentRequest:
 		var resp proto.InternalResolveIntentResponse
 		resp, err = r.InternalResolveIntent(batch, ms, *tArgs)
@@ -127,6 +151,10 @@ func (r *Range) executeCmd(batch engine.Engine, ms *engine.MVCCStats, args proto
 		var resp proto.InternalLeaderLeaseResponse
 		resp, err = r.InternalLeaderLease(batch, ms, *tArgs)
 		reply = &resp
+	case *proto.InternalBatchRequest:
+		var resp proto.InternalBatchResponse
+		resp, err = r.InternalBatch(batch, ms, *tArgs)
+		reply = &resp
 	default:
 		err = util.Errorf("unrecognized command %s", args.Method())
 	}
@@ -227,6 +255,76 @@ func (r *Range) Scan(batch engine.Engine, args proto.ScanRequest) (proto.ScanRes
 	return reply, intents, err
 }
 
+// ReverseScan scans the key range specified by start key through end
+// key in descending order, up to some maximum number of results.
+func (r *Range) ReverseScan(batch engine.Engine, args proto.ReverseScanRequest) (proto.ReverseScanResponse, []proto.Intent, error) {
+	var reply proto.ReverseScanResponse
+
+	rows, intents, err := engine.MVCCReverseScan(batch, args.Key, args.EndKey, args.MaxResults, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn)
+	reply.Rows = rows
+	return reply, intents, err
+}
+
+// ReapQueue scans and deletes up to args.MaxResults messages, oldest
+// first, from the queue of the inbox specified by args.Key. The reaped
+// messages are returned. ReapQueue must be part of a transaction so
+// that the messages are only removed if their processing commits.
+func (r *Range) ReapQueue(batch engine.Engine, ms *engine.MVCCStats, args proto.ReapQueueRequest) (proto.ReapQueueResponse, []proto.Intent, error) {
+	var reply proto.ReapQueueResponse
+
+	if args.Txn == nil {
+		return reply, nil, util.Errorf("ReapQueue must be part of a transaction")
+	}
+	prefix := keys.QueueMessagePrefix(args.Key)
+	msgs, intents, err := engine.MVCCScan(batch, prefix, prefix.PrefixEnd(), args.MaxResults, args.Timestamp, true /* consistent */, args.Txn)
+	if err != nil {
+		return reply, intents, err
+	}
+	for _, msg := range msgs {
+		if err := engine.MVCCDelete(batch, ms, msg.Key, args.Timestamp, args.Txn); err != nil {
+			return reply, intents, err
+		}
+	}
+	reply.Messages = msgs
+	return reply, intents, nil
+}
+
+// EnqueueUpdate stores args.Update with the range containing args.Key
+// for eventual execution by the update queue. Only transactional
+// writes may be enqueued.
+func (r *Range) EnqueueUpdate(batch engine.Engine, ms *engine.MVCCStats, args proto.EnqueueUpdateRequest) (proto.EnqueueUpdateResponse, error) {
+	var reply proto.EnqueueUpdateResponse
+
+	if len(args.Update.Requests) == 0 {
+		return reply, util.Errorf("cannot enqueue an empty update")
+	}
+	for _, union := range args.Update.Requests {
+		req := union.GetValue().(proto.Request)
+		if _, ok := req.(*proto.ReapQueueRequest); ok || !proto.IsTransactionWrite(req) {
+			return reply, util.Errorf("cannot enqueue %s as part of an update", req.Method())
+		}
+	}
+	if args.CmdID.IsEmpty() {
+		return reply, util.Errorf("EnqueueUpdate requires a client command ID")
+	}
+	key := keys.QueueUpdateKey(args.Key, args.Timestamp, args.CmdID)
+	return reply, engine.MVCCPutProto(batch, ms, key, args.Timestamp, args.Txn, &args.Update)
+}
+
+// EnqueueMessage enqueues args.Msg into the queue of the inbox
+// specified by args.Key. The message key is derived from the request's
+// timestamp and client command ID; the latter makes the key unique and,
+// through the response cache, makes retried enqueues idempotent.
+func (r *Range) EnqueueMessage(batch engine.Engine, ms *engine.MVCCStats, args proto.EnqueueMessageRequest) (proto.EnqueueMessageResponse, error) {
+	var reply proto.EnqueueMessageResponse
+
+	if args.CmdID.IsEmpty() {
+		return reply, util.Errorf("EnqueueMessage requires a client command ID")
+	}
+	key := keys.QueueMessageKey(args.Key, args.Timestamp, args.CmdID)
+	return reply, engine.MVCCPut(batch, ms, key, args.Timestamp, args.Msg, args.Txn)
+}
+
 // EndTransaction either commits or aborts (rolls back) an extant
 // transaction according to the args.Commit parameter.
 func (r *Range) EndTransaction(batch engine.Engine, ms *engine.MVCCStats, args proto.EndTransactionRequest) (proto.EndTransactionResponse, error) {
@@ -341,6 +439,74 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *engine.MVCCStats, args p
 	return reply, nil
 }
 
+// InternalBatch applies a transaction which begins and commits within
+// this range in a single phase. The batch consists of point writes
+// followed by a committing EndTransaction. The writes are applied
+// directly at the transaction's commit timestamp, without laying down
+// intents or persisting a transaction record; since all of them are
+// part of the same Raft command, they become visible atomically.
+//
+// An error is returned if the commit timestamp of a SERIALIZABLE
+// transaction differs from its original timestamp, in which case the
+// caller should fall back to a regular two-phase commit.
+func (r *Range) InternalBatch(batch engine.Engine, ms *engine.MVCCStats, args proto.InternalBatchRequest) (proto.InternalBatchResponse, error) {
+	var reply proto.InternalBatchResponse
+
+	if args.Txn == nil {
+		return reply, util.Errorf("no transaction specified to InternalBatch")
+	}
+	if len(args.Requests) < 2 {
+		return reply, util.Errorf("one-phase commit requires at least one write and an EndTransaction")
+	}
+	txn := *args.Txn
+	if txn.Timestamp.Less(args.Timestamp) {
+		txn.Timestamp = args.Timestamp
+	}
+	if txn.Isolation == proto.SERIALIZABLE && !txn.Timestamp.Equal(txn.OrigTimestamp) {
+		return reply, proto.NewTransactionRetryError(&txn)
+	}
+
+	last := len(args.Requests) - 1
+	etArgs, ok := args.Requests[last].GetValue().(*proto.EndTransactionRequest)
+	if !ok || !etArgs.Commit || etArgs.InternalCommitTrigger != nil {
+		return reply, util.Errorf("one-phase commit must end with a committing EndTransaction without trigger")
+	}
+
+	written := map[string]struct{}{}
+	for i := 0; i < last; i++ {
+		req := args.Requests[i].GetValue().(proto.Request)
+		switch req.(type) {
+		case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.IncrementRequest, *proto.DeleteRequest:
+		default:
+			return reply, util.Errorf("%s cannot be committed in one phase", req.Method())
+		}
+		// A second write to the same key would conflict with the first one
+		// at the same timestamp.
+		key := string(req.Header().Key)
+		if _, ok := written[key]; ok {
+			return reply, util.Errorf("key %q written more than once in one-phase commit", req.Header().Key)
+		}
+		written[key] = struct{}{}
+
+		req = gogoproto.Clone(req).(proto.Request)
+		req.Header().Txn = nil
+		req.Header().Timestamp = txn.Timestamp
+		resp, _, err := r.executeCmd(batch, ms, req)
+		if err != nil {
+			return reply, err
+		}
+		reply.Add(resp)
+	}
+
+	txn.Status = proto.COMMITTED
+	etReply := &proto.EndTransactionResponse{}
+	etReply.Timestamp = txn.Timestamp
+	etReply.Txn = gogoproto.Clone(&txn).(*proto.Transaction)
+	reply.Add(etReply)
+	reply.Txn = &txn
+	return reply, nil
+}
+
 // InternalRangeLookup is used to look up RangeDescriptors - a RangeDescriptor
 // is a metadata structure which describes the key range and replica locations
 // of a distinct range in the cluster.
@@ -379,7 +545,11 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *engine.MVCCStats, args p
 func (r *Range) InternalRangeLookup(batch engine.Engine, args proto.InternalRangeLookupRequest) (proto.InternalRangeLookupResponse, []proto.Intent, error) {
 	var reply proto.InternalRangeLookupResponse
 
-	if err := keys.ValidateRangeMetaKey(args.Key); err != nil {
+	validate := keys.ValidateRangeMetaKey
+	if args.Reverse {
+		validate = keys.ValidateReverseRangeMetaKey
+	}
+	if err := validate(args.Key); err != nil {
 		return reply, nil, err
 	}
 
@@ -397,13 +567,20 @@ func (r *Range) InternalRangeLookup(batch engine.Engine, args proto.InternalRang
 		rangeCount = 1
 	}
 
-	// We want to search for the metadata key just greater than args.Key. Scan
-	// for both the requested key and the keys immediately afterwards, up to
-	// MaxRanges.
-	startKey, endKey := keys.MetaScanBounds(args.Key)
-	// Scan for descriptors.
-	kvs, intents, err := engine.MVCCScan(batch, startKey, endKey, rangeCount,
-		args.Timestamp, consistent, args.Txn)
+	var kvs []proto.KeyValue
+	var intents []proto.Intent
+	var err error
+	if !args.Reverse {
+		// We want to search for the metadata key just greater than args.Key. Scan
+		// for both the requested key and the keys immediately afterwards, up to
+		// MaxRanges.
+		startKey, endKey := keys.MetaScanBounds(args.Key)
+		// Scan for descriptors.
+		kvs, intents, err = engine.MVCCScan(batch, startKey, endKey, rangeCount,
+			args.Timestamp, consistent, args.Txn)
+	} else {
+		kvs, intents, err = r.reverseRangeLookup(batch, args, rangeCount, consistent)
+	}
 	if err != nil {
 		// An error here is likely a WriteIntentError when reading consistently.
 		return reply, nil, err
@@ -463,6 +640,35 @@ func (r *Range) InternalRangeLookup(batch engine.Engine, args proto.InternalRang
 	return reply, intents, nil
 }
 
+// reverseRangeLookup scans for the meta records of a reverse range
+// lookup: the record at or just after args.Key, which describes the range
+// containing the keys preceding the looked up key, followed by the
+// records of up to rangeCount-1 ranges preceding that range in descending
+// order. The preceding records are only scanned down to the start of this
+// range.
+func (r *Range) reverseRangeLookup(batch engine.Engine, args proto.InternalRangeLookupRequest,
+	rangeCount int64, consistent bool) ([]proto.KeyValue, []proto.Intent, error) {
+	startKey, endKey := keys.MetaReverseScanBounds(args.Key)
+	kvs, intents, err := engine.MVCCScan(batch, startKey, endKey, 1,
+		args.Timestamp, consistent, args.Txn)
+	if err != nil || len(kvs) == 0 || rangeCount == 1 {
+		return kvs, intents, err
+	}
+	prevStartKey := proto.Key(args.Key[:len(keys.Meta1Prefix)])
+	if desc := r.Desc(); prevStartKey.Less(desc.StartKey) {
+		prevStartKey = desc.StartKey
+	}
+	if !prevStartKey.Less(startKey) {
+		return kvs, intents, nil
+	}
+	prevKVs, prevIntents, err := engine.MVCCReverseScan(batch, prevStartKey, startKey, rangeCount-1,
+		args.Timestamp, consistent, args.Txn)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(kvs, prevKVs...), append(intents, prevIntents...), nil
+}
+
 // InternalHeartbeatTxn updates the transaction status and heartbeat
 // timestamp after receiving transaction heartbeat messages from
 // coordinator. Returns the updated transaction.
@@ -500,7 +706,9 @@ func (r *Range) InternalHeartbeatTxn(batch engine.Engine, ms *engine.MVCCStats,
 // InternalGC iterates through the list of keys to garbage collect
 // specified in the arguments. MVCCGarbageCollect is invoked on each
 // listed key along with the expiration timestamp. The GC metadata
-// specified in the args is persisted after GC.
+// specified in the args is persisted after GC, and the range's noted
+// next expiration is cleared if it hasn't been noted again since the
+// GC queue's scan.
 func (r *Range) InternalGC(batch engine.Engine, ms *engine.MVCCStats, args proto.InternalGCRequest) (proto.InternalGCResponse, error) {
 	var reply proto.InternalGCResponse
 
@@ -514,6 +722,20 @@ func (r *Range) InternalGC(batch engine.Engine, ms *engine.MVCCStats, args proto
 	if err := engine.MVCCPutProto(batch, ms, key, proto.ZeroTimestamp, nil, &args.GCMeta); err != nil {
 		return reply, err
 	}
+
+	// The scan's GC metadata accounts for the noted expiration.
+	if args.NextExpirationSeq != 0 {
+		raftID := r.Desc().RaftID
+		noted, seq, err := loadNextExpiration(batch, raftID)
+		if err != nil {
+			return reply, err
+		}
+		if noted != 0 && seq == args.NextExpirationSeq {
+			if err := setNextExpiration(batch, raftID, 0, seq); err != nil {
+				return reply, err
+			}
+		}
+	}
 	return reply, nil
 }
 
@@ -682,6 +904,29 @@ func (r *Range) InternalPushTxn(batch engine.Engine, ms *engine.MVCCStats, args
 	return reply, nil
 }
 
+// InternalQueryTxn fetches the record of the queried transaction, if
+// any, along with the edges of the waits-for graph leading to it. The
+// latter are served from the range's txn wait queue; as pushes only
+// wait on the leader replica, the request is served by it as well.
+func (r *Range) InternalQueryTxn(batch engine.Engine, args proto.InternalQueryTxnRequest) (proto.InternalQueryTxnResponse, error) {
+	var reply proto.InternalQueryTxnResponse
+
+	if !bytes.Equal(args.Key, args.QueriedTxn.Key) {
+		return reply, util.Errorf("request key %s should match queried txn key %s", args.Key, args.QueriedTxn.Key)
+	}
+	key := keys.TransactionKey(args.QueriedTxn.Key, args.QueriedTxn.ID)
+	txn := &proto.Transaction{}
+	ok, err := engine.MVCCGetProto(batch, key, proto.ZeroTimestamp, true, nil, txn)
+	if err != nil {
+		return reply, err
+	}
+	if ok {
+		reply.QueriedTxn = txn
+	}
+	reply.Waits = r.txnWaitQueue.waits(args.QueriedTxn.ID)
+	return reply, nil
+}
+
 // InternalResolveIntent resolves a write intent from the specified key
 // according to the status of the transaction which created it.
 func (r *Range) InternalResolveIntent(batch engine.Engine, ms *engine.MVCCStats, args proto.InternalResolveIntentRequest) (proto.InternalResolveIntentResponse, error) {
@@ -697,7 +942,8 @@ func (r *Range) InternalResolveIntent(batch engine.Engine, ms *engine.MVCCStats,
 }
 
 // InternalResolveIntentRange resolves write intents in the specified
-// key range according to the status of the transaction which created it.
+// key range according to the status of the transaction which created
+// it, or rolls them back to a savepoint of the transaction.
 func (r *Range) InternalResolveIntentRange(batch engine.Engine, ms *engine.MVCCStats,
 	args proto.InternalResolveIntentRangeRequest) (proto.InternalResolveIntentRangeResponse, error) {
 	var reply proto.InternalResolveIntentRangeResponse
@@ -705,6 +951,16 @@ func (r *Range) InternalResolveIntentRange(batch engine.Engine, ms *engine.MVCCS
 	if args.Txn == nil {
 		return reply, util.Errorf("no transaction specified to InternalResolveIntentRange")
 	}
+	if args.Rollback {
+		var restore *engine.MVCCValue
+		if args.RestoreDeleted {
+			restore = &engine.MVCCValue{Deleted: true}
+		} else if args.Restore != nil {
+			restore = &engine.MVCCValue{Value: args.Restore}
+		}
+		_, err := engine.MVCCRollbackWriteIntentRange(batch, ms, args.Key, args.EndKey, 0, args.Timestamp, args.Txn, restore)
+		return reply, err
+	}
 	_, err := engine.MVCCResolveWriteIntentRange(batch, ms, args.Key, args.EndKey, 0, args.Timestamp, args.Txn)
 	return reply, err
 }
@@ -964,6 +1220,17 @@ func (r *Range) splitTrigger(batch engine.Engine, split *proto.SplitTrigger) err
 		return util.Errorf("unable to copy last verification timestamp: %s", err)
 	}
 
+	// Copy the noted next expiration.
+	noted, seq, err := loadNextExpiration(r.rm.Engine(), r.Desc().RaftID)
+	if err != nil {
+		return util.Errorf("unable to fetch next expiration: %s", err)
+	}
+	if noted != 0 {
+		if err := setNextExpiration(batch, split.NewDesc.RaftID, noted, seq); err != nil {
+			return util.Errorf("unable to copy next expiration: %s", err)
+		}
+	}
+
 	// Compute stats for updated range.
 	now := r.rm.Clock().Timestamp()
 	iter := newRangeDataIterator(&split.UpdatedDesc, batch)
@@ -1124,6 +1391,24 @@ func (r *Range) mergeTrigger(batch engine.Engine, merge *proto.MergeTrigger) err
 		return util.Errorf("unable to copy response cache to new split range: %s", err)
 	}
 
+	// Fold the subsumed range's noted next expiration into this range's.
+	subsumedNoted, _, err := loadNextExpiration(batch, merge.SubsumedRaftID)
+	if err != nil {
+		return util.Errorf("unable to fetch next expiration of subsumed range: %s", err)
+	}
+	if subsumedNoted != 0 {
+		noted, seq, err := loadNextExpiration(batch, r.Desc().RaftID)
+		if err != nil {
+			return util.Errorf("unable to fetch next expiration: %s", err)
+		}
+		if noted == 0 || subsumedNoted < noted {
+			noted = subsumedNoted
+		}
+		if err := setNextExpiration(batch, r.Desc().RaftID, noted, seq+1); err != nil {
+			return util.Errorf("unable to merge next expiration: %s", err)
+		}
+	}
+
 	// Compute stats for updated range.
 	now := r.rm.Clock().Timestamp()
 	iter := newRangeDataIterator(&merge.UpdatedDesc, batch)
@@ -61,6 +61,10 @@ func newRangeDataIterator(d *proto.RangeDescriptor, e engine.Engine) *rangeDataI
 				start: engine.MVCCEncodeKey(keys.MakeKey(keys.LocalRangePrefix, encoding.EncodeBytes(nil, d.StartKey))),
 				end:   engine.MVCCEncodeKey(keys.MakeKey(keys.LocalRangePrefix, encoding.EncodeBytes(nil, d.EndKey))),
 			},
+			{
+				start: engine.MVCCEncodeKey(keys.MakeKey(keys.LocalQueueUpdatePrefix, encoding.EncodeBytes(nil, d.StartKey))),
+				end:   engine.MVCCEncodeKey(keys.MakeKey(keys.LocalQueueUpdatePrefix, encoding.EncodeBytes(nil, d.EndKey))),
+			},
 			{
 				start: engine.MVCCEncodeKey(dataStartKey),
 				end:   engine.MVCCEncodeKey(d.EndKey),
@@ -85,6 +89,30 @@ func (ri *rangeDataIterator) Seek(key []byte) {
 	ri.advance()
 }
 
+// SeekReverse seeks to the last key at or before the specified key,
+// clamped to the key ranges of the range. An empty key seeks to the
+// range's last key.
+func (ri *rangeDataIterator) SeekReverse(key []byte) {
+	if len(key) == 0 {
+		ri.curIndex = len(ri.ranges) - 1
+		ri.iter.SeekReverse(ri.ranges[ri.curIndex].end)
+		ri.retreat()
+		return
+	}
+	ri.curIndex = -1
+	for i := range ri.ranges {
+		if !proto.EncodedKey(key).Less(ri.ranges[i].start) {
+			ri.curIndex = i
+		}
+	}
+	if ri.curIndex < 0 {
+		ri.invalidate()
+		return
+	}
+	ri.iter.SeekReverse(key)
+	ri.retreat()
+}
+
 // Valid returns whether the underlying iterator is valid.
 func (ri *rangeDataIterator) Valid() bool {
 	return ri.iter.Valid()
@@ -97,6 +125,12 @@ func (ri *rangeDataIterator) Next() {
 	ri.advance()
 }
 
+// Prev moves back to the previous raw key value in the iteration.
+func (ri *rangeDataIterator) Prev() {
+	ri.iter.Prev()
+	ri.retreat()
+}
+
 // Key returns the current Key for the iteration if valid.
 func (ri *rangeDataIterator) Key() proto.EncodedKey {
 	return ri.iter.Key()
@@ -131,8 +165,37 @@ func (ri *rangeDataIterator) advance() {
 			ri.iter.Seek(ri.ranges[ri.curIndex].start)
 		} else {
 			// Otherwise, seek to end to make iterator invalid.
-			ri.iter.Seek(engine.MVCCKeyMax)
+			ri.invalidate()
 			return
 		}
 	}
 }
+
+// retreat moves the iterator backward through the ranges until a
+// valid key is found or the iteration is done and the iterator
+// becomes invalid.
+func (ri *rangeDataIterator) retreat() {
+	for ri.iter.Valid() {
+		key := ri.iter.Key()
+		if !key.Less(ri.ranges[ri.curIndex].end) {
+			// The engine iterator may land on the exclusive end key.
+			ri.iter.Prev()
+			continue
+		}
+		if !key.Less(ri.ranges[ri.curIndex].start) {
+			return
+		}
+		ri.curIndex--
+		if ri.curIndex < 0 {
+			ri.invalidate()
+			return
+		}
+		ri.iter.SeekReverse(ri.ranges[ri.curIndex].end)
+	}
+}
+
+// invalidate seeks the underlying iterator past the end of the engine
+// to make it invalid.
+func (ri *rangeDataIterator) invalidate() {
+	ri.iter.Seek(engine.MVCCKeyMax)
+}
@@ -72,6 +72,7 @@ func createRangeData(r *Range, t *testing.T) []proto.EncodedKey {
 		{keys.TransactionKey(r.Desc().StartKey, []byte("1234")), ts0},
 		{keys.TransactionKey(r.Desc().StartKey.Next(), []byte("5678")), ts0},
 		{keys.TransactionKey(prevKey(r.Desc().EndKey), []byte("2468")), ts0},
+		{keys.QueueUpdateKey(r.Desc().StartKey, ts, proto.ClientCmdID{Random: 1}), ts},
 		// TODO(bdarnell): KeyMin.Next() results in a key in the reserved system-local space.
 		// Once we have resolved https://github.com/cockroachdb/cockroach/issues/437,
 		// replace this with something that reliably generates the first valid key in the range.
@@ -119,6 +120,9 @@ func TestRangeDataIteratorEmptyRange(t *testing.T) {
 	for ; iter.Valid(); iter.Next() {
 		t.Error("expected empty iteration")
 	}
+	for iter.SeekReverse(nil); iter.Valid(); iter.Prev() {
+		t.Error("expected empty reverse iteration")
+	}
 }
 
 // TestRangeDataIterator creates three ranges {"a"-"b" (pre), "b"-"c"
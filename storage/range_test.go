@@ -2648,6 +2648,31 @@ func TestInternalRangeLookup(t *testing.T) {
 			t.Fatalf("expected %+v, got %+v", expected, reply.Ranges)
 		}
 	}
+
+	// Reverse lookups find the range containing the keys preceding the
+	// looked up key, including the keys preceding KeyMax, whose meta record
+	// is at exactly the looked up key.
+	for _, key := range []proto.Key{
+		keys.RangeMetaKey(proto.Key("a")),
+		keys.RangeMetaKey(proto.KeyMax),
+	} {
+		resp, err := tc.store.ExecuteCmd(context.Background(), &proto.InternalRangeLookupRequest{
+			RequestHeader: proto.RequestHeader{
+				RaftID: 1,
+				Key:    key,
+			},
+			MaxRanges: 2,
+			Reverse:   true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		reply := resp.(*proto.InternalRangeLookupResponse)
+		expected := []proto.RangeDescriptor{*tc.rng.Desc()}
+		if !reflect.DeepEqual(reply.Ranges, expected) {
+			t.Fatalf("expected %+v, got %+v", expected, reply.Ranges)
+		}
+	}
 }
 
 // benchmarkEvents is designed to determine the impact of sending events on the
@@ -98,6 +98,9 @@ func verifyKeyLength(key proto.Key) error {
 	if bytes.HasPrefix(key, keys.LocalRangePrefix) {
 		key = key[len(keys.LocalRangePrefix):]
 		_, key = encoding.DecodeBytes(key, nil)
+	} else if bytes.HasPrefix(key, keys.LocalQueueUpdatePrefix) {
+		key = key[len(keys.LocalQueueUpdatePrefix):]
+		_, key = encoding.DecodeBytes(key, nil)
 	}
 	if bytes.HasPrefix(key, keys.MetaPrefix) {
 		key = key[len(keys.Meta1Prefix):]
@@ -260,6 +263,7 @@ type Store struct {
 	verifyQueue    *verifyQueue    // Checksum verification queue
 	replicateQueue *replicateQueue // Replication queue
 	rangeGCQueue   *rangeGCQueue   // Range GC queue
+	updateQueue    *updateQueue    // Eventual update queue
 	scanner        *rangeScanner   // Range scanner
 	feed           StoreEventFeed  // Event Feed
 	multiraft      *multiraft.MultiRaft
@@ -315,6 +319,19 @@ type StoreContext struct {
 	// EventFeed is a feed to which this store will publish events.
 	EventFeed *util.Feed
 
+	// ClosedTimestampLag is how far behind the present leader lease
+	// holders close timestamps to further writes. Replicas may serve
+	// reads at closed timestamps without holding the leader lease. If
+	// zero, no timestamps are closed.
+	ClosedTimestampLag time.Duration
+
+	// TxnWaitTimeout is how long a push which failed to prevail over
+	// its pushee waits for the pushee to finish before the pusher has
+	// to back off and restart. Waiting pushes also detect deadlocks.
+	// Waits end at the latest when the pushee's heartbeat expires. If
+	// zero, failed pushes return immediately.
+	TxnWaitTimeout time.Duration
+
 	// Tracer is a request tracer.
 	Tracer *tracer.Tracer
 }
@@ -372,7 +389,8 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *proto.NodeDescripto
 	s.verifyQueue = newVerifyQueue(s.RangeCount)
 	s.replicateQueue = newReplicateQueue(s.ctx.Gossip, s.allocator(), s.ctx.Clock)
 	s.rangeGCQueue = newRangeGCQueue(s.db)
-	s.scanner.AddQueues(s.gcQueue, s.splitQueue(), s.verifyQueue, s.replicateQueue, s.rangeGCQueue)
+	s.updateQueue = newUpdateQueue(s.db)
+	s.scanner.AddQueues(s.gcQueue, s.splitQueue(), s.verifyQueue, s.replicateQueue, s.rangeGCQueue, s.updateQueue)
 
 	return s
 }
@@ -516,6 +534,9 @@ func (s *Store) Start(stopper *stop.Stopper) error {
 	s.multiraft.Start()
 	s.processRaft()
 
+	// Keep closing timestamps on ranges which see no writes.
+	s.startClosingTimestamps()
+
 	// Gossip is only ever nil while bootstrapping a cluster and
 	// in unittests.
 	if s.ctx.Gossip != nil {
@@ -610,6 +631,42 @@ func (s *Store) startGossip() {
 	})
 }
 
+// startClosingTimestamps runs a goroutine which periodically asks the
+// ranges for which this store holds the leader lease to close a
+// recent timestamp, should they not have done so with a write. The
+// closed timestamps are allowed to fall behind by half the closed
+// timestamp lag, but are checked at most once per Raft tick. It does
+// nothing if the store doesn't close timestamps.
+func (s *Store) startClosingTimestamps() {
+	slack := s.ctx.ClosedTimestampLag / 2
+	if slack <= 0 {
+		return
+	}
+	interval := slack
+	if interval < s.ctx.RaftTickInterval {
+		interval = s.ctx.RaftTickInterval
+	}
+	ctx := s.Context(nil)
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				newStoreRangeSet(s).Visit(func(rng *Range) bool {
+					return s.stopper.RunTask(func() {
+						if err := rng.heartbeatLeaderLease(slack); err != nil && log.V(1) {
+							log.Warningc(ctx, "range %d: unable to close timestamp: %s", rng.Desc().RaftID, err)
+						}
+					})
+				})
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
 // maybeGossipFirstRange checks whether the store has a replia of the first
 // range and if so, reminds it to gossip the first range descriptor and
 // sentinel gossip.
@@ -733,6 +790,17 @@ func (s *Store) ForceRangeGCScan(t util.Tester) {
 	}
 }
 
+// ForceUpdateQueueScan iterates over all ranges and enqueues any that
+// have pending updates. Exposed only for testing.
+func (s *Store) ForceUpdateQueueScan(t util.Tester) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.ranges {
+		s.updateQueue.MaybeAdd(r, s.ctx.Clock.Now())
+	}
+}
+
 // setRangesMaxBytes sets the max bytes for every range according
 // to the zone configs.
 //
@@ -975,6 +1043,12 @@ func (s *Store) EventFeed() StoreEventFeed { return s.feed }
 // Tracer accessor.
 func (s *Store) Tracer() *tracer.Tracer { return s.ctx.Tracer }
 
+// closedTimestampLag accessor.
+func (s *Store) closedTimestampLag() time.Duration { return s.ctx.ClosedTimestampLag }
+
+// txnWaitTimeout accessor.
+func (s *Store) txnWaitTimeout() time.Duration { return s.ctx.TxnWaitTimeout }
+
 // NewRangeDescriptor creates a new descriptor based on start and end
 // keys and the supplied proto.Replicas slice. It allocates new Raft
 // and range IDs to fill out the supplied replicas.
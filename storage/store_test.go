@@ -1108,6 +1108,225 @@ func TestStoreResolveWriteIntentNoTxn(t *testing.T) {
 	}
 }
 
+// TestStoreTxnWaitQueue verifies that a push which fails because the
+// pushee prevails waits for the pushee to finish and succeeds once it
+// commits, instead of returning a push error.
+func TestStoreTxnWaitQueue(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+	store.ctx.TxnWaitTimeout = time.Second
+
+	key := proto.Key("a")
+	pusher := newTransaction("test", key, 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pushee := newTransaction("test", key, 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pushee.Priority = 2
+	pusher.Priority = 1 // Pusher will lose.
+
+	// First lay down intent using the pushee's txn.
+	pArgs := putArgs(key, []byte("value"), 1, store.StoreID())
+	pArgs.Timestamp = store.ctx.Clock.Now()
+	pArgs.Txn = pushee
+	if _, err := store.ExecuteCmd(context.Background(), &pArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	// Now, try a put using the pusher's txn. It waits for the pushee.
+	errChan := make(chan error, 1)
+	go func() {
+		args := putArgs(key, []byte("value2"), 1, store.StoreID())
+		args.Timestamp = store.ctx.Clock.Now()
+		args.Txn = pusher
+		_, err := store.ExecuteCmd(context.Background(), &args)
+		errChan <- err
+	}()
+
+	// Wait for the push to be enqueued before committing the pushee.
+	if err := util.IsTrueWithin(func() bool {
+		rng := store.LookupRange(keys.TransactionKey(pushee.Key, pushee.ID), nil)
+		return len(rng.txnWaitQueue.waits(pushee.ID)) == 1
+	}, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errChan:
+		t.Fatalf("put returned before pushee finished: %v", err)
+	default:
+	}
+
+	etArgs := endTxnArgs(pushee, true, 1, store.StoreID())
+	etArgs.Timestamp = pushee.Timestamp
+	if _, err := store.ExecuteCmd(context.Background(), &etArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Errorf("expected put to succeed after pushee committed; got %s", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("put did not return after pushee committed")
+	}
+}
+
+// TestStoreTxnDeadlockDetection verifies that two transactions which
+// wait for each other detect the deadlock and that exactly one of them,
+// the cycle's victim, is aborted while the other proceeds.
+func TestStoreTxnDeadlockDetection(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+	store.ctx.TxnWaitTimeout = time.Second
+
+	keyA, keyB := proto.Key("a"), proto.Key("b")
+	txnA := newTransaction("test", keyA, 1, proto.SERIALIZABLE, store.ctx.Clock)
+	txnB := newTransaction("test", keyB, 1, proto.SERIALIZABLE, store.ctx.Clock)
+	// With equal priorities and timestamps, neither push prevails.
+	txnA.Priority, txnB.Priority = 1, 1
+	txnB.Timestamp, txnB.OrigTimestamp = txnA.Timestamp, txnA.OrigTimestamp
+
+	txns := []*proto.Transaction{txnA, txnB}
+	for i, key := range []proto.Key{keyA, keyB} {
+		pArgs := putArgs(key, []byte("value"), 1, store.StoreID())
+		pArgs.Timestamp = txns[i].Timestamp
+		pArgs.Txn = txns[i]
+		if _, err := store.ExecuteCmd(context.Background(), &pArgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Each transaction now writes the other's key.
+	errs := make([]chan error, len(txns))
+	for i, key := range []proto.Key{keyB, keyA} {
+		errs[i] = make(chan error, 1)
+		go func(i int, key proto.Key) {
+			pArgs := putArgs(key, []byte("value2"), 1, store.StoreID())
+			pArgs.Timestamp = txns[i].Timestamp
+			pArgs.Txn = txns[i]
+			_, err := store.ExecuteCmd(context.Background(), &pArgs)
+			errs[i] <- err
+		}(i, key)
+	}
+
+	victim := 1
+	if bytes.Compare(txnA.ID, txnB.ID) < 0 {
+		victim = 0
+	}
+	for i := range txns {
+		select {
+		case err := <-errs[i]:
+			if i == victim {
+				if _, ok := err.(*proto.TransactionAbortedError); !ok {
+					t.Errorf("%d: expected transaction aborted error; got %v", i, err)
+				}
+			} else if err != nil {
+				t.Errorf("%d: expected put to succeed; got %s", i, err)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatalf("%d: deadlock was not broken", i)
+		}
+	}
+}
+
+// TestStoreTxnWaitQueueHeartbeatExpiry verifies that a push waits at
+// most until the heartbeat of its pushee expires, even if the txn wait
+// timeout is longer, and then prevails over the abandoned pushee.
+func TestStoreTxnWaitQueueHeartbeatExpiry(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, manual, stopper := createTestStore(t)
+	defer stopper.Stop()
+	store.ctx.TxnWaitTimeout = time.Minute
+	manual.Set((2 * DefaultHeartbeatInterval).Nanoseconds())
+
+	key := proto.Key("a")
+	pusher := newTransaction("test", key, 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pushee := newTransaction("test", key, 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pushee.Priority = 2
+	pusher.Priority = 1 // Pusher will lose.
+	// The pushee's heartbeat expires half a second from now.
+	pushee.Timestamp.WallTime -= (2*DefaultHeartbeatInterval - 500*time.Millisecond).Nanoseconds()
+
+	type result struct {
+		reply proto.Response
+		err   error
+	}
+	resultChan := make(chan result, 1)
+	start := time.Now()
+	go func() {
+		args := pushTxnArgs(pusher, pushee, proto.ABORT_TXN, 1, store.StoreID())
+		args.Now = store.ctx.Clock.Now()
+		reply, err := store.ExecuteCmd(context.Background(), &args)
+		resultChan <- result{reply, err}
+	}()
+
+	// Once the push waits, let the pushee's heartbeat expire.
+	if err := util.IsTrueWithin(func() bool {
+		rng := store.LookupRange(keys.TransactionKey(pushee.Key, pushee.ID), nil)
+		return len(rng.txnWaitQueue.waits(pushee.ID)) == 1
+	}, 250*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	manual.Increment(time.Second.Nanoseconds())
+
+	select {
+	case res := <-resultChan:
+		if res.err != nil {
+			t.Fatalf("expected push to succeed once the pushee's heartbeat expired; got %s", res.err)
+		}
+		if status := res.reply.(*proto.InternalPushTxnResponse).PusheeTxn.Status; status != proto.ABORTED {
+			t.Errorf("expected pushee to be aborted; got %s", status)
+		}
+		if elapsed := time.Since(start); elapsed >= 2*time.Second {
+			t.Errorf("push took %s to succeed", elapsed)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("push did not return after the pushee's heartbeat expired")
+	}
+}
+
+// TestStoreTxnWaitQueueFailsFast verifies that a failed push which
+// can't wait in the txn wait queue returns its error right away: with
+// waiting disabled and for cleanup pushes.
+func TestStoreTxnWaitQueueFailsFast(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	key := proto.Key("a")
+	pushee := newTransaction("test", key, 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pushee.Priority = 2
+	pArgs := putArgs(key, []byte("value"), 1, store.StoreID())
+	pArgs.Timestamp = store.ctx.Clock.Now()
+	pArgs.Txn = pushee
+	if _, err := store.ExecuteCmd(context.Background(), &pArgs); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		waitTimeout time.Duration
+		pushType    proto.PushTxnType
+	}{
+		{0, proto.ABORT_TXN},
+		{time.Second, proto.CLEANUP_TXN},
+	}
+	for i, test := range testCases {
+		store.ctx.TxnWaitTimeout = test.waitTimeout
+		pusher := newTransaction("test", key, 1, proto.SERIALIZABLE, store.ctx.Clock)
+		pusher.Priority = 1 // Pusher will lose.
+		args := pushTxnArgs(pusher, pushee, test.pushType, 1, store.StoreID())
+		args.Now = store.ctx.Clock.Now()
+		start := time.Now()
+		_, err := store.ExecuteCmd(context.Background(), &args)
+		if _, ok := err.(*proto.TransactionPushError); !ok {
+			t.Errorf("%d: expected push error; got %v", i, err)
+		}
+		if elapsed := time.Since(start); elapsed >= 500*time.Millisecond {
+			t.Errorf("%d: push took %s to fail", i, elapsed)
+		}
+	}
+}
+
 // TestStoreReadInconsistent verifies that gets and scans with read
 // consistency set to INCONSISTENT either push or simply ignore extant
 // intents (if they cannot be pushed), depending on the intent priority.
@@ -0,0 +1,366 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// txnWaitQueryInterval is the interval at which a waiting push checks
+// on its pushee and queries the record of its pusher.
+var txnWaitQueryInterval = 50 * time.Millisecond
+
+// A waitingPush is a failed push waiting for the pushee's transaction
+// to change before being retried.
+type waitingPush struct {
+	edge proto.TxnWaitEdge
+	// deps holds the edges of the waits-for graph leading to the pusher,
+	// as of the most recent query of the pusher's record.
+	deps []proto.TxnWaitEdge
+	// updated is signaled when the pushee's record changes.
+	updated chan struct{}
+}
+
+// A txnWaitQueue holds the pushes waiting for transactions whose
+// records are stored in a range. A push is woken up for a retry when
+// the record of its pushee changes, sparing the pusher a restart
+// with backoff. The queue also serves the edges of the waits-for
+// graph which lead to the transactions waited for, out of which each
+// waiting push assembles the graph around it to detect deadlocks.
+type txnWaitQueue struct {
+	sync.Mutex
+	waiters map[string][]*waitingPush // keyed by pushee txn ID
+}
+
+// newTxnWaitQueue returns a new, empty txn wait queue.
+func newTxnWaitQueue() *txnWaitQueue {
+	return &txnWaitQueue{
+		waiters: map[string][]*waitingPush{},
+	}
+}
+
+// enqueue adds a push described by edge to the queue.
+func (q *txnWaitQueue) enqueue(edge proto.TxnWaitEdge) *waitingPush {
+	w := &waitingPush{
+		edge:    edge,
+		updated: make(chan struct{}, 1),
+	}
+	q.Lock()
+	defer q.Unlock()
+	id := string(edge.PusheeID)
+	q.waiters[id] = append(q.waiters[id], w)
+	return w
+}
+
+// dequeue removes a push from the queue.
+func (q *txnWaitQueue) dequeue(w *waitingPush) {
+	q.Lock()
+	defer q.Unlock()
+	id := string(w.edge.PusheeID)
+	waiters := q.waiters[id]
+	for i := range waiters {
+		if waiters[i] == w {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(q.waiters, id)
+	} else {
+		q.waiters[id] = waiters
+	}
+}
+
+// setDeps updates the edges of the waits-for graph known to lead to
+// the pusher of w.
+func (q *txnWaitQueue) setDeps(w *waitingPush, deps []proto.TxnWaitEdge) {
+	q.Lock()
+	defer q.Unlock()
+	w.deps = deps
+}
+
+// updateTxn wakes up the pushes waiting for txn.
+func (q *txnWaitQueue) updateTxn(txn *proto.Transaction) {
+	if txn == nil {
+		return
+	}
+	q.Lock()
+	defer q.Unlock()
+	for _, w := range q.waiters[string(txn.ID)] {
+		select {
+		case w.updated <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// maybeUpdateTxn wakes up the pushes waiting for a transaction whose
+// record has been changed by the successful command which returned
+// reply.
+func (q *txnWaitQueue) maybeUpdateTxn(reply proto.Response) {
+	switch t := reply.(type) {
+	case *proto.EndTransactionResponse:
+		q.updateTxn(t.Txn)
+	case *proto.InternalPushTxnResponse:
+		q.updateTxn(t.PusheeTxn)
+	}
+}
+
+// waits returns the edges of the waits-for graph leading to the
+// transaction with the given ID: those of the pushes waiting for it
+// and the ones leading to their pushers in turn. Pushes by
+// non-transactional requests are omitted as they can't be part of a
+// deadlock.
+func (q *txnWaitQueue) waits(txnID []byte) []proto.TxnWaitEdge {
+	q.Lock()
+	defer q.Unlock()
+	type edgeKey struct{ pusher, pushee string }
+	seen := map[edgeKey]struct{}{}
+	var edges []proto.TxnWaitEdge
+	add := func(e proto.TxnWaitEdge) {
+		k := edgeKey{string(e.PusherID), string(e.PusheeID)}
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			edges = append(edges, e)
+		}
+	}
+	for _, w := range q.waiters[string(txnID)] {
+		if len(w.edge.PusherID) == 0 {
+			continue
+		}
+		add(w.edge)
+		for _, e := range w.deps {
+			add(e)
+		}
+	}
+	return edges
+}
+
+// findCycle returns the edges of a cycle in the waits-for graph made
+// up of edge and deps, the edges leading to the pusher of edge, or nil
+// if edge isn't part of a cycle. The cycle starts with edge.
+func findCycle(edge proto.TxnWaitEdge, deps []proto.TxnWaitEdge) []proto.TxnWaitEdge {
+	out := map[string][]proto.TxnWaitEdge{}
+	for _, e := range deps {
+		out[string(e.PusherID)] = append(out[string(e.PusherID)], e)
+	}
+	// Breadth-first search for a path from the pushee back to the pusher,
+	// remembering the edge through which each transaction was reached.
+	via := map[string]proto.TxnWaitEdge{}
+	visited := map[string]bool{string(edge.PusheeID): true}
+	queue := []string{string(edge.PusheeID)}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == string(edge.PusherID) {
+			var path []proto.TxnWaitEdge
+			for id != string(edge.PusheeID) {
+				e := via[id]
+				path = append([]proto.TxnWaitEdge{e}, path...)
+				id = string(e.PusherID)
+			}
+			return append([]proto.TxnWaitEdge{edge}, path...)
+		}
+		for _, e := range out[id] {
+			if next := string(e.PusheeID); !visited[next] {
+				visited[next] = true
+				via[next] = e
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+// deadlockVictim returns the ID of the member of cycle to abort in
+// order to break the deadlock: the one with the lowest priority or,
+// among equal priorities, the one with the lowest ID. All members of
+// the cycle arrive at the same victim.
+func deadlockVictim(cycle []proto.TxnWaitEdge) []byte {
+	victim := cycle[0]
+	for _, e := range cycle[1:] {
+		if e.PusherPriority < victim.PusherPriority ||
+			(e.PusherPriority == victim.PusherPriority && bytes.Compare(e.PusherID, victim.PusherID) < 0) {
+			victim = e
+		}
+	}
+	return victim.PusherID
+}
+
+// maybeWaitForPush is invoked with the outcome of a push of a
+// transaction whose record is held by this range. If the push failed
+// because the pushee prevailed, the push waits in the range's txn
+// wait queue and is retried once the pushee's record changes. While
+// waiting, the record of the pusher is queried periodically. This
+// notices if the pusher is aborted in the meantime, and it gathers the
+// edges of the waits-for graph which lead to the pusher: if the pushee
+// is among them, the transactions are deadlocked and the push aborts
+// the pushee if it is the cycle's victim.
+//
+// A push waits at most until the heartbeat of the pushee, as of the
+// failed push, expires. The push is then retried a last time, which
+// prevails over an abandoned pushee. The store's txn wait timeout
+// bounds the wait further, after which the original error is returned.
+func (r *Range) maybeWaitForPush(ctx context.Context, args *proto.InternalPushTxnRequest, reply proto.Response, err error) (proto.Response, error) {
+	timeout := r.rm.txnWaitTimeout()
+	pushErr, ok := err.(*proto.TransactionPushError)
+	if !ok || timeout == 0 || args.PushType == proto.CLEANUP_TXN || args.RangeLookup {
+		return reply, err
+	}
+	// An abandoned pushee can be pushed once its heartbeat expires.
+	lastHeartbeat := pushErr.PusheeTxn.Timestamp
+	if pushErr.PusheeTxn.LastHeartbeat != nil {
+		lastHeartbeat = *pushErr.PusheeTxn.LastHeartbeat
+	}
+	expiresIn := time.Duration(lastHeartbeat.WallTime-r.rm.Clock().Now().WallTime) +
+		2*DefaultHeartbeatInterval
+	expires := expiresIn <= timeout
+	if expires {
+		timeout = expiresIn
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(txnWaitQueryInterval)
+	defer ticker.Stop()
+
+	for {
+		pushErr, ok := err.(*proto.TransactionPushError)
+		if !ok {
+			return reply, err
+		}
+		pushee := &pushErr.PusheeTxn
+		w := r.txnWaitQueue.enqueue(proto.TxnWaitEdge{
+			PusherID:       args.Txn.GetID(),
+			PusherPriority: args.Txn.GetPriority(),
+			PusheeID:       pushee.ID,
+		})
+
+		retry, last := r.pusheeChanged(args, pushee), false
+		for !retry {
+			select {
+			case <-w.updated:
+				retry = true
+			case <-ticker.C:
+				// Catch changes to the pushee missed while enqueueing.
+				if retry = r.pusheeChanged(args, pushee); retry || args.Txn == nil {
+					break
+				}
+				qReply, qErr := r.queryTxn(args.Txn)
+				if qErr != nil {
+					if log.V(1) {
+						log.Warningc(ctx, "failed to query pusher %s: %s", args.Txn, qErr)
+					}
+					break
+				}
+				if pusher := qReply.QueriedTxn; pusher != nil && pusher.Status == proto.ABORTED {
+					r.txnWaitQueue.dequeue(w)
+					return reply, proto.NewTransactionAbortedError(pusher)
+				}
+				r.txnWaitQueue.setDeps(w, qReply.Waits)
+				if cycle := findCycle(w.edge, qReply.Waits); cycle != nil &&
+					bytes.Equal(deadlockVictim(cycle), pushee.ID) {
+					if log.V(1) {
+						log.Infoc(ctx, "aborting %s to break deadlock of %d transactions", pushee, len(cycle))
+					}
+					r.txnWaitQueue.dequeue(w)
+					return r.abortDeadlockVictim(ctx, args)
+				}
+			case <-deadline.C:
+				if !expires {
+					r.txnWaitQueue.dequeue(w)
+					return reply, err
+				}
+				retry, last = true, true
+			case <-r.rm.Stopper().ShouldStop():
+				r.txnWaitQueue.dequeue(w)
+				return reply, err
+			}
+		}
+		r.txnWaitQueue.dequeue(w)
+
+		// Retry the push as a new command which judges the pushee's
+		// heartbeat by the current time.
+		args.CmdID = proto.ClientCmdID{}
+		args.Now = r.rm.Clock().Now()
+		reply, err = r.addWriteCmd(ctx, args, nil)
+		if last {
+			return reply, err
+		}
+	}
+}
+
+// abortDeadlockVictim aborts the pushee of args, which has been chosen
+// as the victim of a deadlock. The abort is a push on behalf of the
+// range at the highest possible priority, so that it prevails over the
+// pushee regardless of its priority. Clients have no means to request
+// such a push; it is only issued from within the txn wait queue.
+func (r *Range) abortDeadlockVictim(ctx context.Context, args *proto.InternalPushTxnRequest) (proto.Response, error) {
+	victimArgs := gogoproto.Clone(args).(*proto.InternalPushTxnRequest)
+	victimArgs.Txn.Priority = math.MaxInt32
+	// Among equal priorities, the push with the earlier transaction
+	// timestamp prevails.
+	victimArgs.Txn.Timestamp = proto.ZeroTimestamp
+	victimArgs.PushType = proto.ABORT_TXN
+	victimArgs.CmdID = proto.ClientCmdID{}
+	victimArgs.Now = r.rm.Clock().Now()
+	return r.addWriteCmd(ctx, victimArgs, nil)
+}
+
+// pusheeChanged returns true if the persisted record of the pushee
+// shows that a retry of the push is going to succeed.
+func (r *Range) pusheeChanged(args *proto.InternalPushTxnRequest, pushee *proto.Transaction) bool {
+	var txn proto.Transaction
+	ok, err := engine.MVCCGetProto(r.rm.Engine(), keys.TransactionKey(pushee.Key, pushee.ID),
+		proto.ZeroTimestamp, true, nil, &txn)
+	if err != nil || !ok {
+		return false
+	}
+	return txn.Status != proto.PENDING ||
+		(args.PushType == proto.PUSH_TIMESTAMP && args.Timestamp.Less(txn.Timestamp))
+}
+
+// queryTxn queries the record of txn and the edges of the waits-for
+// graph which lead to it.
+func (r *Range) queryTxn(txn *proto.Transaction) (*proto.InternalQueryTxnResponse, error) {
+	call := proto.Call{
+		Args: &proto.InternalQueryTxnRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:  txn.Key,
+				User: security.RootUser,
+			},
+			QueriedTxn: *txn,
+		},
+		Reply: &proto.InternalQueryTxnResponse{},
+	}
+	b := &client.Batch{}
+	b.InternalAddCall(call)
+	if err := r.rm.DB().Run(b); err != nil {
+		return nil, err
+	}
+	return call.Reply.(*proto.InternalQueryTxnResponse), nil
+}
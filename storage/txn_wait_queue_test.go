@@ -0,0 +1,126 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func makeEdge(pusher string, priority int32, pushee string) proto.TxnWaitEdge {
+	return proto.TxnWaitEdge{
+		PusherID:       []byte(pusher),
+		PusherPriority: priority,
+		PusheeID:       []byte(pushee),
+	}
+}
+
+// TestTxnWaitQueueWaits verifies that the edges served for a
+// transaction include those leading to its pushers, without duplicates
+// and without pushes by non-transactional requests.
+func TestTxnWaitQueueWaits(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	q := newTxnWaitQueue()
+	w1 := q.enqueue(makeEdge("b", 1, "a"))
+	w2 := q.enqueue(makeEdge("c", 1, "a"))
+	q.enqueue(makeEdge("", 0, "a"))
+	q.enqueue(makeEdge("d", 1, "b"))
+	q.setDeps(w1, []proto.TxnWaitEdge{makeEdge("d", 1, "b")})
+	q.setDeps(w2, []proto.TxnWaitEdge{makeEdge("d", 1, "b"), makeEdge("e", 1, "c")})
+
+	expected := []proto.TxnWaitEdge{
+		makeEdge("b", 1, "a"),
+		makeEdge("d", 1, "b"),
+		makeEdge("c", 1, "a"),
+		makeEdge("e", 1, "c"),
+	}
+	if waits := q.waits([]byte("a")); !reflect.DeepEqual(waits, expected) {
+		t.Errorf("expected %v; got %v", expected, waits)
+	}
+
+	q.dequeue(w1)
+	q.dequeue(w2)
+	if waits := q.waits([]byte("a")); len(waits) != 0 {
+		t.Errorf("expected no waits after dequeue; got %v", waits)
+	}
+}
+
+// TestTxnWaitQueueUpdateTxn verifies that an update of a transaction
+// wakes up only the pushes waiting for it.
+func TestTxnWaitQueueUpdateTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	q := newTxnWaitQueue()
+	wa := q.enqueue(makeEdge("b", 1, "a"))
+	wc := q.enqueue(makeEdge("b", 1, "c"))
+
+	q.maybeUpdateTxn(&proto.EndTransactionResponse{Txn: &proto.Transaction{ID: []byte("a")}})
+	select {
+	case <-wa.updated:
+	default:
+		t.Error("expected push waiting for a to be woken up")
+	}
+	select {
+	case <-wc.updated:
+		t.Error("unexpected wake-up of push waiting for c")
+	default:
+	}
+}
+
+// TestFindCycle verifies the detection of cycles in the waits-for graph
+// and the choice of their victim.
+func TestFindCycle(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		edge      proto.TxnWaitEdge
+		deps      []proto.TxnWaitEdge
+		expCycle  []proto.TxnWaitEdge
+		expVictim string
+	}{
+		// No dependencies.
+		{makeEdge("a", 1, "b"), nil, nil, ""},
+		// Dependencies not leading back to the pushee.
+		{makeEdge("a", 1, "b"), []proto.TxnWaitEdge{makeEdge("c", 1, "a")}, nil, ""},
+		// Cycle of two; equal priorities choose the lowest ID.
+		{
+			makeEdge("b", 1, "a"),
+			[]proto.TxnWaitEdge{makeEdge("a", 1, "b")},
+			[]proto.TxnWaitEdge{makeEdge("b", 1, "a"), makeEdge("a", 1, "b")},
+			"a",
+		},
+		// Cycle of three with an unrelated branch; lowest priority loses.
+		{
+			makeEdge("a", 3, "b"),
+			[]proto.TxnWaitEdge{makeEdge("d", 1, "a"), makeEdge("c", 2, "a"), makeEdge("b", 4, "c")},
+			[]proto.TxnWaitEdge{makeEdge("a", 3, "b"), makeEdge("b", 4, "c"), makeEdge("c", 2, "a")},
+			"c",
+		},
+	}
+	for i, test := range testCases {
+		cycle := findCycle(test.edge, test.deps)
+		if !reflect.DeepEqual(cycle, test.expCycle) {
+			t.Errorf("%d: expected cycle %v; got %v", i, test.expCycle, cycle)
+			continue
+		}
+		if cycle != nil {
+			if victim := string(deadlockVictim(cycle)); victim != test.expVictim {
+				t.Errorf("%d: expected victim %q; got %q", i, test.expVictim, victim)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// updateQueueMaxSize is the max size of the update queue.
+	updateQueueMaxSize = 100
+
+	// updateQueueTimerDuration is the duration between applying the
+	// updates of queued ranges.
+	updateQueueTimerDuration = 1 * time.Second
+)
+
+// updateQueue manages a queue of ranges which have pending updates
+// enqueued via EnqueueUpdate. Each pending update is applied in its
+// own transaction, which also removes the update from the range.
+type updateQueue struct {
+	*baseQueue
+	db *client.DB
+}
+
+// newUpdateQueue returns a new instance of updateQueue.
+func newUpdateQueue(db *client.DB) *updateQueue {
+	q := &updateQueue{
+		db: db,
+	}
+	q.baseQueue = newBaseQueue("update", q, updateQueueMaxSize)
+	return q
+}
+
+func (q *updateQueue) needsLeaderLease() bool {
+	return true
+}
+
+// shouldQueue determines whether the range has any pending updates.
+// All ranges with pending updates are queued at equal priority.
+func (q *updateQueue) shouldQueue(now proto.Timestamp, rng *Range) (bool, float64) {
+	updateKeys, err := pendingUpdates(rng.rm.Engine(), rng.Desc(), now, 1)
+	if err != nil {
+		log.Errorf("unable to scan range %d for pending updates: %s", rng.Desc().RaftID, err)
+		return false, 0
+	}
+	return len(updateKeys) > 0, 0
+}
+
+// process applies the pending updates of the range. Updates which fail
+// because of a failed condition are discarded; all others are logged,
+// remain queued and are retried on a later pass, while processing
+// continues with the next update.
+func (q *updateQueue) process(now proto.Timestamp, rng *Range) error {
+	updateKeys, err := pendingUpdates(rng.rm.Engine(), rng.Desc(), now, 0)
+	if err != nil {
+		return err
+	}
+	for _, key := range updateKeys {
+		err := q.db.Txn(func(txn *client.Txn) error {
+			var update proto.BatchRequest
+			kv, err := txn.Get(key)
+			if err != nil {
+				return err
+			}
+			if !kv.Exists() {
+				// The update has already been applied.
+				return nil
+			}
+			if err := kv.ValueProto(&update); err != nil {
+				return err
+			}
+			b := &client.Batch{}
+			for _, union := range update.Requests {
+				args := union.GetValue().(proto.Request)
+				if !args.Header().CmdID.IsEmpty() {
+					// Give the request a fresh command ID so that a retry
+					// of this transaction is not answered from the
+					// response cache.
+					args.Header().CmdID = proto.ClientCmdID{
+						WallTime: now.WallTime,
+						Random:   rand.Int63(),
+					}
+				}
+				b.InternalAddCall(proto.Call{Args: args, Reply: args.CreateReply()})
+			}
+			b.Del(key)
+			return txn.Commit(b)
+		})
+		if _, ok := err.(*proto.ConditionFailedError); ok {
+			log.Warningf("discarding update %s: %s", key, err)
+			err = q.db.Del(key)
+		}
+		if err != nil {
+			log.Warningf("unable to apply update %s: %s", key, err)
+		}
+	}
+	return nil
+}
+
+func (q *updateQueue) timer() time.Duration {
+	return updateQueueTimerDuration
+}
+
+// pendingUpdates returns the keys of up to max (or all, if max is 0)
+// updates enqueued with the range described by desc. The keys are
+// returned in the order in which the updates were enqueued for each
+// key.
+func pendingUpdates(eng engine.Engine, desc *proto.RangeDescriptor, now proto.Timestamp, max int) ([]proto.Key, error) {
+	start := keys.QueueUpdatePrefix(desc.StartKey)
+	end := keys.QueueUpdatePrefix(desc.EndKey)
+	var updateKeys []proto.Key
+	_, err := engine.MVCCIterate(eng, start, end, now, false /* !consistent */, nil, func(kv proto.KeyValue) (bool, error) {
+		updateKeys = append(updateKeys, kv.Key)
+		return max > 0 && len(updateKeys) >= max, nil
+	})
+	return updateKeys, err
+}
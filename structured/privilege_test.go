@@ -0,0 +1,112 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package structured
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestPrivilege(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	descriptor := NewDefaultPrivilegeDescriptor()
+
+	testCases := []struct {
+		grantee       string // User to grant/revoke privileges on.
+		grant, revoke privilege.List
+		show          []UserPrivilegeString
+	}{
+		{"", nil, nil,
+			[]UserPrivilegeString{{security.RootUser, "ALL"}},
+		},
+		{security.RootUser, privilege.List{privilege.ALL}, nil,
+			[]UserPrivilegeString{{security.RootUser, "ALL"}},
+		},
+		{security.RootUser, privilege.List{privilege.INSERT}, nil,
+			[]UserPrivilegeString{{security.RootUser, "ALL"}},
+		},
+		{"foo", privilege.List{privilege.INSERT}, nil,
+			[]UserPrivilegeString{{"foo", "INSERT"}, {security.RootUser, "ALL"}},
+		},
+		{"bar", nil, privilege.List{privilege.INSERT},
+			[]UserPrivilegeString{{"foo", "INSERT"}, {security.RootUser, "ALL"}},
+		},
+		{"foo", privilege.List{privilege.ALL}, nil,
+			[]UserPrivilegeString{{"foo", "ALL"}, {security.RootUser, "ALL"}},
+		},
+		{"foo", nil, privilege.List{privilege.SELECT, privilege.INSERT},
+			[]UserPrivilegeString{{"foo", "CREATE,DELETE,DROP,GRANT,UPDATE"}, {security.RootUser, "ALL"}},
+		},
+		{"foo", nil, privilege.List{privilege.ALL},
+			[]UserPrivilegeString{{security.RootUser, "ALL"}},
+		},
+		// Validate checks that root still has ALL privileges, but we do not call it here.
+		{security.RootUser, nil, privilege.List{privilege.SELECT, privilege.INSERT},
+			[]UserPrivilegeString{{security.RootUser, "CREATE,DELETE,DROP,GRANT,UPDATE"}},
+		},
+		{security.RootUser, nil, privilege.List{privilege.ALL},
+			[]UserPrivilegeString{},
+		},
+	}
+
+	for tcNum, tc := range testCases {
+		if tc.grantee != "" {
+			if tc.grant != nil {
+				descriptor.Grant(tc.grantee, tc.grant)
+			}
+			if tc.revoke != nil {
+				descriptor.Revoke(tc.grantee, tc.revoke)
+			}
+		}
+		show := descriptor.Show()
+		if !reflect.DeepEqual(show, tc.show) {
+			t.Fatalf("#%d: expected %+v, got %+v", tcNum, tc.show, show)
+		}
+	}
+}
+
+func TestCheckPrivilege(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testCases := []struct {
+		pd   *PrivilegeDescriptor
+		user string
+		priv privilege.Kind
+		exp  bool
+	}{
+		{NewDefaultPrivilegeDescriptor(), security.RootUser, privilege.CREATE, true},
+		{NewDefaultPrivilegeDescriptor(), "foo", privilege.CREATE, false},
+		{&PrivilegeDescriptor{Users: []UserPrivileges{{User: "foo", Privileges: privilege.List{privilege.CREATE}.ToBitField()}}},
+			"foo", privilege.CREATE, true},
+		{&PrivilegeDescriptor{Users: []UserPrivileges{{User: "foo", Privileges: privilege.List{privilege.CREATE}.ToBitField()}}},
+			"bar", privilege.CREATE, false},
+		{&PrivilegeDescriptor{Users: []UserPrivileges{{User: "foo", Privileges: privilege.List{privilege.CREATE}.ToBitField()}}},
+			"foo", privilege.DROP, false},
+		{&PrivilegeDescriptor{Users: []UserPrivileges{{User: "foo", Privileges: privilege.List{privilege.ALL}.ToBitField()}}},
+			"foo", privilege.DROP, true},
+	}
+
+	for tcNum, tc := range testCases {
+		if found := tc.pd.CheckPrivilege(tc.user, tc.priv); found != tc.exp {
+			t.Errorf("#%d: CheckPrivilege(%s, %v) for descriptor %+v = %t, expected %t",
+				tcNum, tc.user, tc.priv, tc.pd, found, tc.exp)
+		}
+	}
+}
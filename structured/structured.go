@@ -36,6 +36,37 @@ func validateName(name, typ string) error {
 	return nil
 }
 
+// SetID sets the ID of this descriptor.
+func (desc *TableDescriptor) SetID(id uint32) {
+	desc.ID = id
+}
+
+// TypeName returns the plain type of this descriptor.
+func (desc *TableDescriptor) TypeName() string {
+	if desc.IsSequence {
+		return "sequence"
+	}
+	if desc.IsView() {
+		return "view"
+	}
+	return "table"
+}
+
+// IsView returns true if the descriptor describes a view.
+func (desc *TableDescriptor) IsView() bool {
+	return desc.ViewQuery != ""
+}
+
+// SetID sets the ID of this descriptor.
+func (desc *DatabaseDescriptor) SetID(id uint32) {
+	desc.ID = id
+}
+
+// TypeName returns the plain type of this descriptor.
+func (desc *DatabaseDescriptor) TypeName() string {
+	return "database"
+}
+
 // AllocateIDs allocates column and index ids for any column or index which has
 // an ID of 0.
 func (desc *TableDescriptor) AllocateIDs() error {
@@ -89,13 +120,40 @@ func (desc *TableDescriptor) AllocateIDs() error {
 // Validate validates that the table descriptor is well formed. Checks include
 // validating the table, column and index names, verifying that column names
 // and index names are unique and verifying that column IDs and index IDs are
-// consistent.
+// consistent. A sequence must not contain any columns or indexes.
 func (desc *TableDescriptor) Validate() error {
-	if err := validateName(desc.Name, "table"); err != nil {
+	if err := validateName(desc.Name, desc.TypeName()); err != nil {
 		return err
 	}
 	if desc.ID == 0 {
-		return fmt.Errorf("invalid table ID 0")
+		return fmt.Errorf("invalid %s ID 0", desc.TypeName())
+	}
+
+	if desc.IsSequence {
+		if len(desc.Columns) != 0 || len(desc.Indexes) != 0 {
+			return fmt.Errorf("sequence must not contain columns or indexes")
+		}
+		return desc.validatePrivileges()
+	}
+
+	if desc.IsView() {
+		if len(desc.Columns) != 0 || len(desc.Indexes) != 0 {
+			return fmt.Errorf("view must not contain columns or indexes")
+		}
+		if len(desc.ViewColumns) == 0 {
+			return fmt.Errorf("view must contain at least 1 column")
+		}
+		viewColumns := map[string]struct{}{}
+		for _, name := range desc.ViewColumns {
+			if err := validateName(name, "column"); err != nil {
+				return err
+			}
+			if _, ok := viewColumns[name]; ok {
+				return fmt.Errorf("duplicate column name: \"%s\"", name)
+			}
+			viewColumns[name] = struct{}{}
+		}
+		return desc.validatePrivileges()
 	}
 
 	if len(desc.Columns) == 0 {
@@ -182,7 +240,69 @@ func (desc *TableDescriptor) Validate() error {
 			}
 		}
 	}
-	return nil
+
+	fkNames := map[string]struct{}{}
+	for _, fk := range desc.ForeignKeys {
+		if err := validateName(fk.Name, "foreign key"); err != nil {
+			return err
+		}
+		if _, ok := fkNames[fk.Name]; ok {
+			return fmt.Errorf("duplicate foreign key name: \"%s\"", fk.Name)
+		}
+		fkNames[fk.Name] = struct{}{}
+
+		if len(fk.ColumnIDs) == 0 {
+			return fmt.Errorf("foreign key \"%s\" must contain at least 1 column", fk.Name)
+		}
+		for _, colID := range fk.ColumnIDs {
+			if _, ok := columnIDs[colID]; !ok {
+				return fmt.Errorf("foreign key \"%s\" contains unknown column ID %d", fk.Name, colID)
+			}
+		}
+		if fk.ReferencedTableID == 0 || fk.ReferencedIndexID == 0 {
+			return fmt.Errorf("foreign key \"%s\" has an invalid reference", fk.Name)
+		}
+	}
+
+	checkNames := map[string]struct{}{}
+	for _, check := range desc.Checks {
+		if err := validateName(check.Name, "check constraint"); err != nil {
+			return err
+		}
+		if _, ok := checkNames[check.Name]; ok {
+			return fmt.Errorf("duplicate check constraint name: \"%s\"", check.Name)
+		}
+		checkNames[check.Name] = struct{}{}
+		if check.Expr == "" {
+			return fmt.Errorf("check constraint \"%s\" has an empty expression", check.Name)
+		}
+	}
+
+	return desc.validatePrivileges()
+}
+
+func (desc *TableDescriptor) validatePrivileges() error {
+	if desc.Privileges == nil {
+		return fmt.Errorf("missing privilege descriptor")
+	}
+	return desc.Privileges.Validate()
+}
+
+// Validate validates that the database descriptor is well formed.
+// Checks include validating the database name and verifying that the
+// root user has all privileges.
+func (desc *DatabaseDescriptor) Validate() error {
+	if err := validateName(desc.Name, "database"); err != nil {
+		return err
+	}
+	if desc.ID == 0 {
+		return fmt.Errorf("invalid database ID 0")
+	}
+	// Validate the privilege descriptor.
+	if desc.Privileges == nil {
+		return fmt.Errorf("missing privilege descriptor")
+	}
+	return desc.Privileges.Validate()
 }
 
 // FindColumnByName finds the column with specified name.
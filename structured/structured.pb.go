@@ -12,7 +12,12 @@
 		ColumnType
 		ColumnDescriptor
 		IndexDescriptor
+		ForeignKeyDescriptor
+		CheckConstraint
+		UserPrivileges
+		PrivilegeDescriptor
 		TableDescriptor
+		DatabaseDescriptor
 */
 package structured
 
@@ -87,6 +92,41 @@ func (x *ColumnType_Kind) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Action is the action taken on the referencing rows when a referenced row
+// is deleted.
+type ForeignKeyDescriptor_Action int32
+
+const (
+	ForeignKeyDescriptor_RESTRICT ForeignKeyDescriptor_Action = 0
+	ForeignKeyDescriptor_CASCADE  ForeignKeyDescriptor_Action = 1
+)
+
+var ForeignKeyDescriptor_Action_name = map[int32]string{
+	0: "RESTRICT",
+	1: "CASCADE",
+}
+var ForeignKeyDescriptor_Action_value = map[string]int32{
+	"RESTRICT": 0,
+	"CASCADE":  1,
+}
+
+func (x ForeignKeyDescriptor_Action) Enum() *ForeignKeyDescriptor_Action {
+	p := new(ForeignKeyDescriptor_Action)
+	*p = x
+	return p
+}
+func (x ForeignKeyDescriptor_Action) String() string {
+	return proto.EnumName(ForeignKeyDescriptor_Action_name, int32(x))
+}
+func (x *ForeignKeyDescriptor_Action) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ForeignKeyDescriptor_Action_value, data, "ForeignKeyDescriptor_Action")
+	if err != nil {
+		return err
+	}
+	*x = ForeignKeyDescriptor_Action(value)
+	return nil
+}
+
 type ColumnType struct {
 	Kind ColumnType_Kind `protobuf:"varint,1,opt,name=kind,enum=cockroach.structured.ColumnType_Kind" json:"kind"`
 	// BIT, INT, FLOAT, DECIMAL, CHAR and BINARY
@@ -122,11 +162,14 @@ func (m *ColumnType) GetPrecision() int32 {
 }
 
 type ColumnDescriptor struct {
-	Name             string     `protobuf:"bytes,1,opt,name=name" json:"name"`
-	ID               uint32     `protobuf:"varint,2,opt,name=id" json:"id"`
-	Type             ColumnType `protobuf:"bytes,3,opt,name=type" json:"type"`
-	Nullable         bool       `protobuf:"varint,4,opt,name=nullable" json:"nullable"`
-	XXX_unrecognized []byte     `json:"-"`
+	Name     string     `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ID       uint32     `protobuf:"varint,2,opt,name=id" json:"id"`
+	Type     ColumnType `protobuf:"bytes,3,opt,name=type" json:"type"`
+	Nullable bool       `protobuf:"varint,4,opt,name=nullable" json:"nullable"`
+	// default_expr is the SQL expression evaluated for the value of the column
+	// when an inserted row does not specify one.
+	DefaultExpr      *string `protobuf:"bytes,5,opt,name=default_expr" json:"default_expr,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *ColumnDescriptor) Reset()         { *m = ColumnDescriptor{} }
@@ -161,6 +204,13 @@ func (m *ColumnDescriptor) GetNullable() bool {
 	return false
 }
 
+func (m *ColumnDescriptor) GetDefaultExpr() string {
+	if m != nil && m.DefaultExpr != nil {
+		return *m.DefaultExpr
+	}
+	return ""
+}
+
 type IndexDescriptor struct {
 	Name   string `protobuf:"bytes,1,opt,name=name" json:"name"`
 	ID     uint32 `protobuf:"varint,2,opt,name=id" json:"id"`
@@ -215,6 +265,129 @@ func (m *IndexDescriptor) GetColumnIDs() []uint32 {
 	return nil
 }
 
+// ForeignKeyDescriptor describes a reference from columns of a table to the
+// columns of a unique index of another table. Every non-NULL value of the
+// referencing columns must exist in the referenced index.
+type ForeignKeyDescriptor struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	// The IDs of the referencing columns, in the order of the columns of the
+	// referenced index.
+	ColumnIDs         []uint32                    `protobuf:"varint,2,rep,name=column_ids" json:"column_ids,omitempty"`
+	ReferencedTableID uint32                      `protobuf:"varint,3,opt,name=referenced_table_id" json:"referenced_table_id"`
+	ReferencedIndexID uint32                      `protobuf:"varint,4,opt,name=referenced_index_id" json:"referenced_index_id"`
+	OnDelete          ForeignKeyDescriptor_Action `protobuf:"varint,5,opt,name=on_delete,enum=cockroach.structured.ForeignKeyDescriptor_Action" json:"on_delete"`
+	XXX_unrecognized  []byte                      `json:"-"`
+}
+
+func (m *ForeignKeyDescriptor) Reset()         { *m = ForeignKeyDescriptor{} }
+func (m *ForeignKeyDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ForeignKeyDescriptor) ProtoMessage()    {}
+
+func (m *ForeignKeyDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ForeignKeyDescriptor) GetColumnIDs() []uint32 {
+	if m != nil {
+		return m.ColumnIDs
+	}
+	return nil
+}
+
+func (m *ForeignKeyDescriptor) GetReferencedTableID() uint32 {
+	if m != nil {
+		return m.ReferencedTableID
+	}
+	return 0
+}
+
+func (m *ForeignKeyDescriptor) GetReferencedIndexID() uint32 {
+	if m != nil {
+		return m.ReferencedIndexID
+	}
+	return 0
+}
+
+func (m *ForeignKeyDescriptor) GetOnDelete() ForeignKeyDescriptor_Action {
+	if m != nil {
+		return m.OnDelete
+	}
+	return ForeignKeyDescriptor_RESTRICT
+}
+
+// CheckConstraint describes a boolean expression over the columns of a row
+// which must not evaluate to false for any row of the table.
+type CheckConstraint struct {
+	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Expr             string `protobuf:"bytes,2,opt,name=expr" json:"expr"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CheckConstraint) Reset()         { *m = CheckConstraint{} }
+func (m *CheckConstraint) String() string { return proto.CompactTextString(m) }
+func (*CheckConstraint) ProtoMessage()    {}
+
+func (m *CheckConstraint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CheckConstraint) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+// UserPrivileges describes the list of privileges available for a given user.
+type UserPrivileges struct {
+	User string `protobuf:"bytes,1,opt,name=user" json:"user"`
+	// privileges is a bitfield of 1<<Privilege values.
+	Privileges       uint32 `protobuf:"varint,2,opt,name=privileges" json:"privileges"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *UserPrivileges) Reset()         { *m = UserPrivileges{} }
+func (m *UserPrivileges) String() string { return proto.CompactTextString(m) }
+func (*UserPrivileges) ProtoMessage()    {}
+
+func (m *UserPrivileges) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserPrivileges) GetPrivileges() uint32 {
+	if m != nil {
+		return m.Privileges
+	}
+	return 0
+}
+
+// PrivilegeDescriptor describes a list of users and attached
+// privileges. The list should be sorted by user for fast access.
+type PrivilegeDescriptor struct {
+	Users            []UserPrivileges `protobuf:"bytes,1,rep,name=users" json:"users"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *PrivilegeDescriptor) Reset()         { *m = PrivilegeDescriptor{} }
+func (m *PrivilegeDescriptor) String() string { return proto.CompactTextString(m) }
+func (*PrivilegeDescriptor) ProtoMessage()    {}
+
+func (m *PrivilegeDescriptor) GetUsers() []UserPrivileges {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
 // A TableDescriptor represents a table and is stored in a structured metadata
 // key. The TableDescriptor has a globally-unique ID, while its member
 // {Column,Index}Descriptors have locally-unique IDs.
@@ -226,8 +399,32 @@ type TableDescriptor struct {
 	NextColumnID uint32            `protobuf:"varint,4,opt,name=next_column_id" json:"next_column_id"`
 	Indexes      []IndexDescriptor `protobuf:"bytes,5,rep,name=indexes" json:"indexes"`
 	// next_index_id is used to ensure that deleted index ids are not reused.
-	NextIndexID      uint32 `protobuf:"varint,6,opt,name=next_index_id" json:"next_index_id"`
-	XXX_unrecognized []byte `json:"-"`
+	NextIndexID uint32               `protobuf:"varint,6,opt,name=next_index_id" json:"next_index_id"`
+	Privileges  *PrivilegeDescriptor `protobuf:"bytes,7,opt,name=privileges" json:"privileges,omitempty"`
+	// version is incremented every time the descriptor is modified. Nodes
+	// hold leases on a specific version of the descriptor and at most two
+	// versions of a descriptor are in use across the cluster at any time.
+	Version uint32 `protobuf:"varint,8,opt,name=version" json:"version"`
+	// deleted is set when the table is being dropped. No new leases are
+	// granted on a deleted descriptor.
+	Deleted bool `protobuf:"varint,9,opt,name=deleted" json:"deleted"`
+	// is_sequence is set if the descriptor describes a sequence rather than a
+	// table. A sequence has no columns or indexes: its value is stored at the
+	// table prefix of its ID.
+	IsSequence  bool                   `protobuf:"varint,10,opt,name=is_sequence" json:"is_sequence"`
+	ForeignKeys []ForeignKeyDescriptor `protobuf:"bytes,11,rep,name=foreign_keys" json:"foreign_keys"`
+	// referenced_by lists the IDs of the tables with foreign keys referencing
+	// this table and of the views selecting from it.
+	ReferencedBy []uint32          `protobuf:"varint,12,rep,name=referenced_by" json:"referenced_by,omitempty"`
+	Checks       []CheckConstraint `protobuf:"bytes,13,rep,name=checks" json:"checks"`
+	// view_query is the SELECT statement of a view, with fully qualified table
+	// names. A view has no columns or indexes: the rows of the query are
+	// returned under the names in view_columns.
+	ViewQuery   string   `protobuf:"bytes,14,opt,name=view_query" json:"view_query"`
+	ViewColumns []string `protobuf:"bytes,15,rep,name=view_columns" json:"view_columns,omitempty"`
+	// depends_on lists the IDs of the tables and views a view selects from.
+	DependsOn        []uint32 `protobuf:"varint,16,rep,name=depends_on" json:"depends_on,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
 }
 
 func (m *TableDescriptor) Reset()         { *m = TableDescriptor{} }
@@ -276,8 +473,115 @@ func (m *TableDescriptor) GetNextIndexID() uint32 {
 	return 0
 }
 
+func (m *TableDescriptor) GetPrivileges() *PrivilegeDescriptor {
+	if m != nil {
+		return m.Privileges
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *TableDescriptor) GetIsSequence() bool {
+	if m != nil {
+		return m.IsSequence
+	}
+	return false
+}
+
+func (m *TableDescriptor) GetForeignKeys() []ForeignKeyDescriptor {
+	if m != nil {
+		return m.ForeignKeys
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetReferencedBy() []uint32 {
+	if m != nil {
+		return m.ReferencedBy
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetChecks() []CheckConstraint {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetViewQuery() string {
+	if m != nil {
+		return m.ViewQuery
+	}
+	return ""
+}
+
+func (m *TableDescriptor) GetViewColumns() []string {
+	if m != nil {
+		return m.ViewColumns
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetDependsOn() []uint32 {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+// DatabaseDescriptor represents a namespace (aka database) and is stored
+// in a structured metadata key. The DatabaseDescriptor has a globally-unique
+// ID shared with the TableDescriptor ID.
+// New tables inherit the privileges of their database.
+type DatabaseDescriptor struct {
+	Name             string               `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ID               uint32               `protobuf:"varint,2,opt,name=id" json:"id"`
+	Privileges       *PrivilegeDescriptor `protobuf:"bytes,3,opt,name=privileges" json:"privileges,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *DatabaseDescriptor) Reset()         { *m = DatabaseDescriptor{} }
+func (m *DatabaseDescriptor) String() string { return proto.CompactTextString(m) }
+func (*DatabaseDescriptor) ProtoMessage()    {}
+
+func (m *DatabaseDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseDescriptor) GetID() uint32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DatabaseDescriptor) GetPrivileges() *PrivilegeDescriptor {
+	if m != nil {
+		return m.Privileges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cockroach.structured.ColumnType_Kind", ColumnType_Kind_name, ColumnType_Kind_value)
+	proto.RegisterEnum("cockroach.structured.ForeignKeyDescriptor_Action", ForeignKeyDescriptor_Action_name, ForeignKeyDescriptor_Action_value)
 }
 func (m *ColumnType) Unmarshal(data []byte) error {
 	l := len(data)
@@ -464,6 +768,29 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 				}
 			}
 			m.Nullable = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExpr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.DefaultExpr = &s
+			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -624,7 +951,7 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *TableDescriptor) Unmarshal(data []byte) error {
+func (m *ForeignKeyDescriptor) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -667,47 +994,39 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIDs", wireType)
 			}
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ID |= (uint32(b) & 0x7F) << shift
+				v |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ColumnIDs = append(m.ColumnIDs, v)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencedTableID", wireType)
 			}
-			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ReferencedTableID |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Columns = append(m.Columns, ColumnDescriptor{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextColumnID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencedIndexID", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
@@ -715,39 +1034,14 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.NextColumnID |= (uint32(b) & 0x7F) << shift
+				m.ReferencedIndexID |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Indexes = append(m.Indexes, IndexDescriptor{})
-			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextIndexID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDelete", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
@@ -755,7 +1049,7 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.NextIndexID |= (uint32(b) & 0x7F) << shift
+				m.OnDelete |= (ForeignKeyDescriptor_Action(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -784,14 +1078,14 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 
 	return nil
 }
-func skipStructured(data []byte) (n int, err error) {
+func (m *CheckConstraint) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
+				return io.ErrUnexpectedEOF
 			}
 			b := data[iNdEx]
 			iNdEx++
@@ -800,27 +1094,733 @@ func skipStructured(data []byte) (n int, err error) {
 				break
 			}
 		}
+		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for {
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if data[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-			return iNdEx, nil
+		switch fieldNum {
 		case 1:
-			iNdEx += 8
-			return iNdEx, nil
-		case 2:
-			var length int
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *UserPrivileges) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Privileges |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *PrivilegeDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, UserPrivileges{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *TableDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, ColumnDescriptor{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextColumnID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextColumnID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, IndexDescriptor{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndexID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextIndexID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Privileges == nil {
+				m.Privileges = &PrivilegeDescriptor{}
+			}
+			if err := m.Privileges.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Version |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSequence", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSequence = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignKeys = append(m.ForeignKeys, ForeignKeyDescriptor{})
+			if err := m.ForeignKeys[len(m.ForeignKeys)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencedBy", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReferencedBy = append(m.ReferencedBy, v)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, CheckConstraint{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewQuery", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewQuery = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewColumns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewColumns = append(m.ViewColumns, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DependsOn = append(m.DependsOn, v)
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *DatabaseDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Privileges == nil {
+				m.Privileges = &PrivilegeDescriptor{}
+			}
+			if err := m.Privileges.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func skipStructured(data []byte) (n int, err error) {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for {
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if data[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
@@ -866,53 +1866,116 @@ func skipStructured(data []byte) (n int, err error) {
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
 	}
-	panic("unreachable")
+	panic("unreachable")
+}
+func (m *ColumnType) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.Kind))
+	n += 1 + sovStructured(uint64(m.Width))
+	n += 1 + sovStructured(uint64(m.Precision))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ColumnDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.ID))
+	l = m.Type.Size()
+	n += 1 + l + sovStructured(uint64(l))
+	n += 2
+	if m.DefaultExpr != nil {
+		l = len(*m.DefaultExpr)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.ID))
+	n += 2
+	if len(m.ColumnNames) > 0 {
+		for _, s := range m.ColumnNames {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ColumnIDs) > 0 {
+		for _, e := range m.ColumnIDs {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForeignKeyDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	if len(m.ColumnIDs) > 0 {
+		for _, e := range m.ColumnIDs {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	n += 1 + sovStructured(uint64(m.ReferencedTableID))
+	n += 1 + sovStructured(uint64(m.ReferencedIndexID))
+	n += 1 + sovStructured(uint64(m.OnDelete))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *ColumnType) Size() (n int) {
+
+func (m *CheckConstraint) Size() (n int) {
 	var l int
 	_ = l
-	n += 1 + sovStructured(uint64(m.Kind))
-	n += 1 + sovStructured(uint64(m.Width))
-	n += 1 + sovStructured(uint64(m.Precision))
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	l = len(m.Expr)
+	n += 1 + l + sovStructured(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ColumnDescriptor) Size() (n int) {
+func (m *UserPrivileges) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.User)
 	n += 1 + l + sovStructured(uint64(l))
-	n += 1 + sovStructured(uint64(m.ID))
-	l = m.Type.Size()
-	n += 1 + l + sovStructured(uint64(l))
-	n += 2
+	n += 1 + sovStructured(uint64(m.Privileges))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *IndexDescriptor) Size() (n int) {
+func (m *PrivilegeDescriptor) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovStructured(uint64(l))
-	n += 1 + sovStructured(uint64(m.ID))
-	n += 2
-	if len(m.ColumnNames) > 0 {
-		for _, s := range m.ColumnNames {
-			l = len(s)
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
 			n += 1 + l + sovStructured(uint64(l))
 		}
 	}
-	if len(m.ColumnIDs) > 0 {
-		for _, e := range m.ColumnIDs {
-			n += 1 + sovStructured(uint64(e))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -939,6 +2002,59 @@ func (m *TableDescriptor) Size() (n int) {
 		}
 	}
 	n += 1 + sovStructured(uint64(m.NextIndexID))
+	if m.Privileges != nil {
+		l = m.Privileges.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	n += 1 + sovStructured(uint64(m.Version))
+	n += 2
+	n += 2
+	if len(m.ForeignKeys) > 0 {
+		for _, e := range m.ForeignKeys {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ReferencedBy) > 0 {
+		for _, e := range m.ReferencedBy {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	l = len(m.ViewQuery)
+	n += 1 + l + sovStructured(uint64(l))
+	if len(m.ViewColumns) > 0 {
+		for _, s := range m.ViewColumns {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			n += 2 + sovStructured(uint64(e))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatabaseDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.ID))
+	if m.Privileges != nil {
+		l = m.Privileges.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1026,6 +2142,12 @@ func (m *ColumnDescriptor) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0
 	}
 	i++
+	if m.DefaultExpr != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(*m.DefaultExpr)))
+		i += copy(data[i:], *m.DefaultExpr)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1090,6 +2212,137 @@ func (m *IndexDescriptor) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *ForeignKeyDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ForeignKeyDescriptor) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	if len(m.ColumnIDs) > 0 {
+		for _, num := range m.ColumnIDs {
+			data[i] = 0x10
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ReferencedTableID))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ReferencedIndexID))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.OnDelete))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CheckConstraint) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CheckConstraint) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Expr)))
+	i += copy(data[i:], m.Expr)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UserPrivileges) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UserPrivileges) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.User)))
+	i += copy(data[i:], m.User)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Privileges))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PrivilegeDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PrivilegeDescriptor) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, msg := range m.Users {
+			data[i] = 0xa
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *TableDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1142,6 +2395,132 @@ func (m *TableDescriptor) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x30
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.NextIndexID))
+	if m.Privileges != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
+		n2, err := m.Privileges.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	data[i] = 0x40
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Version))
+	data[i] = 0x48
+	i++
+	if m.Deleted {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x50
+	i++
+	if m.IsSequence {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if len(m.ForeignKeys) > 0 {
+		for _, msg := range m.ForeignKeys {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ReferencedBy) > 0 {
+		for _, num := range m.ReferencedBy {
+			data[i] = 0x60
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	if len(m.Checks) > 0 {
+		for _, msg := range m.Checks {
+			data[i] = 0x6a
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x72
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.ViewQuery)))
+	i += copy(data[i:], m.ViewQuery)
+	if len(m.ViewColumns) > 0 {
+		for _, s := range m.ViewColumns {
+			data[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.DependsOn) > 0 {
+		for _, num := range m.DependsOn {
+			data[i] = 0x80
+			i++
+			data[i] = 0x1
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DatabaseDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DatabaseDescriptor) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ID))
+	if m.Privileges != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
+		n3, err := m.Privileges.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -38,6 +38,7 @@ func TestAllocateIDs(t *testing.T) {
 			{Name: "c", ColumnNames: []string{"a"}},
 			{Name: "d", ColumnNames: []string{"b", "a"}},
 		},
+		Privileges: NewDefaultPrivilegeDescriptor(),
 	}
 	if err := desc.AllocateIDs(); err != nil {
 		t.Fatal(err)
@@ -54,6 +55,7 @@ func TestAllocateIDs(t *testing.T) {
 			{ID: 1, Name: "c", ColumnIDs: []uint32{1}, ColumnNames: []string{"a"}},
 			{ID: 2, Name: "d", ColumnIDs: []uint32{2, 1}, ColumnNames: []string{"b", "a"}},
 		},
+		Privileges:   NewDefaultPrivilegeDescriptor(),
 		NextColumnID: 3,
 		NextIndexID:  3,
 	}
@@ -75,6 +77,29 @@ func TestValidateTableDesc(t *testing.T) {
 			TableDescriptor{ID: 0, Name: "foo"}},
 		{`table must contain at least 1 column`,
 			TableDescriptor{ID: 1, Name: "foo"}},
+		{`empty sequence name`,
+			TableDescriptor{IsSequence: true}},
+		{`sequence must not contain columns or indexes`,
+			TableDescriptor{
+				ID:         1,
+				Name:       "foo",
+				IsSequence: true,
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				NextColumnID: 2,
+			}},
+		{`missing privilege descriptor`,
+			TableDescriptor{ID: 1, Name: "foo", IsSequence: true}},
+		{`view must contain at least 1 column`,
+			TableDescriptor{ID: 1, Name: "foo", ViewQuery: "SELECT 1"}},
+		{`duplicate column name: "bar"`,
+			TableDescriptor{
+				ID:          1,
+				Name:        "foo",
+				ViewQuery:   "SELECT 1 AS bar, 2 AS bar",
+				ViewColumns: []string{"bar", "bar"},
+			}},
 		{`empty column name`,
 			TableDescriptor{
 				ID:   1,
@@ -239,6 +264,83 @@ func TestValidateTableDesc(t *testing.T) {
 				NextColumnID: 2,
 				NextIndexID:  2,
 			}},
+		{`missing privilege descriptor`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				Indexes: []IndexDescriptor{
+					{ID: 1, Name: "bar", ColumnIDs: []uint32{1}, ColumnNames: []string{"bar"}},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`root user does not have privileges`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				Indexes: []IndexDescriptor{
+					{ID: 1, Name: "bar", ColumnIDs: []uint32{1}, ColumnNames: []string{"bar"}},
+				},
+				Privileges:   &PrivilegeDescriptor{},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`foreign key "fk" contains unknown column ID 2`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				Indexes: []IndexDescriptor{
+					{ID: 1, Name: "bar", ColumnIDs: []uint32{1}, ColumnNames: []string{"bar"}},
+				},
+				ForeignKeys: []ForeignKeyDescriptor{
+					{Name: "fk", ColumnIDs: []uint32{2}, ReferencedTableID: 2, ReferencedIndexID: 1},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`duplicate foreign key name: "fk"`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				Indexes: []IndexDescriptor{
+					{ID: 1, Name: "bar", ColumnIDs: []uint32{1}, ColumnNames: []string{"bar"}},
+				},
+				ForeignKeys: []ForeignKeyDescriptor{
+					{Name: "fk", ColumnIDs: []uint32{1}, ReferencedTableID: 2, ReferencedIndexID: 1},
+					{Name: "fk", ColumnIDs: []uint32{1}, ReferencedTableID: 3, ReferencedIndexID: 1},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`duplicate check constraint name: "check_bar"`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				Indexes: []IndexDescriptor{
+					{ID: 1, Name: "bar", ColumnIDs: []uint32{1}, ColumnNames: []string{"bar"}},
+				},
+				Checks: []CheckConstraint{
+					{Name: "check_bar", Expr: "bar > 0"},
+					{Name: "check_bar", Expr: "bar < 10"},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
 	}
 	for i, d := range testData {
 		if err := d.desc.Validate(); err == nil {